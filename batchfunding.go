@@ -0,0 +1,367 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/psbt"
+)
+
+// batchChannel describes a single channel within a batch of channels that are
+// all funded by the same funding transaction.
+type batchChannel struct {
+	peer lnpeer.Peer
+	req  *openChanReq
+
+	// pendingChanID and fundingOutput are known once the remote peer has
+	// accepted the channel.
+	pendingChanID [32]byte
+	fundingOutput *wire.TxOut
+
+	// resCtx is the reservation context of the channel, which is set once
+	// the batch has been handed to the reservationCoordinator.
+	resCtx *reservationWithCtx
+}
+
+// fundingBatch tracks a set of channels with different peers that are all
+// funded by a single funding transaction. The funding transaction is only
+// broadcast once every peer has signed our version of the commitment
+// transaction, and none of the channels are opened if the funding workflow of
+// any of them fails.
+//
+// NOTE: Once the batch has been handed to the reservationCoordinator, it MUST
+// only be accessed from within that goroutine.
+type fundingBatch struct {
+	channels  []*batchChannel
+	fundingTx *wire.MsgTx
+
+	// signed holds the FundingSigned messages received so far, indexed by
+	// the pending channel ID of the channel they were sent for.
+	signed map[[32]byte]*lnwire.FundingSigned
+
+	// finished is set once the batch has either been broadcast, or has
+	// been aborted.
+	finished bool
+
+	// done is sent the outcome of the batch once it has finished.
+	//
+	// NOTE: This channel MUST be buffered.
+	done chan error
+}
+
+// fundingBatchMsg hands a batch whose funding transaction has been crafted to
+// the reservationCoordinator, resuming the funding workflow of its channels.
+type fundingBatchMsg struct {
+	batch *fundingBatch
+}
+
+// fundingBatchAbortMsg requests the reservationCoordinator to abort a batch,
+// as the funding workflow of one of its channels has failed.
+type fundingBatchAbortMsg struct {
+	batch *fundingBatch
+	err   error
+}
+
+// BatchFund opens a channel with each of the given peers, funding all of them
+// within a single transaction crafted by the wallet. It blocks until the
+// funding transaction has been broadcast, returning the funding outpoints of
+// the channels in the order of the requests. If the funding workflow of any
+// of the channels fails, the whole batch is aborted, and the coins selected
+// to fund it are released.
+func (f *fundingManager) BatchFund(peers []lnpeer.Peer, reqs []*openChanReq,
+	feeRate lnwallet.SatPerKWeight, minConfs int32) ([]*wire.OutPoint,
+	error) {
+
+	batch := &fundingBatch{
+		signed: make(map[[32]byte]*lnwire.FundingSigned),
+		done:   make(chan error, 1),
+	}
+
+	// First, we'll start the funding workflow of every channel. As the
+	// channels are funded externally, each workflow pauses once the remote
+	// peer has accepted the channel, handing us the funding output.
+	for i, req := range reqs {
+		req.psbtFunding = true
+		f.initFundingWorkflow(peers[i], req)
+
+		batch.channels = append(batch.channels, &batchChannel{
+			peer: peers[i],
+			req:  req,
+		})
+	}
+
+	// We'll wait for the workflow of every channel to either be ready to
+	// be funded or to fail, such that no reservation is left behind if we
+	// need to abort the batch.
+	var fundErr error
+	for _, channel := range batch.channels {
+		select {
+		case upd := <-channel.req.updates:
+			err := channel.readyForFunding(upd)
+			if err != nil && fundErr == nil {
+				fundErr = err
+			}
+
+		case err := <-channel.req.err:
+			if fundErr == nil {
+				fundErr = err
+			}
+
+		case <-f.quit:
+			return nil, ErrFundingManagerShuttingDown
+		}
+	}
+	if fundErr != nil {
+		f.abortBatch(batch, fundErr)
+		return nil, fundErr
+	}
+
+	// With all funding outputs known, we'll have the wallet craft the
+	// funding transaction.
+	outputs := make([]*wire.TxOut, 0, len(batch.channels))
+	for _, channel := range batch.channels {
+		outputs = append(outputs, channel.fundingOutput)
+	}
+	fundingTx, err := f.cfg.Wallet.FundOutputs(outputs, feeRate, minConfs)
+	if err != nil {
+		f.abortBatch(batch, err)
+		return nil, err
+	}
+	batch.fundingTx = fundingTx
+
+	fndgLog.Infof("Funding batch of %v channels with tx %v",
+		len(batch.channels), fundingTx.TxHash())
+
+	// From now on, the failure of any of the workflows must abort the
+	// batch, which we'll leave to the reservationCoordinator.
+	batchQuit := make(chan struct{})
+	defer close(batchQuit)
+	for _, channel := range batch.channels {
+		go func(errChan chan error) {
+			select {
+			case err := <-errChan:
+				select {
+				case f.fundingMsgs <- &fundingBatchAbortMsg{
+					batch: batch,
+					err:   err,
+				}:
+				case <-f.quit:
+				}
+
+			case <-batchQuit:
+			case <-f.quit:
+			}
+		}(channel.req.err)
+	}
+
+	select {
+	case f.fundingMsgs <- &fundingBatchMsg{batch}:
+	case <-f.quit:
+		return nil, ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case err := <-batch.done:
+		if err != nil {
+			return nil, err
+		}
+	case <-f.quit:
+		return nil, ErrFundingManagerShuttingDown
+	}
+
+	fundingTxID := fundingTx.TxHash()
+	outPoints := make([]*wire.OutPoint, 0, len(batch.channels))
+	for _, channel := range batch.channels {
+		_, index := lnwallet.FindScriptOutputIndex(
+			fundingTx, channel.fundingOutput.PkScript,
+		)
+		outPoints = append(outPoints, wire.NewOutPoint(
+			&fundingTxID, index,
+		))
+	}
+
+	return outPoints, nil
+}
+
+// readyForFunding records the funding output of the channel from the update
+// sent once the remote peer has accepted the channel.
+func (c *batchChannel) readyForFunding(upd *lnrpc.OpenStatusUpdate) error {
+	psbtFund, ok := upd.Update.(*lnrpc.OpenStatusUpdate_PsbtFund)
+	if !ok {
+		return fmt.Errorf("unexpected funding update %T", upd.Update)
+	}
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(psbtFund.PsbtFund.Psbt), false,
+	)
+	if err != nil {
+		return err
+	}
+	if len(packet.UnsignedTx.TxOut) != 1 {
+		return fmt.Errorf("expected single funding output, got %v",
+			len(packet.UnsignedTx.TxOut))
+	}
+
+	copy(c.pendingChanID[:], psbtFund.PsbtFund.PendingChanId)
+	c.fundingOutput = packet.UnsignedTx.TxOut[0]
+
+	return nil
+}
+
+// handleFundingBatch hands the funding transaction of the batch to the
+// reservation of each of its channels, then sends the funding created message
+// to every peer.
+func (f *fundingManager) handleFundingBatch(msg *fundingBatchMsg) {
+	batch := msg.batch
+
+	// If one of the workflows failed while the funding transaction was
+	// being crafted, the batch has already been aborted.
+	if batch.finished {
+		return
+	}
+
+	for _, channel := range batch.channels {
+		resCtx, err := f.getReservationCtx(
+			channel.peer.IdentityKey(), channel.pendingChanID,
+		)
+		if err == nil {
+			err = resCtx.reservation.ProcessExternalFunding(
+				batch.fundingTx,
+			)
+		}
+		if err != nil {
+			fndgLog.Errorf("Unable to fund pendingID(%x) within "+
+				"batch: %v", channel.pendingChanID[:], err)
+			f.abortBatch(batch, err)
+			return
+		}
+
+		resCtx.batch = batch
+		channel.resCtx = resCtx
+	}
+
+	for _, channel := range batch.channels {
+		f.sendFundingCreated(channel.resCtx, channel.pendingChanID)
+	}
+}
+
+// handleBatchFundingSigned records the FundingSigned message of a channel
+// within a batch. Once every channel of the batch has been signed, all of
+// them are committed to disk, and the shared funding transaction is
+// broadcast.
+func (f *fundingManager) handleBatchFundingSigned(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, msg *lnwire.FundingSigned) {
+
+	batch := resCtx.batch
+	if batch.finished {
+		return
+	}
+
+	batch.signed[pendingChanID] = msg
+	if len(batch.signed) < len(batch.channels) {
+		fndgLog.Debugf("Received FundingSigned for pendingID(%x), "+
+			"waiting for %v more within batch", pendingChanID[:],
+			len(batch.channels)-len(batch.signed))
+		return
+	}
+
+	// Every peer has signed our commitment transaction, so we can now
+	// complete all reservations. If any of them fails, then we'll abandon
+	// the channels we've already committed to disk, as their funding
+	// transaction won't be broadcast.
+	completeChans := make([]*channeldb.OpenChannel, 0, len(batch.channels))
+	for _, channel := range batch.channels {
+		msg := batch.signed[channel.pendingChanID]
+		completeChan, err := f.completeReservation(
+			channel.resCtx, channel.pendingChanID, nil,
+			msg.CommitSig.ToSignatureBytes(),
+		)
+		if err != nil {
+			for _, completeChan := range completeChans {
+				f.abandonPendingChannel(completeChan)
+			}
+			f.abortBatch(batch, err)
+			return
+		}
+
+		completeChans = append(completeChans, completeChan)
+	}
+
+	batch.finished = true
+
+	// As all channels share the same funding transaction, we only need to
+	// broadcast it once.
+	f.publishFundingTx(completeChans[0])
+	for i, channel := range batch.channels {
+		f.watchPendingChannel(
+			channel.resCtx, channel.pendingChanID, completeChans[i],
+		)
+	}
+
+	batch.done <- nil
+}
+
+// handleFundingBatchAbort aborts the batch of the request, unless it has
+// already finished.
+func (f *fundingManager) handleFundingBatchAbort(msg *fundingBatchAbortMsg) {
+	f.abortBatch(msg.batch, msg.err)
+}
+
+// abortBatch fails the funding workflow of every channel of the batch that is
+// still pending, and releases the coins selected to fund the batch.
+func (f *fundingManager) abortBatch(batch *fundingBatch, err error) {
+	if batch.finished {
+		return
+	}
+	batch.finished = true
+
+	fndgLog.Errorf("Aborting funding batch of %v channels: %v",
+		len(batch.channels), err)
+
+	for _, channel := range batch.channels {
+		peerKey := channel.peer.IdentityKey()
+		if !f.IsPendingChannel(channel.pendingChanID, peerKey) {
+			continue
+		}
+
+		f.failFundingFlow(channel.peer, channel.pendingChanID, err)
+	}
+
+	if batch.fundingTx != nil {
+		if err := f.cfg.Wallet.ReleaseInputs(batch.fundingTx); err != nil {
+			fndgLog.Errorf("Unable to release inputs of funding "+
+				"batch: %v", err)
+		}
+	}
+
+	batch.done <- err
+}
+
+// abandonPendingChannel removes a pending channel whose funding transaction
+// will never be broadcast from the database, leaving only a close summary.
+func (f *fundingManager) abandonPendingChannel(
+	completeChan *channeldb.OpenChannel) {
+
+	summary := &channeldb.ChannelCloseSummary{
+		CloseType:               channeldb.Abandoned,
+		ChanPoint:               completeChan.FundingOutpoint,
+		ChainHash:               completeChan.ChainHash,
+		RemotePub:               completeChan.IdentityPub,
+		Capacity:                completeChan.Capacity,
+		SettledBalance:          completeChan.LocalCommitment.LocalBalance.ToSatoshis(),
+		ShortChanID:             completeChan.ShortChanID(),
+		RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    completeChan.RemoteNextRevocation,
+		LocalChanConfig:         completeChan.LocalChanCfg,
+	}
+	if err := completeChan.CloseChannel(summary); err != nil {
+		fndgLog.Errorf("Unable to abandon ChannelPoint(%v): %v",
+			completeChan.FundingOutpoint, err)
+	}
+}
@@ -40,6 +40,11 @@ var (
 	// errBrarShuttingDown is an error returned if the breacharbiter has
 	// been signalled to exit.
 	errBrarShuttingDown = errors.New("breacharbiter shutting down")
+
+	// errRetributionCancelled is an error returned if the retribution for
+	// a channel has been cancelled, as its breach transaction has been
+	// reorged out of the chain.
+	errRetributionCancelled = errors.New("retribution cancelled")
 )
 
 // ContractBreachEvent is an event the breachArbiter will receive in case a
@@ -61,6 +66,20 @@ type ContractBreachEvent struct {
 	BreachRetribution *lnwallet.BreachRetribution
 }
 
+// ContractBreachReorgEvent is an event the breachArbiter will receive in case
+// the transaction of a contract breach it was handed off has been reorged out
+// of the chain.
+type ContractBreachReorgEvent struct {
+	// ChanPoint is the channel point of the breached channel.
+	ChanPoint wire.OutPoint
+
+	// ProcessACK is an error channel where a nil error should be sent
+	// iff the retribution info of the channel has been removed from the
+	// retribution store. In case removing it fails, a non-nil error
+	// should be sent.
+	ProcessACK chan error
+}
+
 // BreachConfig bundles the required subsystems used by the breach arbiter. An
 // instance of BreachConfig is passed to newBreachArbiter during instantiation.
 type BreachConfig struct {
@@ -97,6 +116,14 @@ type BreachConfig struct {
 	// the sending subsystem knows that the event is properly handed off.
 	ContractBreaches <-chan *ContractBreachEvent
 
+	// ContractBreachReorgs is a channel where the breachArbiter will
+	// receive notifications in the event that the transaction of a
+	// contract breach it was handed off is reorged out of the chain. A
+	// ContractBreachReorgEvent must be ACKed by the breachArbiter, such
+	// that the sending subsystem knows the breach can be handed off again
+	// once the channel is closed within the new chain.
+	ContractBreachReorgs <-chan *ContractBreachReorgEvent
+
 	// Signer is used by the breach arbiter to generate sweep transactions,
 	// which move coins from previously open channels back to the user's
 	// wallet.
@@ -122,17 +149,66 @@ type breachArbiter struct {
 
 	cfg *BreachConfig
 
+	// retributions maps the channel point of each breached channel we're
+	// exacting retribution for to the task doing so, such that it can be
+	// cancelled if the breach transaction is reorged out of the chain.
+	// This map is guarded by the breachArbiter's mutex.
+	retributions map[wire.OutPoint]*retributionTask
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 	sync.Mutex
 }
 
+// retributionTask tracks the exactRetribution goroutine of a breached
+// channel.
+type retributionTask struct {
+	// cancel is closed to signal the goroutine to stop exacting
+	// retribution, as the breach transaction has been reorged out of the
+	// chain.
+	cancel chan struct{}
+
+	// done is closed once the goroutine has exited.
+	done chan struct{}
+}
+
+// newRetributionTask creates a new retribution task for the channel, and
+// tracks it within the retributions map.
+//
+// NOTE: The breachArbiter's mutex MUST be held when calling this method.
+func (b *breachArbiter) newRetributionTask(
+	chanPoint wire.OutPoint) *retributionTask {
+
+	task := &retributionTask{
+		cancel: make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	b.retributions[chanPoint] = task
+
+	return task
+}
+
+// retributionDone stops tracking the retribution task of the channel, and
+// signals that it has exited.
+func (b *breachArbiter) retributionDone(chanPoint wire.OutPoint,
+	task *retributionTask) {
+
+	b.Lock()
+	if b.retributions[chanPoint] == task {
+		delete(b.retributions, chanPoint)
+	}
+	b.Unlock()
+
+	close(task.done)
+}
+
 // newBreachArbiter creates a new instance of a breachArbiter initialized with
 // its dependent objects.
 func newBreachArbiter(cfg *BreachConfig) *breachArbiter {
 	return &breachArbiter{
-		cfg:  cfg,
-		quit: make(chan struct{}),
+		cfg:          cfg,
+		retributions: make(map[wire.OutPoint]*retributionTask),
+		quit:         make(chan struct{}),
 	}
 }
 
@@ -196,9 +272,29 @@ func (b *breachArbiter) Start() error {
 		retInfo := breachRetInfos[chanPoint]
 
 		// Register for a notification when the breach transaction is
-		// confirmed on chain.
+		// confirmed on chain. If none of the breached outputs reside
+		// on the breach transaction anymore, they have all been taken
+		// to the second level, which implies the breach transaction
+		// has already confirmed.
 		breachTXID := retInfo.commitHash
-		breachScript := retInfo.breachedOutputs[0].signDesc.Output.PkScript
+		breachScript := retInfo.commitPkScript()
+		if breachScript == nil {
+			confChan := &chainntnfs.ConfirmationEvent{
+				Confirmed: make(chan *chainntnfs.TxConfirmation, 1),
+			}
+			confChan.Confirmed <- &chainntnfs.TxConfirmation{
+				BlockHeight: retInfo.breachHeight,
+			}
+
+			b.Lock()
+			task := b.newRetributionTask(chanPoint)
+			b.Unlock()
+
+			b.wg.Add(1)
+			go b.exactRetribution(confChan, &retInfo, task)
+			continue
+		}
+
 		confChan, err := b.cfg.Notifier.RegisterConfirmationsNtfn(
 			&breachTXID, breachScript, 1, retInfo.breachHeight,
 		)
@@ -210,8 +306,12 @@ func (b *breachArbiter) Start() error {
 
 		// Launch a new goroutine which to finalize the channel
 		// retribution after the breach transaction confirms.
+		b.Lock()
+		task := b.newRetributionTask(chanPoint)
+		b.Unlock()
+
 		b.wg.Add(1)
-		go b.exactRetribution(confChan, &retInfo)
+		go b.exactRetribution(confChan, &retInfo, task)
 	}
 
 	// Start watching the remaining active channels!
@@ -266,6 +366,14 @@ func (b *breachArbiter) contractObserver() {
 			b.wg.Add(1)
 			go b.handleBreachHandoff(breachEvent)
 
+		case reorgEvent := <-b.cfg.ContractBreachReorgs:
+			// The breach transaction of a channel has been
+			// reorged out of the chain. Handle the reorg, making
+			// sure we ACK the event after we have dropped the
+			// retribution.
+			b.wg.Add(1)
+			go b.handleBreachReorg(reorgEvent)
+
 		case <-b.quit:
 			return
 		}
@@ -311,21 +419,25 @@ func convertToSecondLevelRevoke(bo *breachedOutput, breachInfo *retributionInfo,
 		bo.outpoint)
 }
 
+// breachedOutputSpend wraps the index of a breached output that got spent
+// together with the spend details.
+type breachedOutputSpend struct {
+	index  int
+	detail *chainntnfs.SpendDetail
+}
+
 // waitForSpendEvent waits for any of the breached outputs to get spent, and
-// mutates the breachInfo to be able to sweep it. This method should be used
-// when we fail to publish the justice tx because of a double spend, indicating
-// that the counter party has taken one of the breached outputs to the second
-// level. The spendNtfns map is a cache used to store registered spend
-// subscriptions, in case we must call this method multiple times.
+// returns the spends detected. Each breached output is watched separately,
+// except for the ones in the skip set, as any of them may be spent by either
+// our justice tx, or by the cheating party taking it to the second level, or
+// sweeping it after its delay has expired. The spendNtfns map is a cache used
+// to store registered spend subscriptions, in case we must call this method
+// multiple times. If the cancel channel is closed, errRetributionCancelled is
+// returned.
 func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
-	spendNtfns map[wire.OutPoint]*chainntnfs.SpendEvent) error {
-
-	// spend is used to wrap the index of the output that gets spent
-	// together with the spend details.
-	type spend struct {
-		index  int
-		detail *chainntnfs.SpendDetail
-	}
+	spendNtfns map[wire.OutPoint]*chainntnfs.SpendEvent,
+	skip map[wire.OutPoint]struct{},
+	cancel <-chan struct{}) ([]breachedOutputSpend, error) {
 
 	// We create a channel the first goroutine that gets a spend event can
 	// signal. We make it buffered in case multiple spend events come in at
@@ -334,26 +446,28 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 
 	// The allSpends channel will be used to pass spend events from all the
 	// goroutines that detects a spend before they are signalled to exit.
-	allSpends := make(chan spend, len(breachInfo.breachedOutputs))
+	allSpends := make(
+		chan breachedOutputSpend, len(breachInfo.breachedOutputs),
+	)
 
 	// exit will be used to signal the goroutines that they can exit.
 	exit := make(chan struct{})
 	var wg sync.WaitGroup
 
-	// We'll now launch a goroutine for each of the HTLC outputs, that will
-	// signal the moment they detect a spend event.
+	// We'll now launch a goroutine for each of the breached outputs, that
+	// will signal the moment they detect a spend event.
 	for i := 0; i < len(breachInfo.breachedOutputs); i++ {
 		breachedOutput := &breachInfo.breachedOutputs[i]
 
-		// If this isn't an HTLC output, then we can skip it.
-		if breachedOutput.witnessType != lnwallet.HtlcAcceptedRevoke &&
-			breachedOutput.witnessType != lnwallet.HtlcOfferedRevoke {
+		// If we already know this output has been spent, then we can
+		// skip it.
+		if _, ok := skip[breachedOutput.outpoint]; ok {
 			continue
 		}
 
-		brarLog.Debugf("Checking for second-level attempt on HTLC(%v) "+
-			"for ChannelPoint(%v)", breachedOutput.outpoint,
-			breachInfo.chanPoint)
+		brarLog.Debugf("Checking for spend of %v(%v) for "+
+			"ChannelPoint(%v)", breachedOutput.witnessType,
+			breachedOutput.outpoint, breachInfo.chanPoint)
 
 		// If we have already registered for a notification for this
 		// output, we'll reuse it.
@@ -375,7 +489,7 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 				// to avoid entering an infinite loop.
 				select {
 				case <-b.quit:
-					return errBrarShuttingDown
+					return nil, errBrarShuttingDown
 				default:
 					continue
 				}
@@ -391,20 +505,21 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 			defer wg.Done()
 
 			select {
-			// The output has been taken to the second level!
+			// The output has been spent!
 			case sp, ok := <-spendEv.Spend:
 				if !ok {
 					return
 				}
-				brarLog.Debugf("Detected spend of HTLC(%v) "+
+				brarLog.Debugf("Detected spend of %v(%v) "+
 					"for ChannelPoint(%v)",
+					breachedOutput.witnessType,
 					breachedOutput.outpoint,
 					breachInfo.chanPoint)
 
 				// First we send the spend event on the
 				// allSpends channel, such that it can be
 				// handled after all go routines have exited.
-				allSpends <- spend{index, sp}
+				allSpends <- breachedOutputSpend{index, sp}
 
 				// Finally we'll signal the anySpend channel
 				// that a spend was detected, such that the
@@ -412,6 +527,8 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 				anySpend <- struct{}{}
 			case <-exit:
 				return
+			case <-cancel:
+				return
 			case <-b.quit:
 				return
 			}
@@ -431,53 +548,176 @@ func (b *breachArbiter) waitForSpendEvent(breachInfo *retributionInfo,
 		// channel have exited. We can therefore safely close the
 		// channel before ranging over its content.
 		close(allSpends)
+
+		var spends []breachedOutputSpend
 		for s := range allSpends {
 			breachedOutput := &breachInfo.breachedOutputs[s.index]
-			brarLog.Debugf("Detected second-level spend on "+
-				"HTLC(%v) for ChannelPoint(%v)",
-				breachedOutput.outpoint, breachInfo.chanPoint)
-
 			delete(spendNtfns, breachedOutput.outpoint)
 
-			// In this case we'll morph our initial revoke spend to
-			// instead point to the second level output, and update
-			// the sign descriptor in the process.
-			convertToSecondLevelRevoke(
-				breachedOutput, breachInfo, s.detail,
-			)
+			spends = append(spends, s)
 		}
+
+		return spends, nil
+
+	case <-cancel:
+		return nil, errRetributionCancelled
+
 	case <-b.quit:
-		return errBrarShuttingDown
+		return nil, errBrarShuttingDown
 	}
+}
 
-	return nil
+// updateBreachInfo updates the retribution info to reflect the spends of
+// breached outputs by transactions other than our justice tx. Revoked HTLC
+// outputs taken to the second level by the cheating party are converted
+// into the revoked second level outputs, while any other spent output has
+// reached its final state, and is removed from the retribution info. The
+// resolution reports of the removed outputs are returned.
+func updateBreachInfo(breachInfo *retributionInfo,
+	spends []breachedOutputSpend) []*channeldb.ResolverReport {
+
+	var reports []*channeldb.ResolverReport
+	doneOutputs := make(map[int]struct{})
+	for _, s := range spends {
+		breachedOutput := &breachInfo.breachedOutputs[s.index]
+
+		switch breachedOutput.witnessType {
+		case lnwallet.HtlcAcceptedRevoke, lnwallet.HtlcOfferedRevoke:
+			// If the HTLC output has been spent by the cheating
+			// party's second level transaction, we'll morph our
+			// initial revoke spend to instead point to the second
+			// level output, and update the sign descriptor in the
+			// process.
+			if isSecondLevelSpend(breachedOutput, s.detail) {
+				convertToSecondLevelRevoke(
+					breachedOutput, breachInfo, s.detail,
+				)
+				continue
+			}
+		}
+
+		brarLog.Warnf("Breached output(%v) for ChannelPoint(%v) has "+
+			"been spent by txid=%v, removing it from the justice tx",
+			breachedOutput.outpoint, breachInfo.chanPoint,
+			s.detail.SpenderTxHash)
+
+		report := newBreachReport(
+			breachedOutput, channeldb.ResolverOutcomeLost,
+		)
+		report.SweepTxid = s.detail.SpenderTxHash
+		reports = append(reports, report)
+
+		doneOutputs[s.index] = struct{}{}
+	}
+
+	// Filter out the outputs we can no longer sweep.
+	var nextIndex int
+	for i := range breachInfo.breachedOutputs {
+		if _, ok := doneOutputs[i]; ok {
+			continue
+		}
+
+		breachInfo.breachedOutputs[nextIndex] =
+			breachInfo.breachedOutputs[i]
+		nextIndex++
+	}
+	breachInfo.breachedOutputs = breachInfo.breachedOutputs[:nextIndex]
+
+	return reports
+}
+
+// newBreachReport creates the resolution report of a breached output.
+func newBreachReport(bo *breachedOutput,
+	outcome channeldb.ResolverOutcome) *channeldb.ResolverReport {
+
+	report := &channeldb.ResolverReport{
+		OutPoint:   bo.outpoint,
+		OutputType: channeldb.ResolverOutputRevoked,
+		Amount:     bo.amt,
+		Outcome:    outcome,
+	}
+
+	// If the cheating party took an HTLC to the second level, the output
+	// we went after is the one of their second level transaction.
+	if bo.witnessType == lnwallet.HtlcSecondLevelRevoke {
+		secondLevelTxid := bo.outpoint.Hash
+		report.SecondLevelTxid = &secondLevelTxid
+	}
+
+	return report
+}
+
+// isSecondLevelSpend returns true if the revoked HTLC output was spent by a
+// second level HTLC transaction, whose first output pays to the second level
+// script of the breached output.
+func isSecondLevelSpend(bo *breachedOutput,
+	spendDetails *chainntnfs.SpendDetail) bool {
+
+	spendingTx := spendDetails.SpendingTx
+	if len(spendingTx.TxOut) == 0 {
+		return false
+	}
+
+	secondLevelPkScript, err := lnwallet.WitnessScriptHash(
+		bo.secondLevelWitnessScript,
+	)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(spendingTx.TxOut[0].PkScript, secondLevelPkScript)
 }
 
 // exactRetribution is a goroutine which is executed once a contract breach has
 // been detected by a breachObserver. This function is responsible for
 // punishing a counterparty for violating the channel contract by sweeping ALL
-// the lingering funds within the channel into the daemon's wallet.
+// the lingering funds within the channel into the daemon's wallet. If the
+// task is cancelled, the goroutine cancels its notifications and exits.
 //
 // NOTE: This MUST be run as a goroutine.
 func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
-	breachInfo *retributionInfo) {
+	breachInfo *retributionInfo, task *retributionTask) {
 
 	defer b.wg.Done()
+	defer b.retributionDone(breachInfo.chanPoint, task)
+
+	// We'll watch each of the breached outputs for spends, in case the
+	// cheating party takes them to the second level, or sweeps them before
+	// our justice tx confirms. We'll store the SpendEvents between each
+	// attempt to not re-register unnecessarily.
+	spendNtfns := make(map[wire.OutPoint]*chainntnfs.SpendEvent)
+
+	// cancelNtfns cancels the confirmation and spend notifications we've
+	// registered for, which we'll do once the breach transaction has been
+	// reorged out of the chain.
+	cancelNtfns := func() {
+		brarLog.Infof("Retribution for ChannelPoint(%v) cancelled, "+
+			"breach transaction %v has been reorged out of the "+
+			"chain", breachInfo.chanPoint, breachInfo.commitHash)
+
+		if confChan.Cancel != nil {
+			confChan.Cancel()
+		}
+		for _, spendNtfn := range spendNtfns {
+			spendNtfn.Cancel()
+		}
+	}
 
 	// TODO(roasbeef): state needs to be checkpointed here
-	var breachConfHeight uint32
 	select {
-	case breachConf, ok := <-confChan.Confirmed:
+	case _, ok := <-confChan.Confirmed:
 		// If the second value is !ok, then the channel has been closed
 		// signifying a daemon shutdown, so we exit.
 		if !ok {
 			return
 		}
 
-		breachConfHeight = breachConf.BlockHeight
-
 		// Otherwise, if this is a real confirmation notification, then
 		// we fall through to complete our duty.
+	case <-task.cancel:
+		cancelNtfns()
+		return
+
 	case <-b.quit:
 		return
 	}
@@ -485,11 +725,6 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 	brarLog.Debugf("Breach transaction %v has been confirmed, sweeping "+
 		"revoked funds", breachInfo.commitHash)
 
-	// We may have to wait for some of the HTLC outputs to be spent to the
-	// second level before broadcasting the justice tx. We'll store the
-	// SpendEvents between each attempt to not re-register uneccessarily.
-	spendNtfns := make(map[wire.OutPoint]*chainntnfs.SpendEvent)
-
 	finalTx, err := b.cfg.Store.GetFinalizedTxn(&breachInfo.chanPoint)
 	if err != nil {
 		brarLog.Errorf("unable to get finalized txn for"+
@@ -497,11 +732,22 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 		return
 	}
 
+justiceTxBroadcast:
+	// If all of the breached outputs have been swept by the cheating
+	// party, there is nothing left for us to claim.
+	if len(breachInfo.breachedOutputs) == 0 {
+		brarLog.Warnf("All breached outputs of ChannelPoint(%v) have "+
+			"been swept by the cheating party",
+			breachInfo.chanPoint)
+
+		b.closeBreachedChannel(breachInfo)
+		return
+	}
+
 	// If this retribution has not been finalized before, we will first
 	// construct a sweep transaction and write it to disk. This will allow
-	// the breach arbiter to re-register for notifications for the justice
-	// txid.
-justiceTxBroadcast:
+	// the breach arbiter to recognize the spends of the breached outputs
+	// by the justice tx after a restart.
 	if finalTx == nil {
 		// With the breach transaction confirmed, we now create the
 		// justice tx which will claim ALL the funds within the
@@ -532,100 +778,151 @@ justiceTxBroadcast:
 	if err != nil {
 		brarLog.Errorf("unable to broadcast justice tx: %v", err)
 
+		// Broadcasting the transaction failed because of a conflict
+		// either in the mempool or in chain. We'll learn which of the
+		// breached outputs has been spent below, and craft a new
+		// justice tx once the conflicting spend confirms.
 		if err == lnwallet.ErrDoubleSpend {
-			// Broadcasting the transaction failed because of a
-			// conflict either in the mempool or in chain. We'll
-			// now create spend subscriptions for all HTLC outputs
-			// on the commitment transaction that could possibly
-			// have been spent, and wait for any of them to
-			// trigger.
 			brarLog.Infof("Waiting for a spend event before " +
 				"attempting to craft new justice tx.")
-			finalTx = nil
-
-			err := b.waitForSpendEvent(breachInfo, spendNtfns)
-			if err != nil {
-				if err != errBrarShuttingDown {
-					brarLog.Errorf("error waiting for "+
-						"spend event: %v", err)
-				}
-				return
-			}
-
-			brarLog.Infof("Attempting another justice tx broadcast")
-			goto justiceTxBroadcast
 		}
 	}
 
-	// As a conclusionary step, we register for a notification to be
-	// dispatched once the justice tx is confirmed. After confirmation we
-	// notify the caller that initiated the retribution workflow that the
-	// deed has been done.
+	// As a conclusionary step, we wait for the justice tx to sweep all of
+	// the breached outputs. If any of them is spent by another tx
+	// instead, we'll update the retribution info and craft a new justice
+	// tx.
 	justiceTXID := finalTx.TxHash()
-	justiceScript := finalTx.TxOut[0].PkScript
-	confChan, err = b.cfg.Notifier.RegisterConfirmationsNtfn(
-		&justiceTXID, justiceScript, 1, breachConfHeight,
-	)
-	if err != nil {
-		brarLog.Errorf("unable to register for conf for txid(%v): %v",
-			justiceTXID, err)
-		return
-	}
+	claimedOutputs := make(map[wire.OutPoint]struct{})
+	for len(claimedOutputs) < len(breachInfo.breachedOutputs) {
+		spends, err := b.waitForSpendEvent(
+			breachInfo, spendNtfns, claimedOutputs, task.cancel,
+		)
+		switch {
+		case err == errRetributionCancelled:
+			cancelNtfns()
+			return
 
-	select {
-	case _, ok := <-confChan.Confirmed:
-		if !ok {
+		case err != nil:
+			if err != errBrarShuttingDown {
+				brarLog.Errorf("error waiting for spend "+
+					"event: %v", err)
+			}
 			return
 		}
 
-		// Compute both the total value of funds being swept and the
-		// amount of funds that were revoked from the counter party.
-		var totalFunds, revokedFunds btcutil.Amount
-		for _, input := range breachInfo.breachedOutputs {
-			totalFunds += input.Amount()
-
-			// If the output being revoked is the remote commitment
-			// output or an offered HTLC output, it's amount
-			// contributes to the value of funds being revoked from
-			// the counter party.
-			switch input.WitnessType() {
-			case lnwallet.CommitmentRevoke:
-				revokedFunds += input.Amount()
-			case lnwallet.HtlcOfferedRevoke:
-				revokedFunds += input.Amount()
-			default:
+		var otherSpends []breachedOutputSpend
+		for _, s := range spends {
+			if *s.detail.SpenderTxHash == justiceTXID {
+				outpoint := breachInfo.breachedOutputs[s.index].outpoint
+				claimedOutputs[outpoint] = struct{}{}
+				continue
 			}
+
+			otherSpends = append(otherSpends, s)
+		}
+
+		if len(otherSpends) == 0 {
+			continue
 		}
 
-		brarLog.Infof("Justice for ChannelPoint(%v) has "+
-			"been served, %v revoked funds (%v total) "+
-			"have been claimed", breachInfo.chanPoint,
-			revokedFunds, totalFunds)
+		// Our justice tx can no longer confirm. Update the retribution
+		// info to reflect the spends, and persist it before crafting
+		// a new justice tx.
+		lostReports := updateBreachInfo(breachInfo, otherSpends)
 
-		// With the channel closed, mark it in the database as such.
-		err := b.cfg.DB.MarkChanFullyClosed(&breachInfo.chanPoint)
-		if err != nil {
-			brarLog.Errorf("unable to mark chan as closed: %v", err)
+		if err := b.cfg.Store.Add(breachInfo); err != nil {
+			brarLog.Errorf("unable to update retribution info "+
+				"for chanid=%v: %v", &breachInfo.chanPoint, err)
 			return
 		}
+		b.putResolverReports(&breachInfo.chanPoint, lostReports)
 
-		// Justice has been carried out; we can safely delete the
-		// retribution info from the database.
-		err = b.cfg.Store.Remove(&breachInfo.chanPoint)
-		if err != nil {
-			brarLog.Errorf("unable to remove retribution "+
-				"from the db: %v", err)
+		brarLog.Infof("Attempting another justice tx broadcast")
+		finalTx = nil
+		goto justiceTxBroadcast
+	}
+
+	// Compute both the total value of funds being swept and the amount of
+	// funds that were revoked from the counter party.
+	var totalFunds, revokedFunds btcutil.Amount
+	for _, input := range breachInfo.breachedOutputs {
+		totalFunds += input.Amount()
+
+		// If the output being revoked is the remote commitment output
+		// or an offered HTLC output, it's amount contributes to the
+		// value of funds being revoked from the counter party.
+		switch input.WitnessType() {
+		case lnwallet.CommitmentRevoke:
+			revokedFunds += input.Amount()
+		case lnwallet.HtlcOfferedRevoke:
+			revokedFunds += input.Amount()
+		default:
 		}
+	}
 
-		// TODO(roasbeef): add peer to blacklist?
+	brarLog.Infof("Justice for ChannelPoint(%v) has been served, %v "+
+		"revoked funds (%v total) have been claimed",
+		breachInfo.chanPoint, revokedFunds, totalFunds)
 
-		// TODO(roasbeef): close other active channels with offending
-		// peer
+	// We'll record the outputs we claimed, along with where the funds
+	// were sent to, before marking the channel as closed.
+	claimedReports := make(
+		[]*channeldb.ResolverReport, 0, len(breachInfo.breachedOutputs),
+	)
+	for i := range breachInfo.breachedOutputs {
+		report := newBreachReport(
+			&breachInfo.breachedOutputs[i],
+			channeldb.ResolverOutcomeClaimed,
+		)
+		report.SweepTxid = &justiceTXID
+		report.SweepPkScript = finalTx.TxOut[0].PkScript
+		claimedReports = append(claimedReports, report)
+	}
+	b.putResolverReports(&breachInfo.chanPoint, claimedReports)
 
-		return
-	case <-b.quit:
+	b.closeBreachedChannel(breachInfo)
+
+	// TODO(roasbeef): add peer to blacklist?
+
+	// TODO(roasbeef): close other active channels with offending
+	// peer
+}
+
+// putResolverReports persists the resolution reports of breached outputs of
+// the channel. As the reports are only informational, a failure to persist
+// them is logged, but doesn't halt the retribution.
+func (b *breachArbiter) putResolverReports(chanPoint *wire.OutPoint,
+	reports []*channeldb.ResolverReport) {
+
+	for _, report := range reports {
+		err := b.cfg.DB.PutResolverReport(chanPoint, report)
+		if err != nil {
+			brarLog.Errorf("unable to store resolution report of "+
+				"%v for ChannelPoint(%v): %v", report.OutPoint,
+				chanPoint, err)
+		}
+	}
+}
+
+// closeBreachedChannel marks the breached channel as fully closed, and removes
+// its retribution info from the retribution store, once all of the breached
+// outputs have been resolved.
+func (b *breachArbiter) closeBreachedChannel(breachInfo *retributionInfo) {
+	// With the channel closed, mark it in the database as such.
+	err := b.cfg.DB.MarkChanFullyClosed(&breachInfo.chanPoint)
+	if err != nil {
+		brarLog.Errorf("unable to mark chan as closed: %v", err)
 		return
 	}
+
+	// Justice has been carried out; we can safely delete the retribution
+	// info from the database.
+	err = b.cfg.Store.Remove(&breachInfo.chanPoint)
+	if err != nil {
+		brarLog.Errorf("unable to remove retribution from the db: %v",
+			err)
+	}
 }
 
 // handleBreachHandoff handles a new breach event, by writing it to disk, then
@@ -699,8 +996,14 @@ func (b *breachArbiter) handleBreachHandoff(breachEvent *ContractBreachEvent) {
 	// will be persisted to disk.
 	retInfo := newRetributionInfo(&chanPoint, breachInfo)
 
-	// Persist the pending retribution state to disk.
+	// Persist the pending retribution state to disk. We'll track the task
+	// exacting retribution before acking the handoff, such that it can be
+	// cancelled by a reorg of the breach transaction from then on.
+	var task *retributionTask
 	err = b.cfg.Store.Add(retInfo)
+	if err == nil {
+		task = b.newRetributionTask(chanPoint)
+	}
 	b.Unlock()
 	if err != nil {
 		brarLog.Errorf("unable to persist retribution "+
@@ -735,6 +1038,7 @@ func (b *breachArbiter) handleBreachHandoff(breachEvent *ContractBreachEvent) {
 	if err != nil {
 		brarLog.Errorf("unable to register for conf updates for "+
 			"txid: %v, err: %v", breachTXID, err)
+		b.retributionDone(chanPoint, task)
 		return
 	}
 
@@ -746,7 +1050,54 @@ func (b *breachArbiter) handleBreachHandoff(breachEvent *ContractBreachEvent) {
 	// finalize the channel retribution after the breach transaction has
 	// been confirmed.
 	b.wg.Add(1)
-	go b.exactRetribution(cfChan, retInfo)
+	go b.exactRetribution(cfChan, retInfo, task)
+}
+
+// handleBreachReorg handles the reorg of a breach transaction out of the
+// chain. Any retribution being exacted for the channel is cancelled, and its
+// retribution info is removed from the retribution store, after which the
+// event is ACKed. This allows the breach to be handed off again, should the
+// channel be breached within the new chain.
+func (b *breachArbiter) handleBreachReorg(reorgEvent *ContractBreachReorgEvent) {
+	defer b.wg.Done()
+
+	chanPoint := reorgEvent.ChanPoint
+	brarLog.Warnf("Breach transaction for ChannelPoint(%v) has been "+
+		"reorged out of the chain, dropping retribution", chanPoint)
+
+	// Acquire the mutex to ensure consistency with the handoff of a
+	// breach for the same channel.
+	b.Lock()
+	task, ok := b.retributions[chanPoint]
+	if ok {
+		delete(b.retributions, chanPoint)
+	}
+	b.Unlock()
+
+	// If we're exacting retribution for the channel, we'll cancel it, and
+	// wait for it to exit before modifying the retribution store.
+	if ok {
+		close(task.cancel)
+
+		select {
+		case <-task.done:
+		case <-b.quit:
+			return
+		}
+	}
+
+	b.Lock()
+	err := b.cfg.Store.Remove(&chanPoint)
+	b.Unlock()
+	if err != nil {
+		brarLog.Errorf("unable to remove retribution for "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+	}
+
+	select {
+	case reorgEvent.ProcessACK <- err:
+	case <-b.quit:
+	}
 }
 
 // breachedOutput contains all the information needed to sweep a breached
@@ -933,6 +1284,19 @@ func newRetributionInfo(chanPoint *wire.OutPoint,
 	}
 }
 
+// commitPkScript returns the pkScript of one of the breached outputs still
+// residing on the breach transaction, or nil if all of them have been taken
+// to the second level.
+func (ret *retributionInfo) commitPkScript() []byte {
+	for _, output := range ret.breachedOutputs {
+		if output.outpoint.Hash == ret.commitHash {
+			return output.signDesc.Output.PkScript
+		}
+	}
+
+	return nil
+}
+
 // createJusticeTx creates a transaction which exacts "justice" by sweeping ALL
 // the funds within the channel which we are now entitled to due to a breach of
 // the channel's contract by the counterparty. This function returns a *fully*
@@ -932,7 +932,7 @@ restartCheck:
 func initBreachedState(t *testing.T) (*breachArbiter,
 	*lnwallet.LightningChannel, *lnwallet.LightningChannel,
 	*lnwallet.LocalForceCloseSummary, chan *ContractBreachEvent,
-	func(), func()) {
+	chan *ContractBreachReorgEvent, func(), func()) {
 	// Create a pair of channels using a notifier that allows us to signal
 	// a spend of the funding transaction. Alice's channel will be the on
 	// observing a breach.
@@ -943,9 +943,10 @@ func initBreachedState(t *testing.T) (*breachArbiter,
 
 	// Instantiate a breach arbiter to handle the breach of alice's channel.
 	contractBreaches := make(chan *ContractBreachEvent)
+	contractBreachReorgs := make(chan *ContractBreachReorgEvent)
 
 	brar, cleanUpArb, err := createTestArbiter(
-		t, contractBreaches, alice.State().Db,
+		t, contractBreaches, contractBreachReorgs, alice.State().Db,
 	)
 	if err != nil {
 		t.Fatalf("unable to initialize test breach arbiter: %v", err)
@@ -984,8 +985,8 @@ func initBreachedState(t *testing.T) (*breachArbiter,
 		t.Fatalf("Can't update the channel state: %v", err)
 	}
 
-	return brar, alice, bob, bobClose, contractBreaches, cleanUpChans,
-		cleanUpArb
+	return brar, alice, bob, bobClose, contractBreaches,
+		contractBreachReorgs, cleanUpChans, cleanUpArb
 }
 
 // TestBreachHandoffSuccess tests that a channel's close observer properly
@@ -993,7 +994,7 @@ func initBreachedState(t *testing.T) (*breachArbiter,
 // breach close. This test verifies correctness in the event that the handoff
 // experiences no interruptions.
 func TestBreachHandoffSuccess(t *testing.T) {
-	brar, alice, _, bobClose, contractBreaches,
+	brar, alice, _, bobClose, contractBreaches, _,
 		cleanUpChans, cleanUpArb := initBreachedState(t)
 	defer cleanUpChans()
 	defer cleanUpArb()
@@ -1069,7 +1070,7 @@ func TestBreachHandoffSuccess(t *testing.T) {
 // arbiter fails to write the information to disk, and that a subsequent attempt
 // at the handoff succeeds.
 func TestBreachHandoffFail(t *testing.T) {
-	brar, alice, _, bobClose, contractBreaches,
+	brar, alice, _, bobClose, contractBreaches, _,
 		cleanUpChans, cleanUpArb := initBreachedState(t)
 	defer cleanUpChans()
 	defer cleanUpArb()
@@ -1114,7 +1115,7 @@ func TestBreachHandoffFail(t *testing.T) {
 	assertNotPendingClosed(t, alice)
 
 	brar, cleanUpArb, err := createTestArbiter(
-		t, contractBreaches, alice.State().Db,
+		t, contractBreaches, nil, alice.State().Db,
 	)
 	if err != nil {
 		t.Fatalf("unable to initialize test breach arbiter: %v", err)
@@ -1169,7 +1170,7 @@ func TestBreachHandoffFail(t *testing.T) {
 // breached commitment is transferred to a second level spend if the output is
 // already spent.
 func TestBreachSecondLevelTransfer(t *testing.T) {
-	brar, alice, _, bobClose, contractBreaches,
+	brar, alice, _, bobClose, contractBreaches, _,
 		cleanUpChans, cleanUpArb := initBreachedState(t)
 	defer cleanUpChans()
 	defer cleanUpArb()
@@ -1252,9 +1253,15 @@ func TestBreachSecondLevelTransfer(t *testing.T) {
 	// Since publishing the transaction failed above, the breach arbiter
 	// will attempt another second level check. Now notify that the htlc
 	// output is spent by a second level tx.
+	secondLvlPkScript, err := lnwallet.WitnessScriptHash(
+		retribution.HtlcRetributions[0].SecondLevelWitnessScript,
+	)
+	if err != nil {
+		t.Fatalf("unable to create second level pkscript: %v", err)
+	}
 	secondLvlTx := &wire.MsgTx{
 		TxOut: []*wire.TxOut{
-			{Value: 1},
+			{Value: 1, PkScript: secondLvlPkScript},
 		},
 	}
 	notifier.Spend(htlcOutpoint, 2, secondLvlTx)
@@ -1276,6 +1283,413 @@ func TestBreachSecondLevelTransfer(t *testing.T) {
 	}
 }
 
+// TestBreachSpentOutputsRemoved tests that the breach arbiter watches each of
+// the breached outputs separately, going after the revoked second level
+// output once the cheating party takes an HTLC output to the second level, and
+// removing the outputs swept by the cheating party from the justice tx. The
+// retribution store should reflect each of these steps.
+func TestBreachSpentOutputsRemoved(t *testing.T) {
+	brar, alice, _, bobClose, contractBreaches, _,
+		cleanUpChans, cleanUpArb := initBreachedState(t)
+	defer cleanUpChans()
+	defer cleanUpArb()
+
+	var (
+		height       = bobClose.ChanSnapshot.CommitHeight
+		forceCloseTx = bobClose.CloseTx
+		chanPoint    = alice.ChanPoint
+		publTx       = make(chan *wire.MsgTx)
+	)
+
+	brar.cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+		publTx <- tx
+		return nil
+	}
+
+	retribution, err := lnwallet.NewBreachRetribution(
+		alice.State(), height, forceCloseTx, 1)
+	if err != nil {
+		t.Fatalf("unable to create breach retribution: %v", err)
+	}
+
+	breach := &ContractBreachEvent{
+		ChanPoint:         *chanPoint,
+		ProcessACK:        make(chan error, 1),
+		BreachRetribution: retribution,
+	}
+	contractBreaches <- breach
+
+	select {
+	case err := <-breach.ProcessACK:
+		if err != nil {
+			t.Fatalf("handoff failed: %v", err)
+		}
+	case <-time.After(time.Second * 15):
+		t.Fatalf("breach arbiter didn't send ack back")
+	}
+
+	// Mark the channel as pending closed, as the chain watcher would have
+	// done before handing off the breach, such that it can be marked
+	// fully closed once justice has been served.
+	closeSummary := &channeldb.ChannelCloseSummary{
+		ChanPoint:   *chanPoint,
+		ChainHash:   alice.State().ChainHash,
+		ClosingTXID: forceCloseTx.TxHash(),
+		RemotePub:   alice.State().IdentityPub,
+		Capacity:    alice.State().Capacity,
+		CloseType:   channeldb.BreachClose,
+		IsPending:   true,
+	}
+	if err := alice.State().CloseChannel(closeSummary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	// Notify that the breaching transaction is confirmed, to trigger the
+	// retribution logic.
+	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
+	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+
+	receiveTx := func() *wire.MsgTx {
+		t.Helper()
+
+		select {
+		case tx := <-publTx:
+			return tx
+		case <-time.After(5 * time.Second):
+			t.Fatalf("tx was not published")
+		}
+		return nil
+	}
+
+	// The breach arbiter should attempt to sweep all outputs on the
+	// breached commitment.
+	justiceTx := receiveTx()
+	numOutputs := len(justiceTx.TxIn)
+
+	// The cheating party now takes the HTLC output to the second level.
+	htlcOutpoint := &retribution.HtlcRetributions[0].OutPoint
+	secondLvlPkScript, err := lnwallet.WitnessScriptHash(
+		retribution.HtlcRetributions[0].SecondLevelWitnessScript,
+	)
+	if err != nil {
+		t.Fatalf("unable to create second level pkscript: %v", err)
+	}
+	secondLvlTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: *htlcOutpoint},
+		},
+		TxOut: []*wire.TxOut{
+			{Value: 10000, PkScript: secondLvlPkScript},
+		},
+	}
+	waitForSpendRegistration(t, notifier, htlcOutpoint)
+	notifier.Spend(htlcOutpoint, 2, secondLvlTx)
+
+	// A new justice tx should be published, going after the second level
+	// output instead.
+	secondLvlOutpoint := wire.OutPoint{Hash: secondLvlTx.TxHash()}
+	justiceTx = receiveTx()
+	assertSpendsOutpoint(t, justiceTx, &secondLvlOutpoint, true)
+	assertSpendsOutpoint(t, justiceTx, htlcOutpoint, false)
+	if len(justiceTx.TxIn) != numOutputs {
+		t.Fatalf("expected %v inputs, got %v", numOutputs,
+			len(justiceTx.TxIn))
+	}
+
+	// The retribution store should reflect the second level output.
+	retInfo := fetchRetribution(t, brar, chanPoint)
+	if len(retInfo.breachedOutputs) != numOutputs {
+		t.Fatalf("expected %v breached outputs, got %v", numOutputs,
+			len(retInfo.breachedOutputs))
+	}
+	var found bool
+	for _, output := range retInfo.breachedOutputs {
+		if output.outpoint != secondLvlOutpoint {
+			continue
+		}
+		found = true
+
+		if output.witnessType != lnwallet.HtlcSecondLevelRevoke {
+			t.Fatalf("expected witness type %v, got %v",
+				lnwallet.HtlcSecondLevelRevoke,
+				output.witnessType)
+		}
+	}
+	if !found {
+		t.Fatalf("second level output not found in retribution info")
+	}
+
+	// Next, the cheating party sweeps the second level output before our
+	// justice tx confirms.
+	sweepTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{PreviousOutPoint: secondLvlOutpoint},
+		},
+		TxOut: []*wire.TxOut{
+			{Value: 9000},
+		},
+	}
+	waitForSpendRegistration(t, notifier, &secondLvlOutpoint)
+	notifier.Spend(&secondLvlOutpoint, 3, sweepTx)
+
+	// The justice tx should now be crafted without the swept output, and
+	// the retribution store should no longer track it.
+	justiceTx = receiveTx()
+	assertSpendsOutpoint(t, justiceTx, &secondLvlOutpoint, false)
+	if len(justiceTx.TxIn) != numOutputs-1 {
+		t.Fatalf("expected %v inputs, got %v", numOutputs-1,
+			len(justiceTx.TxIn))
+	}
+
+	retInfo = fetchRetribution(t, brar, chanPoint)
+	if len(retInfo.breachedOutputs) != numOutputs-1 {
+		t.Fatalf("expected %v breached outputs, got %v",
+			numOutputs-1, len(retInfo.breachedOutputs))
+	}
+
+	// Finally, our justice tx confirms, sweeping all remaining outputs.
+	// The breach arbiter should then consider justice served, and remove
+	// the retribution info.
+	for _, txIn := range justiceTx.TxIn {
+		outpoint := txIn.PreviousOutPoint
+		waitForSpendRegistration(t, notifier, &outpoint)
+		notifier.Spend(&outpoint, 4, justiceTx)
+	}
+
+	for i := 0; ; i++ {
+		isBreached, err := brar.IsBreached(chanPoint)
+		if err != nil {
+			t.Fatalf("unable to determine if channel is "+
+				"breached: %v", err)
+		}
+		if !isBreached {
+			break
+		}
+
+		if i == 50 {
+			t.Fatalf("retribution info not removed")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// The resolution of each of the breached outputs should have been
+	// recorded: the second level output swept by the cheating party as
+	// lost, and all others as claimed by our justice tx.
+	reports, err := brar.cfg.DB.FetchResolverReports(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch resolver reports: %v", err)
+	}
+	if len(reports) != numOutputs {
+		t.Fatalf("expected %v reports, got %v", numOutputs,
+			len(reports))
+	}
+
+	justiceTXID := justiceTx.TxHash()
+	for _, report := range reports {
+		if report.OutputType != channeldb.ResolverOutputRevoked {
+			t.Fatalf("expected output type %v, got %v",
+				channeldb.ResolverOutputRevoked, report.OutputType)
+		}
+
+		if report.OutPoint == secondLvlOutpoint {
+			if report.Outcome != channeldb.ResolverOutcomeLost {
+				t.Fatalf("expected second level output to be "+
+					"lost, got %v", report.Outcome)
+			}
+			if *report.SweepTxid != sweepTx.TxHash() {
+				t.Fatalf("expected sweep txid %v, got %v",
+					sweepTx.TxHash(), report.SweepTxid)
+			}
+			continue
+		}
+
+		assertSpendsOutpoint(t, justiceTx, &report.OutPoint, true)
+		if report.Outcome != channeldb.ResolverOutcomeClaimed {
+			t.Fatalf("expected output %v to be claimed, got %v",
+				report.OutPoint, report.Outcome)
+		}
+		if *report.SweepTxid != justiceTXID {
+			t.Fatalf("expected sweep txid %v, got %v", justiceTXID,
+				report.SweepTxid)
+		}
+		if !bytes.Equal(report.SweepPkScript,
+			justiceTx.TxOut[0].PkScript) {
+
+			t.Fatalf("expected sweep script %x, got %x",
+				justiceTx.TxOut[0].PkScript, report.SweepPkScript)
+		}
+	}
+}
+
+// TestBreachReorg tests that once the breach transaction is reorged out of
+// the chain, the breach arbiter stops watching the breached outputs and
+// removes the retribution info from the retribution store, such that the
+// breach can be handed off again within the new chain.
+func TestBreachReorg(t *testing.T) {
+	brar, alice, _, bobClose, contractBreaches, contractBreachReorgs,
+		cleanUpChans, cleanUpArb := initBreachedState(t)
+	defer cleanUpChans()
+	defer cleanUpArb()
+
+	var (
+		height       = bobClose.ChanSnapshot.CommitHeight
+		forceCloseTx = bobClose.CloseTx
+		chanPoint    = alice.ChanPoint
+		publTx       = make(chan *wire.MsgTx)
+	)
+
+	brar.cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+		publTx <- tx
+		return nil
+	}
+
+	retribution, err := lnwallet.NewBreachRetribution(
+		alice.State(), height, forceCloseTx, 1)
+	if err != nil {
+		t.Fatalf("unable to create breach retribution: %v", err)
+	}
+
+	handOffBreach := func() {
+		t.Helper()
+
+		breach := &ContractBreachEvent{
+			ChanPoint:         *chanPoint,
+			ProcessACK:        make(chan error, 1),
+			BreachRetribution: retribution,
+		}
+		contractBreaches <- breach
+
+		select {
+		case err := <-breach.ProcessACK:
+			if err != nil {
+				t.Fatalf("handoff failed: %v", err)
+			}
+		case <-time.After(time.Second * 15):
+			t.Fatalf("breach arbiter didn't send ack back")
+		}
+	}
+
+	handOffBreach()
+	assertArbiterBreach(t, brar, chanPoint)
+
+	// Notify that the breaching transaction is confirmed, to trigger the
+	// retribution logic.
+	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
+	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+
+	// The breach arbiter should broadcast its justice tx, and watch each
+	// of the breached outputs for spends.
+	var justiceTx *wire.MsgTx
+	select {
+	case justiceTx = <-publTx:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("justice tx was not published")
+	}
+
+	for _, txIn := range justiceTx.TxIn {
+		waitForSpendRegistration(t, notifier, &txIn.PreviousOutPoint)
+	}
+
+	// Now the breach transaction is reorged out of the chain.
+	reorg := &ContractBreachReorgEvent{
+		ChanPoint:  *chanPoint,
+		ProcessACK: make(chan error, 1),
+	}
+	contractBreachReorgs <- reorg
+
+	select {
+	case err := <-reorg.ProcessACK:
+		if err != nil {
+			t.Fatalf("breach reorg failed: %v", err)
+		}
+	case <-time.After(time.Second * 15):
+		t.Fatalf("breach arbiter didn't send ack back")
+	}
+
+	// The retribution info should have been removed, and the breach
+	// arbiter should no longer be watching any of the breached outputs.
+	assertNoArbiterBreach(t, brar, chanPoint)
+
+	notifier.mtx.Lock()
+	for _, txIn := range justiceTx.TxIn {
+		if _, ok := notifier.spendMap[txIn.PreviousOutPoint]; ok {
+			notifier.mtx.Unlock()
+			t.Fatalf("spend registration for %v not cancelled",
+				txIn.PreviousOutPoint)
+		}
+	}
+	notifier.mtx.Unlock()
+
+	// Finally, the breach should be handed off again once it confirms
+	// within the new chain.
+	handOffBreach()
+	assertArbiterBreach(t, brar, chanPoint)
+}
+
+// waitForSpendRegistration waits until the breach arbiter has registered for
+// the spend of the given outpoint.
+func waitForSpendRegistration(t *testing.T, notifier *mockSpendNotifier,
+	outpoint *wire.OutPoint) {
+
+	t.Helper()
+
+	for i := 0; i < 50; i++ {
+		notifier.mtx.Lock()
+		_, ok := notifier.spendMap[*outpoint]
+		notifier.mtx.Unlock()
+
+		if ok {
+			return
+		}
+
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	t.Fatalf("no spend registration for %v", outpoint)
+}
+
+// assertSpendsOutpoint checks whether the transaction spends the outpoint.
+func assertSpendsOutpoint(t *testing.T, tx *wire.MsgTx,
+	outpoint *wire.OutPoint, expected bool) {
+
+	t.Helper()
+
+	var spends bool
+	for _, txIn := range tx.TxIn {
+		if txIn.PreviousOutPoint == *outpoint {
+			spends = true
+		}
+	}
+
+	if spends != expected {
+		t.Fatalf("expected tx spending %v to be %v", outpoint,
+			expected)
+	}
+}
+
+// fetchRetribution loads the persisted retribution info of the channel.
+func fetchRetribution(t *testing.T, brar *breachArbiter,
+	chanPoint *wire.OutPoint) *retributionInfo {
+
+	t.Helper()
+
+	var retInfo *retributionInfo
+	err := brar.cfg.Store.ForAll(func(ret *retributionInfo) error {
+		if ret.chanPoint == *chanPoint {
+			retInfo = ret
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to fetch retribution info: %v", err)
+	}
+	if retInfo == nil {
+		t.Fatalf("no retribution info for %v", chanPoint)
+	}
+
+	return retInfo
+}
+
 // assertArbiterBreach checks that the breach arbiter has persisted the breach
 // information for a particular channel.
 func assertArbiterBreach(t *testing.T, brar *breachArbiter,
@@ -1330,6 +1744,7 @@ func assertNotPendingClosed(t *testing.T, c *lnwallet.LightningChannel) {
 // createTestArbiter instantiates a breach arbiter with a failing retribution
 // store, so that controlled failures can be tested.
 func createTestArbiter(t *testing.T, contractBreaches chan *ContractBreachEvent,
+	contractBreachReorgs chan *ContractBreachReorgEvent,
 	db *channeldb.DB) (*breachArbiter, func(), error) {
 
 	// Create a failing retribution store, that wraps a normal one.
@@ -1344,15 +1759,16 @@ func createTestArbiter(t *testing.T, contractBreaches chan *ContractBreachEvent,
 	// Assemble our test arbiter.
 	notifier := makeMockSpendNotifier()
 	ba := newBreachArbiter(&BreachConfig{
-		CloseLink:          func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
-		DB:                 db,
-		Estimator:          &lnwallet.StaticFeeEstimator{FeePerKW: 12500},
-		GenSweepScript:     func() ([]byte, error) { return nil, nil },
-		ContractBreaches:   contractBreaches,
-		Signer:             signer,
-		Notifier:           notifier,
-		PublishTransaction: func(_ *wire.MsgTx) error { return nil },
-		Store:              store,
+		CloseLink:            func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
+		DB:                   db,
+		Estimator:            &lnwallet.StaticFeeEstimator{FeePerKW: 12500},
+		GenSweepScript:       func() ([]byte, error) { return nil, nil },
+		ContractBreaches:     contractBreaches,
+		ContractBreachReorgs: contractBreachReorgs,
+		Signer:               signer,
+		Notifier:             notifier,
+		PublishTransaction:   func(_ *wire.MsgTx) error { return nil },
+		Store:                store,
 	})
 
 	if err := ba.Start(); err != nil {
@@ -1,6 +1,8 @@
 package bitcoindnotify
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
@@ -70,6 +72,11 @@ type BitcoindNotifier struct {
 
 	txNotifier *chainntnfs.TxNotifier
 
+	// mempoolSpends dispatches the spends of outpoints by unconfirmed
+	// transactions to clients registered for mempool spends. It's nil if
+	// mempool spend notifications are disabled.
+	mempoolSpends *chainntnfs.MempoolSpendDispatcher
+
 	blockEpochClients map[uint64]*blockEpochRegistration
 
 	bestBlock chainntnfs.BlockEpoch
@@ -88,15 +95,18 @@ type BitcoindNotifier struct {
 	quit chan struct{}
 }
 
-// Ensure BitcoindNotifier implements the ChainNotifier interface at compile
-// time.
+// Ensure BitcoindNotifier implements the ChainNotifier and
+// MempoolSpendNotifier interfaces at compile time.
 var _ chainntnfs.ChainNotifier = (*BitcoindNotifier)(nil)
+var _ chainntnfs.MempoolSpendNotifier = (*BitcoindNotifier)(nil)
 
 // New returns a new BitcoindNotifier instance. This function assumes the
 // bitcoind node  detailed in the passed configuration is already running, and
-// willing to accept RPC requests and new zmq clients.
+// willing to accept RPC requests and new zmq clients. Clients may only register
+// for mempool spends if mempoolSpends is true.
 func New(chainConn *chain.BitcoindConn, spendHintCache chainntnfs.SpendHintCache,
-	confirmHintCache chainntnfs.ConfirmHintCache) *BitcoindNotifier {
+	confirmHintCache chainntnfs.ConfirmHintCache,
+	mempoolSpends bool) *BitcoindNotifier {
 
 	notifier := &BitcoindNotifier{
 		notificationCancels:  make(chan interface{}),
@@ -110,6 +120,10 @@ func New(chainConn *chain.BitcoindConn, spendHintCache chainntnfs.SpendHintCache
 		quit: make(chan struct{}),
 	}
 
+	if mempoolSpends {
+		notifier.mempoolSpends = chainntnfs.NewMempoolSpendDispatcher()
+	}
+
 	notifier.chainConn = chainConn.NewBitcoindClient()
 
 	return notifier
@@ -176,6 +190,9 @@ func (b *BitcoindNotifier) Stop() error {
 		close(epochClient.epochChan)
 	}
 	b.txNotifier.TearDown()
+	if b.mempoolSpends != nil {
+		b.mempoolSpends.TearDown()
+	}
 
 	return nil
 }
@@ -234,7 +251,8 @@ out:
 					defer b.wg.Done()
 
 					confDetails, _, err := b.historicalConfDetails(
-						msg.TxID, msg.StartHeight, msg.EndHeight,
+						msg.ConfRequest,
+						msg.StartHeight, msg.EndHeight,
 					)
 					if err != nil {
 						chainntnfs.Log.Error(err)
@@ -249,7 +267,7 @@ out:
 					// cache at tip, since any pending
 					// rescans have now completed.
 					err = b.txNotifier.UpdateConfDetails(
-						*msg.TxID, confDetails,
+						msg.ConfRequest, confDetails,
 					)
 					if err != nil {
 						chainntnfs.Log.Error(err)
@@ -271,7 +289,7 @@ out:
 						chainntnfs.Log.Errorf("Rescan to "+
 							"determine the spend "+
 							"details of %v failed: %v",
-							msg.OutPoint, err)
+							msg.SpendRequest, err)
 					}
 				}()
 
@@ -369,15 +387,19 @@ out:
 				b.bestBlock = newBestBlock
 
 			case chain.RelevantTx:
-				// We only care about notifying on confirmed
-				// spends, so if this is a mempool spend, we can
-				// ignore it and wait for the spend to appear in
-				// on-chain.
+				// Spends within the mempool, relayed to us by
+				// bitcoind's rawtx ZMQ notifications, are only
+				// of interest to clients registered for mempool
+				// spends. The regular spend clients will be
+				// notified once the spend appears on-chain.
+				tx := &item.TxRecord.MsgTx
 				if item.Block == nil {
+					if b.mempoolSpends != nil {
+						b.mempoolSpends.ProcessTx(tx)
+					}
 					continue
 				}
 
-				tx := &item.TxRecord.MsgTx
 				err := b.txNotifier.ProcessRelevantSpendTx(
 					tx, item.Block.Height,
 				)
@@ -395,15 +417,26 @@ out:
 	b.wg.Done()
 }
 
-// historicalConfDetails looks up whether a transaction is already included in a
-// block in the active chain and, if so, returns details about the confirmation.
-func (b *BitcoindNotifier) historicalConfDetails(txid *chainhash.Hash,
-	startHeight, endHeight uint32) (*chainntnfs.TxConfirmation,
+// historicalConfDetails looks up whether a transaction, or a transaction paying
+// to an output script, is already included in a block in the active chain
+// and, if so, returns details about the confirmation.
+func (b *BitcoindNotifier) historicalConfDetails(
+	confRequest chainntnfs.ConfRequest, startHeight,
+	endHeight uint32) (*chainntnfs.TxConfirmation,
 	chainntnfs.TxConfStatus, error) {
 
+	// The txindex can only be used to look up transactions by their hash,
+	// so for requests of an output script, we'll need to scan the chain
+	// manually.
+	if confRequest.TxID == chainntnfs.ZeroHash {
+		return b.confDetailsManually(
+			confRequest, startHeight, endHeight,
+		)
+	}
+
 	// We'll first attempt to retrieve the transaction using the node's
 	// txindex.
-	txConf, txStatus, err := b.confDetailsFromTxIndex(txid)
+	txConf, txStatus, err := b.confDetailsFromTxIndex(&confRequest.TxID)
 
 	// We'll then check the status of the transaction lookup returned to
 	// determine whether we should proceed with any fallback methods.
@@ -414,7 +447,9 @@ func (b *BitcoindNotifier) historicalConfDetails(txid *chainhash.Hash,
 	case err != nil:
 		chainntnfs.Log.Debugf("Failed getting conf details from "+
 			"index (%v), scanning manually", err)
-		return b.confDetailsManually(txid, startHeight, endHeight)
+		return b.confDetailsManually(
+			confRequest, startHeight, endHeight,
+		)
 
 	// The transaction was found within the node's mempool.
 	case txStatus == chainntnfs.TxFoundMempool:
@@ -487,6 +522,19 @@ func (b *BitcoindNotifier) confDetailsFromTxIndex(txid *chainhash.Hash,
 				"historical dispatch: %v", blockHash, err)
 	}
 
+	// The subscriber is also handed the confirmed transaction itself, so
+	// we'll decode it from the raw transaction we've retrieved.
+	txBytes, err := hex.DecodeString(tx.Hex)
+	if err != nil {
+		return nil, chainntnfs.TxNotFoundIndex,
+			fmt.Errorf("unable to decode tx %v: %v", txid, err)
+	}
+	msgTx := &wire.MsgTx{}
+	if err := msgTx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		return nil, chainntnfs.TxNotFoundIndex,
+			fmt.Errorf("unable to deserialize tx %v: %v", txid, err)
+	}
+
 	// If the block was obtained, locate the transaction's index within the
 	// block so we can give the subscriber full confirmation details.
 	targetTxidStr := txid.String()
@@ -496,6 +544,7 @@ func (b *BitcoindNotifier) confDetailsFromTxIndex(txid *chainhash.Hash,
 				BlockHash:   blockHash,
 				BlockHeight: uint32(block.Height),
 				TxIndex:     uint32(txIndex),
+				Tx:          msgTx,
 			}
 			return details, chainntnfs.TxFoundIndex, nil
 		}
@@ -508,17 +557,17 @@ func (b *BitcoindNotifier) confDetailsFromTxIndex(txid *chainhash.Hash,
 			blockHash)
 }
 
-// confDetailsManually looks up whether a transaction is already included in a
-// block in the active chain by scanning the chain's blocks, starting from the
-// earliest height the transaction could have been included in, to the current
-// height in the chain. If the transaction is found, its confirmation details
-// are returned. Otherwise, nil is returned.
-func (b *BitcoindNotifier) confDetailsManually(txid *chainhash.Hash,
-	heightHint, currentHeight uint32) (*chainntnfs.TxConfirmation,
+// confDetailsManually looks up whether a transaction, or a transaction paying
+// to an output script, is already included in a block in the active chain by
+// scanning the chain's blocks, starting from the earliest height the
+// transaction could have been included in, to the current height in the
+// chain. If the transaction is found, its confirmation details are returned.
+// Otherwise, nil is returned.
+func (b *BitcoindNotifier) confDetailsManually(
+	confRequest chainntnfs.ConfRequest, heightHint,
+	currentHeight uint32) (*chainntnfs.TxConfirmation,
 	chainntnfs.TxConfStatus, error) {
 
-	targetTxidStr := txid.String()
-
 	// Begin scanning blocks at every height to determine where the
 	// transaction was included in.
 	for height := heightHint; height <= currentHeight; height++ {
@@ -538,21 +587,22 @@ func (b *BitcoindNotifier) confDetailsManually(txid *chainhash.Hash,
 					"with height %d", height)
 		}
 
-		block, err := b.chainConn.GetBlockVerbose(blockHash)
+		block, err := b.chainConn.GetBlock(blockHash)
 		if err != nil {
 			return nil, chainntnfs.TxNotFoundManually,
 				fmt.Errorf("unable to get block with hash "+
 					"%v: %v", blockHash, err)
 		}
 
-		for txIndex, txHash := range block.Tx {
+		for txIndex, tx := range block.Transactions {
 			// If we're able to find the transaction in this block,
 			// return its confirmation details.
-			if txHash == targetTxidStr {
+			if confRequest.MatchesTx(tx) {
 				details := &chainntnfs.TxConfirmation{
 					BlockHash:   blockHash,
 					BlockHeight: height,
 					TxIndex:     uint32(txIndex),
+					Tx:          tx,
 				}
 				return details, chainntnfs.TxFoundManually, nil
 			}
@@ -625,26 +675,32 @@ func (b *BitcoindNotifier) notifyBlockEpochClient(epochClient *blockEpochRegistr
 }
 
 // RegisterSpendNtfn registers an intent to be notified once the target
-// outpoint has been spent by a transaction on-chain. Once a spend of the target
+// outpoint, or an output paying to the target output script if no outpoint is
+// given, has been spent by a transaction on-chain. Once a spend of the target
 // outpoint has been detected, the details of the spending event will be sent
 // across the 'Spend' channel. The heightHint should represent the earliest
 // height in the chain where the transaction could have been spent in.
 func (b *BitcoindNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
 
+	spendRequest, err := chainntnfs.NewSpendRequest(outpoint, pkScript)
+	if err != nil {
+		return nil, err
+	}
+
 	// First, we'll construct a spend notification request and hand it off
 	// to the txNotifier.
 	spendID := atomic.AddUint64(&b.spendClientCounter, 1)
 	cancel := func() {
-		b.txNotifier.CancelSpend(*outpoint, spendID)
+		b.txNotifier.CancelSpend(spendRequest, spendID)
 	}
 
 	ntfn := &chainntnfs.SpendNtfn{
-		SpendID:    spendID,
-		OutPoint:   *outpoint,
-		PkScript:   pkScript,
-		Event:      chainntnfs.NewSpendEvent(cancel),
-		HeightHint: heightHint,
+		SpendID:      spendID,
+		SpendRequest: spendRequest,
+		PkScript:     pkScript,
+		Event:        chainntnfs.NewSpendEvent(cancel),
+		HeightHint:   heightHint,
 	}
 
 	historicalDispatch, err := b.txNotifier.RegisterSpend(ntfn)
@@ -659,6 +715,19 @@ func (b *BitcoindNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 		return ntfn.Event, nil
 	}
 
+	// The spends of an output script will be detected within the blocks
+	// connected at tip, but neither the UTXO set nor the transaction index
+	// can tell us whether one was spent in the past, so we'll scan the
+	// chain for it ourselves.
+	if outpoint == nil {
+		select {
+		case b.notificationRegistry <- historicalDispatch:
+			return ntfn.Event, nil
+		case <-b.quit:
+			return nil, ErrChainNotifierShuttingDown
+		}
+	}
+
 	// We'll then request the backend to notify us when it has detected the
 	// outpoint as spent.
 	if err := b.chainConn.NotifySpent([]*wire.OutPoint{outpoint}); err != nil {
@@ -675,7 +744,7 @@ func (b *BitcoindNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	if txOut != nil {
 		// We'll let the txNotifier know the outpoint is still unspent
 		// in order to begin updating its spend hint.
-		err := b.txNotifier.UpdateSpendDetails(*outpoint, nil)
+		err := b.txNotifier.UpdateSpendDetails(spendRequest, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -737,13 +806,14 @@ func (b *BitcoindNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 }
 
 // disaptchSpendDetailsManually attempts to manually scan the chain within the
-// given height range for a transaction that spends the given outpoint. If one
-// is found, it's spending details are sent to the notifier dispatcher, which
-// will then dispatch the notification to all of its clients.
+// given height range for a transaction that spends the given outpoint, or an
+// output paying to the given output script. If one is found, it's spending
+// details are sent to the notifier dispatcher, which will then dispatch the
+// notification to all of its clients.
 func (b *BitcoindNotifier) dispatchSpendDetailsManually(
 	historicalDispatchDetails *chainntnfs.HistoricalSpendDispatch) error {
 
-	op := historicalDispatchDetails.OutPoint
+	spendRequest := historicalDispatchDetails.SpendRequest
 	startHeight := historicalDispatchDetails.StartHeight
 	endHeight := historicalDispatchDetails.EndHeight
 
@@ -773,52 +843,91 @@ func (b *BitcoindNotifier) dispatchSpendDetailsManually(
 		// Then, we'll manually go over every transaction in it and
 		// determine whether it spends the outpoint in question.
 		for _, tx := range block.Transactions {
-			for i, txIn := range tx.TxIn {
-				if txIn.PreviousOutPoint != op {
-					continue
-				}
-
-				// If it does, we'll construct its spend details
-				// and hand them over to the TxNotifier so that
-				// it can properly notify its registered
-				// clients.
-				txHash := tx.TxHash()
-				details := &chainntnfs.SpendDetail{
-					SpentOutPoint:     &op,
-					SpenderTxHash:     &txHash,
-					SpendingTx:        tx,
-					SpenderInputIndex: uint32(i),
-					SpendingHeight:    int32(height),
-				}
+			matches, inputIndex := spendRequest.MatchesTx(tx)
+			if !matches {
+				continue
+			}
 
-				return b.txNotifier.UpdateSpendDetails(
-					op, details,
-				)
+			// If it does, we'll construct its spend details and
+			// hand them over to the TxNotifier so that it can
+			// properly notify its registered clients.
+			txHash := tx.TxHash()
+			op := tx.TxIn[inputIndex].PreviousOutPoint
+			details := &chainntnfs.SpendDetail{
+				SpentOutPoint:     &op,
+				SpenderTxHash:     &txHash,
+				SpendingTx:        tx,
+				SpenderInputIndex: inputIndex,
+				SpendingHeight:    int32(height),
 			}
+
+			return b.txNotifier.UpdateSpendDetails(
+				spendRequest, details,
+			)
 		}
 	}
 
 	return ErrTransactionNotFound
 }
 
+// RegisterMempoolSpendNtfn registers an intent to be notified once the target
+// outpoint is spent by a transaction accepted into bitcoind's mempool. Once
+// such a spend has been detected, its details will be sent across the 'Spend'
+// channel of the returned event.
+//
+// NOTE: This is part of the chainntnfs.MempoolSpendNotifier interface.
+func (b *BitcoindNotifier) RegisterMempoolSpendNtfn(
+	outpoint *wire.OutPoint) (*chainntnfs.MempoolSpendEvent, error) {
+
+	if b.mempoolSpends == nil {
+		return nil, chainntnfs.ErrMempoolSpendsDisabled
+	}
+
+	ntfn, err := b.mempoolSpends.Register(*outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// bitcoind only relays the mempool transactions that match our
+	// filter, so we'll make sure the outpoint is a part of it.
+	err = b.chainConn.NotifySpent([]*wire.OutPoint{outpoint})
+	if err != nil {
+		ntfn.Cancel()
+		return nil, err
+	}
+
+	return ntfn, nil
+}
+
 // RegisterConfirmationsNtfn registers a notification with BitcoindNotifier
-// which will be triggered once the txid reaches numConfs number of
-// confirmations.
+// which will be triggered once the txid, or the first transaction paying to
+// pkScript if no txid is given, reaches numConfs number of confirmations.
 func (b *BitcoindNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
-	_ []byte, numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+	pkScript []byte,
+	numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	confRequest, err := chainntnfs.NewConfRequest(txid, pkScript)
+	if err != nil {
+		return nil, err
+	}
 
 	// Construct a notification request for the transaction and send it to
 	// the main event loop.
+	confID := atomic.AddUint64(&b.confClientCounter, 1)
+	cancel := func() {
+		b.txNotifier.CancelConf(confRequest, confID)
+	}
 	ntfn := &chainntnfs.ConfNtfn{
-		ConfID:           atomic.AddUint64(&b.confClientCounter, 1),
-		TxID:             txid,
+		ConfID:           confID,
+		ConfRequest:      confRequest,
+		PkScript:         pkScript,
 		NumConfirmations: numConfs,
-		Event:            chainntnfs.NewConfirmationEvent(numConfs),
+		Event:            chainntnfs.NewConfirmationEvent(numConfs, cancel),
 		HeightHint:       heightHint,
 	}
 
-	chainntnfs.Log.Infof("New confirmation subscription: "+
-		"txid=%v, numconfs=%v", txid, numConfs)
+	chainntnfs.Log.Infof("New confirmation subscription: %v, numconfs=%v",
+		confRequest, numConfs)
 
 	// Register the conf notification with the TxNotifier. A non-nil value
 	// for `dispatch` will be returned if we are required to perform a
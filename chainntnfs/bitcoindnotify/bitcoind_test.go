@@ -104,8 +104,8 @@ func TestHistoricalConfDetailsTxIndex(t *testing.T) {
 	// A transaction unknown to the node should not be found within the
 	// txindex even if it is enabled, so we should not proceed with any
 	// fallback methods.
-	var zeroHash chainhash.Hash
-	_, txStatus, err := notifier.historicalConfDetails(&zeroHash, 0, 0)
+	unknownConfReq := chainntnfs.ConfRequest{TxID: chainhash.Hash{0x01}}
+	_, txStatus, err := notifier.historicalConfDetails(unknownConfReq, 0, 0)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
 	}
@@ -129,7 +129,9 @@ func TestHistoricalConfDetailsTxIndex(t *testing.T) {
 	}
 
 	// The transaction should be found in the mempool at this point.
-	_, txStatus, err = notifier.historicalConfDetails(txid, 0, 0)
+	_, txStatus, err = notifier.historicalConfDetails(
+		chainntnfs.ConfRequest{TxID: *txid}, 0, 0,
+	)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
 	}
@@ -151,7 +153,9 @@ func TestHistoricalConfDetailsTxIndex(t *testing.T) {
 	// the txindex includes the transaction just mined.
 	syncNotifierWithMiner(t, notifier, miner)
 
-	_, txStatus, err = notifier.historicalConfDetails(txid, 0, 0)
+	_, txStatus, err = notifier.historicalConfDetails(
+		chainntnfs.ConfRequest{TxID: *txid}, 0, 0,
+	)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
 	}
@@ -186,10 +190,11 @@ func TestHistoricalConfDetailsNoTxIndex(t *testing.T) {
 	// Since the node has its txindex disabled, we fall back to scanning the
 	// chain manually. A transaction unknown to the network should not be
 	// found.
-	var zeroHash chainhash.Hash
+	unknownConfReq := chainntnfs.ConfRequest{TxID: chainhash.Hash{0x01}}
 	broadcastHeight := syncNotifierWithMiner(t, notifier, miner)
 	_, txStatus, err := notifier.historicalConfDetails(
-		&zeroHash, uint32(broadcastHeight), uint32(broadcastHeight),
+		unknownConfReq, uint32(broadcastHeight),
+		uint32(broadcastHeight),
 	)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
@@ -227,7 +232,8 @@ func TestHistoricalConfDetailsNoTxIndex(t *testing.T) {
 	// we can find the transaction when manually scanning the chain.
 	currentHeight := syncNotifierWithMiner(t, notifier, miner)
 	_, txStatus, err = notifier.historicalConfDetails(
-		&output.Hash, uint32(broadcastHeight), uint32(currentHeight),
+		chainntnfs.ConfRequest{TxID: output.Hash},
+		uint32(broadcastHeight), uint32(currentHeight),
 	)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
@@ -1,6 +1,8 @@
 package btcdnotify
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strings"
@@ -76,6 +78,16 @@ type BtcdNotifier struct {
 
 	txNotifier *chainntnfs.TxNotifier
 
+	// mempoolSpends dispatches the spends of outpoints by unconfirmed
+	// transactions to clients registered for mempool spends. It's nil if
+	// mempool spend notifications are disabled.
+	mempoolSpends *chainntnfs.MempoolSpendDispatcher
+
+	// mempoolTxsRequested is true while we've requested btcd to notify us
+	// of all transactions accepted into its mempool.
+	mempoolTxsRequested bool
+	mempoolTxsMtx       sync.Mutex
+
 	blockEpochClients map[uint64]*blockEpochRegistration
 
 	bestBlock chainntnfs.BlockEpoch
@@ -97,14 +109,18 @@ type BtcdNotifier struct {
 	quit chan struct{}
 }
 
-// Ensure BtcdNotifier implements the ChainNotifier interface at compile time.
+// Ensure BtcdNotifier implements the ChainNotifier and MempoolSpendNotifier
+// interfaces at compile time.
 var _ chainntnfs.ChainNotifier = (*BtcdNotifier)(nil)
+var _ chainntnfs.MempoolSpendNotifier = (*BtcdNotifier)(nil)
 
 // New returns a new BtcdNotifier instance. This function assumes the btcd node
 // detailed in the passed configuration is already running, and willing to
-// accept new websockets clients.
+// accept new websockets clients. Clients may only register for mempool spends
+// if mempoolSpends is true.
 func New(config *rpcclient.ConnConfig, spendHintCache chainntnfs.SpendHintCache,
-	confirmHintCache chainntnfs.ConfirmHintCache) (*BtcdNotifier, error) {
+	confirmHintCache chainntnfs.ConfirmHintCache,
+	mempoolSpends bool) (*BtcdNotifier, error) {
 
 	notifier := &BtcdNotifier{
 		notificationCancels:  make(chan interface{}),
@@ -126,6 +142,10 @@ func New(config *rpcclient.ConnConfig, spendHintCache chainntnfs.SpendHintCache,
 		OnBlockDisconnected: notifier.onBlockDisconnected,
 		OnRedeemingTx:       notifier.onRedeemingTx,
 	}
+	if mempoolSpends {
+		notifier.mempoolSpends = chainntnfs.NewMempoolSpendDispatcher()
+		ntfnCallbacks.OnTxAcceptedVerbose = notifier.onTxAcceptedVerbose
+	}
 
 	// Disable connecting to btcd within the rpcclient.New method. We
 	// defer establishing the connection to our .Start() method.
@@ -207,6 +227,9 @@ func (b *BtcdNotifier) Stop() error {
 		close(epochClient.epochChan)
 	}
 	b.txNotifier.TearDown()
+	if b.mempoolSpends != nil {
+		b.mempoolSpends.TearDown()
+	}
 
 	return nil
 }
@@ -259,6 +282,29 @@ func (b *BtcdNotifier) onRedeemingTx(tx *btcutil.Tx, details *btcjson.BlockDetai
 	b.txUpdates.ChanIn() <- &txUpdate{tx, details}
 }
 
+// onTxAcceptedVerbose implements the OnTxAcceptedVerbose callback for
+// rpcclient. It's only called while clients are registered for mempool spends,
+// as we don't request notifications of new mempool transactions otherwise.
+func (b *BtcdNotifier) onTxAcceptedVerbose(txDetails *btcjson.TxRawResult) {
+	txBytes, err := hex.DecodeString(txDetails.Hex)
+	if err != nil {
+		chainntnfs.Log.Errorf("Unable to decode mempool transaction "+
+			"%v: %v", txDetails.Txid, err)
+		return
+	}
+
+	tx := &wire.MsgTx{}
+	if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		chainntnfs.Log.Errorf("Unable to deserialize mempool "+
+			"transaction %v: %v", txDetails.Txid, err)
+		return
+	}
+
+	// Append this new transaction update to the end of the queue of new
+	// chain updates. As it's unconfirmed, it carries no block details.
+	b.txUpdates.ChanIn() <- &txUpdate{btcutil.NewTx(tx), nil}
+}
+
 // notificationDispatcher is the primary goroutine which handles client
 // notification registrations, as well as notification dispatches.
 func (b *BtcdNotifier) notificationDispatcher() {
@@ -305,7 +351,8 @@ out:
 					defer b.wg.Done()
 
 					confDetails, _, err := b.historicalConfDetails(
-						msg.TxID, msg.StartHeight, msg.EndHeight,
+						msg.ConfRequest,
+						msg.StartHeight, msg.EndHeight,
 					)
 					if err != nil {
 						chainntnfs.Log.Error(err)
@@ -320,7 +367,42 @@ out:
 					// cache at tip, since any pending
 					// rescans have now completed.
 					err = b.txNotifier.UpdateConfDetails(
-						*msg.TxID, confDetails,
+						msg.ConfRequest, confDetails,
+					)
+					if err != nil {
+						chainntnfs.Log.Error(err)
+					}
+				}()
+
+			case *chainntnfs.HistoricalSpendDispatch:
+				// As btcd can't rescan the chain for the spend
+				// of an output script, we'll scan the blocks
+				// ourselves in a goroutine to prevent blocking
+				// on what may be a long rescan.
+				//
+				// TODO(wilmer): add retry logic if rescan fails?
+				b.wg.Add(1)
+				go func() {
+					defer b.wg.Done()
+
+					spendDetails, err := b.historicalSpendDetails(
+						msg.SpendRequest,
+						msg.StartHeight, msg.EndHeight,
+					)
+					if err != nil {
+						chainntnfs.Log.Errorf("Rescan to "+
+							"determine the spend "+
+							"details of %v failed: %v",
+							msg.SpendRequest, err)
+						return
+					}
+
+					// Whether or not the spend was found,
+					// we'll let the TxNotifier know that the
+					// rescan has completed, allowing it to
+					// begin updating the spend hint at tip.
+					err = b.txNotifier.UpdateSpendDetails(
+						msg.SpendRequest, spendDetails,
 					)
 					if err != nil {
 						chainntnfs.Log.Error(err)
@@ -423,14 +505,18 @@ out:
 		case item := <-b.txUpdates.ChanOut():
 			newSpend := item.(*txUpdate)
 
-			// We only care about notifying on confirmed spends, so
-			// if this is a mempool spend, we can ignore it and wait
-			// for the spend to appear in on-chain.
+			// Spends within the mempool are only of interest to
+			// clients registered for mempool spends. The regular
+			// spend clients will be notified once the spend
+			// appears on-chain.
+			tx := newSpend.tx.MsgTx()
 			if newSpend.details == nil {
+				if b.mempoolSpends != nil {
+					b.mempoolSpends.ProcessTx(tx)
+				}
 				continue
 			}
 
-			tx := newSpend.tx.MsgTx()
 			err := b.txNotifier.ProcessRelevantSpendTx(
 				tx, newSpend.details.Height,
 			)
@@ -446,15 +532,25 @@ out:
 	b.wg.Done()
 }
 
-// historicalConfDetails looks up whether a transaction is already included in a
-// block in the active chain and, if so, returns details about the confirmation.
-func (b *BtcdNotifier) historicalConfDetails(txid *chainhash.Hash,
+// historicalConfDetails looks up whether a transaction, or a transaction paying
+// to an output script, is already included in a block in the active chain
+// and, if so, returns details about the confirmation.
+func (b *BtcdNotifier) historicalConfDetails(confRequest chainntnfs.ConfRequest,
 	startHeight, endHeight uint32) (*chainntnfs.TxConfirmation,
 	chainntnfs.TxConfStatus, error) {
 
+	// The txindex can only be used to look up transactions by their hash,
+	// so for requests of an output script, we'll need to scan the chain
+	// manually.
+	if confRequest.TxID == chainntnfs.ZeroHash {
+		return b.confDetailsManually(
+			confRequest, startHeight, endHeight,
+		)
+	}
+
 	// We'll first attempt to retrieve the transaction using the node's
 	// txindex.
-	txConf, txStatus, err := b.confDetailsFromTxIndex(txid)
+	txConf, txStatus, err := b.confDetailsFromTxIndex(&confRequest.TxID)
 
 	// We'll then check the status of the transaction lookup returned to
 	// determine whether we should proceed with any fallback methods.
@@ -465,7 +561,9 @@ func (b *BtcdNotifier) historicalConfDetails(txid *chainhash.Hash,
 	case err != nil:
 		chainntnfs.Log.Debugf("Failed getting conf details from "+
 			"index (%v), scanning manually", err)
-		return b.confDetailsManually(txid, startHeight, endHeight)
+		return b.confDetailsManually(
+			confRequest, startHeight, endHeight,
+		)
 
 	// The transaction was found within the node's mempool.
 	case txStatus == chainntnfs.TxFoundMempool:
@@ -538,6 +636,19 @@ func (b *BtcdNotifier) confDetailsFromTxIndex(txid *chainhash.Hash,
 				"historical dispatch: %v", blockHash, err)
 	}
 
+	// The subscriber is also handed the confirmed transaction itself, so
+	// we'll decode it from the raw transaction we've retrieved.
+	txBytes, err := hex.DecodeString(tx.Hex)
+	if err != nil {
+		return nil, chainntnfs.TxNotFoundIndex,
+			fmt.Errorf("unable to decode tx %v: %v", txid, err)
+	}
+	msgTx := &wire.MsgTx{}
+	if err := msgTx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+		return nil, chainntnfs.TxNotFoundIndex,
+			fmt.Errorf("unable to deserialize tx %v: %v", txid, err)
+	}
+
 	// If the block was obtained, locate the transaction's index within the
 	// block so we can give the subscriber full confirmation details.
 	targetTxidStr := txid.String()
@@ -547,6 +658,7 @@ func (b *BtcdNotifier) confDetailsFromTxIndex(txid *chainhash.Hash,
 				BlockHash:   blockHash,
 				BlockHeight: uint32(block.Height),
 				TxIndex:     uint32(txIndex),
+				Tx:          msgTx,
 			}
 			return details, chainntnfs.TxFoundIndex, nil
 		}
@@ -559,17 +671,16 @@ func (b *BtcdNotifier) confDetailsFromTxIndex(txid *chainhash.Hash,
 			blockHash)
 }
 
-// confDetailsManually looks up whether a transaction is already included in a
-// block in the active chain by scanning the chain's blocks, starting from the
-// earliest height the transaction could have been included in, to the current
-// height in the chain. If the transaction is found, its confirmation details
-// are returned. Otherwise, nil is returned.
-func (b *BtcdNotifier) confDetailsManually(txid *chainhash.Hash, startHeight,
-	endHeight uint32) (*chainntnfs.TxConfirmation,
+// confDetailsManually looks up whether a transaction, or a transaction paying
+// to an output script, is already included in a block in the active chain by
+// scanning the chain's blocks, starting from the earliest height the
+// transaction could have been included in, to the current height in the
+// chain. If the transaction is found, its confirmation details are returned.
+// Otherwise, nil is returned.
+func (b *BtcdNotifier) confDetailsManually(confRequest chainntnfs.ConfRequest,
+	startHeight, endHeight uint32) (*chainntnfs.TxConfirmation,
 	chainntnfs.TxConfStatus, error) {
 
-	targetTxidStr := txid.String()
-
 	// Begin scanning blocks at every height to determine where the
 	// transaction was included in.
 	for height := startHeight; height <= endHeight; height++ {
@@ -590,21 +701,22 @@ func (b *BtcdNotifier) confDetailsManually(txid *chainhash.Hash, startHeight,
 		}
 
 		// TODO: fetch the neutrino filters instead.
-		block, err := b.chainConn.GetBlockVerbose(blockHash)
+		block, err := b.chainConn.GetBlock(blockHash)
 		if err != nil {
 			return nil, chainntnfs.TxNotFoundManually,
 				fmt.Errorf("unable to get block with hash "+
 					"%v: %v", blockHash, err)
 		}
 
-		for txIndex, txHash := range block.Tx {
+		for txIndex, tx := range block.Transactions {
 			// If we're able to find the transaction in this block,
 			// return its confirmation details.
-			if txHash == targetTxidStr {
+			if confRequest.MatchesTx(tx) {
 				details := &chainntnfs.TxConfirmation{
 					BlockHash:   blockHash,
 					BlockHeight: height,
 					TxIndex:     uint32(txIndex),
+					Tx:          tx,
 				}
 				return details, chainntnfs.TxFoundManually, nil
 			}
@@ -616,6 +728,59 @@ func (b *BtcdNotifier) confDetailsManually(txid *chainhash.Hash, startHeight,
 	return nil, chainntnfs.TxNotFoundManually, nil
 }
 
+// historicalSpendDetails attempts to manually scan the chain within the given
+// height range for a transaction that spends the given outpoint, or an output
+// paying to the given output script. If one is found, its spend details are
+// returned. Otherwise, nil is returned.
+func (b *BtcdNotifier) historicalSpendDetails(
+	spendRequest chainntnfs.SpendRequest, startHeight,
+	endHeight uint32) (*chainntnfs.SpendDetail, error) {
+
+	// Begin scanning blocks at every height to determine if the outpoint
+	// was spent.
+	for height := startHeight; height <= endHeight; height++ {
+		// Ensure we haven't been requested to shut down before
+		// processing the next height.
+		select {
+		case <-b.quit:
+			return nil, ErrChainNotifierShuttingDown
+		default:
+		}
+
+		blockHash, err := b.chainConn.GetBlockHash(int64(height))
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve hash for "+
+				"block with height %d: %v", height, err)
+		}
+		block, err := b.chainConn.GetBlock(blockHash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve block "+
+				"with hash %v: %v", blockHash, err)
+		}
+
+		// Then, we'll manually go over every transaction in it and
+		// determine whether it spends the outpoint in question.
+		for _, tx := range block.Transactions {
+			matches, inputIndex := spendRequest.MatchesTx(tx)
+			if !matches {
+				continue
+			}
+
+			txHash := tx.TxHash()
+			spentOutPoint := tx.TxIn[inputIndex].PreviousOutPoint
+			return &chainntnfs.SpendDetail{
+				SpentOutPoint:     &spentOutPoint,
+				SpenderTxHash:     &txHash,
+				SpendingTx:        tx,
+				SpenderInputIndex: inputIndex,
+				SpendingHeight:    int32(height),
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
 // handleBlockConnected applies a chain update for a new block. Any watched
 // transactions included this block will processed to either send notifications
 // now or after numConfirmations confs.
@@ -686,25 +851,31 @@ func (b *BtcdNotifier) notifyBlockEpochClient(epochClient *blockEpochRegistratio
 }
 
 // RegisterSpendNtfn registers an intent to be notified once the target
-// outpoint has been spent by a transaction on-chain. Once a spend of the target
+// outpoint, or an output paying to the target output script if no outpoint is
+// given, has been spent by a transaction on-chain. Once a spend of the target
 // outpoint has been detected, the details of the spending event will be sent
 // across the 'Spend' channel. The heightHint should represent the earliest
 // height in the chain where the transaction could have been spent in.
 func (b *BtcdNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
 
+	spendRequest, err := chainntnfs.NewSpendRequest(outpoint, pkScript)
+	if err != nil {
+		return nil, err
+	}
+
 	// First, we'll construct a spend notification request and hand it off
 	// to the txNotifier.
 	spendID := atomic.AddUint64(&b.spendClientCounter, 1)
 	cancel := func() {
-		b.txNotifier.CancelSpend(*outpoint, spendID)
+		b.txNotifier.CancelSpend(spendRequest, spendID)
 	}
 	ntfn := &chainntnfs.SpendNtfn{
-		SpendID:    spendID,
-		OutPoint:   *outpoint,
-		PkScript:   pkScript,
-		Event:      chainntnfs.NewSpendEvent(cancel),
-		HeightHint: heightHint,
+		SpendID:      spendID,
+		SpendRequest: spendRequest,
+		PkScript:     pkScript,
+		Event:        chainntnfs.NewSpendEvent(cancel),
+		HeightHint:   heightHint,
 	}
 
 	historicalDispatch, err := b.txNotifier.RegisterSpend(ntfn)
@@ -719,6 +890,18 @@ func (b *BtcdNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 		return ntfn.Event, nil
 	}
 
+	// The spends of an output script will be detected within the blocks
+	// connected at tip, but btcd is unable to tell us whether one was
+	// spent in the past, so we'll scan the chain for it ourselves.
+	if outpoint == nil {
+		select {
+		case b.notificationRegistry <- historicalDispatch:
+			return ntfn.Event, nil
+		case <-b.quit:
+			return nil, ErrChainNotifierShuttingDown
+		}
+	}
+
 	// We'll then request the backend to notify us when it has detected the
 	// outpoint as spent.
 	ops := []*wire.OutPoint{outpoint}
@@ -736,7 +919,7 @@ func (b *BtcdNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	if txOut != nil {
 		// We'll let the txNotifier know the outpoint is still unspent
 		// in order to begin updating its spend hint.
-		err := b.txNotifier.UpdateSpendDetails(*outpoint, nil)
+		err := b.txNotifier.UpdateSpendDetails(spendRequest, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -820,24 +1003,100 @@ func (b *BtcdNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	return ntfn.Event, nil
 }
 
+// RegisterMempoolSpendNtfn registers an intent to be notified once the target
+// outpoint is spent by a transaction accepted into btcd's mempool. Once such a
+// spend has been detected, its details will be sent across the 'Spend'
+// channel of the returned event.
+//
+// NOTE: This is part of the chainntnfs.MempoolSpendNotifier interface.
+func (b *BtcdNotifier) RegisterMempoolSpendNtfn(
+	outpoint *wire.OutPoint) (*chainntnfs.MempoolSpendEvent, error) {
+
+	if b.mempoolSpends == nil {
+		return nil, chainntnfs.ErrMempoolSpendsDisabled
+	}
+
+	b.mempoolTxsMtx.Lock()
+	defer b.mempoolTxsMtx.Unlock()
+
+	ntfn, err := b.mempoolSpends.Register(*outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// We only request btcd to notify us of the transactions accepted into
+	// its mempool while clients are registered, as relaying every one of
+	// them is costly.
+	if !b.mempoolTxsRequested {
+		if err := b.chainConn.NotifyNewTransactions(true); err != nil {
+			ntfn.Cancel()
+			return nil, err
+		}
+		b.mempoolTxsRequested = true
+	}
+
+	cancel := ntfn.Cancel
+	ntfn.Cancel = func() {
+		cancel()
+		b.maybeStopMempoolTxs()
+	}
+
+	return ntfn, nil
+}
+
+// maybeStopMempoolTxs requests btcd to stop notifying us of the transactions
+// accepted into its mempool once no clients are registered for mempool spends
+// anymore.
+//
+// NOTE: rpcclient will request the notifications again if it has to reconnect
+// to btcd, in which case they'll only be stopped once the next client cancels
+// its registration.
+func (b *BtcdNotifier) maybeStopMempoolTxs() {
+	b.mempoolTxsMtx.Lock()
+	defer b.mempoolTxsMtx.Unlock()
+
+	if !b.mempoolTxsRequested || b.mempoolSpends.NumClients() != 0 {
+		return
+	}
+
+	_, err := b.chainConn.RawRequest("stopnotifynewtransactions", nil)
+	if err != nil {
+		chainntnfs.Log.Errorf("Unable to stop mempool transaction "+
+			"notifications: %v", err)
+		return
+	}
+	b.mempoolTxsRequested = false
+}
+
 // RegisterConfirmationsNtfn registers a notification with BtcdNotifier
-// which will be triggered once the txid reaches numConfs number of
-// confirmations.
-func (b *BtcdNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash, _ []byte,
+// which will be triggered once the txid, or the first transaction paying to
+// pkScript if no txid is given, reaches numConfs number of confirmations.
+func (b *BtcdNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte,
 	numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
 
+	confRequest, err := chainntnfs.NewConfRequest(txid, pkScript)
+	if err != nil {
+		return nil, err
+	}
+
 	// Construct a notification request for the transaction and send it to
 	// the main event loop.
+	confID := atomic.AddUint64(&b.confClientCounter, 1)
+	cancel := func() {
+		b.txNotifier.CancelConf(confRequest, confID)
+	}
 	ntfn := &chainntnfs.ConfNtfn{
-		ConfID:           atomic.AddUint64(&b.confClientCounter, 1),
-		TxID:             txid,
+		ConfID:           confID,
+		ConfRequest:      confRequest,
+		PkScript:         pkScript,
 		NumConfirmations: numConfs,
-		Event:            chainntnfs.NewConfirmationEvent(numConfs),
+		Event:            chainntnfs.NewConfirmationEvent(numConfs, cancel),
 		HeightHint:       heightHint,
 	}
 
-	chainntnfs.Log.Infof("New confirmation subscription: "+
-		"txid=%v, numconfs=%v", txid, numConfs)
+	chainntnfs.Log.Infof("New confirmation subscription: %v, numconfs=%v",
+		confRequest, numConfs)
 
 	// Register the conf notification with the TxNotifier. A non-nil value
 	// for `dispatch` will be returned if we are required to perform a
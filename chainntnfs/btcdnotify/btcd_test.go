@@ -64,8 +64,8 @@ func TestHistoricalConfDetailsTxIndex(t *testing.T) {
 	// A transaction unknown to the node should not be found within the
 	// txindex even if it is enabled, so we should not proceed with any
 	// fallback methods.
-	var zeroHash chainhash.Hash
-	_, txStatus, err := notifier.historicalConfDetails(&zeroHash, 0, 0)
+	unknownConfReq := chainntnfs.ConfRequest{TxID: chainhash.Hash{0x01}}
+	_, txStatus, err := notifier.historicalConfDetails(unknownConfReq, 0, 0)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
 	}
@@ -89,7 +89,9 @@ func TestHistoricalConfDetailsTxIndex(t *testing.T) {
 	}
 
 	// The transaction should be found in the mempool at this point.
-	_, txStatus, err = notifier.historicalConfDetails(txid, 0, 0)
+	_, txStatus, err = notifier.historicalConfDetails(
+		chainntnfs.ConfRequest{TxID: *txid}, 0, 0,
+	)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
 	}
@@ -109,7 +111,9 @@ func TestHistoricalConfDetailsTxIndex(t *testing.T) {
 		t.Fatalf("unable to generate block: %v", err)
 	}
 
-	_, txStatus, err = notifier.historicalConfDetails(txid, 0, 0)
+	_, txStatus, err = notifier.historicalConfDetails(
+		chainntnfs.ConfRequest{TxID: *txid}, 0, 0,
+	)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
 	}
@@ -139,8 +143,8 @@ func TestHistoricalConfDetailsNoTxIndex(t *testing.T) {
 	// Since the node has its txindex disabled, we fall back to scanning the
 	// chain manually. A transaction unknown to the network should not be
 	// found.
-	var zeroHash chainhash.Hash
-	_, txStatus, err := notifier.historicalConfDetails(&zeroHash, 0, 0)
+	unknownConfReq := chainntnfs.ConfRequest{TxID: chainhash.Hash{0x01}}
+	_, txStatus, err := notifier.historicalConfDetails(unknownConfReq, 0, 0)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
 	}
@@ -169,7 +173,9 @@ func TestHistoricalConfDetailsNoTxIndex(t *testing.T) {
 		t.Fatalf("unable to find tx in the mempool: %v", err)
 	}
 
-	_, txStatus, err = notifier.historicalConfDetails(txid, 0, 0)
+	_, txStatus, err = notifier.historicalConfDetails(
+		chainntnfs.ConfRequest{TxID: *txid}, 0, 0,
+	)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
 	}
@@ -188,7 +194,8 @@ func TestHistoricalConfDetailsNoTxIndex(t *testing.T) {
 	}
 
 	_, txStatus, err = notifier.historicalConfDetails(
-		txid, uint32(currentHeight), uint32(currentHeight)+1,
+		chainntnfs.ConfRequest{TxID: *txid},
+		uint32(currentHeight), uint32(currentHeight)+1,
 	)
 	if err != nil {
 		t.Fatalf("unable to retrieve historical conf details: %v", err)
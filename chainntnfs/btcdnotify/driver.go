@@ -11,9 +11,9 @@ import (
 // createNewNotifier creates a new instance of the ChainNotifier interface
 // implemented by BtcdNotifier.
 func createNewNotifier(args ...interface{}) (chainntnfs.ChainNotifier, error) {
-	if len(args) != 3 {
+	if len(args) != 4 {
 		return nil, fmt.Errorf("incorrect number of arguments to "+
-			".New(...), expected 2, instead passed %v", len(args))
+			".New(...), expected 4, instead passed %v", len(args))
 	}
 
 	config, ok := args[0].(*rpcclient.ConnConfig)
@@ -34,7 +34,13 @@ func createNewNotifier(args ...interface{}) (chainntnfs.ChainNotifier, error) {
 			"is incorrect, expected a chainntnfs.ConfirmHintCache")
 	}
 
-	return New(config, spendHintCache, confirmHintCache)
+	mempoolSpends, ok := args[3].(bool)
+	if !ok {
+		return nil, errors.New("fourth argument to btcdnotifier.New " +
+			"is incorrect, expected a bool")
+	}
+
+	return New(config, spendHintCache, confirmHintCache, mempoolSpends)
 }
 
 // init registers a driver for the BtcdNotifier concrete implementation of the
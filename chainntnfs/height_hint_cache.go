@@ -4,8 +4,6 @@ import (
 	"bytes"
 	"errors"
 
-	"github.com/btcsuite/btcd/chaincfg/chainhash"
-	"github.com/btcsuite/btcd/wire"
 	bolt "github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/channeldb"
 )
@@ -21,14 +19,15 @@ const (
 
 var (
 	// spendHintBucket is the name of the bucket which houses the height
-	// hint for outpoints. Each height hint represents the earliest height
-	// at which its corresponding outpoint could have been spent within.
+	// hint for outpoints and output scripts. Each height hint represents
+	// the earliest height at which its corresponding outpoint could have
+	// been spent within.
 	spendHintBucket = []byte("spend-hints")
 
 	// confirmHintBucket is the name of the bucket which houses the height
-	// hints for transactions. Each height hint represents the earliest
-	// height at which its corresponding transaction could have been
-	// confirmed within.
+	// hints for transactions and output scripts. Each height hint
+	// represents the earliest height at which its corresponding
+	// transaction could have been confirmed within.
 	confirmHintBucket = []byte("confirm-hints")
 
 	// ErrCorruptedHeightHintCache indicates that the on-disk bucketing
@@ -47,38 +46,40 @@ var (
 )
 
 // SpendHintCache is an interface whose duty is to cache spend hints for
-// outpoints. A spend hint is defined as the earliest height in the chain at
-// which an outpoint could have been spent within.
+// outpoints and output scripts. A spend hint is defined as the earliest height
+// in the chain at which an outpoint could have been spent within.
 type SpendHintCache interface {
-	// CommitSpendHint commits a spend hint for the outpoints to the cache.
-	CommitSpendHint(height uint32, ops ...wire.OutPoint) error
+	// CommitSpendHint commits a spend hint for the spend requests to the
+	// cache.
+	CommitSpendHint(height uint32, spendRequests ...SpendRequest) error
 
-	// QuerySpendHint returns the latest spend hint for an outpoint.
+	// QuerySpendHint returns the latest spend hint for a spend request.
 	// ErrSpendHintNotFound is returned if a spend hint does not exist
-	// within the cache for the outpoint.
-	QuerySpendHint(op wire.OutPoint) (uint32, error)
+	// within the cache for the request.
+	QuerySpendHint(spendRequest SpendRequest) (uint32, error)
 
-	// PurgeSpendHint removes the spend hint for the outpoints from the
-	// cache.
-	PurgeSpendHint(ops ...wire.OutPoint) error
+	// PurgeSpendHint removes the spend hint for the spend requests from
+	// the cache.
+	PurgeSpendHint(spendRequests ...SpendRequest) error
 }
 
 // ConfirmHintCache is an interface whose duty is to cache confirm hints for
-// transactions. A confirm hint is defined as the earliest height in the chain
-// at which a transaction could have been included in a block.
+// transactions and output scripts. A confirm hint is defined as the earliest
+// height in the chain at which a transaction could have been included in a
+// block.
 type ConfirmHintCache interface {
-	// CommitConfirmHint commits a confirm hint for the transactions to the
-	// cache.
-	CommitConfirmHint(height uint32, txids ...chainhash.Hash) error
-
-	// QueryConfirmHint returns the latest confirm hint for a transaction
-	// hash. ErrConfirmHintNotFound is returned if a confirm hint does not
-	// exist within the cache for the transaction hash.
-	QueryConfirmHint(txid chainhash.Hash) (uint32, error)
-
-	// PurgeConfirmHint removes the confirm hint for the transactions from
-	// the cache.
-	PurgeConfirmHint(txids ...chainhash.Hash) error
+	// CommitConfirmHint commits a confirm hint for the confirmation
+	// requests to the cache.
+	CommitConfirmHint(height uint32, confRequests ...ConfRequest) error
+
+	// QueryConfirmHint returns the latest confirm hint for a confirmation
+	// request. ErrConfirmHintNotFound is returned if a confirm hint does
+	// not exist within the cache for the request.
+	QueryConfirmHint(confRequest ConfRequest) (uint32, error)
+
+	// PurgeConfirmHint removes the confirm hint for the confirmation
+	// requests from the cache.
+	PurgeConfirmHint(confRequests ...ConfRequest) error
 }
 
 // HeightHintCache is an implementation of the SpendHintCache and
@@ -117,13 +118,16 @@ func (c *HeightHintCache) initBuckets() error {
 	})
 }
 
-// CommitSpendHint commits a spend hint for the outpoints to the cache.
-func (c *HeightHintCache) CommitSpendHint(height uint32, ops ...wire.OutPoint) error {
-	if len(ops) == 0 {
+// CommitSpendHint commits a spend hint for the spend requests to the cache.
+func (c *HeightHintCache) CommitSpendHint(height uint32,
+	spendRequests ...SpendRequest) error {
+
+	if len(spendRequests) == 0 {
 		return nil
 	}
 
-	Log.Tracef("Updating spend hint to height %d for %v", height, ops)
+	Log.Tracef("Updating spend hint to height %d for %v", height,
+		spendRequests)
 
 	return c.db.Batch(func(tx *bolt.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
@@ -136,14 +140,13 @@ func (c *HeightHintCache) CommitSpendHint(height uint32, ops ...wire.OutPoint) e
 			return err
 		}
 
-		for _, op := range ops {
-			var outpoint bytes.Buffer
-			err := channeldb.WriteElement(&outpoint, op)
+		for _, spendRequest := range spendRequests {
+			spendHintKey, err := spendRequest.SpendHintKey()
 			if err != nil {
 				return err
 			}
 
-			err = spendHints.Put(outpoint.Bytes(), hint.Bytes())
+			err = spendHints.Put(spendHintKey, hint.Bytes())
 			if err != nil {
 				return err
 			}
@@ -153,10 +156,10 @@ func (c *HeightHintCache) CommitSpendHint(height uint32, ops ...wire.OutPoint) e
 	})
 }
 
-// QuerySpendHint returns the latest spend hint for an outpoint.
+// QuerySpendHint returns the latest spend hint for a spend request.
 // ErrSpendHintNotFound is returned if a spend hint does not exist within the
-// cache for the outpoint.
-func (c *HeightHintCache) QuerySpendHint(op wire.OutPoint) (uint32, error) {
+// cache for the request.
+func (c *HeightHintCache) QuerySpendHint(spendRequest SpendRequest) (uint32, error) {
 	var hint uint32
 	err := c.db.View(func(tx *bolt.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
@@ -164,12 +167,12 @@ func (c *HeightHintCache) QuerySpendHint(op wire.OutPoint) (uint32, error) {
 			return ErrCorruptedHeightHintCache
 		}
 
-		var outpoint bytes.Buffer
-		if err := channeldb.WriteElement(&outpoint, op); err != nil {
+		spendHintKey, err := spendRequest.SpendHintKey()
+		if err != nil {
 			return err
 		}
 
-		spendHint := spendHints.Get(outpoint.Bytes())
+		spendHint := spendHints.Get(spendHintKey)
 		if spendHint == nil {
 			return ErrSpendHintNotFound
 		}
@@ -183,13 +186,13 @@ func (c *HeightHintCache) QuerySpendHint(op wire.OutPoint) (uint32, error) {
 	return hint, nil
 }
 
-// PurgeSpendHint removes the spend hint for the outpoints from the cache.
-func (c *HeightHintCache) PurgeSpendHint(ops ...wire.OutPoint) error {
-	if len(ops) == 0 {
+// PurgeSpendHint removes the spend hint for the spend requests from the cache.
+func (c *HeightHintCache) PurgeSpendHint(spendRequests ...SpendRequest) error {
+	if len(spendRequests) == 0 {
 		return nil
 	}
 
-	Log.Tracef("Removing spend hints for %v", ops)
+	Log.Tracef("Removing spend hints for %v", spendRequests)
 
 	return c.db.Batch(func(tx *bolt.Tx) error {
 		spendHints := tx.Bucket(spendHintBucket)
@@ -197,14 +200,13 @@ func (c *HeightHintCache) PurgeSpendHint(ops ...wire.OutPoint) error {
 			return ErrCorruptedHeightHintCache
 		}
 
-		for _, op := range ops {
-			var outpoint bytes.Buffer
-			err := channeldb.WriteElement(&outpoint, op)
+		for _, spendRequest := range spendRequests {
+			spendHintKey, err := spendRequest.SpendHintKey()
 			if err != nil {
 				return err
 			}
 
-			err = spendHints.Delete(outpoint.Bytes())
+			err = spendHints.Delete(spendHintKey)
 			if err != nil {
 				return err
 			}
@@ -214,13 +216,17 @@ func (c *HeightHintCache) PurgeSpendHint(ops ...wire.OutPoint) error {
 	})
 }
 
-// CommitConfirmHint commits a confirm hint for the transactions to the cache.
-func (c *HeightHintCache) CommitConfirmHint(height uint32, txids ...chainhash.Hash) error {
-	if len(txids) == 0 {
+// CommitConfirmHint commits a confirm hint for the confirmation requests to the
+// cache.
+func (c *HeightHintCache) CommitConfirmHint(height uint32,
+	confRequests ...ConfRequest) error {
+
+	if len(confRequests) == 0 {
 		return nil
 	}
 
-	Log.Tracef("Updating confirm hints to height %d for %v", height, txids)
+	Log.Tracef("Updating confirm hints to height %d for %v", height,
+		confRequests)
 
 	return c.db.Batch(func(tx *bolt.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
@@ -233,14 +239,10 @@ func (c *HeightHintCache) CommitConfirmHint(height uint32, txids ...chainhash.Ha
 			return err
 		}
 
-		for _, txid := range txids {
-			var txHash bytes.Buffer
-			err := channeldb.WriteElement(&txHash, txid)
-			if err != nil {
-				return err
-			}
-
-			err = confirmHints.Put(txHash.Bytes(), hint.Bytes())
+		for _, confRequest := range confRequests {
+			err := confirmHints.Put(
+				confRequest.ConfHintKey(), hint.Bytes(),
+			)
 			if err != nil {
 				return err
 			}
@@ -250,10 +252,10 @@ func (c *HeightHintCache) CommitConfirmHint(height uint32, txids ...chainhash.Ha
 	})
 }
 
-// QueryConfirmHint returns the latest confirm hint for a transaction hash.
+// QueryConfirmHint returns the latest confirm hint for a confirmation request.
 // ErrConfirmHintNotFound is returned if a confirm hint does not exist within
-// the cache for the transaction hash.
-func (c *HeightHintCache) QueryConfirmHint(txid chainhash.Hash) (uint32, error) {
+// the cache for the request.
+func (c *HeightHintCache) QueryConfirmHint(confRequest ConfRequest) (uint32, error) {
 	var hint uint32
 	err := c.db.View(func(tx *bolt.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
@@ -261,12 +263,7 @@ func (c *HeightHintCache) QueryConfirmHint(txid chainhash.Hash) (uint32, error)
 			return ErrCorruptedHeightHintCache
 		}
 
-		var txHash bytes.Buffer
-		if err := channeldb.WriteElement(&txHash, txid); err != nil {
-			return err
-		}
-
-		confirmHint := confirmHints.Get(txHash.Bytes())
+		confirmHint := confirmHints.Get(confRequest.ConfHintKey())
 		if confirmHint == nil {
 			return ErrConfirmHintNotFound
 		}
@@ -280,14 +277,14 @@ func (c *HeightHintCache) QueryConfirmHint(txid chainhash.Hash) (uint32, error)
 	return hint, nil
 }
 
-// PurgeConfirmHint removes the confirm hint for the transactions from the
-// cache.
-func (c *HeightHintCache) PurgeConfirmHint(txids ...chainhash.Hash) error {
-	if len(txids) == 0 {
+// PurgeConfirmHint removes the confirm hint for the confirmation requests from
+// the cache.
+func (c *HeightHintCache) PurgeConfirmHint(confRequests ...ConfRequest) error {
+	if len(confRequests) == 0 {
 		return nil
 	}
 
-	Log.Tracef("Removing confirm hints for %v", txids)
+	Log.Tracef("Removing confirm hints for %v", confRequests)
 
 	return c.db.Batch(func(tx *bolt.Tx) error {
 		confirmHints := tx.Bucket(confirmHintBucket)
@@ -295,14 +292,8 @@ func (c *HeightHintCache) PurgeConfirmHint(txids ...chainhash.Hash) error {
 			return ErrCorruptedHeightHintCache
 		}
 
-		for _, txid := range txids {
-			var txHash bytes.Buffer
-			err := channeldb.WriteElement(&txHash, txid)
-			if err != nil {
-				return err
-			}
-
-			err = confirmHints.Delete(txHash.Bytes())
+		for _, confRequest := range confRequests {
+			err := confirmHints.Delete(confRequest.ConfHintKey())
 			if err != nil {
 				return err
 			}
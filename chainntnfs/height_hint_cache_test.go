@@ -30,7 +30,7 @@ func initHintCache(t *testing.T) *HeightHintCache {
 }
 
 // TestHeightHintCacheConfirms ensures that the height hint cache properly
-// caches confirm hints for transactions.
+// caches confirm hints for transactions and output scripts.
 func TestHeightHintCacheConfirms(t *testing.T) {
 	t.Parallel()
 
@@ -38,31 +38,36 @@ func TestHeightHintCacheConfirms(t *testing.T) {
 
 	// Querying for a transaction hash not found within the cache should
 	// return an error indication so.
-	var unknownHash chainhash.Hash
-	_, err := hintCache.QueryConfirmHint(unknownHash)
+	unknownRequest := ConfRequest{TxID: chainhash.Hash{0xFF}}
+	_, err := hintCache.QueryConfirmHint(unknownRequest)
 	if err != ErrConfirmHintNotFound {
 		t.Fatalf("expected ErrConfirmHintNotFound, got: %v", err)
 	}
 
-	// Now, we'll create some transaction hashes and commit them to the
-	// cache with the same confirm hint.
+	// Now, we'll create some requests for transaction hashes, along with
+	// one for an output script, and commit them to the cache with the same
+	// confirm hint.
 	const height = 100
 	const numHashes = 5
-	txHashes := make([]chainhash.Hash, numHashes)
+	confRequests := make([]ConfRequest, numHashes)
 	for i := 0; i < numHashes; i++ {
 		var txHash chainhash.Hash
-		copy(txHash[:], bytes.Repeat([]byte{byte(i)}, 32))
-		txHashes[i] = txHash
+		copy(txHash[:], bytes.Repeat([]byte{byte(i + 1)}, 32))
+		confRequests[i] = ConfRequest{TxID: txHash}
 	}
+	confRequests = append(confRequests, ConfRequest{
+		PkScript: NewPkScript(bytes.Repeat([]byte{0x01}, 34)),
+	})
 
-	if err := hintCache.CommitConfirmHint(height, txHashes...); err != nil {
+	err = hintCache.CommitConfirmHint(height, confRequests...)
+	if err != nil {
 		t.Fatalf("unable to add entries to cache: %v", err)
 	}
 
-	// With the hashes committed, we'll now query the cache to ensure that
-	// we're able to properly retrieve the confirm hints.
-	for _, txHash := range txHashes {
-		confirmHint, err := hintCache.QueryConfirmHint(txHash)
+	// With the requests committed, we'll now query the cache to ensure
+	// that we're able to properly retrieve the confirm hints.
+	for _, confRequest := range confRequests {
+		confirmHint, err := hintCache.QueryConfirmHint(confRequest)
 		if err != nil {
 			t.Fatalf("unable to query for hint: %v", err)
 		}
@@ -74,14 +79,14 @@ func TestHeightHintCacheConfirms(t *testing.T) {
 
 	// We'll also attempt to purge all of them in a single database
 	// transaction.
-	if err := hintCache.PurgeConfirmHint(txHashes...); err != nil {
+	if err := hintCache.PurgeConfirmHint(confRequests...); err != nil {
 		t.Fatalf("unable to remove confirm hints: %v", err)
 	}
 
-	// Finally, we'll attempt to query for each hash. We should expect not
-	// to find a hint for any of them.
-	for _, txHash := range txHashes {
-		_, err := hintCache.QueryConfirmHint(txHash)
+	// Finally, we'll attempt to query for each request. We should expect
+	// not to find a hint for any of them.
+	for _, confRequest := range confRequests {
+		_, err := hintCache.QueryConfirmHint(confRequest)
 		if err != ErrConfirmHintNotFound {
 			t.Fatalf("expected ErrConfirmHintNotFound, got :%v", err)
 		}
@@ -89,7 +94,7 @@ func TestHeightHintCacheConfirms(t *testing.T) {
 }
 
 // TestHeightHintCacheSpends ensures that the height hint cache properly caches
-// spend hints for outpoints.
+// spend hints for outpoints and output scripts.
 func TestHeightHintCacheSpends(t *testing.T) {
 	t.Parallel()
 
@@ -97,31 +102,38 @@ func TestHeightHintCacheSpends(t *testing.T) {
 
 	// Querying for an outpoint not found within the cache should return an
 	// error indication so.
-	var unknownOutPoint wire.OutPoint
-	_, err := hintCache.QuerySpendHint(unknownOutPoint)
+	unknownRequest := SpendRequest{OutPoint: wire.OutPoint{Index: 1}}
+	_, err := hintCache.QuerySpendHint(unknownRequest)
 	if err != ErrSpendHintNotFound {
 		t.Fatalf("expected ErrSpendHintNotFound, got: %v", err)
 	}
 
-	// Now, we'll create some outpoints and commit them to the cache with
-	// the same spend hint.
+	// Now, we'll create some requests for outpoints, along with one for an
+	// output script, and commit them to the cache with the same spend
+	// hint.
 	const height = 100
 	const numOutpoints = 5
 	var txHash chainhash.Hash
 	copy(txHash[:], bytes.Repeat([]byte{0xFF}, 32))
-	outpoints := make([]wire.OutPoint, numOutpoints)
+	spendRequests := make([]SpendRequest, numOutpoints)
 	for i := uint32(0); i < numOutpoints; i++ {
-		outpoints[i] = wire.OutPoint{Hash: txHash, Index: i}
+		spendRequests[i] = SpendRequest{
+			OutPoint: wire.OutPoint{Hash: txHash, Index: i},
+		}
 	}
+	spendRequests = append(spendRequests, SpendRequest{
+		PkScript: NewPkScript(bytes.Repeat([]byte{0x01}, 34)),
+	})
 
-	if err := hintCache.CommitSpendHint(height, outpoints...); err != nil {
+	err = hintCache.CommitSpendHint(height, spendRequests...)
+	if err != nil {
 		t.Fatalf("unable to add entry to cache: %v", err)
 	}
 
-	// With the outpoints committed, we'll now query the cache to ensure
-	// that we're able to properly retrieve the confirm hints.
-	for _, op := range outpoints {
-		spendHint, err := hintCache.QuerySpendHint(op)
+	// With the requests committed, we'll now query the cache to ensure
+	// that we're able to properly retrieve the spend hints.
+	for _, spendRequest := range spendRequests {
+		spendHint, err := hintCache.QuerySpendHint(spendRequest)
 		if err != nil {
 			t.Fatalf("unable to query for hint: %v", err)
 		}
@@ -133,14 +145,14 @@ func TestHeightHintCacheSpends(t *testing.T) {
 
 	// We'll also attempt to purge all of them in a single database
 	// transaction.
-	if err := hintCache.PurgeSpendHint(outpoints...); err != nil {
+	if err := hintCache.PurgeSpendHint(spendRequests...); err != nil {
 		t.Fatalf("unable to remove spend hint: %v", err)
 	}
 
-	// Finally, we'll attempt to query for each outpoint. We should expect
+	// Finally, we'll attempt to query for each request. We should expect
 	// not to find a hint for any of them.
-	for _, op := range outpoints {
-		_, err = hintCache.QuerySpendHint(op)
+	for _, spendRequest := range spendRequests {
+		_, err = hintCache.QuerySpendHint(spendRequest)
 		if err != ErrSpendHintNotFound {
 			t.Fatalf("expected ErrSpendHintNotFound, got: %v", err)
 		}
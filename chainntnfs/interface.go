@@ -81,6 +81,12 @@ type ChainNotifier interface {
 	// when checking to see if a notification can immediately be dispatched
 	// due to historical data.
 	//
+	// If the txid is nil, the notification is instead dispatched once the
+	// first transaction creating an output paying to pkScript reaches
+	// numConfs confirmations. This allows watching for the confirmation of
+	// an output that we only know the script of, such as one funded by a
+	// third party.
+	//
 	// NOTE: Dispatching notifications to multiple clients subscribed to
 	// the same (txid, numConfs) tuple MUST be supported.
 	RegisterConfirmationsNtfn(txid *chainhash.Hash, pkScript []byte, numConfs,
@@ -96,6 +102,11 @@ type ChainNotifier interface {
 	// The heightHint denotes the earliest height in the blockchain in
 	// which the target output could have been created.
 	//
+	// If the outpoint is nil, the notification is instead dispatched once
+	// any output paying to pkScript is spent. In that case, the script must
+	// be a P2PKH, P2SH, P2WKH or P2WSH script, as the spent script is
+	// reconstructed from the spending input.
+	//
 	// NOTE: The notification should only be triggered when the spending
 	// transaction receives a single confirmation.
 	//
@@ -140,6 +151,16 @@ type TxConfirmation struct {
 	// TxIndex is the index within the block of the ultimate confirmed
 	// transaction.
 	TxIndex uint32
+
+	// Tx is the transaction that was confirmed. For notifications
+	// registered for an output script, this is the first transaction that
+	// created an output paying to it.
+	Tx *wire.MsgTx
+
+	// OutputIndex is the index of the first output of Tx paying to the
+	// output script of the notification request, if the request specified
+	// one and Tx contains such an output.
+	OutputIndex uint32
 }
 
 // ConfirmationEvent encapsulates a confirmation notification. With this struct,
@@ -182,15 +203,21 @@ type ConfirmationEvent struct {
 	//
 	// NOTE: This channel must be buffered.
 	NegativeConf chan int32
+
+	// Cancel is a closure that should be executed by the caller in the
+	// case that they wish to prematurely abandon their registered
+	// confirmation notification.
+	Cancel func()
 }
 
 // NewConfirmationEvent constructs a new ConfirmationEvent with newly opened
 // channels.
-func NewConfirmationEvent(numConfs uint32) *ConfirmationEvent {
+func NewConfirmationEvent(numConfs uint32, cancel func()) *ConfirmationEvent {
 	return &ConfirmationEvent{
 		Confirmed:    make(chan *TxConfirmation, 1),
 		Updates:      make(chan uint32, numConfs),
 		NegativeConf: make(chan int32, 1),
+		Cancel:       cancel,
 	}
 }
 
@@ -222,7 +249,10 @@ type SpendEvent struct {
 
 	// Reorg is a channel that will be sent upon once we detect the spending
 	// transaction of the outpoint in question has been reorged out of the
-	// chain.
+	// chain. The spend is then watched for again, so the Spend channel
+	// will be sent upon once more when the outpoint is spent within the
+	// new chain, possibly by a different transaction. A reorg that is not
+	// yet consumed by the time the outpoint is spent again is discarded.
 	//
 	// NOTE: This channel must be buffered.
 	Reorg chan struct{}
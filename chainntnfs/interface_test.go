@@ -1777,14 +1777,14 @@ func TestInterfaces(t *testing.T) {
 			)
 			newNotifier = func() (chainntnfs.TestChainNotifier, error) {
 				return bitcoindnotify.New(
-					bitcoindConn, hintCache, hintCache,
+					bitcoindConn, hintCache, hintCache, false,
 				), nil
 			}
 
 		case "btcd":
 			newNotifier = func() (chainntnfs.TestChainNotifier, error) {
 				return btcdnotify.New(
-					&rpcConfig, hintCache, hintCache,
+					&rpcConfig, hintCache, hintCache, false,
 				)
 			}
 
@@ -0,0 +1,294 @@
+package chainntnfs
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// ErrMempoolSpendsDisabled is an error returned when attempting to register for
+// mempool spend notifications with a ChainNotifier that has them disabled.
+var ErrMempoolSpendsDisabled = errors.New("mempool spend notifications are " +
+	"disabled")
+
+// ErrMempoolNotifierExiting is an error returned when attempting to register
+// for mempool spend notifications after the dispatcher has been torn down.
+var ErrMempoolNotifierExiting = errors.New("mempool spend dispatcher is " +
+	"exiting")
+
+// MempoolSpendNotifier is an optional interface implemented by ChainNotifiers
+// whose backend relays unconfirmed transactions. It allows callers to learn of
+// a spend of an outpoint as soon as the spending transaction enters the
+// backend's mempool, well before it confirms.
+type MempoolSpendNotifier interface {
+	// RegisterMempoolSpendNtfn registers an intent to be notified once the
+	// target outpoint is spent by a transaction accepted into the
+	// backend's mempool.
+	//
+	// If mempool spend notifications are disabled, then
+	// ErrMempoolSpendsDisabled is returned.
+	//
+	// NOTE: As the spending transaction is unconfirmed, it may never
+	// confirm, or be replaced by another spend. Callers that need the
+	// final spend of the outpoint must still use RegisterSpendNtfn.
+	RegisterMempoolSpendNtfn(outpoint *wire.OutPoint) (*MempoolSpendEvent,
+		error)
+}
+
+// MempoolSpendEvent encapsulates a mempool spentness notification. Its Spend
+// channel will be sent upon for each distinct unconfirmed transaction spending
+// the target outpoint.
+//
+// NOTE: If the caller wishes to cancel their registered mempool spend
+// notification, the Cancel closure MUST be called.
+type MempoolSpendEvent struct {
+	// Spend is a receive only channel which will be sent upon once an
+	// unconfirmed transaction spending the target outpoint is seen. The
+	// SpendingHeight of the details is always zero.
+	//
+	// NOTE: This channel must be buffered.
+	Spend chan *SpendDetail
+
+	// Cancel is a closure that should be executed by the caller in the
+	// case that they wish to abandon their registered notification.
+	Cancel func()
+}
+
+// mempoolSpendClient is a single registration for mempool spends of an
+// outpoint.
+type mempoolSpendClient struct {
+	event *MempoolSpendEvent
+
+	// spendQueue buffers the notifications the client hasn't consumed yet,
+	// so that a slow client never misses a spend nor blocks the
+	// dispatcher.
+	spendQueue *queue.ConcurrentQueue
+
+	// lastSpender is the txid of the last spending transaction we notified
+	// the client of. It's used to avoid notifying the client twice of the
+	// same transaction, as backends may relay it more than once.
+	lastSpender *chainhash.Hash
+
+	cancelChan chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// newMempoolSpendClient creates a new client for the given event, and launches
+// the goroutine proxying its queued notifications to the event's Spend
+// channel.
+func newMempoolSpendClient(event *MempoolSpendEvent) *mempoolSpendClient {
+	client := &mempoolSpendClient{
+		event:      event,
+		spendQueue: queue.NewConcurrentQueue(1),
+		cancelChan: make(chan struct{}),
+	}
+	client.spendQueue.Start()
+
+	// This ensures that all notifications are received *in order*.
+	client.wg.Add(1)
+	go func() {
+		defer client.wg.Done()
+
+		for {
+			select {
+			case ntfn := <-client.spendQueue.ChanOut():
+				spend := ntfn.(*SpendDetail)
+				select {
+				case client.event.Spend <- spend:
+				case <-client.cancelChan:
+					return
+				}
+
+			case <-client.cancelChan:
+				return
+			}
+		}
+	}()
+
+	return client
+}
+
+// stop stops the goroutine proxying the client's notifications, discarding
+// any notification that hasn't been consumed yet.
+func (c *mempoolSpendClient) stop() {
+	close(c.cancelChan)
+	c.wg.Wait()
+	c.spendQueue.Stop()
+}
+
+// MempoolSpendDispatcher keeps track of the registrations for mempool spends,
+// and dispatches them as the ChainNotifier backend relays unconfirmed
+// transactions. It is meant to be shared by the ChainNotifier implementations
+// able to implement the MempoolSpendNotifier interface.
+type MempoolSpendDispatcher struct {
+	sync.Mutex
+
+	clientCounter uint64
+	clients       map[wire.OutPoint]map[uint64]*mempoolSpendClient
+
+	quit chan struct{}
+}
+
+// NewMempoolSpendDispatcher creates a new, empty MempoolSpendDispatcher.
+func NewMempoolSpendDispatcher() *MempoolSpendDispatcher {
+	return &MempoolSpendDispatcher{
+		clients: make(map[wire.OutPoint]map[uint64]*mempoolSpendClient),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Register adds a new registration for mempool spends of the passed outpoint.
+// It's up to the caller to ensure its backend relays transactions spending
+// the outpoint.
+func (d *MempoolSpendDispatcher) Register(
+	outpoint wire.OutPoint) (*MempoolSpendEvent, error) {
+
+	d.Lock()
+	defer d.Unlock()
+
+	select {
+	case <-d.quit:
+		return nil, ErrMempoolNotifierExiting
+	default:
+	}
+
+	d.clientCounter++
+	clientID := d.clientCounter
+
+	event := &MempoolSpendEvent{
+		Spend: make(chan *SpendDetail, 1),
+		Cancel: func() {
+			d.cancel(outpoint, clientID)
+		},
+	}
+
+	if _, ok := d.clients[outpoint]; !ok {
+		d.clients[outpoint] = make(map[uint64]*mempoolSpendClient)
+	}
+	d.clients[outpoint][clientID] = newMempoolSpendClient(event)
+
+	Log.Debugf("New mempool spend subscription: outpoint=%v, id=%v",
+		outpoint, clientID)
+
+	return event, nil
+}
+
+// cancel removes the registration with the given ID for mempool spends of the
+// passed outpoint.
+func (d *MempoolSpendDispatcher) cancel(outpoint wire.OutPoint,
+	clientID uint64) {
+
+	d.Lock()
+	defer d.Unlock()
+
+	select {
+	case <-d.quit:
+		return
+	default:
+	}
+
+	clients, ok := d.clients[outpoint]
+	if !ok {
+		return
+	}
+	client, ok := clients[clientID]
+	if !ok {
+		return
+	}
+
+	Log.Debugf("Canceling mempool spend subscription: outpoint=%v, id=%v",
+		outpoint, clientID)
+
+	client.stop()
+
+	delete(clients, clientID)
+	if len(clients) == 0 {
+		delete(d.clients, outpoint)
+	}
+}
+
+// NumClients returns the number of active registrations for mempool spends.
+func (d *MempoolSpendDispatcher) NumClients() int {
+	d.Lock()
+	defer d.Unlock()
+
+	var numClients int
+	for _, clients := range d.clients {
+		numClients += len(clients)
+	}
+
+	return numClients
+}
+
+// ProcessTx examines an unconfirmed transaction, and notifies the clients
+// registered for mempool spends of any of the outpoints it spends. The
+// notifications are queued for clients that haven't consumed their previous
+// ones yet.
+func (d *MempoolSpendDispatcher) ProcessTx(tx *wire.MsgTx) {
+	d.Lock()
+	defer d.Unlock()
+
+	select {
+	case <-d.quit:
+		return
+	default:
+	}
+
+	if len(d.clients) == 0 {
+		return
+	}
+
+	txHash := tx.TxHash()
+	for i, txIn := range tx.TxIn {
+		clients, ok := d.clients[txIn.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+
+		prevOut := txIn.PreviousOutPoint
+		details := &SpendDetail{
+			SpentOutPoint:     &prevOut,
+			SpenderTxHash:     &txHash,
+			SpendingTx:        tx,
+			SpenderInputIndex: uint32(i),
+		}
+
+		Log.Infof("Dispatching mempool spend notification for "+
+			"outpoint=%v, spender=%v", prevOut, txHash)
+
+		for _, client := range clients {
+			if client.lastSpender != nil &&
+				*client.lastSpender == txHash {
+
+				continue
+			}
+
+			select {
+			case client.spendQueue.ChanIn() <- details:
+				client.lastSpender = &txHash
+			case <-d.quit:
+				return
+			}
+		}
+	}
+}
+
+// TearDown closes the notification channels of all registered clients, and
+// prevents any further registrations.
+func (d *MempoolSpendDispatcher) TearDown() {
+	d.Lock()
+	defer d.Unlock()
+
+	close(d.quit)
+
+	for _, clients := range d.clients {
+		for _, client := range clients {
+			client.stop()
+			close(client.event.Spend)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package chainntnfs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// TestMempoolSpendDispatcher tests that the MempoolSpendDispatcher notifies
+// the clients registered for mempool spends of an outpoint of each distinct
+// transaction spending it, until they cancel their registration, and that the
+// number of active registrations is tracked.
+func TestMempoolSpendDispatcher(t *testing.T) {
+	t.Parallel()
+
+	d := chainntnfs.NewMempoolSpendDispatcher()
+
+	op := wire.OutPoint{Index: 1}
+	ntfn1, err := d.Register(op)
+	if err != nil {
+		t.Fatalf("unable to register mempool spend: %v", err)
+	}
+	ntfn2, err := d.Register(op)
+	if err != nil {
+		t.Fatalf("unable to register mempool spend: %v", err)
+	}
+
+	assertNoSpend := func(ntfn *chainntnfs.MempoolSpendEvent) {
+		t.Helper()
+
+		select {
+		case spend := <-ntfn.Spend:
+			t.Fatalf("unexpected mempool spend: %v",
+				spend.SpenderTxHash)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	assertSpend := func(ntfn *chainntnfs.MempoolSpendEvent,
+		tx *wire.MsgTx) {
+
+		t.Helper()
+
+		select {
+		case spend := <-ntfn.Spend:
+			if *spend.SpenderTxHash != tx.TxHash() {
+				t.Fatalf("expected spender %v, got %v",
+					tx.TxHash(), spend.SpenderTxHash)
+			}
+			if *spend.SpentOutPoint != op {
+				t.Fatalf("expected spent outpoint %v, got %v",
+					op, spend.SpentOutPoint)
+			}
+			if spend.SpenderInputIndex != 1 {
+				t.Fatalf("expected spender input index 1, "+
+					"got %v", spend.SpenderInputIndex)
+			}
+			if spend.SpendingHeight != 0 {
+				t.Fatalf("expected spending height 0, got %v",
+					spend.SpendingHeight)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected mempool spend")
+		}
+	}
+
+	// A transaction that doesn't spend the outpoint shouldn't trigger a
+	// notification.
+	unrelatedTx := wire.NewMsgTx(2)
+	unrelatedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 2},
+	})
+	d.ProcessTx(unrelatedTx)
+	assertNoSpend(ntfn1)
+	assertNoSpend(ntfn2)
+
+	// A transaction spending the outpoint should notify both clients.
+	spendTx := wire.NewMsgTx(2)
+	spendTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 2},
+	})
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: op})
+	d.ProcessTx(spendTx)
+	assertSpend(ntfn1, spendTx)
+	assertSpend(ntfn2, spendTx)
+
+	// Backends may relay the same transaction more than once, which
+	// shouldn't result in duplicate notifications.
+	d.ProcessTx(spendTx)
+	assertNoSpend(ntfn1)
+	assertNoSpend(ntfn2)
+
+	if d.NumClients() != 2 {
+		t.Fatalf("expected 2 clients, got %v", d.NumClients())
+	}
+
+	// Once the first client cancels its registration, only the second one
+	// should be notified of a replacement of the spending transaction.
+	ntfn1.Cancel()
+	if d.NumClients() != 1 {
+		t.Fatalf("expected 1 client, got %v", d.NumClients())
+	}
+
+	replacementTx := spendTx.Copy()
+	replacementTx.LockTime = 1
+	d.ProcessTx(replacementTx)
+	assertNoSpend(ntfn1)
+	assertSpend(ntfn2, replacementTx)
+
+	// A client that doesn't consume its notifications right away shouldn't
+	// miss any of them.
+	replacementTx2 := spendTx.Copy()
+	replacementTx2.LockTime = 2
+	d.ProcessTx(spendTx)
+	d.ProcessTx(replacementTx2)
+	assertSpend(ntfn2, spendTx)
+	assertSpend(ntfn2, replacementTx2)
+
+	// Tearing down the dispatcher should close the channels of the
+	// remaining clients, and prevent any further registrations.
+	d.TearDown()
+
+	if _, ok := <-ntfn2.Spend; ok {
+		t.Fatal("expected spend channel to be closed")
+	}
+	if _, err := d.Register(op); err != chainntnfs.ErrMempoolNotifierExiting {
+		t.Fatalf("expected ErrMempoolNotifierExiting, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package neutrinonotify
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"strings"
@@ -299,7 +300,7 @@ out:
 					defer n.wg.Done()
 
 					confDetails, err := n.historicalConfDetails(
-						msg.TxID, msg.PkScript,
+						msg.ConfRequest, msg.PkScript,
 						msg.StartHeight, msg.EndHeight,
 					)
 					if err != nil {
@@ -314,7 +315,7 @@ out:
 					// cache at tip, since any pending
 					// rescans have now completed.
 					err = n.txNotifier.UpdateConfDetails(
-						*msg.TxID, confDetails,
+						msg.ConfRequest, confDetails,
 					)
 					if err != nil {
 						chainntnfs.Log.Error(err)
@@ -458,11 +459,11 @@ out:
 	}
 }
 
-// historicalConfDetails looks up whether a transaction is already included in
-// a block in the active chain and, if so, returns details about the
-// confirmation.
-func (n *NeutrinoNotifier) historicalConfDetails(targetHash *chainhash.Hash,
-	pkScript []byte,
+// historicalConfDetails looks up whether a transaction, or a transaction paying
+// to an output script, is already included in a block in the active chain
+// and, if so, returns details about the confirmation.
+func (n *NeutrinoNotifier) historicalConfDetails(
+	confRequest chainntnfs.ConfRequest, pkScript []byte,
 	startHeight, endHeight uint32) (*chainntnfs.TxConfirmation, error) {
 
 	// Starting from the height hint, we'll walk forwards in the chain to
@@ -523,12 +524,12 @@ func (n *NeutrinoNotifier) historicalConfDetails(targetHash *chainhash.Hash,
 			return nil, fmt.Errorf("unable to get block from network: %v", err)
 		}
 		for j, tx := range block.Transactions() {
-			txHash := tx.Hash()
-			if txHash.IsEqual(targetHash) {
+			if confRequest.MatchesTx(tx.MsgTx()) {
 				confDetails := chainntnfs.TxConfirmation{
 					BlockHash:   blockHash,
 					BlockHeight: scanHeight,
 					TxIndex:     uint32(j),
+					Tx:          tx.MsgTx(),
 				}
 				return &confDetails, nil
 			}
@@ -538,6 +539,138 @@ func (n *NeutrinoNotifier) historicalConfDetails(targetHash *chainhash.Hash,
 	return nil, nil
 }
 
+// historicalSpendDetails looks up whether an output paying to the output
+// script of the given request has already been spent within the given height
+// range of the active chain, using the compact filters of each block. If one
+// was, its spend details are returned. The outpoints paying to the script that
+// were created within the range and were left unspent up to that point are
+// returned as well, such that their spends can be watched for at tip.
+func (n *NeutrinoNotifier) historicalSpendDetails(
+	spendRequest chainntnfs.SpendRequest, startHeight,
+	endHeight uint32) (*chainntnfs.SpendDetail, []wire.OutPoint, error) {
+
+	pkScript := spendRequest.PkScript.Script()
+
+	var unspent []wire.OutPoint
+	for scanHeight := startHeight; scanHeight <= endHeight; scanHeight++ {
+		// Ensure we haven't been requested to shut down before
+		// processing the next height.
+		select {
+		case <-n.quit:
+			return nil, nil, ErrChainNotifierShuttingDown
+		default:
+		}
+
+		blockHash, err := n.p2pNode.GetBlockHash(int64(scanHeight))
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to get header for "+
+				"height=%v: %v", scanHeight, err)
+		}
+
+		regFilter, err := n.p2pNode.GetCFilter(
+			*blockHash, wire.GCSFilterRegular,
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to retrieve "+
+				"regular filter for height=%v: %v", scanHeight,
+				err)
+		}
+		if regFilter == nil {
+			continue
+		}
+
+		// As the filters include the output scripts spent by each
+		// block, a match tells us that the block either creates or
+		// spends an output paying to our script.
+		key := builder.DeriveKey(blockHash)
+		match, err := regFilter.Match(key, pkScript)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to query filter: "+
+				"%v", err)
+		}
+		if !match {
+			continue
+		}
+
+		block, err := n.p2pNode.GetBlock(*blockHash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to get block from "+
+				"network: %v", err)
+		}
+		for _, tx := range block.Transactions() {
+			msgTx := tx.MsgTx()
+			matches, inputIndex := spendRequest.MatchesTx(msgTx)
+			if matches {
+				op := msgTx.TxIn[inputIndex].PreviousOutPoint
+				return &chainntnfs.SpendDetail{
+					SpentOutPoint:     &op,
+					SpenderTxHash:     tx.Hash(),
+					SpendingTx:        msgTx,
+					SpenderInputIndex: inputIndex,
+					SpendingHeight:    int32(scanHeight),
+				}, unspent, nil
+			}
+
+			for i, txOut := range msgTx.TxOut {
+				if !bytes.Equal(txOut.PkScript, pkScript) {
+					continue
+				}
+
+				unspent = append(unspent, wire.OutPoint{
+					Hash:  *tx.Hash(),
+					Index: uint32(i),
+				})
+			}
+		}
+	}
+
+	return nil, unspent, nil
+}
+
+// updateFilter sends the filter update request to the notifier's main event
+// handler and waits for its response.
+func (n *NeutrinoNotifier) updateFilter(
+	updateOptions ...neutrino.UpdateOption) error {
+
+	errChan := make(chan error, 1)
+	select {
+	case n.notificationRegistry <- &rescanFilterUpdate{
+		updateOptions: updateOptions,
+		errChan:       errChan,
+	}:
+	case <-n.quit:
+		return ErrChainNotifierShuttingDown
+	}
+
+	var err error
+	select {
+	case err = <-errChan:
+	case <-n.quit:
+		return ErrChainNotifierShuttingDown
+	}
+	if err != nil {
+		return fmt.Errorf("unable to update filter: %v", err)
+	}
+
+	return nil
+}
+
+// waitForHeight blocks until the notifier has caught up to the given height,
+// ensuring no notification dispatch is missed by a rescan starting there.
+func (n *NeutrinoNotifier) waitForHeight(height uint32) {
+	for {
+		n.heightMtx.RLock()
+		currentHeight := n.bestHeight
+		n.heightMtx.RUnlock()
+
+		if currentHeight >= height {
+			return
+		}
+
+		time.Sleep(time.Millisecond * 200)
+	}
+}
+
 // handleBlockConnected applies a chain update for a new block. Any watched
 // transactions included this block will processed to either send notifications
 // now or after numConfirmations confs.
@@ -609,23 +742,30 @@ func (n *NeutrinoNotifier) notifyBlockEpochClient(epochClient *blockEpochRegistr
 }
 
 // RegisterSpendNtfn registers an intent to be notified once the target
-// outpoint has been spent by a transaction on-chain. Once a spend of the
-// target outpoint has been detected, the details of the spending event will be
-// sent across the 'Spend' channel.
+// outpoint, or an output paying to the target output script if no outpoint is
+// given, has been spent by a transaction on-chain. Once a spend of the target
+// outpoint has been detected, the details of the spending event will be sent
+// across the 'Spend' channel.
 func (n *NeutrinoNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
 
+	spendRequest, err := chainntnfs.NewSpendRequest(outpoint, pkScript)
+	if err != nil {
+		return nil, err
+	}
+
 	// First, we'll construct a spend notification request and hand it off
 	// to the txNotifier.
 	spendID := atomic.AddUint64(&n.spendClientCounter, 1)
 	cancel := func() {
-		n.txNotifier.CancelSpend(*outpoint, spendID)
+		n.txNotifier.CancelSpend(spendRequest, spendID)
 	}
 	ntfn := &chainntnfs.SpendNtfn{
-		SpendID:    spendID,
-		OutPoint:   *outpoint,
-		Event:      chainntnfs.NewSpendEvent(cancel),
-		HeightHint: heightHint,
+		SpendID:      spendID,
+		SpendRequest: spendRequest,
+		PkScript:     pkScript,
+		Event:        chainntnfs.NewSpendEvent(cancel),
+		HeightHint:   heightHint,
 	}
 
 	historicalDispatch, err := n.txNotifier.RegisterSpend(ntfn)
@@ -640,6 +780,17 @@ func (n *NeutrinoNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 		return ntfn.Event, nil
 	}
 
+	// Spends of an output script can't be looked up through the UTXO
+	// set, so we'll handle those separately.
+	if outpoint == nil {
+		err := n.dispatchScriptSpend(historicalDispatch)
+		if err != nil {
+			return nil, err
+		}
+
+		return ntfn.Event, nil
+	}
+
 	// To determine whether this outpoint has been spent on-chain, we'll
 	// update our filter to watch for the transaction at tip and we'll also
 	// dispatch a historical rescan to determine if it has been spent in the
@@ -653,27 +804,13 @@ func (n *NeutrinoNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 		OutPoint: *outpoint,
 		PkScript: pkScript,
 	}
-	errChan := make(chan error, 1)
-	select {
-	case n.notificationRegistry <- &rescanFilterUpdate{
-		updateOptions: []neutrino.UpdateOption{
-			neutrino.AddInputs(inputToWatch),
-			neutrino.Rewind(historicalDispatch.EndHeight),
-			neutrino.DisableDisconnectedNtfns(true),
-		},
-		errChan: errChan,
-	}:
-	case <-n.quit:
-		return nil, ErrChainNotifierShuttingDown
-	}
-
-	select {
-	case err = <-errChan:
-	case <-n.quit:
-		return nil, ErrChainNotifierShuttingDown
-	}
+	err = n.updateFilter(
+		neutrino.AddInputs(inputToWatch),
+		neutrino.Rewind(historicalDispatch.EndHeight),
+		neutrino.DisableDisconnectedNtfns(true),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to update filter: %v", err)
+		return nil, err
 	}
 
 	// With the filter updated, we'll dispatch our historical rescan to
@@ -681,17 +818,7 @@ func (n *NeutrinoNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	// that neutrino is caught up to the starting height before we attempt
 	// to fetch the UTXO from the chain. If we're behind, then we may miss a
 	// notification dispatch.
-	for {
-		n.heightMtx.RLock()
-		currentHeight := n.bestHeight
-		n.heightMtx.RUnlock()
-
-		if currentHeight >= historicalDispatch.StartHeight {
-			break
-		}
-
-		time.Sleep(time.Millisecond * 200)
-	}
+	n.waitForHeight(historicalDispatch.StartHeight)
 
 	spendReport, err := n.p2pNode.GetUtxo(
 		neutrino.WatchInputs(inputToWatch),
@@ -724,7 +851,7 @@ func (n *NeutrinoNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	// not, we'll mark our historical rescan as complete to ensure the
 	// outpoint's spend hint gets updated upon connected/disconnected
 	// blocks.
-	err = n.txNotifier.UpdateSpendDetails(*outpoint, spendDetails)
+	err = n.txNotifier.UpdateSpendDetails(spendRequest, spendDetails)
 	if err != nil {
 		return nil, err
 	}
@@ -732,26 +859,106 @@ func (n *NeutrinoNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	return ntfn.Event, nil
 }
 
+// dispatchScriptSpend determines whether an output paying to the output script
+// of the given historical dispatch has been spent on-chain, and ensures the
+// spends of those that are still unspent will be detected at tip.
+func (n *NeutrinoNotifier) dispatchScriptSpend(
+	historicalDispatch *chainntnfs.HistoricalSpendDispatch) error {
+
+	spendRequest := historicalDispatch.SpendRequest
+	pkScript := spendRequest.PkScript.Script()
+
+	// We'll start by watching the script's address at tip. Besides the
+	// transactions paying to it, the rescan will then also match those
+	// spending any of the outputs it has matched.
+	params := n.p2pNode.ChainParams()
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, &params)
+	if err != nil {
+		return fmt.Errorf("unable to extract script: %v", err)
+	}
+	err = n.updateFilter(
+		neutrino.AddAddrs(addrs...),
+		neutrino.Rewind(historicalDispatch.EndHeight),
+		neutrino.DisableDisconnectedNtfns(true),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Then, we'll scan the compact filters of the chain for the script to
+	// determine whether one of its outputs was spent in the past.
+	n.waitForHeight(historicalDispatch.StartHeight)
+
+	spendDetails, unspent, err := n.historicalSpendDetails(
+		spendRequest, historicalDispatch.StartHeight,
+		historicalDispatch.EndHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The outputs that were created before the rescan's starting point
+	// aren't known to it, so we'll watch them explicitly in order to
+	// detect their spends at tip. If an output was found spent, we'll
+	// watch it as well, as its spending transaction can still be reorged
+	// out of the chain, in which case we'll need to detect its new spend.
+	if spendDetails != nil {
+		unspent = append(unspent, *spendDetails.SpentOutPoint)
+	}
+	if len(unspent) > 0 {
+		inputs := make([]neutrino.InputWithScript, 0, len(unspent))
+		for _, op := range unspent {
+			inputs = append(inputs, neutrino.InputWithScript{
+				OutPoint: op,
+				PkScript: pkScript,
+			})
+		}
+
+		err := n.updateFilter(
+			neutrino.AddInputs(inputs...),
+			neutrino.Rewind(historicalDispatch.EndHeight),
+			neutrino.DisableDisconnectedNtfns(true),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Finally, no matter whether the rescan found a spend in the past or
+	// not, we'll mark our historical rescan as complete to ensure the
+	// spend hint gets updated upon connected/disconnected blocks.
+	return n.txNotifier.UpdateSpendDetails(spendRequest, spendDetails)
+}
+
 // RegisterConfirmationsNtfn registers a notification with NeutrinoNotifier
-// which will be triggered once the txid reaches numConfs number of
-// confirmations.
+// which will be triggered once the txid, or the first transaction paying to
+// pkScript if no txid is given, reaches numConfs number of confirmations.
 func (n *NeutrinoNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 	pkScript []byte,
 	numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
 
+	confRequest, err := chainntnfs.NewConfRequest(txid, pkScript)
+	if err != nil {
+		return nil, err
+	}
+
 	// Construct a notification request for the transaction and send it to
 	// the main event loop.
+	confID := atomic.AddUint64(&n.confClientCounter, 1)
+	cancel := func() {
+		n.txNotifier.CancelConf(confRequest, confID)
+	}
 	ntfn := &chainntnfs.ConfNtfn{
-		ConfID:           atomic.AddUint64(&n.confClientCounter, 1),
-		TxID:             txid,
+		ConfID:           confID,
+		ConfRequest:      confRequest,
 		PkScript:         pkScript,
 		NumConfirmations: numConfs,
-		Event:            chainntnfs.NewConfirmationEvent(numConfs),
+		Event:            chainntnfs.NewConfirmationEvent(numConfs, cancel),
 		HeightHint:       heightHint,
 	}
 
-	chainntnfs.Log.Infof("New confirmation subscription: "+
-		"txid=%v, numconfs=%v", txid, numConfs)
+	chainntnfs.Log.Infof("New confirmation subscription: %v, numconfs=%v",
+		confRequest, numConfs)
 
 	// Register the conf notification with the TxNotifier. A non-nil value
 	// for `dispatch` will be returned if we are required to perform a
@@ -783,27 +990,13 @@ func (n *NeutrinoNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
 
 	// We'll send the filter update request to the notifier's main event
 	// handler and wait for its response.
-	errChan := make(chan error, 1)
-	select {
-	case n.notificationRegistry <- &rescanFilterUpdate{
-		updateOptions: []neutrino.UpdateOption{
-			neutrino.AddAddrs(addrs...),
-			neutrino.Rewind(dispatch.EndHeight),
-			neutrino.DisableDisconnectedNtfns(true),
-		},
-		errChan: errChan,
-	}:
-	case <-n.quit:
-		return nil, ErrChainNotifierShuttingDown
-	}
-
-	select {
-	case err = <-errChan:
-	case <-n.quit:
-		return nil, ErrChainNotifierShuttingDown
-	}
+	err = n.updateFilter(
+		neutrino.AddAddrs(addrs...),
+		neutrino.Rewind(dispatch.EndHeight),
+		neutrino.DisableDisconnectedNtfns(true),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to update filter: %v", err)
+		return nil, err
 	}
 
 	// Finally, with the filter updates, we can dispatch the historical
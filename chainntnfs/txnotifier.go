@@ -1,13 +1,17 @@
 package chainntnfs
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"sync"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
 )
 
 var (
@@ -18,6 +22,29 @@ var (
 	// ErrTxMaxConfs signals that the user requested a number of
 	// confirmations beyond the reorg safety limit.
 	ErrTxMaxConfs = errors.New("too many confirmations requested")
+
+	// ErrNoScript signals that a notification was requested for an output
+	// script, without providing one.
+	ErrNoScript = errors.New("an output script must be provided when " +
+		"no txid or outpoint is")
+
+	// ErrUnsupportedScript signals that a spend notification was requested
+	// for an output script that we're unable to reconstruct from the
+	// inputs spending it.
+	ErrUnsupportedScript = errors.New("spend notifications by output " +
+		"script are only supported for P2PKH, P2SH, P2WKH and P2WSH " +
+		"scripts")
+)
+
+var (
+	// ZeroHash is the zero value of a transaction hash. Confirmation
+	// requests for it watch for the confirmation of an output script
+	// instead.
+	ZeroHash chainhash.Hash
+
+	// ZeroOutPoint is the zero value of an outpoint. Spend requests for it
+	// watch for the spend of an output script instead.
+	ZeroOutPoint wire.OutPoint
 )
 
 // rescanState indicates the progression of a registration before the notifier
@@ -41,14 +68,248 @@ const (
 	rescanComplete
 )
 
+// PkScript wraps an output script such that it can be compared, which allows
+// the requests watching for it to be used as map keys.
+type PkScript struct {
+	script string
+}
+
+// NewPkScript wraps the passed output script.
+func NewPkScript(pkScript []byte) PkScript {
+	return PkScript{script: string(pkScript)}
+}
+
+// Script returns the raw output script.
+func (s PkScript) Script() []byte {
+	return []byte(s.script)
+}
+
+// String returns the hex encoding of the output script.
+func (s PkScript) String() string {
+	return fmt.Sprintf("%x", s.script)
+}
+
+// ConfRequest identifies what a confirmation notification request watches
+// for: either a transaction by its hash, or the first transaction creating an
+// output paying to an output script.
+type ConfRequest struct {
+	// TxID is the hash of the transaction to watch for. If this is the
+	// ZeroHash, PkScript is watched for instead.
+	TxID chainhash.Hash
+
+	// PkScript is the output script to watch for, if no TxID is set.
+	PkScript PkScript
+}
+
+// NewConfRequest creates a request for the confirmation of the transaction
+// with the given hash, or, if it's nil, of the first transaction creating an
+// output paying to pkScript.
+func NewConfRequest(txid *chainhash.Hash, pkScript []byte) (ConfRequest, error) {
+	if txid != nil {
+		return ConfRequest{TxID: *txid}, nil
+	}
+
+	if len(pkScript) == 0 {
+		return ConfRequest{}, ErrNoScript
+	}
+
+	return ConfRequest{PkScript: NewPkScript(pkScript)}, nil
+}
+
+// String returns a human readable description of the request.
+func (r ConfRequest) String() string {
+	if r.TxID != ZeroHash {
+		return fmt.Sprintf("txid=%v", r.TxID)
+	}
+	return fmt.Sprintf("script=%v", r.PkScript)
+}
+
+// ConfHintKey returns the key the confirm hint of the request is stored under
+// within the height hint cache.
+func (r ConfRequest) ConfHintKey() []byte {
+	if r.TxID != ZeroHash {
+		return r.TxID[:]
+	}
+	return r.PkScript.Script()
+}
+
+// MatchesTx returns whether the transaction satisfies the request.
+func (r ConfRequest) MatchesTx(tx *wire.MsgTx) bool {
+	if r.TxID != ZeroHash {
+		return tx.TxHash() == r.TxID
+	}
+
+	_, ok := outputIndex(tx, r.PkScript.Script())
+	return ok
+}
+
+// outputIndex returns the index of the first output of the transaction paying
+// to the given output script, if there is one.
+func outputIndex(tx *wire.MsgTx, pkScript []byte) (uint32, bool) {
+	if len(pkScript) == 0 {
+		return 0, false
+	}
+
+	for i, txOut := range tx.TxOut {
+		if bytes.Equal(txOut.PkScript, pkScript) {
+			return uint32(i), true
+		}
+	}
+
+	return 0, false
+}
+
+// SpendRequest identifies what a spend notification request watches for:
+// either the spend of an outpoint, or the spend of any output paying to an
+// output script.
+type SpendRequest struct {
+	// OutPoint is the outpoint to watch the spend of. If this is the
+	// ZeroOutPoint, PkScript is watched for instead.
+	OutPoint wire.OutPoint
+
+	// PkScript is the output script to watch the spend of, if no OutPoint
+	// is set.
+	PkScript PkScript
+}
+
+// NewSpendRequest creates a request for the spend of the given outpoint, or,
+// if it's nil, of any output paying to pkScript.
+func NewSpendRequest(op *wire.OutPoint, pkScript []byte) (SpendRequest, error) {
+	if op != nil {
+		return SpendRequest{OutPoint: *op}, nil
+	}
+
+	if len(pkScript) == 0 {
+		return SpendRequest{}, ErrNoScript
+	}
+
+	// As we can only tell that an output paying to the script is spent by
+	// reconstructing its script from the spending input, we'll make sure
+	// that's possible for this type of script.
+	switch txscript.GetScriptClass(pkScript) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy,
+		txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy:
+
+	default:
+		return SpendRequest{}, ErrUnsupportedScript
+	}
+
+	return SpendRequest{PkScript: NewPkScript(pkScript)}, nil
+}
+
+// String returns a human readable description of the request.
+func (r SpendRequest) String() string {
+	if r.OutPoint != ZeroOutPoint {
+		return fmt.Sprintf("outpoint=%v", r.OutPoint)
+	}
+	return fmt.Sprintf("script=%v", r.PkScript)
+}
+
+// SpendHintKey returns the key the spend hint of the request is stored under
+// within the height hint cache.
+func (r SpendRequest) SpendHintKey() ([]byte, error) {
+	if r.OutPoint == ZeroOutPoint {
+		return r.PkScript.Script(), nil
+	}
+
+	var b bytes.Buffer
+	if err := channeldb.WriteElement(&b, r.OutPoint); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// MatchesTx returns whether the transaction satisfies the request, along with
+// the index of the input spending the watched output.
+func (r SpendRequest) MatchesTx(tx *wire.MsgTx) (bool, uint32) {
+	for i, txIn := range tx.TxIn {
+		if r.matchesInput(txIn) {
+			return true, uint32(i)
+		}
+	}
+
+	return false, 0
+}
+
+// matchesInput returns whether the input spends the output watched for by the
+// request.
+func (r SpendRequest) matchesInput(txIn *wire.TxIn) bool {
+	if r.OutPoint != ZeroOutPoint {
+		return txIn.PreviousOutPoint == r.OutPoint
+	}
+
+	pkScript := r.PkScript.Script()
+	for _, spentScript := range spentScripts(txIn) {
+		if bytes.Equal(spentScript, pkScript) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// spentScripts reconstructs the candidates for the output script spent by the
+// input from its signature script or witness. As the type of the spent script
+// can't be told with certainty from the input alone, all of the P2PKH, P2SH,
+// P2WKH and P2WSH scripts the input could be spending are returned.
+func spentScripts(txIn *wire.TxIn) [][]byte {
+	var scripts [][]byte
+	addScript := func(builder *txscript.ScriptBuilder) {
+		script, err := builder.Script()
+		if err == nil {
+			scripts = append(scripts, script)
+		}
+	}
+
+	// A P2WSH input carries the witness script as its last element, while
+	// a P2WKH input carries a signature and the public key committed to.
+	if len(txIn.Witness) > 0 {
+		witnessScript := txIn.Witness[len(txIn.Witness)-1]
+		scriptHash := sha256.Sum256(witnessScript)
+		addScript(txscript.NewScriptBuilder().
+			AddOp(txscript.OP_0).
+			AddData(scriptHash[:]))
+
+		if len(txIn.Witness) == 2 {
+			pubKeyHash := btcutil.Hash160(txIn.Witness[1])
+			addScript(txscript.NewScriptBuilder().
+				AddOp(txscript.OP_0).
+				AddData(pubKeyHash))
+		}
+	}
+
+	// Both P2SH and P2PKH inputs push the preimage of the committed hash,
+	// the redeem script or the public key respectively, last.
+	pushes, err := txscript.PushedData(txIn.SignatureScript)
+	if err != nil || len(pushes) == 0 {
+		return scripts
+	}
+	hash := btcutil.Hash160(pushes[len(pushes)-1])
+
+	addScript(txscript.NewScriptBuilder().
+		AddOp(txscript.OP_HASH160).
+		AddData(hash).
+		AddOp(txscript.OP_EQUAL))
+
+	addScript(txscript.NewScriptBuilder().
+		AddOp(txscript.OP_DUP).
+		AddOp(txscript.OP_HASH160).
+		AddData(hash).
+		AddOp(txscript.OP_EQUALVERIFY).
+		AddOp(txscript.OP_CHECKSIG))
+
+	return scripts
+}
+
 // confNtfnSet holds all known, registered confirmation notifications for a
-// single txid. If duplicates notifications are requested, only one historical
-// dispatch will be spawned to ensure redundant scans are not permitted. A
-// single conf detail will be constructed and dispatched to all interested
-// clients.
+// single txid or output script. If duplicates notifications are requested,
+// only one historical dispatch will be spawned to ensure redundant scans are
+// not permitted. A single conf detail will be constructed and dispatched to
+// all interested clients.
 type confNtfnSet struct {
 	// ntfns keeps tracks of all the active client notification requests for
-	// a transaction.
+	// a transaction or output script.
 	ntfns map[uint64]*ConfNtfn
 
 	// rescanStatus represents the current rescan state for the transaction.
@@ -61,7 +322,7 @@ type confNtfnSet struct {
 }
 
 // newConfNtfnSet constructs a fresh confNtfnSet for a group of clients
-// interested in a notification for a particular txid.
+// interested in a notification for a particular txid or output script.
 func newConfNtfnSet() *confNtfnSet {
 	return &confNtfnSet{
 		ntfns:        make(map[uint64]*ConfNtfn),
@@ -69,12 +330,33 @@ func newConfNtfnSet() *confNtfnSet {
 	}
 }
 
-// spendNtfnSet holds all known, registered spend notifications for an outpoint.
-// If duplicate notifications are requested, only one historical dispatch will
-// be spawned to ensure redundant scans are not permitted.
+// outputIndex returns the index of the first output of the confirmed
+// transaction paying to the output script of the request, or, for a request
+// of a txid, to the output script provided by any of its clients.
+func (s *confNtfnSet) outputIndex(confRequest ConfRequest,
+	tx *wire.MsgTx) uint32 {
+
+	if confRequest.TxID == ZeroHash {
+		index, _ := outputIndex(tx, confRequest.PkScript.Script())
+		return index
+	}
+
+	for _, ntfn := range s.ntfns {
+		if index, ok := outputIndex(tx, ntfn.PkScript); ok {
+			return index
+		}
+	}
+
+	return 0
+}
+
+// spendNtfnSet holds all known, registered spend notifications for an outpoint
+// or output script. If duplicate notifications are requested, only one
+// historical dispatch will be spawned to ensure redundant scans are not
+// permitted.
 type spendNtfnSet struct {
 	// ntfns keeps tracks of all the active client notification requests for
-	// an outpoint.
+	// an outpoint or output script.
 	ntfns map[uint64]*SpendNtfn
 
 	// rescanStatus represents the current rescan state for the outpoint.
@@ -102,9 +384,9 @@ type ConfNtfn struct {
 	// the specified transaction.
 	ConfID uint64
 
-	// TxID is the hash of the transaction for which confirmation notifications
-	// are requested.
-	TxID *chainhash.Hash
+	// ConfRequest identifies the transaction, or output script, for which
+	// confirmation notifications are requested.
+	ConfRequest ConfRequest
 
 	// PkScript is the public key script of an outpoint created in this
 	// transaction.
@@ -133,8 +415,9 @@ type ConfNtfn struct {
 // transaction identifier. The parameters include the start and end block
 // heights specifying the range of blocks to scan.
 type HistoricalConfDispatch struct {
-	// TxID is the transaction ID to search for in the historical dispatch.
-	TxID *chainhash.Hash
+	// ConfRequest identifies the transaction, or output script, to search
+	// for in the historical dispatch.
+	ConfRequest ConfRequest
 
 	// PkScript is a public key script from an output created by this
 	// transaction.
@@ -160,9 +443,9 @@ type SpendNtfn struct {
 	// specified outpoint.
 	SpendID uint64
 
-	// OutPoint is the outpoint for which a client has requested a spend
-	// notification for.
-	OutPoint wire.OutPoint
+	// SpendRequest identifies the outpoint, or output script, for which a
+	// client has requested a spend notification for.
+	SpendRequest SpendRequest
 
 	// PkScript is the script of the outpoint. This is needed in order to
 	// match compact filters when attempting a historical rescan to
@@ -187,8 +470,9 @@ type SpendNtfn struct {
 // spending details (if any) of an outpoint. The parameters include the start
 // and end block heights specifying the range of blocks to scan.
 type HistoricalSpendDispatch struct {
-	// OutPoint is the outpoint which we should attempt to find the spending
-	OutPoint wire.OutPoint
+	// SpendRequest identifies the outpoint, or output script, which we
+	// should attempt to find the spending transaction of.
+	SpendRequest SpendRequest
 
 	// PkScript is the script of the outpoint. This is needed in order to
 	// match compact filters when attempting a historical rescan.
@@ -205,9 +489,11 @@ type HistoricalSpendDispatch struct {
 
 // TxNotifier is a struct responsible for delivering transaction notifications
 // to subscribers. These notifications can be of two different types:
-// transaction confirmations and/or outpoint spends. The TxNotifier will watch
-// the blockchain as new blocks come in, in order to satisfy its client
-// requests.
+// transaction confirmations and/or outpoint spends. Either of them can also be
+// requested for an output script instead, in which case the first transaction
+// creating, or spending, an output paying to it satisfies the request. The
+// TxNotifier will watch the blockchain as new blocks come in, in order to
+// satisfy its client requests.
 type TxNotifier struct {
 	// currentHeight is the height of the tracked blockchain. It is used to
 	// determine the number of confirmations a tx has and ensure blocks are
@@ -227,28 +513,28 @@ type TxNotifier struct {
 	reorgDepth uint32
 
 	// confNotifications is an index of notification requests by transaction
-	// hash.
-	confNotifications map[chainhash.Hash]*confNtfnSet
+	// hash or output script.
+	confNotifications map[ConfRequest]*confNtfnSet
 
 	// txsByInitialHeight is an index of watched transactions by the height
 	// that they are included at in the blockchain. This is tracked so that
 	// incorrect notifications are not sent if a transaction is reorged out
 	// of the chain and so that negative confirmations can be recognized.
-	txsByInitialHeight map[uint32]map[chainhash.Hash]struct{}
+	txsByInitialHeight map[uint32]map[ConfRequest]struct{}
 
 	// ntfnsByConfirmHeight is an index of notification requests by the
 	// height at which the transaction will have sufficient confirmations.
 	ntfnsByConfirmHeight map[uint32]map[*ConfNtfn]struct{}
 
 	// spendNotifications is an index of all active notification requests
-	// per outpoint.
-	spendNotifications map[wire.OutPoint]*spendNtfnSet
+	// per outpoint or output script.
+	spendNotifications map[SpendRequest]*spendNtfnSet
 
 	// opsBySpendHeight is an index that keeps tracks of the spending height
-	// of an outpoint we are currently tracking notifications for. This is
-	// used in order to recover from the spending transaction of an outpoint
-	// being reorged out of the chain.
-	opsBySpendHeight map[uint32]map[wire.OutPoint]struct{}
+	// of an outpoint, or output script, we are currently tracking
+	// notifications for. This is used in order to recover from the spending
+	// transaction of an outpoint being reorged out of the chain.
+	opsBySpendHeight map[uint32]map[SpendRequest]struct{}
 
 	// confirmHintCache is a cache used to maintain the latest height hints
 	// for transactions. Each height hint represents the earliest height at
@@ -278,11 +564,11 @@ func NewTxNotifier(startHeight uint32, reorgSafetyLimit uint32,
 	return &TxNotifier{
 		currentHeight:        startHeight,
 		reorgSafetyLimit:     reorgSafetyLimit,
-		confNotifications:    make(map[chainhash.Hash]*confNtfnSet),
-		txsByInitialHeight:   make(map[uint32]map[chainhash.Hash]struct{}),
+		confNotifications:    make(map[ConfRequest]*confNtfnSet),
+		txsByInitialHeight:   make(map[uint32]map[ConfRequest]struct{}),
 		ntfnsByConfirmHeight: make(map[uint32]map[*ConfNtfn]struct{}),
-		spendNotifications:   make(map[wire.OutPoint]*spendNtfnSet),
-		opsBySpendHeight:     make(map[uint32]map[wire.OutPoint]struct{}),
+		spendNotifications:   make(map[SpendRequest]*spendNtfnSet),
+		opsBySpendHeight:     make(map[uint32]map[SpendRequest]struct{}),
 		confirmHintCache:     confirmHintCache,
 		spendHintCache:       spendHintCache,
 		quit:                 make(chan struct{}),
@@ -290,11 +576,12 @@ func NewTxNotifier(startHeight uint32, reorgSafetyLimit uint32,
 }
 
 // RegisterConf handles a new notification request. The client will be notified
-// when the transaction gets a sufficient number of confirmations on the
-// blockchain. The registration succeeds if no error is returned. If the
-// returned HistoricalConfDispatch is non-nil, the caller is responsible for
-// attempting to manually rescan blocks for the txid between the start and end
-// heights.
+// when the transaction, or the first transaction paying to the output script,
+// gets a sufficient number of confirmations on the blockchain. The
+// registration succeeds if no error is returned. If the returned
+// HistoricalConfDispatch is non-nil, the caller is responsible for attempting
+// to manually rescan blocks for the txid or output script between the start
+// and end heights.
 //
 // NOTE: If the transaction has already been included in a block on the chain,
 // the confirmation details must be provided with the UpdateConfDetails method,
@@ -318,27 +605,27 @@ func (n *TxNotifier) RegisterConf(ntfn *ConfNtfn) (*HistoricalConfDispatch, erro
 	//
 	// TODO(conner): verify that all submitted height hints are identical.
 	startHeight := ntfn.HeightHint
-	hint, err := n.confirmHintCache.QueryConfirmHint(*ntfn.TxID)
+	hint, err := n.confirmHintCache.QueryConfirmHint(ntfn.ConfRequest)
 	if err == nil {
 		if hint > startHeight {
 			Log.Debugf("Using height hint %d retrieved "+
-				"from cache for %v", hint, *ntfn.TxID)
+				"from cache for %v", hint, ntfn.ConfRequest)
 			startHeight = hint
 		}
 	} else if err != ErrConfirmHintNotFound {
 		Log.Errorf("Unable to query confirm hint for %v: %v",
-			*ntfn.TxID, err)
+			ntfn.ConfRequest, err)
 	}
 
 	n.Lock()
 	defer n.Unlock()
 
-	confSet, ok := n.confNotifications[*ntfn.TxID]
+	confSet, ok := n.confNotifications[ntfn.ConfRequest]
 	if !ok {
-		// If this is the first registration for this txid, construct a
-		// confSet to coalesce all notifications for the same txid.
+		// If this is the first registration for this request, construct
+		// a confSet to coalesce all notifications for the same request.
 		confSet = newConfNtfnSet()
-		n.confNotifications[*ntfn.TxID] = confSet
+		n.confNotifications[ntfn.ConfRequest] = confSet
 	}
 
 	confSet.ntfns[ntfn.ConfID] = ntfn
@@ -351,8 +638,9 @@ func (n *TxNotifier) RegisterConf(ntfn *ConfNtfn) (*HistoricalConfDispatch, erro
 		// If conf details for this set of notifications has already
 		// been found, we'll attempt to deliver them immediately to this
 		// client.
-		Log.Debugf("Attempting to dispatch conf for txid=%v "+
-			"on registration since rescan has finished", ntfn.TxID)
+		Log.Debugf("Attempting to dispatch conf for %v on "+
+			"registration since rescan has finished",
+			ntfn.ConfRequest)
 		return nil, n.dispatchConfDetails(ntfn, confSet.details)
 
 	// A rescan is already in progress, return here to prevent dispatching
@@ -360,7 +648,7 @@ func (n *TxNotifier) RegisterConf(ntfn *ConfNtfn) (*HistoricalConfDispatch, erro
 	// updated as well.
 	case rescanPending:
 		Log.Debugf("Waiting for pending rescan to finish before "+
-			"notifying txid=%v at tip", ntfn.TxID)
+			"notifying %v at tip", ntfn.ConfRequest)
 		return nil, nil
 
 	// If no rescan has been dispatched, attempt to do so now.
@@ -372,7 +660,7 @@ func (n *TxNotifier) RegisterConf(ntfn *ConfNtfn) (*HistoricalConfDispatch, erro
 	// current height, we'll refrain from spawning a historical dispatch.
 	if startHeight > n.currentHeight {
 		Log.Debugf("Height hint is above current height, not dispatching "+
-			"historical rescan for txid=%v ", ntfn.TxID)
+			"historical rescan for %v", ntfn.ConfRequest)
 		// Set the rescan status to complete, which will allow the conf
 		// notifier to start delivering messages for this set
 		// immediately.
@@ -380,14 +668,14 @@ func (n *TxNotifier) RegisterConf(ntfn *ConfNtfn) (*HistoricalConfDispatch, erro
 		return nil, nil
 	}
 
-	Log.Debugf("Dispatching historical rescan for txid=%v ", ntfn.TxID)
+	Log.Debugf("Dispatching historical rescan for %v", ntfn.ConfRequest)
 
 	// Construct the parameters for historical dispatch, scanning the range
 	// of blocks between our best known height hint and the notifier's
 	// current height. The notifier will begin also watching for
 	// confirmations at tip starting with the next block.
 	dispatch := &HistoricalConfDispatch{
-		TxID:        ntfn.TxID,
+		ConfRequest: ntfn.ConfRequest,
 		PkScript:    ntfn.PkScript,
 		StartHeight: startHeight,
 		EndHeight:   n.currentHeight,
@@ -400,13 +688,58 @@ func (n *TxNotifier) RegisterConf(ntfn *ConfNtfn) (*HistoricalConfDispatch, erro
 	return dispatch, nil
 }
 
+// CancelConf cancels an existing request for a confirmation notification of a
+// transaction or output script. The request is identified by its conf ID.
+func (n *TxNotifier) CancelConf(confRequest ConfRequest, confID uint64) {
+	select {
+	case <-n.quit:
+		return
+	default:
+	}
+
+	n.Lock()
+	defer n.Unlock()
+
+	Log.Infof("Canceling confirmation notification: conf_id=%d, %v",
+		confID, confRequest)
+
+	confSet, ok := n.confNotifications[confRequest]
+	if !ok {
+		return
+	}
+	ntfn, ok := confSet.ntfns[confID]
+	if !ok {
+		return
+	}
+
+	// If the confirmation notification has yet to be dispatched, we'll
+	// need to clear its entry within the ntfnsByConfirmHeight index to
+	// prevent from notifying the client once the notifier reaches the
+	// confirmation height.
+	if confSet.details != nil && !ntfn.dispatched {
+		confHeight := confSet.details.BlockHeight +
+			ntfn.NumConfirmations - 1
+		ntfnSet, exists := n.ntfnsByConfirmHeight[confHeight]
+		if exists {
+			delete(ntfnSet, ntfn)
+		}
+	}
+
+	// We'll close all the notification channels to let the client know
+	// their cancel request has been fulfilled.
+	close(ntfn.Event.Confirmed)
+	close(ntfn.Event.Updates)
+	close(ntfn.Event.NegativeConf)
+	delete(confSet.ntfns, confID)
+}
+
 // UpdateConfDetails attempts to update the confirmation details for an active
 // notification within the notifier. This should only be used in the case of a
 // transaction that has confirmed before the notifier's current height.
 //
 // NOTE: The notification should be registered first to ensure notifications are
 // dispatched correctly.
-func (n *TxNotifier) UpdateConfDetails(txid chainhash.Hash,
+func (n *TxNotifier) UpdateConfDetails(confRequest ConfRequest,
 	details *TxConfirmation) error {
 
 	select {
@@ -421,10 +754,10 @@ func (n *TxNotifier) UpdateConfDetails(txid chainhash.Hash,
 	defer n.Unlock()
 
 	// First, we'll determine whether we have an active notification for
-	// this transaction with the given ID.
-	confSet, ok := n.confNotifications[txid]
+	// this request.
+	confSet, ok := n.confNotifications[confRequest]
 	if !ok {
-		return fmt.Errorf("no notification found with TxID %v", txid)
+		return fmt.Errorf("no notification found for %v", confRequest)
 	}
 
 	// If the conf details were already found at tip, all existing
@@ -445,43 +778,52 @@ func (n *TxNotifier) UpdateConfDetails(txid chainhash.Hash,
 	// included in a block, so we should defer until handling it then within
 	// ConnectTip.
 	if details == nil {
-		Log.Debugf("Conf details for txid=%v not found during "+
-			"historical dispatch, waiting to dispatch at tip", txid)
+		Log.Debugf("Conf details for %v not found during historical "+
+			"dispatch, waiting to dispatch at tip", confRequest)
 
 		// We'll commit the current height as the confirm hint to
 		// prevent another potentially long rescan if we restart before
 		// a new block comes in.
 		err := n.confirmHintCache.CommitConfirmHint(
-			n.currentHeight, txid,
+			n.currentHeight, confRequest,
 		)
 		if err != nil {
 			// The error is not fatal as this is an optimistic
 			// optimization, so we'll avoid returning an error.
 			Log.Debugf("Unable to update confirm hint to %d for "+
-				"%v: %v", n.currentHeight, txid, err)
+				"%v: %v", n.currentHeight, confRequest, err)
 		}
 
 		return nil
 	}
 
 	if details.BlockHeight > n.currentHeight {
-		Log.Debugf("Conf details for txid=%v found above current "+
-			"height, waiting to dispatch at tip", txid)
+		Log.Debugf("Conf details for %v found above current height, "+
+			"waiting to dispatch at tip", confRequest)
 		return nil
 	}
 
-	Log.Debugf("Updating conf details for txid=%v details", txid)
+	Log.Debugf("Updating conf details for %v", confRequest)
 
-	err := n.confirmHintCache.CommitConfirmHint(details.BlockHeight, txid)
+	err := n.confirmHintCache.CommitConfirmHint(
+		details.BlockHeight, confRequest,
+	)
 	if err != nil {
 		// The error is not fatal, so we should not return an error to
 		// the caller.
 		Log.Errorf("Unable to update confirm hint to %d for %v: %v",
-			details.BlockHeight, txid, err)
+			details.BlockHeight, confRequest, err)
 	}
 
 	// Cache the details found in the rescan and attempt to dispatch any
-	// notifications that have not yet been delivered.
+	// notifications that have not yet been delivered. The backend only
+	// knows of the transaction, so we'll locate the output paying to the
+	// script of the notifications ourselves.
+	if details.Tx != nil {
+		details.OutputIndex = confSet.outputIndex(
+			confRequest, details.Tx,
+		)
+	}
 	confSet.details = details
 	for _, ntfn := range confSet.ntfns {
 		err = n.dispatchConfDetails(ntfn, details)
@@ -502,7 +844,7 @@ func (n *TxNotifier) dispatchConfDetails(
 	// If no details are provided, return early as we can't dispatch.
 	if details == nil {
 		Log.Debugf("Unable to dispatch %v, no details provided",
-			ntfn.TxID)
+			ntfn.ConfRequest)
 		return nil
 	}
 
@@ -513,7 +855,7 @@ func (n *TxNotifier) dispatchConfDetails(
 	confHeight := details.BlockHeight + ntfn.NumConfirmations - 1
 	if confHeight <= n.currentHeight {
 		Log.Infof("Dispatching %v conf notification for %v",
-			ntfn.NumConfirmations, ntfn.TxID)
+			ntfn.NumConfirmations, ntfn.ConfRequest)
 
 		// We'll send a 0 value to the Updates channel,
 		// indicating that the transaction has already been
@@ -532,7 +874,7 @@ func (n *TxNotifier) dispatchConfDetails(
 		}
 	} else {
 		Log.Debugf("Queueing %v conf notification for %v at tip ",
-			ntfn.NumConfirmations, ntfn.TxID)
+			ntfn.NumConfirmations, ntfn.ConfRequest)
 
 		// Otherwise, we'll keep track of the notification
 		// request by the height at which we should dispatch the
@@ -562,17 +904,18 @@ func (n *TxNotifier) dispatchConfDetails(
 	if reorgSafeHeight > n.currentHeight {
 		txSet, exists := n.txsByInitialHeight[blockHeight]
 		if !exists {
-			txSet = make(map[chainhash.Hash]struct{})
+			txSet = make(map[ConfRequest]struct{})
 			n.txsByInitialHeight[blockHeight] = txSet
 		}
-		txSet[*ntfn.TxID] = struct{}{}
+		txSet[ntfn.ConfRequest] = struct{}{}
 	}
 
 	return nil
 }
 
 // RegisterSpend handles a new spend notification request. The client will be
-// notified once the outpoint is detected as spent within the chain.
+// notified once the outpoint, or an output paying to the output script, is
+// detected as spent within the chain.
 //
 // The registration succeeds if no error is returned. If the returned
 // HistoricalSpendDisaptch is non-nil, the caller is responsible for attempting
@@ -593,32 +936,32 @@ func (n *TxNotifier) RegisterSpend(ntfn *SpendNtfn) (*HistoricalSpendDispatch, e
 	// Before proceeding to register the notification, we'll query our spend
 	// hint cache to determine whether a better one exists.
 	startHeight := ntfn.HeightHint
-	hint, err := n.spendHintCache.QuerySpendHint(ntfn.OutPoint)
+	hint, err := n.spendHintCache.QuerySpendHint(ntfn.SpendRequest)
 	if err == nil {
 		if hint > startHeight {
 			Log.Debugf("Using height hint %d retrieved from cache "+
-				"for %v", startHeight, ntfn.OutPoint)
+				"for %v", startHeight, ntfn.SpendRequest)
 			startHeight = hint
 		}
 	} else if err != ErrSpendHintNotFound {
 		Log.Errorf("Unable to query spend hint for %v: %v",
-			ntfn.OutPoint, err)
+			ntfn.SpendRequest, err)
 	}
 
 	n.Lock()
 	defer n.Unlock()
 
-	Log.Infof("New spend subscription: spend_id=%d, outpoint=%v, "+
-		"height_hint=%d", ntfn.SpendID, ntfn.OutPoint, ntfn.HeightHint)
+	Log.Infof("New spend subscription: spend_id=%d, %v, height_hint=%d",
+		ntfn.SpendID, ntfn.SpendRequest, ntfn.HeightHint)
 
 	// Keep track of the notification request so that we can properly
 	// dispatch a spend notification later on.
-	spendSet, ok := n.spendNotifications[ntfn.OutPoint]
+	spendSet, ok := n.spendNotifications[ntfn.SpendRequest]
 	if !ok {
-		// If this is the first registration for the outpoint, we'll
+		// If this is the first registration for the request, we'll
 		// construct a spendNtfnSet to coalesce all notifications.
 		spendSet = newSpendNtfnSet()
-		n.spendNotifications[ntfn.OutPoint] = spendSet
+		n.spendNotifications[ntfn.SpendRequest] = spendSet
 	}
 	spendSet.ntfns[ntfn.SpendID] = ntfn
 
@@ -649,7 +992,7 @@ func (n *TxNotifier) RegisterSpend(ntfn *SpendNtfn) (*HistoricalSpendDispatch, e
 	// historical rescan and wait for the spend to come in at tip.
 	if startHeight > n.currentHeight {
 		Log.Debugf("Spend hint of %d for %v is above current height %d",
-			startHeight, ntfn.OutPoint, n.currentHeight)
+			startHeight, ntfn.SpendRequest, n.currentHeight)
 
 		// We'll also set the rescan status as complete to ensure that
 		// spend hints for this outpoint get updated upon
@@ -663,16 +1006,16 @@ func (n *TxNotifier) RegisterSpend(ntfn *SpendNtfn) (*HistoricalSpendDispatch, e
 	spendSet.rescanStatus = rescanPending
 
 	return &HistoricalSpendDispatch{
-		OutPoint:    ntfn.OutPoint,
-		PkScript:    ntfn.PkScript,
-		StartHeight: startHeight,
-		EndHeight:   n.currentHeight,
+		SpendRequest: ntfn.SpendRequest,
+		PkScript:     ntfn.PkScript,
+		StartHeight:  startHeight,
+		EndHeight:    n.currentHeight,
 	}, nil
 }
 
 // CancelSpend cancels an existing request for a spend notification of an
-// outpoint. The request is identified by its spend ID.
-func (n *TxNotifier) CancelSpend(op wire.OutPoint, spendID uint64) {
+// outpoint or output script. The request is identified by its spend ID.
+func (n *TxNotifier) CancelSpend(spendRequest SpendRequest, spendID uint64) {
 	select {
 	case <-n.quit:
 		return
@@ -682,10 +1025,10 @@ func (n *TxNotifier) CancelSpend(op wire.OutPoint, spendID uint64) {
 	n.Lock()
 	defer n.Unlock()
 
-	Log.Infof("Canceling spend notification: spend_id=%d, outpoint=%v",
-		spendID, op)
+	Log.Infof("Canceling spend notification: spend_id=%d, %v", spendID,
+		spendRequest)
 
-	spendSet, ok := n.spendNotifications[op]
+	spendSet, ok := n.spendNotifications[spendRequest]
 	if !ok {
 		return
 	}
@@ -717,32 +1060,55 @@ func (n *TxNotifier) ProcessRelevantSpendTx(tx *wire.MsgTx, txHeight int32) erro
 	n.Lock()
 	defer n.Unlock()
 
-	// Grab the set of active registered outpoints to determine if the
-	// transaction spends any of them.
-	spendNtfns := n.spendNotifications
-
 	// We'll check if this transaction spends an output that has an existing
-	// spend notification for it.
+	// spend notification for it, and if so, create a spend summary and
+	// send off the details to the notification subscribers.
+	return n.filterSpends(tx, func(spendRequest SpendRequest,
+		details *SpendDetail) error {
+
+		details.SpendingHeight = txHeight
+		return n.updateSpendDetails(spendRequest, details)
+	})
+}
+
+// filterSpends invokes the passed callback with the spend details of each
+// registered request that the transaction spends an outpoint or output script
+// of. The spending height of the details is left for the callback to set.
+//
+// NOTE: This method must be called with the TxNotifier's lock held.
+func (n *TxNotifier) filterSpends(tx *wire.MsgTx,
+	cb func(SpendRequest, *SpendDetail) error) error {
+
+	txHash := tx.TxHash()
 	for i, txIn := range tx.TxIn {
-		// If this input doesn't spend an existing registered outpoint,
-		// we'll go on to the next.
 		prevOut := txIn.PreviousOutPoint
-		if _, ok := spendNtfns[prevOut]; !ok {
-			continue
+		details := func() *SpendDetail {
+			return &SpendDetail{
+				SpentOutPoint:     &prevOut,
+				SpenderTxHash:     &txHash,
+				SpendingTx:        tx,
+				SpenderInputIndex: uint32(i),
+			}
 		}
 
-		// Otherwise, we'll create a spend summary and send off the
-		// details to the notification subscribers.
-		txHash := tx.TxHash()
-		details := &SpendDetail{
-			SpentOutPoint:     &prevOut,
-			SpenderTxHash:     &txHash,
-			SpendingTx:        tx,
-			SpenderInputIndex: uint32(i),
-			SpendingHeight:    txHeight,
+		// We'll check whether the input spends an outpoint that has a
+		// registered request, along with the requests of any of the
+		// output scripts it could be spending.
+		spendRequests := []SpendRequest{{OutPoint: prevOut}}
+		for _, spentScript := range spentScripts(txIn) {
+			spendRequests = append(spendRequests, SpendRequest{
+				PkScript: NewPkScript(spentScript),
+			})
 		}
-		if err := n.updateSpendDetails(prevOut, details); err != nil {
-			return err
+
+		for _, spendRequest := range spendRequests {
+			if _, ok := n.spendNotifications[spendRequest]; !ok {
+				continue
+			}
+
+			if err := cb(spendRequest, details()); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -750,13 +1116,14 @@ func (n *TxNotifier) ProcessRelevantSpendTx(tx *wire.MsgTx, txHeight int32) erro
 }
 
 // UpdateSpendDetails attempts to update the spend details for all active spend
-// notification requests for an outpoint. This method should be used once a
-// historical scan of the chain has finished. If the historical scan did not
-// find a spending transaction for the outpoint, the spend details may be nil.
+// notification requests for an outpoint or output script. This method should
+// be used once a historical scan of the chain has finished. If the historical
+// scan did not find a spending transaction for the outpoint, the spend details
+// may be nil.
 //
 // NOTE: A notification request for the outpoint must be registered first to
 // ensure notifications are delivered.
-func (n *TxNotifier) UpdateSpendDetails(op wire.OutPoint,
+func (n *TxNotifier) UpdateSpendDetails(spendRequest SpendRequest,
 	details *SpendDetail) error {
 
 	select {
@@ -770,24 +1137,25 @@ func (n *TxNotifier) UpdateSpendDetails(op wire.OutPoint,
 	n.Lock()
 	defer n.Unlock()
 
-	return n.updateSpendDetails(op, details)
+	return n.updateSpendDetails(spendRequest, details)
 }
 
 // updateSpendDetails attempts to update the spend details for all active spend
-// notification requests for an outpoint. This method should be used once a
-// historical scan of the chain has finished. If the historical scan did not
-// find a spending transaction for the outpoint, the spend details may be nil.
+// notification requests for an outpoint or output script. This method should
+// be used once a historical scan of the chain has finished. If the historical
+// scan did not find a spending transaction for the outpoint, the spend details
+// may be nil.
 //
 // NOTE: This method must be called with the TxNotifier's lock held.
-func (n *TxNotifier) updateSpendDetails(op wire.OutPoint,
+func (n *TxNotifier) updateSpendDetails(spendRequest SpendRequest,
 	details *SpendDetail) error {
 
 	// Mark the ongoing historical rescan for this outpoint as finished.
 	// This will allow us to update the spend hints for this outpoint at
 	// tip.
-	spendSet, ok := n.spendNotifications[op]
+	spendSet, ok := n.spendNotifications[spendRequest]
 	if !ok {
-		return fmt.Errorf("no notifications found for outpoint %v", op)
+		return fmt.Errorf("no notifications found for %v", spendRequest)
 	}
 
 	// If the spend details have already been found either at tip, then the
@@ -809,12 +1177,14 @@ func (n *TxNotifier) updateSpendDetails(op wire.OutPoint,
 		// We'll commit the current height as the spend hint to prevent
 		// another potentially long rescan if we restart before a new
 		// block comes in.
-		err := n.spendHintCache.CommitSpendHint(n.currentHeight, op)
+		err := n.spendHintCache.CommitSpendHint(
+			n.currentHeight, spendRequest,
+		)
 		if err != nil {
 			// The error is not fatal as this is an optimistic
 			// optimization, so we'll avoid returning an error.
 			Log.Debugf("Unable to update spend hint to %d for %v: %v",
-				n.currentHeight, op, err)
+				n.currentHeight, spendRequest, err)
 		}
 
 		return nil
@@ -833,13 +1203,13 @@ func (n *TxNotifier) updateSpendDetails(op wire.OutPoint,
 	// its spending height as its hint in the cache and dispatch
 	// notifications to all of its respective clients.
 	err := n.spendHintCache.CommitSpendHint(
-		uint32(details.SpendingHeight), op,
+		uint32(details.SpendingHeight), spendRequest,
 	)
 	if err != nil {
 		// The error is not fatal as this is an optimistic optimization,
 		// so we'll avoid returning an error.
 		Log.Debugf("Unable to update spend hint to %d for %v: %v",
-			details.SpendingHeight, op, err)
+			details.SpendingHeight, spendRequest, err)
 	}
 
 	spendSet.details = details
@@ -850,6 +1220,20 @@ func (n *TxNotifier) updateSpendDetails(op wire.OutPoint,
 		}
 	}
 
+	// As a final check, we'll also watch the spending transaction if it's
+	// still possible for it to get reorged out of the chain, such that the
+	// clients are notified and the spend is watched for again at tip.
+	spendHeight := uint32(details.SpendingHeight)
+	reorgSafeHeight := spendHeight + n.reorgSafetyLimit
+	if reorgSafeHeight > n.currentHeight {
+		opSet, exists := n.opsBySpendHeight[spendHeight]
+		if !exists {
+			opSet = make(map[SpendRequest]struct{})
+			n.opsBySpendHeight[spendHeight] = opSet
+		}
+		opSet[spendRequest] = struct{}{}
+	}
+
 	return nil
 }
 
@@ -863,8 +1247,8 @@ func (n *TxNotifier) dispatchSpendDetails(ntfn *SpendNtfn, details *SpendDetail)
 		return nil
 	}
 
-	Log.Infof("Dispatching spend notification for outpoint=%v at height=%d",
-		ntfn.OutPoint, n.currentHeight)
+	Log.Infof("Dispatching spend notification for %v at height=%d",
+		ntfn.SpendRequest, n.currentHeight)
 
 	select {
 	case ntfn.Event.Spend <- details:
@@ -880,11 +1264,13 @@ func (n *TxNotifier) dispatchSpendDetails(ntfn *SpendNtfn, details *SpendDetail)
 // through every transaction and determine if it is relevant to any of its
 // clients. A transaction can be relevant in either of the following two ways:
 //
-//   1. One of the inputs in the transaction spends an outpoint for which we
-//   currently have an active spend registration for.
+//   1. One of the inputs in the transaction spends an outpoint, or an output
+//   paying to an output script, for which we currently have an active spend
+//   registration for.
 //
-//   2. The transaction is a transaction for which we currently have an active
-//   confirmation registration for.
+//   2. The transaction is a transaction, or creates an output paying to an
+//   output script, for which we currently have an active confirmation
+//   registration for.
 //
 // In the event that the transaction is relevant, a confirmation/spend
 // notification will be queued for dispatch to the relevant clients.
@@ -917,107 +1303,42 @@ func (n *TxNotifier) ConnectTip(blockHash *chainhash.Hash, blockHeight uint32,
 	// First, we'll iterate over all the transactions found in this block to
 	// determine if it includes any relevant transactions to the TxNotifier.
 	for _, tx := range txns {
-		txHash := tx.Hash()
-
 		// In order to determine if this transaction is relevant to the
 		// notifier, we'll check its inputs for any outstanding spend
 		// notifications.
-		for i, txIn := range tx.MsgTx().TxIn {
-			prevOut := txIn.PreviousOutPoint
-			spendSet, ok := n.spendNotifications[prevOut]
-			if !ok {
-				continue
-			}
+		err := n.filterSpends(tx.MsgTx(), func(spendRequest SpendRequest,
+			details *SpendDetail) error {
 
-			// If we have any, we'll record its spend height so that
-			// notifications get dispatched to the respective
+			// If we have any, we'll record its spend height so
+			// that notifications get dispatched to the respective
 			// clients.
-			spendDetails := &SpendDetail{
-				SpentOutPoint:     &prevOut,
-				SpenderTxHash:     txHash,
-				SpendingTx:        tx.MsgTx(),
-				SpenderInputIndex: uint32(i),
-				SpendingHeight:    int32(blockHeight),
-			}
-
-			// TODO(wilmer): cancel pending historical rescans if any?
-			spendSet.rescanStatus = rescanComplete
-			spendSet.details = spendDetails
-			for _, ntfn := range spendSet.ntfns {
-				// In the event that this notification was aware
-				// that the spending transaction of its outpoint
-				// was reorged out of the chain, we'll consume
-				// the reorg notification if it hasn't been
-				// done yet already.
-				select {
-				case <-ntfn.Event.Reorg:
-				default:
-				}
-			}
+			details.SpendingHeight = int32(blockHeight)
+			n.handleSpendAtTip(spendRequest, details, blockHeight)
 
-			// We'll note the outpoints spending height in order to
-			// correctly handle dispatching notifications when the
-			// spending transactions gets reorged out of the chain.
-			opSet, exists := n.opsBySpendHeight[blockHeight]
-			if !exists {
-				opSet = make(map[wire.OutPoint]struct{})
-				n.opsBySpendHeight[blockHeight] = opSet
-			}
-			opSet[prevOut] = struct{}{}
-		}
-
-		// Check if we have any pending notifications for this txid. If
-		// none are found, we can proceed to the next transaction.
-		confSet, ok := n.confNotifications[*txHash]
-		if !ok {
-			continue
+			return nil
+		})
+		if err != nil {
+			return err
 		}
 
-		Log.Debugf("Block contains txid=%v, constructing details",
-			txHash)
-
-		// If we have any, we'll record its confirmed height so that
-		// notifications get dispatched when the transaction reaches the
-		// clients' desired number of confirmations.
-		details := &TxConfirmation{
-			BlockHash:   blockHash,
-			BlockHeight: blockHeight,
-			TxIndex:     uint32(tx.Index()),
+		// Check if we have any pending notifications for this txid, or
+		// for the scripts of any of its outputs. If none are found, we
+		// can proceed to the next transaction.
+		confRequests := []ConfRequest{{TxID: *tx.Hash()}}
+		for _, txOut := range tx.MsgTx().TxOut {
+			confRequests = append(confRequests, ConfRequest{
+				PkScript: NewPkScript(txOut.PkScript),
+			})
 		}
 
-		// TODO(wilmer): cancel pending historical rescans if any?
-		confSet.rescanStatus = rescanComplete
-		confSet.details = details
-		for _, ntfn := range confSet.ntfns {
-			// In the event that this notification was aware that
-			// the transaction was reorged out of the chain, we'll
-			// consume the reorg notification if it hasn't been done
-			// yet already.
-			select {
-			case <-ntfn.Event.NegativeConf:
-			default:
+		for _, confRequest := range confRequests {
+			if _, ok := n.confNotifications[confRequest]; !ok {
+				continue
 			}
 
-			// We'll note this client's required number of
-			// confirmations so that we can notify them when
-			// expected.
-			confHeight := blockHeight + ntfn.NumConfirmations - 1
-			ntfnSet, exists := n.ntfnsByConfirmHeight[confHeight]
-			if !exists {
-				ntfnSet = make(map[*ConfNtfn]struct{})
-				n.ntfnsByConfirmHeight[confHeight] = ntfnSet
-			}
-			ntfnSet[ntfn] = struct{}{}
-
-			// We'll also note the initial confirmation height in
-			// order to correctly handle dispatching notifications
-			// when the transaction gets reorged out of the chain.
-			txSet, exists := n.txsByInitialHeight[blockHeight]
-			if !exists {
-				txSet = make(map[chainhash.Hash]struct{})
-				n.txsByInitialHeight[blockHeight] = txSet
-			}
-			txSet[*txHash] = struct{}{}
+			n.handleConfAtTip(
+				confRequest, tx, blockHash, blockHeight,
+			)
 		}
 	}
 
@@ -1030,6 +1351,113 @@ func (n *TxNotifier) ConnectTip(blockHash *chainhash.Hash, blockHeight uint32,
 	return nil
 }
 
+// handleSpendAtTip records the spend of a registered outpoint, or output
+// script, within the block being connected, such that notifications are
+// dispatched to its clients once NotifyHeight is called for the block.
+//
+// NOTE: This method must be called with the TxNotifier's lock held.
+func (n *TxNotifier) handleSpendAtTip(spendRequest SpendRequest,
+	details *SpendDetail, blockHeight uint32) {
+
+	spendSet := n.spendNotifications[spendRequest]
+
+	// If the request was already satisfied by an earlier spend, as can
+	// happen with multiple outputs paying to the same script, we should
+	// only dispatch the first one.
+	if spendSet.details != nil {
+		return
+	}
+
+	// TODO(wilmer): cancel pending historical rescans if any?
+	spendSet.rescanStatus = rescanComplete
+	spendSet.details = details
+	for _, ntfn := range spendSet.ntfns {
+		// In the event that this notification was aware that the
+		// spending transaction of its outpoint was reorged out of the
+		// chain, we'll consume the reorg notification if it hasn't been
+		// done yet already.
+		select {
+		case <-ntfn.Event.Reorg:
+		default:
+		}
+	}
+
+	// We'll note the outpoints spending height in order to correctly
+	// handle dispatching notifications when the spending transactions gets
+	// reorged out of the chain.
+	opSet, exists := n.opsBySpendHeight[blockHeight]
+	if !exists {
+		opSet = make(map[SpendRequest]struct{})
+		n.opsBySpendHeight[blockHeight] = opSet
+	}
+	opSet[spendRequest] = struct{}{}
+}
+
+// handleConfAtTip records the confirmation of a registered transaction, or of
+// the first transaction paying to a registered output script, within the block
+// being connected, such that notifications are dispatched to its clients once
+// they reach their required number of confirmations.
+//
+// NOTE: This method must be called with the TxNotifier's lock held.
+func (n *TxNotifier) handleConfAtTip(confRequest ConfRequest, tx *btcutil.Tx,
+	blockHash *chainhash.Hash, blockHeight uint32) {
+
+	confSet := n.confNotifications[confRequest]
+
+	// If the request was already satisfied by an earlier transaction, as
+	// can happen with multiple transactions paying to the same script, we
+	// should only dispatch the first one.
+	if confSet.details != nil {
+		return
+	}
+
+	Log.Debugf("Block contains %v, constructing details", confRequest)
+
+	// If we have any, we'll record its confirmed height so that
+	// notifications get dispatched when the transaction reaches the
+	// clients' desired number of confirmations.
+	details := &TxConfirmation{
+		BlockHash:   blockHash,
+		BlockHeight: blockHeight,
+		TxIndex:     uint32(tx.Index()),
+		Tx:          tx.MsgTx(),
+	}
+	details.OutputIndex = confSet.outputIndex(confRequest, tx.MsgTx())
+
+	// TODO(wilmer): cancel pending historical rescans if any?
+	confSet.rescanStatus = rescanComplete
+	confSet.details = details
+	for _, ntfn := range confSet.ntfns {
+		// In the event that this notification was aware that the
+		// transaction was reorged out of the chain, we'll consume the
+		// reorg notification if it hasn't been done yet already.
+		select {
+		case <-ntfn.Event.NegativeConf:
+		default:
+		}
+
+		// We'll note this client's required number of confirmations so
+		// that we can notify them when expected.
+		confHeight := blockHeight + ntfn.NumConfirmations - 1
+		ntfnSet, exists := n.ntfnsByConfirmHeight[confHeight]
+		if !exists {
+			ntfnSet = make(map[*ConfNtfn]struct{})
+			n.ntfnsByConfirmHeight[confHeight] = ntfnSet
+		}
+		ntfnSet[ntfn] = struct{}{}
+	}
+
+	// We'll also note the initial confirmation height in order to
+	// correctly handle dispatching notifications when the transaction gets
+	// reorged out of the chain.
+	txSet, exists := n.txsByInitialHeight[blockHeight]
+	if !exists {
+		txSet = make(map[ConfRequest]struct{})
+		n.txsByInitialHeight[blockHeight] = txSet
+	}
+	txSet[confRequest] = struct{}{}
+}
+
 // NotifyHeight dispatches confirmation and spend notifications to the clients
 // who registered for a notification which has been fulfilled at the passed
 // height.
@@ -1040,9 +1468,9 @@ func (n *TxNotifier) NotifyHeight(height uint32) error {
 	// First, we'll dispatch an update to all of the notification clients
 	// for our watched transactions with the number of confirmations left at
 	// this new height.
-	for _, txHashes := range n.txsByInitialHeight {
-		for txHash := range txHashes {
-			confSet := n.confNotifications[txHash]
+	for _, confRequests := range n.txsByInitialHeight {
+		for confRequest := range confRequests {
+			confSet := n.confNotifications[confRequest]
 			for _, ntfn := range confSet.ntfns {
 				txConfHeight := confSet.details.BlockHeight +
 					ntfn.NumConfirmations - 1
@@ -1069,10 +1497,10 @@ func (n *TxNotifier) NotifyHeight(height uint32) error {
 	// Then, we'll dispatch notifications for all the transactions that have
 	// become confirmed at this new block height.
 	for ntfn := range n.ntfnsByConfirmHeight[height] {
-		confSet := n.confNotifications[*ntfn.TxID]
+		confSet := n.confNotifications[ntfn.ConfRequest]
 
 		Log.Infof("Dispatching %v conf notification for %v",
-			ntfn.NumConfirmations, ntfn.TxID)
+			ntfn.NumConfirmations, ntfn.ConfRequest)
 
 		select {
 		case ntfn.Event.Confirmed <- confSet.details:
@@ -1085,8 +1513,8 @@ func (n *TxNotifier) NotifyHeight(height uint32) error {
 
 	// We'll also dispatch spend notifications for all the outpoints that
 	// were spent at this new block height.
-	for op := range n.opsBySpendHeight[height] {
-		spendSet := n.spendNotifications[op]
+	for spendRequest := range n.opsBySpendHeight[height] {
+		spendSet := n.spendNotifications[spendRequest]
 		for _, ntfn := range spendSet.ntfns {
 			err := n.dispatchSpendDetails(ntfn, spendSet.details)
 			if err != nil {
@@ -1100,12 +1528,12 @@ func (n *TxNotifier) NotifyHeight(height uint32) error {
 	// reorged out of the chain.
 	if height >= n.reorgSafetyLimit {
 		matureBlockHeight := height - n.reorgSafetyLimit
-		for tx := range n.txsByInitialHeight[matureBlockHeight] {
-			delete(n.confNotifications, tx)
+		for confRequest := range n.txsByInitialHeight[matureBlockHeight] {
+			delete(n.confNotifications, confRequest)
 		}
 		delete(n.txsByInitialHeight, matureBlockHeight)
-		for op := range n.opsBySpendHeight[matureBlockHeight] {
-			delete(n.spendNotifications, op)
+		for spendRequest := range n.opsBySpendHeight[matureBlockHeight] {
+			delete(n.spendNotifications, spendRequest)
 		}
 		delete(n.opsBySpendHeight, matureBlockHeight)
 	}
@@ -1145,13 +1573,13 @@ func (n *TxNotifier) DisconnectTip(blockHeight uint32) error {
 	// We'll go through all of our watched transactions and attempt to drain
 	// their notification channels to ensure sending notifications to the
 	// clients is always non-blocking.
-	for initialHeight, txHashes := range n.txsByInitialHeight {
-		for txHash := range txHashes {
+	for initialHeight, confRequests := range n.txsByInitialHeight {
+		for confRequest := range confRequests {
 			// If the transaction has been reorged out of the chain,
 			// we'll make sure to remove the cached confirmation
 			// details to prevent notifying clients with old
 			// information.
-			confSet := n.confNotifications[txHash]
+			confSet := n.confNotifications[confRequest]
 			if initialHeight == blockHeight {
 				confSet.details = nil
 			}
@@ -1188,11 +1616,11 @@ func (n *TxNotifier) DisconnectTip(blockHeight uint32) error {
 	// clients later on is always non-blocking.  We're only interested in
 	// outpoints whose spending transaction was included at the height being
 	// disconnected.
-	for op := range n.opsBySpendHeight[blockHeight] {
+	for spendRequest := range n.opsBySpendHeight[blockHeight] {
 		// Since the spending transaction is being reorged out of the
 		// chain, we'll need to clear out the spending details of the
 		// outpoint.
-		spendSet := n.spendNotifications[op]
+		spendSet := n.spendNotifications[spendRequest]
 		spendSet.details = nil
 
 		// For all requests which have had a spend notification
@@ -1230,8 +1658,8 @@ func (n *TxNotifier) updateHints(height uint32) {
 	// transactions along with the ones that confirmed at the height being
 	// connected/disconnected.
 	txsToUpdateHints := n.unconfirmedTxs()
-	for confirmedTx := range n.txsByInitialHeight[height] {
-		txsToUpdateHints = append(txsToUpdateHints, confirmedTx)
+	for confRequest := range n.txsByInitialHeight[height] {
+		txsToUpdateHints = append(txsToUpdateHints, confRequest)
 	}
 	err := n.confirmHintCache.CommitConfirmHint(
 		n.currentHeight, txsToUpdateHints...,
@@ -1248,8 +1676,8 @@ func (n *TxNotifier) updateHints(height uint32) {
 	// outpoints along with the ones that were spent at the height being
 	// connected/disconnected.
 	opsToUpdateHints := n.unspentOutPoints()
-	for spentOp := range n.opsBySpendHeight[height] {
-		opsToUpdateHints = append(opsToUpdateHints, spentOp)
+	for spendRequest := range n.opsBySpendHeight[height] {
+		opsToUpdateHints = append(opsToUpdateHints, spendRequest)
 	}
 	err = n.spendHintCache.CommitSpendHint(
 		n.currentHeight, opsToUpdateHints...,
@@ -1262,13 +1690,13 @@ func (n *TxNotifier) updateHints(height uint32) {
 	}
 }
 
-// unconfirmedTxs returns the set of transactions that are still seen as
-// unconfirmed by the TxNotifier.
+// unconfirmedTxs returns the set of transactions, and output scripts, that are
+// still seen as unconfirmed by the TxNotifier.
 //
 // NOTE: This method must be called with the TxNotifier's lock held.
-func (n *TxNotifier) unconfirmedTxs() []chainhash.Hash {
-	var unconfirmedTxs []chainhash.Hash
-	for tx, confNtfnSet := range n.confNotifications {
+func (n *TxNotifier) unconfirmedTxs() []ConfRequest {
+	var unconfirmedTxs []ConfRequest
+	for confRequest, confNtfnSet := range n.confNotifications {
 		// If the notification is already aware of its confirmation
 		// details, or it's in the process of learning them, we'll skip
 		// it as we can't yet determine if it's confirmed or not.
@@ -1277,19 +1705,19 @@ func (n *TxNotifier) unconfirmedTxs() []chainhash.Hash {
 			continue
 		}
 
-		unconfirmedTxs = append(unconfirmedTxs, tx)
+		unconfirmedTxs = append(unconfirmedTxs, confRequest)
 	}
 
 	return unconfirmedTxs
 }
 
-// unspentOutPoints returns the set of outpoints that are still seen as unspent
-// by the TxNotifier.
+// unspentOutPoints returns the set of outpoints, and output scripts, that are
+// still seen as unspent by the TxNotifier.
 //
 // NOTE: This method must be called with the TxNotifier's lock held.
-func (n *TxNotifier) unspentOutPoints() []wire.OutPoint {
-	var unspentOps []wire.OutPoint
-	for op, spendNtfnSet := range n.spendNotifications {
+func (n *TxNotifier) unspentOutPoints() []SpendRequest {
+	var unspentOps []SpendRequest
+	for spendRequest, spendNtfnSet := range n.spendNotifications {
 		// If the notification is already aware of its spend details, or
 		// it's in the process of learning them, we'll skip it as we
 		// can't yet determine if it's unspent or not.
@@ -1298,7 +1726,7 @@ func (n *TxNotifier) unspentOutPoints() []wire.OutPoint {
 			continue
 		}
 
-		unspentOps = append(unspentOps, op)
+		unspentOps = append(unspentOps, spendRequest)
 	}
 
 	return unspentOps
@@ -1363,6 +1791,17 @@ func (n *TxNotifier) dispatchSpendReorg(ntfn *SpendNtfn) error {
 	default:
 	}
 
+	// The client may not have consumed the reorg notification of a prior
+	// reorg yet, which can happen when the spending transaction is reorged
+	// out, re-included, and reorged out once again before the client has
+	// had a chance to act. A single pending reorg notification already
+	// conveys that the spend is no longer valid, so we'll drain it to
+	// ensure the send below is non-blocking.
+	select {
+	case <-ntfn.Event.Reorg:
+	default:
+	}
+
 	// Send a reorg notification to the client in order for them to
 	// correctly handle reorgs.
 	select {
@@ -1,45 +1,61 @@
 package chainntnfs_test
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"sync"
 	"testing"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 )
 
 var (
-	zeroHash     chainhash.Hash
-	zeroOutPoint wire.OutPoint
+	zeroHash chainhash.Hash
+
+	// testTxID and testOutPoint are a transaction and an outpoint that
+	// notifications can be registered for, as the zero values of both
+	// signal requests for output scripts instead.
+	testTxID     = chainhash.Hash{0x01}
+	testOutPoint = wire.OutPoint{Hash: testTxID}
+
+	// testPubKey is a compressed public key that outputs paying to it are
+	// created and spent with.
+	testPubKey = append([]byte{0x02}, bytes.Repeat([]byte{0x01}, 32)...)
 )
 
 type mockHintCache struct {
 	mu         sync.Mutex
-	confHints  map[chainhash.Hash]uint32
-	spendHints map[wire.OutPoint]uint32
+	confHints  map[chainntnfs.ConfRequest]uint32
+	spendHints map[chainntnfs.SpendRequest]uint32
 }
 
 var _ chainntnfs.SpendHintCache = (*mockHintCache)(nil)
 var _ chainntnfs.ConfirmHintCache = (*mockHintCache)(nil)
 
-func (c *mockHintCache) CommitSpendHint(heightHint uint32, ops ...wire.OutPoint) error {
+func (c *mockHintCache) CommitSpendHint(heightHint uint32,
+	spendRequests ...chainntnfs.SpendRequest) error {
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, op := range ops {
-		c.spendHints[op] = heightHint
+	for _, spendRequest := range spendRequests {
+		c.spendHints[spendRequest] = heightHint
 	}
 
 	return nil
 }
 
-func (c *mockHintCache) QuerySpendHint(op wire.OutPoint) (uint32, error) {
+func (c *mockHintCache) QuerySpendHint(
+	spendRequest chainntnfs.SpendRequest) (uint32, error) {
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	hint, ok := c.spendHints[op]
+	hint, ok := c.spendHints[spendRequest]
 	if !ok {
 		return 0, chainntnfs.ErrSpendHintNotFound
 	}
@@ -47,33 +63,39 @@ func (c *mockHintCache) QuerySpendHint(op wire.OutPoint) (uint32, error) {
 	return hint, nil
 }
 
-func (c *mockHintCache) PurgeSpendHint(ops ...wire.OutPoint) error {
+func (c *mockHintCache) PurgeSpendHint(
+	spendRequests ...chainntnfs.SpendRequest) error {
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, op := range ops {
-		delete(c.spendHints, op)
+	for _, spendRequest := range spendRequests {
+		delete(c.spendHints, spendRequest)
 	}
 
 	return nil
 }
 
-func (c *mockHintCache) CommitConfirmHint(heightHint uint32, txids ...chainhash.Hash) error {
+func (c *mockHintCache) CommitConfirmHint(heightHint uint32,
+	confRequests ...chainntnfs.ConfRequest) error {
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, txid := range txids {
-		c.confHints[txid] = heightHint
+	for _, confRequest := range confRequests {
+		c.confHints[confRequest] = heightHint
 	}
 
 	return nil
 }
 
-func (c *mockHintCache) QueryConfirmHint(txid chainhash.Hash) (uint32, error) {
+func (c *mockHintCache) QueryConfirmHint(
+	confRequest chainntnfs.ConfRequest) (uint32, error) {
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	hint, ok := c.confHints[txid]
+	hint, ok := c.confHints[confRequest]
 	if !ok {
 		return 0, chainntnfs.ErrConfirmHintNotFound
 	}
@@ -81,12 +103,14 @@ func (c *mockHintCache) QueryConfirmHint(txid chainhash.Hash) (uint32, error) {
 	return hint, nil
 }
 
-func (c *mockHintCache) PurgeConfirmHint(txids ...chainhash.Hash) error {
+func (c *mockHintCache) PurgeConfirmHint(
+	confRequests ...chainntnfs.ConfRequest) error {
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	for _, txid := range txids {
-		delete(c.confHints, txid)
+	for _, confRequest := range confRequests {
+		delete(c.confHints, confRequest)
 	}
 
 	return nil
@@ -94,8 +118,8 @@ func (c *mockHintCache) PurgeConfirmHint(txids ...chainhash.Hash) error {
 
 func newMockHintCache() *mockHintCache {
 	return &mockHintCache{
-		confHints:  make(map[chainhash.Hash]uint32),
-		spendHints: make(map[wire.OutPoint]uint32),
+		confHints:  make(map[chainntnfs.ConfRequest]uint32),
+		spendHints: make(map[chainntnfs.SpendRequest]uint32),
 	}
 }
 
@@ -123,9 +147,9 @@ func TestTxNotifierFutureConfDispatch(t *testing.T) {
 	// notifications.
 	tx1Hash := tx1.TxHash()
 	ntfn1 := chainntnfs.ConfNtfn{
-		TxID:             &tx1Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx1Hash},
 		NumConfirmations: tx1NumConfs,
-		Event:            chainntnfs.NewConfirmationEvent(tx1NumConfs),
+		Event:            chainntnfs.NewConfirmationEvent(tx1NumConfs, nil),
 	}
 	if _, err := n.RegisterConf(&ntfn1); err != nil {
 		t.Fatalf("unable to register ntfn: %v", err)
@@ -133,9 +157,9 @@ func TestTxNotifierFutureConfDispatch(t *testing.T) {
 
 	tx2Hash := tx2.TxHash()
 	ntfn2 := chainntnfs.ConfNtfn{
-		TxID:             &tx2Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx2Hash},
 		NumConfirmations: tx2NumConfs,
-		Event:            chainntnfs.NewConfirmationEvent(tx2NumConfs),
+		Event:            chainntnfs.NewConfirmationEvent(tx2NumConfs, nil),
 	}
 	if _, err := n.RegisterConf(&ntfn2); err != nil {
 		t.Fatalf("unable to register ntfn: %v", err)
@@ -301,9 +325,9 @@ func TestTxNotifierHistoricalConfDispatch(t *testing.T) {
 	tx1Hash := tx1.TxHash()
 	ntfn1 := chainntnfs.ConfNtfn{
 		ConfID:           0,
-		TxID:             &tx1Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx1Hash},
 		NumConfirmations: tx1NumConfs,
-		Event:            chainntnfs.NewConfirmationEvent(tx1NumConfs),
+		Event:            chainntnfs.NewConfirmationEvent(tx1NumConfs, nil),
 	}
 	if _, err := n.RegisterConf(&ntfn1); err != nil {
 		t.Fatalf("unable to register ntfn: %v", err)
@@ -312,9 +336,9 @@ func TestTxNotifierHistoricalConfDispatch(t *testing.T) {
 	tx2Hash := tx2.TxHash()
 	ntfn2 := chainntnfs.ConfNtfn{
 		ConfID:           1,
-		TxID:             &tx2Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx2Hash},
 		NumConfirmations: tx2NumConfs,
-		Event:            chainntnfs.NewConfirmationEvent(tx2NumConfs),
+		Event:            chainntnfs.NewConfirmationEvent(tx2NumConfs, nil),
 	}
 	if _, err := n.RegisterConf(&ntfn2); err != nil {
 		t.Fatalf("unable to register ntfn: %v", err)
@@ -327,7 +351,7 @@ func TestTxNotifierHistoricalConfDispatch(t *testing.T) {
 		BlockHeight: 9,
 		TxIndex:     1,
 	}
-	err := n.UpdateConfDetails(tx1Hash, &txConf1)
+	err := n.UpdateConfDetails(chainntnfs.ConfRequest{TxID: tx1Hash}, &txConf1)
 	if err != nil {
 		t.Fatalf("unable to update conf details: %v", err)
 	}
@@ -360,7 +384,7 @@ func TestTxNotifierHistoricalConfDispatch(t *testing.T) {
 		BlockHeight: 9,
 		TxIndex:     2,
 	}
-	err = n.UpdateConfDetails(tx2Hash, &txConf2)
+	err = n.UpdateConfDetails(chainntnfs.ConfRequest{TxID: tx2Hash}, &txConf2)
 	if err != nil {
 		t.Fatalf("unable to update conf details: %v", err)
 	}
@@ -441,8 +465,8 @@ func TestTxNotifierFutureSpendDispatch(t *testing.T) {
 	// We'll start off by registering for a spend notification of an
 	// outpoint.
 	ntfn := &chainntnfs.SpendNtfn{
-		OutPoint: zeroOutPoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	if _, err := n.RegisterSpend(ntfn); err != nil {
 		t.Fatalf("unable to register spend ntfn: %v", err)
@@ -460,7 +484,7 @@ func TestTxNotifierFutureSpendDispatch(t *testing.T) {
 	// above. We'll include it in the next block, which should trigger a
 	// spend notification.
 	spendTx := wire.NewMsgTx(2)
-	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: zeroOutPoint})
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: testOutPoint})
 	spendTxHash := spendTx.TxHash()
 	block := btcutil.NewBlock(&wire.MsgBlock{
 		Transactions: []*wire.MsgTx{spendTx},
@@ -474,7 +498,7 @@ func TestTxNotifierFutureSpendDispatch(t *testing.T) {
 	}
 
 	expectedSpendDetails := &chainntnfs.SpendDetail{
-		SpentOutPoint:     &ntfn.OutPoint,
+		SpentOutPoint:     &ntfn.SpendRequest.OutPoint,
 		SpenderTxHash:     &spendTxHash,
 		SpendingTx:        spendTx,
 		SpenderInputIndex: 0,
@@ -524,9 +548,9 @@ func TestTxNotifierHistoricalSpendDispatch(t *testing.T) {
 
 	// We'll start by constructing the spending details of the outpoint
 	// below.
-	spentOutpoint := zeroOutPoint
+	spentOutpoint := testOutPoint
 	spendTx := wire.NewMsgTx(2)
-	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: zeroOutPoint})
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: testOutPoint})
 	spendTxHash := spendTx.TxHash()
 
 	expectedSpendDetails := &chainntnfs.SpendDetail{
@@ -540,8 +564,8 @@ func TestTxNotifierHistoricalSpendDispatch(t *testing.T) {
 	// We'll register for a spend notification of the outpoint and ensure
 	// that a notification isn't dispatched.
 	ntfn := &chainntnfs.SpendNtfn{
-		OutPoint: spentOutpoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: spentOutpoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	if _, err := n.RegisterSpend(ntfn); err != nil {
 		t.Fatalf("unable to register spend ntfn: %v", err)
@@ -557,7 +581,7 @@ func TestTxNotifierHistoricalSpendDispatch(t *testing.T) {
 	// we'll hand off the spending details of the outpoint to the notifier
 	// as it is not possible for it to view historical events in the chain.
 	// By doing this, we replicate the functionality of the ChainNotifier.
-	err := n.UpdateSpendDetails(ntfn.OutPoint, expectedSpendDetails)
+	err := n.UpdateSpendDetails(ntfn.SpendRequest, expectedSpendDetails)
 	if err != nil {
 		t.Fatalf("unable to update spend details: %v", err)
 	}
@@ -607,9 +631,9 @@ func TestTxNotifierMultipleHistoricalConfRescans(t *testing.T) {
 	// request a historical confirmation rescan as it does not have a
 	// historical view of the chain.
 	confNtfn1 := &chainntnfs.ConfNtfn{
-		ConfID: 0,
-		TxID:   &zeroHash,
-		Event:  chainntnfs.NewConfirmationEvent(1),
+		ConfID:      0,
+		ConfRequest: chainntnfs.ConfRequest{TxID: testTxID},
+		Event:       chainntnfs.NewConfirmationEvent(1, nil),
 	}
 	historicalConfDispatch1, err := n.RegisterConf(confNtfn1)
 	if err != nil {
@@ -623,9 +647,9 @@ func TestTxNotifierMultipleHistoricalConfRescans(t *testing.T) {
 	// transaction. This should not request a historical confirmation rescan
 	// since the first one is still pending.
 	confNtfn2 := &chainntnfs.ConfNtfn{
-		ConfID: 1,
-		TxID:   &zeroHash,
-		Event:  chainntnfs.NewConfirmationEvent(1),
+		ConfID:      1,
+		ConfRequest: chainntnfs.ConfRequest{TxID: testTxID},
+		Event:       chainntnfs.NewConfirmationEvent(1, nil),
 	}
 	historicalConfDispatch2, err := n.RegisterConf(confNtfn2)
 	if err != nil {
@@ -642,14 +666,14 @@ func TestTxNotifierMultipleHistoricalConfRescans(t *testing.T) {
 	confDetails := &chainntnfs.TxConfirmation{
 		BlockHeight: startingHeight - 1,
 	}
-	if err := n.UpdateConfDetails(*confNtfn2.TxID, confDetails); err != nil {
+	if err := n.UpdateConfDetails(confNtfn2.ConfRequest, confDetails); err != nil {
 		t.Fatalf("unable to update conf details: %v", err)
 	}
 
 	confNtfn3 := &chainntnfs.ConfNtfn{
-		ConfID: 2,
-		TxID:   &zeroHash,
-		Event:  chainntnfs.NewConfirmationEvent(1),
+		ConfID:      2,
+		ConfRequest: chainntnfs.ConfRequest{TxID: testTxID},
+		Event:       chainntnfs.NewConfirmationEvent(1, nil),
 	}
 	historicalConfDispatch3, err := n.RegisterConf(confNtfn3)
 	if err != nil {
@@ -673,9 +697,9 @@ func TestTxNotifierMultipleHistoricalSpendRescans(t *testing.T) {
 	// a historical spend rescan as it does not have a historical view of
 	// the chain.
 	ntfn1 := &chainntnfs.SpendNtfn{
-		SpendID:  0,
-		OutPoint: zeroOutPoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendID:      0,
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	historicalDispatch1, err := n.RegisterSpend(ntfn1)
 	if err != nil {
@@ -689,9 +713,9 @@ func TestTxNotifierMultipleHistoricalSpendRescans(t *testing.T) {
 	// should not request a historical spend rescan since the first one is
 	// still pending.
 	ntfn2 := &chainntnfs.SpendNtfn{
-		SpendID:  1,
-		OutPoint: zeroOutPoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendID:      1,
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	historicalDispatch2, err := n.RegisterSpend(ntfn2)
 	if err != nil {
@@ -706,21 +730,21 @@ func TestTxNotifierMultipleHistoricalSpendRescans(t *testing.T) {
 	// historical rescan request since the confirmation details should be
 	// cached.
 	spendDetails := &chainntnfs.SpendDetail{
-		SpentOutPoint:     &ntfn2.OutPoint,
+		SpentOutPoint:     &ntfn2.SpendRequest.OutPoint,
 		SpenderTxHash:     &zeroHash,
 		SpendingTx:        wire.NewMsgTx(2),
 		SpenderInputIndex: 0,
 		SpendingHeight:    startingHeight - 1,
 	}
-	err = n.UpdateSpendDetails(ntfn2.OutPoint, spendDetails)
+	err = n.UpdateSpendDetails(ntfn2.SpendRequest, spendDetails)
 	if err != nil {
 		t.Fatalf("unable to update spend details: %v", err)
 	}
 
 	ntfn3 := &chainntnfs.SpendNtfn{
-		SpendID:  2,
-		OutPoint: zeroOutPoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendID:      2,
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	historicalDispatch3, err := n.RegisterSpend(ntfn3)
 	if err != nil {
@@ -752,9 +776,9 @@ func TestTxNotifierMultipleHistoricalNtfns(t *testing.T) {
 	confNtfns := make([]*chainntnfs.ConfNtfn, numNtfns)
 	for i := uint64(0); i < numNtfns; i++ {
 		confNtfns[i] = &chainntnfs.ConfNtfn{
-			ConfID: i,
-			TxID:   &zeroHash,
-			Event:  chainntnfs.NewConfirmationEvent(1),
+			ConfID:      i,
+			ConfRequest: chainntnfs.ConfRequest{TxID: testTxID},
+			Event:       chainntnfs.NewConfirmationEvent(1, nil),
 		}
 		if _, err := n.RegisterConf(confNtfns[i]); err != nil {
 			t.Fatalf("unable to register conf ntfn #%d: %v", i, err)
@@ -777,7 +801,7 @@ func TestTxNotifierMultipleHistoricalNtfns(t *testing.T) {
 	expectedConfDetails := &chainntnfs.TxConfirmation{
 		BlockHeight: startingHeight - 1,
 	}
-	err := n.UpdateConfDetails(*confNtfns[0].TxID, expectedConfDetails)
+	err := n.UpdateConfDetails(confNtfns[0].ConfRequest, expectedConfDetails)
 	if err != nil {
 		t.Fatalf("unable to update conf details: %v", err)
 	}
@@ -799,9 +823,9 @@ func TestTxNotifierMultipleHistoricalNtfns(t *testing.T) {
 	// see a historical rescan request and the confirmation notification
 	// should come through immediately.
 	extraConfNtfn := &chainntnfs.ConfNtfn{
-		ConfID: numNtfns + 1,
-		TxID:   &zeroHash,
-		Event:  chainntnfs.NewConfirmationEvent(1),
+		ConfID:      numNtfns + 1,
+		ConfRequest: chainntnfs.ConfRequest{TxID: testTxID},
+		Event:       chainntnfs.NewConfirmationEvent(1, nil),
 	}
 	historicalConfRescan, err := n.RegisterConf(extraConfNtfn)
 	if err != nil {
@@ -822,9 +846,9 @@ func TestTxNotifierMultipleHistoricalNtfns(t *testing.T) {
 	spendNtfns := make([]*chainntnfs.SpendNtfn, numNtfns)
 	for i := uint64(0); i < numNtfns; i++ {
 		spendNtfns[i] = &chainntnfs.SpendNtfn{
-			SpendID:  i,
-			OutPoint: zeroOutPoint,
-			Event:    chainntnfs.NewSpendEvent(nil),
+			SpendID:      i,
+			SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+			Event:        chainntnfs.NewSpendEvent(nil),
 		}
 		if _, err := n.RegisterSpend(spendNtfns[i]); err != nil {
 			t.Fatalf("unable to register spend ntfn #%d: %v", i, err)
@@ -845,13 +869,13 @@ func TestTxNotifierMultipleHistoricalNtfns(t *testing.T) {
 	// following spend details. We'll let the notifier know so that it can
 	// stop watching at tip.
 	expectedSpendDetails := &chainntnfs.SpendDetail{
-		SpentOutPoint:     &spendNtfns[0].OutPoint,
+		SpentOutPoint:     &spendNtfns[0].SpendRequest.OutPoint,
 		SpenderTxHash:     &zeroHash,
 		SpendingTx:        wire.NewMsgTx(2),
 		SpenderInputIndex: 0,
 		SpendingHeight:    startingHeight - 1,
 	}
-	err = n.UpdateSpendDetails(spendNtfns[0].OutPoint, expectedSpendDetails)
+	err = n.UpdateSpendDetails(spendNtfns[0].SpendRequest, expectedSpendDetails)
 	if err != nil {
 		t.Fatalf("unable to update spend details: %v", err)
 	}
@@ -873,9 +897,9 @@ func TestTxNotifierMultipleHistoricalNtfns(t *testing.T) {
 	// should not see a historical rescan request and the spend notification
 	// should come through immediately.
 	extraSpendNtfn := &chainntnfs.SpendNtfn{
-		SpendID:  numNtfns + 1,
-		OutPoint: zeroOutPoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendID:      numNtfns + 1,
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	historicalSpendRescan, err := n.RegisterSpend(extraSpendNtfn)
 	if err != nil {
@@ -905,18 +929,18 @@ func TestTxNotifierCancelSpend(t *testing.T) {
 	// We'll register two notification requests. Only the second one will be
 	// canceled.
 	ntfn1 := &chainntnfs.SpendNtfn{
-		SpendID:  0,
-		OutPoint: zeroOutPoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendID:      0,
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	if _, err := n.RegisterSpend(ntfn1); err != nil {
 		t.Fatalf("unable to register spend ntfn: %v", err)
 	}
 
 	ntfn2 := &chainntnfs.SpendNtfn{
-		SpendID:  1,
-		OutPoint: zeroOutPoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendID:      1,
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	if _, err := n.RegisterSpend(ntfn2); err != nil {
 		t.Fatalf("unable to register spend ntfn: %v", err)
@@ -925,10 +949,10 @@ func TestTxNotifierCancelSpend(t *testing.T) {
 	// Construct the spending details of the outpoint and create a dummy
 	// block containing it.
 	spendTx := wire.NewMsgTx(2)
-	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: ntfn1.OutPoint})
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: ntfn1.SpendRequest.OutPoint})
 	spendTxHash := spendTx.TxHash()
 	expectedSpendDetails := &chainntnfs.SpendDetail{
-		SpentOutPoint:     &ntfn1.OutPoint,
+		SpentOutPoint:     &ntfn1.SpendRequest.OutPoint,
 		SpenderTxHash:     &spendTxHash,
 		SpendingTx:        spendTx,
 		SpenderInputIndex: 0,
@@ -941,7 +965,7 @@ func TestTxNotifierCancelSpend(t *testing.T) {
 
 	// Before extending the notifier's tip with the dummy block above, we'll
 	// cancel the second request.
-	n.CancelSpend(ntfn2.OutPoint, ntfn2.SpendID)
+	n.CancelSpend(ntfn2.SpendRequest, ntfn2.SpendID)
 
 	err := n.ConnectTip(block.Hash(), startingHeight+1, block.Transactions())
 	if err != nil {
@@ -973,6 +997,101 @@ func TestTxNotifierCancelSpend(t *testing.T) {
 	}
 }
 
+// TestTxNotifierCancelConf ensures that a confirmation notification is not
+// dispatched after a client has canceled their intent to receive one.
+func TestTxNotifierCancelConf(t *testing.T) {
+	t.Parallel()
+
+	const (
+		startingHeight        = 10
+		numConfs       uint32 = 2
+	)
+
+	hintCache := newMockHintCache()
+	n := chainntnfs.NewTxNotifier(startingHeight, 100, hintCache, hintCache)
+
+	// We'll register two notification requests. Only the second one will
+	// be canceled.
+	tx := wire.MsgTx{Version: 1}
+	confRequest := chainntnfs.ConfRequest{TxID: tx.TxHash()}
+	ntfn1 := &chainntnfs.ConfNtfn{
+		ConfID:           0,
+		ConfRequest:      confRequest,
+		NumConfirmations: numConfs,
+		Event:            chainntnfs.NewConfirmationEvent(numConfs, nil),
+	}
+	if _, err := n.RegisterConf(ntfn1); err != nil {
+		t.Fatalf("unable to register conf ntfn: %v", err)
+	}
+
+	ntfn2 := &chainntnfs.ConfNtfn{
+		ConfID:           1,
+		ConfRequest:      confRequest,
+		NumConfirmations: numConfs,
+		Event:            chainntnfs.NewConfirmationEvent(numConfs, nil),
+	}
+	if _, err := n.RegisterConf(ntfn2); err != nil {
+		t.Fatalf("unable to register conf ntfn: %v", err)
+	}
+
+	// Include the transaction in a block. As it requires two
+	// confirmations, both requests should now be waiting for the next
+	// block.
+	block1 := btcutil.NewBlock(&wire.MsgBlock{
+		Transactions: []*wire.MsgTx{&tx},
+	})
+	err := n.ConnectTip(
+		block1.Hash(), startingHeight+1, block1.Transactions(),
+	)
+	if err != nil {
+		t.Fatalf("unable to connect block: %v", err)
+	}
+	if err := n.NotifyHeight(startingHeight + 1); err != nil {
+		t.Fatalf("unable to dispatch notifications: %v", err)
+	}
+
+	// Before confirming the transaction a second time, we'll cancel the
+	// second request.
+	n.CancelConf(ntfn2.ConfRequest, ntfn2.ConfID)
+
+	block2 := btcutil.NewBlock(&wire.MsgBlock{})
+	err = n.ConnectTip(
+		block2.Hash(), startingHeight+2, block2.Transactions(),
+	)
+	if err != nil {
+		t.Fatalf("unable to connect block: %v", err)
+	}
+	if err := n.NotifyHeight(startingHeight + 2); err != nil {
+		t.Fatalf("unable to dispatch notifications: %v", err)
+	}
+
+	// The first request should still be active, so we should receive a
+	// confirmation notification with the correct details.
+	select {
+	case txConf := <-ntfn1.Event.Confirmed:
+		expectedConf := chainntnfs.TxConfirmation{
+			BlockHash:   block1.Hash(),
+			BlockHeight: startingHeight + 1,
+			TxIndex:     0,
+		}
+		assertConfDetails(t, txConf, &expectedConf)
+	default:
+		t.Fatal("expected to receive confirmation notification")
+	}
+
+	// The second one, however, should not have. The event's Confirmed
+	// channel must have also been closed to indicate the caller that the
+	// TxNotifier can no longer fulfill their canceled request.
+	select {
+	case _, ok := <-ntfn2.Event.Confirmed:
+		if ok {
+			t.Fatal("expected Confirmed channel to be closed")
+		}
+	default:
+		t.Fatal("expected Confirmed channel to be closed")
+	}
+}
+
 // TestTxNotifierConfReorg ensures that clients are notified of a reorg when a
 // transaction for which they registered a confirmation notification has been
 // reorged out of the chain.
@@ -997,45 +1116,45 @@ func TestTxNotifierConfReorg(t *testing.T) {
 	// Tx 1 will be confirmed in block 9 and requires 2 confs.
 	tx1Hash := tx1.TxHash()
 	ntfn1 := chainntnfs.ConfNtfn{
-		TxID:             &tx1Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx1Hash},
 		NumConfirmations: tx1NumConfs,
-		Event:            chainntnfs.NewConfirmationEvent(tx1NumConfs),
+		Event:            chainntnfs.NewConfirmationEvent(tx1NumConfs, nil),
 	}
 	if _, err := n.RegisterConf(&ntfn1); err != nil {
 		t.Fatalf("unable to register ntfn: %v", err)
 	}
 
-	if err := n.UpdateConfDetails(*ntfn1.TxID, nil); err != nil {
+	if err := n.UpdateConfDetails(ntfn1.ConfRequest, nil); err != nil {
 		t.Fatalf("unable to deliver conf details: %v", err)
 	}
 
 	// Tx 2 will be confirmed in block 10 and requires 1 conf.
 	tx2Hash := tx2.TxHash()
 	ntfn2 := chainntnfs.ConfNtfn{
-		TxID:             &tx2Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx2Hash},
 		NumConfirmations: tx2NumConfs,
-		Event:            chainntnfs.NewConfirmationEvent(tx2NumConfs),
+		Event:            chainntnfs.NewConfirmationEvent(tx2NumConfs, nil),
 	}
 	if _, err := n.RegisterConf(&ntfn2); err != nil {
 		t.Fatalf("unable to register ntfn: %v", err)
 	}
 
-	if err := n.UpdateConfDetails(*ntfn2.TxID, nil); err != nil {
+	if err := n.UpdateConfDetails(ntfn2.ConfRequest, nil); err != nil {
 		t.Fatalf("unable to deliver conf details: %v", err)
 	}
 
 	// Tx 3 will be confirmed in block 10 and requires 2 confs.
 	tx3Hash := tx3.TxHash()
 	ntfn3 := chainntnfs.ConfNtfn{
-		TxID:             &tx3Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx3Hash},
 		NumConfirmations: tx3NumConfs,
-		Event:            chainntnfs.NewConfirmationEvent(tx3NumConfs),
+		Event:            chainntnfs.NewConfirmationEvent(tx3NumConfs, nil),
 	}
 	if _, err := n.RegisterConf(&ntfn3); err != nil {
 		t.Fatalf("unable to register ntfn: %v", err)
 	}
 
-	if err := n.UpdateConfDetails(*ntfn3.TxID, nil); err != nil {
+	if err := n.UpdateConfDetails(ntfn3.ConfRequest, nil); err != nil {
 		t.Fatalf("unable to deliver conf details: %v", err)
 	}
 
@@ -1267,7 +1386,7 @@ func TestTxNotifierSpendReorg(t *testing.T) {
 	// We'll have two outpoints that will be spent throughout the test. The
 	// first will be spent and will not experience a reorg, while the second
 	// one will.
-	op1 := zeroOutPoint
+	op1 := testOutPoint
 	op1.Index = 1
 	spendTx1 := wire.NewMsgTx(2)
 	spendTx1.AddTxIn(&wire.TxIn{PreviousOutPoint: op1})
@@ -1280,10 +1399,10 @@ func TestTxNotifierSpendReorg(t *testing.T) {
 		SpendingHeight:    startingHeight + 1,
 	}
 
-	op2 := zeroOutPoint
+	op2 := testOutPoint
 	op2.Index = 2
 	spendTx2 := wire.NewMsgTx(2)
-	spendTx2.AddTxIn(&wire.TxIn{PreviousOutPoint: zeroOutPoint})
+	spendTx2.AddTxIn(&wire.TxIn{PreviousOutPoint: testOutPoint})
 	spendTx2.AddTxIn(&wire.TxIn{PreviousOutPoint: op2})
 	spendTxHash2 := spendTx2.TxHash()
 
@@ -1305,18 +1424,18 @@ func TestTxNotifierSpendReorg(t *testing.T) {
 
 	// We'll register for a spend notification for each outpoint above.
 	ntfn1 := &chainntnfs.SpendNtfn{
-		SpendID:  78,
-		OutPoint: op1,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendID:      78,
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: op1},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	if _, err := n.RegisterSpend(ntfn1); err != nil {
 		t.Fatalf("unable to register spend ntfn: %v", err)
 	}
 
 	ntfn2 := &chainntnfs.SpendNtfn{
-		SpendID:  21,
-		OutPoint: op2,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendID:      21,
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: op2},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	if _, err := n.RegisterSpend(ntfn2); err != nil {
 		t.Fatalf("unable to register spend ntfn: %v", err)
@@ -1465,6 +1584,152 @@ func TestTxNotifierSpendReorg(t *testing.T) {
 	}
 }
 
+// TestTxNotifierSpendDeepReorg ensures that a spend found through a historical
+// rescan is reorged out of the chain once the block including it is
+// disconnected within a deep reorg, and that the spend is watched for again
+// such that a different spending transaction within the new chain is notified.
+// It also ensures a client that hasn't consumed its notifications during
+// successive reorgs is only left with a reorg notification.
+func TestTxNotifierSpendDeepReorg(t *testing.T) {
+	t.Parallel()
+
+	const startingHeight = 10
+	hintCache := newMockHintCache()
+	n := chainntnfs.NewTxNotifier(startingHeight, 100, hintCache, hintCache)
+
+	// We'll start by registering for a spend notification of an outpoint,
+	// which will be spent by a different transaction in each of the chains
+	// throughout the test.
+	op := testOutPoint
+	ntfn := &chainntnfs.SpendNtfn{
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: op},
+		Event:        chainntnfs.NewSpendEvent(nil),
+	}
+	if _, err := n.RegisterSpend(ntfn); err != nil {
+		t.Fatalf("unable to register spend ntfn: %v", err)
+	}
+
+	newSpendDetails := func(lockTime, height uint32) *chainntnfs.SpendDetail {
+		spendTx := wire.NewMsgTx(2)
+		spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: op})
+		spendTx.LockTime = lockTime
+		spendTxHash := spendTx.TxHash()
+		return &chainntnfs.SpendDetail{
+			SpentOutPoint:     &op,
+			SpenderTxHash:     &spendTxHash,
+			SpendingTx:        spendTx,
+			SpenderInputIndex: 0,
+			SpendingHeight:    int32(height),
+		}
+	}
+
+	assertNoNtfn := func() {
+		t.Helper()
+
+		select {
+		case <-ntfn.Event.Spend:
+			t.Fatal("received unexpected spend notification")
+		case <-ntfn.Event.Reorg:
+			t.Fatal("received unexpected spend reorg notification")
+		default:
+		}
+	}
+
+	// The outpoint was spent three blocks deep before the notifier's
+	// height, which will be found by the historical rescan.
+	historicalSpend := newSpendDetails(1, startingHeight-2)
+	err := n.UpdateSpendDetails(ntfn.SpendRequest, historicalSpend)
+	if err != nil {
+		t.Fatalf("unable to update spend details: %v", err)
+	}
+	select {
+	case spendDetails := <-ntfn.Event.Spend:
+		assertSpendDetails(t, spendDetails, historicalSpend)
+	default:
+		t.Fatal("expected to receive spend details")
+	}
+
+	// We'll now disconnect the three blocks up to and including the one
+	// containing the spending transaction. Only once the latter is
+	// disconnected should we receive a reorg notification.
+	for height := uint32(startingHeight); height > startingHeight-2; height-- {
+		if err := n.DisconnectTip(height); err != nil {
+			t.Fatalf("unable to disconnect block: %v", err)
+		}
+		assertNoNtfn()
+	}
+	if err := n.DisconnectTip(startingHeight - 2); err != nil {
+		t.Fatalf("unable to disconnect block: %v", err)
+	}
+	select {
+	case <-ntfn.Event.Reorg:
+	default:
+		t.Fatal("expected to receive spend reorg notification")
+	}
+
+	// The new chain doesn't spend the outpoint until its third block, so
+	// we shouldn't receive any notifications before then.
+	for height := uint32(startingHeight - 2); height < startingHeight; height++ {
+		block := btcutil.NewBlock(&wire.MsgBlock{})
+		err := n.ConnectTip(block.Hash(), height, block.Transactions())
+		if err != nil {
+			t.Fatalf("unable to connect block: %v", err)
+		}
+		if err := n.NotifyHeight(height); err != nil {
+			t.Fatalf("unable to dispatch notifications: %v", err)
+		}
+		assertNoNtfn()
+	}
+
+	newSpend := newSpendDetails(2, startingHeight)
+	block := btcutil.NewBlock(&wire.MsgBlock{
+		Transactions: []*wire.MsgTx{newSpend.SpendingTx},
+	})
+	err = n.ConnectTip(block.Hash(), startingHeight, block.Transactions())
+	if err != nil {
+		t.Fatalf("unable to connect block: %v", err)
+	}
+	if err := n.NotifyHeight(startingHeight); err != nil {
+		t.Fatalf("unable to dispatch notifications: %v", err)
+	}
+	select {
+	case spendDetails := <-ntfn.Event.Spend:
+		assertSpendDetails(t, spendDetails, newSpend)
+	default:
+		t.Fatal("expected to receive spend details")
+	}
+
+	// Finally, we'll reorg the new spend out, include yet another spending
+	// transaction and reorg it out as well, all without consuming any of
+	// the notifications. As the outpoint is left unspent, the client
+	// should only be left with a single reorg notification.
+	if err := n.DisconnectTip(startingHeight); err != nil {
+		t.Fatalf("unable to disconnect block: %v", err)
+	}
+
+	lastSpend := newSpendDetails(3, startingHeight)
+	block = btcutil.NewBlock(&wire.MsgBlock{
+		Transactions: []*wire.MsgTx{lastSpend.SpendingTx},
+	})
+	err = n.ConnectTip(block.Hash(), startingHeight, block.Transactions())
+	if err != nil {
+		t.Fatalf("unable to connect block: %v", err)
+	}
+	if err := n.NotifyHeight(startingHeight); err != nil {
+		t.Fatalf("unable to dispatch notifications: %v", err)
+	}
+	if err := n.DisconnectTip(startingHeight); err != nil {
+		t.Fatalf("unable to disconnect block: %v", err)
+	}
+
+	select {
+	case <-ntfn.Event.Reorg:
+	default:
+		t.Fatal("expected to receive spend reorg notification")
+	}
+	assertNoNtfn()
+}
+
 // TestTxNotifierConfirmHintCache ensures that the height hints for transactions
 // are kept track of correctly with each new block connected/disconnected. This
 // test also asserts that the height hints are not updated until the simulated
@@ -1488,17 +1753,17 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 	tx1 := wire.MsgTx{Version: 1}
 	tx1Hash := tx1.TxHash()
 	ntfn1 := &chainntnfs.ConfNtfn{
-		TxID:             &tx1Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx1Hash},
 		NumConfirmations: 1,
-		Event:            chainntnfs.NewConfirmationEvent(1),
+		Event:            chainntnfs.NewConfirmationEvent(1, nil),
 	}
 
 	tx2 := wire.MsgTx{Version: 2}
 	tx2Hash := tx2.TxHash()
 	ntfn2 := &chainntnfs.ConfNtfn{
-		TxID:             &tx2Hash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx2Hash},
 		NumConfirmations: 2,
-		Event:            chainntnfs.NewConfirmationEvent(2),
+		Event:            chainntnfs.NewConfirmationEvent(2, nil),
 	}
 
 	if _, err := n.RegisterConf(ntfn1); err != nil {
@@ -1510,14 +1775,14 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 
 	// Both transactions should not have a height hint set, as RegisterConf
 	// should not alter the cache state.
-	_, err := hintCache.QueryConfirmHint(tx1Hash)
+	_, err := hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx1Hash})
 	if err != chainntnfs.ErrConfirmHintNotFound {
 		t.Fatalf("unexpected error when querying for height hint "+
 			"want: %v, got %v",
 			chainntnfs.ErrConfirmHintNotFound, err)
 	}
 
-	_, err = hintCache.QueryConfirmHint(tx2Hash)
+	_, err = hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx2Hash})
 	if err != chainntnfs.ErrConfirmHintNotFound {
 		t.Fatalf("unexpected error when querying for height hint "+
 			"want: %v, got %v",
@@ -1543,14 +1808,14 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 	// the height hints should remain unchanged. This simulates blocks
 	// confirming while the historical dispatch is processing the
 	// registration.
-	hint, err := hintCache.QueryConfirmHint(tx1Hash)
+	hint, err := hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx1Hash})
 	if err != chainntnfs.ErrConfirmHintNotFound {
 		t.Fatalf("unexpected error when querying for height hint "+
 			"want: %v, got %v",
 			chainntnfs.ErrConfirmHintNotFound, err)
 	}
 
-	hint, err = hintCache.QueryConfirmHint(tx2Hash)
+	hint, err = hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx2Hash})
 	if err != chainntnfs.ErrConfirmHintNotFound {
 		t.Fatalf("unexpected error when querying for height hint "+
 			"want: %v, got %v",
@@ -1559,10 +1824,10 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 
 	// Now, update the conf details reporting that the neither txn was found
 	// in the historical dispatch.
-	if err := n.UpdateConfDetails(tx1Hash, nil); err != nil {
+	if err := n.UpdateConfDetails(chainntnfs.ConfRequest{TxID: tx1Hash}, nil); err != nil {
 		t.Fatalf("unable to update conf details: %v", err)
 	}
-	if err := n.UpdateConfDetails(tx2Hash, nil); err != nil {
+	if err := n.UpdateConfDetails(chainntnfs.ConfRequest{TxID: tx2Hash}, nil); err != nil {
 		t.Fatalf("unable to update conf details: %v", err)
 	}
 
@@ -1583,7 +1848,7 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 	// Now that both notifications are waiting at tip for confirmations,
 	// they should have their height hints updated to the latest block
 	// height.
-	hint, err = hintCache.QueryConfirmHint(tx1Hash)
+	hint, err = hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx1Hash})
 	if err != nil {
 		t.Fatalf("unable to query for hint: %v", err)
 	}
@@ -1592,7 +1857,7 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 			tx1Height, hint)
 	}
 
-	hint, err = hintCache.QueryConfirmHint(tx2Hash)
+	hint, err = hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx2Hash})
 	if err != nil {
 		t.Fatalf("unable to query for hint: %v", err)
 	}
@@ -1616,7 +1881,7 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 	}
 
 	// The height hint for the first transaction should remain the same.
-	hint, err = hintCache.QueryConfirmHint(tx1Hash)
+	hint, err = hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx1Hash})
 	if err != nil {
 		t.Fatalf("unable to query for hint: %v", err)
 	}
@@ -1627,7 +1892,7 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 
 	// The height hint for the second transaction should now be updated to
 	// reflect its confirmation.
-	hint, err = hintCache.QueryConfirmHint(tx2Hash)
+	hint, err = hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx2Hash})
 	if err != nil {
 		t.Fatalf("unable to query for hint: %v", err)
 	}
@@ -1644,7 +1909,7 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 
 	// This should update the second transaction's height hint within the
 	// cache to the previous height.
-	hint, err = hintCache.QueryConfirmHint(tx2Hash)
+	hint, err = hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx2Hash})
 	if err != nil {
 		t.Fatalf("unable to query for hint: %v", err)
 	}
@@ -1655,7 +1920,7 @@ func TestTxNotifierConfirmHintCache(t *testing.T) {
 
 	// The first transaction's height hint should remain at the original
 	// confirmation height.
-	hint, err = hintCache.QueryConfirmHint(tx2Hash)
+	hint, err = hintCache.QueryConfirmHint(chainntnfs.ConfRequest{TxID: tx2Hash})
 	if err != nil {
 		t.Fatalf("unable to query for hint: %v", err)
 	}
@@ -1687,13 +1952,13 @@ func TestTxNotifierSpendHintCache(t *testing.T) {
 	// Create two test outpoints and register them for spend notifications.
 	op1 := wire.OutPoint{Hash: zeroHash, Index: 1}
 	ntfn1 := &chainntnfs.SpendNtfn{
-		OutPoint: op1,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: op1},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	op2 := wire.OutPoint{Hash: zeroHash, Index: 2}
 	ntfn2 := &chainntnfs.SpendNtfn{
-		OutPoint: op2,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: op2},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 
 	if _, err := n.RegisterSpend(ntfn1); err != nil {
@@ -1706,13 +1971,13 @@ func TestTxNotifierSpendHintCache(t *testing.T) {
 	// Both outpoints should not have a spend hint set upon registration, as
 	// we must first determine whether they have already been spent in the
 	// chain.
-	_, err := hintCache.QuerySpendHint(op1)
+	_, err := hintCache.QuerySpendHint(chainntnfs.SpendRequest{OutPoint: op1})
 	if err != chainntnfs.ErrSpendHintNotFound {
 		t.Fatalf("unexpected error when querying for height hint "+
 			"expected: %v, got %v", chainntnfs.ErrSpendHintNotFound,
 			err)
 	}
-	_, err = hintCache.QuerySpendHint(op2)
+	_, err = hintCache.QuerySpendHint(chainntnfs.SpendRequest{OutPoint: op2})
 	if err != chainntnfs.ErrSpendHintNotFound {
 		t.Fatalf("unexpected error when querying for height hint "+
 			"expected: %v, got %v", chainntnfs.ErrSpendHintNotFound,
@@ -1734,13 +1999,13 @@ func TestTxNotifierSpendHintCache(t *testing.T) {
 	// Since we haven't called UpdateSpendDetails on any of the test
 	// outpoints, this implies that there is a still a pending historical
 	// rescan for them, so their spend hints should not be created/updated.
-	_, err = hintCache.QuerySpendHint(op1)
+	_, err = hintCache.QuerySpendHint(chainntnfs.SpendRequest{OutPoint: op1})
 	if err != chainntnfs.ErrSpendHintNotFound {
 		t.Fatalf("unexpected error when querying for height hint "+
 			"expected: %v, got %v", chainntnfs.ErrSpendHintNotFound,
 			err)
 	}
-	_, err = hintCache.QuerySpendHint(op2)
+	_, err = hintCache.QuerySpendHint(chainntnfs.SpendRequest{OutPoint: op2})
 	if err != chainntnfs.ErrSpendHintNotFound {
 		t.Fatalf("unexpected error when querying for height hint "+
 			"expected: %v, got %v", chainntnfs.ErrSpendHintNotFound,
@@ -1750,17 +2015,17 @@ func TestTxNotifierSpendHintCache(t *testing.T) {
 	// Now, we'll simulate that their historical rescans have finished by
 	// calling UpdateSpendDetails. This should allow their spend hints to be
 	// updated upon every block connected/disconnected.
-	if err := n.UpdateSpendDetails(ntfn1.OutPoint, nil); err != nil {
+	if err := n.UpdateSpendDetails(ntfn1.SpendRequest, nil); err != nil {
 		t.Fatalf("unable to update spend details: %v", err)
 	}
-	if err := n.UpdateSpendDetails(ntfn2.OutPoint, nil); err != nil {
+	if err := n.UpdateSpendDetails(ntfn2.SpendRequest, nil); err != nil {
 		t.Fatalf("unable to update spend details: %v", err)
 	}
 
 	// We'll create a new block that only contains the spending transaction
 	// of the first outpoint.
 	spendTx1 := wire.NewMsgTx(2)
-	spendTx1.AddTxIn(&wire.TxIn{PreviousOutPoint: ntfn1.OutPoint})
+	spendTx1.AddTxIn(&wire.TxIn{PreviousOutPoint: ntfn1.SpendRequest.OutPoint})
 	block1 := btcutil.NewBlock(&wire.MsgBlock{
 		Transactions: []*wire.MsgTx{spendTx1},
 	})
@@ -1775,14 +2040,14 @@ func TestTxNotifierSpendHintCache(t *testing.T) {
 	// Both outpoints should have their spend hints reflect the height of
 	// the new block being connected due to the first outpoint being spent
 	// at this height, and the second outpoint still being unspent.
-	op1Hint, err := hintCache.QuerySpendHint(ntfn1.OutPoint)
+	op1Hint, err := hintCache.QuerySpendHint(ntfn1.SpendRequest)
 	if err != nil {
 		t.Fatalf("unable to query for spend hint of op1: %v", err)
 	}
 	if op1Hint != op1Height {
 		t.Fatalf("expected hint %d, got %d", op1Height, op1Hint)
 	}
-	op2Hint, err := hintCache.QuerySpendHint(ntfn2.OutPoint)
+	op2Hint, err := hintCache.QuerySpendHint(ntfn2.SpendRequest)
 	if err != nil {
 		t.Fatalf("unable to query for spend hint of op2: %v", err)
 	}
@@ -1792,7 +2057,7 @@ func TestTxNotifierSpendHintCache(t *testing.T) {
 
 	// Then, we'll create another block that spends the second outpoint.
 	spendTx2 := wire.NewMsgTx(2)
-	spendTx2.AddTxIn(&wire.TxIn{PreviousOutPoint: ntfn2.OutPoint})
+	spendTx2.AddTxIn(&wire.TxIn{PreviousOutPoint: ntfn2.SpendRequest.OutPoint})
 	block2 := btcutil.NewBlock(&wire.MsgBlock{
 		Transactions: []*wire.MsgTx{spendTx2},
 	})
@@ -1807,14 +2072,14 @@ func TestTxNotifierSpendHintCache(t *testing.T) {
 	// Only the second outpoint should have its spend hint updated due to
 	// being spent within the new block. The first outpoint's spend hint
 	// should remain the same as it's already been spent before.
-	op1Hint, err = hintCache.QuerySpendHint(ntfn1.OutPoint)
+	op1Hint, err = hintCache.QuerySpendHint(ntfn1.SpendRequest)
 	if err != nil {
 		t.Fatalf("unable to query for spend hint of op1: %v", err)
 	}
 	if op1Hint != op1Height {
 		t.Fatalf("expected hint %d, got %d", op1Height, op1Hint)
 	}
-	op2Hint, err = hintCache.QuerySpendHint(ntfn2.OutPoint)
+	op2Hint, err = hintCache.QuerySpendHint(ntfn2.SpendRequest)
 	if err != nil {
 		t.Fatalf("unable to query for spend hint of op2: %v", err)
 	}
@@ -1832,14 +2097,14 @@ func TestTxNotifierSpendHintCache(t *testing.T) {
 	// to the previous height, as that's where its spending transaction was
 	// included in within the chain. The first outpoint's spend hint should
 	// remain the same.
-	op1Hint, err = hintCache.QuerySpendHint(ntfn1.OutPoint)
+	op1Hint, err = hintCache.QuerySpendHint(ntfn1.SpendRequest)
 	if err != nil {
 		t.Fatalf("unable to query for spend hint of op1: %v", err)
 	}
 	if op1Hint != op1Height {
 		t.Fatalf("expected hint %d, got %d", op1Height, op1Hint)
 	}
-	op2Hint, err = hintCache.QuerySpendHint(ntfn2.OutPoint)
+	op2Hint, err = hintCache.QuerySpendHint(ntfn2.SpendRequest)
 	if err != nil {
 		t.Fatalf("unable to query for spend hint of op2: %v", err)
 	}
@@ -1859,17 +2124,17 @@ func TestTxNotifierTearDown(t *testing.T) {
 	// To begin the test, we'll register for a confirmation and spend
 	// notification.
 	confNtfn := &chainntnfs.ConfNtfn{
-		TxID:             &zeroHash,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: testTxID},
 		NumConfirmations: 1,
-		Event:            chainntnfs.NewConfirmationEvent(1),
+		Event:            chainntnfs.NewConfirmationEvent(1, nil),
 	}
 	if _, err := n.RegisterConf(confNtfn); err != nil {
 		t.Fatalf("unable to register conf ntfn: %v", err)
 	}
 
 	spendNtfn := &chainntnfs.SpendNtfn{
-		OutPoint: zeroOutPoint,
-		Event:    chainntnfs.NewSpendEvent(nil),
+		SpendRequest: chainntnfs.SpendRequest{OutPoint: testOutPoint},
+		Event:        chainntnfs.NewSpendEvent(nil),
 	}
 	if _, err := n.RegisterSpend(spendNtfn); err != nil {
 		t.Fatalf("unable to register spend ntfn: %v", err)
@@ -1916,6 +2181,198 @@ func TestTxNotifierTearDown(t *testing.T) {
 	}
 }
 
+// TestTxNotifierConfByScript tests that the TxNotifier dispatches the
+// confirmation of the first transaction paying to an output script that was
+// registered without a txid, along with the transaction and the index of the
+// output.
+func TestTxNotifierConfByScript(t *testing.T) {
+	t.Parallel()
+
+	const numConfs = 1
+
+	hintCache := newMockHintCache()
+	n := chainntnfs.NewTxNotifier(10, 100, hintCache, hintCache)
+
+	// A request without either a txid or a script should be rejected.
+	_, err := chainntnfs.NewConfRequest(nil, nil)
+	if err != chainntnfs.ErrNoScript {
+		t.Fatalf("expected ErrNoScript, got %v", err)
+	}
+
+	pkScript := testP2WKHScript(t, testPubKey)
+	confRequest, err := chainntnfs.NewConfRequest(nil, pkScript)
+	if err != nil {
+		t.Fatalf("unable to create conf request: %v", err)
+	}
+	ntfn := &chainntnfs.ConfNtfn{
+		ConfRequest:      confRequest,
+		PkScript:         pkScript,
+		NumConfirmations: numConfs,
+		Event:            chainntnfs.NewConfirmationEvent(numConfs, nil),
+	}
+	if _, err := n.RegisterConf(ntfn); err != nil {
+		t.Fatalf("unable to register ntfn: %v", err)
+	}
+
+	// We'll include a transaction that doesn't pay to the script in the
+	// block, followed by one paying to it in its second output.
+	tx1 := wire.NewMsgTx(1)
+	tx1.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x51}})
+	tx2 := wire.NewMsgTx(2)
+	tx2.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x51}})
+	tx2.AddTxOut(&wire.TxOut{Value: 2000, PkScript: pkScript})
+	block := btcutil.NewBlock(&wire.MsgBlock{
+		Transactions: []*wire.MsgTx{tx1, tx2},
+	})
+
+	err = n.ConnectTip(block.Hash(), 11, block.Transactions())
+	if err != nil {
+		t.Fatalf("unable to connect block: %v", err)
+	}
+	if err := n.NotifyHeight(11); err != nil {
+		t.Fatalf("unable to dispatch notifications: %v", err)
+	}
+
+	select {
+	case txConf := <-ntfn.Event.Confirmed:
+		expectedConf := chainntnfs.TxConfirmation{
+			BlockHash:   block.Hash(),
+			BlockHeight: 11,
+			TxIndex:     1,
+		}
+		assertConfDetails(t, txConf, &expectedConf)
+
+		if txConf.Tx == nil || txConf.Tx.TxHash() != tx2.TxHash() {
+			t.Fatalf("expected confirmed tx %v", tx2.TxHash())
+		}
+		if txConf.OutputIndex != 1 {
+			t.Fatalf("expected output index 1, got %d",
+				txConf.OutputIndex)
+		}
+	default:
+		t.Fatal("expected confirmation for script")
+	}
+}
+
+// TestTxNotifierSpendByScript tests that the TxNotifier dispatches the spend
+// of an output paying to a script that was registered without an outpoint,
+// for both P2WKH and P2WSH scripts, and that unsupported scripts are rejected.
+func TestTxNotifierSpendByScript(t *testing.T) {
+	t.Parallel()
+
+	hintCache := newMockHintCache()
+	n := chainntnfs.NewTxNotifier(10, 100, hintCache, hintCache)
+
+	// Spends of scripts whose spent script can't be determined from the
+	// spending input should be rejected.
+	_, err := chainntnfs.NewSpendRequest(nil, []byte{txscript.OP_RETURN})
+	if err != chainntnfs.ErrUnsupportedScript {
+		t.Fatalf("expected ErrUnsupportedScript, got %v", err)
+	}
+
+	witnessScript := []byte{txscript.OP_TRUE}
+	witnessScriptHash := sha256.Sum256(witnessScript)
+	p2wsh, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(witnessScriptHash[:]).Script()
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		pkScript []byte
+		witness  wire.TxWitness
+	}{
+		{
+			name:     "p2wkh",
+			pkScript: testP2WKHScript(t, testPubKey),
+			witness:  wire.TxWitness{{0x30}, testPubKey},
+		},
+		{
+			name:     "p2wsh",
+			pkScript: p2wsh,
+			witness:  wire.TxWitness{nil, witnessScript},
+		},
+	}
+
+	ntfns := make([]*chainntnfs.SpendNtfn, len(testCases))
+	for i, testCase := range testCases {
+		spendRequest, err := chainntnfs.NewSpendRequest(
+			nil, testCase.pkScript,
+		)
+		if err != nil {
+			t.Fatalf("%s: unable to create spend request: %v",
+				testCase.name, err)
+		}
+		ntfns[i] = &chainntnfs.SpendNtfn{
+			SpendRequest: spendRequest,
+			PkScript:     testCase.pkScript,
+			Event:        chainntnfs.NewSpendEvent(nil),
+		}
+		if _, err := n.RegisterSpend(ntfns[i]); err != nil {
+			t.Fatalf("%s: unable to register spend ntfn: %v",
+				testCase.name, err)
+		}
+	}
+
+	// We'll spend an output paying to each of the scripts within the same
+	// transaction, after an input spending something else entirely.
+	spendTx := wire.NewMsgTx(2)
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: testOutPoint})
+	for i, testCase := range testCases {
+		spendTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{
+				Hash:  testTxID,
+				Index: uint32(i + 1),
+			},
+			Witness: testCase.witness,
+		})
+	}
+	spendTxHash := spendTx.TxHash()
+	block := btcutil.NewBlock(&wire.MsgBlock{
+		Transactions: []*wire.MsgTx{spendTx},
+	})
+	err = n.ConnectTip(block.Hash(), 11, block.Transactions())
+	if err != nil {
+		t.Fatalf("unable to connect block: %v", err)
+	}
+	if err := n.NotifyHeight(11); err != nil {
+		t.Fatalf("unable to dispatch notifications: %v", err)
+	}
+
+	for i, testCase := range testCases {
+		expectedSpendDetails := &chainntnfs.SpendDetail{
+			SpentOutPoint:     &spendTx.TxIn[i+1].PreviousOutPoint,
+			SpenderTxHash:     &spendTxHash,
+			SpendingTx:        spendTx,
+			SpenderInputIndex: uint32(i + 1),
+			SpendingHeight:    11,
+		}
+
+		select {
+		case spendDetails := <-ntfns[i].Event.Spend:
+			assertSpendDetails(t, spendDetails, expectedSpendDetails)
+		default:
+			t.Fatalf("%s: expected to receive spend details",
+				testCase.name)
+		}
+	}
+}
+
+// testP2WKHScript returns the P2WKH output script paying to the given public
+// key.
+func testP2WKHScript(t *testing.T, pubKey []byte) []byte {
+	t.Helper()
+
+	pkScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(btcutil.Hash160(pubKey)).Script()
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+
+	return pkScript
+}
+
 func assertConfDetails(t *testing.T, result, expected *chainntnfs.TxConfirmation) {
 	t.Helper()
 
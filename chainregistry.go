@@ -59,8 +59,6 @@ const (
 
 // defaultBtcChannelConstraints is the default set of channel constraints that are
 // meant to be used when initially funding a Bitcoin channel.
-//
-// TODO(halseth): make configurable at startup?
 var defaultBtcChannelConstraints = channeldb.ChannelConstraints{
 	DustLimit:        lnwallet.DefaultDustLimit(),
 	MaxAcceptedHtlcs: lnwallet.MaxHTLCNumber / 2,
@@ -332,7 +330,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		}
 
 		cc.chainNotifier = bitcoindnotify.New(
-			bitcoindConn, hintCache, hintCache,
+			bitcoindConn, hintCache, hintCache, cfg.MempoolSpends,
 		)
 		cc.chainView = chainview.NewBitcoindFilteredChainView(bitcoindConn)
 		walletConfig.ChainSource = bitcoindConn.NewBitcoindClient()
@@ -442,7 +440,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			DisableAutoReconnect: false,
 		}
 		cc.chainNotifier, err = btcdnotify.New(
-			rpcConfig, hintCache, hintCache,
+			rpcConfig, hintCache, hintCache, cfg.MempoolSpends,
 		)
 		if err != nil {
 			return nil, nil, err
@@ -509,6 +507,13 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		channelConstraints = defaultLtcChannelConstraints
 	}
 
+	// The default dust limit of the chain may be overridden by the user.
+	if homeChainConfig.DefaultDustLimit != 0 {
+		channelConstraints.DustLimit = btcutil.Amount(
+			homeChainConfig.DefaultDustLimit,
+		)
+	}
+
 	keyRing := keychain.NewBtcWalletKeyRing(
 		wc.InternalWallet(), activeNetParams.CoinType,
 	)
@@ -0,0 +1,82 @@
+package chanacceptor
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ChainedAcceptor represents a conjunction of ChannelAcceptor results. An
+// inbound channel is only accepted if every registered acceptor accepts it.
+type ChainedAcceptor struct {
+	// acceptorID is incremented for each acceptor that is added, such
+	// that every acceptor has a unique ID.
+	//
+	// NOTE: This MUST be used atomically.
+	acceptorID uint64
+
+	acceptors    map[uint64]ChannelAcceptor
+	acceptorsMtx sync.RWMutex
+}
+
+// NewChainedAcceptor initializes a ChainedAcceptor.
+func NewChainedAcceptor() *ChainedAcceptor {
+	return &ChainedAcceptor{
+		acceptors: make(map[uint64]ChannelAcceptor),
+	}
+}
+
+// AddAcceptor adds a ChannelAcceptor to this ChainedAcceptor, returning the
+// ID it can later be removed with.
+func (c *ChainedAcceptor) AddAcceptor(acceptor ChannelAcceptor) uint64 {
+	id := atomic.AddUint64(&c.acceptorID, 1)
+
+	c.acceptorsMtx.Lock()
+	c.acceptors[id] = acceptor
+	c.acceptorsMtx.Unlock()
+
+	return id
+}
+
+// RemoveAcceptor removes the ChannelAcceptor with the given ID from this
+// ChainedAcceptor.
+func (c *ChainedAcceptor) RemoveAcceptor(id uint64) {
+	c.acceptorsMtx.Lock()
+	delete(c.acceptors, id)
+	c.acceptorsMtx.Unlock()
+}
+
+// Accept evaluates the results of all ChannelAcceptors in the acceptors map
+// and returns the first rejection, if any. If no acceptors are registered,
+// the channel is accepted.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (c *ChainedAcceptor) Accept(
+	req *ChannelAcceptRequest) *ChannelAcceptResponse {
+
+	// We copy the set of acceptors, as each of them may block for a while
+	// waiting on its decision, during which acceptors should still be able
+	// to be added or removed.
+	c.acceptorsMtx.RLock()
+	acceptors := make(map[uint64]ChannelAcceptor, len(c.acceptors))
+	for id, acceptor := range c.acceptors {
+		acceptors[id] = acceptor
+	}
+	c.acceptorsMtx.RUnlock()
+
+	for id, acceptor := range acceptors {
+		resp := acceptor.Accept(req)
+		if !resp.Accept {
+			log.Debugf("Acceptor(%v) rejected channel request "+
+				"with pendingID=%x: %v", id,
+				req.OpenChanMsg.PendingChannelID[:], resp.Error)
+
+			return resp
+		}
+	}
+
+	return NewAcceptResponse()
+}
+
+// A compile-time constraint to ensure ChainedAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*ChainedAcceptor)(nil)
@@ -0,0 +1,75 @@
+package chanacceptor
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// mockAcceptor is a ChannelAcceptor which returns a fixed response.
+type mockAcceptor struct {
+	resp *ChannelAcceptResponse
+}
+
+// Accept returns the fixed response of the mock acceptor.
+func (m *mockAcceptor) Accept(*ChannelAcceptRequest) *ChannelAcceptResponse {
+	return m.resp
+}
+
+// newTestRequest returns a channel request with the given pending channel
+// ID.
+func newTestRequest(t *testing.T, pendingID byte) *ChannelAcceptRequest {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	return &ChannelAcceptRequest{
+		Node: priv.PubKey(),
+		OpenChanMsg: &lnwire.OpenChannel{
+			PendingChannelID: [32]byte{pendingID},
+			FundingAmount:    100000,
+		},
+	}
+}
+
+// TestChainedAcceptor tests that the ChainedAcceptor only accepts a channel if
+// all of its acceptors accept it, and that acceptors can be removed again.
+func TestChainedAcceptor(t *testing.T) {
+	t.Parallel()
+
+	chained := NewChainedAcceptor()
+	req := newTestRequest(t, 1)
+
+	// Without any acceptors, the channel should be accepted.
+	if resp := chained.Accept(req); !resp.Accept {
+		t.Fatalf("expected channel to be accepted")
+	}
+
+	chained.AddAcceptor(&mockAcceptor{resp: NewAcceptResponse()})
+	if resp := chained.Accept(req); !resp.Accept {
+		t.Fatalf("expected channel to be accepted")
+	}
+
+	// Once a rejecting acceptor is added, the channel should be rejected
+	// with its reason.
+	const reason = "no thanks"
+	rejectID := chained.AddAcceptor(
+		&mockAcceptor{resp: NewRejectResponse(reason)},
+	)
+	resp := chained.Accept(req)
+	if resp.Accept {
+		t.Fatalf("expected channel to be rejected")
+	}
+	if resp.Error != reason {
+		t.Fatalf("expected reason %q, got %q", reason, resp.Error)
+	}
+
+	// Removing the rejecting acceptor should result in the channel being
+	// accepted again.
+	chained.RemoveAcceptor(rejectID)
+	if resp := chained.Accept(req); !resp.Accept {
+		t.Fatalf("expected channel to be accepted")
+	}
+}
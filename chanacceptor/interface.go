@@ -0,0 +1,55 @@
+package chanacceptor
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ChannelAcceptRequest is a struct containing the requesting node's public
+// key along with the lnwire.OpenChannel message that they sent when
+// requesting an inbound channel. This information is provided to each
+// acceptor so that they can each leverage their own decision-making with this
+// information.
+type ChannelAcceptRequest struct {
+	// Node is the public key of the node requesting to open a channel.
+	Node *btcec.PublicKey
+
+	// OpenChanMsg is the actual OpenChannel protocol message that the peer
+	// sent to us.
+	OpenChanMsg *lnwire.OpenChannel
+}
+
+// ChannelAcceptResponse is the decision of an acceptor on an inbound channel
+// request.
+type ChannelAcceptResponse struct {
+	// Accept is true if the channel should be accepted.
+	Accept bool
+
+	// Error is the reason for rejecting the channel, which is sent to the
+	// remote peer. If empty, a generic error is sent instead.
+	Error string
+}
+
+// NewAcceptResponse returns a response which accepts the channel.
+func NewAcceptResponse() *ChannelAcceptResponse {
+	return &ChannelAcceptResponse{
+		Accept: true,
+	}
+}
+
+// NewRejectResponse returns a response which rejects the channel for the
+// given reason.
+func NewRejectResponse(reason string) *ChannelAcceptResponse {
+	return &ChannelAcceptResponse{
+		Accept: false,
+		Error:  reason,
+	}
+}
+
+// ChannelAcceptor is an interface that represents a predicate on the data
+// contained in ChannelAcceptRequest.
+type ChannelAcceptor interface {
+	// Accept decides whether the inbound channel described by the request
+	// should be accepted.
+	Accept(req *ChannelAcceptRequest) *ChannelAcceptResponse
+}
@@ -0,0 +1,234 @@
+package chanacceptor
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// errShuttingDown is returned by Run if the acceptor is shutting down.
+	errShuttingDown = errors.New("channel acceptor shutting down")
+
+	// errTimeout is the reason sent to the remote peer if the RPC client
+	// doesn't decide on the channel within the acceptor timeout.
+	errTimeout = errors.New("timed out waiting for channel acceptor")
+)
+
+// chanAcceptInfo pairs an inbound channel request with the channel its
+// decision is sent back on.
+type chanAcceptInfo struct {
+	request  *ChannelAcceptRequest
+	response chan *ChannelAcceptResponse
+}
+
+// RPCAcceptor represents the RPC-controlled variant of the ChannelAcceptor.
+// One RPCAcceptor is created for each client of the ChannelAcceptor RPC,
+// which forwards the inbound channel requests to the client, and waits a
+// limited time for its decision.
+type RPCAcceptor struct {
+	// receive is a function from which we receive the decisions of the
+	// RPC client.
+	receive func() (*lnrpc.ChannelAcceptResponse, error)
+
+	// send is a function which sends inbound channel requests to the RPC
+	// client.
+	send func(*lnrpc.ChannelAcceptRequest) error
+
+	// timeout is the amount of time we allow the RPC client to decide on
+	// an inbound channel request before it is rejected.
+	timeout time.Duration
+
+	// requests is the channel over which inbound channel requests are
+	// handed to the goroutine running the RPC stream.
+	requests chan *chanAcceptInfo
+
+	// timeouts is the channel over which requests that timed out while
+	// waiting for the decision of the RPC client are handed to the
+	// goroutine running the RPC stream, such that they're no longer
+	// tracked.
+	timeouts chan *chanAcceptInfo
+
+	// done is closed once the RPC stream has ended.
+	done chan struct{}
+
+	// quit is closed when the server shuts down.
+	quit <-chan struct{}
+}
+
+// NewRPCAcceptor creates a new RPCAcceptor which communicates with the RPC
+// client using the given receive and send functions. Requests are rejected if
+// the client doesn't respond within the passed timeout.
+func NewRPCAcceptor(receive func() (*lnrpc.ChannelAcceptResponse, error),
+	send func(*lnrpc.ChannelAcceptRequest) error, timeout time.Duration,
+	quit <-chan struct{}) *RPCAcceptor {
+
+	return &RPCAcceptor{
+		receive:  receive,
+		send:     send,
+		timeout:  timeout,
+		requests: make(chan *chanAcceptInfo),
+		timeouts: make(chan *chanAcceptInfo),
+		done:     make(chan struct{}),
+		quit:     quit,
+	}
+}
+
+// Accept is a predicate on the ChannelAcceptRequest which is sent to the RPC
+// client who will respond with the ultimate decision. The request is rejected
+// if the client doesn't respond within the acceptor timeout, or the RPC
+// stream ends in the meantime.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (r *RPCAcceptor) Accept(req *ChannelAcceptRequest) *ChannelAcceptResponse {
+	respChan := make(chan *ChannelAcceptResponse, 1)
+	info := &chanAcceptInfo{
+		request:  req,
+		response: respChan,
+	}
+
+	timeout := time.After(r.timeout)
+
+	select {
+	case r.requests <- info:
+	case <-timeout:
+		return NewRejectResponse(errTimeout.Error())
+	case <-r.done:
+		return NewRejectResponse("")
+	case <-r.quit:
+		return NewRejectResponse("")
+	}
+
+	select {
+	case resp := <-respChan:
+		return resp
+	case <-timeout:
+		log.Warnf("Channel acceptor timed out on channel request "+
+			"with pendingID=%x", req.OpenChanMsg.PendingChannelID[:])
+
+		select {
+		case r.timeouts <- info:
+		case <-r.done:
+		case <-r.quit:
+		}
+
+		return NewRejectResponse(errTimeout.Error())
+	case <-r.done:
+		return NewRejectResponse("")
+	case <-r.quit:
+		return NewRejectResponse("")
+	}
+}
+
+// Run forwards inbound channel requests to the RPC client, and dispatches its
+// decisions to the pending requests they apply to. It blocks until the RPC
+// stream ends, or the server shuts down.
+func (r *RPCAcceptor) Run() error {
+	defer close(r.done)
+
+	// We'll receive the decisions of the client within a goroutine, as
+	// receiving from the stream blocks. The goroutine exits once the
+	// stream ends, which happens as soon as the RPC call returns.
+	responses := make(chan *lnrpc.ChannelAcceptResponse)
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := r.receive()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			select {
+			case responses <- resp:
+			case <-r.done:
+				return
+			}
+		}
+	}()
+
+	// pending maps the pending channel ID of every request we've sent to
+	// the client to the channel its decision is delivered on.
+	pending := make(map[[32]byte]chan *ChannelAcceptResponse)
+
+	for {
+		select {
+		case info := <-r.requests:
+			req := info.request
+			pendingID := req.OpenChanMsg.PendingChannelID
+			if _, ok := pending[pendingID]; ok {
+				info.response <- NewRejectResponse(
+					"duplicate pending channel ID",
+				)
+				continue
+			}
+			pending[pendingID] = info.response
+
+			if err := r.send(newRPCRequest(req)); err != nil {
+				return err
+			}
+
+		case resp := <-responses:
+			var pendingID [32]byte
+			copy(pendingID[:], resp.PendingChanId)
+
+			respChan, ok := pending[pendingID]
+			if !ok {
+				log.Warnf("Received channel acceptor response "+
+					"for unknown pendingID=%x", pendingID[:])
+				continue
+			}
+			delete(pending, pendingID)
+
+			// The response channel is buffered, so this won't
+			// block even if the request has already timed out.
+			respChan <- &ChannelAcceptResponse{
+				Accept: resp.Accept,
+				Error:  resp.Error,
+			}
+
+		// A request timed out, so we'll stop tracking it, unless the
+		// client's decision arrived in the meantime and its pending
+		// channel ID has been reused since.
+		case info := <-r.timeouts:
+			pendingID := info.request.OpenChanMsg.PendingChannelID
+			if pending[pendingID] == info.response {
+				delete(pending, pendingID)
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-r.quit:
+			return errShuttingDown
+		}
+	}
+}
+
+// newRPCRequest converts an inbound channel request to its RPC
+// representation.
+func newRPCRequest(req *ChannelAcceptRequest) *lnrpc.ChannelAcceptRequest {
+	msg := req.OpenChanMsg
+
+	return &lnrpc.ChannelAcceptRequest{
+		NodePubkey:       req.Node.SerializeCompressed(),
+		ChainHash:        msg.ChainHash[:],
+		PendingChanId:    msg.PendingChannelID[:],
+		FundingAmt:       uint64(msg.FundingAmount),
+		PushAmt:          uint64(msg.PushAmount),
+		DustLimit:        uint64(msg.DustLimit),
+		MaxValueInFlight: uint64(msg.MaxValueInFlight),
+		ChannelReserve:   uint64(msg.ChannelReserve),
+		MinHtlc:          uint64(msg.HtlcMinimum),
+		FeePerKw:         uint64(msg.FeePerKiloWeight),
+		CsvDelay:         uint32(msg.CsvDelay),
+		MaxAcceptedHtlcs: uint32(msg.MaxAcceptedHTLCs),
+		Private:          msg.ChannelFlags&lnwire.FFAnnounceChannel == 0,
+	}
+}
+
+// A compile-time constraint to ensure RPCAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*RPCAcceptor)(nil)
@@ -0,0 +1,201 @@
+package chanacceptor
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// mockStream mocks the bi-directional stream of the ChannelAcceptor RPC.
+type mockStream struct {
+	requests  chan *lnrpc.ChannelAcceptRequest
+	responses chan *lnrpc.ChannelAcceptResponse
+}
+
+func newMockStream() *mockStream {
+	return &mockStream{
+		requests:  make(chan *lnrpc.ChannelAcceptRequest),
+		responses: make(chan *lnrpc.ChannelAcceptResponse),
+	}
+}
+
+func (m *mockStream) send(req *lnrpc.ChannelAcceptRequest) error {
+	m.requests <- req
+	return nil
+}
+
+func (m *mockStream) receive() (*lnrpc.ChannelAcceptResponse, error) {
+	resp, ok := <-m.responses
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return resp, nil
+}
+
+// startRPCAcceptor creates and runs an RPCAcceptor on top of a mock stream,
+// returning the error Run exits with over the returned channel.
+func startRPCAcceptor(timeout time.Duration) (*RPCAcceptor, *mockStream,
+	chan error, chan struct{}) {
+
+	stream := newMockStream()
+	quit := make(chan struct{})
+	acceptor := NewRPCAcceptor(stream.receive, stream.send, timeout, quit)
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- acceptor.Run()
+	}()
+
+	return acceptor, stream, runErr, quit
+}
+
+// TestRPCAcceptorDecisions tests that the decisions of the RPC client are
+// returned for the requests they apply to.
+func TestRPCAcceptorDecisions(t *testing.T) {
+	t.Parallel()
+
+	acceptor, stream, _, quit := startRPCAcceptor(time.Minute)
+	defer close(quit)
+
+	tests := []struct {
+		name   string
+		accept bool
+		reason string
+	}{
+		{
+			name:   "accept",
+			accept: true,
+		},
+		{
+			name:   "reject with reason",
+			accept: false,
+			reason: "channel too small for my taste",
+		},
+	}
+
+	for i, test := range tests {
+		req := newTestRequest(t, byte(i))
+
+		respChan := make(chan *ChannelAcceptResponse, 1)
+		go func() {
+			respChan <- acceptor.Accept(req)
+		}()
+
+		var rpcReq *lnrpc.ChannelAcceptRequest
+		select {
+		case rpcReq = <-stream.requests:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%v: request not sent to client", test.name)
+		}
+
+		pendingID := req.OpenChanMsg.PendingChannelID
+		if !bytes.Equal(rpcReq.PendingChanId, pendingID[:]) {
+			t.Fatalf("%v: wrong pending chan id", test.name)
+		}
+		if !bytes.Equal(rpcReq.NodePubkey,
+			req.Node.SerializeCompressed()) {
+
+			t.Fatalf("%v: wrong node pubkey", test.name)
+		}
+		if rpcReq.FundingAmt != uint64(req.OpenChanMsg.FundingAmount) {
+			t.Fatalf("%v: wrong funding amount", test.name)
+		}
+
+		stream.responses <- &lnrpc.ChannelAcceptResponse{
+			Accept:        test.accept,
+			PendingChanId: rpcReq.PendingChanId,
+			Error:         test.reason,
+		}
+
+		select {
+		case resp := <-respChan:
+			if resp.Accept != test.accept {
+				t.Fatalf("%v: expected accept=%v", test.name,
+					test.accept)
+			}
+			if resp.Error != test.reason {
+				t.Fatalf("%v: expected reason %q, got %q",
+					test.name, test.reason, resp.Error)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%v: no decision received", test.name)
+		}
+	}
+}
+
+// TestRPCAcceptorTimeout tests that a request is rejected if the RPC client
+// doesn't respond within the timeout, after which it's no longer tracked.
+func TestRPCAcceptorTimeout(t *testing.T) {
+	t.Parallel()
+
+	acceptor, stream, _, quit := startRPCAcceptor(50 * time.Millisecond)
+	defer close(quit)
+
+	respChan := make(chan *ChannelAcceptResponse, 1)
+	go func() {
+		respChan <- acceptor.Accept(newTestRequest(t, 1))
+	}()
+
+	// The client receives the request, but never responds.
+	select {
+	case <-stream.requests:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("request not sent to client")
+	}
+
+	select {
+	case resp := <-respChan:
+		if resp.Accept {
+			t.Fatalf("expected channel to be rejected")
+		}
+		if resp.Error != errTimeout.Error() {
+			t.Fatalf("expected timeout reason, got %q", resp.Error)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("request didn't time out")
+	}
+
+	// As the timed out request is no longer pending, a new request with
+	// the same pending channel ID should be sent to the client, rather
+	// than being rejected as a duplicate.
+	go func() {
+		respChan <- acceptor.Accept(newTestRequest(t, 1))
+	}()
+
+	select {
+	case <-stream.requests:
+	case resp := <-respChan:
+		t.Fatalf("request not sent to client: %q", resp.Error)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("request not sent to client")
+	}
+}
+
+// TestRPCAcceptorStreamEnd tests that Run exits once the stream ends, after
+// which any request is rejected.
+func TestRPCAcceptorStreamEnd(t *testing.T) {
+	t.Parallel()
+
+	acceptor, stream, runErr, quit := startRPCAcceptor(time.Minute)
+	defer close(quit)
+
+	close(stream.responses)
+
+	select {
+	case err := <-runErr:
+		if err != io.EOF {
+			t.Fatalf("expected io.EOF, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("acceptor didn't exit")
+	}
+
+	resp := acceptor.Accept(newTestRequest(t, 1))
+	if resp.Accept {
+		t.Fatalf("expected channel to be rejected")
+	}
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -25,6 +28,12 @@ var (
 	// ErrInvalidState is returned when the closing state machine receives
 	// a message while it is in an unknown state.
 	ErrInvalidState = fmt.Errorf("invalid state")
+
+	// ErrUpfrontShutdownScriptMismatch is returned when the remote party
+	// sends a shutdown message with a delivery script that differs from
+	// the script it committed to when the channel was opened.
+	ErrUpfrontShutdownScriptMismatch = fmt.Errorf("shutdown script does " +
+		"not match upfront shutdown script")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -82,6 +91,10 @@ type chanCloseCfg struct {
 	// forward payments.
 	disableChannel func(wire.OutPoint) error
 
+	// putResolverReport persists the resolution report of the output of
+	// the closing transaction that pays out our settled funds.
+	putResolverReport func(*channeldb.ResolverReport) error
+
 	// quit is a channel that should be sent upon in the occasion the state
 	// machine should cease all progress and shutdown.
 	quit chan struct{}
@@ -116,6 +129,11 @@ type channelCloser struct {
 	// offer when starting negotiation. This will be used as a baseline.
 	idealFeeSat btcutil.Amount
 
+	// maxFeeSat is the highest fee we're willing to pay for the closing
+	// transaction. Any fee proposal of the remote party above it is
+	// rejected. If zero, any fee is accepted.
+	maxFeeSat btcutil.Amount
+
 	// lastFeeProposal is the last fee that we proposed to the remote
 	// party. We'll use this as a pivot point to rachet our next offer up,
 	// or down, or simply accept the remote party's prior offer.
@@ -147,10 +165,12 @@ type channelCloser struct {
 }
 
 // newChannelCloser creates a new instance of the channel closure given the
-// passed configuration, and delivery+fee preference. The final argument should
-// only be populated iff, we're the initiator of this closing request.
+// passed configuration, and delivery+fee preference. A zero maxFeePerKw
+// doesn't limit the negotiated fee. The final argument should only be
+// populated iff, we're the initiator of this closing request.
 func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
-	idealFeePerKw lnwallet.SatPerKWeight, negotiationHeight uint32,
+	idealFeePerKw, maxFeePerKw lnwallet.SatPerKWeight,
+	negotiationHeight uint32,
 	closeReq *htlcswitch.ChanClose) *channelCloser {
 
 	// Given the target fee-per-kw, we'll compute what our ideal _total_
@@ -173,6 +193,20 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 		idealFeeSat = channelCommitFee
 	}
 
+	// Similarly, our ideal fee may not exceed the maximum fee we're willing
+	// to pay, if any.
+	var maxFeeSat btcutil.Amount
+	if maxFeePerKw != 0 {
+		maxFeeSat = cfg.channel.CalcFee(maxFeePerKw)
+		if idealFeeSat > maxFeeSat {
+			peerLog.Infof("Ideal starting fee of %v is greater "+
+				"than max fee of %v, clamping",
+				int64(idealFeeSat), int64(maxFeeSat))
+
+			idealFeeSat = maxFeeSat
+		}
+	}
+
 	peerLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v sat",
 		cfg.channel.ChannelPoint(), int64(idealFeeSat))
 
@@ -185,6 +219,7 @@ func newChannelCloser(cfg chanCloseCfg, deliveryScript []byte,
 		cfg:                 cfg,
 		negotiationHeight:   negotiationHeight,
 		idealFeeSat:         idealFeeSat,
+		maxFeeSat:           maxFeeSat,
 		localDeliveryScript: deliveryScript,
 		priorFeeOffers:      make(map[btcutil.Amount]*lnwire.ClosingSigned),
 	}
@@ -262,6 +297,32 @@ func (c *channelCloser) CloseRequest() *htlcswitch.ChanClose {
 	return c.closeReq
 }
 
+// reportDeliveryOutput persists the resolution report of the output of the
+// closing transaction that pays to our delivery script. If our settled
+// balance was dust, there's no such output, and nothing is reported.
+func (c *channelCloser) reportDeliveryOutput(closeTx *wire.MsgTx) error {
+	for i, txOut := range closeTx.TxOut {
+		if !bytes.Equal(txOut.PkScript, c.localDeliveryScript) {
+			continue
+		}
+
+		closeTxid := closeTx.TxHash()
+		return c.cfg.putResolverReport(&channeldb.ResolverReport{
+			OutPoint: wire.OutPoint{
+				Hash:  closeTxid,
+				Index: uint32(i),
+			},
+			OutputType:    channeldb.ResolverOutputCommit,
+			Amount:        btcutil.Amount(txOut.Value),
+			Outcome:       channeldb.ResolverOutcomeClaimed,
+			SweepTxid:     &closeTxid,
+			SweepPkScript: c.localDeliveryScript,
+		})
+	}
+
+	return nil
+}
+
 // ProcessCloseMsg attempts to process the next message in the closing series.
 // This method will update the state accordingly and return two primary values:
 // the next set of messages to be sent, and a bool indicating if the fee
@@ -282,6 +343,13 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// If the other party committed to a delivery address when the
+		// channel was opened, we'll ensure they're paying to it.
+		err := c.checkRemoteDeliveryScript(shutDownMsg.Address)
+		if err != nil {
+			return nil, false, err
+		}
+
 		// Next, we'll note the other party's preference for their
 		// delivery address. We'll use this when we craft the closure
 		// transaction.
@@ -332,6 +400,13 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				"instead have %v", spew.Sdump(msg))
 		}
 
+		// If the other party committed to a delivery address when the
+		// channel was opened, we'll ensure they're paying to it.
+		err := c.checkRemoteDeliveryScript(shutDownMsg.Address)
+		if err != nil {
+			return nil, false, err
+		}
+
 		// Now that we know this is a valid shutdown message, we'll
 		// record their preferred delivery closing script.
 		c.remoteDeliveryScript = shutDownMsg.Address
@@ -376,6 +451,7 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 		// during the negotiations, if it doesn't match any of our
 		// prior offers, then we'll attempt to rachet the fee closer to
 		remoteProposedFee := closeSignedMsg.FeeSatoshis
+		c.notifyFeeProposal(remoteProposedFee, false)
 		if _, ok := c.priorFeeOffers[remoteProposedFee]; !ok {
 			// We'll now attempt to rachet towards a fee deemed
 			// acceptable by both parties, factoring in our ideal
@@ -385,6 +461,19 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 				remoteProposedFee,
 			)
 
+			// We'll never propose, and therefore never accept, a
+			// fee above our maximum fee. Instead, we'll counter
+			// with the maximum fee, in the hope that the remote
+			// party comes down to it.
+			if c.maxFeeSat != 0 && feeProposal > c.maxFeeSat {
+				peerLog.Infof("ChannelPoint(%v): rejecting "+
+					"fee proposal of %v, max fee is %v",
+					c.chanPoint, int64(remoteProposedFee),
+					int64(c.maxFeeSat))
+
+				feeProposal = c.maxFeeSat
+			}
+
 			// With our new fee proposal calculated, we'll craft a
 			// new close signed signature to send to the other
 			// party so we can continue the fee negotiation
@@ -440,6 +529,12 @@ func (c *channelCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message, b
 			return nil, false, err
 		}
 
+		// We'll record where our settled funds are paid out to, such
+		// that the destination is reported along with the channel.
+		if err := c.reportDeliveryOutput(closeTx); err != nil {
+			return nil, false, err
+		}
+
 		// We'll attempt to disable the channel in the background to
 		// avoid blocking due to sending the update message to all
 		// active peers.
@@ -512,9 +607,69 @@ func (c *channelCloser) proposeCloseSigned(fee btcutil.Amount) (*lnwire.ClosingS
 	// accepts our offer. This way, we don't have to re-sign.
 	c.priorFeeOffers[fee] = closeSignedMsg
 
+	c.notifyFeeProposal(fee, true)
+
 	return closeSignedMsg, nil
 }
 
+// numFinalCloseUpdates is the number of updates sent to the local subsystem
+// that requested a channel closure once the negotiation has completed: one
+// for the broadcast closing transaction, and one once it confirms.
+const numFinalCloseUpdates = 2
+
+// notifyFeeProposal sends a fee proposal made by either party during the fee
+// negotiation to the local subsystem that requested the channel closure, if
+// any, so it can follow the negotiation.
+func (c *channelCloser) notifyFeeProposal(fee btcutil.Amount, local bool) {
+	if c.closeReq == nil {
+		return
+	}
+
+	update := &lnrpc.CloseStatusUpdate{
+		Update: &lnrpc.CloseStatusUpdate_FeeUpdate{
+			FeeUpdate: &lnrpc.ClosingFeeUpdate{
+				FeeSat: int64(fee),
+				Local:  local,
+			},
+		},
+	}
+
+	// The update is sent from the goroutine handling all channels of the
+	// peer, so we can't block on the subsystem consuming the updates.
+	// Instead, we'll drop fee proposals once it falls behind, always
+	// leaving room for the final updates of the closure. As we're the only
+	// one sending on the channel during the negotiation, the send below
+	// won't block.
+	updates := c.closeReq.Updates
+	if len(updates) >= cap(updates)-numFinalCloseUpdates {
+		peerLog.Debugf("ChannelPoint(%v): dropping fee proposal of %v, "+
+			"close updates not consumed", c.chanPoint, int64(fee))
+		return
+	}
+
+	updates <- update
+}
+
+// checkRemoteDeliveryScript ensures that the delivery script sent by the
+// remote party within its shutdown message matches the upfront shutdown script
+// it committed to when opening the channel, if any.
+func (c *channelCloser) checkRemoteDeliveryScript(script []byte) error {
+	upfrontScript := c.cfg.channel.State().RemoteShutdownScript
+	if len(upfrontScript) == 0 {
+		return nil
+	}
+
+	if !bytes.Equal(upfrontScript, script) {
+		peerLog.Warnf("ChannelPoint(%v): remote shutdown script %x "+
+			"doesn't match upfront shutdown script %x",
+			c.chanPoint, script, upfrontScript)
+
+		return ErrUpfrontShutdownScriptMismatch
+	}
+
+	return nil
+}
+
 // feeInAcceptableRange returns true if the passed remote fee is deemed to be
 // in an "acceptable" range to our local fee. This is an attempt at a
 // compromise and to ensure that the fee negotiation has a stopping point. We
@@ -33,6 +33,17 @@ var (
 	// TODO(roasbeef): flesh out comment
 	openChannelBucket = []byte("open-chan-bucket")
 
+	// historicalChannelBucket stores the state of channels that have been
+	// closed, but have yet to be fully resolved. As the transaction that
+	// closed such a channel may still be reorged out of the chain, we'll
+	// need the state to handle a close of the channel within the new
+	// chain. Within this bucket, each channel has a nested bucket keyed by
+	// its chanPoint, which holds the same keys as its bucket did within
+	// the open channel bucket.
+	//
+	// historicalChan -> chanPoint
+	historicalChannelBucket = []byte("historical-chan-bucket")
+
 	// chanInfoKey can be accessed within the bucket for a channel
 	// (identified by its chanPoint). This key stores all the static
 	// information for a channel which is decided at the end of  the
@@ -55,6 +66,23 @@ var (
 	// remote peer during a channel sync in case we have lost channel state.
 	dataLossCommitPointKey = []byte("data-loss-commit-point-key")
 
+	// localUpfrontShutdownKey can be accessed within the bucket for a
+	// channel (identified by its chanPoint). This key stores the optional
+	// upfront shutdown script committed to by the local party when the
+	// channel was opened.
+	localUpfrontShutdownKey = []byte("local-upfront-shutdown-key")
+
+	// remoteUpfrontShutdownKey can be accessed within the bucket for a
+	// channel (identified by its chanPoint). This key stores the optional
+	// upfront shutdown script committed to by the remote party when the
+	// channel was opened.
+	remoteUpfrontShutdownKey = []byte("remote-upfront-shutdown-key")
+
+	// aliasShortChanIDKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the alias short
+	// channel ID of zero-conf channels.
+	aliasShortChanIDKey = []byte("alias-short-chan-id-key")
+
 	// commitDiffKey stores the current pending commitment state we've
 	// extended to the remote party (if any). Each time we propose a new
 	// state, we store the information necessary to reconstruct this state
@@ -450,9 +478,26 @@ type OpenChannel struct {
 	// is found to be pending.
 	//
 	// NOTE: This value will only be populated for single-funder channels
-	// for which we are the initiator.
+	// for which we are the initiator, and for dual-funder channels.
 	FundingTxn *wire.MsgTx
 
+	// LocalShutdownScript is the script the local party committed to pay
+	// its funds to upon a cooperative close when the channel was opened.
+	// If empty, no upfront shutdown script was set.
+	LocalShutdownScript lnwire.DeliveryAddress
+
+	// RemoteShutdownScript is the script the remote party committed to
+	// pay its funds to upon a cooperative close when the channel was
+	// opened. If empty, no upfront shutdown script was set.
+	RemoteShutdownScript lnwire.DeliveryAddress
+
+	// AliasShortChanID is the locally assigned alias short channel ID of a
+	// zero-conf channel, under which the channel is used until its funding
+	// transaction confirms. Forwarding packages of the channel remain
+	// keyed by the alias, even once the confirmed ShortChannelID is known.
+	// This is the zero value for channels that aren't zero-conf.
+	AliasShortChanID lnwire.ShortChannelID
+
 	// TODO(roasbeef): eww
 	Db *DB
 
@@ -487,6 +532,23 @@ func (c *OpenChannel) ChanStatus() ChannelStatus {
 	return c.chanStatus
 }
 
+// IsZeroConf returns true if the channel was used before its funding
+// transaction confirmed, under a locally assigned alias short channel ID.
+func (c *OpenChannel) IsZeroConf() bool {
+	return c.AliasShortChanID != lnwire.ShortChannelID{}
+}
+
+// packagerSource returns the short channel ID the forwarding packages of the
+// channel are keyed by. For zero-conf channels this is the alias, as HTLCs may
+// have been forwarded before the confirmed short channel ID was known.
+func (c *OpenChannel) packagerSource() lnwire.ShortChannelID {
+	if c.IsZeroConf() {
+		return c.AliasShortChanID
+	}
+
+	return c.ShortChannelID
+}
+
 // RefreshShortChanID updates the in-memory short channel ID using the latest
 // value observed on disk.
 func (c *OpenChannel) RefreshShortChanID() error {
@@ -516,17 +578,54 @@ func (c *OpenChannel) RefreshShortChanID() error {
 	}
 
 	c.ShortChannelID = sid
-	c.Packager = NewChannelPackager(sid)
+	c.Packager = NewChannelPackager(c.packagerSource())
 
 	return nil
 }
 
 // fetchChanBucket is a helper function that returns the bucket where a
 // channel's data resides in given: the public key for the node, the outpoint,
-// and the chainhash that the channel resides on.
+// and the chainhash that the channel resides on. Within read-only
+// transactions, the state of a closed channel that has yet to be fully
+// resolved is returned if the channel is no longer open.
 func fetchChanBucket(tx *bolt.Tx, nodeKey *btcec.PublicKey,
 	outPoint *wire.OutPoint, chainHash chainhash.Hash) (*bolt.Bucket, error) {
 
+	chanBucket, err := fetchOpenChanBucket(
+		tx, nodeKey, outPoint, chainHash,
+	)
+	switch {
+	// If the channel is no longer open, then it may have been closed by a
+	// transaction that has yet to be fully resolved. The state of such a
+	// channel has been kept within the historical channel bucket, and
+	// remains available to read-only transactions, so the channel can
+	// still be acted upon if its closing transaction is reorged out of the
+	// chain.
+	case !tx.Writable() && (err == ErrNoChanDBExists ||
+		err == ErrNoActiveChannels || err == ErrChannelNotFound):
+
+		historicalBucket, histErr := fetchHistoricalChanBucket(
+			tx, outPoint,
+		)
+		if histErr != nil {
+			return nil, err
+		}
+
+		return historicalBucket, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	return chanBucket, nil
+}
+
+// fetchOpenChanBucket returns the bucket within the open channel bucket where
+// a channel's data resides in given: the public key for the node, the
+// outpoint, and the chainhash that the channel resides on.
+func fetchOpenChanBucket(tx *bolt.Tx, nodeKey *btcec.PublicKey,
+	outPoint *wire.OutPoint, chainHash chainhash.Hash) (*bolt.Bucket, error) {
+
 	// First fetch the top level bucket which stores all data related to
 	// current, active channels.
 	openChanBucket := tx.Bucket(openChannelBucket)
@@ -563,6 +662,29 @@ func fetchChanBucket(tx *bolt.Tx, nodeKey *btcec.PublicKey,
 	return chanBucket, nil
 }
 
+// fetchHistoricalChanBucket is a helper function that returns the bucket
+// within the historical channel bucket where the state of the channel with
+// the given outpoint has been kept after it was closed.
+func fetchHistoricalChanBucket(tx *bolt.Tx,
+	outPoint *wire.OutPoint) (*bolt.Bucket, error) {
+
+	historicalBucket := tx.Bucket(historicalChannelBucket)
+	if historicalBucket == nil {
+		return nil, ErrChannelNotFound
+	}
+
+	var chanPointBuf bytes.Buffer
+	if err := writeOutpoint(&chanPointBuf, outPoint); err != nil {
+		return nil, err
+	}
+	chanBucket := historicalBucket.Bucket(chanPointBuf.Bytes())
+	if chanBucket == nil {
+		return nil, ErrChannelNotFound
+	}
+
+	return chanBucket, nil
+}
+
 // fullSync is an internal version of the FullSync method which allows callers
 // to sync the contents of an OpenChannel while re-using an existing database
 // transaction.
@@ -635,7 +757,45 @@ func (c *OpenChannel) MarkAsOpen(openLoc lnwire.ShortChannelID) error {
 
 	c.IsPending = false
 	c.ShortChannelID = openLoc
-	c.Packager = NewChannelPackager(openLoc)
+	c.Packager = NewChannelPackager(c.packagerSource())
+
+	return nil
+}
+
+// MarkZeroConfOpen marks a zero-conf channel as open before its funding
+// transaction has confirmed. Until the channel is marked as open with its
+// confirmed location through MarkAsOpen, the passed alias is used as its short
+// channel ID.
+func (c *OpenChannel) MarkZeroConfOpen(alias lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.IsPending = false
+		channel.ShortChannelID = alias
+		channel.AliasShortChanID = alias
+
+		return putOpenChannel(chanBucket, channel)
+	}); err != nil {
+		return err
+	}
+
+	c.IsPending = false
+	c.ShortChannelID = alias
+	c.AliasShortChanID = alias
+	c.Packager = NewChannelPackager(alias)
 
 	return nil
 }
@@ -822,7 +982,7 @@ func fetchOpenChannel(chanBucket *bolt.Bucket,
 		return nil, fmt.Errorf("unable to fetch chan revocations: %v", err)
 	}
 
-	channel.Packager = NewChannelPackager(channel.ShortChannelID)
+	channel.Packager = NewChannelPackager(channel.packagerSource())
 
 	return channel, nil
 }
@@ -1880,6 +2040,30 @@ func (c *OpenChannel) CloseChannel(summary *ChannelCloseSummary) error {
 			return err
 		}
 
+		// If the channel has yet to be fully resolved, then we'll
+		// keep its state around until it is, as we'll need it to
+		// handle a close of the channel within a new chain if the
+		// closing transaction is reorged out of the current one.
+		if summary.IsPending {
+			historicalBucket, err := tx.CreateBucketIfNotExists(
+				historicalChannelBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			histChanBucket, err := historicalBucket.CreateBucket(
+				chanPointBuf.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+			err = copyBucket(histChanBucket, chanBucket)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Now that the index to this channel has been deleted, purge
 		// the remaining channel metadata from the database.
 		err = deleteOpenChannel(chanBucket, chanPointBuf.Bytes())
@@ -2152,6 +2336,14 @@ func writeChanConfig(b io.Writer, c *ChannelConfig) error {
 	)
 }
 
+// hasFundingTxn returns true if the full funding transaction of the channel is
+// stored along with it, which is only the case if we're able to broadcast it:
+// either we solely funded the channel, or we contributed to it.
+func (c *OpenChannel) hasFundingTxn() bool {
+	return (c.ChanType == SingleFunder && c.IsInitiator) ||
+		c.ChanType == DualFunder
+}
+
 func putChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 	var w bytes.Buffer
 	if err := WriteElements(&w,
@@ -2165,8 +2357,9 @@ func putChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// For single funder channels that we initiated, write the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	// For single funder channels that we initiated, as well as dual funder
+	// channels, write the funding txn.
+	if channel.hasFundingTxn() {
 		if err := WriteElement(&w, channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2179,7 +2372,64 @@ func putChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	return chanBucket.Put(chanInfoKey, w.Bytes())
+	if err := chanBucket.Put(chanInfoKey, w.Bytes()); err != nil {
+		return err
+	}
+
+	// The upfront shutdown scripts are optional, so they're stored under
+	// their own keys to remain compatible with existing channels.
+	err := putOptionalUpfrontShutdownScript(
+		chanBucket, localUpfrontShutdownKey, channel.LocalShutdownScript,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = putOptionalUpfrontShutdownScript(
+		chanBucket, remoteUpfrontShutdownKey,
+		channel.RemoteShutdownScript,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Likewise, the alias short channel ID is only stored for zero-conf
+	// channels.
+	if !channel.IsZeroConf() {
+		return nil
+	}
+
+	var alias [8]byte
+	byteOrder.PutUint64(alias[:], channel.AliasShortChanID.ToUint64())
+
+	return chanBucket.Put(aliasShortChanIDKey, alias[:])
+}
+
+// putOptionalUpfrontShutdownScript stores the given upfront shutdown script
+// under the passed key, if it is set.
+func putOptionalUpfrontShutdownScript(chanBucket *bolt.Bucket, key []byte,
+	script lnwire.DeliveryAddress) error {
+
+	if len(script) == 0 {
+		return nil
+	}
+
+	return chanBucket.Put(key, script)
+}
+
+// getOptionalUpfrontShutdownScript reads the upfront shutdown script stored
+// under the passed key, returning nil if no script was set.
+func getOptionalUpfrontShutdownScript(chanBucket *bolt.Bucket,
+	key []byte) lnwire.DeliveryAddress {
+
+	script := chanBucket.Get(key)
+	if script == nil {
+		return nil
+	}
+
+	// The returned slice is only valid during the transaction, so we'll
+	// copy it.
+	return append(lnwire.DeliveryAddress(nil), script...)
 }
 
 func serializeChanCommit(w io.Writer, c *ChannelCommitment) error {
@@ -2275,8 +2525,9 @@ func fetchChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	// For single funder channels that we initiated, read the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	// For single funder channels that we initiated, as well as dual funder
+	// channels, read the funding txn.
+	if channel.hasFundingTxn() {
 		if err := ReadElement(r, &channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2289,7 +2540,20 @@ func fetchChanInfo(chanBucket *bolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
-	channel.Packager = NewChannelPackager(channel.ShortChannelID)
+	channel.LocalShutdownScript = getOptionalUpfrontShutdownScript(
+		chanBucket, localUpfrontShutdownKey,
+	)
+	channel.RemoteShutdownScript = getOptionalUpfrontShutdownScript(
+		chanBucket, remoteUpfrontShutdownKey,
+	)
+
+	if alias := chanBucket.Get(aliasShortChanIDKey); len(alias) == 8 {
+		channel.AliasShortChanID = lnwire.NewShortChanIDFromInt(
+			byteOrder.Uint64(alias),
+		)
+	}
+
+	channel.Packager = NewChannelPackager(channel.packagerSource())
 
 	return nil
 }
@@ -2378,6 +2642,16 @@ func deleteOpenChannel(chanBucket *bolt.Bucket, chanPointBytes []byte) error {
 		return err
 	}
 
+	if err := chanBucket.Delete(localUpfrontShutdownKey); err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(remoteUpfrontShutdownKey); err != nil {
+		return err
+	}
+	if err := chanBucket.Delete(aliasShortChanIDKey); err != nil {
+		return err
+	}
+
 	err := chanBucket.Delete(append(chanCommitmentKey, byte(0x00)))
 	if err != nil {
 		return err
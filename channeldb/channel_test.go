@@ -241,9 +241,23 @@ func createTestChannelState(cdb *DB) (*OpenChannel, error) {
 		Db:                      cdb,
 		Packager:                NewChannelPackager(chanID),
 		FundingTxn:              testTx,
+		LocalShutdownScript:     testUpfrontScript(0x01),
+		RemoteShutdownScript:    testUpfrontScript(0x02),
 	}, nil
 }
 
+// testUpfrontScript returns a p2wkh upfront shutdown script filled with the
+// given byte.
+func testUpfrontScript(b byte) lnwire.DeliveryAddress {
+	script := make(lnwire.DeliveryAddress, 22)
+	script[1] = 0x14
+	for i := 2; i < len(script); i++ {
+		script[i] = b
+	}
+
+	return script
+}
+
 func TestOpenChannelPutGetDelete(t *testing.T) {
 	t.Parallel()
 
@@ -852,6 +866,179 @@ func TestFetchClosedChannels(t *testing.T) {
 	}
 }
 
+// TestUpdateClosedChannel tests that the close summary of a closed channel
+// can be replaced, as is done once the channel is closed by a different
+// transaction after its closing transaction is reorged out of the chain.
+func TestUpdateClosedChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	// Updating the close summary of a channel that hasn't been closed
+	// should fail.
+	summary := &ChannelCloseSummary{
+		ChanPoint:      state.FundingOutpoint,
+		ClosingTXID:    rev,
+		RemotePub:      state.IdentityPub,
+		Capacity:       state.Capacity,
+		SettledBalance: state.LocalCommitment.LocalBalance.ToSatoshis(),
+		CloseType:      RemoteForceClose,
+		IsPending:      true,
+	}
+	err = cdb.UpdateClosedChannel(summary)
+	if err != ErrClosedChannelNotFound {
+		t.Fatalf("expected ErrClosedChannelNotFound, got %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+	if err := state.CloseChannel(summary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	// We'll now replace the close summary with one for a local force
+	// close. The revocation state and local channel config, which are
+	// populated from the channel state, should be carried over.
+	newSummary := &ChannelCloseSummary{
+		ChanPoint:         state.FundingOutpoint,
+		ClosingTXID:       chainhash.Hash{0x01},
+		RemotePub:         state.IdentityPub,
+		Capacity:          state.Capacity,
+		CloseHeight:       100,
+		SettledBalance:    state.LocalCommitment.LocalBalance.ToSatoshis(),
+		TimeLockedBalance: state.LocalCommitment.LocalBalance.ToSatoshis(),
+		CloseType:         LocalForceClose,
+		IsPending:         true,
+	}
+	if err := cdb.UpdateClosedChannel(newSummary); err != nil {
+		t.Fatalf("unable to update closed channel: %v", err)
+	}
+
+	closed, err := cdb.FetchClosedChannel(&state.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch closed channel: %v", err)
+	}
+	if !reflect.DeepEqual(newSummary, closed) {
+		t.Fatalf("database summaries don't match: expected %v got %v",
+			spew.Sdump(newSummary), spew.Sdump(closed))
+	}
+	if !reflect.DeepEqual(closed.LocalChanConfig, state.LocalChanCfg) {
+		t.Fatalf("expected local channel config to be carried over")
+	}
+}
+
+// TestHistoricalChannel asserts that the state of a channel that's pending
+// close is kept around until the channel is fully closed.
+func TestHistoricalChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 101); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// An open channel has no historical state.
+	_, err = cdb.FetchHistoricalChannel(&state.FundingOutpoint)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got %v", err)
+	}
+
+	openChannels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channel: %v", err)
+	}
+	openState := openChannels[0]
+
+	summary := &ChannelCloseSummary{
+		ChanPoint:      state.FundingOutpoint,
+		ClosingTXID:    rev,
+		RemotePub:      state.IdentityPub,
+		Capacity:       state.Capacity,
+		SettledBalance: state.LocalCommitment.LocalBalance.ToSatoshis(),
+		CloseType:      RemoteForceClose,
+		IsPending:      true,
+	}
+	if err := state.CloseChannel(summary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	// Once the channel is pending close, its state as of the time of the
+	// close should be found.
+	histState, err := cdb.FetchHistoricalChannel(&state.FundingOutpoint)
+	if err != nil {
+		t.Fatalf("unable to fetch historical channel: %v", err)
+	}
+	if !reflect.DeepEqual(openState, histState) {
+		t.Fatalf("channel state doesn't match:: %v vs %v",
+			spew.Sdump(openState), spew.Sdump(histState))
+	}
+
+	// The state should also be readable through the channel itself, even
+	// though it's no longer open.
+	localCommit, _, err := state.LatestCommitments()
+	if err != nil {
+		t.Fatalf("unable to fetch latest commitments: %v", err)
+	}
+	if !reflect.DeepEqual(*localCommit, openState.LocalCommitment) {
+		t.Fatalf("local commitment doesn't match:: %v vs %v",
+			spew.Sdump(openState.LocalCommitment),
+			spew.Sdump(localCommit))
+	}
+
+	// The historical state shouldn't make the channel appear to be open,
+	// nor should it be possible to update it.
+	openChannels, err = cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(openChannels) != 0 {
+		t.Fatalf("expected no open channels, found %v",
+			len(openChannels))
+	}
+	if err := state.MarkBorked(); err == nil {
+		t.Fatalf("closed channel shouldn't be updated")
+	}
+
+	// Once the channel is fully closed, its state is no longer needed.
+	if err := cdb.MarkChanFullyClosed(&state.FundingOutpoint); err != nil {
+		t.Fatalf("unable to mark channel fully closed: %v", err)
+	}
+	_, err = cdb.FetchHistoricalChannel(&state.FundingOutpoint)
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected ErrChannelNotFound, got %v", err)
+	}
+	if _, _, err := state.LatestCommitments(); err == nil {
+		t.Fatalf("fully closed channel shouldn't have commitments")
+	}
+}
+
 // TestRefreshShortChanID asserts that RefreshShortChanID updates the in-memory
 // short channel ID of another OpenChannel to reflect a preceding call to
 // MarkOpen on a different OpenChannel.
@@ -953,3 +1140,88 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestZeroConfChannel tests that a zero-conf channel is used under its alias
+// short channel ID until it's marked as open with its confirmed location, and
+// that its forwarding packages remain keyed by the alias.
+func TestZeroConfChannel(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+
+	addr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 18555,
+	}
+	if err := state.SyncPending(addr, 99); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+	if state.IsZeroConf() {
+		t.Fatalf("channel without alias shouldn't be zero-conf")
+	}
+
+	alias := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasScidStartHeight,
+		TxIndex:     1,
+		TxPosition:  2,
+	}
+	if err := state.MarkZeroConfOpen(alias); err != nil {
+		t.Fatalf("unable to mark channel as zero-conf open: %v", err)
+	}
+
+	// The channel should now be open under its alias.
+	openChans, err := cdb.FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(openChans) != 1 {
+		t.Fatalf("expected 1 open channel, got %v", len(openChans))
+	}
+	channel := openChans[0]
+	if !channel.IsZeroConf() {
+		t.Fatalf("channel should be zero-conf")
+	}
+	if channel.ShortChanID() != alias {
+		t.Fatalf("expected short chan id %v, got %v", alias,
+			channel.ShortChanID())
+	}
+
+	// Once the channel is marked as open with its confirmed location, the
+	// alias should be retained for its forwarding packages.
+	confirmed := lnwire.ShortChannelID{
+		BlockHeight: 5,
+		TxIndex:     10,
+		TxPosition:  2,
+	}
+	if err := channel.MarkAsOpen(confirmed); err != nil {
+		t.Fatalf("unable to mark channel as open: %v", err)
+	}
+
+	openChans, err = cdb.FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	channel = openChans[0]
+	if channel.ShortChanID() != confirmed {
+		t.Fatalf("expected short chan id %v, got %v", confirmed,
+			channel.ShortChanID())
+	}
+	if channel.AliasShortChanID != alias {
+		t.Fatalf("expected alias %v, got %v", alias,
+			channel.AliasShortChanID)
+	}
+	packager := channel.Packager.(*ChannelPackager)
+	if packager.source != alias {
+		t.Fatalf("expected forwarding packages keyed by %v, got %v",
+			alias, packager.source)
+	}
+}
@@ -172,6 +172,16 @@ func WriteElement(w io.Writer, element interface{}) error {
 			return err
 		}
 
+	case ResolverOutputType:
+		if err := binary.Write(w, byteOrder, e); err != nil {
+			return err
+		}
+
+	case ResolverOutcome:
+		if err := binary.Write(w, byteOrder, e); err != nil {
+			return err
+		}
+
 	case lnwire.FundingFlag:
 		if err := binary.Write(w, byteOrder, e); err != nil {
 			return err
@@ -367,6 +377,16 @@ func ReadElement(r io.Reader, element interface{}) error {
 			return err
 		}
 
+	case *ResolverOutputType:
+		if err := binary.Read(r, byteOrder, e); err != nil {
+			return err
+		}
+
+	case *ResolverOutcome:
+		if err := binary.Read(r, byteOrder, e); err != nil {
+			return err
+		}
+
 	case *lnwire.FundingFlag:
 		if err := binary.Read(r, byteOrder, e); err != nil {
 			return err
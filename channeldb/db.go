@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
+	"github.com/lightningnetwork/lnd/lnwire"
 )
 
 const (
@@ -149,6 +150,16 @@ func (d *DB) Wipe() error {
 			return err
 		}
 
+		err = tx.DeleteBucket(historicalChannelBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
+		err = tx.DeleteBucket(closeReportBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
 		err = tx.DeleteBucket(invoiceBucket)
 		if err != nil && err != bolt.ErrBucketNotFound {
 			return err
@@ -204,6 +215,12 @@ func createChannelDB(dbPath string) error {
 		if _, err := tx.CreateBucket(closedChannelBucket); err != nil {
 			return err
 		}
+		if _, err := tx.CreateBucket(historicalChannelBucket); err != nil {
+			return err
+		}
+		if _, err := tx.CreateBucket(closeReportBucket); err != nil {
+			return err
+		}
 
 		if _, err := tx.CreateBucket(forwardingLogBucket); err != nil {
 			return err
@@ -251,6 +268,12 @@ func createChannelDB(dbPath string) error {
 		if _, err := edges.CreateBucket(channelPointBucket); err != nil {
 			return err
 		}
+		if _, err := edges.CreateBucket(zombieBucket); err != nil {
+			return err
+		}
+		if _, err := edges.CreateBucket(splicedEdgeBucket); err != nil {
+			return err
+		}
 
 		graphMeta, err := tx.CreateBucket(graphMetaBucket)
 		if err != nil {
@@ -434,6 +457,26 @@ func (d *DB) FetchAllOpenChannels() ([]*OpenChannel, error) {
 	return fetchChannels(d, false, false)
 }
 
+// FetchZeroConfChannel returns the open zero-conf channel which was assigned
+// the given alias short channel ID. If no such channel exists,
+// ErrChannelNotFound is returned.
+func (d *DB) FetchZeroConfChannel(
+	alias lnwire.ShortChannelID) (*OpenChannel, error) {
+
+	channels, err := d.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, channel := range channels {
+		if channel.IsZeroConf() && channel.AliasShortChanID == alias {
+			return channel, nil
+		}
+	}
+
+	return nil, ErrChannelNotFound
+}
+
 // FetchPendingChannels will return channels that have completed the process of
 // generating and broadcasting funding transactions, but whose funding
 // transactions have yet to be confirmed on the blockchain.
@@ -609,6 +652,76 @@ func (d *DB) FetchClosedChannel(chanID *wire.OutPoint) (*ChannelCloseSummary, er
 	return chanSummary, nil
 }
 
+// FetchHistoricalChannel fetches the state of a channel that has been closed,
+// but has yet to be fully resolved, as it was at the time of the close. If no
+// such state exists, ErrChannelNotFound is returned.
+func (d *DB) FetchHistoricalChannel(chanPoint *wire.OutPoint) (*OpenChannel,
+	error) {
+
+	var channel *OpenChannel
+	err := d.View(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchHistoricalChanBucket(tx, chanPoint)
+		if err != nil {
+			return err
+		}
+
+		channel, err = fetchOpenChannel(chanBucket, chanPoint)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	channel.Db = d
+
+	return channel, nil
+}
+
+// UpdateClosedChannel replaces the close summary of a channel that has already
+// been closed. This is used when the transaction that closed the channel has
+// been reorged out of the chain, and the channel has since been closed by
+// another transaction within the new chain. As the channel state it was
+// populated from no longer exists, the revocation state and local channel
+// config of the prior summary are carried over.
+func (d *DB) UpdateClosedChannel(summary *ChannelCloseSummary) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		closedChanBucket := tx.Bucket(closedChannelBucket)
+		if closedChanBucket == nil {
+			return ErrClosedChannelNotFound
+		}
+
+		var b bytes.Buffer
+		if err := writeOutpoint(&b, &summary.ChanPoint); err != nil {
+			return err
+		}
+		chanID := b.Bytes()
+
+		chanSummaryBytes := closedChanBucket.Get(chanID)
+		if chanSummaryBytes == nil {
+			return ErrClosedChannelNotFound
+		}
+
+		prevSummary, err := deserializeCloseChannelSummary(
+			bytes.NewReader(chanSummaryBytes),
+		)
+		if err != nil {
+			return err
+		}
+
+		summary.RemoteCurrentRevocation = prevSummary.RemoteCurrentRevocation
+		summary.RemoteNextRevocation = prevSummary.RemoteNextRevocation
+		summary.LocalChanConfig = prevSummary.LocalChanConfig
+
+		var newSummary bytes.Buffer
+		err = serializeChannelCloseSummary(&newSummary, summary)
+		if err != nil {
+			return err
+		}
+
+		return closedChanBucket.Put(chanID, newSummary.Bytes())
+	})
+}
+
 // MarkChanFullyClosed marks a channel as fully closed within the database. A
 // channel should be marked as fully closed if the channel was initially
 // cooperatively closed and it's reached a single confirmation, or after all
@@ -657,6 +770,17 @@ func (d *DB) MarkChanFullyClosed(chanPoint *wire.OutPoint) error {
 			return err
 		}
 
+		// As the channel is fully resolved, we no longer need the
+		// state we kept around to handle a reorg of its closing
+		// transaction.
+		historicalBucket := tx.Bucket(historicalChannelBucket)
+		if historicalBucket != nil {
+			err := historicalBucket.DeleteBucket(chanID)
+			if err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+		}
+
 		// Now that the channel is closed, we'll check if we have any
 		// other open channels with this peer. If we don't we'll
 		// garbage collect it to ensure we don't establish persistent
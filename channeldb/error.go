@@ -79,6 +79,10 @@ var (
 	// can't be found.
 	ErrEdgeNotFound = fmt.Errorf("edge not found")
 
+	// ErrZombieEdge is an error returned when we attempt to look up an
+	// edge but it is marked as a zombie within the zombie index.
+	ErrZombieEdge = fmt.Errorf("edge marked as zombie")
+
 	// ErrEdgeAlreadyExist is returned when edge with specific
 	// channel id can't be added because it already exist.
 	ErrEdgeAlreadyExist = fmt.Errorf("edge already exist")
@@ -97,6 +101,10 @@ var (
 	// ErrNoForwardingEvents is returned in the case that a query fails due
 	// to the log not having any recorded events.
 	ErrNoForwardingEvents = fmt.Errorf("no recorded forwarding events")
+
+	// ErrNoSweepDestination is returned when the sweep destination is
+	// queried, but none has been set.
+	ErrNoSweepDestination = fmt.Errorf("no sweep destination set")
 )
 
 // ErrTooManyExtraOpaqueBytes creates an error which should be returned if the
@@ -106,6 +106,30 @@ var (
 	// maps: outPoint -> chanID
 	channelPointBucket = []byte("chan-index")
 
+	// zombieBucket is a sub-bucket of the main edgeBucket bucket
+	// responsible for maintaining an index of zombie channels. Each entry
+	// exists within the bucket as follows:
+	//
+	// maps: chanID -> pubKey1 || pubKey2
+	//
+	// The chanID represents the channel ID of the edge that is marked as a
+	// zombie and is used as the key, which maps to the public keys of the
+	// edge's participants.
+	zombieBucket = []byte("zombie-index")
+
+	// splicedEdgeBucket is a sub-bucket of the main edgeBucket bucket
+	// responsible for maintaining an index of channel edges whose funding
+	// output has been spent by a splice transaction, but whose
+	// continuation hasn't been announced yet. Each entry exists within the
+	// bucket as follows:
+	//
+	// maps: outpoint -> chanID || newOutpoint || height
+	//
+	// The outpoint is the funding output of the spliced edge, which maps
+	// to the channel ID of the edge, the new funding output created by the
+	// splice transaction, and the height at which it confirmed.
+	splicedEdgeBucket = []byte("spliced-edge-index")
+
 	// graphMetaBucket is a top-level bucket which stores various meta-deta
 	// related to the on-disk channel graph. Data stored in this bucket
 	// includes the block to which the graph has been synced to, the total
@@ -556,6 +580,16 @@ func (c *ChannelGraph) AddChannelEdge(edge *ChannelEdgeInfo) error {
 			return err
 		}
 
+		// If the channel was previously marked as a zombie, then it
+		// has now been resurrected, so we'll remove it from the zombie
+		// index.
+		zombieIndex := edges.Bucket(zombieBucket)
+		if zombieIndex != nil {
+			if err := zombieIndex.Delete(chanKey[:]); err != nil {
+				return err
+			}
+		}
+
 		// Mark edge policies for both sides as unknown. This is to
 		// enable efficient incoming channel lookup for a node.
 		for _, key := range []*[33]byte{&edge.NodeKey1Bytes,
@@ -1056,13 +1090,24 @@ func (c *ChannelGraph) PruneTip() (*chainhash.Hash, uint32, error) {
 }
 
 // DeleteChannelEdge removes an edge from the database as identified by its
-// funding outpoint. If the edge does not exist within the database, then
+// funding outpoint. If markZombie is true, the edge is also marked as a zombie
+// within the zombie index. This ensures we don't accept the channel again as a
+// new channel, unless it's resurrected through MarkEdgeLive. Edges that are
+// removed because they were superseded, rather than closed, shouldn't be
+// marked as zombies. If the edge does not exist within the database, then
 // ErrEdgeNotFound will be returned.
-func (c *ChannelGraph) DeleteChannelEdge(chanPoint *wire.OutPoint) error {
+func (c *ChannelGraph) DeleteChannelEdge(chanPoint *wire.OutPoint,
+	markZombie bool) error {
+
 	// TODO(roasbeef): possibly delete from node bucket if node has no more
 	// channels
 	// TODO(roasbeef): don't delete both edges?
 
+	var b bytes.Buffer
+	if err := writeOutpoint(&b, chanPoint); err != nil {
+		return err
+	}
+
 	return c.db.Update(func(tx *bolt.Tx) error {
 		// First grab the edges bucket which houses the information
 		// we'd like to delete
@@ -1083,11 +1128,304 @@ func (c *ChannelGraph) DeleteChannelEdge(chanPoint *wire.OutPoint) error {
 		if err != nil {
 			return err
 		}
+		zombieIndex, err := edges.CreateBucketIfNotExists(zombieBucket)
+		if err != nil {
+			return err
+		}
+
+		// Before we delete the edge, we'll grab its node keys so we
+		// can store them within the zombie index.
+		chanID := chanIndex.Get(b.Bytes())
+		if chanID == nil {
+			return ErrEdgeNotFound
+		}
+		nodeKeys := edgeIndex.Get(chanID)
+		if len(nodeKeys) < 66 {
+			return fmt.Errorf("could not find nodekeys for "+
+				"chanID %v", chanID)
+		}
+
+		var pubKey1, pubKey2 [33]byte
+		copy(pubKey1[:], nodeKeys[:33])
+		copy(pubKey2[:], nodeKeys[33:66])
+
+		err = delChannelByEdge(edges, edgeIndex, chanIndex, nodes, chanPoint)
+		if err != nil {
+			return err
+		}
+
+		if !markZombie {
+			return nil
+		}
+
+		return markEdgeZombie(
+			zombieIndex, byteOrder.Uint64(chanID), pubKey1, pubKey2,
+		)
+	})
+}
+
+// MarkEdgeZombie marks an edge as a zombie within the graph's zombie index.
+// The public keys should represent the node public keys of the two parties
+// involved in the edge.
+func (c *ChannelGraph) MarkEdgeZombie(chanID uint64, pubKey1,
+	pubKey2 [33]byte) error {
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		edges, err := tx.CreateBucketIfNotExists(edgeBucket)
+		if err != nil {
+			return err
+		}
+		zombieIndex, err := edges.CreateBucketIfNotExists(zombieBucket)
+		if err != nil {
+			return err
+		}
+
+		return markEdgeZombie(zombieIndex, chanID, pubKey1, pubKey2)
+	})
+}
+
+// markEdgeZombie marks an edge as a zombie within our zombie index. The public
+// keys should represent the node public keys of the two parties involved in
+// the edge.
+func markEdgeZombie(zombieIndex *bolt.Bucket, chanID uint64, pubKey1,
+	pubKey2 [33]byte) error {
+
+	var k [8]byte
+	byteOrder.PutUint64(k[:], chanID)
+
+	var v [66]byte
+	copy(v[:33], pubKey1[:])
+	copy(v[33:], pubKey2[:])
+
+	return zombieIndex.Put(k[:], v[:])
+}
+
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live. If
+// the edge isn't marked as a zombie, then ErrEdgeNotFound is returned.
+func (c *ChannelGraph) MarkEdgeLive(chanID uint64) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return ErrGraphNoEdgesFound
+		}
+		zombieIndex := edges.Bucket(zombieBucket)
+		if zombieIndex == nil {
+			return ErrEdgeNotFound
+		}
+
+		var k [8]byte
+		byteOrder.PutUint64(k[:], chanID)
+
+		if zombieIndex.Get(k[:]) == nil {
+			return ErrEdgeNotFound
+		}
+
+		return zombieIndex.Delete(k[:])
+	})
+}
+
+// IsZombieEdge returns whether the edge is considered zombie. If it is a
+// zombie, then the two node public keys corresponding to this edge are also
+// returned.
+func (c *ChannelGraph) IsZombieEdge(chanID uint64) (bool, [33]byte, [33]byte,
+	error) {
+
+	var (
+		isZombie         bool
+		pubKey1, pubKey2 [33]byte
+	)
+
+	err := c.db.View(func(tx *bolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+		zombieIndex := edges.Bucket(zombieBucket)
+		if zombieIndex == nil {
+			return nil
+		}
+
+		isZombie, pubKey1, pubKey2 = isZombieEdge(zombieIndex, chanID)
+		return nil
+	})
+	if err != nil {
+		return false, [33]byte{}, [33]byte{}, err
+	}
+
+	return isZombie, pubKey1, pubKey2, nil
+}
+
+// isZombieEdge returns whether an entry exists for the given channel in the
+// zombie index. If an entry exists, then the two node public keys
+// corresponding to this edge are also returned.
+func isZombieEdge(zombieIndex *bolt.Bucket,
+	chanID uint64) (bool, [33]byte, [33]byte) {
+
+	var k [8]byte
+	byteOrder.PutUint64(k[:], chanID)
+
+	v := zombieIndex.Get(k[:])
+	if v == nil || len(v) != 66 {
+		return false, [33]byte{}, [33]byte{}
+	}
+
+	var pubKey1, pubKey2 [33]byte
+	copy(pubKey1[:], v[:33])
+	copy(pubKey2[:], v[33:])
+
+	return true, pubKey1, pubKey2
+}
+
+// NumZombies returns the current number of zombie channels in the graph.
+func (c *ChannelGraph) NumZombies() (uint64, error) {
+	var numZombies uint64
+	err := c.db.View(func(tx *bolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+		zombieIndex := edges.Bucket(zombieBucket)
+		if zombieIndex == nil {
+			return nil
+		}
+
+		return zombieIndex.ForEach(func(_, _ []byte) error {
+			numZombies++
+			return nil
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numZombies, nil
+}
+
+// SplicedEdge is a channel edge whose funding output has been spent by a
+// splice transaction. The edge is kept within the graph until the channel on
+// top of the new funding output is announced.
+type SplicedEdge struct {
+	// ChanPoint is the funding output of the spliced edge.
+	ChanPoint wire.OutPoint
+
+	// ChannelID is the short channel ID of the spliced edge.
+	ChannelID uint64
+
+	// NewChanPoint is the new funding output of the channel created by the
+	// splice transaction.
+	NewChanPoint wire.OutPoint
+
+	// Height is the height at which the splice transaction confirmed.
+	Height uint32
+}
+
+// AddSplicedEdge adds the passed edge to the index of spliced edges, replacing
+// any existing entry for its funding output.
+func (c *ChannelGraph) AddSplicedEdge(edge *SplicedEdge) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		edges, err := tx.CreateBucketIfNotExists(edgeBucket)
+		if err != nil {
+			return err
+		}
+		splicedIndex, err := edges.CreateBucketIfNotExists(
+			splicedEdgeBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		var k bytes.Buffer
+		if err := writeOutpoint(&k, &edge.ChanPoint); err != nil {
+			return err
+		}
+
+		var v bytes.Buffer
+		err = binary.Write(&v, byteOrder, edge.ChannelID)
+		if err != nil {
+			return err
+		}
+		if err := writeOutpoint(&v, &edge.NewChanPoint); err != nil {
+			return err
+		}
+		if err := binary.Write(&v, byteOrder, edge.Height); err != nil {
+			return err
+		}
 
-		return delChannelByEdge(edges, edgeIndex, chanIndex, nodes, chanPoint)
+		return splicedIndex.Put(k.Bytes(), v.Bytes())
 	})
 }
 
+// DeleteSplicedEdge removes the edge funded by the passed outpoint from the
+// index of spliced edges. If no such entry exists, then ErrEdgeNotFound is
+// returned.
+func (c *ChannelGraph) DeleteSplicedEdge(chanPoint *wire.OutPoint) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return ErrGraphNoEdgesFound
+		}
+		splicedIndex := edges.Bucket(splicedEdgeBucket)
+		if splicedIndex == nil {
+			return ErrEdgeNotFound
+		}
+
+		var k bytes.Buffer
+		if err := writeOutpoint(&k, chanPoint); err != nil {
+			return err
+		}
+
+		if splicedIndex.Get(k.Bytes()) == nil {
+			return ErrEdgeNotFound
+		}
+
+		return splicedIndex.Delete(k.Bytes())
+	})
+}
+
+// FetchSplicedEdges returns all edges within the index of spliced edges.
+func (c *ChannelGraph) FetchSplicedEdges() ([]*SplicedEdge, error) {
+	var splicedEdges []*SplicedEdge
+	err := c.db.View(func(tx *bolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+		splicedIndex := edges.Bucket(splicedEdgeBucket)
+		if splicedIndex == nil {
+			return nil
+		}
+
+		return splicedIndex.ForEach(func(k, v []byte) error {
+			var edge SplicedEdge
+			err := readOutpoint(bytes.NewReader(k), &edge.ChanPoint)
+			if err != nil {
+				return err
+			}
+
+			r := bytes.NewReader(v)
+			err = binary.Read(r, byteOrder, &edge.ChannelID)
+			if err != nil {
+				return err
+			}
+			if err := readOutpoint(r, &edge.NewChanPoint); err != nil {
+				return err
+			}
+			err = binary.Read(r, byteOrder, &edge.Height)
+			if err != nil {
+				return err
+			}
+
+			splicedEdges = append(splicedEdges, &edge)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return splicedEdges, nil
+}
+
 // ChannelID attempt to lookup the 8-byte compact channel ID which maps to the
 // passed channel point (outpoint). If the passed channel doesn't exist within
 // the database, then ErrEdgeNotFound is returned.
@@ -1351,7 +1689,8 @@ func (c *ChannelGraph) NodeUpdatesInHorizon(startTime, endTime time.Time) ([]Lig
 // ID's that we don't know of in the passed set. In other words, we perform a
 // set difference of our set of chan ID's and the ones passed in. This method
 // can be used by callers to determine the set of channels ta peer knows of
-// that we don't.
+// that we don't. Channels that have been marked as zombies are considered to
+// be known, as we don't want to re-validate them.
 func (c *ChannelGraph) FilterKnownChanIDs(chanIDs []uint64) ([]uint64, error) {
 	var newChanIDs []uint64
 
@@ -1365,15 +1704,30 @@ func (c *ChannelGraph) FilterKnownChanIDs(chanIDs []uint64) ([]uint64, error) {
 			return ErrGraphNoEdgesFound
 		}
 
+		// Fetch the zombie index, it may not exist if no edges have
+		// ever been marked as zombies.
+		zombieIndex := edges.Bucket(zombieBucket)
+
 		// We'll run through the set of chanIDs and collate only the
 		// set of channel that are unable to be found within our db.
 		var cidBytes [8]byte
 		for _, cid := range chanIDs {
 			byteOrder.PutUint64(cidBytes[:], cid)
 
-			if v := edgeIndex.Get(cidBytes[:]); v == nil {
-				newChanIDs = append(newChanIDs, cid)
+			// If the edge is already known, skip it.
+			if v := edgeIndex.Get(cidBytes[:]); v != nil {
+				continue
+			}
+
+			// If the edge is a known zombie, skip it.
+			if zombieIndex != nil {
+				isZombie, _, _ := isZombieEdge(zombieIndex, cid)
+				if isZombie {
+					continue
+				}
 			}
+
+			newChanIDs = append(newChanIDs, cid)
 		}
 
 		return nil
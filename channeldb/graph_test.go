@@ -362,7 +362,7 @@ func TestEdgeInsertionDeletion(t *testing.T) {
 
 	// Next, attempt to delete the edge from the database, again this
 	// should proceed without any issues.
-	if err := graph.DeleteChannelEdge(&outpoint); err != nil {
+	if err := graph.DeleteChannelEdge(&outpoint, true); err != nil {
 		t.Fatalf("unable to delete edge: %v", err)
 	}
 
@@ -377,7 +377,7 @@ func TestEdgeInsertionDeletion(t *testing.T) {
 
 	// Finally, attempt to delete a (now) non-existent edge within the
 	// database, this should result in an error.
-	err = graph.DeleteChannelEdge(&outpoint)
+	err = graph.DeleteChannelEdge(&outpoint, true)
 	if err != ErrEdgeNotFound {
 		t.Fatalf("deleting a non-existent edge should fail!")
 	}
@@ -1699,6 +1699,18 @@ func TestFilterKnownChanIDs(t *testing.T) {
 		chanIDs = append(chanIDs, chanID.ToUint64())
 	}
 
+	// We'll also mark two channels as zombies. These should be treated as
+	// known channels.
+	zombieIDs := []uint64{102, 103}
+	for _, zombieID := range zombieIDs {
+		err := graph.MarkEdgeZombie(
+			zombieID, node1.PubKeyBytes, node2.PubKeyBytes,
+		)
+		if err != nil {
+			t.Fatalf("unable to mark edge as zombie: %v", err)
+		}
+	}
+
 	queryCases := []struct {
 		queryIDs []uint64
 
@@ -1723,6 +1735,13 @@ func TestFilterKnownChanIDs(t *testing.T) {
 			queryIDs: append(chanIDs, []uint64{99, 101}...),
 			resp:     []uint64{99, 101},
 		},
+
+		// If we query for a set of ID's that includes zombies, then
+		// the zombies should be filtered out.
+		{
+			queryIDs: append(zombieIDs, []uint64{99, 104}...),
+			resp:     []uint64{99, 104},
+		},
 	}
 
 	for _, queryCase := range queryCases {
@@ -2552,7 +2571,7 @@ func TestNodeIsPublic(t *testing.T) {
 	// graph. This will make Alice be seen as a private node as it no longer
 	// has any advertised edges.
 	for _, graph := range graphs {
-		err := graph.DeleteChannelEdge(&aliceBobEdge.ChannelPoint)
+		err := graph.DeleteChannelEdge(&aliceBobEdge.ChannelPoint, true)
 		if err != nil {
 			t.Fatalf("unable to remove edge: %v", err)
 		}
@@ -2569,7 +2588,7 @@ func TestNodeIsPublic(t *testing.T) {
 	// completely remove the edge as it is not possible for her to know of
 	// it without it being advertised.
 	for i, graph := range graphs {
-		err := graph.DeleteChannelEdge(&bobCarolEdge.ChannelPoint)
+		err := graph.DeleteChannelEdge(&bobCarolEdge.ChannelPoint, true)
 		if err != nil {
 			t.Fatalf("unable to remove edge: %v", err)
 		}
@@ -2594,6 +2613,190 @@ func TestNodeIsPublic(t *testing.T) {
 	)
 }
 
+// TestGraphZombieIndex ensures that we can mark edges correctly as zombie/live
+// and that channels deleted from the graph are added to the zombie index.
+func TestGraphZombieIndex(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create test database: %v", err)
+	}
+	graph := db.ChannelGraph()
+
+	node1, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test vertex: %v", err)
+	}
+	node2, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test vertex: %v", err)
+	}
+
+	// Swap the nodes if the second's pubkey is smaller than the first.
+	// Without this, the comparisons at the end will fail probabilistically.
+	if bytes.Compare(node2.PubKeyBytes[:], node1.PubKeyBytes[:]) < 0 {
+		node1, node2 = node2, node1
+	}
+
+	edge, _ := createEdge(1, 0, 0, 0, node1, node2)
+	if err := graph.AddChannelEdge(&edge); err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+
+	assertZombie := func(expZombie bool) {
+		t.Helper()
+
+		isZombie, pubKey1, pubKey2, err := graph.IsZombieEdge(
+			edge.ChannelID,
+		)
+		if err != nil {
+			t.Fatalf("unable to query zombie index: %v", err)
+		}
+		if isZombie != expZombie {
+			t.Fatalf("expected zombie=%v, got %v", expZombie,
+				isZombie)
+		}
+		if !expZombie {
+			return
+		}
+		if pubKey1 != node1.PubKeyBytes {
+			t.Fatalf("expected pubkey1 %x, got %x",
+				node1.PubKeyBytes, pubKey1)
+		}
+		if pubKey2 != node2.PubKeyBytes {
+			t.Fatalf("expected pubkey2 %x, got %x",
+				node2.PubKeyBytes, pubKey2)
+		}
+	}
+
+	// The edge was just added, so it shouldn't be a zombie.
+	assertZombie(false)
+
+	// Deleting the edge without marking it as a zombie should leave the
+	// zombie index untouched.
+	if err := graph.DeleteChannelEdge(&edge.ChannelPoint, false); err != nil {
+		t.Fatalf("unable to delete edge: %v", err)
+	}
+	assertZombie(false)
+	if err := graph.AddChannelEdge(&edge); err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+
+	// Deleting the edge from the graph should mark it as a zombie.
+	if err := graph.DeleteChannelEdge(&edge.ChannelPoint, true); err != nil {
+		t.Fatalf("unable to delete edge: %v", err)
+	}
+	assertZombie(true)
+
+	numZombies, err := graph.NumZombies()
+	if err != nil {
+		t.Fatalf("unable to query number of zombies: %v", err)
+	}
+	if numZombies != 1 {
+		t.Fatalf("expected 1 zombie, got %v", numZombies)
+	}
+
+	// Marking the edge as live should remove it from the index.
+	if err := graph.MarkEdgeLive(edge.ChannelID); err != nil {
+		t.Fatalf("unable to mark edge as live: %v", err)
+	}
+	assertZombie(false)
+
+	// Attempting to mark it live once more should fail as it's no longer
+	// a zombie.
+	if err := graph.MarkEdgeLive(edge.ChannelID); err != ErrEdgeNotFound {
+		t.Fatalf("expected ErrEdgeNotFound, got %v", err)
+	}
+
+	// Finally, we'll mark the edge as a zombie manually, and ensure that
+	// re-adding it to the graph removes it from the zombie index.
+	err = graph.MarkEdgeZombie(
+		edge.ChannelID, node1.PubKeyBytes, node2.PubKeyBytes,
+	)
+	if err != nil {
+		t.Fatalf("unable to mark edge as zombie: %v", err)
+	}
+	assertZombie(true)
+
+	if err := graph.AddChannelEdge(&edge); err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+	assertZombie(false)
+}
+
+// TestGraphSplicedEdgeIndex ensures that spliced edges can be added to,
+// fetched from, and removed from the index of spliced edges.
+func TestGraphSplicedEdgeIndex(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create test database: %v", err)
+	}
+	graph := db.ChannelGraph()
+
+	assertSplicedEdges := func(expEdges ...*SplicedEdge) {
+		t.Helper()
+
+		splicedEdges, err := graph.FetchSplicedEdges()
+		if err != nil {
+			t.Fatalf("unable to fetch spliced edges: %v", err)
+		}
+		if len(splicedEdges) != len(expEdges) {
+			t.Fatalf("expected %v spliced edges, got %v",
+				len(expEdges), len(splicedEdges))
+		}
+		for i, edge := range splicedEdges {
+			if !reflect.DeepEqual(edge, expEdges[i]) {
+				t.Fatalf("spliced edge mismatch: expected %v, "+
+					"got %v", spew.Sdump(expEdges[i]),
+					spew.Sdump(edge))
+			}
+		}
+	}
+
+	// The index should initially be empty.
+	assertSplicedEdges()
+
+	edge := &SplicedEdge{
+		ChanPoint: wire.OutPoint{
+			Hash:  chainhash.Hash(rev),
+			Index: 1,
+		},
+		ChannelID: 12345,
+		NewChanPoint: wire.OutPoint{
+			Hash:  chainhash.Hash(key),
+			Index: 2,
+		},
+		Height: 100,
+	}
+	if err := graph.AddSplicedEdge(edge); err != nil {
+		t.Fatalf("unable to add spliced edge: %v", err)
+	}
+	assertSplicedEdges(edge)
+
+	// Adding the edge once more should replace the existing entry.
+	edge.Height = 101
+	if err := graph.AddSplicedEdge(edge); err != nil {
+		t.Fatalf("unable to add spliced edge: %v", err)
+	}
+	assertSplicedEdges(edge)
+
+	if err := graph.DeleteSplicedEdge(&edge.ChanPoint); err != nil {
+		t.Fatalf("unable to delete spliced edge: %v", err)
+	}
+	assertSplicedEdges()
+
+	// Deleting it again should fail, as it's no longer in the index.
+	err = graph.DeleteSplicedEdge(&edge.ChanPoint)
+	if err != ErrEdgeNotFound {
+		t.Fatalf("expected ErrEdgeNotFound, got %v", err)
+	}
+}
+
 // compareNodes is used to compare two LightningNodes while excluding the
 // Features struct, which cannot be compared as the semantics for reserializing
 // the featuresMap have not been defined.
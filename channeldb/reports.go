@@ -0,0 +1,302 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// closeReportBucket stores the resolution reports of the outputs of
+	// closed channels. Within this bucket, a sub-bucket keyed by the
+	// channel point of each channel holds a report for each of its
+	// outputs, keyed by the outpoint of the output.
+	closeReportBucket = []byte("close-report-bucket")
+)
+
+// ResolverOutputType denotes the kind of commitment output a resolver report
+// describes.
+type ResolverOutputType uint8
+
+const (
+	// ResolverOutputCommit is our output on the commitment transaction.
+	ResolverOutputCommit ResolverOutputType = 0
+
+	// ResolverOutputIncomingHtlc is an HTLC offered to us.
+	ResolverOutputIncomingHtlc ResolverOutputType = 1
+
+	// ResolverOutputOutgoingHtlc is an HTLC offered by us.
+	ResolverOutputOutgoingHtlc ResolverOutputType = 2
+
+	// ResolverOutputRevoked is an output of a revoked commitment
+	// transaction broadcast by the remote party, which we claim using the
+	// revocation key.
+	ResolverOutputRevoked ResolverOutputType = 3
+)
+
+// String returns a human readable version of the ResolverOutputType.
+func (r ResolverOutputType) String() string {
+	switch r {
+	case ResolverOutputCommit:
+		return "Commit"
+	case ResolverOutputIncomingHtlc:
+		return "IncomingHtlc"
+	case ResolverOutputOutgoingHtlc:
+		return "OutgoingHtlc"
+	case ResolverOutputRevoked:
+		return "Revoked"
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverOutcome denotes how a commitment output was finally resolved.
+type ResolverOutcome uint8
+
+const (
+	// ResolverOutcomeClaimed indicates that we swept the output into our
+	// wallet, either directly or via a second-level success transaction.
+	ResolverOutcomeClaimed ResolverOutcome = 0
+
+	// ResolverOutcomeTimeout indicates that an outgoing HTLC timed out,
+	// and we swept it back into our wallet.
+	ResolverOutcomeTimeout ResolverOutcome = 1
+
+	// ResolverOutcomeLost indicates that the remote party swept an
+	// outgoing HTLC with its preimage.
+	ResolverOutcomeLost ResolverOutcome = 2
+
+	// ResolverOutcomeAbandoned indicates that an incoming HTLC timed out
+	// before we learned its preimage, so we gave up on it, and left it to
+	// the remote party to sweep.
+	ResolverOutcomeAbandoned ResolverOutcome = 3
+)
+
+// String returns a human readable version of the ResolverOutcome.
+func (r ResolverOutcome) String() string {
+	switch r {
+	case ResolverOutcomeClaimed:
+		return "Claimed"
+	case ResolverOutcomeTimeout:
+		return "Timeout"
+	case ResolverOutcomeLost:
+		return "Lost"
+	case ResolverOutcomeAbandoned:
+		return "Abandoned"
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverReport is the final resolution report of a single output of the
+// commitment transaction of a closed channel.
+type ResolverReport struct {
+	// OutPoint is the output on the commitment transaction.
+	OutPoint wire.OutPoint
+
+	// OutputType is the kind of output that was resolved.
+	OutputType ResolverOutputType
+
+	// Amount is the value of the output that was swept. For HTLCs on our
+	// own commitment transaction, this is the value of the output of the
+	// second-level transaction.
+	Amount btcutil.Amount
+
+	// Outcome describes how the output was resolved.
+	Outcome ResolverOutcome
+
+	// SecondLevelTxid is the txid of the second-level HTLC transaction
+	// that spent the output, if any.
+	SecondLevelTxid *chainhash.Hash
+
+	// SweepTxid is the txid of the transaction that finally swept the
+	// output, if it was swept.
+	SweepTxid *chainhash.Hash
+
+	// SweepFee is the on-chain fee we paid to sweep the output. This is
+	// only known if the output was the sole input of a sweep transaction
+	// we created, and is zero otherwise.
+	SweepFee btcutil.Amount
+
+	// SweepPkScript is the output script that we swept the output to. It
+	// is nil if we didn't sweep the output.
+	SweepPkScript []byte
+}
+
+// PutResolverReport persists the final resolution report of an output of the
+// channel with the passed channel point. Any report previously stored for the
+// same output is replaced.
+func (d *DB) PutResolverReport(chanPoint *wire.OutPoint,
+	report *ResolverReport) error {
+
+	return d.Update(func(tx *bolt.Tx) error {
+		reportBucket, err := tx.CreateBucketIfNotExists(
+			closeReportBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		var chanKey bytes.Buffer
+		if err := writeOutpoint(&chanKey, chanPoint); err != nil {
+			return err
+		}
+
+		chanBucket, err := reportBucket.CreateBucketIfNotExists(
+			chanKey.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+
+		var outputKey bytes.Buffer
+		err = writeOutpoint(&outputKey, &report.OutPoint)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeResolverReport(&b, report); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(outputKey.Bytes(), b.Bytes())
+	})
+}
+
+// FetchResolverReports returns the resolution reports of the outputs of the
+// channel with the passed channel point. If no outputs of the channel have
+// been resolved, an empty slice is returned.
+func (d *DB) FetchResolverReports(
+	chanPoint *wire.OutPoint) ([]*ResolverReport, error) {
+
+	var reports []*ResolverReport
+	err := d.View(func(tx *bolt.Tx) error {
+		reportBucket := tx.Bucket(closeReportBucket)
+		if reportBucket == nil {
+			return nil
+		}
+
+		var chanKey bytes.Buffer
+		if err := writeOutpoint(&chanKey, chanPoint); err != nil {
+			return err
+		}
+
+		chanBucket := reportBucket.Bucket(chanKey.Bytes())
+		if chanBucket == nil {
+			return nil
+		}
+
+		return chanBucket.ForEach(func(_, reportBytes []byte) error {
+			report, err := deserializeResolverReport(
+				bytes.NewReader(reportBytes),
+			)
+			if err != nil {
+				return err
+			}
+
+			reports = append(reports, report)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// writeOptionalHash writes the passed hash, prefixed by a boolean indicating
+// whether it is set.
+func writeOptionalHash(w io.Writer, hash *chainhash.Hash) error {
+	if hash == nil {
+		return WriteElement(w, false)
+	}
+
+	return WriteElements(w, true, *hash)
+}
+
+// readOptionalHash reads a hash written by writeOptionalHash.
+func readOptionalHash(r io.Reader) (*chainhash.Hash, error) {
+	var hasHash bool
+	if err := ReadElement(r, &hasHash); err != nil {
+		return nil, err
+	}
+	if !hasHash {
+		return nil, nil
+	}
+
+	var hash chainhash.Hash
+	if err := ReadElement(r, &hash); err != nil {
+		return nil, err
+	}
+
+	return &hash, nil
+}
+
+func serializeResolverReport(w io.Writer, report *ResolverReport) error {
+	err := WriteElements(
+		w, report.OutPoint, report.OutputType, report.Amount,
+		report.Outcome,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := writeOptionalHash(w, report.SecondLevelTxid); err != nil {
+		return err
+	}
+	if err := writeOptionalHash(w, report.SweepTxid); err != nil {
+		return err
+	}
+
+	if err := WriteElement(w, report.SweepFee); err != nil {
+		return err
+	}
+
+	// The sweep script was added after the other fields, so we only write
+	// it if it's set, allowing reports that lack it to be read back
+	// alike.
+	if len(report.SweepPkScript) == 0 {
+		return nil
+	}
+
+	return WriteElement(w, report.SweepPkScript)
+}
+
+func deserializeResolverReport(r io.Reader) (*ResolverReport, error) {
+	var report ResolverReport
+	err := ReadElements(
+		r, &report.OutPoint, &report.OutputType, &report.Amount,
+		&report.Outcome,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report.SecondLevelTxid, err = readOptionalHash(r)
+	if err != nil {
+		return nil, err
+	}
+	report.SweepTxid, err = readOptionalHash(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ReadElement(r, &report.SweepFee); err != nil {
+		return nil, err
+	}
+
+	// Reports written before the addition of the sweep script, or of
+	// outputs we didn't sweep, end here.
+	err = ReadElement(r, &report.SweepPkScript)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	return &report, nil
+}
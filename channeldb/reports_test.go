@@ -0,0 +1,103 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+)
+
+// TestResolverReports tests that the resolution reports of the outputs of a
+// closed channel can be stored and retrieved, and that storing a report for
+// an output twice replaces the prior report.
+func TestResolverReports(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	chanPoint := wire.OutPoint{Hash: key, Index: 1}
+	otherChanPoint := wire.OutPoint{Hash: key, Index: 2}
+
+	// Initially, no reports should be known for the channel.
+	reports, err := cdb.FetchResolverReports(&chanPoint)
+	if err != nil {
+		t.Fatalf("unable to fetch reports: %v", err)
+	}
+	if len(reports) != 0 {
+		t.Fatalf("expected no reports, got %v", spew.Sdump(reports))
+	}
+
+	sweepTxid := chainhash.Hash{1}
+	secondLevelTxid := chainhash.Hash{2}
+	commitReport := &ResolverReport{
+		OutPoint:   wire.OutPoint{Hash: rev, Index: 0},
+		OutputType: ResolverOutputCommit,
+		Amount:     100000,
+		Outcome:    ResolverOutcomeClaimed,
+		SweepTxid:  &sweepTxid,
+		SweepFee:   500,
+		SweepPkScript: []byte{
+			0x00, 0x14, 0x75, 0x1e, 0x76, 0xe8, 0x19, 0x91, 0x96,
+			0xd4, 0x54, 0x94, 0x1c, 0x45, 0xd1, 0xb3, 0xa3, 0x23,
+			0xf1, 0x43, 0x3b, 0xd6,
+		},
+	}
+	htlcReport := &ResolverReport{
+		OutPoint:        wire.OutPoint{Hash: rev, Index: 1},
+		OutputType:      ResolverOutputOutgoingHtlc,
+		Amount:          20000,
+		Outcome:         ResolverOutcomeLost,
+		SecondLevelTxid: &secondLevelTxid,
+	}
+	otherReport := &ResolverReport{
+		OutPoint:   wire.OutPoint{Hash: rev, Index: 2},
+		OutputType: ResolverOutputIncomingHtlc,
+		Amount:     30000,
+		Outcome:    ResolverOutcomeAbandoned,
+	}
+
+	for _, report := range []*ResolverReport{commitReport, htlcReport} {
+		if err := cdb.PutResolverReport(&chanPoint, report); err != nil {
+			t.Fatalf("unable to put report: %v", err)
+		}
+	}
+	err = cdb.PutResolverReport(&otherChanPoint, otherReport)
+	if err != nil {
+		t.Fatalf("unable to put report: %v", err)
+	}
+
+	assertReports := func(chanPoint *wire.OutPoint,
+		expected ...*ResolverReport) {
+
+		t.Helper()
+
+		reports, err := cdb.FetchResolverReports(chanPoint)
+		if err != nil {
+			t.Fatalf("unable to fetch reports: %v", err)
+		}
+		if !reflect.DeepEqual(reports, expected) {
+			t.Fatalf("expected reports %v, got %v",
+				spew.Sdump(expected), spew.Sdump(reports))
+		}
+	}
+
+	assertReports(&chanPoint, commitReport, htlcReport)
+	assertReports(&otherChanPoint, otherReport)
+
+	// Storing a new report for an output should replace the old one.
+	htlcReport.Outcome = ResolverOutcomeTimeout
+	htlcReport.SweepTxid = &sweepTxid
+	htlcReport.SweepFee = 200
+	htlcReport.SweepPkScript = commitReport.SweepPkScript
+	if err := cdb.PutResolverReport(&chanPoint, htlcReport); err != nil {
+		t.Fatalf("unable to put report: %v", err)
+	}
+
+	assertReports(&chanPoint, commitReport, htlcReport)
+}
@@ -0,0 +1,298 @@
+package channeldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// pendingSpliceKey stores the pending splice of a channel within the
+	// sub-bucket of the channel, if the channel is being spliced.
+	pendingSpliceKey = []byte("pending-splice-key")
+
+	// ErrNoPendingSplice is returned when a channel doesn't have a pending
+	// splice.
+	ErrNoPendingSplice = fmt.Errorf("no pending splice found")
+)
+
+// ChannelSplice describes a pending splice of a channel. A splice replaces the
+// funding output of the channel with a new one, created by a splice
+// transaction spending the current funding output. Until the splice
+// transaction confirms, either of the funding outputs may end up on-chain, so
+// the commitment transactions spending the new funding output are stored
+// alongside the current commitments of the channel. Both sets of commitments
+// are at the same commitment height, and are revoked by the same commitment
+// secrets.
+type ChannelSplice struct {
+	// SpliceTx is the splice transaction spending the current funding
+	// output of the channel. It only carries the witnesses of its inputs
+	// once both parties have exchanged their signatures for it.
+	SpliceTx *wire.MsgTx
+
+	// FundingOutpoint is the new funding output created by the splice
+	// transaction.
+	FundingOutpoint wire.OutPoint
+
+	// Capacity is the capacity of the channel after the splice.
+	Capacity btcutil.Amount
+
+	// LocalCommitment is our commitment transaction spending the new
+	// funding output, along with the remote party's signature for it.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the commitment transaction of the remote party
+	// spending the new funding output.
+	RemoteCommitment ChannelCommitment
+
+	// IsInitiator is true if we initiated the splice.
+	IsInitiator bool
+
+	// BroadcastHeight is the height at which the splice transaction was
+	// broadcast.
+	BroadcastHeight uint32
+}
+
+// IsSigned returns true if the splice transaction carries the witnesses of all
+// of its inputs, and can therefore be broadcast.
+func (s *ChannelSplice) IsSigned() bool {
+	for _, txIn := range s.SpliceTx.TxIn {
+		if len(txIn.Witness) == 0 && len(txIn.SignatureScript) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MarkSplicePending persists the given splice as the pending splice of the
+// channel, replacing any pending splice stored before. The splice should be
+// persisted before we hand out our signature for the current funding output,
+// as the splice transaction may be broadcast from then on.
+func (c *OpenChannel) MarkSplicePending(splice *ChannelSplice) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeChannelSplice(&b, splice); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(pendingSpliceKey, b.Bytes())
+	})
+}
+
+// PendingSplice returns the pending splice of the channel. If the channel
+// isn't being spliced, ErrNoPendingSplice is returned.
+func (c *OpenChannel) PendingSplice() (*ChannelSplice, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var splice *ChannelSplice
+	err := c.Db.View(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch err {
+		case nil:
+		case ErrNoChanDBExists, ErrNoActiveChannels, ErrChannelNotFound:
+			return ErrNoPendingSplice
+		default:
+			return err
+		}
+
+		splice, err = fetchChannelSplice(chanBucket)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return splice, nil
+}
+
+// AbandonSplice removes the pending splice of the channel. This must only be
+// done if the splice transaction can't have been broadcast, as otherwise we'd
+// lose track of the commitment transactions spending its funding output.
+func (c *OpenChannel) AbandonSplice() error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Delete(pendingSpliceKey)
+	})
+}
+
+// CompleteSplice moves the channel on top of the funding output created by
+// its pending splice, once the splice transaction has been confirmed at the
+// given location within the chain. As channels are indexed by their funding
+// output, all of the channel's data is moved into the bucket of the new
+// funding output, and the commitments spending the prior funding output are
+// replaced by the ones spending the new funding output.
+func (c *OpenChannel) CompleteSplice(shortChanID lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var splice *ChannelSplice
+	err := c.Db.Update(func(tx *bolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		splice, err = fetchChannelSplice(chanBucket)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		// We'll create the bucket of the new funding output within the
+		// same chain bucket, and move over the entire state of the
+		// channel, including its revocation log.
+		chainBucket := tx.Bucket(openChannelBucket).Bucket(
+			c.IdentityPub.SerializeCompressed(),
+		).Bucket(c.ChainHash[:])
+
+		var oldChanPoint, newChanPoint bytes.Buffer
+		err = writeOutpoint(&oldChanPoint, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+		err = writeOutpoint(&newChanPoint, &splice.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		newChanBucket, err := chainBucket.CreateBucket(
+			newChanPoint.Bytes(),
+		)
+		if err != nil {
+			return err
+		}
+		if err := copyBucket(newChanBucket, chanBucket); err != nil {
+			return err
+		}
+		if err := newChanBucket.Delete(pendingSpliceKey); err != nil {
+			return err
+		}
+		err = chainBucket.DeleteBucket(oldChanPoint.Bytes())
+		if err != nil {
+			return err
+		}
+
+		channel.FundingOutpoint = splice.FundingOutpoint
+		channel.Capacity = splice.Capacity
+		channel.ShortChannelID = shortChanID
+		channel.FundingBroadcastHeight = splice.BroadcastHeight
+		channel.FundingTxn = splice.SpliceTx
+		channel.LocalCommitment = splice.LocalCommitment
+		channel.RemoteCommitment = splice.RemoteCommitment
+
+		return putOpenChannel(newChanBucket, channel)
+	})
+	if err != nil {
+		return err
+	}
+
+	c.FundingOutpoint = splice.FundingOutpoint
+	c.Capacity = splice.Capacity
+	c.ShortChannelID = shortChanID
+	c.FundingBroadcastHeight = splice.BroadcastHeight
+	c.FundingTxn = splice.SpliceTx
+	c.LocalCommitment = splice.LocalCommitment
+	c.RemoteCommitment = splice.RemoteCommitment
+	c.Packager = NewChannelPackager(c.packagerSource())
+
+	return nil
+}
+
+// copyBucket recursively copies all keys and nested buckets of the source
+// bucket into the destination bucket.
+func copyBucket(dst, src *bolt.Bucket) error {
+	return src.ForEach(func(k, v []byte) error {
+		key := append([]byte(nil), k...)
+
+		// A nil value indicates a nested bucket.
+		if v == nil {
+			dstChild, err := dst.CreateBucket(key)
+			if err != nil {
+				return err
+			}
+
+			return copyBucket(dstChild, src.Bucket(k))
+		}
+
+		return dst.Put(key, append([]byte(nil), v...))
+	})
+}
+
+func serializeChannelSplice(w io.Writer, s *ChannelSplice) error {
+	err := WriteElements(w,
+		s.SpliceTx, s.FundingOutpoint, s.Capacity, s.IsInitiator,
+		s.BroadcastHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := serializeChanCommit(w, &s.LocalCommitment); err != nil {
+		return err
+	}
+
+	return serializeChanCommit(w, &s.RemoteCommitment)
+}
+
+func fetchChannelSplice(chanBucket *bolt.Bucket) (*ChannelSplice, error) {
+	spliceBytes := chanBucket.Get(pendingSpliceKey)
+	if spliceBytes == nil {
+		return nil, ErrNoPendingSplice
+	}
+
+	r := bytes.NewReader(spliceBytes)
+
+	s := &ChannelSplice{}
+	err := ReadElements(r,
+		&s.SpliceTx, &s.FundingOutpoint, &s.Capacity, &s.IsInitiator,
+		&s.BroadcastHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.LocalCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+	s.RemoteCommitment, err = deserializeChanCommit(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
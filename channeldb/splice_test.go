@@ -0,0 +1,133 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestChannelSplice tests that a pending splice can be stored for a channel,
+// and that completing the splice moves the channel on top of the new funding
+// output.
+func TestChannelSplice(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	state, err := createTestChannelState(cdb)
+	if err != nil {
+		t.Fatalf("unable to create channel state: %v", err)
+	}
+	if err := state.FullSync(); err != nil {
+		t.Fatalf("unable to save and serialize channel state: %v", err)
+	}
+
+	// Initially, the channel shouldn't have a pending splice.
+	if _, err := state.PendingSplice(); err != ErrNoPendingSplice {
+		t.Fatalf("expected ErrNoPendingSplice, got: %v", err)
+	}
+
+	// We'll now create a splice spending the current funding output of
+	// the channel, adding some funds to it.
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&state.FundingOutpoint, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(
+		int64(state.Capacity)+50000, testTx.TxOut[0].PkScript,
+	))
+
+	localCommit := state.LocalCommitment
+	localCommit.LocalBalance += 50000 * 1000
+	remoteCommit := state.RemoteCommitment
+	remoteCommit.RemoteBalance += 50000 * 1000
+
+	splice := &ChannelSplice{
+		SpliceTx: spliceTx,
+		FundingOutpoint: wire.OutPoint{
+			Hash:  spliceTx.TxHash(),
+			Index: 0,
+		},
+		Capacity:         state.Capacity + 50000,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+		IsInitiator:      true,
+		BroadcastHeight:  100,
+	}
+	if err := state.MarkSplicePending(splice); err != nil {
+		t.Fatalf("unable to mark splice pending: %v", err)
+	}
+
+	dbSplice, err := state.PendingSplice()
+	if err != nil {
+		t.Fatalf("unable to fetch pending splice: %v", err)
+	}
+	if dbSplice.SpliceTx.TxHash() != spliceTx.TxHash() {
+		t.Fatalf("splice tx doesn't match: %v vs %v",
+			spew.Sdump(spliceTx), spew.Sdump(dbSplice.SpliceTx))
+	}
+	if dbSplice.FundingOutpoint != splice.FundingOutpoint ||
+		dbSplice.Capacity != splice.Capacity ||
+		dbSplice.IsInitiator != splice.IsInitiator ||
+		dbSplice.BroadcastHeight != splice.BroadcastHeight {
+
+		t.Fatalf("splice doesn't match: %v vs %v",
+			spew.Sdump(splice), spew.Sdump(dbSplice))
+	}
+	assertCommitmentEqual(
+		t, &splice.LocalCommitment, &dbSplice.LocalCommitment,
+	)
+	assertCommitmentEqual(
+		t, &splice.RemoteCommitment, &dbSplice.RemoteCommitment,
+	)
+	if dbSplice.IsSigned() {
+		t.Fatalf("splice without witnesses shouldn't be signed")
+	}
+
+	// Once the splice confirms, the channel should be moved on top of the
+	// new funding output.
+	oldChanPoint := state.FundingOutpoint
+	spliceLoc := lnwire.ShortChannelID{
+		BlockHeight: 106,
+		TxIndex:     3,
+		TxPosition:  0,
+	}
+	if err := state.CompleteSplice(spliceLoc); err != nil {
+		t.Fatalf("unable to complete splice: %v", err)
+	}
+
+	if state.FundingOutpoint != splice.FundingOutpoint {
+		t.Fatalf("funding outpoint not updated: want %v, got %v",
+			splice.FundingOutpoint, state.FundingOutpoint)
+	}
+	if state.Packager.(*ChannelPackager).source != spliceLoc {
+		t.Fatalf("channel packager source was not updated")
+	}
+
+	openChannels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+	if len(openChannels) != 1 {
+		t.Fatalf("expected 1 open channel, got %d", len(openChannels))
+	}
+
+	dbChannel := openChannels[0]
+	if dbChannel.FundingOutpoint == oldChanPoint {
+		t.Fatalf("channel still stored under the prior funding output")
+	}
+	if !reflect.DeepEqual(state, dbChannel) {
+		t.Fatalf("channel state doesn't match: %v vs %v",
+			spew.Sdump(state), spew.Sdump(dbChannel))
+	}
+
+	// The splice itself should no longer be pending.
+	if _, err := dbChannel.PendingSplice(); err != ErrNoPendingSplice {
+		t.Fatalf("expected ErrNoPendingSplice, got: %v", err)
+	}
+}
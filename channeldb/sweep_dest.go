@@ -0,0 +1,130 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// sweepDestBucket stores the destination that swept funds should be
+	// sent to, if one has been set.
+	sweepDestBucket = []byte("sweep-dest-bucket")
+
+	// sweepDestKey is the key within the sweepDestBucket that the
+	// serialized SweepDestination is stored under.
+	sweepDestKey = []byte("sweep-dest")
+)
+
+// SweepDestination describes where the funds swept from closed channels,
+// and those paid out to us by cooperative closes, should be sent to. Either
+// a fixed address, or an extended public key that addresses are derived from
+// is set.
+type SweepDestination struct {
+	// Address is the encoded address all swept funds are sent to. If set,
+	// XPub is empty.
+	Address string
+
+	// XPub is the encoded extended public key that a fresh address is
+	// derived from for each sweep. If set, Address is empty.
+	XPub string
+
+	// NextIndex is the index of the child key of the external branch of
+	// XPub that the next address will be derived from.
+	NextIndex uint32
+}
+
+// PutSweepDestination persists the destination that swept funds should be
+// sent to, replacing any prior destination.
+func (d *DB) PutSweepDestination(dest *SweepDestination) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		destBucket, err := tx.CreateBucketIfNotExists(sweepDestBucket)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeSweepDestination(&b, dest); err != nil {
+			return err
+		}
+
+		return destBucket.Put(sweepDestKey, b.Bytes())
+	})
+}
+
+// FetchSweepDestination returns the destination that swept funds should be
+// sent to. If no destination has been set, ErrNoSweepDestination is
+// returned.
+func (d *DB) FetchSweepDestination() (*SweepDestination, error) {
+	var dest *SweepDestination
+	err := d.View(func(tx *bolt.Tx) error {
+		destBucket := tx.Bucket(sweepDestBucket)
+		if destBucket == nil {
+			return ErrNoSweepDestination
+		}
+
+		destBytes := destBucket.Get(sweepDestKey)
+		if destBytes == nil {
+			return ErrNoSweepDestination
+		}
+
+		var err error
+		dest, err = deserializeSweepDestination(
+			bytes.NewReader(destBytes),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dest, nil
+}
+
+// DeleteSweepDestination removes the destination that swept funds should be
+// sent to, such that they are sent to the wallet again.
+func (d *DB) DeleteSweepDestination() error {
+	return d.Update(func(tx *bolt.Tx) error {
+		destBucket := tx.Bucket(sweepDestBucket)
+		if destBucket == nil {
+			return nil
+		}
+
+		return destBucket.Delete(sweepDestKey)
+	})
+}
+
+func serializeSweepDestination(w io.Writer, dest *SweepDestination) error {
+	if err := wire.WriteVarString(w, 0, dest.Address); err != nil {
+		return err
+	}
+	if err := wire.WriteVarString(w, 0, dest.XPub); err != nil {
+		return err
+	}
+
+	return WriteElement(w, dest.NextIndex)
+}
+
+func deserializeSweepDestination(r io.Reader) (*SweepDestination, error) {
+	var (
+		dest SweepDestination
+		err  error
+	)
+
+	dest.Address, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+	dest.XPub, err = wire.ReadVarString(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ReadElement(r, &dest.NextIndex); err != nil {
+		return nil, err
+	}
+
+	return &dest, nil
+}
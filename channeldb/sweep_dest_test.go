@@ -0,0 +1,62 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestSweepDestination tests that the sweep destination can be stored,
+// replaced and deleted.
+func TestSweepDestination(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Initially, no sweep destination should be set.
+	if _, err := cdb.FetchSweepDestination(); err != ErrNoSweepDestination {
+		t.Fatalf("expected ErrNoSweepDestination, got %v", err)
+	}
+
+	assertDest := func(expected *SweepDestination) {
+		t.Helper()
+
+		dest, err := cdb.FetchSweepDestination()
+		if err != nil {
+			t.Fatalf("unable to fetch sweep destination: %v", err)
+		}
+		if !reflect.DeepEqual(dest, expected) {
+			t.Fatalf("expected sweep destination %v, got %v",
+				expected, dest)
+		}
+	}
+
+	addrDest := &SweepDestination{
+		Address: "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+	}
+	if err := cdb.PutSweepDestination(addrDest); err != nil {
+		t.Fatalf("unable to put sweep destination: %v", err)
+	}
+	assertDest(addrDest)
+
+	// Storing another destination should replace the prior one.
+	xpubDest := &SweepDestination{
+		XPub:      "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8Nq",
+		NextIndex: 7,
+	}
+	if err := cdb.PutSweepDestination(xpubDest); err != nil {
+		t.Fatalf("unable to put sweep destination: %v", err)
+	}
+	assertDest(xpubDest)
+
+	// Finally, after deleting the destination, none should be set.
+	if err := cdb.DeleteSweepDestination(); err != nil {
+		t.Fatalf("unable to delete sweep destination: %v", err)
+	}
+	if _, err := cdb.FetchSweepDestination(); err != ErrNoSweepDestination {
+		t.Fatalf("expected ErrNoSweepDestination, got %v", err)
+	}
+}
@@ -0,0 +1,859 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/txsort"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrChanAlreadySplicing is returned when a splice of a channel is
+	// attempted while another splice of the channel is still pending.
+	ErrChanAlreadySplicing = fmt.Errorf("channel splice already initiated")
+
+	// ErrSpliceTimeout is returned when the negotiation of a splice
+	// doesn't complete in time.
+	ErrSpliceTimeout = fmt.Errorf("splice negotiation timed out")
+)
+
+const (
+	// spliceNegotiationTimeout is the time after which a splice that
+	// hasn't been committed to is aborted, returning the channel to its
+	// normal state.
+	spliceNegotiationTimeout = 2 * time.Minute
+)
+
+// spliceState represents all the possible states the channel splicer state
+// machine can be in. Each message will either advance to the next state, or
+// fail the splice.
+type spliceState uint8
+
+const (
+	// spliceIdle is the initial starting state. If a state machine
+	// receives a SpliceInit message while in this state, then it is the
+	// responder to a splice initiated by the remote party.
+	spliceIdle spliceState = iota
+
+	// spliceInitSent is the state that's transitioned to once the
+	// initiator of a splice has sent its SpliceInit message. At this
+	// point, it's waiting for the responder to accept the splice.
+	spliceInitSent
+
+	// spliceTxNegotiation is the state in which the responder collects
+	// the inputs and outputs the initiator adds to the splice transaction.
+	spliceTxNegotiation
+
+	// spliceCommitSigning is the state in which both parties exchange
+	// signatures for the commitment transactions spending the new funding
+	// output. The responder signs first, followed by the initiator.
+	spliceCommitSigning
+
+	// spliceTxSigning is the state in which both parties exchange the
+	// witnesses of their inputs to the splice transaction. The initiator
+	// sends its witnesses first, as the responder only needs to sign the
+	// input spending the current funding output.
+	spliceTxSigning
+
+	// spliceAwaitingConf is the final state of the state machine. The
+	// splice transaction has been fully signed and broadcast, and both
+	// parties are waiting for it to confirm before exchanging their
+	// SpliceLocked messages.
+	spliceAwaitingConf
+)
+
+// spliceReq is a local request to splice funds into or out of an active
+// channel.
+type spliceReq struct {
+	// chanPoint is the funding output of the channel to be spliced.
+	chanPoint *wire.OutPoint
+
+	// amt is the amount to splice into the channel from the wallet. A
+	// negative amount is spliced out of the channel, to the delivery
+	// script.
+	amt btcutil.Amount
+
+	// deliveryScript is the script the funds spliced out of the channel
+	// are sent to.
+	deliveryScript []byte
+
+	// feeRate is the fee rate of the splice transaction.
+	feeRate lnwallet.SatPerKWeight
+
+	// resp is sent upon with the new funding output of the channel, once
+	// the splice transaction has been broadcast.
+	resp chan *wire.OutPoint
+
+	// err is sent upon if the splice fails.
+	err chan error
+}
+
+// chanSpliceCfg holds all the items that a channelSplicer requires to carry
+// out its duties.
+type chanSpliceCfg struct {
+	// channel is the channel that should be spliced.
+	channel *lnwallet.LightningChannel
+
+	// claimQuiescence claims the quiescent state of the link of the
+	// channel for the splice, preventing the link from returning to its
+	// normal state before the splice completes. An error is returned if
+	// the link isn't quiescent, as a channel may only be spliced once
+	// quiescent.
+	claimQuiescence func() error
+
+	// fundSplice selects coins of the wallet which add the given amount to
+	// the channel.
+	fundSplice func(btcutil.Amount,
+		lnwallet.SatPerKWeight) (*lnwallet.ChannelContribution, error)
+
+	// fetchInputInfo returns the output of the wallet spent by the passed
+	// outpoint.
+	fetchInputInfo func(*wire.OutPoint) (*wire.TxOut, error)
+
+	// signInputs signs the inputs of the splice transaction that spend the
+	// passed coins of the wallet.
+	signInputs func(*wire.MsgTx, []*wire.TxIn) ([]*lnwallet.InputScript,
+		error)
+
+	// releaseInputs unlocks any coins of the wallet spent by the passed
+	// transaction.
+	releaseInputs func(*wire.MsgTx) error
+
+	// broadcastTx broadcasts the passed transaction to the network.
+	broadcastTx func(*wire.MsgTx) error
+
+	// bestHeight returns the current height of the chain.
+	bestHeight func() (uint32, error)
+}
+
+// channelSplicer is a state machine that handles the splice of an active
+// channel. Once the channel is quiescent, the initiator proposes the amount
+// it adds to, or removes from the channel, then adds its inputs and outputs to
+// the splice transaction. Both parties then exchange signatures for the
+// commitments spending the new funding output, followed by the witnesses of
+// the splice transaction. Once the splice transaction confirms, both parties
+// exchange SpliceLocked messages, after which the channel continues on top of
+// the new funding output.
+//
+// TODO(roasbeef): allow the responder to contribute to splices as well.
+type channelSplicer struct {
+	// state is the current state of the state machine.
+	state spliceState
+
+	// cfg holds the configuration for this channelSplicer instance.
+	cfg chanSpliceCfg
+
+	// chanPoint is the funding output the channel is spliced from.
+	chanPoint wire.OutPoint
+
+	// cid is the channel ID of the channel being spliced.
+	cid lnwire.ChannelID
+
+	// spliceReq is the local splice request. This will only be populated
+	// if we're the initiator of the splice.
+	spliceReq *spliceReq
+
+	// relativeAmt is the amount by which the initiator's balance changes
+	// as a result of the splice.
+	relativeAmt btcutil.Amount
+
+	// inputs and outputs are the inputs and outputs the initiator adds to
+	// the splice transaction, besides the current and new funding output.
+	inputs  []*wire.TxIn
+	outputs []*wire.TxOut
+
+	// splice is the splice being negotiated. This is populated once the
+	// splice transaction has been constructed.
+	splice *lnwallet.Splice
+
+	// ourFundingSig is our signature for the input of the splice
+	// transaction spending the current funding output.
+	ourFundingSig []byte
+
+	// pendingSplice is the splice as stored within the database. This is
+	// populated once the splice has been committed to.
+	pendingSplice *channeldb.ChannelSplice
+
+	// shortChanID is the short channel ID of the channel on top of the new
+	// funding output. This is populated once the splice transaction has
+	// sufficiently confirmed.
+	shortChanID *lnwire.ShortChannelID
+
+	// remoteLocked is true once we've received the remote party's
+	// SpliceLocked message.
+	remoteLocked bool
+}
+
+// newChannelSplicer creates a new instance of the channel splicer state
+// machine. If the passed splice request is nil, then we're the responder to a
+// splice initiated by the remote party.
+func newChannelSplicer(cfg chanSpliceCfg, req *spliceReq) *channelSplicer {
+	chanPoint := cfg.channel.ChannelPoint()
+
+	return &channelSplicer{
+		state:     spliceIdle,
+		cfg:       cfg,
+		chanPoint: *chanPoint,
+		cid:       lnwire.NewChanIDFromOutPoint(chanPoint),
+		spliceReq: req,
+	}
+}
+
+// restoreChannelSplicer creates a channel splicer for a splice that was
+// committed to before a restart, which is waiting for the splice transaction
+// to confirm.
+func restoreChannelSplicer(cfg chanSpliceCfg,
+	pendingSplice *channeldb.ChannelSplice) *channelSplicer {
+
+	c := newChannelSplicer(cfg, nil)
+	c.state = spliceAwaitingConf
+	c.pendingSplice = pendingSplice
+
+	return c
+}
+
+// SpliceRequest returns the local splice request. This will be nil if we're
+// the responder of the splice.
+func (c *channelSplicer) SpliceRequest() *spliceReq {
+	return c.spliceReq
+}
+
+// PendingSplice returns the splice as it is stored within the database, or
+// nil if the splice hasn't been committed to yet.
+func (c *channelSplicer) PendingSplice() *channeldb.ChannelSplice {
+	return c.pendingSplice
+}
+
+// ShortChanID returns the short channel ID of the channel on top of the new
+// funding output, or nil if the splice transaction hasn't confirmed yet.
+func (c *channelSplicer) ShortChanID() *lnwire.ShortChannelID {
+	return c.shortChanID
+}
+
+// IsAwaitingConf returns true once the splice transaction has been broadcast,
+// and we're waiting for it to confirm.
+func (c *channelSplicer) IsAwaitingConf() bool {
+	return c.state == spliceAwaitingConf
+}
+
+// AwaitConf transitions the splicer to wait for the splice transaction to
+// confirm. This is used once a splice that has already been committed to
+// fails, as it may no longer be aborted.
+func (c *channelSplicer) AwaitConf() {
+	c.state = spliceAwaitingConf
+}
+
+// IsLocked returns true once the splice transaction has sufficiently
+// confirmed, and both parties have sent their SpliceLocked message.
+func (c *channelSplicer) IsLocked() bool {
+	return c.shortChanID != nil && c.remoteLocked
+}
+
+// InitSplice kicks off the splice of the channel. Our contribution to the
+// splice transaction is assembled, and the SpliceInit message which proposes
+// the splice to the remote party is returned.
+//
+// NOTE: The channel link must be quiescent before a splice is initiated.
+func (c *channelSplicer) InitSplice() (*lnwire.SpliceInit, error) {
+	if c.state != spliceIdle {
+		return nil, ErrChanAlreadySplicing
+	}
+
+	req := c.spliceReq
+	switch {
+	// Funds added to the channel are taken from the wallet, which also
+	// pays the fee of the splice transaction.
+	case req.amt > 0:
+		contribution, err := c.cfg.fundSplice(req.amt, req.feeRate)
+		if err != nil {
+			return nil, err
+		}
+
+		c.relativeAmt = req.amt
+		c.inputs = contribution.Inputs
+		c.outputs = contribution.ChangeOutputs
+
+	// Funds removed from the channel are sent to the delivery script, with
+	// the fee of the splice transaction being paid from our balance.
+	case req.amt < 0:
+		if -req.amt <= lnwallet.DefaultDustLimit() {
+			return nil, fmt.Errorf("spliced out amount %v is below "+
+				"the dust limit", -req.amt)
+		}
+
+		var weightEstimate lnwallet.TxWeightEstimator
+		weightEstimate.AddWitnessInput(lnwallet.WitnessSize)
+		weightEstimate.AddP2WSHOutput()
+		weightEstimate.AddP2WSHOutput()
+		fee := req.feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+
+		c.relativeAmt = req.amt - fee
+		c.outputs = []*wire.TxOut{{
+			Value:    int64(-req.amt),
+			PkScript: req.deliveryScript,
+		}}
+
+	default:
+		return nil, fmt.Errorf("splice amount must be non-zero")
+	}
+
+	peerLog.Infof("ChannelPoint(%v): initiating splice, relative "+
+		"amount=%v, fee_rate=%v", c.chanPoint, c.relativeAmt,
+		req.feeRate)
+
+	c.state = spliceInitSent
+
+	return &lnwire.SpliceInit{
+		ChanID:           c.cid,
+		RelativeAmount:   c.relativeAmt,
+		FeePerKiloWeight: uint32(req.feeRate),
+	}, nil
+}
+
+// ReleaseInputs unlocks the coins of the wallet we've added to the splice
+// transaction, as the splice has failed before it was committed to.
+func (c *channelSplicer) ReleaseInputs() {
+	if len(c.inputs) == 0 || c.pendingSplice != nil {
+		return
+	}
+
+	tx := wire.NewMsgTx(2)
+	for _, txIn := range c.inputs {
+		tx.AddTxIn(txIn)
+	}
+	if err := c.cfg.releaseInputs(tx); err != nil {
+		peerLog.Errorf("ChannelPoint(%v): unable to release splice "+
+			"inputs: %v", c.chanPoint, err)
+	}
+}
+
+// ProcessSpliceMsg attempts to process the next message in the splice
+// negotiation. The set of messages to send to the remote party is returned,
+// along with a bool indicating whether the splice transaction has been fully
+// signed and broadcast, in which case we wait for it to confirm.
+func (c *channelSplicer) ProcessSpliceMsg(
+	msg lnwire.Message) ([]lnwire.Message, bool, error) {
+
+	switch msg := msg.(type) {
+	case *lnwire.SpliceInit:
+		return c.processSpliceInit(msg)
+
+	case *lnwire.SpliceAck:
+		return c.processSpliceAck(msg)
+
+	case *lnwire.TxAddInput:
+		return nil, false, c.addRemoteInput(msg)
+
+	case *lnwire.TxAddOutput:
+		return nil, false, c.addRemoteOutput(msg)
+
+	case *lnwire.TxComplete:
+		return c.processTxComplete(msg)
+
+	case *lnwire.SpliceSigned:
+		return c.processSpliceSigned(msg)
+
+	case *lnwire.TxSignatures:
+		return c.processTxSignatures(msg)
+
+	case *lnwire.SpliceLocked:
+		if c.state != spliceAwaitingConf {
+			return nil, false, ErrInvalidState
+		}
+
+		spliceTxid := c.pendingSplice.SpliceTx.TxHash()
+		if msg.SpliceTxid != spliceTxid {
+			return nil, false, fmt.Errorf("splice txid mismatch: "+
+				"expected %v, got %v", spliceTxid,
+				msg.SpliceTxid)
+		}
+
+		peerLog.Infof("ChannelPoint(%v): remote party locked splice "+
+			"%v", c.chanPoint, spliceTxid)
+
+		c.remoteLocked = true
+
+		return nil, false, nil
+
+	default:
+		return nil, false, fmt.Errorf("unexpected splice message "+
+			"%T", msg)
+	}
+}
+
+// SpliceConfirmed records that the splice transaction has sufficiently
+// confirmed at the position denoted by the given short channel ID, returning
+// the SpliceLocked message to send to the remote party.
+func (c *channelSplicer) SpliceConfirmed(
+	shortChanID lnwire.ShortChannelID) *lnwire.SpliceLocked {
+
+	c.shortChanID = &shortChanID
+
+	return &lnwire.SpliceLocked{
+		ChanID:     c.cid,
+		SpliceTxid: c.pendingSplice.SpliceTx.TxHash(),
+	}
+}
+
+// processSpliceInit validates the splice proposed by the remote party. As we
+// don't contribute to splices initiated by the remote party, we'll accept the
+// splice without adding any funds of our own.
+func (c *channelSplicer) processSpliceInit(
+	msg *lnwire.SpliceInit) ([]lnwire.Message, bool, error) {
+
+	switch {
+	case c.state != spliceIdle:
+		return nil, false, ErrChanAlreadySplicing
+
+	case len(c.cfg.channel.ActiveHtlcs()) != 0:
+		return nil, false, fmt.Errorf("cannot splice channel w/ " +
+			"active htlcs")
+
+	case msg.RelativeAmount == 0:
+		return nil, false, fmt.Errorf("splice amount must be non-zero")
+	}
+
+	if err := c.cfg.claimQuiescence(); err != nil {
+		return nil, false, err
+	}
+
+	peerLog.Infof("ChannelPoint(%v): responding to splice, relative "+
+		"amount=%v", c.chanPoint, msg.RelativeAmount)
+
+	c.relativeAmt = msg.RelativeAmount
+	c.state = spliceTxNegotiation
+
+	return []lnwire.Message{&lnwire.SpliceAck{
+		ChanID:         c.cid,
+		RelativeAmount: 0,
+	}}, false, nil
+}
+
+// processSpliceAck handles the acceptance of our splice by the remote party.
+// We'll send over our inputs and outputs to the splice transaction, and
+// create the commitments spending the new funding output.
+func (c *channelSplicer) processSpliceAck(
+	msg *lnwire.SpliceAck) ([]lnwire.Message, bool, error) {
+
+	switch {
+	case c.state != spliceInitSent:
+		return nil, false, ErrInvalidState
+
+	case msg.RelativeAmount != 0:
+		return nil, false, fmt.Errorf("remote contributions to " +
+			"splices are not supported")
+	}
+
+	if err := c.createSplice(true); err != nil {
+		return nil, false, err
+	}
+
+	msgs := make([]lnwire.Message, 0, len(c.inputs)+len(c.outputs)+1)
+	for _, txIn := range c.inputs {
+		prevOut, err := c.cfg.fetchInputInfo(&txIn.PreviousOutPoint)
+		if err != nil {
+			return nil, false, err
+		}
+
+		msgs = append(msgs, &lnwire.TxAddInput{
+			PendingChannelID: c.cid,
+			PreviousOutPoint: txIn.PreviousOutPoint,
+			Sequence:         txIn.Sequence,
+			Value:            btcutil.Amount(prevOut.Value),
+			PkScript:         prevOut.PkScript,
+		})
+	}
+	for _, txOut := range c.outputs {
+		msgs = append(msgs, &lnwire.TxAddOutput{
+			PendingChannelID: c.cid,
+			Value:            btcutil.Amount(txOut.Value),
+			PkScript:         txOut.PkScript,
+		})
+	}
+	msgs = append(msgs, &lnwire.TxComplete{
+		PendingChannelID: c.cid,
+		FundingAmount:    c.relativeAmt,
+	})
+
+	c.state = spliceCommitSigning
+
+	return msgs, false, nil
+}
+
+// addRemoteInput adds an input of the initiator to the splice transaction.
+func (c *channelSplicer) addRemoteInput(msg *lnwire.TxAddInput) error {
+	if c.state != spliceTxNegotiation {
+		return ErrInvalidState
+	}
+	if len(c.inputs) >= maxRemoteFundingTxAdditions {
+		return fmt.Errorf("remote party added more than %v inputs",
+			maxRemoteFundingTxAdditions)
+	}
+
+	// Each outpoint may only be spent once within the splice transaction.
+	if msg.PreviousOutPoint == c.chanPoint {
+		return fmt.Errorf("input %v already added",
+			msg.PreviousOutPoint)
+	}
+	for _, txIn := range c.inputs {
+		if txIn.PreviousOutPoint == msg.PreviousOutPoint {
+			return fmt.Errorf("input %v already added",
+				msg.PreviousOutPoint)
+		}
+	}
+
+	txIn := wire.NewTxIn(&msg.PreviousOutPoint, nil, nil)
+	txIn.Sequence = msg.Sequence
+	c.inputs = append(c.inputs, txIn)
+
+	return nil
+}
+
+// addRemoteOutput adds an output of the initiator to the splice transaction.
+func (c *channelSplicer) addRemoteOutput(msg *lnwire.TxAddOutput) error {
+	if c.state != spliceTxNegotiation {
+		return ErrInvalidState
+	}
+	if len(c.outputs) >= maxRemoteFundingTxAdditions {
+		return fmt.Errorf("remote party added more than %v outputs",
+			maxRemoteFundingTxAdditions)
+	}
+	if msg.Value <= lnwallet.DefaultDustLimit() {
+		return fmt.Errorf("output value %v is below the dust limit",
+			msg.Value)
+	}
+
+	c.outputs = append(c.outputs, &wire.TxOut{
+		Value:    int64(msg.Value),
+		PkScript: msg.PkScript,
+	})
+
+	return nil
+}
+
+// processTxComplete handles the completed contribution of the initiator to
+// the splice transaction. We'll create the commitments spending the new
+// funding output, and send over our signature for the initiator's commitment.
+func (c *channelSplicer) processTxComplete(
+	msg *lnwire.TxComplete) ([]lnwire.Message, bool, error) {
+
+	switch {
+	case c.state != spliceTxNegotiation:
+		return nil, false, ErrInvalidState
+
+	case msg.FundingAmount != c.relativeAmt:
+		return nil, false, fmt.Errorf("initiator contributes %v, "+
+			"expected %v", msg.FundingAmount, c.relativeAmt)
+	}
+
+	if err := c.createSplice(false); err != nil {
+		return nil, false, err
+	}
+
+	commitSig, err := c.cfg.channel.SignSplice(c.splice)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.state = spliceCommitSigning
+
+	return []lnwire.Message{&lnwire.SpliceSigned{
+		ChanID:    c.cid,
+		CommitSig: commitSig,
+	}}, false, nil
+}
+
+// createSplice assembles the splice transaction from the current funding
+// output and the initiator's inputs and outputs, then creates the
+// commitments spending its new funding output.
+func (c *channelSplicer) createSplice(isInitiator bool) error {
+	fundingScript, err := makeFundingScript(c.cfg.channel.State())
+	if err != nil {
+		return err
+	}
+	capacity := c.cfg.channel.State().Capacity + c.relativeAmt
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&c.chanPoint, nil, nil))
+	for _, txIn := range c.inputs {
+		spliceTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: txIn.PreviousOutPoint,
+			Sequence:         txIn.Sequence,
+		})
+	}
+	spliceTx.AddTxOut(wire.NewTxOut(int64(capacity), fundingScript))
+	for _, txOut := range c.outputs {
+		spliceTx.AddTxOut(wire.NewTxOut(txOut.Value, txOut.PkScript))
+	}
+	txsort.InPlaceSort(spliceTx)
+
+	localDelta, remoteDelta := c.relativeAmt, btcutil.Amount(0)
+	if !isInitiator {
+		localDelta, remoteDelta = remoteDelta, localDelta
+	}
+
+	c.splice, err = c.cfg.channel.NewSplice(
+		spliceTx, localDelta, remoteDelta, isInitiator,
+	)
+	if err != nil {
+		return err
+	}
+
+	peerLog.Infof("ChannelPoint(%v): created splice into "+
+		"ChannelPoint(%v) with capacity %v", c.chanPoint,
+		c.splice.FundingOutpoint, c.splice.Capacity)
+
+	return nil
+}
+
+// processSpliceSigned handles the remote party's signature for our
+// commitment spending the new funding output. If we're the initiator, we'll
+// reply with our own signature, and commit to the splice by sending the
+// witnesses of our inputs to the splice transaction.
+func (c *channelSplicer) processSpliceSigned(
+	msg *lnwire.SpliceSigned) ([]lnwire.Message, bool, error) {
+
+	if c.state != spliceCommitSigning {
+		return nil, false, ErrInvalidState
+	}
+
+	err := c.cfg.channel.ReceiveSpliceSig(c.splice, msg.CommitSig)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.state = spliceTxSigning
+
+	// If we're the responder, we've already sent our signature, so we'll
+	// wait for the initiator's witnesses.
+	if !c.splice.IsInitiator {
+		return nil, false, nil
+	}
+
+	commitSig, err := c.cfg.channel.SignSplice(c.splice)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.ourFundingSig, err = c.cfg.channel.SignSpliceFundingInput(c.splice)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The witness of the input spending the current funding output is
+	// sent first, followed by the witnesses of our own inputs in the
+	// order they appear within the splice transaction.
+	spliceTx := c.splice.Tx
+	inputScripts, err := c.cfg.signInputs(spliceTx, c.inputs)
+	if err != nil {
+		return nil, false, err
+	}
+	witnesses := make([]lnwire.InputWitness, 0, len(inputScripts)+1)
+	witnesses = append(witnesses, lnwire.InputWitness{
+		Witness: wire.TxWitness{c.ourFundingSig},
+	})
+	for _, inputScript := range inputScripts {
+		witnesses = append(witnesses, lnwire.InputWitness{
+			SigScript: inputScript.ScriptSig,
+			Witness:   inputScript.Witness,
+		})
+	}
+	if err := c.applyInputWitnesses(witnesses[1:]); err != nil {
+		return nil, false, err
+	}
+
+	// Before handing out our signature for the current funding output,
+	// we'll commit to the splice, as the responder may broadcast the
+	// splice transaction as soon as it has received our signature.
+	if err := c.commitSplice(); err != nil {
+		return nil, false, err
+	}
+
+	return []lnwire.Message{
+		&lnwire.SpliceSigned{
+			ChanID:    c.cid,
+			CommitSig: commitSig,
+		},
+		&lnwire.TxSignatures{
+			PendingChannelID: c.cid,
+			TxHash:           c.splice.FundingOutpoint.Hash,
+			Witnesses:        witnesses,
+		},
+	}, false, nil
+}
+
+// applyInputWitnesses populates the initiator's inputs to the splice
+// transaction with the passed witnesses, in the order the inputs appear
+// within the transaction.
+func (c *channelSplicer) applyInputWitnesses(
+	witnesses []lnwire.InputWitness) error {
+
+	spliceTx := c.splice.Tx
+	if len(witnesses) != len(spliceTx.TxIn)-1 {
+		return fmt.Errorf("expected %v input witnesses, got %v",
+			len(spliceTx.TxIn)-1, len(witnesses))
+	}
+
+	i := 0
+	for inputIndex, txIn := range spliceTx.TxIn {
+		if inputIndex == c.splice.FundingInputIndex() {
+			continue
+		}
+
+		txIn.SignatureScript = witnesses[i].SigScript
+		txIn.Witness = witnesses[i].Witness
+		i++
+	}
+
+	// As the txid of the splice transaction is committed to by the new
+	// commitments, all inputs must spend witness outputs whose txid isn't
+	// malleated by their sigScript.
+	//
+	// TODO(roasbeef): restrict coin selection of splices to native
+	// witness outputs.
+	if spliceTx.TxHash() != c.splice.FundingOutpoint.Hash {
+		return fmt.Errorf("input witnesses alter splice txid")
+	}
+
+	return nil
+}
+
+// commitSplice persists the splice within the database. From this point on,
+// the splice transaction may confirm, so we'll need to watch for it.
+func (c *channelSplicer) commitSplice() error {
+	bestHeight, err := c.cfg.bestHeight()
+	if err != nil {
+		return err
+	}
+
+	pendingSplice := c.splice.ToDisk(bestHeight)
+	err = c.cfg.channel.State().MarkSplicePending(pendingSplice)
+	if err != nil {
+		return err
+	}
+	c.pendingSplice = pendingSplice
+
+	return nil
+}
+
+// processTxSignatures handles the remote party's witnesses for its inputs to
+// the splice transaction. If we're the responder, we'll complete the splice
+// transaction with our own signature for the current funding output, and
+// send it over to the initiator. Either way, the splice transaction is now
+// complete, so it's broadcast.
+func (c *channelSplicer) processTxSignatures(
+	msg *lnwire.TxSignatures) ([]lnwire.Message, bool, error) {
+
+	switch {
+	case c.state != spliceTxSigning:
+		return nil, false, ErrInvalidState
+
+	case msg.TxHash != c.splice.FundingOutpoint.Hash:
+		return nil, false, fmt.Errorf("splice txid mismatch: "+
+			"expected %v, got %v", c.splice.FundingOutpoint.Hash,
+			msg.TxHash)
+
+	case len(msg.Witnesses) == 0 || len(msg.Witnesses[0].Witness) != 1:
+		return nil, false, fmt.Errorf("missing signature for " +
+			"funding input")
+	}
+	remoteFundingSig := msg.Witnesses[0].Witness[0]
+
+	if c.splice.IsInitiator {
+		return c.completeInitiatorSplice(remoteFundingSig)
+	}
+
+	if err := c.applyInputWitnesses(msg.Witnesses[1:]); err != nil {
+		return nil, false, err
+	}
+
+	ourFundingSig, err := c.cfg.channel.SignSpliceFundingInput(c.splice)
+	if err != nil {
+		return nil, false, err
+	}
+	err = c.cfg.channel.CompleteSpliceFundingInput(
+		c.splice, ourFundingSig, remoteFundingSig,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// With the splice transaction complete, we'll commit to the splice
+	// and broadcast it. If the transaction is rejected, the initiator
+	// doesn't have our signature yet, so we're able to abandon the
+	// splice.
+	if err := c.commitSplice(); err != nil {
+		return nil, false, err
+	}
+
+	spliceTx := c.splice.Tx
+	peerLog.Infof("Broadcasting splice tx: %v", newLogClosure(func() string {
+		return spew.Sdump(spliceTx)
+	}))
+
+	err = c.cfg.broadcastTx(spliceTx)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		if err := c.cfg.channel.State().AbandonSplice(); err != nil {
+			peerLog.Errorf("ChannelPoint(%v): unable to abandon "+
+				"splice: %v", c.chanPoint, err)
+		}
+		c.pendingSplice = nil
+
+		return nil, false, fmt.Errorf("unable to broadcast splice "+
+			"tx: %v", err)
+	}
+
+	c.state = spliceAwaitingConf
+
+	return []lnwire.Message{&lnwire.TxSignatures{
+		PendingChannelID: c.cid,
+		TxHash:           c.splice.FundingOutpoint.Hash,
+		Witnesses: []lnwire.InputWitness{{
+			Witness: wire.TxWitness{ourFundingSig},
+		}},
+	}}, true, nil
+}
+
+// completeInitiatorSplice completes the splice transaction with the
+// responder's signature for the current funding output, then broadcasts it.
+func (c *channelSplicer) completeInitiatorSplice(
+	remoteFundingSig []byte) ([]lnwire.Message, bool, error) {
+
+	err := c.cfg.channel.CompleteSpliceFundingInput(
+		c.splice, c.ourFundingSig, remoteFundingSig,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// We'll update the stored splice with the fully signed transaction,
+	// allowing it to be rebroadcast after a restart.
+	c.pendingSplice.SpliceTx = c.splice.Tx
+	err = c.cfg.channel.State().MarkSplicePending(c.pendingSplice)
+	if err != nil {
+		return nil, false, err
+	}
+
+	c.state = spliceAwaitingConf
+
+	// The responder broadcasts the splice transaction as well, so we'll
+	// only log any failure to do so.
+	spliceTx := c.splice.Tx
+	peerLog.Infof("Broadcasting splice tx: %v", newLogClosure(func() string {
+		return spew.Sdump(spliceTx)
+	}))
+
+	err = c.cfg.broadcastTx(spliceTx)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		peerLog.Errorf("ChannelPoint(%v): unable to broadcast splice "+
+			"tx: %v", c.chanPoint, err)
+	}
+
+	return nil, true, nil
+}
@@ -0,0 +1,236 @@
+// +build !rpctest
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// newTestSpliceCfg returns a splice config for the given channel which
+// records the transactions it broadcasts.
+func newTestSpliceCfg(channel *lnwallet.LightningChannel,
+	published *[]*wire.MsgTx) chanSpliceCfg {
+
+	return chanSpliceCfg{
+		channel:         channel,
+		claimQuiescence: func() error { return nil },
+		fundSplice: func(btcutil.Amount,
+			lnwallet.SatPerKWeight) (*lnwallet.ChannelContribution,
+			error) {
+
+			return &lnwallet.ChannelContribution{}, nil
+		},
+		fetchInputInfo: func(*wire.OutPoint) (*wire.TxOut, error) {
+			return &wire.TxOut{}, nil
+		},
+		signInputs: func(*wire.MsgTx,
+			[]*wire.TxIn) ([]*lnwallet.InputScript, error) {
+
+			return nil, nil
+		},
+		releaseInputs: func(*wire.MsgTx) error { return nil },
+		broadcastTx: func(tx *wire.MsgTx) error {
+			*published = append(*published, tx)
+			return nil
+		},
+		bestHeight: func() (uint32, error) { return 100, nil },
+	}
+}
+
+// deliverSpliceMsgs delivers the given messages to the splicer, returning
+// its replies, and whether it broadcast the splice transaction.
+func deliverSpliceMsgs(t *testing.T, c *channelSplicer,
+	msgs []lnwire.Message) ([]lnwire.Message, bool) {
+
+	var (
+		replies     []lnwire.Message
+		broadcasted bool
+	)
+	for _, msg := range msgs {
+		out, done, err := c.ProcessSpliceMsg(msg)
+		if err != nil {
+			t.Fatalf("unable to process %T: %v", msg, err)
+		}
+		replies = append(replies, out...)
+		broadcasted = broadcasted || done
+	}
+
+	return replies, broadcasted
+}
+
+// TestChannelSplicerSpliceOut tests that two channel splicers are able to
+// negotiate, sign and lock in a splice which removes funds from the channel.
+func TestChannelSplicerSpliceOut(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	_, aliceChan, bobChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// The test channels are created with random reserves, so we'll pin
+	// them to a value the spliced out amount can be withdrawn above.
+	const chanReserve = btcutil.Amount(10000)
+	for _, channel := range []*lnwallet.LightningChannel{aliceChan, bobChan} {
+		channel.State().LocalChanCfg.ChanReserve = chanReserve
+		channel.State().RemoteChanCfg.ChanReserve = chanReserve
+	}
+
+	var alicePublished, bobPublished []*wire.MsgTx
+	const spliceAmt = btcutil.Amount(100000)
+	req := &spliceReq{
+		chanPoint:      aliceChan.ChannelPoint(),
+		amt:            -spliceAmt,
+		deliveryScript: dummyDeliveryScript,
+		feeRate:        lnwallet.FeePerKwFloor,
+		resp:           make(chan *wire.OutPoint, 1),
+		err:            make(chan error, 1),
+	}
+	alice := newChannelSplicer(
+		newTestSpliceCfg(aliceChan, &alicePublished), req,
+	)
+	bob := newChannelSplicer(newTestSpliceCfg(bobChan, &bobPublished), nil)
+
+	spliceInit, err := alice.InitSplice()
+	if err != nil {
+		t.Fatalf("unable to init splice: %v", err)
+	}
+	if spliceInit.RelativeAmount >= -spliceAmt {
+		t.Fatalf("splice fee not paid by initiator: relative "+
+			"amount %v", spliceInit.RelativeAmount)
+	}
+
+	// A second splice can't be proposed while the first one is being
+	// negotiated.
+	if _, err := alice.InitSplice(); err != ErrChanAlreadySplicing {
+		t.Fatalf("expected ErrChanAlreadySplicing, got %v", err)
+	}
+
+	// Ping-pong the messages between both parties, until both of them
+	// broadcast the splice transaction.
+	var (
+		toBob     = []lnwire.Message{spliceInit}
+		toAlice   []lnwire.Message
+		aliceDone bool
+		bobDone   bool
+	)
+	for i := 0; i < 10 && (len(toBob) != 0 || len(toAlice) != 0); i++ {
+		var done bool
+		toAlice, done = deliverSpliceMsgs(t, bob, toBob)
+		bobDone = bobDone || done
+
+		toBob, done = deliverSpliceMsgs(t, alice, toAlice)
+		aliceDone = aliceDone || done
+		toAlice = nil
+	}
+	if !aliceDone || !bobDone {
+		t.Fatalf("splice not completed: alice=%v, bob=%v", aliceDone,
+			bobDone)
+	}
+	if len(alicePublished) != 1 || len(bobPublished) != 1 {
+		t.Fatalf("expected a single broadcast by each party, got "+
+			"alice=%v, bob=%v", len(alicePublished),
+			len(bobPublished))
+	}
+
+	spliceTx := alicePublished[0]
+	if spliceTx.TxHash() != bobPublished[0].TxHash() {
+		t.Fatalf("parties broadcast different splice transactions")
+	}
+	if len(spliceTx.TxIn) != 1 ||
+		spliceTx.TxIn[0].PreviousOutPoint != *aliceChan.ChannelPoint() {
+
+		t.Fatalf("splice tx doesn't spend the funding output: %v",
+			spliceTx.TxIn)
+	}
+	if len(spliceTx.TxIn[0].Witness) == 0 {
+		t.Fatalf("funding input of splice tx isn't signed")
+	}
+
+	// The new funding output should hold the old capacity, minus the
+	// spliced out amount and fee.
+	alicePending := alice.PendingSplice()
+	bobPending := bob.PendingSplice()
+	if alicePending == nil || bobPending == nil {
+		t.Fatalf("splice not committed to")
+	}
+	newCapacity := aliceChan.StateSnapshot().Capacity +
+		spliceInit.RelativeAmount
+	if alicePending.Capacity != newCapacity ||
+		bobPending.Capacity != newCapacity {
+
+		t.Fatalf("expected capacity %v, got alice=%v, bob=%v",
+			newCapacity, alicePending.Capacity, bobPending.Capacity)
+	}
+	newFundingOut := spliceTx.TxOut[alicePending.FundingOutpoint.Index]
+	if btcutil.Amount(newFundingOut.Value) != newCapacity {
+		t.Fatalf("expected funding output of %v, got %v", newCapacity,
+			newFundingOut.Value)
+	}
+
+	// Once the splice transaction confirms, both parties exchange
+	// SpliceLocked, after which the splice is locked in.
+	scid := lnwire.ShortChannelID{BlockHeight: 110, TxIndex: 1}
+	aliceLocked := alice.SpliceConfirmed(scid)
+	bobLocked := bob.SpliceConfirmed(scid)
+	if alice.IsLocked() || bob.IsLocked() {
+		t.Fatalf("splice locked before receiving SpliceLocked")
+	}
+
+	deliverSpliceMsgs(t, alice, []lnwire.Message{bobLocked})
+	deliverSpliceMsgs(t, bob, []lnwire.Message{aliceLocked})
+	if !alice.IsLocked() || !bob.IsLocked() {
+		t.Fatalf("splice not locked after exchanging SpliceLocked")
+	}
+}
+
+// TestChannelSplicerRejectNotQuiescent tests that the responder rejects a
+// splice if the channel isn't quiescent.
+func TestChannelSplicerRejectNotQuiescent(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	_, aliceChan, bobChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	var published []*wire.MsgTx
+	cfg := newTestSpliceCfg(bobChan, &published)
+	cfg.claimQuiescence = func() error {
+		return fmt.Errorf("channel isn't quiescent")
+	}
+	bob := newChannelSplicer(cfg, nil)
+
+	chanID := lnwire.NewChanIDFromOutPoint(aliceChan.ChannelPoint())
+	spliceInit := &lnwire.SpliceInit{
+		ChanID:           chanID,
+		RelativeAmount:   -100000,
+		FeePerKiloWeight: uint32(lnwallet.FeePerKwFloor),
+	}
+	if _, _, err := bob.ProcessSpliceMsg(spliceInit); err == nil {
+		t.Fatalf("splice of non-quiescent channel accepted")
+	}
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -143,6 +144,78 @@ func newAddress(ctx *cli.Context) error {
 	return nil
 }
 
+var setSweepDestCommand = cli.Command{
+	Name:     "setsweepdest",
+	Category: "On-chain",
+	Usage: "Set the destination that swept funds and funds of " +
+		"cooperative closes are sent to.",
+	Description: `
+	Set the destination that all funds swept from closed channels, including
+	justice transactions, and our funds of cooperative closes are sent to.
+
+	Either a fixed address, or an extended public key that a fresh address
+	is derived from for each sweep can be set. If neither is set, funds are
+	sent to a fresh address of the wallet again.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "addr",
+			Usage: "the address to send all swept funds to",
+		},
+		cli.StringFlag{
+			Name: "xpub",
+			Usage: "the extended public key to derive the " +
+				"addresses to send swept funds to from",
+		},
+	},
+	Action: actionDecorator(setSweepDest),
+}
+
+func setSweepDest(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.IsSet("addr") && ctx.IsSet("xpub") {
+		return fmt.Errorf("only one of addr and xpub can be set")
+	}
+
+	ctxb := context.Background()
+	resp, err := client.SetSweepDestination(
+		ctxb, &lnrpc.SetSweepDestinationRequest{
+			Address: ctx.String("addr"),
+			Xpub:    ctx.String("xpub"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var getSweepDestCommand = cli.Command{
+	Name:     "getsweepdest",
+	Category: "On-chain",
+	Usage:    "Show the destination that swept funds are sent to.",
+	Action:   actionDecorator(getSweepDest),
+}
+
+func getSweepDest(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	ctxb := context.Background()
+	resp, err := client.GetSweepDestination(
+		ctxb, &lnrpc.GetSweepDestinationRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var sendCoinsCommand = cli.Command{
 	Name:      "sendcoins",
 	Category:  "On-chain",
@@ -408,7 +481,13 @@ var openChannelCommand = cli.Command{
 	a channelPoint (txid:vout) of the funding output is returned.
 
 	One can manually set the fee to be used for the funding transaction via either
-	the --conf_target or --sat_per_byte arguments. This is optional.`,
+	the --conf_target or --sat_per_byte arguments. This is optional.
+
+	If --psbt is set, the funding transaction is crafted by an external wallet
+	instead. Once the remote node has accepted the channel, the funding address,
+	amount and a PSBT template are printed, and the fully signed base64 encoded
+	PSBT paying exactly that amount to that address must be entered. All inputs
+	of the PSBT must spend segwit outputs.`,
 	ArgsUsage: "node-key local-amt push-amt",
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -476,6 +555,49 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
+		cli.BoolFlag{
+			Name: "psbt",
+			Usage: "fund the channel from an external wallet by " +
+				"providing a signed PSBT",
+		},
+		cli.StringFlag{
+			Name: "close_address",
+			Usage: "(optional) an address to commit to as the " +
+				"upfront shutdown script of the channel, the " +
+				"funds of a cooperative close can then only be " +
+				"paid to this address",
+		},
+		cli.BoolFlag{
+			Name: "zero_conf",
+			Usage: "(optional) use the channel right away, before " +
+				"the funding transaction confirms, this " +
+				"requires the remote peer to trust us",
+		},
+		cli.Int64Flag{
+			Name: "remote_chan_reserve_sat",
+			Usage: "(optional) the channel reserve we will require " +
+				"our channel counterparty to keep. If this is " +
+				"not set, we will scale the value according to " +
+				"the channel size",
+		},
+		cli.Int64Flag{
+			Name: "dust_limit_sat",
+			Usage: "(optional) the dust limit of our commitment " +
+				"transaction. If this is not set, the default " +
+				"dust limit of the chain is used",
+		},
+		cli.Uint64Flag{
+			Name: "remote_max_htlcs",
+			Usage: "(optional) the maximum number of HTLCs our " +
+				"channel counterparty may offer us at once",
+		},
+		cli.Uint64Flag{
+			Name: "remote_max_value_in_flight_msat",
+			Usage: "(optional) the maximum value of the HTLCs our " +
+				"channel counterparty may offer us at once. If " +
+				"this is not set, we will scale the value " +
+				"according to the channel size",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -496,11 +618,19 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.OpenChannelRequest{
-		TargetConf:     int32(ctx.Int64("conf_target")),
-		SatPerByte:     ctx.Int64("sat_per_byte"),
-		MinHtlcMsat:    ctx.Int64("min_htlc_msat"),
-		RemoteCsvDelay: uint32(ctx.Uint64("remote_csv_delay")),
-		MinConfs:       int32(ctx.Uint64("min_confs")),
+		TargetConf:           int32(ctx.Int64("conf_target")),
+		SatPerByte:           ctx.Int64("sat_per_byte"),
+		MinHtlcMsat:          ctx.Int64("min_htlc_msat"),
+		RemoteCsvDelay:       uint32(ctx.Uint64("remote_csv_delay")),
+		MinConfs:             int32(ctx.Uint64("min_confs")),
+		CloseAddress:         ctx.String("close_address"),
+		ZeroConf:             ctx.Bool("zero_conf"),
+		RemoteChanReserveSat: ctx.Int64("remote_chan_reserve_sat"),
+		DustLimitSat:         ctx.Int64("dust_limit_sat"),
+		RemoteMaxHtlcs:       uint32(ctx.Uint64("remote_max_htlcs")),
+		RemoteMaxValueInFlightMsat: ctx.Uint64(
+			"remote_max_value_in_flight_msat",
+		),
 	}
 
 	switch {
@@ -568,6 +698,7 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req.Private = ctx.Bool("private")
+	req.Psbt = ctx.Bool("psbt")
 
 	stream, err := client.OpenChannel(ctxb, req)
 	if err != nil {
@@ -583,6 +714,12 @@ func openChannel(ctx *cli.Context) error {
 		}
 
 		switch update := resp.Update.(type) {
+		case *lnrpc.OpenStatusUpdate_PsbtFund:
+			err := fundChannelPsbt(ctxb, client, update.PsbtFund)
+			if err != nil {
+				return err
+			}
+
 		case *lnrpc.OpenStatusUpdate_ChanPending:
 			txid, err := chainhash.NewHash(update.ChanPending.Txid)
 			if err != nil {
@@ -636,8 +773,159 @@ func openChannel(ctx *cli.Context) error {
 	}
 }
 
+// fundChannelPsbt prints the funding output of a channel that is funded by an
+// external wallet, then reads the signed PSBT funding it from stdin and hands
+// it to lnd, resuming the funding workflow.
+func fundChannelPsbt(ctxb context.Context, client lnrpc.LightningClient,
+	fund *lnrpc.ReadyForPsbtFunding) error {
+
+	printJSON(struct {
+		FundingAddress string `json:"funding_address"`
+		FundingAmount  int64  `json:"funding_amount"`
+		Psbt           string `json:"psbt"`
+	}{
+		FundingAddress: fund.FundingAddress,
+		FundingAmount:  fund.FundingAmount,
+		Psbt:           base64.StdEncoding.EncodeToString(fund.Psbt),
+	})
+
+	fmt.Printf("Send exactly %d satoshis to %v within a single output of "+
+		"the funding transaction.\nPaste the signed base64 encoded "+
+		"PSBT: ", fund.FundingAmount, fund.FundingAddress)
+
+	reader := bufio.NewReader(os.Stdin)
+	b64Psbt, err := reader.ReadString('\n')
+	if err != nil {
+		return err
+	}
+	signedPsbt, err := base64.StdEncoding.DecodeString(
+		strings.TrimSpace(b64Psbt),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decode PSBT: %v", err)
+	}
+
+	req := &lnrpc.FundingTransitionMsg{
+		Trigger: &lnrpc.FundingTransitionMsg_PsbtFinalize{
+			PsbtFinalize: &lnrpc.PsbtFinalize{
+				PendingChanId: fund.PendingChanId,
+				SignedPsbt:    signedPsbt,
+			},
+		},
+	}
+	_, err = client.FundingStateStep(ctxb, req)
+	return err
+}
+
 // TODO(roasbeef): also allow short relative channel ID.
 
+var batchOpenChannelCommand = cli.Command{
+	Name:      "batchopenchannel",
+	Category:  "Channels",
+	Usage:     "Open multiple channels within a single funding transaction.",
+	ArgsUsage: "channels-json",
+	Description: `
+	Attempt to open a new channel to each of the given existing peers, funding
+	all of them within a single transaction. The funding transaction is only
+	broadcast once every peer has accepted its channel, and none of the
+	channels are opened if any of them fails.
+
+	The channels-json param decodes the channels to open in the following
+	format:
+
+	    '[{"node_pubkey": "<hex pubkey>", "local_funding_amount": N,
+	       "push_sat": P, "private": false}, ...]'
+
+	Once the funding transaction has been broadcast, the funding outpoints of
+	the pending channels are returned in the order of the given channels.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the transaction",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "(optional) the minimum number of confirmations " +
+				"each one of your outputs used for the funding " +
+				"transaction must satisfy",
+			Value: 1,
+		},
+	},
+	Action: actionDecorator(batchOpenChannel),
+}
+
+// batchChannelJSON is the JSON representation of a single channel to open
+// within a batch.
+type batchChannelJSON struct {
+	NodePubkey         string `json:"node_pubkey"`
+	LocalFundingAmount int64  `json:"local_funding_amount"`
+	PushSat            int64  `json:"push_sat"`
+	Private            bool   `json:"private"`
+	MinHtlcMsat        int64  `json:"min_htlc_msat"`
+	RemoteCsvDelay     uint32 `json:"remote_csv_delay"`
+}
+
+func batchOpenChannel(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "batchopenchannel")
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	var channels []batchChannelJSON
+	err := json.Unmarshal([]byte(ctx.Args().First()), &channels)
+	if err != nil {
+		return fmt.Errorf("unable to decode channels: %v", err)
+	}
+
+	minConfs := int32(ctx.Uint64("min_confs"))
+	req := &lnrpc.BatchOpenChannelRequest{
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerByte:       ctx.Int64("sat_per_byte"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
+	}
+	for _, channel := range channels {
+		nodePubHex, err := hex.DecodeString(channel.NodePubkey)
+		if err != nil {
+			return fmt.Errorf("unable to decode node public key: "+
+				"%v", err)
+		}
+
+		req.Channels = append(req.Channels, &lnrpc.BatchOpenChannel{
+			NodePubkey:         nodePubHex,
+			LocalFundingAmount: channel.LocalFundingAmount,
+			PushSat:            channel.PushSat,
+			Private:            channel.Private,
+			MinHtlcMsat:        channel.MinHtlcMsat,
+			RemoteCsvDelay:     channel.RemoteCsvDelay,
+		})
+	}
+
+	resp, err := client.BatchOpenChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var closeChannelCommand = cli.Command{
 	Name:     "closechannel",
 	Category: "Channels",
@@ -695,6 +983,18 @@ var closeChannelCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.StringFlag{
+			Name: "delivery_addr",
+			Usage: "(optional) an address to send our funds to " +
+				"in a cooperative close, if not set a new " +
+				"wallet address is used",
+		},
+		cli.Int64Flag{
+			Name: "max_sat_per_byte",
+			Usage: "(optional) the maximum fee expressed in " +
+				"sat/byte we're willing to pay for the " +
+				"cooperative close transaction",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -716,10 +1016,12 @@ func closeChannel(ctx *cli.Context) error {
 
 	// TODO(roasbeef): implement time deadline within server
 	req := &lnrpc.CloseChannelRequest{
-		ChannelPoint: channelPoint,
-		Force:        ctx.Bool("force"),
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		ChannelPoint:    channelPoint,
+		Force:           ctx.Bool("force"),
+		TargetConf:      int32(ctx.Int64("conf_target")),
+		SatPerByte:      ctx.Int64("sat_per_byte"),
+		DeliveryAddress: ctx.String("delivery_addr"),
+		MaxSatPerByte:   ctx.Int64("max_sat_per_byte"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1064,6 +1366,98 @@ func abandonChannel(ctx *cli.Context) error {
 	return nil
 }
 
+var spliceChannelCommand = cli.Command{
+	Name:     "splicechannel",
+	Category: "Channels",
+	Usage:    "Add funds to or remove funds from an existing channel.",
+	Description: `
+	Splice funds into or out of an active channel without closing it. The
+	channel's funding output is spent by a new funding transaction, and the
+	channel keeps operating under its current channel point until the
+	splice transaction confirms.
+
+	A positive amount is added to the channel from the wallet, while a
+	negative amount is removed from our balance and sent to the given
+	address, or to a new wallet address if none is set.
+
+	To view which funding_txids/output_indexes can be used for this command,
+	see the channel_point values within the listchannels command output.
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the funding " +
+				"transaction",
+		},
+		cli.Int64Flag{
+			Name: "amt",
+			Usage: "the number of satoshis to splice into the channel, " +
+				"negative to splice out of the channel",
+		},
+		cli.StringFlag{
+			Name: "addr",
+			Usage: "(optional) the address spliced out funds are " +
+				"sent to",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"splice transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the splice transaction",
+		},
+	},
+	Action: actionDecorator(spliceChannel),
+}
+
+func spliceChannel(ctx *cli.Context) error {
+	ctxb := context.Background()
+
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "splicechannel")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("amt") {
+		return fmt.Errorf("amt argument missing")
+	}
+
+	req := &lnrpc.SpliceChannelRequest{
+		ChannelPoint: channelPoint,
+		Amount:       ctx.Int64("amt"),
+		Addr:         ctx.String("addr"),
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerByte:   ctx.Int64("sat_per_byte"),
+	}
+
+	resp, err := client.SpliceChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // parseChannelPoint parses a funding txid and output index from the command
 // line. Both named options as well as unnamed parameters are supported.
 func parseChannelPoint(ctx *cli.Context) (*lnrpc.ChannelPoint, error) {
@@ -1785,6 +2179,44 @@ var sendPaymentCommand = cli.Command{
 			Name:  "final_cltv_delta",
 			Usage: "the number of blocks the last hop has to reveal the preimage",
 		},
+		cli.StringFlag{
+			Name: "route_strategy",
+			Usage: "the strategy used to select the route of the " +
+				"payment: default, cheapest, fastest, " +
+				"most_reliable or weighted",
+		},
+		cli.Float64Flag{
+			Name: "fee_weight",
+			Usage: "the weight of each msat of fees when using the " +
+				"weighted route strategy",
+		},
+		cli.Float64Flag{
+			Name: "time_lock_weight",
+			Usage: "the weight of each block of time lock when " +
+				"using the weighted route strategy",
+		},
+		cli.Float64Flag{
+			Name: "reliability_weight",
+			Usage: "the weight of each millionth of the negative " +
+				"log-probability of success when using the " +
+				"weighted route strategy",
+		},
+		cli.Uint64Flag{
+			Name: "max_hop_fee_rate",
+			Usage: "(optional) the maximum effective fee rate, in " +
+				"parts per million, that any single hop of " +
+				"the route may charge",
+		},
+		cli.Int64Flag{
+			Name: "max_hop_fee_msat",
+			Usage: "(optional) the maximum fee in milli-satoshis " +
+				"that any single hop of the route may charge",
+		},
+		cli.Uint64Flag{
+			Name: "cltv_limit",
+			Usage: "(optional) the maximum number of blocks the " +
+				"funds of the payment may be locked up for",
+		},
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -1819,6 +2251,49 @@ func retrieveFeeLimit(ctx *cli.Context) (*lnrpc.FeeLimit, error) {
 	return nil, nil
 }
 
+// retrieveRouteStrategy retrieves the route strategy and the weights used to
+// blend strategies based on the route strategy flags passed.
+func retrieveRouteStrategy(ctx *cli.Context) (lnrpc.SendRequest_RouteStrategy,
+	*lnrpc.RouteWeights, error) {
+
+	strategy := lnrpc.SendRequest_DEFAULT
+	if ctx.IsSet("route_strategy") {
+		name := strings.ToUpper(ctx.String("route_strategy"))
+		value, ok := lnrpc.SendRequest_RouteStrategy_value[name]
+		if !ok {
+			return 0, nil, fmt.Errorf("unknown route strategy: %v",
+				ctx.String("route_strategy"))
+		}
+		strategy = lnrpc.SendRequest_RouteStrategy(value)
+	}
+
+	weightsSet := ctx.IsSet("fee_weight") ||
+		ctx.IsSet("time_lock_weight") || ctx.IsSet("reliability_weight")
+
+	switch {
+	case strategy != lnrpc.SendRequest_WEIGHTED && weightsSet:
+		return 0, nil, fmt.Errorf("route weights can only be set " +
+			"when using the weighted route strategy")
+
+	case strategy != lnrpc.SendRequest_WEIGHTED:
+		return strategy, nil, nil
+	}
+
+	return strategy, &lnrpc.RouteWeights{
+		FeeWeight:         ctx.Float64("fee_weight"),
+		TimeLockWeight:    ctx.Float64("time_lock_weight"),
+		ReliabilityWeight: ctx.Float64("reliability_weight"),
+	}, nil
+}
+
+// setRouteConstraints populates the per-hop fee and CLTV limits of the send
+// request based on the route constraint flags passed.
+func setRouteConstraints(ctx *cli.Context, req *lnrpc.SendRequest) {
+	req.MaxHopFeeRatePpm = uint32(ctx.Uint64("max_hop_fee_rate"))
+	req.MaxHopFeeMsat = ctx.Int64("max_hop_fee_msat")
+	req.CltvLimit = uint32(ctx.Uint64("cltv_limit"))
+}
+
 func confirmPayReq(ctx *cli.Context, client lnrpc.LightningClient, payReq string) error {
 	ctxb := context.Background()
 
@@ -1868,6 +2343,12 @@ func sendPayment(ctx *cli.Context) error {
 		return err
 	}
 
+	// The same goes for the route strategy.
+	strategy, weights, err := retrieveRouteStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
 	// If a payment request was provided, we can exit early since all of the
 	// details of the payment are encoded within the request.
 	if ctx.IsSet("pay_req") {
@@ -1881,7 +2362,10 @@ func sendPayment(ctx *cli.Context) error {
 			PaymentRequest: ctx.String("pay_req"),
 			Amt:            ctx.Int64("amt"),
 			FeeLimit:       feeLimit,
+			RouteStrategy:  strategy,
+			RouteWeights:   weights,
 		}
+		setRouteConstraints(ctx, req)
 
 		return sendPaymentRequest(client, req)
 	}
@@ -1922,10 +2406,13 @@ func sendPayment(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.SendRequest{
-		Dest:     destNode,
-		Amt:      amount,
-		FeeLimit: feeLimit,
+		Dest:          destNode,
+		Amt:           amount,
+		FeeLimit:      feeLimit,
+		RouteStrategy: strategy,
+		RouteWeights:  weights,
 	}
+	setRouteConstraints(ctx, req)
 
 	if ctx.Bool("debug_send") && (ctx.IsSet("payment_hash") || args.Present()) {
 		return fmt.Errorf("do not provide a payment hash with debug send")
@@ -2020,6 +2507,44 @@ var payInvoiceCommand = cli.Command{
 			Usage: "percentage of the payment's amount used as the" +
 				"maximum fee allowed when sending the payment",
 		},
+		cli.StringFlag{
+			Name: "route_strategy",
+			Usage: "the strategy used to select the route of the " +
+				"payment: default, cheapest, fastest, " +
+				"most_reliable or weighted",
+		},
+		cli.Float64Flag{
+			Name: "fee_weight",
+			Usage: "the weight of each msat of fees when using the " +
+				"weighted route strategy",
+		},
+		cli.Float64Flag{
+			Name: "time_lock_weight",
+			Usage: "the weight of each block of time lock when " +
+				"using the weighted route strategy",
+		},
+		cli.Float64Flag{
+			Name: "reliability_weight",
+			Usage: "the weight of each millionth of the negative " +
+				"log-probability of success when using the " +
+				"weighted route strategy",
+		},
+		cli.Uint64Flag{
+			Name: "max_hop_fee_rate",
+			Usage: "(optional) the maximum effective fee rate, in " +
+				"parts per million, that any single hop of " +
+				"the route may charge",
+		},
+		cli.Int64Flag{
+			Name: "max_hop_fee_msat",
+			Usage: "(optional) the maximum fee in milli-satoshis " +
+				"that any single hop of the route may charge",
+		},
+		cli.Uint64Flag{
+			Name: "cltv_limit",
+			Usage: "(optional) the maximum number of blocks the " +
+				"funds of the payment may be locked up for",
+		},
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -2048,6 +2573,11 @@ func payInvoice(ctx *cli.Context) error {
 		return err
 	}
 
+	strategy, weights, err := retrieveRouteStrategy(ctx)
+	if err != nil {
+		return err
+	}
+
 	if !ctx.Bool("force") {
 		err = confirmPayReq(ctx, client, payReq)
 		if err != nil {
@@ -2059,7 +2589,11 @@ func payInvoice(ctx *cli.Context) error {
 		PaymentRequest: payReq,
 		Amt:            ctx.Int64("amt"),
 		FeeLimit:       feeLimit,
+		RouteStrategy:  strategy,
+		RouteWeights:   weights,
 	}
+	setRouteConstraints(ctx, req)
+
 	return sendPaymentRequest(client, req)
 }
 
@@ -2740,6 +3274,108 @@ func queryRoutes(ctx *cli.Context) error {
 	return nil
 }
 
+var probePaymentCommand = cli.Command{
+	Name:     "probepayment",
+	Category: "Payments",
+	Usage:    "Probe whether a destination can receive an amount.",
+	Description: `
+	Sends HTLCs with a random payment hash towards the destination, retrying
+	over alternative routes just like a regular payment. The probe succeeds
+	once the destination rejects the HTLC for having an unknown payment hash,
+	which means that no funds are ever transferred.
+
+	The destination and amount can either be given directly, or be taken
+	from a payment request using the --pay_req flag.`,
+	ArgsUsage: "dest amt",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "dest",
+			Usage: "the 33-byte hex-encoded public key for the probe " +
+				"destination",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount to probe for expressed in satoshis",
+		},
+		cli.StringFlag{
+			Name:  "pay_req",
+			Usage: "a zpay32 encoded payment request to probe",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when probing " +
+				"the route",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_percent",
+			Usage: "percentage of the probe's amount used as the " +
+				"maximum fee allowed when probing the route",
+		},
+		cli.Int64Flag{
+			Name: "final_cltv_delta",
+			Usage: "(optional) number of blocks the last hop has to reveal " +
+				"the preimage",
+		},
+	},
+	Action: actionDecorator(probePayment),
+}
+
+func probePayment(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	feeLimit, err := retrieveFeeLimit(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.ProbePaymentRequest{
+		FeeLimit:       feeLimit,
+		FinalCltvDelta: int32(ctx.Int("final_cltv_delta")),
+	}
+
+	args := ctx.Args()
+
+	// If a payment request was specified, then the destination and amount
+	// will be taken from it.
+	if ctx.IsSet("pay_req") {
+		req.PaymentRequest = ctx.String("pay_req")
+		req.Amt = ctx.Int64("amt")
+	} else {
+		switch {
+		case ctx.IsSet("dest"):
+			req.DestString = ctx.String("dest")
+		case args.Present():
+			req.DestString = args.First()
+			args = args.Tail()
+		default:
+			return fmt.Errorf("dest argument missing")
+		}
+
+		switch {
+		case ctx.IsSet("amt"):
+			req.Amt = ctx.Int64("amt")
+		case args.Present():
+			req.Amt, err = strconv.ParseInt(args.First(), 10, 64)
+			if err != nil {
+				return fmt.Errorf("unable to decode amt "+
+					"argument: %v", err)
+			}
+		default:
+			return fmt.Errorf("amt argument missing")
+		}
+	}
+
+	resp, err := client.ProbePayment(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var getNetworkInfoCommand = cli.Command{
 	Name:     "getnetworkinfo",
 	Category: "Channels",
@@ -257,14 +257,18 @@ func main() {
 		unlockCommand,
 		changePasswordCommand,
 		newAddressCommand,
+		setSweepDestCommand,
+		getSweepDestCommand,
 		sendManyCommand,
 		sendCoinsCommand,
 		connectCommand,
 		disconnectCommand,
 		openChannelCommand,
+		batchOpenChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
+		spliceChannelCommand,
 		listPeersCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
@@ -283,6 +287,7 @@ func main() {
 		getChanInfoCommand,
 		getNodeInfoCommand,
 		queryRoutesCommand,
+		probePaymentCommand,
 		getNetworkInfoCommand,
 		debugLevelCommand,
 		decodePayReqCommand,
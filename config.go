@@ -24,6 +24,7 @@ import (
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/tor"
@@ -50,6 +51,7 @@ const (
 	defaultNoSeedBackup        = false
 	defaultTrickleDelay        = 30 * 1000
 	defaultInactiveChanTimeout = 20 * time.Minute
+	defaultAcceptorTimeout     = 15 * time.Second
 	defaultMaxLogFiles         = 3
 	defaultMaxLogFileSize      = 10
 
@@ -62,6 +64,9 @@ const (
 
 	defaultBroadcastDelta = 10
 
+	defaultGoOnChainConfTarget    = 6
+	defaultMinGoOnChainValueRatio = 1.0
+
 	// minTimeLockDelta is the minimum timelock we require for incoming
 	// HTLCs on our channels.
 	minTimeLockDelta = 4
@@ -110,6 +115,11 @@ type chainConfig struct {
 	BaseFee             lnwire.MilliSatoshi `long:"basefee" description:"The base fee in millisatoshi we will charge for forwarding payments on our channels"`
 	FeeRate             lnwire.MilliSatoshi `long:"feerate" description:"The fee rate used when forwarding payments on our channels. The total fee charged is basefee + (amount * feerate / 1000000), where amount is the forwarded amount."`
 	TimeLockDelta       uint32              `long:"timelockdelta" description:"The CLTV delta we will subtract from a forwarded HTLC's timelock value"`
+
+	DefaultRemoteChanReserve      int64               `long:"defaultremotechanreserve" description:"The default channel reserve (in satoshis) we will require our channel counterparty to keep. If this is not set, we will require 1% of the channel size."`
+	DefaultDustLimit              int64               `long:"defaultdustlimit" description:"The default dust limit (in satoshis) we will use for our commitment transactions. Outputs below this value will not be added to our commitment transactions. If this is not set, the dust limit of the chain is used."`
+	DefaultRemoteMaxHTLCs         uint16              `long:"defaultremotemaxhtlcs" description:"The default maximum number of HTLCs our channel counterparty may offer us at once. If this is not set, the maximum permitted by the protocol is used."`
+	DefaultRemoteMaxValueInFlight lnwire.MilliSatoshi `long:"defaultremotemaxvalueinflight" description:"The default maximum value (in millisatoshi) of the HTLCs our channel counterparty may offer us at once. If this is not set, the channel size minus the channel reserve is used."`
 }
 
 type neutrinoConfig struct {
@@ -233,8 +243,30 @@ type config struct {
 	Color       string `long:"color" description:"The color of the node in hex format (i.e. '#3399FF'). Used to customize node appearance in intelligence services"`
 	MinChanSize int64  `long:"minchansize" description:"The smallest channel size (in satoshis) that we should accept. Incoming channels smaller than this will be rejected"`
 
+	MaxChanSize int64 `long:"maxchansize" description:"The largest channel size (in satoshis) that we should accept. Incoming channels larger than this will be rejected. If not set, the largest channel size permitted by the protocol is used"`
+
+	WumboChans bool `long:"wumbo-channels" description:"If set, lnd will signal support for, and open or accept, channels larger than 0.16777215 BTC with peers that signal support for them as well"`
+
+	AcceptorTimeout time.Duration `long:"acceptortimeout" description:"Time after which a client of the ChannelAcceptor RPC has to decide on an inbound channel request before the channel is rejected"`
+
+	ZeroConfPeers []string `long:"zeroconfpeer" description:"The hex-encoded public key of a peer we trust to open zero-conf channels with us, which are usable before their funding transaction confirms. Can be specified multiple times"`
+
+	DualFunding             bool  `long:"dualfunding" description:"If set, lnd will signal support for, and construct the funding transactions of channels interactively with peers that signal support for it as well, allowing both parties to contribute funds. This feature is experimental and uses non-standard message types"`
+	MaxDualFundContribution int64 `long:"maxdualfundcontribution" description:"The maximum amount (in satoshis) we'll add to channels opened by peers that construct the funding transaction interactively, matching the funds of the initiator up to this amount. If zero, we won't contribute any funds"`
+
+	Splicing bool `long:"splicing" description:"If set, lnd will signal support for, and accept splices of active channels with peers that signal support for it as well, allowing funds to be added to or removed from a channel without closing it. This feature is experimental and uses non-standard message types"`
+
 	NoChanUpdates bool `long:"nochanupdates" description:"If specified, lnd will not request real-time channel updates from connected peers. This option should be used by routing nodes to save bandwidth."`
 
+	ForceCloseUneconomical bool    `long:"forcecloseuneconomicalhtlcs" description:"If set, lnd will force close a channel to claim an HTLC that's close to expiry, even if the on-chain fees of claiming it exceed its value"`
+	GoOnChainConfTarget    uint32  `long:"goonchainconftarget" description:"The confirmation target used to estimate the on-chain fees of claiming an HTLC that's close to expiry"`
+	MinGoOnChainValueRatio float64 `long:"mingoonchainvalueratio" description:"The minimum ratio between the value of an HTLC that's close to expiry and the estimated on-chain fees of claiming it for lnd to force close the channel"`
+
+	MempoolSpends bool `long:"mempoolspends" description:"If set, lnd will watch the mempool of its btcd or bitcoind backend for the remote party's on-chain claims of outgoing HTLCs, to settle them upstream before the claims confirm. With btcd, this requires relaying every mempool transaction to lnd while such claims are watched"`
+
+	SweepAddr string `long:"sweepaddr" description:"The address that all funds swept from closed channels, and our funds of cooperative closes, are sent to, instead of a fresh address of the wallet"`
+	SweepXPub string `long:"sweepxpub" description:"The extended public key to derive the addresses from that all funds swept from closed channels, and our funds of cooperative closes, are sent to, instead of a fresh address of the wallet"`
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -304,12 +336,15 @@ func loadConfig() (*config, error) {
 		Alias:               defaultAlias,
 		Color:               defaultColor,
 		MinChanSize:         int64(minChanFundingSize),
+		AcceptorTimeout:     defaultAcceptorTimeout,
 		Tor: &torConfig{
 			SOCKS:   defaultTorSOCKS,
 			DNS:     defaultTorDNS,
 			Control: defaultTorControl,
 		},
-		net: &tor.ClearNet{},
+		GoOnChainConfTarget:    defaultGoOnChainConfTarget,
+		MinGoOnChainValueRatio: defaultMinGoOnChainValueRatio,
+		net:                    &tor.ClearNet{},
 	}
 
 	// Pre-parse the command line options to pick up an alternative config
@@ -445,8 +480,9 @@ func loadConfig() (*config, error) {
 	if cfg.Autopilot.MinChannelSize < int64(minChanFundingSize) {
 		cfg.Autopilot.MinChannelSize = int64(minChanFundingSize)
 	}
-	if cfg.Autopilot.MaxChannelSize > int64(maxFundingAmount) {
-		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
+	maxAutopilotChanSize := int64(maxChanSizeLimit(cfg.WumboChans))
+	if cfg.Autopilot.MaxChannelSize > maxAutopilotChanSize {
+		cfg.Autopilot.MaxChannelSize = maxAutopilotChanSize
 	}
 
 	// Validate the Tor config parameters.
@@ -634,6 +670,7 @@ func loadConfig() (*config, error) {
 		// primary chain.
 		registeredChains.RegisterPrimaryChain(litecoinChain)
 		maxFundingAmount = maxLtcFundingAmount
+		maxWumboFundingAmount = maxLtcFundingAmountWumbo
 		maxPaymentMSat = maxLtcPaymentMSat
 
 	case cfg.Bitcoin.Active:
@@ -768,8 +805,83 @@ func loadConfig() (*config, error) {
 	if cfg.Autopilot.MinChannelSize < int64(minChanFundingSize) {
 		cfg.Autopilot.MinChannelSize = int64(minChanFundingSize)
 	}
-	if cfg.Autopilot.MaxChannelSize > int64(maxFundingAmount) {
-		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
+	maxChanSize := maxChanSizeLimit(cfg.WumboChans)
+	if cfg.Autopilot.MaxChannelSize > int64(maxChanSize) {
+		cfg.Autopilot.MaxChannelSize = int64(maxChanSize)
+	}
+
+	// The max channel size we'll accept defaults to the largest channel
+	// permitted by the protocol, which depends on whether large channels
+	// are enabled.
+	switch {
+	case cfg.MaxChanSize == 0:
+		cfg.MaxChanSize = int64(maxChanSize)
+
+	case cfg.MaxChanSize > int64(maxChanSize):
+		str := "%s: maxchansize must be at most %v, larger " +
+			"channels require wumbo-channels to be set"
+		err := fmt.Errorf(str, funcName, maxChanSize)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+
+	case cfg.MaxChanSize < cfg.MinChanSize:
+		str := "%s: maxchansize must be at least minchansize"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Ensure the thresholds for going on-chain to claim an HTLC close to
+	// expiry are sane.
+	switch {
+	case cfg.GoOnChainConfTarget == 0:
+		str := "%s: goonchainconftarget must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+
+	case cfg.MinGoOnChainValueRatio < 0:
+		str := "%s: mingoonchainvalueratio must not be negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Funds can only be swept to either a fixed address, or to addresses
+	// derived from an extended public key.
+	if cfg.SweepAddr != "" && cfg.SweepXPub != "" {
+		str := "%s: sweepaddr and sweepxpub are mutually exclusive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Ensure the default channel parameters of the primary chain are
+	// within the bounds of the protocol.
+	homeChainConfig := cfg.Bitcoin
+	if registeredChains.PrimaryChain() == litecoinChain {
+		homeChainConfig = cfg.Litecoin
+	}
+	switch {
+	case homeChainConfig.DefaultDustLimit != 0 &&
+		homeChainConfig.DefaultDustLimit < int64(lnwallet.MinDustLimit):
+
+		str := "%s: defaultdustlimit must be at least %v"
+		err := fmt.Errorf(str, funcName, lnwallet.MinDustLimit)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+
+	case homeChainConfig.DefaultRemoteChanReserve < 0:
+		str := "%s: defaultremotechanreserve must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+
+	case homeChainConfig.DefaultRemoteMaxHTLCs > lnwallet.MaxHTLCNumber/2:
+		str := "%s: defaultremotemaxhtlcs must be at most %v"
+		err := fmt.Errorf(str, funcName, lnwallet.MaxHTLCNumber/2)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
 	}
 
 	// Validate profile port number.
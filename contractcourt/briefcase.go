@@ -92,6 +92,15 @@ type ArbitratorLog interface {
 	// state machine forward.
 	FetchChainActions() (ChainActionMap, error)
 
+	// RollbackContracts is to be called once the commitment transaction
+	// the contract resolutions were logged for has been reorged out of the
+	// chain. This method will delete the contract resolutions and all
+	// unresolved contracts from the log, and commit the passed state, such
+	// that the contracts can be resolved anew once a commitment
+	// transaction confirms within the new chain. The chain actions are
+	// left in place.
+	RollbackContracts(ArbitratorState) error
+
 	// WipeHistory is to be called ONLY once *all* contracts have been
 	// fully resolved, and the channel closure if finalized. This method
 	// will delete all on-disk state within the persistent log.
@@ -801,6 +810,43 @@ func (b *boltArbitratorLog) FetchChainActions() (ChainActionMap, error) {
 	return actionsMap, nil
 }
 
+// RollbackContracts is to be called once the commitment transaction the
+// contract resolutions were logged for has been reorged out of the chain. This
+// method will delete the contract resolutions and all unresolved contracts
+// from the log, and commit the passed state.
+//
+// NOTE: Part of the ContractResolver interface.
+func (b *boltArbitratorLog) RollbackContracts(s ArbitratorState) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		scopeBucket, err := tx.CreateBucketIfNotExists(b.scopeKey[:])
+		if err != nil {
+			return err
+		}
+
+		// We'll replace the contracts bucket with an empty one, which
+		// deletes all of the unresolved contracts stored within it.
+		if scopeBucket.Bucket(contractsBucketKey) != nil {
+			err := scopeBucket.DeleteBucket(contractsBucketKey)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = scopeBucket.CreateBucket(contractsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		// Next, we'll delete the contract resolutions the contracts
+		// were created from.
+		if err := scopeBucket.Delete(resolutionsKey); err != nil {
+			return err
+		}
+
+		// Finally, we'll commit the state we're rolling back to.
+		return scopeBucket.Put(stateKey[:], []byte{uint8(s)})
+	})
+}
+
 // WipeHistory is to be called ONLY once *all* contracts have been fully
 // resolved, and the channel closure if finalized. This method will delete all
 // on-disk state within the persistent log.
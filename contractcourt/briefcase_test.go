@@ -266,6 +266,7 @@ func TestContractInsertionRetrieval(t *testing.T) {
 		resolved:         true,
 		broadcastHeight:  102,
 		htlcIndex:        12,
+		payHash:          [32]byte{1, 2, 3},
 	}
 	successResolver := htlcSuccessResolver{
 		htlcResolution: lnwallet.IncomingHtlcResolution{
@@ -486,6 +487,136 @@ func TestContractSwapping(t *testing.T) {
 	assertResolversEqual(t, &timeoutResolver, dbContracts[0])
 }
 
+// TestContractRollback tests that once the contracts of a log are rolled back,
+// all unresolved contracts and contract resolutions are removed, while the
+// chain actions are retained and the passed state is committed.
+func TestContractRollback(t *testing.T) {
+	t.Parallel()
+
+	// First, we'll create a test instance of the ArbitratorLog
+	// implementation backed by boltdb.
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll populate the log as the arbitrator would once a commitment
+	// transaction confirms: the chain actions, the contract resolutions,
+	// and the resolvers crafted from them.
+	chainActions := ChainActionMap{
+		HtlcTimeoutAction: []channeldb.HTLC{
+			{
+				RHash:         testPreimage,
+				Amt:           lnwire.MilliSatoshi(prand.Uint64()),
+				RefundTimeout: prand.Uint32(),
+				OutputIndex:   int32(prand.Uint32()),
+				HtlcIndex:     prand.Uint64(),
+				LogIndex:      prand.Uint64(),
+				OnionBlob:     make([]byte, 0),
+				Signature:     make([]byte, 0),
+			},
+		},
+	}
+	if err := testLog.LogChainActions(chainActions); err != nil {
+		t.Fatalf("unable to write chain actions: %v", err)
+	}
+
+	timeoutResolution := lnwallet.OutgoingHtlcResolution{
+		Expiry:          99,
+		SignedTimeoutTx: nil,
+		CsvDelay:        99,
+		ClaimOutpoint:   randOutPoint(),
+		SweepSignDesc:   testSignDesc,
+	}
+	res := ContractResolutions{
+		CommitHash: testChainHash,
+		HtlcResolutions: lnwallet.HtlcResolutions{
+			OutgoingHTLCs: []lnwallet.OutgoingHtlcResolution{
+				timeoutResolution,
+			},
+		},
+	}
+	if err := testLog.LogContractResolutions(&res); err != nil {
+		t.Fatalf("unable to insert resolutions into db: %v", err)
+	}
+
+	contestResolver := &htlcOutgoingContestResolver{
+		htlcTimeoutResolver: htlcTimeoutResolver{
+			htlcResolution:  timeoutResolution,
+			broadcastHeight: 102,
+			htlcIndex:       12,
+		},
+	}
+	err = testLog.InsertUnresolvedContracts(contestResolver)
+	if err != nil {
+		t.Fatalf("unable to insert contract into db: %v", err)
+	}
+	if err := testLog.CommitState(StateWaitingFullResolution); err != nil {
+		t.Fatalf("unable to write state: %v", err)
+	}
+
+	// Now we'll roll back the contracts, as if the commitment transaction
+	// was reorged out of the chain.
+	err = testLog.RollbackContracts(StateCommitmentBroadcasted)
+	if err != nil {
+		t.Fatalf("unable to roll back contracts: %v", err)
+	}
+
+	// No unresolved contracts or contract resolutions should remain
+	// within the log.
+	dbContracts, err := testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch contracts from db: %v", err)
+	}
+	if len(dbContracts) != 0 {
+		t.Fatalf("no contract should be from in the db, instead %v "+
+			"were", len(dbContracts))
+	}
+	_, err = testLog.FetchContractResolutions()
+	if err != errNoResolutions {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The state we rolled back to should now be the current state.
+	arbState, err := testLog.CurrentState()
+	if err != nil {
+		t.Fatalf("unable to read arb state: %v", err)
+	}
+	if arbState != StateCommitmentBroadcasted {
+		t.Fatalf("state mismatch: expected %v, got %v",
+			StateCommitmentBroadcasted, arbState)
+	}
+
+	// The chain actions should have been left untouched.
+	diskActions, err := testLog.FetchChainActions()
+	if err != nil {
+		t.Fatalf("unable to read chain actions: %v", err)
+	}
+	if !reflect.DeepEqual(chainActions, diskActions) {
+		t.Fatalf("chain action mismatch: expected %v, got %v",
+			spew.Sdump(chainActions), spew.Sdump(diskActions))
+	}
+
+	// Finally, we should be able to insert contracts into the log once
+	// again.
+	err = testLog.InsertUnresolvedContracts(contestResolver)
+	if err != nil {
+		t.Fatalf("unable to insert contract into db: %v", err)
+	}
+	dbContracts, err = testLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch contracts from db: %v", err)
+	}
+	if len(dbContracts) != 1 {
+		t.Fatalf("one contract should be from in the db, instead %v "+
+			"were", len(dbContracts))
+	}
+	assertResolversEqual(t, contestResolver, dbContracts[0])
+}
+
 // TestContractResolutionsStorage tests that we're able to properly store and
 // retrieve contract resolutions written to disk.
 func TestContractResolutionsStorage(t *testing.T) {
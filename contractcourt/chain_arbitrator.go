@@ -93,6 +93,13 @@ type ChainArbitratorConfig struct {
 	// the channel as pending close in the database.
 	ContractBreach func(wire.OutPoint, *lnwallet.BreachRetribution) error
 
+	// ContractBreachReorg is a function closure that the ChainArbitrator
+	// will use to notify the breachArbiter that the transaction of a
+	// contract breach it was notified about has been reorged out of the
+	// chain. It should only return a nil error once the breachArbiter has
+	// dropped the breach info for this channel point.
+	ContractBreachReorg func(wire.OutPoint) error
+
 	// IsOurAddress is a function that returns true if the passed address
 	// is known to the underlying wallet. Otherwise, false should be
 	// returned.
@@ -134,6 +141,32 @@ type ChainArbitratorConfig struct {
 
 	// Sweeper allows resolvers to sweep their final outputs.
 	Sweeper *sweep.UtxoSweeper
+
+	// ForceCloseUneconomical, if true, makes us go on-chain for any HTLC
+	// that's close to expiry, even if the on-chain fees of claiming it
+	// exceed its value.
+	ForceCloseUneconomical bool
+
+	// GoOnChainConfTarget is the confirmation target we'll use to estimate
+	// the fee rate at which claiming an HTLC on-chain is priced.
+	GoOnChainConfTarget uint32
+
+	// MinGoOnChainValueRatio is the minimum ratio between the value of an
+	// HTLC close to expiry and the estimated on-chain fees of claiming it
+	// for us to go on-chain. This is ignored if ForceCloseUneconomical is
+	// set.
+	MinGoOnChainValueRatio float64
+}
+
+// SkippedGoOnChainStats counts the times we decided not to go on-chain for an
+// HTLC close to expiry, as claiming it would cost more than it's worth.
+type SkippedGoOnChainStats struct {
+	// Incoming is the number of skipped decisions for incoming HTLCs we
+	// know the preimage of.
+	Incoming uint64
+
+	// Outgoing is the number of skipped decisions for outgoing HTLCs.
+	Outgoing uint64
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -149,6 +182,9 @@ type ChainArbitrator struct {
 	started int32 // To be used atomically.
 	stopped int32 // To be used atomically.
 
+	skippedIncoming uint64 // To be used atomically.
+	skippedOutgoing uint64 // To be used atomically.
+
 	sync.Mutex
 
 	// activeChannels is a map of all the active contracts that are still
@@ -251,10 +287,26 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 			return chanMachine.ForceClose()
 		},
 		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcasted,
-		MarkChannelClosed:         channel.CloseChannel,
-		IsPendingClose:            false,
-		ChainArbitratorConfig:     c.cfg,
-		ChainEvents:               chanEvents,
+		MarkChannelClosed: func(summary *channeldb.ChannelCloseSummary) error {
+			err := channel.CloseChannel(summary)
+			switch err {
+			// If the channel was already closed by a transaction
+			// that has since been reorged out of the chain, we'll
+			// replace its close summary with the one of the
+			// transaction that closed it within the new chain.
+			case channeldb.ErrNoChanDBExists, channeldb.ErrNoActiveChannels:
+				return c.chanSource.UpdateClosedChannel(summary)
+			}
+
+			return err
+		},
+		IsPendingClose:        false,
+		ChainArbitratorConfig: c.cfg,
+		ChainEvents:           chanEvents,
+		PutResolverReport: func(report *channeldb.ResolverReport) error {
+			return c.chanSource.PutResolverReport(&chanPoint, report)
+		},
+		RecordSkippedGoOnChain: c.recordSkippedGoOnChain,
 	}
 
 	// The final component needed is an arbitrator log that the arbitrator
@@ -359,6 +411,9 @@ func (c *ChainArbitrator) Start() error {
 				contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 					return c.cfg.ContractBreach(chanPoint, retInfo)
 				},
+				contractBreachReorg: func() error {
+					return c.cfg.ContractBreachReorg(chanPoint)
+				},
 			},
 		)
 		if err != nil {
@@ -390,27 +445,83 @@ func (c *ChainArbitrator) Start() error {
 	}
 
 	// Next, for each channel is the closing state, we'll launch a
-	// corresponding more restricted resolver, as we don't have to watch
-	// the chain any longer, only resolve the contracts on the confirmed
-	// commitment.
+	// corresponding more restricted resolver, as we only have to resolve
+	// the contracts on the confirmed commitment. We'll still watch the
+	// chain for the channels we kept the state of at the time of the
+	// close though, as their closing transaction may be reorged out of the
+	// chain, in which case the channel may be closed by another
+	// transaction within the new chain.
 	for _, closeChanInfo := range closingChannels {
+		chanPoint := closeChanInfo.ChanPoint
+
+		chanEvents := &ChainEventSubscription{}
+		channel, err := c.chanSource.FetchHistoricalChannel(&chanPoint)
+		switch err {
+		case nil:
+			chainWatcher, err := newChainWatcher(
+				chainWatcherConfig{
+					chanState:    channel,
+					pendingClose: closeChanInfo,
+					notifier:     c.cfg.Notifier,
+					pCache:       c.cfg.PreimageDB,
+					signer:       c.cfg.Signer,
+					isOurAddr:    c.cfg.IsOurAddress,
+					contractBreach: func(
+						retInfo *lnwallet.BreachRetribution) error {
+
+						return c.cfg.ContractBreach(
+							chanPoint, retInfo,
+						)
+					},
+					contractBreachReorg: func() error {
+						return c.cfg.ContractBreachReorg(
+							chanPoint,
+						)
+					},
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			c.activeWatchers[chanPoint] = chainWatcher
+			chanEvents = chainWatcher.SubscribeChannelEvents()
+
+		// Channels that were closed before we started keeping their
+		// state around can't be watched any longer.
+		case channeldb.ErrChannelNotFound:
+
+		default:
+			return err
+		}
+
 		blockEpoch, err := c.cfg.Notifier.RegisterBlockEpochNtfn(nil)
 		if err != nil {
 			return err
 		}
 
 		// We can leave off the CloseContract and ForceCloseChan
-		// methods as the channel is already closed at this point.
-		chanPoint := closeChanInfo.ChanPoint
+		// methods as the channel is already closed at this point. If
+		// the channel is closed again after a reorg, we'll only have
+		// to replace its close summary.
 		arbCfg := ChannelArbitratorConfig{
 			ChanPoint:             chanPoint,
 			ShortChanID:           closeChanInfo.ShortChanID,
 			BlockEpochs:           blockEpoch,
 			ChainArbitratorConfig: c.cfg,
-			ChainEvents:           &ChainEventSubscription{},
+			ChainEvents:           chanEvents,
+			MarkChannelClosed:     c.chanSource.UpdateClosedChannel,
 			IsPendingClose:        true,
 			ClosingHeight:         closeChanInfo.CloseHeight,
 			CloseType:             closeChanInfo.CloseType,
+			PutResolverReport: func(
+				report *channeldb.ResolverReport) error {
+
+				return c.chanSource.PutResolverReport(
+					&chanPoint, report,
+				)
+			},
+			RecordSkippedGoOnChain: c.recordSkippedGoOnChain,
 		}
 		chanLog, err := newBoltArbitratorLog(
 			c.chanSource.DB, arbCfg, c.cfg.ChainHash, chanPoint,
@@ -679,6 +790,9 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 			contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 				return c.cfg.ContractBreach(chanPoint, retInfo)
 			},
+			contractBreachReorg: func() error {
+				return c.cfg.ContractBreachReorg(chanPoint)
+			},
 		},
 	)
 	if err != nil {
@@ -707,6 +821,65 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 	return chainWatcher.Start()
 }
 
+// SpliceChannel moves the contract of a spliced channel on top of its new
+// funding output, once the splice has been locked in. The arbitrator and chain
+// watcher of the prior funding output are torn down without marking the channel
+// as closed, and new ones are launched for the passed channel, which must be
+// the channel as it exists after the splice.
+func (c *ChainArbitrator) SpliceChannel(prevChanPoint wire.OutPoint,
+	newChan *channeldb.OpenChannel) error {
+
+	log.Infof("Moving ChannelArbitrator for ChannelPoint(%v) to spliced "+
+		"ChannelPoint(%v)", prevChanPoint, newChan.FundingOutpoint)
+
+	c.Lock()
+	channelArb, ok := c.activeChannels[prevChanPoint]
+	delete(c.activeChannels, prevChanPoint)
+
+	chainWatcher, watcherOk := c.activeWatchers[prevChanPoint]
+	delete(c.activeWatchers, prevChanPoint)
+	c.Unlock()
+
+	if watcherOk {
+		chainWatcher.Stop()
+	}
+
+	if ok {
+		if err := channelArb.Stop(); err != nil {
+			return err
+		}
+
+		// As the prior funding output can no longer be spent by any
+		// commitment transaction, the arbitrator has nothing left to
+		// resolve, so we can wipe its log.
+		if err := channelArb.log.WipeHistory(); err != nil {
+			return err
+		}
+	}
+
+	return c.WatchNewChannel(newChan)
+}
+
+// ResolveContract stops watching the channel with the passed funding outpoint,
+// and marks its contract as fully resolved. This is used for zero-conf
+// channels whose funding transaction has been double spent, as the funding
+// output they're watching will never exist.
+func (c *ChainArbitrator) ResolveContract(chanPoint wire.OutPoint) error {
+	c.Lock()
+	channelArb, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+
+	var arbLog ArbitratorLog
+	if ok {
+		if err := channelArb.Stop(); err != nil {
+			return err
+		}
+		arbLog = channelArb.log
+	}
+
+	return c.resolveContract(chanPoint, arbLog)
+}
+
 // SubscribeChannelEvents returns a new active subscription for the set of
 // possible on-chain events for a particular channel. The struct can be used by
 // callers to be notified whenever an event that changes the state of the
@@ -733,5 +906,39 @@ func (c *ChainArbitrator) SubscribeChannelEvents(
 	return watcher.SubscribeChannelEvents(), nil
 }
 
-// TODO(roasbeef): arbitration reports
-//  * types: contested, waiting for success conf, etc
+// recordSkippedGoOnChain records that a channel arbitrator decided not to go
+// on-chain for the passed HTLC, as claiming it would be uneconomical.
+func (c *ChainArbitrator) recordSkippedGoOnChain(htlc channeldb.HTLC) {
+	if htlc.Incoming {
+		atomic.AddUint64(&c.skippedIncoming, 1)
+		return
+	}
+
+	atomic.AddUint64(&c.skippedOutgoing, 1)
+}
+
+// SkippedGoOnChainStats returns the number of times our channel arbitrators
+// decided not to go on-chain for an uneconomical HTLC since startup.
+func (c *ChainArbitrator) SkippedGoOnChainStats() SkippedGoOnChainStats {
+	return SkippedGoOnChainStats{
+		Incoming: atomic.LoadUint64(&c.skippedIncoming),
+		Outgoing: atomic.LoadUint64(&c.skippedOutgoing),
+	}
+}
+
+// ContractReports returns a report on the resolution progress of each contract
+// resolver of the channel with the passed funding outpoint that hasn't been
+// fully resolved yet. If we aren't arbitrating the channel, nil is returned.
+func (c *ChainArbitrator) ContractReports(
+	chanPoint wire.OutPoint) []*ContractReport {
+
+	c.Lock()
+	channelArb, ok := c.activeChannels[chanPoint]
+	c.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return channelArb.Report()
+}
@@ -56,6 +56,15 @@ type ChainEventSubscription struct {
 	// material required to bring the cheating channel peer to justice.
 	ContractBreach chan *lnwallet.BreachRetribution
 
+	// CloseReorg is a channel that will be sent upon once the transaction
+	// of a close event that was previously dispatched has been reorged out
+	// of the chain. Any handling of the close should then be rolled back,
+	// as the close event will be dispatched again once the funding output
+	// is spent within the new chain, possibly by a different transaction.
+	// A close event that has yet to be consumed when the reorg is detected
+	// is discarded.
+	CloseReorg chan struct{}
+
 	// Cancel cancels the subscription to the event stream for a particular
 	// channel. This method should be called once the caller no longer needs to
 	// be notified of any on-chain events for a particular channel.
@@ -70,6 +79,13 @@ type chainWatcherConfig struct {
 	// database to ensure that we act using the most up to date state.
 	chanState *channeldb.OpenChannel
 
+	// pendingClose is the close summary of the channel if it had already
+	// been closed before the chain watcher was started, in which case
+	// chanState is the state of the channel at the time it was closed. We
+	// keep watching such a channel until it's fully resolved, as its
+	// closing transaction may still be reorged out of the chain.
+	pendingClose *channeldb.ChannelCloseSummary
+
 	// notifier is a reference to the channel notifier that we'll use to be
 	// notified of output spends and when transactions are confirmed.
 	notifier chainntnfs.ChainNotifier
@@ -90,6 +106,12 @@ type chainWatcherConfig struct {
 	// the channel as pending close in the database.
 	contractBreach func(*lnwallet.BreachRetribution) error
 
+	// contractBreachReorg is a method that will be called by the watcher
+	// if the transaction of a contract breach it handed off through
+	// contractBreach has been reorged out of the chain. Only when this
+	// method returns with a nil error the breach can be handed off again.
+	contractBreachReorg func() error
+
 	// isOurAddr is a function that returns true if the passed address is
 	// known to us.
 	isOurAddr func(btcutil.Address) bool
@@ -113,6 +135,12 @@ type chainWatcher struct {
 	// the current state number on the commitment transactions.
 	stateHintObfuscator [lnwallet.StateHintSize]byte
 
+	// breachDispatched indicates whether the close event that was last
+	// dispatched was a contract breach, which has been handed off to the
+	// breach arbiter. This is only accessed by the closeObserver
+	// goroutine.
+	breachDispatched bool
+
 	// All the fields below are protected by this mutex.
 	sync.Mutex
 
@@ -239,6 +267,7 @@ func (c *chainWatcher) SubscribeChannelEvents() *ChainEventSubscription {
 		LocalUnilateralClosure:  make(chan *LocalUnilateralCloseInfo, 1),
 		CooperativeClosure:      make(chan *CooperativeCloseInfo, 1),
 		ContractBreach:          make(chan *lnwallet.BreachRetribution, 1),
+		CloseReorg:              make(chan struct{}, 1),
 		Cancel: func() {
 			c.Lock()
 			delete(c.clientSubscriptions, clientID)
@@ -258,209 +287,340 @@ func (c *chainWatcher) SubscribeChannelEvents() *ChainEventSubscription {
 // channel that it's watching on chain. In the event of an on-chain event, the
 // close observer will assembled the proper materials required to claim the
 // funds of the channel on-chain (if required), then dispatch these as
-// notifications to all subscribers.
+// notifications to all subscribers. If the closing transaction is later
+// reorged out of the chain, the subscribers are notified of the reorg, and the
+// close observer goes back to watching for a spend of the funding output
+// within the new chain.
 func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 	defer c.wg.Done()
 
 	log.Infof("Close observer for ChannelPoint(%v) active",
 		c.cfg.chanState.FundingOutpoint)
 
-	select {
-	// We've detected a spend of the channel onchain! Depending on
-	// the type of spend, we'll act accordingly , so we'll examine
-	// the spending transaction to determine what we should do.
-	//
-	// TODO(Roasbeef): need to be able to ensure this only triggers
-	// on confirmation, to ensure if multiple txns are broadcast, we
-	// act on the one that's timestamped
-	case commitSpend, ok := <-spendNtfn.Spend:
-		// If the channel was closed, then this means that the
-		// notifier exited, so we will as well.
-		if !ok {
-			return
-		}
+	var (
+		// remoteChainTip is the pending commitment of the remote
+		// party, if any, at the time the funding output was first
+		// spent.
+		remoteChainTip *channeldb.CommitDiff
+
+		// stateFetched indicates whether we've already fetched the
+		// latest state of the channel from the database. We only do
+		// so once, as after a close has been dispatched the channel
+		// will be marked closed, which removes its state from the
+		// database, so we'll need to rely on the state we fetched
+		// before to handle a spend within a new chain after a reorg.
+		stateFetched bool
+
+		// closeDispatched indicates whether a close event has been
+		// dispatched for the current spend of the funding output.
+		closeDispatched bool
+
+		// pendingClose is the close summary of the channel if it had
+		// already been closed before we were started, and we've yet
+		// to be notified of the spend of its funding output.
+		pendingClose = c.cfg.pendingClose
+	)
 
-		// Otherwise, the remote party might have broadcast a
-		// prior revoked state...!!!
-		commitTxBroadcast := commitSpend.SpendingTx
+	for {
+		select {
+		// We've detected a spend of the channel onchain! Depending on
+		// the type of spend, we'll act accordingly , so we'll examine
+		// the spending transaction to determine what we should do.
+		//
+		// TODO(Roasbeef): need to be able to ensure this only triggers
+		// on confirmation, to ensure if multiple txns are broadcast, we
+		// act on the one that's timestamped
+		case commitSpend, ok := <-spendNtfn.Spend:
+			// If the channel was closed, then this means that the
+			// notifier exited, so we will as well.
+			if !ok {
+				return
+			}
 
-		localCommit, remoteCommit, err := c.cfg.chanState.LatestCommitments()
-		if err != nil {
-			log.Errorf("Unable to fetch channel state for "+
-				"chan_point=%v", c.cfg.chanState.FundingOutpoint)
-			return
-		}
+			// If the channel had already been closed before we
+			// were started, then the close event for its closing
+			// transaction was dispatched back then. If the funding
+			// output is still spent by that transaction, there's
+			// nothing to do until it's reorged out of the chain.
+			// Otherwise, it has been reorged out while we were
+			// down, so we'll let our subscribers roll back the
+			// close before dispatching the new one.
+			if pendingClose != nil {
+				closingTxid := pendingClose.ClosingTXID
+				c.breachDispatched = pendingClose.CloseType ==
+					channeldb.BreachClose
+				pendingClose = nil
+
+				if *commitSpend.SpenderTxHash == closingTxid {
+					closeDispatched = true
+					continue
+				}
+
+				if err := c.dispatchCloseReorg(); err != nil {
+					log.Errorf("unable to handle close "+
+						"reorg for chan_point=%v: %v",
+						c.cfg.chanState.FundingOutpoint,
+						err)
+				}
+			}
 
-		// We'll not retrieve the latest sate of the revocation
-		// store so we can populate the information within the
-		// channel state object that we have.
-		//
-		// TODO(roasbeef): mutation is bad mkay
-		_, err = c.cfg.chanState.RemoteRevocationStore()
-		if err != nil {
-			log.Errorf("Unable to fetch revocation state for "+
-				"chan_point=%v", c.cfg.chanState.FundingOutpoint)
-			return
-		}
+			if !stateFetched {
+				_, _, err := c.cfg.chanState.LatestCommitments()
+				if err != nil {
+					log.Errorf("Unable to fetch channel "+
+						"state for chan_point=%v",
+						c.cfg.chanState.FundingOutpoint)
+					return
+				}
+
+				// We'll not retrieve the latest sate of the
+				// revocation store so we can populate the
+				// information within the channel state object
+				// that we have.
+				//
+				// TODO(roasbeef): mutation is bad mkay
+				_, err = c.cfg.chanState.RemoteRevocationStore()
+				if err != nil {
+					log.Errorf("Unable to fetch revocation "+
+						"state for chan_point=%v",
+						c.cfg.chanState.FundingOutpoint)
+					return
+				}
+
+				remoteChainTip, err = c.cfg.chanState.RemoteCommitChainTip()
+				if err != nil && err != channeldb.ErrNoPendingCommit {
+					log.Errorf("unable to obtain chain tip "+
+						"for ChannelPoint(%v): %v",
+						c.cfg.chanState.FundingOutpoint,
+						err)
+					return
+				}
+
+				stateFetched = true
+			}
 
-		// If this is our commitment transaction, then we can
-		// exit here as we don't have any further processing we
-		// need to do (we can't cheat ourselves :p).
-		commitmentHash := localCommit.CommitTx.TxHash()
-		isOurCommitment := commitSpend.SpenderTxHash.IsEqual(
-			&commitmentHash,
-		)
-		if isOurCommitment {
-			if err := c.dispatchLocalForceClose(
-				commitSpend, *localCommit,
-			); err != nil {
-				log.Errorf("unable to handle local"+
-					"close for chan_point=%v: %v",
+			closeDispatched = c.handleCommitSpend(
+				commitSpend, remoteChainTip,
+			)
+
+		// The spend of the funding output has been reorged out of the
+		// chain. The notifier will keep watching for the funding
+		// output to be spent within the new chain, so we'll do the
+		// same after letting our subscribers know the close they've
+		// been notified of is no longer valid.
+		case _, ok := <-spendNtfn.Reorg:
+			if !ok {
+				return
+			}
+
+			if !closeDispatched {
+				continue
+			}
+			closeDispatched = false
+
+			if err := c.dispatchCloseReorg(); err != nil {
+				log.Errorf("unable to handle close reorg for "+
+					"chan_point=%v: %v",
 					c.cfg.chanState.FundingOutpoint, err)
 			}
+
+		// The chainWatcher has been signalled to exit, so we'll do so
+		// now.
+		case <-c.quit:
 			return
 		}
+	}
+}
 
-		// Next, we'll check to see if this is a cooperative
-		// channel closure or not. This is characterized by
-		// having an input sequence number that's finalized.
-		// This won't happen with regular commitment
-		// transactions due to the state hint encoding scheme.
-		if commitTxBroadcast.TxIn[0].Sequence == wire.MaxTxInSequenceNum {
-			err := c.dispatchCooperativeClose(commitSpend)
-			if err != nil {
-				log.Errorf("unable to handle co op close: %v", err)
-			}
-			return
+// handleCommitSpend examines the spending transaction of the funding output to
+// determine how the channel was closed, and dispatches the matching close
+// event to all subscribers. It returns whether a close event was dispatched.
+func (c *chainWatcher) handleCommitSpend(commitSpend *chainntnfs.SpendDetail,
+	remoteChainTip *channeldb.CommitDiff) bool {
+
+	// The remote party might have broadcast a prior revoked
+	// state...!!!
+	commitTxBroadcast := commitSpend.SpendingTx
+	localCommit := &c.cfg.chanState.LocalCommitment
+	remoteCommit := &c.cfg.chanState.RemoteCommitment
+
+	// If this is our commitment transaction, then we can
+	// exit here as we don't have any further processing we
+	// need to do (we can't cheat ourselves :p).
+	commitmentHash := localCommit.CommitTx.TxHash()
+	isOurCommitment := commitSpend.SpenderTxHash.IsEqual(
+		&commitmentHash,
+	)
+	if isOurCommitment {
+		if err := c.dispatchLocalForceClose(
+			commitSpend, *localCommit,
+		); err != nil {
+			log.Errorf("unable to handle local"+
+				"close for chan_point=%v: %v",
+				c.cfg.chanState.FundingOutpoint, err)
+			return false
 		}
+		return true
+	}
 
-		log.Warnf("Unprompted commitment broadcast for "+
-			"ChannelPoint(%v) ", c.cfg.chanState.FundingOutpoint)
+	// If the funding output was spent by the splice
+	// transaction of a pending splice, then the channel
+	// remains open on top of the new funding output, which
+	// will be watched once the splice is locked in.
+	splice, err := c.cfg.chanState.PendingSplice()
+	switch {
+	case err == nil:
+		spliceTxid := splice.SpliceTx.TxHash()
+		if commitSpend.SpenderTxHash.IsEqual(&spliceTxid) {
+			log.Infof("ChannelPoint(%v) has been spliced "+
+				"into %v", c.cfg.chanState.FundingOutpoint,
+				splice.FundingOutpoint)
+			return false
+		}
 
-		// Decode the state hint encoded within the commitment
-		// transaction to determine if this is a revoked state
-		// or not.
-		obfuscator := c.stateHintObfuscator
-		broadcastStateNum := lnwallet.GetStateNumHint(
-			commitTxBroadcast, obfuscator,
-		)
-		remoteStateNum := remoteCommit.CommitHeight
+	case err != channeldb.ErrNoPendingSplice:
+		log.Errorf("Unable to fetch pending splice for "+
+			"chan_point=%v: %v",
+			c.cfg.chanState.FundingOutpoint, err)
+		return false
+	}
 
-		remoteChainTip, err := c.cfg.chanState.RemoteCommitChainTip()
-		if err != nil && err != channeldb.ErrNoPendingCommit {
-			log.Errorf("unable to obtain chain tip for "+
-				"ChannelPoint(%v): %v",
-				c.cfg.chanState.FundingOutpoint, err)
-			return
+	// Next, we'll check to see if this is a cooperative
+	// channel closure or not. This is characterized by
+	// having an input sequence number that's finalized.
+	// This won't happen with regular commitment
+	// transactions due to the state hint encoding scheme.
+	if commitTxBroadcast.TxIn[0].Sequence == wire.MaxTxInSequenceNum {
+		err := c.dispatchCooperativeClose(commitSpend)
+		if err != nil {
+			log.Errorf("unable to handle co op close: %v", err)
+			return false
 		}
+		return true
+	}
 
-		switch {
-		// If state number spending transaction matches the
-		// current latest state, then they've initiated a
-		// unilateral close. So we'll trigger the unilateral
-		// close signal so subscribers can clean up the state
-		// as necessary.
-		case broadcastStateNum == remoteStateNum:
-			err := c.dispatchRemoteForceClose(
-				commitSpend, *remoteCommit,
-				c.cfg.chanState.RemoteCurrentRevocation,
-			)
-			if err != nil {
-				log.Errorf("unable to handle remote "+
-					"close for chan_point=%v: %v",
-					c.cfg.chanState.FundingOutpoint, err)
-			}
+	log.Warnf("Unprompted commitment broadcast for "+
+		"ChannelPoint(%v) ", c.cfg.chanState.FundingOutpoint)
 
-		// We'll also handle the case of the remote party
-		// broadcasting their commitment transaction which is
-		// one height above ours. This case can arise when we
-		// initiate a state transition, but the remote party
-		// has a fail crash _after_ accepting the new state,
-		// but _before_ sending their signature to us.
-		case broadcastStateNum == remoteStateNum+1 &&
-			remoteChainTip != nil:
-
-			err := c.dispatchRemoteForceClose(
-				commitSpend, remoteChainTip.Commitment,
-				c.cfg.chanState.RemoteNextRevocation,
-			)
-			if err != nil {
-				log.Errorf("unable to handle remote "+
-					"close for chan_point=%v: %v",
-					c.cfg.chanState.FundingOutpoint, err)
-			}
+	// Decode the state hint encoded within the commitment
+	// transaction to determine if this is a revoked state
+	// or not.
+	obfuscator := c.stateHintObfuscator
+	broadcastStateNum := lnwallet.GetStateNumHint(
+		commitTxBroadcast, obfuscator,
+	)
+	remoteStateNum := remoteCommit.CommitHeight
+
+	switch {
+	// If state number spending transaction matches the
+	// current latest state, then they've initiated a
+	// unilateral close. So we'll trigger the unilateral
+	// close signal so subscribers can clean up the state
+	// as necessary.
+	case broadcastStateNum == remoteStateNum:
+		err := c.dispatchRemoteForceClose(
+			commitSpend, *remoteCommit,
+			c.cfg.chanState.RemoteCurrentRevocation,
+		)
+		if err != nil {
+			log.Errorf("unable to handle remote "+
+				"close for chan_point=%v: %v",
+				c.cfg.chanState.FundingOutpoint, err)
+			return false
+		}
 
-		// This is the case that somehow the commitment broadcast is
-		// actually greater than even one beyond our best known state
-		// number. This should ONLY happen in case we experienced some
-		// sort of data loss.
-		case broadcastStateNum > remoteStateNum+1:
-			log.Warnf("Remote node broadcast state #%v, "+
-				"which is more than 1 beyond best known "+
-				"state #%v!!! Attempting recovery...",
-				broadcastStateNum, remoteStateNum)
-
-			// If we are lucky, the remote peer sent us the correct
-			// commitment point during channel sync, such that we
-			// can sweep our funds.
-			// TODO(halseth): must handle the case where we haven't
-			// yet processed the chan sync message.
-			commitPoint, err := c.cfg.chanState.DataLossCommitPoint()
-			if err != nil {
-				log.Errorf("Unable to retrieve commitment "+
-					"point for channel(%v) with lost "+
-					"state: %v",
-					c.cfg.chanState.FundingOutpoint, err)
-				return
-			}
+	// We'll also handle the case of the remote party
+	// broadcasting their commitment transaction which is
+	// one height above ours. This case can arise when we
+	// initiate a state transition, but the remote party
+	// has a fail crash _after_ accepting the new state,
+	// but _before_ sending their signature to us.
+	case broadcastStateNum == remoteStateNum+1 &&
+		remoteChainTip != nil:
+
+		err := c.dispatchRemoteForceClose(
+			commitSpend, remoteChainTip.Commitment,
+			c.cfg.chanState.RemoteNextRevocation,
+		)
+		if err != nil {
+			log.Errorf("unable to handle remote "+
+				"close for chan_point=%v: %v",
+				c.cfg.chanState.FundingOutpoint, err)
+			return false
+		}
 
-			log.Infof("Recovered commit point(%x) for "+
-				"channel(%v)! Now attempting to use it to "+
-				"sweep our funds...",
-				commitPoint.SerializeCompressed(),
-				c.cfg.chanState.FundingOutpoint)
-
-			// Since we don't have the commitment stored for this
-			// state, we'll just pass an empty commitment. Note
-			// that this means we won't be able to recover any HTLC
-			// funds.
-			// TODO(halseth): can we try to recover some HTLCs?
-			err = c.dispatchRemoteForceClose(
-				commitSpend, channeldb.ChannelCommitment{},
-				commitPoint,
-			)
-			if err != nil {
-				log.Errorf("unable to handle remote "+
-					"close for chan_point=%v: %v",
-					c.cfg.chanState.FundingOutpoint, err)
-			}
+	// This is the case that somehow the commitment broadcast is
+	// actually greater than even one beyond our best known state
+	// number. This should ONLY happen in case we experienced some
+	// sort of data loss.
+	case broadcastStateNum > remoteStateNum+1:
+		log.Warnf("Remote node broadcast state #%v, "+
+			"which is more than 1 beyond best known "+
+			"state #%v!!! Attempting recovery...",
+			broadcastStateNum, remoteStateNum)
+
+		// If we are lucky, the remote peer sent us the correct
+		// commitment point during channel sync, such that we
+		// can sweep our funds.
+		// TODO(halseth): must handle the case where we haven't
+		// yet processed the chan sync message.
+		commitPoint, err := c.cfg.chanState.DataLossCommitPoint()
+		if err != nil {
+			log.Errorf("Unable to retrieve commitment "+
+				"point for channel(%v) with lost "+
+				"state: %v",
+				c.cfg.chanState.FundingOutpoint, err)
+			return false
+		}
 
-		// If the state number broadcast is lower than the
-		// remote node's current un-revoked height, then
-		// THEY'RE ATTEMPTING TO VIOLATE THE CONTRACT LAID OUT
-		// WITHIN THE PAYMENT CHANNEL.  Therefore we close the
-		// signal indicating a revoked broadcast to allow
-		// subscribers to swiftly dispatch justice!!!
-		case broadcastStateNum < remoteStateNum:
-			err := c.dispatchContractBreach(
-				commitSpend, remoteCommit,
-				broadcastStateNum,
-			)
-			if err != nil {
-				log.Errorf("unable to handle channel "+
-					"breach for chan_point=%v: %v",
-					c.cfg.chanState.FundingOutpoint, err)
-			}
+		log.Infof("Recovered commit point(%x) for "+
+			"channel(%v)! Now attempting to use it to "+
+			"sweep our funds...",
+			commitPoint.SerializeCompressed(),
+			c.cfg.chanState.FundingOutpoint)
+
+		// Since we don't have the commitment stored for this
+		// state, we'll just pass an empty commitment. Note
+		// that this means we won't be able to recover any HTLC
+		// funds.
+		// TODO(halseth): can we try to recover some HTLCs?
+		err = c.dispatchRemoteForceClose(
+			commitSpend, channeldb.ChannelCommitment{},
+			commitPoint,
+		)
+		if err != nil {
+			log.Errorf("unable to handle remote "+
+				"close for chan_point=%v: %v",
+				c.cfg.chanState.FundingOutpoint, err)
+			return false
 		}
 
-		// Now that a spend has been detected, we've done our
-		// job, so we'll exit immediately.
-		return
+	// If the state number broadcast is lower than the
+	// remote node's current un-revoked height, then
+	// THEY'RE ATTEMPTING TO VIOLATE THE CONTRACT LAID OUT
+	// WITHIN THE PAYMENT CHANNEL.  Therefore we close the
+	// signal indicating a revoked broadcast to allow
+	// subscribers to swiftly dispatch justice!!!
+	case broadcastStateNum < remoteStateNum:
+		err := c.dispatchContractBreach(
+			commitSpend, remoteCommit,
+			broadcastStateNum,
+		)
+		if err != nil {
+			log.Errorf("unable to handle channel "+
+				"breach for chan_point=%v: %v",
+				c.cfg.chanState.FundingOutpoint, err)
+			return false
+		}
 
-	// The chainWatcher has been signalled to exit, so we'll do so now.
-	case <-c.quit:
-		return
+	// Otherwise, the state number is equal to the remote node's state
+	// number plus one, but we don't have a pending commitment for it, so
+	// we don't know how to handle this spend.
+	default:
+		return false
 	}
+
+	return true
 }
 
 // toSelfAmount takes a transaction and returns the sum of all outputs that pay
@@ -657,6 +817,52 @@ func (c *chainWatcher) dispatchRemoteForceClose(
 	return nil
 }
 
+// dispatchCloseReorg notifies all subscribers that the transaction of the
+// close event they were last notified of has been reorged out of the chain.
+// Any close event that is still pending delivery to a subscriber is drained,
+// such that the close event that is dispatched once the funding output is
+// spent within the new chain can be delivered without blocking. If the
+// reorged out close was a contract breach, the breach arbiter is notified as
+// well, such that it drops the retribution it was handed.
+func (c *chainWatcher) dispatchCloseReorg() error {
+	log.Warnf("Closing transaction of ChannelPoint(%v) has been reorged "+
+		"out of the chain", c.cfg.chanState.FundingOutpoint)
+
+	if c.breachDispatched {
+		if err := c.cfg.contractBreachReorg(); err != nil {
+			return fmt.Errorf("unable to hand breach reorg off to "+
+				"breachArbiter: %v", err)
+		}
+		c.breachDispatched = false
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	for _, sub := range c.clientSubscriptions {
+		select {
+		case <-sub.RemoteUnilateralClosure:
+		case <-sub.LocalUnilateralClosure:
+		case <-sub.CooperativeClosure:
+		case <-sub.ContractBreach:
+		default:
+		}
+
+		select {
+		case <-sub.CloseReorg:
+		default:
+		}
+
+		select {
+		case sub.CloseReorg <- struct{}{}:
+		case <-c.quit:
+			return fmt.Errorf("exiting")
+		}
+	}
+
+	return nil
+}
+
 // dispatchContractBreach processes a detected contract breached by the remote
 // party. This method is to be called once we detect that the remote party has
 // broadcast a prior revoked commitment state. This method well prepare all the
@@ -670,7 +876,15 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 		"ChannelPoint(%v). Revoked state #%v was broadcast!!!",
 		c.cfg.chanState.FundingOutpoint, broadcastStateNum)
 
-	if err := c.cfg.chanState.MarkBorked(); err != nil {
+	// If the channel was already closed by a transaction that has since
+	// been reorged out of the chain, then there's no channel state left
+	// to mark.
+	err := c.cfg.chanState.MarkBorked()
+	switch err {
+	case nil, channeldb.ErrNoChanDBExists, channeldb.ErrNoActiveChannels,
+		channeldb.ErrChannelNotFound:
+
+	default:
 		return fmt.Errorf("unable to mark channel as borked: %v", err)
 	}
 
@@ -712,6 +926,7 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 			"breachArbiter: %v", err)
 		return err
 	}
+	c.breachDispatched = true
 
 	// With the event processed, we'll now notify all subscribers of the
 	// event.
@@ -749,7 +964,20 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 		LocalChanConfig:         c.cfg.chanState.LocalChanCfg,
 	}
 
-	if err := c.cfg.chanState.CloseChannel(&closeSummary); err != nil {
+	err = c.cfg.chanState.CloseChannel(&closeSummary)
+	switch err {
+	case nil:
+
+	// If the channel was already closed by a transaction that has since
+	// been reorged out of the chain, we'll replace its close summary with
+	// the one of the breach.
+	case channeldb.ErrNoChanDBExists, channeldb.ErrNoActiveChannels:
+		err := c.cfg.chanState.Db.UpdateClosedChannel(&closeSummary)
+		if err != nil {
+			return err
+		}
+
+	default:
 		return err
 	}
 
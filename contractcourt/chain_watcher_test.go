@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -17,6 +18,7 @@ type mockNotifier struct {
 	spendChan chan *chainntnfs.SpendDetail
 	epochChan chan *chainntnfs.BlockEpoch
 	confChan  chan *chainntnfs.TxConfirmation
+	reorgChan chan struct{}
 }
 
 func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash, _ []byte, numConfs,
@@ -47,6 +49,7 @@ func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint, _ []byte,
 
 	return &chainntnfs.SpendEvent{
 		Spend:  m.spendChan,
+		Reorg:  m.reorgChan,
 		Cancel: func() {},
 	}, nil
 }
@@ -213,3 +216,379 @@ func TestChainWatcherRemoteUnilateralClosePendingCommit(t *testing.T) {
 		t.Fatalf("unable to find alice's commit resolution")
 	}
 }
+
+// TestChainWatcherCloseReorg tests that the chain watcher notifies its
+// subscribers once the spend of the funding output is reorged out of the
+// chain, and that it properly dispatches the close again once the funding
+// output is spent within the new chain.
+func TestChainWatcherCloseReorg(t *testing.T) {
+	t.Parallel()
+
+	// First, we'll create two channels which already have established a
+	// commitment contract between themselves.
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// With the channels created, we'll now create a chain watcher instance
+	// which will be watching for any closes of Alice's channel.
+	aliceNotifier := &mockNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail),
+		reorgChan: make(chan struct{}),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState: aliceChannel.State(),
+		notifier:  aliceNotifier,
+		signer:    aliceChannel.Signer,
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	// We'll request a new channel event subscription from Alice's chain
+	// watcher.
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	assertCloseReorg := func() {
+		t.Helper()
+
+		select {
+		case <-chanEvents.CloseReorg:
+		case <-time.After(time.Second * 15):
+			t.Fatalf("didn't receive close reorg event")
+		}
+	}
+
+	// We'll start by simulating Bob's commitment confirming, which should
+	// be detected as a remote unilateral close.
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx
+	bobTxHash := bobCommit.TxHash()
+	bobSpend := &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+	aliceNotifier.spendChan <- bobSpend
+
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive unilateral close event")
+	}
+
+	// Now, we'll simulate a reorg that removes Bob's commitment from the
+	// chain. The chain watcher should notify us that the close has been
+	// reorged out.
+	aliceNotifier.reorgChan <- struct{}{}
+	assertCloseReorg()
+
+	// As no close is active anymore, another reorg notification should be
+	// ignored.
+	aliceNotifier.reorgChan <- struct{}{}
+
+	// Within the new chain, Alice's commitment confirms instead. The chain
+	// watcher should detect this as a local unilateral close.
+	aliceCommit := aliceChannel.State().LocalCommitment.CommitTx
+	aliceTxHash := aliceCommit.TxHash()
+	aliceSpend := &chainntnfs.SpendDetail{
+		SpenderTxHash: &aliceTxHash,
+		SpendingTx:    aliceCommit,
+	}
+	aliceNotifier.spendChan <- aliceSpend
+
+	select {
+	case <-chanEvents.LocalUnilateralClosure:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive local unilateral close event")
+	}
+
+	// The ignored reorg shouldn't have resulted in a notification.
+	select {
+	case <-chanEvents.CloseReorg:
+		t.Fatalf("unexpected close reorg event")
+	default:
+	}
+
+	// Finally, we'll simulate a deeper reorg that removes Alice's
+	// commitment, after which Bob's commitment confirms once again, only
+	// to also be reorged out before we had a chance to act on it.
+	aliceNotifier.reorgChan <- struct{}{}
+	assertCloseReorg()
+
+	aliceNotifier.spendChan <- bobSpend
+	aliceNotifier.reorgChan <- struct{}{}
+	assertCloseReorg()
+
+	// The stale close of Bob's commitment should have been discarded.
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+		t.Fatalf("unexpected unilateral close event")
+	default:
+	}
+}
+
+// TestChainWatcherBreachReorg tests that once the transaction of a contract
+// breach is reorged out of the chain, the chain watcher lets the breach
+// arbiter know, besides notifying its subscribers of the reorg.
+func TestChainWatcherBreachReorg(t *testing.T) {
+	t.Parallel()
+
+	// First, we'll create two channels which already have established a
+	// commitment contract between themselves.
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll hold on to Bob's current commitment, which we'll revoke by
+	// advancing the channel state to a new commitment.
+	bobRevokedCommit := bobChannel.State().LocalCommitment.CommitTx
+
+	htlc := &lnwire.UpdateAddHTLC{
+		ID:          uint64(0),
+		PaymentHash: sha256.Sum256(bytes.Repeat([]byte{1}, 32)),
+		Amount:      lnwire.NewMSatFromSatoshis(20000),
+		Expiry:      uint32(5),
+	}
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("bob unable to recv add htlc: %v", err)
+	}
+
+	aliceSig, aliceHtlcSigs, err := aliceChannel.SignNextCommitment()
+	if err != nil {
+		t.Fatalf("alice unable to sign commitment: %v", err)
+	}
+	err = bobChannel.ReceiveNewCommitment(aliceSig, aliceHtlcSigs)
+	if err != nil {
+		t.Fatalf("bob unable to receive commitment: %v", err)
+	}
+	bobRevocation, _, err := bobChannel.RevokeCurrentCommitment()
+	if err != nil {
+		t.Fatalf("bob unable to revoke commitment: %v", err)
+	}
+	if _, _, _, err := aliceChannel.ReceiveRevocation(bobRevocation); err != nil {
+		t.Fatalf("alice unable to receive revocation: %v", err)
+	}
+	bobSig, bobHtlcSigs, err := bobChannel.SignNextCommitment()
+	if err != nil {
+		t.Fatalf("bob unable to sign commitment: %v", err)
+	}
+	err = aliceChannel.ReceiveNewCommitment(bobSig, bobHtlcSigs)
+	if err != nil {
+		t.Fatalf("alice unable to receive commitment: %v", err)
+	}
+	aliceRevocation, _, err := aliceChannel.RevokeCurrentCommitment()
+	if err != nil {
+		t.Fatalf("alice unable to revoke commitment: %v", err)
+	}
+	if _, _, _, err := bobChannel.ReceiveRevocation(aliceRevocation); err != nil {
+		t.Fatalf("bob unable to receive revocation: %v", err)
+	}
+
+	// With the channels created, we'll now create a chain watcher instance
+	// which will be watching for any closes of Alice's channel, and hands
+	// off breaches and their reorgs.
+	breachChan := make(chan struct{}, 1)
+	breachReorgChan := make(chan struct{}, 1)
+	aliceNotifier := &mockNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail),
+		reorgChan: make(chan struct{}),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState: aliceChannel.State(),
+		notifier:  aliceNotifier,
+		signer:    aliceChannel.Signer,
+		contractBreach: func(*lnwallet.BreachRetribution) error {
+			breachChan <- struct{}{}
+			return nil
+		},
+		contractBreachReorg: func() error {
+			breachReorgChan <- struct{}{}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// Bob now broadcasts his revoked commitment, which should be handed
+	// off to the breach arbiter.
+	bobTxHash := bobRevokedCommit.TxHash()
+	aliceNotifier.spendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobRevokedCommit,
+	}
+
+	select {
+	case <-breachChan:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("breach not handed off")
+	}
+	select {
+	case <-chanEvents.ContractBreach:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive contract breach event")
+	}
+
+	// Once the breach is reorged out of the chain, the breach arbiter
+	// should be notified before our subscribers are.
+	aliceNotifier.reorgChan <- struct{}{}
+
+	select {
+	case <-breachReorgChan:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("breach reorg not handed off")
+	}
+	select {
+	case <-chanEvents.CloseReorg:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive close reorg event")
+	}
+}
+
+// TestChainWatcherPendingCloseReorg tests that a chain watcher started for a
+// channel that was closed before, handles a reorg of the closing transaction
+// using the state of the channel as of the time of the close, whether the
+// reorg happens while the chain watcher is active or while it was down.
+func TestChainWatcherPendingCloseReorg(t *testing.T) {
+	t.Parallel()
+
+	// First, we'll create two channels which already have established a
+	// commitment contract between themselves.
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx
+	bobTxHash := bobCommit.TxHash()
+	bobSpend := &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+	aliceCommit := aliceChannel.State().LocalCommitment.CommitTx
+	aliceTxHash := aliceCommit.TxHash()
+	aliceSpend := &chainntnfs.SpendDetail{
+		SpenderTxHash: &aliceTxHash,
+		SpendingTx:    aliceCommit,
+	}
+
+	// We'll mark Alice's channel as closed by Bob's commitment, after
+	// which only the state as of the time of the close remains.
+	aliceState := aliceChannel.State()
+	closeSummary := &channeldb.ChannelCloseSummary{
+		ChanPoint:   aliceState.FundingOutpoint,
+		ChainHash:   aliceState.ChainHash,
+		ClosingTXID: bobTxHash,
+		RemotePub:   aliceState.IdentityPub,
+		Capacity:    aliceState.Capacity,
+		CloseType:   channeldb.RemoteForceClose,
+		IsPending:   true,
+	}
+	if err := aliceState.CloseChannel(closeSummary); err != nil {
+		t.Fatalf("unable to close channel: %v", err)
+	}
+
+	startChainWatcher := func() (*mockNotifier, *chainWatcher,
+		*ChainEventSubscription) {
+
+		t.Helper()
+
+		histState, err := aliceState.Db.FetchHistoricalChannel(
+			&aliceState.FundingOutpoint,
+		)
+		if err != nil {
+			t.Fatalf("unable to fetch historical channel: %v", err)
+		}
+
+		notifier := &mockNotifier{
+			spendChan: make(chan *chainntnfs.SpendDetail),
+			reorgChan: make(chan struct{}),
+		}
+		chainWatcher, err := newChainWatcher(chainWatcherConfig{
+			chanState:    histState,
+			pendingClose: closeSummary,
+			notifier:     notifier,
+			signer:       aliceChannel.Signer,
+		})
+		if err != nil {
+			t.Fatalf("unable to create chain watcher: %v", err)
+		}
+		if err := chainWatcher.Start(); err != nil {
+			t.Fatalf("unable to start chain watcher: %v", err)
+		}
+
+		return notifier, chainWatcher,
+			chainWatcher.SubscribeChannelEvents()
+	}
+
+	assertCloseReorg := func(chanEvents *ChainEventSubscription) {
+		t.Helper()
+
+		select {
+		case <-chanEvents.CloseReorg:
+		case <-time.After(time.Second * 15):
+			t.Fatalf("didn't receive close reorg event")
+		}
+	}
+
+	assertLocalClose := func(chanEvents *ChainEventSubscription) {
+		t.Helper()
+
+		select {
+		case <-chanEvents.LocalUnilateralClosure:
+		case <-time.After(time.Second * 15):
+			t.Fatalf("didn't receive local unilateral close event")
+		}
+	}
+
+	// We'll start a chain watcher as we would after a restart. As Bob's
+	// commitment still spends the funding output, the close it was
+	// already notified of shouldn't be dispatched again.
+	notifier, chainWatcher, chanEvents := startChainWatcher()
+	notifier.spendChan <- bobSpend
+
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+		t.Fatalf("unexpected unilateral close event")
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	// Once Bob's commitment is reorged out of the chain, we should be
+	// notified, and Alice's commitment confirming within the new chain
+	// should be detected as a local unilateral close.
+	notifier.reorgChan <- struct{}{}
+	assertCloseReorg(chanEvents)
+
+	notifier.spendChan <- aliceSpend
+	assertLocalClose(chanEvents)
+
+	chainWatcher.Stop()
+
+	// Finally, we'll start a chain watcher again, but this time Bob's
+	// commitment was reorged out of the chain while it was down, so the
+	// funding output is spent by Alice's commitment right away. We should
+	// be notified of the reorg before the new close.
+	notifier, chainWatcher, chanEvents = startChainWatcher()
+	defer chainWatcher.Stop()
+
+	notifier.spendChan <- aliceSpend
+	assertCloseReorg(chanEvents)
+	assertLocalClose(chanEvents)
+}
@@ -5,6 +5,7 @@ import (
 	"sync/atomic"
 
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -121,6 +122,16 @@ type ChannelArbitratorConfig struct {
 	// TODO(roasbeef): need RPC's to combine for pendingchannels RPC
 	MarkChannelResolved func() error
 
+	// PutResolverReport persists the final resolution report of an output
+	// of the channel, once the contract resolver tasked with it has fully
+	// resolved it.
+	PutResolverReport func(*channeldb.ResolverReport) error
+
+	// RecordSkippedGoOnChain is called once for each HTLC close to expiry
+	// that we decide not to go on-chain for, as claiming it on-chain would
+	// be uneconomical.
+	RecordSkippedGoOnChain func(channeldb.HTLC)
+
 	ChainArbitratorConfig
 }
 
@@ -177,6 +188,25 @@ type ChannelArbitrator struct {
 	// commitment transaction.
 	activeHTLCs htlcSet
 
+	// skippedHTLCs is the set of active HTLC's that we decided not to go
+	// on-chain for, as claiming them would be uneconomical. We track them
+	// so each HTLC is only recorded and failed back once, rather than on
+	// every new block until it's removed from the commitment transaction.
+	skippedHTLCs htlcSet
+
+	// incubatedOutputs is the set of outputs we've sent to the nursery for
+	// incubation, and resolvedHTLCs the set of HTLC indexes we've
+	// delivered a resolution message to the switch for. As neither the
+	// nursery nor the switch can take these back, we keep track of them
+	// across contract rollbacks, such that a close that's dispatched
+	// again after a reorg doesn't hand them off a second time.
+	incubatedOutputs map[wire.OutPoint]struct{}
+	resolvedHTLCs    map[uint64]struct{}
+
+	// handoffMtx guards incubatedOutputs and resolvedHTLCs, as they're
+	// accessed by the goroutines resolving the active resolvers.
+	handoffMtx sync.Mutex
+
 	// cfg contains all the functionality that the ChannelArbitrator requires
 	// to do its duty.
 	cfg ChannelArbitratorConfig
@@ -191,9 +221,23 @@ type ChannelArbitrator struct {
 	htlcUpdates <-chan []channeldb.HTLC
 
 	// activeResolvers is a slice of any active resolvers. This is used to
-	// be able to signal them for shutdown in the case that we shutdown.
+	// be able to signal them for shutdown in the case that we shutdown,
+	// and to report on their progress.
 	activeResolvers []ContractResolver
 
+	// activeResolversLock guards activeResolvers, as resolvers are
+	// swapped out or removed by the goroutines resolving them.
+	activeResolversLock sync.RWMutex
+
+	// resolversQuit is closed to signal the goroutines resolving the
+	// active resolvers to exit, without shutting down the arbitrator
+	// itself. This is used to roll back the resolvers once the commitment
+	// transaction they were launched for is reorged out of the chain.
+	resolversQuit chan struct{}
+
+	// resolversWg tracks the goroutines resolving the active resolvers.
+	resolversWg sync.WaitGroup
+
 	// resolutionSignal is a channel that will be sent upon by contract
 	// resolvers once their contract has been fully resolved. With each
 	// send, we'll check to see if the contract is fully resolved.
@@ -223,6 +267,9 @@ func NewChannelArbitrator(cfg ChannelArbitratorConfig,
 		resolutionSignal: make(chan struct{}),
 		forceCloseReqs:   make(chan *forceCloseReq),
 		activeHTLCs:      newHtlcSet(startingHTLCs),
+		skippedHTLCs:     newHtlcSet(nil),
+		incubatedOutputs: make(map[wire.OutPoint]struct{}),
+		resolvedHTLCs:    make(map[uint64]struct{}),
 		cfg:              cfg,
 		quit:             make(chan struct{}),
 	}
@@ -336,11 +383,7 @@ func (c *ChannelArbitrator) Start() error {
 		log.Infof("ChannelArbitrator(%v): relaunching %v contract "+
 			"resolvers", c.cfg.ChanPoint, len(unresolvedContracts))
 
-		c.activeResolvers = unresolvedContracts
-		for _, contract := range unresolvedContracts {
-			c.wg.Add(1)
-			go c.resolveContract(contract)
-		}
+		c.launchResolvers(unresolvedContracts)
 	}
 
 	// TODO(roasbeef): cancel if breached
@@ -362,9 +405,11 @@ func (c *ChannelArbitrator) Stop() error {
 		go c.cfg.ChainEvents.Cancel()
 	}
 
+	c.activeResolversLock.RLock()
 	for _, activeResolver := range c.activeResolvers {
 		activeResolver.Stop()
 	}
+	c.activeResolversLock.RUnlock()
 
 	close(c.quit)
 	c.wg.Wait()
@@ -646,7 +691,7 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 			log.Infof("ChannelArbitrator(%v): sending commit "+
 				"output for incubation", c.cfg.ChanPoint)
 
-			err = c.cfg.IncubateOutputs(
+			err = c.incubateOutputs(
 				c.cfg.ChanPoint, commitRes,
 				nil, nil, triggerHeight,
 			)
@@ -678,7 +723,7 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 
 		// With the commitment broadcast, we'll then send over all
 		// messages we can send immediately.
-		err = c.cfg.DeliverResolutionMsg(pktsToSend...)
+		err = c.deliverResolutionMsg(pktsToSend...)
 		if err != nil {
 			// TODO(roasbeef): make sure packet sends are idempotent
 			log.Errorf("unable to send pkts: %v", err)
@@ -695,11 +740,7 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 
 		// Finally, we'll launch all the required contract resolvers.
 		// Once they're all resolved, we're no longer needed.
-		c.activeResolvers = htlcResolvers
-		for _, contract := range htlcResolvers {
-			c.wg.Add(1)
-			go c.resolveContract(contract)
-		}
+		c.launchResolvers(htlcResolvers)
 
 		nextState = StateWaitingFullResolution
 
@@ -884,6 +925,101 @@ func (c *ChannelArbitrator) shouldGoOnChain(htlcExpiry, broadcastDelta,
 	return currentHeight >= broadcastCutOff
 }
 
+// htlcClaimCost estimates the on-chain fees of claiming an HTLC at the current
+// fee rate: its share of the commitment transaction, the second-level HTLC
+// transaction of the given weight, and the sweep of the second-level output.
+func (c *ChannelArbitrator) htlcClaimCost(
+	secondLevelWeight int64) (btcutil.Amount, error) {
+
+	feePerKw, err := c.cfg.FeeEstimator.EstimateFeePerKW(
+		c.cfg.GoOnChainConfTarget,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var sweepWeight lnwallet.TxWeightEstimator
+	sweepWeight.AddWitnessInput(lnwallet.ToLocalTimeoutWitnessSize)
+	sweepWeight.AddP2WKHOutput()
+
+	totalWeight := lnwallet.CommitWeight + lnwallet.HtlcWeight +
+		secondLevelWeight + int64(sweepWeight.Weight())
+
+	return feePerKw.FeeForWeight(totalWeight), nil
+}
+
+// isEconomical returns true if the value of the HTLC justifies the on-chain
+// fees of claiming it, given the second-level transaction weight it requires.
+// If the fees can't be estimated, we err on the side of going on-chain.
+func (c *ChannelArbitrator) isEconomical(htlc channeldb.HTLC,
+	secondLevelWeight int64) bool {
+
+	if c.cfg.ForceCloseUneconomical {
+		return true
+	}
+
+	claimCost, err := c.htlcClaimCost(secondLevelWeight)
+	if err != nil {
+		log.Warnf("ChannelArbitrator(%v): unable to estimate on-chain "+
+			"cost of htlc=%x: %v", c.cfg.ChanPoint, htlc.RHash[:],
+			err)
+		return true
+	}
+
+	minValue := btcutil.Amount(
+		float64(claimCost) * c.cfg.MinGoOnChainValueRatio,
+	)
+	if htlc.Amt.ToSatoshis() >= minValue {
+		return true
+	}
+
+	log.Debugf("ChannelArbitrator(%v): not going on-chain for "+
+		"uneconomical htlc=%x: value=%v, estimated claim cost=%v",
+		c.cfg.ChanPoint, htlc.RHash[:], htlc.Amt.ToSatoshis(),
+		claimCost)
+
+	return false
+}
+
+// skipGoOnChain records the set of HTLC's close to expiry that we decided not
+// to go on-chain for. Each of them is recorded only once. Outgoing HTLC's are
+// left in place rather than failed back, as the downstream peer may still
+// settle them off-chain, and are only resolved upstream once they've been
+// removed from the commitment transaction.
+func (c *ChannelArbitrator) skipGoOnChain(htlcs []channeldb.HTLC) {
+	for _, htlc := range htlcs {
+		skipped := c.skippedHTLCs.outgoingHTLCs
+		if htlc.Incoming {
+			skipped = c.skippedHTLCs.incomingHTLCs
+		}
+		if _, ok := skipped[htlc.HtlcIndex]; ok {
+			continue
+		}
+
+		log.Infof("ChannelArbitrator(%v): not going on-chain for "+
+			"uneconomical htlc=%x, incoming=%v", c.cfg.ChanPoint,
+			htlc.RHash[:], htlc.Incoming)
+
+		skipped[htlc.HtlcIndex] = htlc
+		c.cfg.RecordSkippedGoOnChain(htlc)
+	}
+}
+
+// pruneSkippedHTLCs removes the HTLC's that are no longer active from the set
+// of HTLC's we decided not to go on-chain for.
+func (c *ChannelArbitrator) pruneSkippedHTLCs() {
+	for htlcIndex := range c.skippedHTLCs.incomingHTLCs {
+		if _, ok := c.activeHTLCs.incomingHTLCs[htlcIndex]; !ok {
+			delete(c.skippedHTLCs.incomingHTLCs, htlcIndex)
+		}
+	}
+	for htlcIndex := range c.skippedHTLCs.outgoingHTLCs {
+		if _, ok := c.activeHTLCs.outgoingHTLCs[htlcIndex]; !ok {
+			delete(c.skippedHTLCs.outgoingHTLCs, htlcIndex)
+		}
+	}
+}
+
 // checkChainActions is called for each new block connected to the end of the
 // main chain. Given the new block height, this new method will examine all
 // active HTLC's, and determine if we need to go on-chain to claim any of them.
@@ -906,6 +1042,7 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 
 	// First, we'll make an initial pass over the set of incoming and
 	// outgoing HTLC's to decide if we need to go on chain at all.
+	var uneconomicalHTLCs []channeldb.HTLC
 	haveChainActions := false
 	for _, htlc := range c.activeHTLCs.outgoingHTLCs {
 		// If any of our HTLC's triggered an on-chain action, then we
@@ -916,9 +1053,20 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 
 		// We'll need to go on-chain for an outgoing HTLC if it was
 		// never resolved downstream, and it's "close" to timing out.
-		haveChainActions = haveChainActions || c.shouldGoOnChain(
+		if !c.shouldGoOnChain(
 			htlc.RefundTimeout, c.cfg.BroadcastDelta, height,
+		) {
+			continue
+		}
+
+		// However, we won't do so if the HTLC is worth less than the
+		// fees of timing it out on-chain.
+		haveChainActions = c.isEconomical(
+			htlc, lnwallet.HtlcTimeoutWeight,
 		)
+		if !haveChainActions {
+			uneconomicalHTLCs = append(uneconomicalHTLCs, htlc)
+		}
 	}
 	for _, htlc := range c.activeHTLCs.incomingHTLCs {
 		// If any of our HTLC's triggered an on-chain action, then we
@@ -934,9 +1082,16 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 		if _, ok := c.cfg.PreimageDB.LookupPreimage(htlc.RHash[:]); !ok {
 			continue
 		}
-		haveChainActions = haveChainActions || c.shouldGoOnChain(
-			htlc.RefundTimeout, redeemCutoff, height,
+		if !c.shouldGoOnChain(htlc.RefundTimeout, redeemCutoff, height) {
+			continue
+		}
+
+		haveChainActions = c.isEconomical(
+			htlc, lnwallet.HtlcSuccessWeight,
 		)
+		if !haveChainActions {
+			uneconomicalHTLCs = append(uneconomicalHTLCs, htlc)
+		}
 	}
 
 	// If we don't have any actions to make, then we'll return an empty
@@ -946,6 +1101,9 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 	if !haveChainActions && trigger == chainTrigger {
 		log.Tracef("ChannelArbitrator(%v): no actions to take at "+
 			"height=%v", c.cfg.ChanPoint, height)
+
+		c.skipGoOnChain(uneconomicalHTLCs)
+
 		return actionMap
 	}
 
@@ -1093,9 +1251,14 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 	}
 
 	// We'll create the resolver kit that we'll be cloning for each
-	// resolver so they each can do their duty.
+	// resolver so they each can do their duty. Any outputs or HTLC
+	// resolutions they hand off are routed through the arbitrator, so
+	// they won't be handed off again if the contracts are rolled back.
+	resCfg := c.cfg
+	resCfg.IncubateOutputs = c.incubateOutputs
+	resCfg.DeliverResolutionMsg = c.deliverResolutionMsg
 	resKit := ResolverKit{
-		ChannelArbitratorConfig: c.cfg,
+		ChannelArbitratorConfig: resCfg,
 		Checkpoint: func(res ContractResolver) error {
 			return c.log.InsertUnresolvedContracts(res)
 		},
@@ -1174,6 +1337,7 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 					htlcResolution:  resolution,
 					broadcastHeight: height,
 					htlcIndex:       htlc.HtlcIndex,
+					payHash:         htlc.RHash,
 					ResolverKit:     resKit,
 				}
 				htlcResolvers = append(htlcResolvers, resolver)
@@ -1237,6 +1401,7 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 						htlcResolution:  resolution,
 						broadcastHeight: height,
 						htlcIndex:       htlc.HtlcIndex,
+						payHash:         htlc.RHash,
 						ResolverKit:     resKit,
 					},
 				}
@@ -1263,6 +1428,154 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 	return htlcResolvers, msgsToSend, nil
 }
 
+// launchResolvers updates the activeResolvers list and starts the resolvers.
+func (c *ChannelArbitrator) launchResolvers(resolvers []ContractResolver) {
+	c.activeResolversLock.Lock()
+	defer c.activeResolversLock.Unlock()
+
+	c.activeResolvers = resolvers
+
+	quit := make(chan struct{})
+	c.resolversQuit = quit
+
+	for _, contract := range resolvers {
+		c.wg.Add(1)
+		c.resolversWg.Add(1)
+		go c.resolveContract(contract, quit)
+	}
+}
+
+// rollbackContracts is called once the commitment transaction the contract
+// resolvers were launched for has been reorged out of the chain. All active
+// resolvers will be stopped, and both they and the contract resolutions are
+// removed from the log. The arbitrator is then moved back into the
+// StateCommitmentBroadcasted state, where it'll wait for the close to be
+// dispatched again once a commitment transaction confirms within the new
+// chain.
+//
+// NOTE: Any outputs already sent to the nursery for incubation, and any HTLC
+// resolutions already sent back to the switch, can't be rolled back. Instead,
+// they're remembered so the contracts launched for the new chain won't hand
+// them off a second time.
+func (c *ChannelArbitrator) rollbackContracts() error {
+	switch c.state {
+	case StateContractClosed, StateWaitingFullResolution:
+	default:
+		log.Infof("ChannelArbitrator(%v): nothing to roll back in "+
+			"state=%v", c.cfg.ChanPoint, c.state)
+		return nil
+	}
+
+	c.activeResolversLock.Lock()
+	resolvers := c.activeResolvers
+	c.activeResolvers = nil
+	if c.resolversQuit != nil {
+		close(c.resolversQuit)
+		c.resolversQuit = nil
+	}
+	c.activeResolversLock.Unlock()
+
+	// We'll stop all of the active resolvers, and wait for the goroutines
+	// resolving them to exit before modifying the log.
+	for _, resolver := range resolvers {
+		resolver.Stop()
+	}
+	c.resolversWg.Wait()
+
+	log.Infof("ChannelArbitrator(%v): rolled back %v contract resolvers",
+		c.cfg.ChanPoint, len(resolvers))
+
+	err := c.log.RollbackContracts(StateCommitmentBroadcasted)
+	if err != nil {
+		return err
+	}
+	c.state = StateCommitmentBroadcasted
+
+	return nil
+}
+
+// incubateOutputs sends the passed outputs to the nursery for incubation,
+// unless all of them have already been sent to it before.
+func (c *ChannelArbitrator) incubateOutputs(chanPoint wire.OutPoint,
+	commitRes *lnwallet.CommitOutputResolution,
+	outgoingRes *lnwallet.OutgoingHtlcResolution,
+	incomingRes *lnwallet.IncomingHtlcResolution,
+	broadcastHeight uint32) error {
+
+	var outpoints []wire.OutPoint
+	if commitRes != nil {
+		outpoints = append(outpoints, commitRes.SelfOutPoint)
+	}
+	if outgoingRes != nil {
+		outpoints = append(outpoints, outgoingRes.ClaimOutpoint)
+	}
+	if incomingRes != nil {
+		outpoints = append(outpoints, incomingRes.ClaimOutpoint)
+	}
+
+	c.handoffMtx.Lock()
+	defer c.handoffMtx.Unlock()
+
+	incubated := len(outpoints) != 0
+	for _, op := range outpoints {
+		if _, ok := c.incubatedOutputs[op]; !ok {
+			incubated = false
+			break
+		}
+	}
+	if incubated {
+		log.Infof("ChannelArbitrator(%v): outputs %v already sent for "+
+			"incubation", c.cfg.ChanPoint, outpoints)
+		return nil
+	}
+
+	err := c.cfg.IncubateOutputs(
+		chanPoint, commitRes, outgoingRes, incomingRes,
+		broadcastHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, op := range outpoints {
+		c.incubatedOutputs[op] = struct{}{}
+	}
+
+	return nil
+}
+
+// deliverResolutionMsg delivers the passed resolution messages to the switch,
+// skipping those for HTLCs we've already delivered a resolution for.
+func (c *ChannelArbitrator) deliverResolutionMsg(msgs ...ResolutionMsg) error {
+	c.handoffMtx.Lock()
+	defer c.handoffMtx.Unlock()
+
+	var newMsgs []ResolutionMsg
+	for _, msg := range msgs {
+		if _, ok := c.resolvedHTLCs[msg.HtlcIndex]; ok {
+			log.Infof("ChannelArbitrator(%v): resolution for "+
+				"htlc_index=%v already delivered",
+				c.cfg.ChanPoint, msg.HtlcIndex)
+			continue
+		}
+
+		newMsgs = append(newMsgs, msg)
+	}
+	if len(newMsgs) == 0 && len(msgs) != 0 {
+		return nil
+	}
+
+	if err := c.cfg.DeliverResolutionMsg(newMsgs...); err != nil {
+		return err
+	}
+
+	for _, msg := range newMsgs {
+		c.resolvedHTLCs[msg.HtlcIndex] = struct{}{}
+	}
+
+	return nil
+}
+
 // resolveContract is a goroutine tasked with fully resolving an unresolved
 // contract. Either the initial contract will be resolved after a single step,
 // or the contract will itself create another contract to be resolved. In
@@ -1271,8 +1584,11 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 // contracts.
 //
 // NOTE: This MUST be run as a goroutine.
-func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
+func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver,
+	quit <-chan struct{}) {
+
 	defer c.wg.Done()
+	defer c.resolversWg.Done()
 
 	log.Debugf("ChannelArbitrator(%v): attempting to resolve %T",
 		c.cfg.ChanPoint, currentContract)
@@ -1289,6 +1605,11 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 		case <-c.quit:
 			return
 
+		// If the resolvers are being rolled back, then we'll exit
+		// early as well.
+		case <-quit:
+			return
+
 		default:
 			// Otherwise, we'll attempt to resolve the current
 			// contract.
@@ -1323,6 +1644,9 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 				// As this contract produced another, we'll
 				// re-assign, so we can continue our resolution
 				// loop.
+				c.replaceActiveResolver(
+					currentContract, nextContract,
+				)
 				currentContract = nextContract
 
 			// If this contract is actually fully resolved, then
@@ -1337,11 +1661,14 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 					log.Errorf("unable to resolve contract: %v",
 						err)
 				}
+				c.replaceActiveResolver(currentContract, nil)
 
 				// Now that the contract has been resolved,
 				// well signal to the main goroutine.
 				select {
 				case c.resolutionSignal <- struct{}{}:
+				case <-quit:
+					return
 				case <-c.quit:
 					return
 				}
@@ -1351,6 +1678,46 @@ func (c *ChannelArbitrator) resolveContract(currentContract ContractResolver) {
 	}
 }
 
+// replaceActiveResolver swaps out the passed active resolver for the next one
+// it produced. If next is nil, the resolver is fully resolved and is removed
+// from the set of active resolvers instead.
+func (c *ChannelArbitrator) replaceActiveResolver(prev,
+	next ContractResolver) {
+
+	c.activeResolversLock.Lock()
+	defer c.activeResolversLock.Unlock()
+
+	for i, resolver := range c.activeResolvers {
+		if resolver != prev {
+			continue
+		}
+
+		if next != nil {
+			c.activeResolvers[i] = next
+			return
+		}
+
+		c.activeResolvers = append(
+			c.activeResolvers[:i], c.activeResolvers[i+1:]...,
+		)
+		return
+	}
+}
+
+// Report returns a report on the resolution progress of each contract
+// resolver of the channel that hasn't been fully resolved yet.
+func (c *ChannelArbitrator) Report() []*ContractReport {
+	c.activeResolversLock.RLock()
+	defer c.activeResolversLock.RUnlock()
+
+	reports := make([]*ContractReport, 0, len(c.activeResolvers))
+	for _, resolver := range c.activeResolvers {
+		reports = append(reports, resolver.Report())
+	}
+
+	return reports
+}
+
 // signalUpdateMsg is a struct that carries fresh signals to the
 // ChannelArbitrator. We need to receive a message like this each time the
 // channel becomes active, as it's internal state may change.
@@ -1464,6 +1831,10 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			// current commitment state.
 			c.activeHTLCs = newHtlcSet(newStateHTLCs)
 
+			// We'll also forget about any skipped HTLC's that
+			// are no longer active.
+			c.pruneSkippedHTLCs()
+
 			log.Tracef("ChannelArbitrator(%v): fresh set of "+
 				"htlcs=%v", c.cfg.ChanPoint,
 				newLogClosure(func() string {
@@ -1617,6 +1988,21 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 				log.Errorf("unable to advance state: %v", err)
 			}
 
+		// The commitment transaction that closed the channel has been
+		// reorged out of the chain. We'll roll back the contracts
+		// launched for it, and wait for the close to be dispatched
+		// again once a spend of the funding output confirms within
+		// the new chain.
+		case <-c.cfg.ChainEvents.CloseReorg:
+			log.Warnf("ChannelArbitrator(%v): channel close "+
+				"reorged out of the chain", c.cfg.ChanPoint)
+
+			if err := c.rollbackContracts(); err != nil {
+				log.Errorf("unable to roll back contracts: %v",
+					err)
+				return
+			}
+
 		// A new contract has just been resolved, we'll now check our
 		// log to see if all contracts have been resolved. If so, then
 		// we can exit as the contract is fully resolved.
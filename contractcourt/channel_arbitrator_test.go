@@ -2,12 +2,15 @@ package contractcourt
 
 import (
 	"fmt"
+	"reflect"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
@@ -117,6 +120,15 @@ func (b *mockArbitratorLog) FetchChainActions() (ChainActionMap, error) {
 	return actionsMap, nil
 }
 
+func (b *mockArbitratorLog) RollbackContracts(s ArbitratorState) error {
+	b.Lock()
+	b.resolvers = make(map[ContractResolver]struct{})
+	b.resolutions = nil
+	b.Unlock()
+
+	return b.CommitState(s)
+}
+
 func (b *mockArbitratorLog) WipeHistory() error {
 	return nil
 }
@@ -153,6 +165,7 @@ func createTestChannelArbitrator(log ArbitratorLog) (*ChannelArbitrator,
 		LocalUnilateralClosure:  make(chan *LocalUnilateralCloseInfo, 1),
 		CooperativeClosure:      make(chan *CooperativeCloseInfo, 1),
 		ContractBreach:          make(chan *lnwallet.BreachRetribution, 1),
+		CloseReorg:              make(chan struct{}, 1),
 	}
 
 	chainIO := &mockChainIO{}
@@ -165,6 +178,11 @@ func createTestChannelArbitrator(log ArbitratorLog) (*ChannelArbitrator,
 			return nil
 		},
 		BroadcastDelta: 5,
+		FeeEstimator: lnwallet.StaticFeeEstimator{
+			FeePerKW: 250,
+		},
+		GoOnChainConfTarget:    6,
+		MinGoOnChainValueRatio: 1,
 		Notifier: &mockNotifier{
 			epochChan: make(chan *chainntnfs.BlockEpoch),
 			spendChan: make(chan *chainntnfs.SpendDetail),
@@ -204,9 +222,13 @@ func createTestChannelArbitrator(log ArbitratorLog) (*ChannelArbitrator,
 		MarkChannelClosed: func(*channeldb.ChannelCloseSummary) error {
 			return nil
 		},
-		IsPendingClose:        false,
-		ChainArbitratorConfig: chainArbCfg,
-		ChainEvents:           chanEvents,
+		PutResolverReport: func(*channeldb.ResolverReport) error {
+			return nil
+		},
+		RecordSkippedGoOnChain: func(channeldb.HTLC) {},
+		IsPendingClose:         false,
+		ChainArbitratorConfig:  chainArbCfg,
+		ChainEvents:            chanEvents,
 	}
 
 	return NewChannelArbitrator(arbCfg, nil, log), resolvedChan, nil
@@ -293,6 +315,33 @@ func assertStateTransitions(t *testing.T, newStates <-chan ArbitratorState,
 	}
 }
 
+// assertContractReports asserts that the channel arbitrator eventually reports
+// the expected resolution progress of its contract resolvers.
+func assertContractReports(t *testing.T, chanArb *ChannelArbitrator,
+	expectedReports ...*ContractReport) {
+	t.Helper()
+
+	if expectedReports == nil {
+		expectedReports = []*ContractReport{}
+	}
+
+	var reports []*ContractReport
+	timeout := time.After(5 * time.Second)
+	for {
+		reports = chanArb.Report()
+		if reflect.DeepEqual(reports, expectedReports) {
+			return
+		}
+
+		select {
+		case <-time.After(50 * time.Millisecond):
+		case <-timeout:
+			t.Fatalf("expected reports %v, got %v",
+				spew.Sdump(expectedReports), spew.Sdump(reports))
+		}
+	}
+}
+
 // TestChannelArbitratorRemoteForceClose checks that the ChannelArbitrator goes
 // through the expected states if a remote force close is observed in the
 // chain.
@@ -473,6 +522,16 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 		return nil
 	}
 
+	// We'll use the reportChan to capture the final resolution report of
+	// the HTLC.
+	reportChan := make(chan *channeldb.ResolverReport, 1)
+	chanArb.cfg.PutResolverReport = func(
+		report *channeldb.ResolverReport) error {
+
+		reportChan <- report
+		return nil
+	}
+
 	if err := chanArb.Start(); err != nil {
 		t.Fatalf("unable to start ChannelArbitrator: %v", err)
 	}
@@ -538,9 +597,12 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	// Set up the outgoing resolution. Populate SignedTimeoutTx because
 	// our commitment transaction got confirmed.
 	outgoingRes := lnwallet.OutgoingHtlcResolution{
-		Expiry: 10,
+		Expiry:   10,
+		CsvDelay: 5,
 		SweepSignDesc: lnwallet.SignDescriptor{
-			Output: &wire.TxOut{},
+			Output: &wire.TxOut{
+				Value: 9000,
+			},
 		},
 		SignedTimeoutTx: &wire.MsgTx{
 			TxIn: []*wire.TxIn{
@@ -579,6 +641,15 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	default:
 	}
 
+	report := &ContractReport{
+		Type:         ResolverTypeHtlcOutgoingContest,
+		Outpoint:     htlcOp,
+		Amount:       9000,
+		Stage:        ResolverStageWaitingExpiry,
+		ExpiryHeight: 10,
+	}
+	assertContractReports(t, chanArb, report)
+
 	// Send a notification that the expiry height has been reached.
 	notifier := chanArb.cfg.Notifier.(*mockNotifier)
 	notifier.epochChan <- &chainntnfs.BlockEpoch{Height: 10}
@@ -591,8 +662,19 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 		t.Fatalf("no response received")
 	}
 
+	// The outgoing contest resolver should have been swapped out for the
+	// timeout resolver.
+	report.Type = ResolverTypeHtlcTimeout
+	assertContractReports(t, chanArb, report)
+
 	// Notify resolver that output of the commitment has been spent.
-	notifier.confChan <- &chainntnfs.TxConfirmation{}
+	notifier.confChan <- &chainntnfs.TxConfirmation{BlockHeight: 11}
+
+	// The output of the second level transaction is now locked by its CSV
+	// delay.
+	report.Stage = ResolverStageCsvDelay
+	report.MaturityHeight = 16
+	assertContractReports(t, chanArb, report)
 
 	// As this is our own commitment transaction, the HTLC will go through
 	// to the second level. Channel arbitrator should still not be marked as
@@ -603,8 +685,49 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	default:
 	}
 
-	// Notify resolver that the second level transaction is spent.
-	notifier.spendChan <- &chainntnfs.SpendDetail{}
+	// Notify resolver that the second level transaction is spent by a
+	// sweep transaction paying a fee of 1000 satoshis.
+	sweepTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: wire.OutPoint{
+					Hash: outgoingRes.SignedTimeoutTx.TxHash(),
+				},
+			},
+		},
+		TxOut: []*wire.TxOut{
+			{
+				Value: 8000,
+			},
+		},
+	}
+	sweepTxid := sweepTx.TxHash()
+	notifier.spendChan <- &chainntnfs.SpendDetail{
+		SpendingTx:    sweepTx,
+		SpenderTxHash: &sweepTxid,
+	}
+
+	// The resolver should persist the final report of the HTLC.
+	secondLevelTxid := outgoingRes.SignedTimeoutTx.TxHash()
+	expectedReport := &channeldb.ResolverReport{
+		OutPoint:        htlcOp,
+		OutputType:      channeldb.ResolverOutputOutgoingHtlc,
+		Amount:          9000,
+		Outcome:         channeldb.ResolverOutcomeTimeout,
+		SecondLevelTxid: &secondLevelTxid,
+		SweepTxid:       &sweepTxid,
+		SweepFee:        1000,
+	}
+	select {
+	case resolverReport := <-reportChan:
+		if !reflect.DeepEqual(resolverReport, expectedReport) {
+			t.Fatalf("expected report %v, got %v",
+				spew.Sdump(expectedReport),
+				spew.Sdump(resolverReport))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no resolver report received")
+	}
 
 	// At this point channel should be marked as resolved.
 
@@ -617,6 +740,292 @@ func TestChannelArbitratorLocalForceClosePendingHtlc(t *testing.T) {
 	case <-time.After(5 * time.Second):
 		t.Fatalf("contract was not resolved")
 	}
+
+	// As the contract is fully resolved, there's nothing left to report.
+	assertContractReports(t, chanArb)
+}
+
+// TestChannelArbitratorCloseReorg tests that the ChannelArbitrator rolls back
+// its contract resolvers once the confirmed commitment transaction is reorged
+// out of the chain, and launches a fresh set of resolvers once the close is
+// dispatched again within the new chain.
+func TestChannelArbitratorCloseReorg(t *testing.T) {
+	// We'll start out having already broadcast our commitment, which
+	// carries an outgoing HTLC that we'll need to watch on-chain.
+	htlc := channeldb.HTLC{
+		Incoming:  false,
+		Amt:       10000,
+		HtlcIndex: 0,
+	}
+	arbLog := &mockArbitratorLog{
+		state:     StateCommitmentBroadcasted,
+		newStates: make(chan ArbitratorState, 5),
+		resolvers: make(map[ContractResolver]struct{}),
+		chainActions: ChainActionMap{
+			HtlcOutgoingWatchAction: []channeldb.HTLC{htlc},
+		},
+	}
+
+	chanArb, resolved, err := createTestChannelArbitrator(arbLog)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	// As we've already broadcast our commitment, we'll now be waiting for
+	// a commitment to confirm.
+	assertState(t, chanArb, StateCommitmentBroadcasted)
+
+	// sendLocalClose notifies the arbitrator about the confirmation of the
+	// passed commitment transaction, which carries a single outgoing HTLC,
+	// and returns the report of the resolver we expect to be launched for
+	// it.
+	sendLocalClose := func(closeTx *wire.MsgTx) *ContractReport {
+		htlcOp := wire.OutPoint{
+			Hash:  closeTx.TxHash(),
+			Index: 0,
+		}
+		outgoingRes := lnwallet.OutgoingHtlcResolution{
+			Expiry:   10,
+			CsvDelay: 5,
+			SweepSignDesc: lnwallet.SignDescriptor{
+				Output: &wire.TxOut{
+					Value: 9000,
+				},
+			},
+			SignedTimeoutTx: &wire.MsgTx{
+				TxIn: []*wire.TxIn{
+					{
+						PreviousOutPoint: htlcOp,
+						Witness:          [][]byte{{}},
+					},
+				},
+				TxOut: []*wire.TxOut{
+					{},
+				},
+			},
+		}
+
+		chanArb.cfg.ChainEvents.LocalUnilateralClosure <- &LocalUnilateralCloseInfo{
+			&chainntnfs.SpendDetail{},
+			&lnwallet.LocalForceCloseSummary{
+				CloseTx: closeTx,
+				HtlcResolutions: &lnwallet.HtlcResolutions{
+					OutgoingHTLCs: []lnwallet.OutgoingHtlcResolution{
+						outgoingRes,
+					},
+				},
+			},
+			&channeldb.ChannelCloseSummary{},
+		}
+
+		return &ContractReport{
+			Type:         ResolverTypeHtlcOutgoingContest,
+			Outpoint:     htlcOp,
+			Amount:       9000,
+			Stage:        ResolverStageWaitingExpiry,
+			ExpiryHeight: 10,
+		}
+	}
+
+	// Notify the arbitrator about our commitment transaction confirming.
+	// It should launch a resolver for the outgoing HTLC, which will wait
+	// for the HTLC to expire.
+	closeTx := &wire.MsgTx{}
+	report := sendLocalClose(closeTx)
+	assertStateTransitions(t, arbLog.newStates, StateContractClosed,
+		StateWaitingFullResolution)
+	assertContractReports(t, chanArb, report)
+
+	// Now we'll simulate the commitment transaction being reorged out of
+	// the chain. The arbitrator should stop the resolver, remove it along
+	// with the contract resolutions from its log, and go back to waiting
+	// for a commitment to confirm.
+	chanArb.cfg.ChainEvents.CloseReorg <- struct{}{}
+	assertStateTransitions(
+		t, arbLog.newStates, StateCommitmentBroadcasted,
+	)
+	assertContractReports(t, chanArb)
+
+	unresolved, err := arbLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch unresolved contracts: %v", err)
+	}
+	if len(unresolved) != 0 {
+		t.Fatalf("expected no unresolved contracts, got %v",
+			len(unresolved))
+	}
+	res, err := arbLog.FetchContractResolutions()
+	if err != nil {
+		t.Fatalf("unable to fetch contract resolutions: %v", err)
+	}
+	if res != nil {
+		t.Fatalf("expected contract resolutions to be rolled back")
+	}
+
+	// Within the new chain, a different version of our commitment
+	// transaction confirms. The arbitrator should now launch a fresh
+	// resolver for the HTLC on this commitment.
+	reorgCloseTx := &wire.MsgTx{LockTime: 1}
+	report = sendLocalClose(reorgCloseTx)
+	assertStateTransitions(t, arbLog.newStates, StateContractClosed,
+		StateWaitingFullResolution)
+	assertContractReports(t, chanArb, report)
+
+	unresolved, err = arbLog.FetchUnresolvedContracts()
+	if err != nil {
+		t.Fatalf("unable to fetch unresolved contracts: %v", err)
+	}
+	if len(unresolved) != 1 {
+		t.Fatalf("expected one unresolved contract, got %v",
+			len(unresolved))
+	}
+
+	res, err = arbLog.FetchContractResolutions()
+	if err != nil {
+		t.Fatalf("unable to fetch contract resolutions: %v", err)
+	}
+	if res.CommitHash != reorgCloseTx.TxHash() {
+		t.Fatalf("expected resolutions for commitment %v, got %v",
+			reorgCloseTx.TxHash(), res.CommitHash)
+	}
+
+	// The channel shouldn't have been marked as resolved at any point.
+	select {
+	case <-resolved:
+		t.Fatalf("channel resolved prematurely")
+	default:
+	}
+}
+
+// TestChannelArbitratorCloseReorgHandoff tests that once a close is
+// dispatched again after having been reorged out of the chain, the
+// ChannelArbitrator doesn't send the outputs or HTLC resolutions it already
+// handed off for the reorged out close to the nursery or the switch a second
+// time.
+func TestChannelArbitratorCloseReorgHandoff(t *testing.T) {
+	// We'll start out having already broadcast our commitment, which
+	// carries an outgoing HTLC we can fail back immediately.
+	htlc := channeldb.HTLC{
+		Incoming:  false,
+		Amt:       10000,
+		HtlcIndex: 2,
+	}
+	arbLog := &mockArbitratorLog{
+		state:     StateCommitmentBroadcasted,
+		newStates: make(chan ArbitratorState, 5),
+		resolvers: make(map[ContractResolver]struct{}),
+		chainActions: ChainActionMap{
+			HtlcFailNowAction: []channeldb.HTLC{htlc},
+		},
+	}
+
+	chanArb, _, err := createTestChannelArbitrator(arbLog)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	incubateChan := make(chan wire.OutPoint, 2)
+	chanArb.cfg.IncubateOutputs = func(_ wire.OutPoint,
+		commitRes *lnwallet.CommitOutputResolution,
+		_ *lnwallet.OutgoingHtlcResolution,
+		_ *lnwallet.IncomingHtlcResolution, _ uint32) error {
+
+		incubateChan <- commitRes.SelfOutPoint
+		return nil
+	}
+
+	resolutionChan := make(chan ResolutionMsg, 2)
+	chanArb.cfg.DeliverResolutionMsg = func(msgs ...ResolutionMsg) error {
+		for _, msg := range msgs {
+			resolutionChan <- msg
+		}
+		return nil
+	}
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	// sendLocalClose notifies the arbitrator about the confirmation of our
+	// commitment transaction, which carries a time locked output to
+	// ourselves.
+	closeTx := &wire.MsgTx{}
+	commitRes := &lnwallet.CommitOutputResolution{
+		SelfOutPoint: wire.OutPoint{
+			Hash:  closeTx.TxHash(),
+			Index: 1,
+		},
+		SelfOutputSignDesc: lnwallet.SignDescriptor{
+			Output: &wire.TxOut{
+				Value: 5000,
+			},
+		},
+		MaturityDelay: 5,
+	}
+	sendLocalClose := func() {
+		chanArb.cfg.ChainEvents.LocalUnilateralClosure <- &LocalUnilateralCloseInfo{
+			&chainntnfs.SpendDetail{},
+			&lnwallet.LocalForceCloseSummary{
+				CloseTx:          closeTx,
+				CommitResolution: commitRes,
+				HtlcResolutions:  &lnwallet.HtlcResolutions{},
+			},
+			&channeldb.ChannelCloseSummary{},
+		}
+	}
+
+	// Once our commitment confirms, the arbitrator should send our output
+	// to the nursery, and fail the HTLC back.
+	sendLocalClose()
+	assertStateTransitions(t, arbLog.newStates, StateContractClosed,
+		StateWaitingFullResolution)
+
+	select {
+	case op := <-incubateChan:
+		if op != commitRes.SelfOutPoint {
+			t.Fatalf("expected output %v to be incubated, got %v",
+				commitRes.SelfOutPoint, op)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("commitment output not incubated")
+	}
+
+	select {
+	case msg := <-resolutionChan:
+		if msg.HtlcIndex != htlc.HtlcIndex {
+			t.Fatalf("expected resolution for htlc %v, got %v",
+				htlc.HtlcIndex, msg.HtlcIndex)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("htlc not failed back")
+	}
+
+	// We'll now reorg the commitment out of the chain, and have it confirm
+	// again within the new chain.
+	chanArb.cfg.ChainEvents.CloseReorg <- struct{}{}
+	assertStateTransitions(
+		t, arbLog.newStates, StateCommitmentBroadcasted,
+	)
+
+	sendLocalClose()
+	assertStateTransitions(t, arbLog.newStates, StateContractClosed,
+		StateWaitingFullResolution)
+
+	// As the output and the HTLC have already been handed off, neither
+	// the nursery nor the switch should hear about them again.
+	select {
+	case op := <-incubateChan:
+		t.Fatalf("output %v incubated a second time", op)
+	case msg := <-resolutionChan:
+		t.Fatalf("htlc %v failed back a second time", msg.HtlcIndex)
+	case <-time.After(100 * time.Millisecond):
+	}
 }
 
 // TestChannelArbitratorLocalForceCloseRemoteConfiremd tests that the
@@ -1143,3 +1552,120 @@ func TestChannelArbitratorEmptyResolutions(t *testing.T) {
 	}
 	chanArb.Stop()
 }
+
+// TestChannelArbitratorUneconomicalHtlc tests that the ChannelArbitrator only
+// goes on-chain for an outgoing HTLC close to expiry if its value justifies
+// the on-chain fees of timing it out, unless configured to always do so.
+func TestChannelArbitratorUneconomicalHtlc(t *testing.T) {
+	t.Parallel()
+
+	const (
+		expiry = 100
+		height = expiry - 5
+	)
+
+	testCases := []struct {
+		name          string
+		amt           btcutil.Amount
+		forceClose    bool
+		goOnChain     bool
+		expectSkipped bool
+	}{
+		{
+			name:      "economical",
+			amt:       100000,
+			goOnChain: true,
+		},
+		{
+			name:          "uneconomical",
+			amt:           100,
+			goOnChain:     false,
+			expectSkipped: true,
+		},
+		{
+			name:       "uneconomical force close",
+			amt:        100,
+			forceClose: true,
+			goOnChain:  true,
+		},
+	}
+
+	for _, test := range testCases {
+		log := &mockArbitratorLog{
+			state:     StateDefault,
+			newStates: make(chan ArbitratorState, 5),
+		}
+		chanArb, _, err := createTestChannelArbitrator(log)
+		if err != nil {
+			t.Fatalf("%v: unable to create ChannelArbitrator: %v",
+				test.name, err)
+		}
+
+		var (
+			skipped  []channeldb.HTLC
+			resolved []ResolutionMsg
+		)
+		chanArb.cfg.RecordSkippedGoOnChain = func(htlc channeldb.HTLC) {
+			skipped = append(skipped, htlc)
+		}
+		chanArb.cfg.DeliverResolutionMsg = func(
+			msgs ...ResolutionMsg) error {
+
+			resolved = append(resolved, msgs...)
+			return nil
+		}
+		chanArb.cfg.ForceCloseUneconomical = test.forceClose
+
+		htlc := channeldb.HTLC{
+			Amt:           lnwire.NewMSatFromSatoshis(test.amt),
+			RefundTimeout: expiry,
+			HtlcIndex:     3,
+			OutputIndex:   1,
+		}
+		chanArb.activeHTLCs = newHtlcSet([]channeldb.HTLC{htlc})
+
+		// We'll check the chain actions over several blocks, as a
+		// skipped HTLC should only be recorded once.
+		for i := uint32(0); i < 3; i++ {
+			actions := chanArb.checkChainActions(
+				height+i, chainTrigger,
+			)
+			timeouts := actions[HtlcTimeoutAction]
+			switch {
+			case test.goOnChain && len(timeouts) != 1:
+				t.Fatalf("%v: expected to time out htlc "+
+					"on-chain, got actions %v", test.name,
+					spew.Sdump(actions))
+
+			case !test.goOnChain && len(actions) != 0:
+				t.Fatalf("%v: expected no chain actions, "+
+					"got %v", test.name, spew.Sdump(actions))
+			}
+		}
+
+		if test.expectSkipped != (len(skipped) == 1) {
+			t.Fatalf("%v: expected skipped=%v, got %v", test.name,
+				test.expectSkipped, spew.Sdump(skipped))
+		}
+		if !test.expectSkipped && len(skipped) != 0 {
+			t.Fatalf("%v: expected no skipped htlcs, got %v",
+				test.name, spew.Sdump(skipped))
+		}
+
+		// A skipped outgoing HTLC may still be settled downstream, so
+		// it shouldn't have been failed back.
+		if len(resolved) != 0 {
+			t.Fatalf("%v: expected no resolutions, got %v",
+				test.name, spew.Sdump(resolved))
+		}
+
+		// Once the HTLC is removed from the commitment, it should no
+		// longer be tracked as skipped.
+		chanArb.activeHTLCs = newHtlcSet(nil)
+		chanArb.pruneSkippedHTLCs()
+		if len(chanArb.skippedHTLCs.outgoingHTLCs) != 0 {
+			t.Fatalf("%v: expected skipped htlcs to be pruned",
+				test.name)
+		}
+	}
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/lightningnetwork/lnd/sweep"
 
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -21,12 +23,6 @@ var (
 	endian = binary.BigEndian
 )
 
-const (
-	// sweepConfTarget is the default number of blocks that we'll use as a
-	// confirmation target when sweeping.
-	sweepConfTarget = 6
-)
-
 // ContractResolver is an interface which packages a state machine which is
 // able to carry out the necessary steps required to fully resolve a Bitcoin
 // contract on-chain. Resolvers are fully encodable to ensure callers are able
@@ -67,6 +63,10 @@ type ContractResolver interface {
 	// kit that resolvers need to complete their duty.
 	AttachResolverKit(ResolverKit)
 
+	// Report returns a snapshot of the resolver's current resolution
+	// progress. It is safe to call concurrently with Resolve.
+	Report() *ContractReport
+
 	// Stop signals the resolver to cancel any current resolution
 	// processes, and suspend.
 	Stop()
@@ -118,6 +118,14 @@ type htlcTimeoutResolver struct {
 	// additional commitment state machine.
 	htlcIndex uint64
 
+	// payHash is the payment hash of the HTLC we offered. It's used to
+	// validate the preimages revealed by unconfirmed spends of the HTLC
+	// output. It's blank for resolvers stored before it was introduced.
+	payHash [32]byte
+
+	// progress tracks the resolution progress of the HTLC output.
+	progress resolverProgress
+
 	ResolverKit
 }
 
@@ -126,19 +134,19 @@ type htlcTimeoutResolver struct {
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcTimeoutResolver) ResolverKey() []byte {
-	// The primary key for this resolver will be the outpoint of the HTLC
-	// on the commitment transaction itself. If this is our commitment,
-	// then the output can be found within the signed timeout tx,
-	// otherwise, it's just the ClaimOutpoint.
-	var op wire.OutPoint
+	key := newResolverID(h.commitOutpoint())
+	return key[:]
+}
+
+// commitOutpoint returns the outpoint of the HTLC on the commitment
+// transaction itself. If this is our commitment, then the output can be found
+// within the signed timeout tx, otherwise, it's just the ClaimOutpoint.
+func (h *htlcTimeoutResolver) commitOutpoint() wire.OutPoint {
 	if h.htlcResolution.SignedTimeoutTx != nil {
-		op = h.htlcResolution.SignedTimeoutTx.TxIn[0].PreviousOutPoint
-	} else {
-		op = h.htlcResolution.ClaimOutpoint
+		return h.htlcResolution.SignedTimeoutTx.TxIn[0].PreviousOutPoint
 	}
 
-	key := newResolverID(op)
-	return key[:]
+	return h.htlcResolution.ClaimOutpoint
 }
 
 // Resolve kicks off full resolution of an outgoing HTLC output. If it's our
@@ -178,6 +186,7 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 	// waitForOutputResolution waits for the HTLC output to be fully
 	// resolved. The output is considered fully resolved once it has been
 	// spent, and the spending transaction has been fully confirmed.
+	var sweepTx *wire.MsgTx
 	waitForOutputResolution := func() error {
 		// We first need to register to see when the HTLC output itself
 		// has been spent by a confirmed transaction.
@@ -191,11 +200,16 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		}
 
 		select {
-		case _, ok := <-spendNtfn.Spend:
+		case spend, ok := <-spendNtfn.Spend:
 			if !ok {
 				return fmt.Errorf("notifier quit")
 			}
 
+			h.progress.advance(
+				ResolverStageSwept, 0, spend.SpendingTx,
+			)
+			sweepTx = spend.SpendingTx
+
 		case <-h.Quit:
 			return fmt.Errorf("quitting")
 		}
@@ -236,11 +250,18 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 			secondLevelTXID)
 
 		select {
-		case _, ok := <-confNtfn.Confirmed:
+		case conf, ok := <-confNtfn.Confirmed:
 			if !ok {
 				return nil, fmt.Errorf("quitting")
 			}
 
+			// The output of the second-level transaction is now
+			// locked by its CSV delay.
+			h.progress.advance(
+				ResolverStageCsvDelay,
+				conf.BlockHeight+h.htlcResolution.CsvDelay, nil,
+			)
+
 		case <-h.Quit:
 			return nil, fmt.Errorf("quitting")
 		}
@@ -277,8 +298,16 @@ func (h *htlcTimeoutResolver) Resolve() (ContractResolver, error) {
 		}
 	}
 
-	// With the clean up message sent, we'll now mark the contract
-	// resolved, and wait.
+	// With the clean up message sent, we'll persist the final report of
+	// the HTLC, then mark the contract resolved, and wait.
+	report := h.finalReport(
+		channeldb.ResolverOutcomeTimeout,
+		h.htlcResolution.SignedTimeoutTx, sweepTx,
+	)
+	if err := h.PutResolverReport(report); err != nil {
+		return nil, err
+	}
+
 	h.resolved = true
 	return nil, h.Checkpoint(h)
 }
@@ -299,6 +328,34 @@ func (h *htlcTimeoutResolver) IsResolved() bool {
 	return h.resolved
 }
 
+// Report returns a snapshot of the resolver's current resolution progress.
+//
+// NOTE: Part of the ContractResolver interface.
+func (h *htlcTimeoutResolver) Report() *ContractReport {
+	report := &ContractReport{
+		Type:     ResolverTypeHtlcTimeout,
+		Outpoint: h.commitOutpoint(),
+		Amount: btcutil.Amount(
+			h.htlcResolution.SweepSignDesc.Output.Value,
+		),
+		ExpiryHeight: h.htlcResolution.Expiry,
+	}
+	h.progress.populate(report, ResolverStageWaitingExpiry)
+
+	return report
+}
+
+// finalReport creates the final resolution report of the HTLC output.
+func (h *htlcTimeoutResolver) finalReport(outcome channeldb.ResolverOutcome,
+	secondLevelTx, sweepTx *wire.MsgTx) *channeldb.ResolverReport {
+
+	return newResolverReport(
+		h.commitOutpoint(), channeldb.ResolverOutputOutgoingHtlc,
+		btcutil.Amount(h.htlcResolution.SweepSignDesc.Output.Value),
+		outcome, secondLevelTx, sweepTx,
+	)
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
@@ -326,6 +383,10 @@ func (h *htlcTimeoutResolver) Encode(w io.Writer) error {
 		return err
 	}
 
+	if _, err := w.Write(h.payHash[:]); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -356,6 +417,13 @@ func (h *htlcTimeoutResolver) Decode(r io.Reader) error {
 		return err
 	}
 
+	// Resolvers stored before the payment hash was introduced end here,
+	// so we'll leave it blank for them.
+	_, err := io.ReadFull(r, h.payHash[:])
+	if err != nil && err != io.EOF {
+		return err
+	}
+
 	return nil
 }
 
@@ -407,6 +475,9 @@ type htlcSuccessResolver struct {
 	// TODO(roasbeef): send off to utxobundler
 	sweepTx *wire.MsgTx
 
+	// progress tracks the resolution progress of the HTLC output.
+	progress resolverProgress
+
 	ResolverKit
 }
 
@@ -415,19 +486,19 @@ type htlcSuccessResolver struct {
 //
 // NOTE: Part of the ContractResolver interface.
 func (h *htlcSuccessResolver) ResolverKey() []byte {
-	// The primary key for this resolver will be the outpoint of the HTLC
-	// on the commitment transaction itself. If this is our commitment,
-	// then the output can be found within the signed success tx,
-	// otherwise, it's just the ClaimOutpoint.
-	var op wire.OutPoint
+	key := newResolverID(h.commitOutpoint())
+	return key[:]
+}
+
+// commitOutpoint returns the outpoint of the HTLC on the commitment
+// transaction itself. If this is our commitment, then the output can be found
+// within the signed success tx, otherwise, it's just the ClaimOutpoint.
+func (h *htlcSuccessResolver) commitOutpoint() wire.OutPoint {
 	if h.htlcResolution.SignedSuccessTx != nil {
-		op = h.htlcResolution.SignedSuccessTx.TxIn[0].PreviousOutPoint
-	} else {
-		op = h.htlcResolution.ClaimOutpoint
+		return h.htlcResolution.SignedSuccessTx.TxIn[0].PreviousOutPoint
 	}
 
-	key := newResolverID(op)
-	return key[:]
+	return h.htlcResolution.ClaimOutpoint
 }
 
 // Resolve attempts to resolve an unresolved incoming HTLC that we know the
@@ -447,14 +518,14 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// If we don't have a success transaction, then this means that this is
 	// an output on the remote party's commitment transaction.
 	if h.htlcResolution.SignedSuccessTx == nil {
-		// If we don't already have the sweep transaction constructed,
-		// we'll do so and broadcast it.
+		// If we don't already have the sweep transaction, we'll offer
+		// the output to the sweeper, which will batch it with any
+		// other mature outputs.
 		if h.sweepTx == nil {
-			log.Infof("%T(%x): crafting sweep tx for "+
-				"incoming+remote htlc confirmed", h,
-				h.payHash[:])
+			log.Infof("%T(%x): offering incoming+remote htlc "+
+				"to sweeper", h, h.payHash[:])
 
-			// Before we can craft out sweeping transaction, we
+			// Before we can offer the output to the sweeper, we
 			// need to create an input which contains all the items
 			// required to add this input to a sweeping transaction,
 			// and generate a witness.
@@ -464,45 +535,54 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 				h.htlcResolution.Preimage[:],
 			)
 
-			// With the input created, we can now generate the full
-			// sweep transaction, that we'll use to move these
-			// coins back into the backing wallet.
-			//
-			// TODO: Set tx lock time to current block height
-			// instead of zero. Will be taken care of once sweeper
-			// implementation is complete.
-			var err error
-			h.sweepTx, err = h.Sweeper.CreateSweepTx(
-				[]sweep.Input{&input}, sweepConfTarget, 0,
+			// The output is spendable right away, so there's no
+			// maturity height to wait for.
+			resultChan, err := h.Sweeper.SweepInput(
+				&input, 0, h.broadcastHeight,
 			)
 			if err != nil {
 				return nil, err
 			}
 
-			log.Infof("%T(%x): crafted sweep tx=%v", h,
-				h.payHash[:], spew.Sdump(h.sweepTx))
+			// Wait for the sweeper to report the spend of the
+			// output.
+			select {
+			case result, ok := <-resultChan:
+				if !ok {
+					return nil, fmt.Errorf("quitting")
+				}
+				if result.Err != nil {
+					log.Errorf("%T(%x): unable to sweep "+
+						"htlc: %v", h, h.payHash[:],
+						result.Err)
+					return nil, result.Err
+				}
+
+				h.sweepTx = result.Tx
+
+			case <-h.Quit:
+				return nil, fmt.Errorf("quitting")
+			}
 
-			// With the sweep transaction signed, we'll now
-			// Checkpoint our state.
-			if err := h.Checkpoint(h); err != nil {
-				log.Errorf("unable to Checkpoint: %v", err)
+			log.Infof("%T(%x): htlc swept by tx=%v", h,
+				h.payHash[:], spew.Sdump(h.sweepTx))
+		} else {
+			// Otherwise, the sweep transaction was crafted by a
+			// previous version of the resolver, so we'll
+			// rebroadcast it to ensure it confirms.
+			err := h.PublishTx(h.sweepTx)
+			if err != nil && err != lnwallet.ErrDoubleSpend {
+				log.Infof("%T(%x): unable to publish tx: %v",
+					h, h.payHash[:], err)
 				return nil, err
 			}
 		}
 
-		// Regardless of whether an existing transaction was found or newly
-		// constructed, we'll broadcast the sweep transaction to the
-		// network.
-		err := h.PublishTx(h.sweepTx)
-		if err != nil && err != lnwallet.ErrDoubleSpend {
-			log.Infof("%T(%x): unable to publish tx: %v",
-				h, h.payHash[:], err)
-			return nil, err
-		}
-
 		// With the sweep transaction broadcast, we'll wait for its
 		// confirmation.
 		sweepTXID := h.sweepTx.TxHash()
+		h.progress.advance(ResolverStageSwept, 0, h.sweepTx)
+
 		sweepScript := h.sweepTx.TxOut[0].PkScript
 		confNtfn, err := h.Notifier.RegisterConfirmationsNtfn(
 			&sweepTXID, sweepScript, 1, h.broadcastHeight,
@@ -525,7 +605,15 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		}
 
 		// Once the transaction has received a sufficient number of
-		// confirmations, we'll mark ourselves as fully resolved and exit.
+		// confirmations, we'll persist the final report of the HTLC,
+		// mark ourselves as fully resolved and exit.
+		report := h.finalReport(
+			channeldb.ResolverOutcomeClaimed, nil, h.sweepTx,
+		)
+		if err := h.PutResolverReport(report); err != nil {
+			return nil, err
+		}
+
 		h.resolved = true
 		return nil, h.Checkpoint(h)
 	}
@@ -541,6 +629,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	if err != nil && err != lnwallet.ErrDoubleSpend {
 		return nil, err
 	}
+	h.progress.advance(ResolverStageFirstLevelPublished, 0, nil)
 
 	// Otherwise, this is an output on our commitment transaction. In this
 	// case, we'll send it to the incubator, but only if we haven't already
@@ -565,6 +654,33 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		}
 	}
 
+	// Before the output of the second-level transaction can be swept, the
+	// transaction needs to confirm. We'll wait for it, so we know at which
+	// height its CSV delay expires.
+	secondLevelTXID := h.htlcResolution.SignedSuccessTx.TxHash()
+	secondLevelScript := h.htlcResolution.SignedSuccessTx.TxOut[0].PkScript
+	confNtfn, err := h.Notifier.RegisterConfirmationsNtfn(
+		&secondLevelTXID, secondLevelScript, 1, h.broadcastHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case conf, ok := <-confNtfn.Confirmed:
+		if !ok {
+			return nil, fmt.Errorf("quitting")
+		}
+
+		h.progress.advance(
+			ResolverStageCsvDelay,
+			conf.BlockHeight+h.htlcResolution.CsvDelay, nil,
+		)
+
+	case <-h.Quit:
+		return nil, fmt.Errorf("quitting")
+	}
+
 	// To wrap this up, we'll wait until the second-level transaction has
 	// been spent, then fully resolve the contract.
 	spendNtfn, err := h.Notifier.RegisterSpendNtfn(
@@ -579,16 +695,28 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	log.Infof("%T(%x): waiting for second-level HTLC output to be spent "+
 		"after csv_delay=%v", h, h.payHash[:], h.htlcResolution.CsvDelay)
 
+	var sweepTx *wire.MsgTx
 	select {
-	case _, ok := <-spendNtfn.Spend:
+	case spend, ok := <-spendNtfn.Spend:
 		if !ok {
 			return nil, fmt.Errorf("quitting")
 		}
 
+		h.progress.advance(ResolverStageSwept, 0, spend.SpendingTx)
+		sweepTx = spend.SpendingTx
+
 	case <-h.Quit:
 		return nil, fmt.Errorf("quitting")
 	}
 
+	report := h.finalReport(
+		channeldb.ResolverOutcomeClaimed,
+		h.htlcResolution.SignedSuccessTx, sweepTx,
+	)
+	if err := h.PutResolverReport(report); err != nil {
+		return nil, err
+	}
+
 	h.resolved = true
 	return nil, h.Checkpoint(h)
 }
@@ -609,6 +737,50 @@ func (h *htlcSuccessResolver) IsResolved() bool {
 	return h.resolved
 }
 
+// Report returns a snapshot of the resolver's current resolution progress.
+//
+// NOTE: Part of the ContractResolver interface.
+func (h *htlcSuccessResolver) Report() *ContractReport {
+	// As we know the preimage, the first thing we'll do is publish the
+	// second-level transaction, or sweep the output directly if this is
+	// the remote party's commitment.
+	initialStage := ResolverStageSwept
+	if h.htlcResolution.SignedSuccessTx != nil {
+		initialStage = ResolverStageFirstLevelPublished
+	}
+
+	return h.report(ResolverTypeHtlcSuccess, initialStage)
+}
+
+// report returns a snapshot of the resolver's current resolution progress
+// with the given type, falling back to the given stage if no progress has
+// been made yet.
+func (h *htlcSuccessResolver) report(resolverType ResolverType,
+	initialStage ResolverStage) *ContractReport {
+
+	report := &ContractReport{
+		Type:     resolverType,
+		Outpoint: h.commitOutpoint(),
+		Amount: btcutil.Amount(
+			h.htlcResolution.SweepSignDesc.Output.Value,
+		),
+	}
+	h.progress.populate(report, initialStage)
+
+	return report
+}
+
+// finalReport creates the final resolution report of the HTLC output.
+func (h *htlcSuccessResolver) finalReport(outcome channeldb.ResolverOutcome,
+	secondLevelTx, sweepTx *wire.MsgTx) *channeldb.ResolverReport {
+
+	return newResolverReport(
+		h.commitOutpoint(), channeldb.ResolverOutputIncomingHtlc,
+		btcutil.Amount(h.htlcResolution.SweepSignDesc.Output.Value),
+		outcome, secondLevelTx, sweepTx,
+	)
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
@@ -713,33 +885,12 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 	// is spent by the remote party. It'll extract the preimage, add it to
 	// the global cache, and finally send the appropriate clean up message.
 	claimCleanUp := func(commitSpend *chainntnfs.SpendDetail) (ContractResolver, error) {
-		// Depending on if this is our commitment or not, then we'll be
-		// looking for a different witness pattern.
-		spenderIndex := commitSpend.SpenderInputIndex
-		spendingInput := commitSpend.SpendingTx.TxIn[spenderIndex]
-
 		log.Infof("%T(%v): extracting preimage! remote party spent "+
 			"HTLC with tx=%v", h, h.htlcResolution.ClaimOutpoint,
 			spew.Sdump(commitSpend.SpendingTx))
 
-		// If this is the remote party's commitment, then we'll be
-		// looking for them to spend using the second-level success
-		// transaction.
 		var preimage [32]byte
-		if h.htlcResolution.SignedTimeoutTx == nil {
-			// The witness stack when the remote party sweeps the
-			// output to them looks like:
-			//
-			//  * <sender sig> <recvr sig> <preimage> <witness script>
-			copy(preimage[:], spendingInput.Witness[3])
-		} else {
-			// Otherwise, they'll be spending directly from our
-			// commitment output. In which case the witness stack
-			// looks like:
-			//
-			//  * <sig> <preimage> <witness script>
-			copy(preimage[:], spendingInput.Witness[1])
-		}
+		copy(preimage[:], h.extractPreimage(commitSpend))
 
 		log.Infof("%T(%v): extracting preimage=%x from on-chain "+
 			"spend!", h, h.htlcResolution.ClaimOutpoint, preimage[:])
@@ -760,6 +911,15 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 		}); err != nil {
 			return nil, err
 		}
+
+		report := h.finalReport(
+			channeldb.ResolverOutcomeLost, nil,
+			commitSpend.SpendingTx,
+		)
+		if err := h.PutResolverReport(report); err != nil {
+			return nil, err
+		}
+
 		h.resolved = true
 		return nil, h.Checkpoint(h)
 	}
@@ -804,6 +964,29 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 		return nil, err
 	}
 
+	// If our chain backend relays unconfirmed transactions, and we've
+	// enabled it to, we'll also watch for the remote party's sweep within
+	// the mempool. This allows us to settle the HTLC upstream as soon as
+	// the sweep is broadcast, rather than only once it confirms.
+	var mempoolSpends chan *chainntnfs.SpendDetail
+	notifier, ok := h.Notifier.(chainntnfs.MempoolSpendNotifier)
+	if ok {
+		mempoolNtfn, err := notifier.RegisterMempoolSpendNtfn(
+			&outPointToWatch,
+		)
+		switch {
+		case err == chainntnfs.ErrMempoolSpendsDisabled:
+
+		case err != nil:
+			return nil, err
+
+		default:
+			defer mempoolNtfn.Cancel()
+
+			mempoolSpends = mempoolNtfn.Spend
+		}
+	}
+
 	// We'll quickly check to see if the output has already been spent.
 	select {
 	// If the output has already been spent, then we can stop early and
@@ -886,12 +1069,102 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 			// claimed.
 			return claimCleanUp(commitSpend)
 
+		// The remote party has broadcast a transaction spending the
+		// output, revealing the preimage within the mempool. We'll
+		// settle the HTLC upstream right away, but will wait for the
+		// spend to confirm before considering the contract resolved.
+		case mempoolSpend, ok := <-mempoolSpends:
+			if !ok {
+				return nil, fmt.Errorf("quitting")
+			}
+
+			if err := h.settleFromMempool(mempoolSpend); err != nil {
+				return nil, err
+			}
+
 		case <-h.Quit:
 			return nil, fmt.Errorf("resolver cancelled")
 		}
 	}
 }
 
+// extractPreimage returns the preimage revealed by the remote party's spend of
+// the HTLC output. Depending on if this is our commitment or not, then we'll
+// be looking for a different witness pattern. If the witness doesn't match the
+// expected pattern, nil is returned.
+func (h *htlcOutgoingContestResolver) extractPreimage(
+	spend *chainntnfs.SpendDetail) []byte {
+
+	spendingInput := spend.SpendingTx.TxIn[spend.SpenderInputIndex]
+
+	// If this is the remote party's commitment, then we'll be looking for
+	// them to spend using the second-level success transaction. The
+	// witness stack then looks like:
+	//
+	//  * <nil> <sender sig> <recvr sig> <preimage> <witness script>
+	//
+	// Otherwise, they'll be spending directly from our commitment output.
+	// In which case the witness stack looks like:
+	//
+	//  * <sig> <preimage> <witness script>
+	preimageIndex := 3
+	if h.htlcResolution.SignedTimeoutTx != nil {
+		preimageIndex = 1
+	}
+
+	if len(spendingInput.Witness) <= preimageIndex {
+		return nil
+	}
+
+	return spendingInput.Witness[preimageIndex]
+}
+
+// settleFromMempool extracts the preimage revealed by an unconfirmed spend of
+// the HTLC output, adds it to the global cache, and settles the HTLC upstream.
+// Spends that don't reveal the preimage of the HTLC's payment hash are
+// ignored.
+func (h *htlcOutgoingContestResolver) settleFromMempool(
+	spend *chainntnfs.SpendDetail) error {
+
+	preimageBytes := h.extractPreimage(spend)
+	if len(preimageBytes) != 32 {
+		log.Warnf("%T(%v): unconfirmed spend %v doesn't reveal the "+
+			"preimage", h, h.htlcResolution.ClaimOutpoint,
+			spend.SpenderTxHash)
+		return nil
+	}
+
+	var preimage [32]byte
+	copy(preimage[:], preimageBytes)
+
+	// As the spend is unconfirmed, we'll make sure the preimage is the
+	// one we're after before trusting it.
+	if sha256.Sum256(preimage[:]) != h.payHash {
+		log.Warnf("%T(%v): unconfirmed spend %v reveals preimage=%x "+
+			"not matching payment hash=%x", h,
+			h.htlcResolution.ClaimOutpoint, spend.SpenderTxHash,
+			preimage[:], h.payHash[:])
+		return nil
+	}
+
+	log.Infof("%T(%v): extracting preimage=%x from unconfirmed spend %v",
+		h, h.htlcResolution.ClaimOutpoint, preimage[:],
+		spend.SpenderTxHash)
+
+	if err := h.PreimageDB.AddPreimage(preimage[:]); err != nil {
+		log.Errorf("%T(%v): unable to add witness to cache",
+			h, h.htlcResolution.ClaimOutpoint)
+	}
+
+	// Processing the resolution message is idempotent, so it's fine that
+	// we'll send it again once the spend confirms.
+	return h.DeliverResolutionMsg(ResolutionMsg{
+		SourceChan: h.ShortChanID,
+		HtlcIndex:  h.htlcIndex,
+		PreImage:   &preimage,
+	})
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -908,6 +1181,16 @@ func (h *htlcOutgoingContestResolver) IsResolved() bool {
 	return h.resolved
 }
 
+// Report returns a snapshot of the resolver's current resolution progress.
+//
+// NOTE: Part of the ContractResolver interface.
+func (h *htlcOutgoingContestResolver) Report() *ContractReport {
+	report := h.htlcTimeoutResolver.Report()
+	report.Type = ResolverTypeHtlcOutgoingContest
+
+	return report
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
@@ -993,8 +1276,7 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 		log.Infof("%T(%v): HTLC has timed out (expiry=%v, height=%v), "+
 			"abandoning", h, h.htlcResolution.ClaimOutpoint,
 			h.htlcExpiry, currentHeight)
-		h.resolved = true
-		return nil, h.Checkpoint(h)
+		return nil, h.abandon()
 	}
 
 	// applyPreimage is a helper function that will populate our internal
@@ -1085,8 +1367,7 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 					"(expiry=%v, height=%v), abandoning", h,
 					h.htlcResolution.ClaimOutpoint,
 					h.htlcExpiry, currentHeight)
-				h.resolved = true
-				return nil, h.Checkpoint(h)
+				return nil, h.abandon()
 			}
 
 		case <-h.Quit:
@@ -1095,6 +1376,18 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 	}
 }
 
+// abandon persists the final report of the HTLC, as it timed out before we
+// learned of its preimage, and marks the contract resolved.
+func (h *htlcIncomingContestResolver) abandon() error {
+	report := h.finalReport(channeldb.ResolverOutcomeAbandoned, nil, nil)
+	if err := h.PutResolverReport(report); err != nil {
+		return err
+	}
+
+	h.resolved = true
+	return h.Checkpoint(h)
+}
+
 // Stop signals the resolver to cancel any current resolution processes, and
 // suspend.
 //
@@ -1111,6 +1404,18 @@ func (h *htlcIncomingContestResolver) IsResolved() bool {
 	return h.resolved
 }
 
+// Report returns a snapshot of the resolver's current resolution progress.
+//
+// NOTE: Part of the ContractResolver interface.
+func (h *htlcIncomingContestResolver) Report() *ContractReport {
+	report := h.report(
+		ResolverTypeHtlcIncomingContest, ResolverStageWaitingExpiry,
+	)
+	report.ExpiryHeight = h.htlcExpiry
+
+	return report
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
@@ -1177,6 +1482,9 @@ type commitSweepResolver struct {
 	// source wallet.
 	sweepTx *wire.MsgTx
 
+	// progress tracks the resolution progress of the commitment output.
+	progress resolverProgress
+
 	ResolverKit
 }
 
@@ -1215,11 +1523,13 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 
 	log.Debugf("%T(%v): waiting for commit tx to confirm", c, c.chanPoint)
 
+	var commitConfHeight uint32
 	select {
-	case _, ok := <-confNtfn.Confirmed:
+	case conf, ok := <-confNtfn.Confirmed:
 		if !ok {
 			return nil, fmt.Errorf("quitting")
 		}
+		commitConfHeight = conf.BlockHeight
 
 	case <-c.Quit:
 		return nil, fmt.Errorf("quitting")
@@ -1232,57 +1542,55 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	isLocalCommitTx := c.commitResolution.MaturityDelay != 0
 
 	switch {
-	// If the sweep transaction isn't already generated, and the remote
-	// party broadcast the commitment transaction then we'll create it now.
+	// If the sweep transaction isn't already known, and the remote party
+	// broadcast the commitment transaction then we'll offer our output to
+	// the sweeper.
 	case c.sweepTx == nil && !isLocalCommitTx:
-		// As we haven't already generated the sweeping transaction,
-		// we'll now craft an input with all the information required
-		// to create a fully valid sweeping transaction to recover
-		// these coins.
+		// We'll craft an input with all the information required to
+		// create a fully valid sweeping transaction to recover these
+		// coins.
 		input := sweep.MakeBaseInput(
 			&c.commitResolution.SelfOutPoint,
 			lnwallet.CommitmentNoDelay,
 			&c.commitResolution.SelfOutputSignDesc,
 		)
 
-		// With out input constructed, we'll now request that the
-		// sweeper construct a valid sweeping transaction for this
-		// input.
-		//
-		// TODO: Set tx lock time to current block height instead of
-		// zero. Will be taken care of once sweeper implementation is
-		// complete.
-		c.sweepTx, err = c.Sweeper.CreateSweepTx(
-			[]sweep.Input{&input}, sweepConfTarget, 0,
+		// With our input constructed, we'll now offer it to the
+		// sweeper, which will batch it with any other mature outputs.
+		// As the sweeper persists the transactions it publishes, we
+		// don't need to checkpoint our state until the sweep
+		// confirms: upon restart, the input will be offered again.
+		resultChan, err := c.Sweeper.SweepInput(
+			&input, 0, c.broadcastHeight,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		log.Infof("%T(%v): sweeping commit output with tx=%v", c,
-			c.chanPoint, spew.Sdump(c.sweepTx))
+		select {
+		case result, ok := <-resultChan:
+			if !ok {
+				return nil, fmt.Errorf("quitting")
+			}
+			if result.Err != nil {
+				log.Errorf("%T(%v): unable to sweep commit "+
+					"output: %v", c, c.chanPoint,
+					result.Err)
+				return nil, result.Err
+			}
 
-		// With the sweep transaction constructed, we'll now Checkpoint
-		// our state.
-		if err := c.Checkpoint(c); err != nil {
-			log.Errorf("unable to Checkpoint: %v", err)
-			return nil, err
-		}
+			c.sweepTx = result.Tx
 
-		// With the sweep transaction checkpointed, we'll now publish
-		// the transaction. Upon restart, the resolver will immediately
-		// take the case below since the sweep tx is checkpointed.
-		err := c.PublishTx(c.sweepTx)
-		if err != nil && err != lnwallet.ErrDoubleSpend {
-			log.Errorf("%T(%v): unable to publish sweep tx: %v",
-				c, c.chanPoint, err)
-			return nil, err
+		case <-c.Quit:
+			return nil, fmt.Errorf("quitting")
 		}
 
-	// If the sweep transaction has been generated, and the remote party
-	// broadcast the commit transaction, we'll republish it for reliability
-	// to ensure it confirms. The resolver will enter this case after
-	// checkpointing in the case above, ensuring we reliably on restarts.
+		log.Infof("%T(%v): commit output swept with tx=%v", c,
+			c.chanPoint, spew.Sdump(c.sweepTx))
+
+	// If the sweep transaction was generated by a previous version of the
+	// resolver, and the remote party broadcast the commit transaction,
+	// we'll republish it for reliability to ensure it confirms.
 	case c.sweepTx != nil && !isLocalCommitTx:
 		err := c.PublishTx(c.sweepTx)
 		if err != nil && err != lnwallet.ErrDoubleSpend {
@@ -1298,6 +1606,11 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 		// transaction, then the output we need to sweep has been sent
 		// to the nursery for incubation. In this case, we'll wait
 		// until the commitment output has been spent.
+		c.progress.advance(
+			ResolverStageCsvDelay,
+			commitConfHeight+c.commitResolution.MaturityDelay, nil,
+		)
+
 		spendNtfn, err := c.Notifier.RegisterSpendNtfn(
 			&c.commitResolution.SelfOutPoint,
 			c.commitResolution.SelfOutputSignDesc.Output.PkScript,
@@ -1339,6 +1652,8 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	// Now we'll wait until the sweeping transaction has been fully
 	// confirmed.  Once it's confirmed, we can mark this contract resolved.
 	sweepTXID := c.sweepTx.TxHash()
+	c.progress.advance(ResolverStageSwept, 0, c.sweepTx)
+
 	sweepingScript := c.sweepTx.TxOut[0].PkScript
 	confNtfn, err = c.Notifier.RegisterConfirmationsNtfn(
 		&sweepTXID, sweepingScript, 1, c.broadcastHeight,
@@ -1360,7 +1675,19 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	}
 
 	// Once the transaction has received a sufficient number of
-	// confirmations, we'll mark ourselves as fully resolved and exit.
+	// confirmations, we'll persist the final report of the commitment
+	// output, mark ourselves as fully resolved and exit.
+	report := newResolverReport(
+		c.commitResolution.SelfOutPoint, channeldb.ResolverOutputCommit,
+		btcutil.Amount(
+			c.commitResolution.SelfOutputSignDesc.Output.Value,
+		),
+		channeldb.ResolverOutcomeClaimed, nil, c.sweepTx,
+	)
+	if err := c.PutResolverReport(report); err != nil {
+		return nil, err
+	}
+
 	c.resolved = true
 	return nil, c.Checkpoint(c)
 }
@@ -1381,6 +1708,22 @@ func (c *commitSweepResolver) IsResolved() bool {
 	return c.resolved
 }
 
+// Report returns a snapshot of the resolver's current resolution progress.
+//
+// NOTE: Part of the ContractResolver interface.
+func (c *commitSweepResolver) Report() *ContractReport {
+	report := &ContractReport{
+		Type:     ResolverTypeCommitSweep,
+		Outpoint: c.commitResolution.SelfOutPoint,
+		Amount: btcutil.Amount(
+			c.commitResolution.SelfOutputSignDesc.Output.Value,
+		),
+	}
+	c.progress.populate(report, ResolverStageWaitingCommitConf)
+
+	return report
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
@@ -1 +1,217 @@
 package contractcourt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// mockMempoolNotifier is a mockNotifier that also relays the spends of
+// outpoints by unconfirmed transactions.
+type mockMempoolNotifier struct {
+	*mockNotifier
+
+	mempoolSpendChan chan *chainntnfs.SpendDetail
+}
+
+func (m *mockMempoolNotifier) RegisterMempoolSpendNtfn(
+	outpoint *wire.OutPoint) (*chainntnfs.MempoolSpendEvent, error) {
+
+	return &chainntnfs.MempoolSpendEvent{
+		Spend:  m.mempoolSpendChan,
+		Cancel: func() {},
+	}, nil
+}
+
+// mockWitnessBeacon is a WitnessBeacon that relays the preimages added to it.
+type mockWitnessBeacon struct {
+	newPreimages chan []byte
+}
+
+func (m *mockWitnessBeacon) SubscribeUpdates() *WitnessSubscription {
+	return &WitnessSubscription{
+		WitnessUpdates:     make(chan []byte),
+		CancelSubscription: func() {},
+	}
+}
+
+func (m *mockWitnessBeacon) LookupPreimage(payhash []byte) ([]byte, bool) {
+	return nil, false
+}
+
+func (m *mockWitnessBeacon) AddPreimage(preimage []byte) error {
+	m.newPreimages <- preimage
+	return nil
+}
+
+// TestHtlcOutgoingContestResolverMempoolPreimage tests that the outgoing
+// contest resolver settles the HTLC upstream as soon as the remote party's
+// sweep reveals the preimage within the mempool, and only considers the
+// contract resolved once the sweep confirms. Unconfirmed spends revealing a
+// preimage that doesn't match the payment hash should be ignored.
+func TestHtlcOutgoingContestResolverMempoolPreimage(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockMempoolNotifier{
+		mockNotifier: &mockNotifier{
+			spendChan: make(chan *chainntnfs.SpendDetail, 1),
+			epochChan: make(chan *chainntnfs.BlockEpoch),
+		},
+		mempoolSpendChan: make(chan *chainntnfs.SpendDetail, 1),
+	}
+	beacon := &mockWitnessBeacon{
+		newPreimages: make(chan []byte, 2),
+	}
+	resolutionMsgs := make(chan ResolutionMsg, 2)
+	claimOutpoint := wire.OutPoint{Index: 2}
+	preimage := bytes.Repeat([]byte{1}, 32)
+
+	resolver := &htlcOutgoingContestResolver{
+		htlcTimeoutResolver: htlcTimeoutResolver{
+			htlcResolution: lnwallet.OutgoingHtlcResolution{
+				Expiry:        100,
+				ClaimOutpoint: claimOutpoint,
+				SweepSignDesc: lnwallet.SignDescriptor{
+					Output: &wire.TxOut{Value: 10000},
+				},
+			},
+			htlcIndex: 3,
+			payHash:   sha256.Sum256(preimage),
+			ResolverKit: ResolverKit{
+				ChannelArbitratorConfig: ChannelArbitratorConfig{
+					ChainArbitratorConfig: ChainArbitratorConfig{
+						Notifier:   notifier,
+						PreimageDB: beacon,
+						ChainIO:    &mockChainIO{},
+						DeliverResolutionMsg: func(
+							msgs ...ResolutionMsg) error {
+
+							for _, msg := range msgs {
+								resolutionMsgs <- msg
+							}
+							return nil
+						},
+					},
+					PutResolverReport: func(
+						*channeldb.ResolverReport) error {
+
+						return nil
+					},
+				},
+				Checkpoint: func(ContractResolver) error {
+					return nil
+				},
+				Quit: make(chan struct{}),
+			},
+		},
+	}
+
+	resolveErr := make(chan error, 1)
+	go func() {
+		nextResolver, err := resolver.Resolve()
+		if err == nil && nextResolver != nil {
+			t.Errorf("unexpected next resolver %T", nextResolver)
+		}
+		resolveErr <- err
+	}()
+
+	// As this is the remote party's commitment, they'll sweep the HTLC
+	// output using the second-level success transaction.
+	newSweep := func(preimage []byte) *chainntnfs.SpendDetail {
+		sweepTx := &wire.MsgTx{
+			TxIn: []*wire.TxIn{
+				{
+					PreviousOutPoint: claimOutpoint,
+					Witness: [][]byte{
+						nil, {2}, {3}, preimage, {4},
+					},
+				},
+			},
+		}
+		sweepTxid := sweepTx.TxHash()
+		return &chainntnfs.SpendDetail{
+			SpentOutPoint:  &claimOutpoint,
+			SpenderTxHash:  &sweepTxid,
+			SpendingTx:     sweepTx,
+			SpendingHeight: 0,
+		}
+	}
+	sweep := newSweep(preimage)
+
+	assertSettled := func() {
+		t.Helper()
+
+		select {
+		case newPreimage := <-beacon.newPreimages:
+			if !bytes.Equal(newPreimage, preimage) {
+				t.Fatalf("expected preimage %x, got %x",
+					preimage, newPreimage)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("preimage not added to witness beacon")
+		}
+
+		select {
+		case msg := <-resolutionMsgs:
+			if msg.HtlcIndex != 3 || msg.PreImage == nil ||
+				!bytes.Equal(msg.PreImage[:], preimage) {
+
+				t.Fatalf("unexpected resolution msg: %v", msg)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("htlc not settled upstream")
+		}
+	}
+
+	// An unconfirmed spend revealing a preimage that doesn't match the
+	// payment hash shouldn't settle the HTLC.
+	notifier.mempoolSpendChan <- newSweep(bytes.Repeat([]byte{2}, 32))
+
+	select {
+	case newPreimage := <-beacon.newPreimages:
+		t.Fatalf("unexpected preimage %x added to witness beacon",
+			newPreimage)
+	case msg := <-resolutionMsgs:
+		t.Fatalf("unexpected resolution msg: %v", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Once the sweep enters the mempool, the resolver should extract the
+	// preimage and settle the HTLC upstream, but shouldn't consider the
+	// contract resolved yet.
+	notifier.mempoolSpendChan <- sweep
+	assertSettled()
+
+	select {
+	case err := <-resolveErr:
+		t.Fatalf("resolver exited before sweep confirmed: %v", err)
+	default:
+	}
+	if resolver.IsResolved() {
+		t.Fatal("contract resolved before sweep confirmed")
+	}
+
+	// Once the sweep confirms, the contract should be fully resolved.
+	confirmedSweep := *sweep
+	confirmedSweep.SpendingHeight = 10
+	notifier.spendChan <- &confirmedSweep
+	assertSettled()
+
+	select {
+	case err := <-resolveErr:
+		if err != nil {
+			t.Fatalf("unable to resolve contract: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("resolver didn't exit")
+	}
+	if !resolver.IsResolved() {
+		t.Fatal("contract not resolved")
+	}
+}
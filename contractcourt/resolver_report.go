@@ -0,0 +1,258 @@
+package contractcourt
+
+import (
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// ResolverType denotes the kind of contract resolver that's tasked with
+// resolving a particular output of a closed channel.
+type ResolverType uint8
+
+const (
+	// ResolverTypeHtlcTimeout denotes a resolver that sweeps an outgoing
+	// HTLC once it has timed out.
+	ResolverTypeHtlcTimeout ResolverType = iota
+
+	// ResolverTypeHtlcSuccess denotes a resolver that sweeps an incoming
+	// HTLC we know the preimage of.
+	ResolverTypeHtlcSuccess
+
+	// ResolverTypeHtlcOutgoingContest denotes a resolver that waits for an
+	// outgoing HTLC to either time out, or be swept by the remote party.
+	ResolverTypeHtlcOutgoingContest
+
+	// ResolverTypeHtlcIncomingContest denotes a resolver that waits for
+	// either the preimage of an incoming HTLC, or for it to time out.
+	ResolverTypeHtlcIncomingContest
+
+	// ResolverTypeCommitSweep denotes a resolver that sweeps our output on
+	// the commitment transaction.
+	ResolverTypeCommitSweep
+)
+
+// String returns a human readable version of the ResolverType.
+func (r ResolverType) String() string {
+	switch r {
+	case ResolverTypeHtlcTimeout:
+		return "HtlcTimeout"
+	case ResolverTypeHtlcSuccess:
+		return "HtlcSuccess"
+	case ResolverTypeHtlcOutgoingContest:
+		return "HtlcOutgoingContest"
+	case ResolverTypeHtlcIncomingContest:
+		return "HtlcIncomingContest"
+	case ResolverTypeCommitSweep:
+		return "CommitSweep"
+	default:
+		return "Unknown"
+	}
+}
+
+// ResolverStage denotes how far a contract resolver has come in resolving its
+// output.
+type ResolverStage uint8
+
+const (
+	// ResolverStageWaitingExpiry denotes that the output can't be claimed
+	// yet, as we're waiting for the HTLC to expire, or to learn its
+	// preimage.
+	ResolverStageWaitingExpiry ResolverStage = iota
+
+	// ResolverStageWaitingCommitConf denotes that we're waiting for the
+	// commitment transaction to confirm before the output can be claimed.
+	ResolverStageWaitingCommitConf
+
+	// ResolverStageFirstLevelPublished denotes that the second-level HTLC
+	// transaction spending the output from the commitment transaction has
+	// been published, but hasn't confirmed yet.
+	ResolverStageFirstLevelPublished
+
+	// ResolverStageCsvDelay denotes that the output to be swept, either
+	// the output of a second-level HTLC transaction or our delayed output
+	// on our commitment transaction, is confirmed but still locked by its
+	// CSV delay.
+	ResolverStageCsvDelay
+
+	// ResolverStageSwept denotes that the output has been swept.
+	ResolverStageSwept
+)
+
+// String returns a human readable version of the ResolverStage.
+func (r ResolverStage) String() string {
+	switch r {
+	case ResolverStageWaitingExpiry:
+		return "WaitingExpiry"
+	case ResolverStageWaitingCommitConf:
+		return "WaitingCommitConf"
+	case ResolverStageFirstLevelPublished:
+		return "FirstLevelPublished"
+	case ResolverStageCsvDelay:
+		return "CsvDelay"
+	case ResolverStageSwept:
+		return "Swept"
+	default:
+		return "Unknown"
+	}
+}
+
+// ContractReport is a snapshot of the resolution progress of a single output
+// of a closed channel, as seen by the contract resolver tasked with resolving
+// it.
+type ContractReport struct {
+	// Type is the kind of resolver that's resolving the output.
+	Type ResolverType
+
+	// Outpoint is the output on the commitment transaction that's being
+	// resolved.
+	Outpoint wire.OutPoint
+
+	// Amount is the value of the output that will be swept.
+	Amount btcutil.Amount
+
+	// Stage is the stage of resolution the resolver has reached.
+	Stage ResolverStage
+
+	// ExpiryHeight is the absolute expiry height of the HTLC. This is
+	// zero for outputs that don't belong to an HTLC.
+	ExpiryHeight uint32
+
+	// MaturityHeight is the height at which the CSV delay of the output
+	// expires. This is only known once the output has reached the
+	// ResolverStageCsvDelay stage.
+	MaturityHeight uint32
+
+	// SweepTxid is the txid of the transaction that swept the output. This
+	// is only known once the output has reached the ResolverStageSwept
+	// stage.
+	SweepTxid *chainhash.Hash
+
+	// SweepPkScript is the output script that the output was swept to.
+	// Like the SweepTxid, this is only known once the output has reached
+	// the ResolverStageSwept stage.
+	SweepPkScript []byte
+}
+
+// progressSnapshot is an immutable snapshot of a resolver's resolution
+// progress.
+type progressSnapshot struct {
+	stage          ResolverStage
+	maturityHeight uint32
+	sweepTxid      *chainhash.Hash
+	sweepPkScript  []byte
+}
+
+// resolverProgress tracks the resolution progress of a contract resolver. It
+// is advanced by the resolver's Resolve method, and may be read concurrently
+// in order to report on the resolver.
+type resolverProgress struct {
+	// snapshot holds the latest progressSnapshot, or nothing if the
+	// resolver hasn't made any progress yet. Only the goroutine running
+	// the resolver stores new snapshots.
+	snapshot atomic.Value
+}
+
+// advance moves the resolver to the given stage. A zero maturity height or a
+// nil sweep transaction leave the previously known values untouched.
+func (p *resolverProgress) advance(stage ResolverStage, maturityHeight uint32,
+	sweepTx *wire.MsgTx) {
+
+	var next progressSnapshot
+	if prev, ok := p.snapshot.Load().(progressSnapshot); ok {
+		next = prev
+	}
+
+	next.stage = stage
+	if maturityHeight != 0 {
+		next.maturityHeight = maturityHeight
+	}
+	if sweepTx != nil {
+		sweepTxid := sweepTx.TxHash()
+		next.sweepTxid = &sweepTxid
+		next.sweepPkScript = sweepOutputScript(sweepTx)
+	}
+
+	p.snapshot.Store(next)
+}
+
+// populate fills in the progress related fields of the passed report. If the
+// resolver hasn't made any progress yet, the report is set to the given
+// initial stage.
+func (p *resolverProgress) populate(report *ContractReport,
+	initialStage ResolverStage) {
+
+	current, ok := p.snapshot.Load().(progressSnapshot)
+	if !ok {
+		report.Stage = initialStage
+		return
+	}
+
+	report.Stage = current.stage
+	report.MaturityHeight = current.maturityHeight
+	report.SweepTxid = current.sweepTxid
+	report.SweepPkScript = current.sweepPkScript
+}
+
+// sweepOutputScript returns the output script of the sweep transaction,
+// which is the destination of the swept funds. Our sweep transactions only
+// have a single output.
+func sweepOutputScript(sweepTx *wire.MsgTx) []byte {
+	if len(sweepTx.TxOut) == 0 {
+		return nil
+	}
+
+	return sweepTx.TxOut[0].PkScript
+}
+
+// newResolverReport creates the final resolution report of an output of the
+// commitment transaction. The sweep fee is only determined if we swept the
+// output as the sole input of the sweep transaction, as we can't tell the
+// value of any other inputs.
+func newResolverReport(outPoint wire.OutPoint,
+	outputType channeldb.ResolverOutputType, amount btcutil.Amount,
+	outcome channeldb.ResolverOutcome,
+	secondLevelTx, sweepTx *wire.MsgTx) *channeldb.ResolverReport {
+
+	report := &channeldb.ResolverReport{
+		OutPoint:   outPoint,
+		OutputType: outputType,
+		Amount:     amount,
+		Outcome:    outcome,
+	}
+
+	if secondLevelTx != nil {
+		secondLevelTxid := secondLevelTx.TxHash()
+		report.SecondLevelTxid = &secondLevelTxid
+	}
+
+	if sweepTx == nil {
+		return report
+	}
+
+	sweepTxid := sweepTx.TxHash()
+	report.SweepTxid = &sweepTxid
+
+	// If the remote party swept the output, neither the destination nor
+	// the fee are ours.
+	if outcome == channeldb.ResolverOutcomeLost {
+		return report
+	}
+
+	report.SweepPkScript = sweepOutputScript(sweepTx)
+
+	if len(sweepTx.TxIn) != 1 {
+		return report
+	}
+
+	var outputValue btcutil.Amount
+	for _, txOut := range sweepTx.TxOut {
+		outputValue += btcutil.Amount(txOut.Value)
+	}
+	report.SweepFee = amount - outputValue
+
+	return report
+}
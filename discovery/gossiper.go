@@ -1542,6 +1542,57 @@ func (d *AuthenticatedGossiper) processRejectedEdge(
 	return announcements, nil
 }
 
+// processZombieUpdate determines whether the provided channel update should
+// resurrect a given zombie edge. The update must be signed by the node
+// corresponding to its direction, and must be more recent than the channel
+// prune expiry. If so, the edge is removed from the zombie index such that
+// its announcement can be processed once again.
+func (d *AuthenticatedGossiper) processZombieUpdate(pubKey1, pubKey2 [33]byte,
+	msg *lnwire.ChannelUpdate) error {
+
+	// The least-significant bit in the flag on the channel update tells us
+	// which edge is being updated.
+	var pubKeyBytes [33]byte
+	if msg.Flags&lnwire.ChanUpdateDirection == 0 {
+		pubKeyBytes = pubKey1
+	} else {
+		pubKeyBytes = pubKey2
+	}
+
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes[:], btcec.S256())
+	if err != nil {
+		return fmt.Errorf("unable to parse pubkey for zombie "+
+			"short_chan_id=%v: %v", msg.ShortChannelID, err)
+	}
+
+	// We'll only resurrect the edge if the update was signed by the
+	// expected node.
+	err = routing.ValidateChannelUpdateAnn(pubKey, msg)
+	if err != nil {
+		return fmt.Errorf("unable to validate channel update for "+
+			"zombie short_chan_id=%v: %v", msg.ShortChannelID, err)
+	}
+
+	// An update which is as stale as the one that led to the edge being
+	// pruned in the first place isn't enough to bring it back.
+	timestamp := time.Unix(int64(msg.Timestamp), 0)
+	if time.Since(timestamp) >= routing.DefaultChannelPruneExpiry {
+		return fmt.Errorf("ignoring stale channel update for zombie "+
+			"short_chan_id=%v", msg.ShortChannelID)
+	}
+
+	err = d.cfg.Router.MarkEdgeLive(msg.ShortChannelID)
+	if err != nil {
+		return fmt.Errorf("unable to remove edge with short_chan_id=%v "+
+			"from zombie index: %v", msg.ShortChannelID, err)
+	}
+
+	log.Debugf("Removed edge with short_chan_id=%v from zombie index",
+		msg.ShortChannelID)
+
+	return nil
+}
+
 // processNetworkAnnouncement processes a new network relate authenticated
 // channel or node announcement or announcements proofs. If the announcement
 // didn't affect the internal state due to either being out of date, invalid,
@@ -1925,6 +1976,26 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			case channeldb.ErrGraphNoEdgesFound:
 				fallthrough
 			case channeldb.ErrEdgeNotFound:
+				// If the edge was previously pruned as a
+				// zombie, we'll only consider resurrecting it
+				// if this update is both valid and fresh.
+				// Otherwise, we'll reject it without caching
+				// the rejection, as a later update may still
+				// revive the channel.
+				isZombie, pubKey1, pubKey2 := d.cfg.Router.IsZombieEdge(
+					msg.ShortChannelID,
+				)
+				if isZombie {
+					err := d.processZombieUpdate(
+						pubKey1, pubKey2, msg,
+					)
+					if err != nil {
+						log.Debug(err)
+						nMsg.err <- err
+						return nil
+					}
+				}
+
 				// If the edge corresponding to this
 				// ChannelUpdate was not found in the graph,
 				// this might be a channel in the process of
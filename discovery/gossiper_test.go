@@ -109,6 +109,9 @@ type mockGraphSource struct {
 	infos      map[uint64]*channeldb.ChannelEdgeInfo
 	edges      map[uint64][]*channeldb.ChannelEdgePolicy
 	bestHeight uint32
+
+	mu      sync.Mutex
+	zombies map[uint64][2][33]byte
 }
 
 func newMockRouter(height uint32) *mockGraphSource {
@@ -116,6 +119,7 @@ func newMockRouter(height uint32) *mockGraphSource {
 		bestHeight: height,
 		infos:      make(map[uint64]*channeldb.ChannelEdgeInfo),
 		edges:      make(map[uint64][]*channeldb.ChannelEdgePolicy),
+		zombies:    make(map[uint64][2][33]byte),
 	}
 }
 
@@ -226,10 +230,36 @@ func (r *mockGraphSource) IsPublicNode(node routing.Vertex) (bool, error) {
 }
 
 // IsKnownEdge returns true if the graph source already knows of the passed
-// channel ID.
+// channel ID either as a live or zombie edge.
 func (r *mockGraphSource) IsKnownEdge(chanID lnwire.ShortChannelID) bool {
-	_, ok := r.infos[chanID.ToUint64()]
-	return ok
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	chanIDInt := chanID.ToUint64()
+	_, exists := r.infos[chanIDInt]
+	_, isZombie := r.zombies[chanIDInt]
+	return exists || isZombie
+}
+
+// IsZombieEdge returns true if the graph source has marked the passed channel
+// ID as a zombie edge.
+func (r *mockGraphSource) IsZombieEdge(
+	chanID lnwire.ShortChannelID) (bool, [33]byte, [33]byte) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pubKeys, ok := r.zombies[chanID.ToUint64()]
+	return ok, pubKeys[0], pubKeys[1]
+}
+
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+func (r *mockGraphSource) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.zombies, chanID.ToUint64())
+	return nil
 }
 
 // IsStaleEdgePolicy returns true if the graph source has a channel edge for
@@ -2383,6 +2413,134 @@ func TestExtraDataChannelUpdateValidation(t *testing.T) {
 	}
 }
 
+// TestProcessZombieEdgeNowLive ensures that a zombie edge is only resurrected
+// once a fresh channel update signed by the correct node is received for it.
+func TestProcessZombieEdgeNowLive(t *testing.T) {
+	t.Parallel()
+
+	ctx, cleanup, err := createTestCtx(0)
+	if err != nil {
+		t.Fatalf("can't create context: %v", err)
+	}
+	defer cleanup()
+
+	remotePeer := &mockPeer{nodeKeyPriv1.PubKey(), nil, nil}
+
+	chanAnn, err := createRemoteChannelAnnouncement(0)
+	if err != nil {
+		t.Fatalf("unable to create chan ann: %v", err)
+	}
+
+	// We'll start by marking the channel as a zombie within the router.
+	var pubKey1, pubKey2 [33]byte
+	copy(pubKey1[:], nodeKeyPriv1.PubKey().SerializeCompressed())
+	copy(pubKey2[:], nodeKeyPriv2.PubKey().SerializeCompressed())
+
+	router := ctx.gossiper.cfg.Router.(*mockGraphSource)
+	router.mu.Lock()
+	router.zombies[chanAnn.ShortChannelID.ToUint64()] = [2][33]byte{
+		pubKey1, pubKey2,
+	}
+	router.mu.Unlock()
+
+	assertZombie := func(expZombie bool) {
+		t.Helper()
+
+		isZombie, _, _ := router.IsZombieEdge(chanAnn.ShortChannelID)
+		if isZombie != expZombie {
+			t.Fatalf("expected zombie=%v, got %v", expZombie,
+				isZombie)
+		}
+	}
+
+	processUpdate := func(upd *lnwire.ChannelUpdate, expErr bool) {
+		t.Helper()
+
+		select {
+		case err := <-ctx.gossiper.ProcessRemoteAnnouncement(
+			upd, remotePeer,
+		):
+			if !expErr {
+				t.Fatalf("expected update to be stashed, "+
+					"got: %v", err)
+			}
+			if err == nil {
+				t.Fatal("expected update to be rejected")
+			}
+		case <-time.After(time.Second):
+			if expErr {
+				t.Fatal("did not process remote announcement")
+			}
+		}
+	}
+
+	// A channel update with a timestamp older than the prune expiry
+	// shouldn't resurrect the edge.
+	staleTimestamp := time.Now().Add(-routing.DefaultChannelPruneExpiry)
+	staleUpd, err := createUpdateAnnouncement(
+		0, 0, nodeKeyPriv1, uint32(staleTimestamp.Unix()-1),
+	)
+	if err != nil {
+		t.Fatalf("unable to create chan up: %v", err)
+	}
+	processUpdate(staleUpd, true)
+	assertZombie(true)
+
+	// Neither should a fresh channel update signed by the wrong node.
+	freshTimestamp := uint32(time.Now().Unix())
+	badSigUpd, err := createUpdateAnnouncement(
+		0, 0, nodeKeyPriv2, freshTimestamp,
+	)
+	if err != nil {
+		t.Fatalf("unable to create chan up: %v", err)
+	}
+	processUpdate(badSigUpd, true)
+	assertZombie(true)
+
+	// Finally, a fresh update signed by the correct node should resurrect
+	// the edge. As the edge isn't yet within the graph, the update will
+	// be stashed until we receive its announcement.
+	updTimestamp := freshTimestamp + 1
+	upd, err := createUpdateAnnouncement(0, 0, nodeKeyPriv1, updTimestamp)
+	if err != nil {
+		t.Fatalf("unable to create chan up: %v", err)
+	}
+	updErr := ctx.gossiper.ProcessRemoteAnnouncement(upd, remotePeer)
+	timeout := time.After(2 * time.Second)
+	for {
+		isZombie, _, _ := router.IsZombieEdge(chanAnn.ShortChannelID)
+		if !isZombie {
+			break
+		}
+
+		select {
+		case <-timeout:
+			t.Fatal("expected edge to be removed from zombie index")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	// Now that the edge is live, its announcement should be accepted, and
+	// the stashed update processed along with it.
+	select {
+	case err = <-ctx.gossiper.ProcessRemoteAnnouncement(chanAnn, remotePeer):
+	case <-time.After(2 * time.Second):
+		t.Fatal("did not process remote announcement")
+	}
+	if err != nil {
+		t.Fatalf("unable to process announcement: %v", err)
+	}
+
+	select {
+	case err = <-updErr:
+	case <-time.After(2 * time.Second):
+		t.Fatal("did not process remote announcement")
+	}
+	if err != nil {
+		t.Fatalf("unable to process update: %v", err)
+	}
+}
+
 // TestExtraDataNodeAnnouncementValidation tests that we're able to properly
 // validate a NodeAnnouncement that includes opaque bytes that we don't
 // currently know of.
@@ -2453,6 +2611,9 @@ func (p *mockPeer) PubKey() [33]byte {
 	return pubkey
 }
 func (p *mockPeer) Address() net.Addr { return nil }
+func (p *mockPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
 func (p *mockPeer) QuitSignal() <-chan struct{} {
 	return p.quit
 }
@@ -0,0 +1,769 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// maxRemoteFundingTxAdditions is the maximum number of inputs, as well
+	// as the maximum number of outputs, the remote party may add to the
+	// funding transaction of a dual funded channel.
+	maxRemoteFundingTxAdditions = 64
+)
+
+var (
+	// errDualFundingBroadcast is returned to the initiator of a dual
+	// funded channel whose funding transaction was rejected, as one of
+	// its inputs has already been spent.
+	errDualFundingBroadcast = errors.New("funding transaction double " +
+		"spends its inputs")
+)
+
+// txAddInputMsg couples an lnwire.TxAddInput message with the peer who sent
+// the message. This allows the funding manager to queue a response directly
+// to the peer, progressing the funding workflow.
+type txAddInputMsg struct {
+	msg  *lnwire.TxAddInput
+	peer lnpeer.Peer
+}
+
+// txAddOutputMsg couples an lnwire.TxAddOutput message with the peer who sent
+// the message. This allows the funding manager to queue a response directly
+// to the peer, progressing the funding workflow.
+type txAddOutputMsg struct {
+	msg  *lnwire.TxAddOutput
+	peer lnpeer.Peer
+}
+
+// txCompleteMsg couples an lnwire.TxComplete message with the peer who sent
+// the message. This allows the funding manager to queue a response directly
+// to the peer, progressing the funding workflow.
+type txCompleteMsg struct {
+	msg  *lnwire.TxComplete
+	peer lnpeer.Peer
+}
+
+// txSignaturesMsg couples an lnwire.TxSignatures message with the peer who
+// sent the message. This allows the funding manager to queue a response
+// directly to the peer, progressing the funding workflow.
+type txSignaturesMsg struct {
+	msg  *lnwire.TxSignatures
+	peer lnpeer.Peer
+}
+
+// processTxAddInput sends a message to the fundingManager allowing it to add
+// an input of the remote party to the funding transaction of a dual funded
+// channel.
+func (f *fundingManager) processTxAddInput(msg *lnwire.TxAddInput,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &txAddInputMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// processTxAddOutput sends a message to the fundingManager allowing it to add
+// an output of the remote party to the funding transaction of a dual funded
+// channel.
+func (f *fundingManager) processTxAddOutput(msg *lnwire.TxAddOutput,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &txAddOutputMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// processTxComplete sends a message to the fundingManager signalling that the
+// remote party has completed its contribution to the funding transaction of a
+// dual funded channel.
+func (f *fundingManager) processTxComplete(msg *lnwire.TxComplete,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &txCompleteMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// processTxSignatures sends a message to the fundingManager carrying the
+// remote party's signatures for its inputs to the funding transaction of a
+// dual funded channel.
+func (f *fundingManager) processTxSignatures(msg *lnwire.TxSignatures,
+	peer lnpeer.Peer) {
+
+	select {
+	case f.fundingMsgs <- &txSignaturesMsg{msg, peer}:
+	case <-f.quit:
+		return
+	}
+}
+
+// sendTxContribution sends our inputs and outputs to the funding transaction
+// of a dual funded channel to the remote peer, followed by the amount of
+// funds we contribute to the channel. Along with each input, the output it
+// spends is sent, such that the remote party is able to verify the amount we
+// contribute.
+func (f *fundingManager) sendTxContribution(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, fundingAmt btcutil.Amount) error {
+
+	ourContribution := resCtx.reservation.OurContribution()
+
+	msgs := make([]lnwire.Message, 0, len(ourContribution.Inputs)+
+		len(ourContribution.ChangeOutputs)+1)
+	for _, txIn := range ourContribution.Inputs {
+		prevOut, err := f.cfg.Wallet.FetchInputInfo(
+			&txIn.PreviousOutPoint,
+		)
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, &lnwire.TxAddInput{
+			PendingChannelID: pendingChanID,
+			PreviousOutPoint: txIn.PreviousOutPoint,
+			Sequence:         txIn.Sequence,
+			Value:            btcutil.Amount(prevOut.Value),
+			PkScript:         prevOut.PkScript,
+		})
+	}
+	for _, txOut := range ourContribution.ChangeOutputs {
+		msgs = append(msgs, &lnwire.TxAddOutput{
+			PendingChannelID: pendingChanID,
+			Value:            btcutil.Amount(txOut.Value),
+			PkScript:         txOut.PkScript,
+		})
+	}
+	msgs = append(msgs, &lnwire.TxComplete{
+		PendingChannelID: pendingChanID,
+		FundingAmount:    fundingAmt,
+	})
+
+	for _, msg := range msgs {
+		if err := resCtx.peer.SendMessage(false, msg); err != nil {
+			return err
+		}
+	}
+
+	resCtx.sentTxComplete = true
+
+	return nil
+}
+
+// addRemoteInput adds an input of the remote party to its contribution to the
+// funding transaction.
+func (r *reservationWithCtx) addRemoteInput(msg *lnwire.TxAddInput) error {
+	if err := r.canAddToFundingTx(); err != nil {
+		return err
+	}
+
+	contribution := r.remoteContribution
+	if len(contribution.Inputs) >= maxRemoteFundingTxAdditions {
+		return fmt.Errorf("remote party added more than %v inputs",
+			maxRemoteFundingTxAdditions)
+	}
+
+	// Each outpoint may only be spent once within the funding transaction.
+	ourInputs := r.reservation.OurContribution().Inputs
+	for _, inputs := range [][]*wire.TxIn{ourInputs, contribution.Inputs} {
+		for _, txIn := range inputs {
+			if txIn.PreviousOutPoint == msg.PreviousOutPoint {
+				return fmt.Errorf("input %v already added",
+					msg.PreviousOutPoint)
+			}
+		}
+	}
+
+	// We only accept P2WKH inputs, as the fees the remote party must pay
+	// for its inputs are based on their witness size.
+	scriptClass := txscript.GetScriptClass(msg.PkScript)
+	if scriptClass != txscript.WitnessV0PubKeyHashTy {
+		return fmt.Errorf("input %v isn't p2wkh", msg.PreviousOutPoint)
+	}
+
+	txIn := wire.NewTxIn(&msg.PreviousOutPoint, nil, nil)
+	txIn.Sequence = msg.Sequence
+	contribution.Inputs = append(contribution.Inputs, txIn)
+	r.remotePrevOuts = append(r.remotePrevOuts, &wire.TxOut{
+		Value:    int64(msg.Value),
+		PkScript: msg.PkScript,
+	})
+
+	return nil
+}
+
+// verifyRemoteContribution checks that the inputs the remote party added to
+// the funding transaction are unspent outputs matching the values and scripts
+// it claimed, and that they cover the funds it adds to the channel, its change
+// outputs, and the fees for its part of the transaction at the minimum fee
+// rate. If the remote party is the initiator, it also pays for the funding
+// output.
+//
+// NOTE: The remote inputs must be P2WKH, which is enforced as they're added.
+func (f *fundingManager) verifyRemoteContribution(resCtx *reservationWithCtx,
+	fundingAmt btcutil.Amount, remoteInitiator bool) error {
+
+	contribution := resCtx.remoteContribution
+
+	var (
+		weightEstimate lnwallet.TxWeightEstimator
+		totalIn        btcutil.Amount
+		totalOut       = fundingAmt
+	)
+	for i, txIn := range contribution.Inputs {
+		op := txIn.PreviousOutPoint
+		claimed := resCtx.remotePrevOuts[i]
+
+		utxo, err := f.cfg.Wallet.Cfg.ChainIO.GetUtxo(
+			&op, claimed.PkScript, 0,
+		)
+		switch {
+		case err != nil:
+			return fmt.Errorf("unable to fetch input %v: %v", op,
+				err)
+
+		case utxo == nil:
+			return fmt.Errorf("input %v is spent or unknown", op)
+
+		case utxo.Value != claimed.Value ||
+			!bytes.Equal(utxo.PkScript, claimed.PkScript):
+
+			return fmt.Errorf("input %v doesn't match the claimed "+
+				"output", op)
+		}
+
+		totalIn += btcutil.Amount(utxo.Value)
+		weightEstimate.AddP2WKHInput()
+	}
+	for _, txOut := range contribution.ChangeOutputs {
+		totalOut += btcutil.Amount(txOut.Value)
+		weightEstimate.AddP2WKHOutput()
+	}
+
+	// The initiator always funds the channel, so it pays for the funding
+	// output.
+	if remoteInitiator {
+		weightEstimate.AddP2WSHOutput()
+	}
+
+	// A responder that doesn't add anything to the transaction doesn't
+	// pay any fees either.
+	var fee btcutil.Amount
+	if remoteInitiator || len(contribution.Inputs) > 0 ||
+		len(contribution.ChangeOutputs) > 0 {
+
+		fee = lnwallet.FeePerKwFloor.FeeForWeight(
+			int64(weightEstimate.Weight()),
+		)
+	}
+	if totalIn < totalOut+fee {
+		return fmt.Errorf("remote inputs of %v don't cover the "+
+			"contribution of %v and the fee of %v", totalIn,
+			totalOut, fee)
+	}
+
+	return nil
+}
+
+// addRemoteOutput adds an output of the remote party to its contribution to
+// the funding transaction.
+func (r *reservationWithCtx) addRemoteOutput(msg *lnwire.TxAddOutput) error {
+	if err := r.canAddToFundingTx(); err != nil {
+		return err
+	}
+
+	contribution := r.remoteContribution
+	if len(contribution.ChangeOutputs) >= maxRemoteFundingTxAdditions {
+		return fmt.Errorf("remote party added more than %v outputs",
+			maxRemoteFundingTxAdditions)
+	}
+
+	if msg.Value <= lnwallet.DefaultDustLimit() {
+		return fmt.Errorf("output value %v is below the dust limit",
+			msg.Value)
+	}
+
+	contribution.ChangeOutputs = append(
+		contribution.ChangeOutputs, &wire.TxOut{
+			Value:    int64(msg.Value),
+			PkScript: msg.PkScript,
+		},
+	)
+
+	return nil
+}
+
+// canAddToFundingTx returns an error if the remote party isn't allowed to add
+// inputs or outputs to the funding transaction of the reservation.
+func (r *reservationWithCtx) canAddToFundingTx() error {
+	switch {
+	case r.remoteContribution == nil:
+		return fmt.Errorf("funding transaction isn't constructed " +
+			"interactively")
+
+	case r.recvTxComplete:
+		return fmt.Errorf("remote contribution to funding " +
+			"transaction already completed")
+	}
+
+	return nil
+}
+
+// handleTxAddInput adds an input of the remote party to the funding
+// transaction of a dual funded channel.
+func (f *fundingManager) handleTxAddInput(fmsg *txAddInputMsg) {
+	pendingChanID := fmsg.msg.PendingChannelID
+	peerKey := fmsg.peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the txAddInputMsg has been handled.
+	defer resCtx.updateTimestamp()
+
+	if err := resCtx.addRemoteInput(fmsg.msg); err != nil {
+		fndgLog.Errorf("Unable to add input to funding tx of "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+	}
+}
+
+// handleTxAddOutput adds an output of the remote party to the funding
+// transaction of a dual funded channel.
+func (f *fundingManager) handleTxAddOutput(fmsg *txAddOutputMsg) {
+	pendingChanID := fmsg.msg.PendingChannelID
+	peerKey := fmsg.peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the txAddOutputMsg has been handled.
+	defer resCtx.updateTimestamp()
+
+	if err := resCtx.addRemoteOutput(fmsg.msg); err != nil {
+		fndgLog.Errorf("Unable to add output to funding tx of "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+	}
+}
+
+// handleTxComplete processes the completed contribution of the remote party
+// to the funding transaction of a dual funded channel. If we're the
+// responder, we'll add our own funds to the channel and send over our
+// contribution in turn. If we're the initiator, both contributions are now
+// known, so we're able to create the funding transaction and send our
+// signature for the remote party's commitment transaction.
+func (f *fundingManager) handleTxComplete(fmsg *txCompleteMsg) {
+	msg := fmsg.msg
+	pendingChanID := msg.PendingChannelID
+	peerKey := fmsg.peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the txCompleteMsg has been handled.
+	defer resCtx.updateTimestamp()
+
+	if err := resCtx.canAddToFundingTx(); err != nil {
+		fndgLog.Errorf("Unexpected TxComplete for pendingID(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+	resCtx.recvTxComplete = true
+
+	fndgLog.Infof("Remote party contributes %v to pendingID(%x) with "+
+		"%v inputs and %v outputs", msg.FundingAmount, pendingChanID[:],
+		len(resCtx.remoteContribution.Inputs),
+		len(resCtx.remoteContribution.ChangeOutputs))
+
+	// If we haven't sent our contribution yet, then we're the responder,
+	// and the initiator has just proposed the funding transaction.
+	if !resCtx.sentTxComplete {
+		f.respondTxContribution(resCtx, pendingChanID, msg)
+		return
+	}
+
+	// Otherwise, the responder has completed its contribution in turn, so
+	// we'll account for the funds it adds to the channel.
+	if resCtx.chanAmt+msg.FundingAmount > f.maxChanSize(resCtx.peer) {
+		f.failFundingFlow(
+			fmsg.peer, pendingChanID, lnwire.ErrChanTooLarge,
+		)
+		return
+	}
+	err = f.verifyRemoteContribution(resCtx, msg.FundingAmount, false)
+	if err != nil {
+		fndgLog.Errorf("Invalid contribution to pendingID(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+	err = resCtx.reservation.AddRemoteFunding(msg.FundingAmount)
+	if err != nil {
+		fndgLog.Errorf("Unable to add remote funding to "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	resCtx.remoteContribution.FundingAmount = msg.FundingAmount
+	err = resCtx.reservation.ProcessContribution(resCtx.remoteContribution)
+	if err != nil {
+		fndgLog.Errorf("Unable to process contribution from %v: %v",
+			peerKey, err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	f.sendFundingCreated(resCtx, pendingChanID)
+}
+
+// respondTxContribution adds our own funds to a dual funded channel we're the
+// responder of, then creates the funding transaction from both contributions
+// and sends our contribution to the initiator.
+func (f *fundingManager) respondTxContribution(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, msg *lnwire.TxComplete) {
+
+	// The initiator funds the full capacity it proposed within the
+	// OpenChannel message.
+	if msg.FundingAmount != resCtx.chanAmt {
+		err := fmt.Errorf("initiator contributes %v, expected %v",
+			msg.FundingAmount, resCtx.chanAmt)
+		fndgLog.Errorf("Invalid contribution to pendingID(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+	err := f.verifyRemoteContribution(resCtx, msg.FundingAmount, true)
+	if err != nil {
+		fndgLog.Errorf("Invalid contribution to pendingID(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	// We'll attempt to add our own funds to the channel. If we're unable
+	// to, the channel can still be opened with the initiator's funds only.
+	amt := f.dualFundContribution(resCtx.peer, resCtx.chanAmt)
+	if amt > 0 {
+		feePerKw, err := f.cfg.FeeEstimator.EstimateFeePerKW(6)
+		if err == nil {
+			err = resCtx.reservation.AddFunding(amt, feePerKw, 1)
+		}
+		if err != nil {
+			fndgLog.Warnf("Unable to contribute %v to "+
+				"pendingID(%x): %v", amt, pendingChanID[:], err)
+			amt = 0
+		}
+	}
+
+	err = resCtx.reservation.ProcessContribution(resCtx.remoteContribution)
+	if err != nil {
+		fndgLog.Errorf("Unable to process contribution from %v: %v",
+			resCtx.peer.IdentityKey(), err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return
+	}
+
+	fndgLog.Infof("Contributing %v to pendingID(%x)", amt,
+		pendingChanID[:])
+
+	if err := f.sendTxContribution(resCtx, pendingChanID, amt); err != nil {
+		fndgLog.Errorf("Unable to send funding contribution for "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+	}
+}
+
+// dualFundingEnabled returns whether both we and the passed peer are able to
+// construct the funding transaction of a channel interactively.
+func (f *fundingManager) dualFundingEnabled(peer lnpeer.Peer) bool {
+	remoteFeatures := peer.RemoteLocalFeatures()
+	return f.cfg.DualFunding && remoteFeatures != nil &&
+		remoteFeatures.HasFeature(lnwire.DualFundOptional)
+}
+
+// dualFundContribution returns the amount of funds we'll add to a dual funded
+// channel initiated by the remote peer with the given capacity.
+func (f *fundingManager) dualFundContribution(peer lnpeer.Peer,
+	capacity btcutil.Amount) btcutil.Amount {
+
+	amt := f.cfg.MaxDualFundContribution
+	if amt > capacity {
+		amt = capacity
+	}
+	if maxChanSize := f.maxChanSize(peer); capacity+amt > maxChanSize {
+		amt = maxChanSize - capacity
+	}
+	if amt < 0 {
+		return 0
+	}
+
+	return amt
+}
+
+// handleDualFundingCreated progresses the funding workflow of a dual funded
+// channel we're the responder of. Once the initiator's signature for our
+// commitment transaction has been verified, we send our signature for its
+// commitment transaction, along with the signatures for our inputs to the
+// funding transaction.
+func (f *fundingManager) handleDualFundingCreated(resCtx *reservationWithCtx,
+	fmsg *fundingCreatedMsg) {
+
+	pendingChanID := fmsg.msg.PendingChannelID
+
+	// As we've crafted the funding transaction ourselves, the initiator
+	// must have arrived at the same funding outpoint.
+	fundingPoint := *resCtx.reservation.FundingOutpoint()
+	if fmsg.msg.FundingPoint != fundingPoint {
+		err := fmt.Errorf("funding outpoint mismatch: expected %v, "+
+			"got %v", fundingPoint, fmsg.msg.FundingPoint)
+		fndgLog.Errorf("Invalid FundingCreated for pendingID(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	// Before handing out the signatures for our inputs, we must make sure
+	// we're able to claim our funds from the channel.
+	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
+	if err := resCtx.reservation.VerifyCommitSig(commitSig); err != nil {
+		fndgLog.Errorf("Unable to verify commitment signature for "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+	resCtx.remoteCommitSig = commitSig
+
+	// A new channel has almost finished the funding process. In order to
+	// properly synchronize with the writeHandler goroutine, we add a new
+	// channel to the barriers map which will be closed once the channel is
+	// fully open.
+	f.barrierMtx.Lock()
+	channelID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+	fndgLog.Debugf("Creating chan barrier for ChanID(%v)", channelID)
+	f.newChanBarriers[channelID] = make(chan struct{})
+	f.barrierMtx.Unlock()
+
+	fndgLog.Infof("sending FundingSigned and TxSignatures for "+
+		"pendingID(%x) over ChannelPoint(%v)", pendingChanID[:],
+		fundingPoint)
+
+	_, sig := resCtx.reservation.OurSignatures()
+	ourCommitSig, err := lnwire.NewSigFromRawSignature(sig)
+	if err != nil {
+		fndgLog.Errorf("unable to parse signature: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	fundingSigned := &lnwire.FundingSigned{
+		ChanID:    channelID,
+		CommitSig: ourCommitSig,
+	}
+	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
+		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	err = sendTxSignatures(fmsg.peer, pendingChanID, resCtx.reservation)
+	if err != nil {
+		fndgLog.Errorf("unable to send TxSignatures message: %v", err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+}
+
+// sendTxSignatures sends the signatures for our inputs to the funding
+// transaction of the reservation to the remote peer.
+func sendTxSignatures(peer lnpeer.Peer, pendingChanID [32]byte,
+	reservation *lnwallet.ChannelReservation) error {
+
+	inputScripts, _ := reservation.OurSignatures()
+	witnesses := make([]lnwire.InputWitness, len(inputScripts))
+	for i, inputScript := range inputScripts {
+		witnesses[i] = lnwire.InputWitness{
+			SigScript: inputScript.ScriptSig,
+			Witness:   inputScript.Witness,
+		}
+	}
+
+	return peer.SendMessage(false, &lnwire.TxSignatures{
+		PendingChannelID: pendingChanID,
+		TxHash:           reservation.FundingOutpoint().Hash,
+		Witnesses:        witnesses,
+	})
+}
+
+// handleTxSignatures processes the remote party's signatures for its inputs
+// to the funding transaction of a dual funded channel. With these, the
+// funding transaction is complete, so the reservation is committed to disk,
+// and the funding transaction is broadcast. If we're the initiator, we'll
+// first send the signatures for our own inputs to the responder.
+func (f *fundingManager) handleTxSignatures(fmsg *txSignaturesMsg) {
+	msg := fmsg.msg
+	pendingChanID := msg.PendingChannelID
+	peerKey := fmsg.peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil {
+		fndgLog.Warnf("Can't find reservation (peerKey:%v, chanID:%x)",
+			peerKey, pendingChanID[:])
+		return
+	}
+
+	fundingPoint := resCtx.reservation.FundingOutpoint()
+	switch {
+	case resCtx.remoteCommitSig == nil:
+		err = fmt.Errorf("commitment signature not yet received")
+
+	case msg.TxHash != fundingPoint.Hash:
+		err = fmt.Errorf("funding txid mismatch: expected %v, got %v",
+			fundingPoint.Hash, msg.TxHash)
+	}
+	if err != nil {
+		fndgLog.Errorf("Unexpected TxSignatures for pendingID(%x): %v",
+			pendingChanID[:], err)
+		f.failFundingFlow(fmsg.peer, pendingChanID, err)
+		return
+	}
+
+	inputScripts := make([]*lnwallet.InputScript, len(msg.Witnesses))
+	for i, witness := range msg.Witnesses {
+		inputScripts[i] = &lnwallet.InputScript{
+			Witness:   witness.Witness,
+			ScriptSig: witness.SigScript,
+		}
+	}
+
+	completeChan, err := f.completeReservation(
+		resCtx, pendingChanID, inputScripts, resCtx.remoteCommitSig,
+	)
+	if err != nil {
+		return
+	}
+
+	// If we're the responder, our signatures were already sent along
+	// with FundingSigned, so we only need to wait for the funding
+	// transaction to confirm. We'll broadcast it as well, as we're able
+	// to.
+	if !completeChan.IsInitiator {
+		if err := f.publishDualFundingTx(completeChan); err != nil {
+			f.abandonDualFundedChannel(resCtx, completeChan, err)
+			return
+		}
+		f.watchRespondedChannel(fmsg.peer, pendingChanID, completeChan)
+		return
+	}
+
+	// Otherwise, the responder still needs our signatures. If we're unable
+	// to send them, then the funding transaction can't be broadcast, so
+	// we'll abandon the channel.
+	err = sendTxSignatures(fmsg.peer, pendingChanID, resCtx.reservation)
+	if err != nil {
+		fndgLog.Errorf("Unable to send TxSignatures for "+
+			"pendingID(%x): %v", pendingChanID[:], err)
+		f.abandonDualFundedChannel(resCtx, completeChan, err)
+		return
+	}
+
+	if err := f.publishDualFundingTx(completeChan); err != nil {
+		f.abandonDualFundedChannel(resCtx, completeChan, err)
+		return
+	}
+	f.watchPendingChannel(resCtx, pendingChanID, completeChan)
+}
+
+// publishDualFundingTx broadcasts the funding transaction of a dual funded
+// channel. As the remote party is able to double spend its inputs to the
+// transaction, the channel can't be watched for in the same way as channels we
+// funded ourselves. If the transaction is rejected because one of its inputs
+// is already spent, an error is returned, such that the channel can be
+// abandoned. Any other failure is only logged, as the transaction may still
+// confirm after being broadcast by the remote party.
+func (f *fundingManager) publishDualFundingTx(
+	completeChan *channeldb.OpenChannel) error {
+
+	fundingTx := completeChan.FundingTxn
+	fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
+		completeChan.FundingOutpoint, spew.Sdump(fundingTx))
+
+	err := f.cfg.PublishTransaction(fundingTx)
+	switch {
+	case err == lnwallet.ErrDoubleSpend:
+		fndgLog.Errorf("Funding tx for ChannelPoint(%v) double spends "+
+			"its inputs", completeChan.FundingOutpoint)
+		return errDualFundingBroadcast
+
+	case err != nil:
+		fndgLog.Errorf("Unable to broadcast funding tx for "+
+			"ChannelPoint(%v): %v", completeChan.FundingOutpoint,
+			err)
+	}
+
+	return nil
+}
+
+// abandonDualFundedChannel abandons a dual funded channel whose funding
+// transaction will never confirm, releasing our inputs to it. The remote party
+// is notified of the failure, as well as the caller if we're the initiator.
+func (f *fundingManager) abandonDualFundedChannel(resCtx *reservationWithCtx,
+	completeChan *channeldb.OpenChannel, fundingErr error) {
+
+	f.abandonPendingChannel(completeChan)
+	if err := f.cfg.Wallet.ReleaseInputs(completeChan.FundingTxn); err != nil {
+		fndgLog.Errorf("Unable to release inputs of funding tx: %v",
+			err)
+	}
+
+	// Any message for the channel that was waiting for it to be fully
+	// opened can now proceed, only to find it doesn't exist.
+	chanID := lnwire.NewChanIDFromOutPoint(&completeChan.FundingOutpoint)
+	f.barrierMtx.Lock()
+	if chanBarrier, ok := f.newChanBarriers[chanID]; ok {
+		close(chanBarrier)
+		delete(f.newChanBarriers, chanID)
+	}
+	f.barrierMtx.Unlock()
+
+	errMsg := &lnwire.Error{
+		ChanID: chanID,
+		Data:   lnwire.ErrorData(fundingErr.Error()),
+	}
+	if err := resCtx.peer.SendMessage(false, errMsg); err != nil {
+		fndgLog.Errorf("Unable to send error message to peer: %v", err)
+	}
+
+	if completeChan.IsInitiator {
+		resCtx.err <- fundingErr
+	}
+}
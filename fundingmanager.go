@@ -10,12 +10,14 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -23,6 +25,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/psbt"
 	"github.com/lightningnetwork/lnd/routing"
 	"golang.org/x/crypto/salsa20"
 	"google.golang.org/grpc"
@@ -66,6 +69,17 @@ const (
 	// currently accepted on the Litecoin chain within the Lightning
 	// Protocol.
 	maxLtcFundingAmount = maxBtcFundingAmount * btcToLtcConversionRate
+
+	// maxBtcFundingAmountWumbo is the maximum channel size on the Bitcoin
+	// chain we'll open or accept with peers that signal support for
+	// channels above the soft-limit of BOLT-0002.
+	maxBtcFundingAmountWumbo = btcutil.Amount(1000000000)
+
+	// maxLtcFundingAmountWumbo is the maximum channel size on the Litecoin
+	// chain we'll open or accept with peers that signal support for
+	// channels above the soft-limit of BOLT-0002.
+	maxLtcFundingAmountWumbo = maxBtcFundingAmountWumbo *
+		btcToLtcConversionRate
 )
 
 var (
@@ -80,6 +94,11 @@ var (
 	// TODO(roasbeef): add command line param to modify
 	maxFundingAmount = maxBtcFundingAmount
 
+	// maxWumboFundingAmount is the maximum channel size we'll open or
+	// accept with peers that, like us, signal support for channels above
+	// maxFundingAmount. This value also depends on which chain is active.
+	maxWumboFundingAmount = maxBtcFundingAmountWumbo
+
 	// ErrFundingManagerShuttingDown is an error returned when attempting to
 	// process a funding request/message but the funding manager has already
 	// been signaled to shut down.
@@ -103,8 +122,42 @@ type reservationWithCtx struct {
 	chanAmt btcutil.Amount
 
 	// Constraints we require for the remote.
-	remoteCsvDelay uint16
-	remoteMinHtlc  lnwire.MilliSatoshi
+	remoteCsvDelay    uint16
+	remoteMinHtlc     lnwire.MilliSatoshi
+	remoteChanReserve btcutil.Amount
+	remoteMaxValue    lnwire.MilliSatoshi
+	remoteMaxHtlcs    uint16
+
+	// zeroConf is true if we asked the remote party to consider the
+	// channel open before its funding transaction confirms.
+	zeroConf bool
+
+	// batch is the batch of channels this channel is funded with, if any.
+	// It's only set once the funding transaction of the batch is known.
+	batch *fundingBatch
+
+	// remoteContribution is the contribution of the remote party to a
+	// channel whose funding transaction may be constructed interactively.
+	// The inputs and outputs the remote party adds to the funding
+	// transaction are collected within it until it sends TxComplete.
+	remoteContribution *lnwallet.ChannelContribution
+
+	// remotePrevOuts are the outputs spent by the inputs within
+	// remoteContribution, as claimed by the remote party. They're checked
+	// against the UTXO set before we sign anything for the channel.
+	remotePrevOuts []*wire.TxOut
+
+	// sentTxComplete and recvTxComplete denote whether we and the remote
+	// party respectively have completed our contributions to the funding
+	// transaction of a dual funded channel.
+	sentTxComplete bool
+	recvTxComplete bool
+
+	// remoteCommitSig is the remote party's signature for our version of
+	// the commitment transaction of a dual funded channel. It's only used
+	// to complete the reservation once the remote party has also sent the
+	// signatures for its inputs to the funding transaction.
+	remoteCommitSig []byte
 
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
@@ -157,6 +210,14 @@ type fundingOpenMsg struct {
 	peer lnpeer.Peer
 }
 
+// acceptorDecisionMsg carries the decision of the channel acceptors on an
+// inbound channel request back to the reservationCoordinator.
+type acceptorDecisionMsg struct {
+	fmsg     *fundingOpenMsg
+	zeroConf bool
+	resp     *chanacceptor.ChannelAcceptResponse
+}
+
 // fundingAcceptMsg couples an lnwire.AcceptChannel message with the peer who
 // sent the message. This allows the funding manager to queue a response
 // directly to the peer, progressing the funding workflow.
@@ -196,6 +257,15 @@ type fundingErrorMsg struct {
 	peerKey *btcec.PublicKey
 }
 
+// fundingPsbtMsg couples the fully signed PSBT funding a pending channel with
+// the channel's pending ID. This allows the funding manager to resume the
+// funding workflow of a channel that is funded by an external wallet.
+type fundingPsbtMsg struct {
+	pendingChanID [32]byte
+	packet        *psbt.Packet
+	err           chan error
+}
+
 // pendingChannels is a map instantiated per-peer which tracks all active
 // pending single funded channels indexed by their pending channel identifier,
 // which is a set of 32-bytes generated via a CSPRNG.
@@ -332,6 +402,47 @@ type fundingConfig struct {
 	// flood us with very small channels that would never really be usable
 	// due to fees.
 	MinChanSize btcutil.Amount
+
+	// DualFunding indicates whether we'll construct the funding
+	// transactions of channels interactively with peers that signal
+	// support for it, allowing both parties to contribute funds.
+	DualFunding bool
+
+	// MaxDualFundContribution is the maximum amount of funds we'll add to
+	// a channel initiated by a remote peer that constructs the funding
+	// transaction interactively. We'll match the funds of the initiator up
+	// to this amount, such that both parties start out with about the
+	// same balance. If zero, we won't contribute any funds.
+	MaxDualFundContribution btcutil.Amount
+
+	// WumboChannels indicates whether we'll open and accept channels
+	// larger than maxFundingAmount with peers that signal support for
+	// them.
+	WumboChannels bool
+
+	// MaxChanSize is the largest channel size that we'll accept as an
+	// inbound channel.
+	MaxChanSize btcutil.Amount
+
+	// OpenChannelPredicate is a predicate on the inbound channel requests
+	// of remote peers, which decides whether a channel is accepted after
+	// it passed our own checks.
+	OpenChannelPredicate chanacceptor.ChannelAcceptor
+
+	// AllowZeroConf returns true if we trust the given peer to open
+	// zero-conf channels with us, which we consider open before their
+	// funding transaction has confirmed.
+	AllowZeroConf func(*btcec.PublicKey) bool
+
+	// DeleteAliasEdge removes the edge of a zero-conf channel from the
+	// channel graph, if it's still identified by its alias short channel
+	// ID.
+	DeleteAliasEdge func(*wire.OutPoint) error
+
+	// FailZeroConfChannel tears down a zero-conf channel with the given
+	// funding outpoint within all sub-systems, once its funding
+	// transaction has been double spent.
+	FailZeroConfChannel func(wire.OutPoint) error
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -376,6 +487,11 @@ type fundingManager struct {
 	// goroutine safe.
 	resMtx sync.RWMutex
 
+	// pendingAcceptance counts the inbound channel requests of each peer
+	// that are awaiting the decision of the channel acceptors. It's only
+	// accessed by the reservationCoordinator.
+	pendingAcceptance map[serializedPubKey]int
+
 	// fundingMsgs is a channel which receives wrapped wire messages
 	// related to funding workflow from outside peers.
 	fundingMsgs chan interface{}
@@ -449,6 +565,7 @@ func newFundingManager(cfg fundingConfig) (*fundingManager, error) {
 		chanIDKey:                   cfg.TempChanIDSeed,
 		activeReservations:          make(map[serializedPubKey]pendingChannels),
 		signedReservations:          make(map[lnwire.ChannelID][32]byte),
+		pendingAcceptance:           make(map[serializedPubKey]int),
 		newChanBarriers:             make(map[lnwire.ChannelID]chan struct{}),
 		fundingMsgs:                 make(chan interface{}, msgBufferSize),
 		fundingRequests:             make(chan *initFundingMsg, msgBufferSize),
@@ -494,13 +611,14 @@ func (f *fundingManager) Start() error {
 		f.localDiscoverySignals[chanID] = make(chan struct{})
 
 		// Rebroadcast the funding transaction for any pending channel
-		// that we initiated. If this operation fails due to a reported
-		// double spend, we treat this as an indicator that we have
-		// already broadcast this transaction. Otherwise, we simply log
-		// the error as there isn't anything we can currently do to
-		// recover.
-		if channel.ChanType == channeldb.SingleFunder &&
-			channel.IsInitiator {
+		// that we initiated or contributed to. If this operation fails
+		// due to a reported double spend, we treat this as an
+		// indicator that we have already broadcast this transaction.
+		// Otherwise, we simply log the error as there isn't anything
+		// we can currently do to recover.
+		if (channel.ChanType == channeldb.SingleFunder &&
+			channel.IsInitiator) ||
+			channel.ChanType == channeldb.DualFunder {
 
 			err := f.cfg.PublishTransaction(channel.FundingTxn)
 			if err != nil && err != lnwallet.ErrDoubleSpend {
@@ -510,6 +628,27 @@ func (f *fundingManager) Start() error {
 			}
 		}
 
+		// Zero-conf channels that weren't yet marked as open are opened
+		// right away, once the remote peer is online.
+		if channel.NumConfsRequired == 0 {
+			f.wg.Add(1)
+			go func(ch *channeldb.OpenChannel) {
+				defer f.wg.Done()
+
+				peerChan := make(chan lnpeer.Peer, 1)
+				f.cfg.NotifyWhenOnline(ch.IdentityPub, peerChan)
+
+				var peer lnpeer.Peer
+				select {
+				case peer = <-peerChan:
+				case <-f.quit:
+					return
+				}
+				f.openZeroConfChannel(peer, ch, nil)
+			}(channel)
+			continue
+		}
+
 		confChan := make(chan *lnwire.ShortChannelID)
 		timeoutChan := make(chan struct{})
 
@@ -611,6 +750,16 @@ func (f *fundingManager) Start() error {
 			f.barrierMtx.Unlock()
 		}
 
+		// Zero-conf channels that are still identified by their alias
+		// have yet to see their funding transaction confirm.
+		if shortChanID.IsAlias() {
+			f.wg.Add(1)
+			go f.resumeZeroConfChannel(
+				channel, channelState, shortChanID,
+			)
+			continue
+		}
+
 		// If we did find the channel in the opening state database, we
 		// have seen the funding transaction being confirmed, but we
 		// did not finish the rest of the setup procedure before we shut
@@ -882,6 +1031,8 @@ func (f *fundingManager) reservationCoordinator() {
 			switch fmsg := msg.(type) {
 			case *fundingOpenMsg:
 				f.handleFundingOpen(fmsg)
+			case *acceptorDecisionMsg:
+				f.handleAcceptorDecision(fmsg)
 			case *fundingAcceptMsg:
 				f.handleFundingAccept(fmsg)
 			case *fundingCreatedMsg:
@@ -893,6 +1044,20 @@ func (f *fundingManager) reservationCoordinator() {
 				go f.handleFundingLocked(fmsg)
 			case *fundingErrorMsg:
 				f.handleErrorMsg(fmsg)
+			case *fundingPsbtMsg:
+				f.handlePsbtFunding(fmsg)
+			case *fundingBatchMsg:
+				f.handleFundingBatch(fmsg)
+			case *fundingBatchAbortMsg:
+				f.handleFundingBatchAbort(fmsg)
+			case *txAddInputMsg:
+				f.handleTxAddInput(fmsg)
+			case *txAddOutputMsg:
+				f.handleTxAddOutput(fmsg)
+			case *txCompleteMsg:
+				f.handleTxComplete(fmsg)
+			case *txSignaturesMsg:
+				f.handleTxSignatures(fmsg)
 			}
 		case req := <-f.fundingRequests:
 			f.handleInitFundingMsg(req)
@@ -950,9 +1115,52 @@ func (f *fundingManager) processFundingOpen(msg *lnwire.OpenChannel,
 	}
 }
 
-// handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
-// then responds to the source peer with an accept channel message progressing
-// the funding workflow.
+// maxChanSizeLimit returns the largest channel size that can be opened on the
+// active chain, depending on whether channels above the soft-limit of
+// BOLT-0002 are enabled.
+func maxChanSizeLimit(wumbo bool) btcutil.Amount {
+	if wumbo {
+		return maxWumboFundingAmount
+	}
+
+	return maxFundingAmount
+}
+
+// maxChanSize returns the largest channel that can be opened with the given
+// peer. Channels above the soft-limit of BOLT-0002 are only permitted if both
+// we and the peer signal support for them.
+func (f *fundingManager) maxChanSize(peer lnpeer.Peer) btcutil.Amount {
+	remoteFeatures := peer.RemoteLocalFeatures()
+	wumbo := f.cfg.WumboChannels && remoteFeatures != nil &&
+		remoteFeatures.HasFeature(lnwire.WumboChannelsOptional)
+
+	return maxChanSizeLimit(wumbo)
+}
+
+// validateUpfrontShutdown ensures that an upfront shutdown script committed to
+// by the remote party is empty, or one of the standard script types we're able
+// to pay to within a cooperative close.
+func validateUpfrontShutdown(script lnwire.DeliveryAddress) error {
+	if len(script) == 0 {
+		return nil
+	}
+
+	switch txscript.GetScriptClass(script) {
+	case txscript.PubKeyHashTy, txscript.ScriptHashTy,
+		txscript.WitnessV0PubKeyHashTy, txscript.WitnessV0ScriptHashTy:
+
+		return nil
+
+	default:
+		return lnwallet.ErrNonStandardUpfrontShutdown(script)
+	}
+}
+
+// handleFundingOpen checks an inbound channel request against our policy, then
+// hands it to the channel acceptors. Once they accept the channel,
+// handleAcceptorDecision creates an initial 'ChannelReservation' within the
+// wallet, then responds to the source peer with an accept channel message
+// progressing the funding workflow.
 //
 // TODO(roasbeef): add error chan to all, let channelManager handle
 // error+propagate
@@ -967,11 +1175,13 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	amt := msg.FundingAmount
 
 	// We count the number of pending channels for this peer. This is the
-	// sum of the active reservations and the channels pending open in the
+	// sum of the active reservations, the requests awaiting the decision of
+	// the channel acceptors, and the channels pending open in the
 	// database.
 	f.resMtx.RLock()
 	numPending := len(f.activeReservations[peerIDKey])
 	f.resMtx.RUnlock()
+	numPending += f.pendingAcceptance[peerIDKey]
 
 	channels, err := f.cfg.Wallet.Cfg.Database.FetchOpenChannels(peerPubKey)
 	if err != nil {
@@ -1013,8 +1223,9 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	}
 
 	// We'll reject any request to create a channel that's above the
-	// current soft-limit for channel size.
-	if msg.FundingAmount > maxFundingAmount {
+	// current soft-limit for channel size, unless both of us signal
+	// support for larger channels.
+	if msg.FundingAmount > f.maxChanSize(fmsg.peer) {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwire.ErrChanTooLarge,
@@ -1022,6 +1233,16 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// We'll also reject any channel that's larger than the max channel
+	// size we're willing to accept.
+	if amt > f.cfg.MaxChanSize {
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			lnwallet.ErrChanTooLarge(amt, f.cfg.MaxChanSize),
+		)
+		return
+	}
+
 	// We'll, also ensure that the remote party isn't attempting to propose
 	// a channel that's below our current min channel size.
 	if amt < f.cfg.MinChanSize {
@@ -1032,6 +1253,83 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// Zero-conf channels must be explicitly requested through the
+	// channel type, and are only accepted from peers we trust not to
+	// double spend the funding transaction.
+	zeroConf := msg.ChannelType.IsSet(lnwire.ZeroConfRequired)
+	if zeroConf && !f.cfg.AllowZeroConf(peerPubKey) {
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			lnwallet.ErrZeroConfRejected(),
+		)
+		return
+	}
+
+	// Finally, we'll consult the channel acceptors, which may reject the
+	// channel based on its parameters. As an RPC acceptor may take a while
+	// to decide, we'll wait on the decision within its own goroutine, and
+	// continue the funding workflow once it's sent back to the
+	// reservationCoordinator.
+	chanReq := &chanacceptor.ChannelAcceptRequest{
+		Node:        peerPubKey,
+		OpenChanMsg: msg,
+	}
+	f.pendingAcceptance[peerIDKey]++
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		decision := &acceptorDecisionMsg{
+			fmsg:     fmsg,
+			zeroConf: zeroConf,
+			resp:     f.cfg.OpenChannelPredicate.Accept(chanReq),
+		}
+
+		select {
+		case f.fundingMsgs <- decision:
+		case <-f.quit:
+		}
+	}()
+}
+
+// handleAcceptorDecision continues the funding workflow of an inbound channel
+// request once the channel acceptors have decided on it. If the channel is
+// rejected, the reason for the rejection is sent to the remote peer.
+// Otherwise, a reservation is created for the channel, and our contribution
+// is sent to the remote peer.
+func (f *fundingManager) handleAcceptorDecision(dmsg *acceptorDecisionMsg) {
+	fmsg := dmsg.fmsg
+	peerPubKey := fmsg.peer.IdentityKey()
+	peerIDKey := newSerializedKey(peerPubKey)
+
+	f.pendingAcceptance[peerIDKey]--
+	if f.pendingAcceptance[peerIDKey] == 0 {
+		delete(f.pendingAcceptance, peerIDKey)
+	}
+
+	msg := fmsg.msg
+	amt := msg.FundingAmount
+	zeroConf := dmsg.zeroConf
+
+	if resp := dmsg.resp; !resp.Accept {
+		reason := resp.Error
+		if reason == "" {
+			reason = "channel rejected"
+		}
+
+		fndgLog.Infof("Channel request (pendingId=%x) from peer(%x) "+
+			"rejected by channel acceptor: %v",
+			msg.PendingChannelID, peerPubKey.SerializeCompressed(),
+			reason)
+
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			lnwallet.ErrChanRejected(reason),
+		)
+		return
+	}
+
 	fndgLog.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -1067,8 +1365,12 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// confirmations that we require before both of us consider the channel
 	// open. We'll use out mapping to derive the proper number of
 	// confirmations based on the amount of the channel, and also if any
-	// funds are being pushed to us.
+	// funds are being pushed to us. Zero-conf channels don't require any
+	// confirmations at all.
 	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+	if zeroConf {
+		numConfsReq = 0
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1102,12 +1404,15 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		f.activeReservations[peerIDKey] = make(pendingChannels)
 	}
 	resCtx := &reservationWithCtx{
-		reservation:    reservation,
-		chanAmt:        amt,
-		remoteCsvDelay: remoteCsvDelay,
-		remoteMinHtlc:  minHtlc,
-		err:            make(chan error, 1),
-		peer:           fmsg.peer,
+		reservation:       reservation,
+		chanAmt:           amt,
+		remoteCsvDelay:    remoteCsvDelay,
+		remoteMinHtlc:     minHtlc,
+		remoteChanReserve: chanReserve,
+		remoteMaxValue:    maxValue,
+		remoteMaxHtlcs:    maxHtlcs,
+		err:               make(chan error, 1),
+		peer:              fmsg.peer,
 	}
 	f.activeReservations[peerIDKey][msg.PendingChannelID] = resCtx
 	f.resMtx.Unlock()
@@ -1115,11 +1420,21 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// Update the timestamp once the fundingOpenMsg has been handled.
 	defer resCtx.updateTimestamp()
 
+	// If the initiator committed to an upfront shutdown script, we'll make
+	// sure it's one we'll be able to pay to in the cooperative close.
+	if err := validateUpfrontShutdown(msg.UpfrontShutdownScript); err != nil {
+		fndgLog.Errorf("Rejecting upfront shutdown script of "+
+			"pendingID(%x): %v", msg.PendingChannelID, err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
+
 	// With our parameters set, we'll now process their contribution so we
 	// can move the funding workflow ahead.
 	remoteContribution := &lnwallet.ChannelContribution{
 		FundingAmount:        amt,
 		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		UpfrontShutdown:      msg.UpfrontShutdownScript,
 		ChannelConfig: &channeldb.ChannelConfig{
 			ChannelConstraints: channeldb.ChannelConstraints{
 				DustLimit:        msg.DustLimit,
@@ -1153,6 +1468,15 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// If both of us are able to construct the funding transaction
+	// interactively, the initiator may go on to do so, in which case the
+	// inputs and outputs it adds are collected within a copy of its
+	// contribution.
+	if f.dualFundingEnabled(fmsg.peer) {
+		dualContribution := *remoteContribution
+		resCtx.remoteContribution = &dualContribution
+	}
+
 	fndgLog.Infof("Sending fundingResp for pendingID(%x)",
 		msg.PendingChannelID)
 	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
@@ -1162,20 +1486,29 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// contribution in the next message of the workflow.
 	ourContribution := reservation.OurContribution()
 	fundingAccept := lnwire.AcceptChannel{
-		PendingChannelID:     msg.PendingChannelID,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		MinAcceptDepth:       uint32(numConfsReq),
-		HtlcMinimum:          minHtlc,
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
+		PendingChannelID:      msg.PendingChannelID,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		MinAcceptDepth:        uint32(numConfsReq),
+		HtlcMinimum:           minHtlc,
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+	}
+
+	// If we agreed to a zero-conf channel, we'll echo the requested
+	// channel type back to the initiator. Any other channel type is
+	// unknown to us, so we'll leave it out, and the channel will be of
+	// the default type.
+	if zeroConf {
+		fundingAccept.ChannelType = msg.ChannelType
 	}
 	if err := fmsg.peer.SendMessage(false, &fundingAccept); err != nil {
 		fndgLog.Errorf("unable to send funding response to peer: %v", err)
@@ -1216,10 +1549,30 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 
 	fndgLog.Infof("Recv'd fundingResponse for pendingID(%x)", pendingChanID[:])
 
+	// If we asked for a zero-conf channel, then the responder must agree
+	// to the channel type and consider the channel open right away.
+	// Otherwise, we'll never skip the confirmation of the funding
+	// transaction.
+	minAcceptDepth := msg.MinAcceptDepth
+	switch {
+	case resCtx.zeroConf && (minAcceptDepth != 0 ||
+		!msg.ChannelType.IsSet(lnwire.ZeroConfRequired)):
+
+		err := fmt.Errorf("peer %x rejected zero-conf channel, "+
+			"requiring %v confirmations", peerKey.SerializeCompressed(),
+			minAcceptDepth)
+		fndgLog.Warnf(err.Error())
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+
+	case !resCtx.zeroConf && minAcceptDepth == 0:
+		minAcceptDepth = 1
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
-	resCtx.reservation.SetNumConfsRequired(uint16(msg.MinAcceptDepth))
+	resCtx.reservation.SetNumConfsRequired(uint16(minAcceptDepth))
 	err = resCtx.reservation.CommitConstraints(
 		msg.CsvDelay, msg.MaxAcceptedHTLCs, msg.MaxValueInFlight,
 		msg.HtlcMinimum, msg.ChannelReserve, msg.DustLimit,
@@ -1230,12 +1583,23 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
-	// As they've accepted our channel constraints, we'll regenerate them
-	// here so we can properly commit their accepted constraints to the
-	// reservation.
-	chanReserve := f.cfg.RequiredRemoteChanReserve(resCtx.chanAmt, msg.DustLimit)
-	maxValue := f.cfg.RequiredRemoteMaxValue(resCtx.chanAmt)
-	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(resCtx.chanAmt)
+	// As they've accepted our channel constraints, we'll commit them to
+	// the reservation. The reserve we require must be at least their dust
+	// limit, as required by BOLT #2.
+	chanReserve := resCtx.remoteChanReserve
+	if chanReserve < msg.DustLimit {
+		chanReserve = msg.DustLimit
+	}
+	maxValue := resCtx.remoteMaxValue
+	maxHtlcs := resCtx.remoteMaxHtlcs
+
+	// If the responder committed to an upfront shutdown script, we'll make
+	// sure it's one we'll be able to pay to in the cooperative close.
+	if err := validateUpfrontShutdown(msg.UpfrontShutdownScript); err != nil {
+		fndgLog.Warnf("Unacceptable upfront shutdown script: %v", err)
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+	}
 
 	// The remote node has responded with their portion of the channel
 	// contribution. At this point, we can process their contribution which
@@ -1243,6 +1607,7 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 	// the funding transaction.
 	remoteContribution := &lnwallet.ChannelContribution{
 		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		UpfrontShutdown:      msg.UpfrontShutdownScript,
 		ChannelConfig: &channeldb.ChannelConfig{
 			ChannelConstraints: channeldb.ChannelConstraints{
 				DustLimit:        msg.DustLimit,
@@ -1269,6 +1634,31 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 			},
 		},
 	}
+
+	fndgLog.Infof("pendingChan(%x): remote party proposes num_confs=%v, "+
+		"csv_delay=%v", pendingChanID[:], msg.MinAcceptDepth, msg.CsvDelay)
+	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
+		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
+
+	// If both of us are able to construct the funding transaction
+	// interactively, then we'll give the remote peer the chance to
+	// contribute funds to the channel as well. We'll send over our own
+	// inputs and outputs, and only process its contribution once it has
+	// completed it.
+	dualFund := f.dualFundingEnabled(fmsg.peer)
+	if dualFund && !resCtx.reservation.IsExternallyFunded() {
+		resCtx.remoteContribution = remoteContribution
+		err := f.sendTxContribution(
+			resCtx, pendingChanID, resCtx.chanAmt,
+		)
+		if err != nil {
+			fndgLog.Errorf("Unable to send funding contribution "+
+				"for pendingID(%x): %v", pendingChanID[:], err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		}
+		return
+	}
+
 	err = resCtx.reservation.ProcessContribution(remoteContribution)
 	if err != nil {
 		fndgLog.Errorf("Unable to process contribution from %v: %v",
@@ -1277,10 +1667,169 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
-	fndgLog.Infof("pendingChan(%x): remote party proposes num_confs=%v, "+
-		"csv_delay=%v", pendingChanID[:], msg.MinAcceptDepth, msg.CsvDelay)
-	fndgLog.Debugf("Remote party accepted commitment constraints: %v",
-		spew.Sdump(remoteContribution.ChannelConfig.ChannelConstraints))
+	// If the channel is funded by an external wallet, then the funding
+	// transaction can only be crafted now that the funding output is
+	// known. We'll hand the output to the caller, and resume the workflow
+	// once the signed PSBT has been supplied through ProcessPsbt.
+	if resCtx.reservation.IsExternallyFunded() {
+		if err := f.requestPsbtFunding(resCtx, pendingChanID); err != nil {
+			fndgLog.Errorf("Unable to request PSBT funding for "+
+				"pendingID(%x): %v", pendingChanID[:], err)
+			f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		}
+		return
+	}
+
+	f.sendFundingCreated(resCtx, pendingChanID)
+}
+
+// requestPsbtFunding sends an update to the caller of an externally funded
+// channel, describing the funding output its wallet must create.
+func (f *fundingManager) requestPsbtFunding(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) error {
+
+	fundingOutput, err := resCtx.reservation.FundingOutput()
+	if err != nil {
+		return err
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		fundingOutput.PkScript, &f.cfg.Wallet.Cfg.NetParams,
+	)
+	if err != nil {
+		return err
+	}
+
+	// The template only carries the funding output, leaving the selection
+	// of inputs and change to the external wallet.
+	templateTx := wire.NewMsgTx(2)
+	templateTx.AddTxOut(fundingOutput)
+	packet, err := psbt.NewFromUnsignedTx(templateTx)
+	if err != nil {
+		return err
+	}
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		return err
+	}
+
+	fndgLog.Infof("Waiting for PSBT funding of pendingID(%x) paying %v "+
+		"to %v", pendingChanID[:], btcutil.Amount(fundingOutput.Value),
+		addrs[0])
+
+	upd := &lnrpc.OpenStatusUpdate{
+		Update: &lnrpc.OpenStatusUpdate_PsbtFund{
+			PsbtFund: &lnrpc.ReadyForPsbtFunding{
+				FundingAddress: addrs[0].String(),
+				FundingAmount:  fundingOutput.Value,
+				Psbt:           b.Bytes(),
+				PendingChanId:  pendingChanID[:],
+			},
+		},
+	}
+
+	select {
+	case resCtx.updates <- upd:
+		return nil
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
+// ProcessPsbt supplies the fully signed PSBT funding the pending channel with
+// the given ID, resuming its funding workflow. All inputs of the PSBT must be
+// finalized and spend segwit outputs, as the txid of the funding transaction
+// must not be malleable once the remote party has signed our commitment.
+func (f *fundingManager) ProcessPsbt(pendingChanID [32]byte,
+	packet *psbt.Packet) error {
+
+	errChan := make(chan error, 1)
+	select {
+	case f.fundingMsgs <- &fundingPsbtMsg{pendingChanID, packet, errChan}:
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
+// handlePsbtFunding verifies the signed PSBT funding a pending channel, and
+// hands the final funding transaction to the wallet. If the transaction is
+// acceptable, the funding workflow resumes by sending the funding created
+// message to the remote peer. Otherwise, the reservation is left untouched,
+// allowing the caller to supply a corrected PSBT.
+func (f *fundingManager) handlePsbtFunding(fmsg *fundingPsbtMsg) {
+	pendingChanID := fmsg.pendingChanID
+
+	var resCtx *reservationWithCtx
+	f.resMtx.RLock()
+	for _, pendingChans := range f.activeReservations {
+		if ctx, ok := pendingChans[pendingChanID]; ok {
+			resCtx = ctx
+			break
+		}
+	}
+	f.resMtx.RUnlock()
+	if resCtx == nil {
+		fmsg.err <- fmt.Errorf("unknown pending channel %x",
+			pendingChanID[:])
+		return
+	}
+	if !resCtx.reservation.IsExternallyFunded() {
+		fmsg.err <- fmt.Errorf("pending channel %x isn't funded by "+
+			"PSBT", pendingChanID[:])
+		return
+	}
+
+	// Update the timestamp once the PSBT has been handled.
+	defer resCtx.updateTimestamp()
+
+	packet := fmsg.packet
+	if err := packet.SanityCheck(); err != nil {
+		fmsg.err <- err
+		return
+	}
+	for i, pInput := range packet.Inputs {
+		if !pInput.IsFinalized() {
+			fmsg.err <- fmt.Errorf("input %v of PSBT isn't "+
+				"finalized", i)
+			return
+		}
+		if len(pInput.FinalScriptWitness) == 0 {
+			fmsg.err <- fmt.Errorf("input %v of PSBT doesn't "+
+				"spend a segwit output", i)
+			return
+		}
+	}
+	fundingTx, err := psbt.Extract(packet)
+	if err != nil {
+		fmsg.err <- err
+		return
+	}
+
+	if err := resCtx.reservation.ProcessExternalFunding(fundingTx); err != nil {
+		fmsg.err <- err
+		return
+	}
+
+	fndgLog.Infof("Processed PSBT funding tx %v for pendingID(%x)",
+		fundingTx.TxHash(), pendingChanID[:])
+
+	// With the funding transaction accepted, we can let the caller know
+	// and resume the funding workflow.
+	fmsg.err <- nil
+	f.sendFundingCreated(resCtx, pendingChanID)
+}
+
+// sendFundingCreated sends the funding outpoint along with our signature for
+// the remote party's commitment transaction to the remote peer, once the
+// funding transaction of the reservation is known.
+func (f *fundingManager) sendFundingCreated(resCtx *reservationWithCtx,
+	pendingChanID [32]byte) {
 
 	// Now that we have their contribution, we can extract, then send over
 	// both the funding out point and our signature for their version of
@@ -1313,15 +1862,16 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		PendingChannelID: pendingChanID,
 		FundingPoint:     *outPoint,
 	}
+	var err error
 	fundingCreated.CommitSig, err = lnwire.NewSigFromRawSignature(sig)
 	if err != nil {
 		fndgLog.Errorf("Unable to parse signature: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
 		return
 	}
-	if err := fmsg.peer.SendMessage(false, fundingCreated); err != nil {
+	if err := resCtx.peer.SendMessage(false, fundingCreated); err != nil {
 		fndgLog.Errorf("Unable to send funding complete message: %v", err)
-		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
 		return
 	}
 }
@@ -1353,6 +1903,14 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 		return
 	}
 
+	// If the funding transaction has been constructed interactively, then
+	// we've already crafted it ourselves, and need to hand out the
+	// signatures for our inputs as well.
+	if resCtx.recvTxComplete {
+		f.handleDualFundingCreated(resCtx, fmsg)
+		return
+	}
+
 	// The channel initiator has responded with the funding outpoint of the
 	// final funding transaction, as well as a signature for our version of
 	// the commitment transaction. So at this point, we can validate the
@@ -1381,29 +1939,6 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	// from the set of active reservations.
 	f.deleteReservationCtx(peerKey, fmsg.msg.PendingChannelID)
 
-	// If something goes wrong before the funding transaction is confirmed,
-	// we use this convenience method to delete the pending OpenChannel
-	// from the database.
-	deleteFromDatabase := func() {
-		localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
-		closeInfo := &channeldb.ChannelCloseSummary{
-			ChanPoint:               completeChan.FundingOutpoint,
-			ChainHash:               completeChan.ChainHash,
-			RemotePub:               completeChan.IdentityPub,
-			CloseType:               channeldb.FundingCanceled,
-			Capacity:                completeChan.Capacity,
-			SettledBalance:          localBalance,
-			RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
-			RemoteNextRevocation:    completeChan.RemoteNextRevocation,
-			LocalChanConfig:         completeChan.LocalChanCfg,
-		}
-
-		if err := completeChan.CloseChannel(closeInfo); err != nil {
-			fndgLog.Errorf("Failed closing channel %v: %v",
-				completeChan.FundingOutpoint, err)
-		}
-	}
-
 	// A new channel has almost finished the funding process. In order to
 	// properly synchronize with the writeHandler goroutine, we add a new
 	// channel to the barriers map which will be closed once the channel is
@@ -1424,7 +1959,7 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	if err != nil {
 		fndgLog.Errorf("unable to parse signature: %v", err)
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		deleteFromDatabase()
+		f.deletePendingChannel(completeChan)
 		return
 	}
 
@@ -1435,24 +1970,70 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 	if err := fmsg.peer.SendMessage(false, fundingSigned); err != nil {
 		fndgLog.Errorf("unable to send FundingSigned message: %v", err)
 		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		deleteFromDatabase()
+		f.deletePendingChannel(completeChan)
 		return
 	}
 
+	// Create an entry in the local discovery map so we can ensure that we
+	// process the channel confirmation fully before we receive a funding
+	// locked message.
+	f.localDiscoveryMtx.Lock()
+	f.localDiscoverySignals[channelID] = make(chan struct{})
+	f.localDiscoveryMtx.Unlock()
+
+	f.watchRespondedChannel(fmsg.peer, pendingChanID, completeChan)
+}
+
+// deletePendingChannel removes a channel whose funding transaction hasn't
+// confirmed from the database. It's used if something goes wrong before the
+// funding transaction is confirmed.
+func (f *fundingManager) deletePendingChannel(
+	completeChan *channeldb.OpenChannel) {
+
+	localBalance := completeChan.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChanPoint:               completeChan.FundingOutpoint,
+		ChainHash:               completeChan.ChainHash,
+		RemotePub:               completeChan.IdentityPub,
+		CloseType:               channeldb.FundingCanceled,
+		Capacity:                completeChan.Capacity,
+		SettledBalance:          localBalance,
+		RemoteCurrentRevocation: completeChan.RemoteCurrentRevocation,
+		RemoteNextRevocation:    completeChan.RemoteNextRevocation,
+		LocalChanConfig:         completeChan.LocalChanCfg,
+	}
+
+	if err := completeChan.CloseChannel(closeInfo); err != nil {
+		fndgLog.Errorf("Failed closing channel %v: %v",
+			completeChan.FundingOutpoint, err)
+	}
+}
+
+// watchRespondedChannel hands a channel whose funding workflow we're the
+// responder of to the ChainArbitrator, and then waits for its funding
+// transaction to confirm in order to finish the funding workflow.
+func (f *fundingManager) watchRespondedChannel(peer lnpeer.Peer,
+	pendingChanID [32]byte, completeChan *channeldb.OpenChannel) {
+
 	// Now that we've sent over our final signature for this channel, we'll
 	// send it to the ChainArbitrator so it can watch for any on-chain
 	// actions during this final confirmation stage.
+	peerKey := peer.IdentityKey()
 	if err := f.cfg.WatchNewChannel(completeChan, peerKey); err != nil {
 		fndgLog.Errorf("Unable to send new ChannelPoint(%v) for "+
-			"arbitration: %v", fundingOut, err)
+			"arbitration: %v", completeChan.FundingOutpoint, err)
 	}
 
-	// Create an entry in the local discovery map so we can ensure that we
-	// process the channel confirmation fully before we receive a funding
-	// locked message.
-	f.localDiscoveryMtx.Lock()
-	f.localDiscoverySignals[channelID] = make(chan struct{})
-	f.localDiscoveryMtx.Unlock()
+	// If we trust the initiator not to double spend the funding
+	// transaction, then we'll consider the channel open right away.
+	if completeChan.NumConfsRequired == 0 {
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+			f.openZeroConfChannel(peer, completeChan, nil)
+		}()
+		return
+	}
 
 	// At this point we have sent our last funding message to the
 	// initiating peer before the funding transaction will be broadcast.
@@ -1487,8 +2068,8 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 			err := fmt.Errorf("timeout waiting for funding tx "+
 				"(%v) to confirm", completeChan.FundingOutpoint)
 			fndgLog.Warnf(err.Error())
-			f.failFundingFlow(fmsg.peer, pendingChanID, err)
-			deleteFromDatabase()
+			f.failFundingFlow(peer, pendingChanID, err)
+			f.deletePendingChannel(completeChan)
 			return
 		case <-f.quit:
 			// The fundingManager is shutting down, will resume
@@ -1505,7 +2086,7 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 
 		// Success, funding transaction was confirmed.
 		err := f.handleFundingConfirmation(
-			fmsg.peer, completeChan, shortChanID,
+			peer, completeChan, shortChanID,
 		)
 		if err != nil {
 			fndgLog.Errorf("failed to handle funding"+
@@ -1558,6 +2139,50 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		return
 	}
 
+	// If the channel is funded as part of a batch, then the funding
+	// transaction can't be broadcast until all channels of the batch have
+	// been signed.
+	if resCtx.batch != nil {
+		f.handleBatchFundingSigned(resCtx, pendingChanID, fmsg.msg)
+		return
+	}
+
+	// If the funding transaction has been constructed interactively, then
+	// the reservation can only be completed once the remote party has also
+	// sent the signatures for its inputs. Until then, we'll only verify
+	// its signature for our commitment transaction.
+	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
+	if resCtx.recvTxComplete {
+		err := resCtx.reservation.VerifyCommitSig(commitSig)
+		if err != nil {
+			fndgLog.Errorf("Unable to verify commitment signature "+
+				"for pendingID(%x): %v", pendingChanID[:], err)
+			f.failFundingFlow(fmsg.peer, pendingChanID, err)
+			return
+		}
+		resCtx.remoteCommitSig = commitSig
+		return
+	}
+
+	completeChan, err := f.completeReservation(
+		resCtx, pendingChanID, nil, commitSig,
+	)
+	if err != nil {
+		return
+	}
+
+	f.publishFundingTx(completeChan)
+	f.watchPendingChannel(resCtx, pendingChanID, completeChan)
+}
+
+// completeReservation verifies the remote party's signature for our version
+// of the commitment transaction, as well as the signatures for its inputs to
+// the funding transaction if any, then commits the pending channel to disk.
+// If the reservation can't be completed, the funding flow is failed.
+func (f *fundingManager) completeReservation(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, inputScripts []*lnwallet.InputScript,
+	commitSig []byte) (*channeldb.OpenChannel, error) {
+
 	// Create an entry in the local discovery map so we can ensure that we
 	// process the channel confirmation fully before we receive a funding
 	// locked message.
@@ -1570,27 +2195,31 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 	// The remote peer has responded with a signature for our commitment
 	// transaction. We'll verify the signature for validity, then commit
 	// the state to disk as we can now open the channel.
-	commitSig := fmsg.msg.CommitSig.ToSignatureBytes()
 	completeChan, err := resCtx.reservation.CompleteReservation(
-		nil, commitSig,
+		inputScripts, commitSig,
 	)
 	if err != nil {
 		fndgLog.Errorf("Unable to complete reservation sign "+
 			"complete: %v", err)
-		f.failFundingFlow(fmsg.peer, pendingChanID, err)
-		return
+		f.failFundingFlow(resCtx.peer, pendingChanID, err)
+		return nil, err
 	}
 
 	// The channel is now marked IsPending in the database, and we can
 	// delete it from our set of active reservations.
-	f.deleteReservationCtx(peerKey, pendingChanID)
+	f.deleteReservationCtx(resCtx.peer.IdentityKey(), pendingChanID)
+
+	return completeChan, nil
+}
 
-	// Broadcast the finalized funding transaction to the network.
+// publishFundingTx broadcasts the finalized funding transaction of the
+// channel to the network.
+func (f *fundingManager) publishFundingTx(completeChan *channeldb.OpenChannel) {
 	fundingTx := completeChan.FundingTxn
 	fndgLog.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
 		completeChan.FundingOutpoint, spew.Sdump(fundingTx))
 
-	err = f.cfg.PublishTransaction(fundingTx)
+	err := f.cfg.PublishTransaction(fundingTx)
 	if err != nil {
 		fndgLog.Errorf("Unable to broadcast funding tx for "+
 			"ChannelPoint(%v): %v", completeChan.FundingOutpoint,
@@ -1601,6 +2230,17 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		// TODO(halseth): retry more often? Handle with CPFP? Just
 		// delete from the DB?
 	}
+}
+
+// watchPendingChannel hands a channel whose funding transaction has been
+// broadcast to the ChainArbitrator, notifies the caller that the channel is
+// pending, and then waits for the funding transaction to confirm in order to
+// finish the funding workflow.
+func (f *fundingManager) watchPendingChannel(resCtx *reservationWithCtx,
+	pendingChanID [32]byte, completeChan *channeldb.OpenChannel) {
+
+	peerKey := resCtx.peer.IdentityKey()
+	fundingPoint := &completeChan.FundingOutpoint
 
 	// Now that we have a finalized reservation for this funding flow,
 	// we'll send the to be active channel to the ChainArbitrator so it can
@@ -1634,6 +2274,19 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		return
 	}
 
+	// Zero-conf channels are considered open right away, without waiting
+	// for the funding transaction to confirm.
+	if completeChan.NumConfsRequired == 0 {
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+			f.openZeroConfChannel(
+				resCtx.peer, completeChan, resCtx.updates,
+			)
+		}()
+		return
+	}
+
 	// At this point we have broadcast the funding transaction and done all
 	// necessary processing.
 	f.wg.Add(1)
@@ -1681,7 +2334,7 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 		defer lnChannel.Stop()
 
 		err = f.sendFundingLocked(
-			fmsg.peer, completeChan, lnChannel, shortChanID,
+			resCtx.peer, completeChan, lnChannel, shortChanID,
 		)
 		if err != nil {
 			fndgLog.Errorf("failed sending fundingLocked: %v", err)
@@ -1770,9 +2423,17 @@ func (f *fundingManager) waitForFundingWithTimeout(completeChan *channeldb.OpenC
 				return
 			}
 
-			// If we are not the channel initiator it's safe
-			// to timeout the channel
-			if uint32(epoch.Height) >= maxHeight && !completeChan.IsInitiator {
+			// If we are not the channel initiator, and haven't
+			// contributed any funds to it, it's safe to timeout
+			// the channel. A dual funded channel only has the
+			// DualFunder type if we added funds of our own, which
+			// we may lose track of if we forget about it. Its
+			// funding transaction being invalidated by the remote
+			// party is instead handled when broadcasting it.
+			if uint32(epoch.Height) >= maxHeight &&
+				!completeChan.IsInitiator &&
+				completeChan.ChanType != channeldb.DualFunder {
+
 				fndgLog.Warnf("waited for %v blocks without "+
 					"seeing funding transaction confirmed,"+
 					" cancelling.", maxWaitNumBlocksFundingConf)
@@ -1846,7 +2507,12 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 			"ChannelPoint(%v): %v", completeChan.FundingOutpoint, err)
 		return
 	}
+	// Zero-conf channels are already open, but we'll still wait for a
+	// single confirmation to learn their short channel ID.
 	numConfs := uint32(completeChan.NumConfsRequired)
+	if numConfs == 0 {
+		numConfs = 1
+	}
 	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
 		&txid, fundingScript, numConfs, completeChan.FundingBroadcastHeight,
 	)
@@ -1916,14 +2582,21 @@ func (f *fundingManager) waitForFundingConfirmation(completeChan *channeldb.Open
 	// to track the remaining process of the channel opening. This is
 	// useful to resume the opening process in case of restarts.
 	//
+	// As the FundingLocked message of a zero-conf channel has already been
+	// sent, it resumes the process from the point at which the channel is
+	// added to the router graph under its confirmed short channel ID.
+	//
 	// TODO(halseth): make the two db transactions (MarkChannelAsOpen and
 	// saveChannelOpeningState) atomic by doing them in the same transaction.
 	// Needed to be properly fault-tolerant.
-	err = f.saveChannelOpeningState(&completeChan.FundingOutpoint, markedOpen,
-		&shortChanID)
+	openingState := markedOpen
+	if completeChan.IsZeroConf() {
+		openingState = fundingLockedSent
+	}
+	err = f.saveChannelOpeningState(&completeChan.FundingOutpoint,
+		openingState, &shortChanID)
 	if err != nil {
-		fndgLog.Errorf("error setting channel state to markedOpen: %v",
-			err)
+		fndgLog.Errorf("error saving channel opening state: %v", err)
 		return
 	}
 
@@ -2074,6 +2747,17 @@ func (f *fundingManager) addToRouterGraph(completeChan *channeldb.OpenChannel,
 
 	chanID := lnwire.NewChanIDFromOutPoint(&completeChan.FundingOutpoint)
 
+	// If a zero-conf channel is added under its confirmed short channel
+	// ID, then its edge under the alias must be removed first, as both
+	// share the same funding outpoint.
+	if completeChan.IsZeroConf() && !shortChanID.IsAlias() {
+		err := f.cfg.DeleteAliasEdge(&completeChan.FundingOutpoint)
+		if err != nil {
+			return fmt.Errorf("unable to delete alias edge: %v",
+				err)
+		}
+	}
+
 	// We'll obtain the min HTLC value we can forward in our direction, as
 	// we'll use this value within our ChannelUpdate. This constraint is
 	// originally set by the remote node, as it will be the one that will
@@ -2277,6 +2961,42 @@ func (f *fundingManager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 	return nil
 }
 
+// announceSplicedChannel announces a channel which has been spliced into a
+// new funding output, under the short channel ID of the new funding output.
+// As the channel is already active, the opening process is resumed from the
+// point at which the channel is added to the router graph.
+func (f *fundingManager) announceSplicedChannel(
+	completeChan *channeldb.OpenChannel,
+	shortChanID *lnwire.ShortChannelID) error {
+
+	err := f.saveChannelOpeningState(
+		&completeChan.FundingOutpoint, fundingLockedSent, shortChanID,
+	)
+	if err != nil {
+		return fmt.Errorf("error setting channel state to "+
+			"fundingLockedSent: %v", err)
+	}
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		err := f.addToRouterGraph(completeChan, shortChanID)
+		if err != nil {
+			fndgLog.Errorf("failed adding to router graph: %v", err)
+			return
+		}
+
+		err = f.annAfterSixConfs(completeChan, shortChanID)
+		if err != nil {
+			fndgLog.Errorf("error sending channel announcement: %v",
+				err)
+		}
+	}()
+
+	return nil
+}
+
 // processFundingLocked sends a message to the fundingManager allowing it to
 // finish the funding workflow.
 func (f *fundingManager) processFundingLocked(msg *lnwire.FundingLocked,
@@ -2649,15 +3369,90 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		capacity       = localAmt + remoteAmt
 		minHtlc        = msg.minHtlc
 		remoteCsvDelay = msg.remoteCsvDelay
+		ourDustLimit   = msg.dustLimit
+		chanReserve    = msg.remoteChanReserve
+		maxValue       = msg.remoteMaxValue
+		maxHtlcs       = msg.remoteMaxHtlcs
 	)
 
-	// We'll determine our dust limit depending on which chain is active.
-	var ourDustLimit btcutil.Amount
-	switch registeredChains.PrimaryChain() {
-	case bitcoinChain:
-		ourDustLimit = lnwallet.DefaultDustLimit()
-	case litecoinChain:
-		ourDustLimit = defaultLitecoinDustLimit
+	// If no dust limit was specified, we'll use the default dust limit of
+	// the active chain.
+	if ourDustLimit == 0 {
+		ourDustLimit = f.cfg.Wallet.Cfg.DefaultConstraints.DustLimit
+	}
+
+	// Channels above the soft-limit for channel size may only be opened
+	// if both of us signal support for them.
+	if maxChanSize := f.maxChanSize(msg.peer); capacity > maxChanSize {
+		msg.err <- fmt.Errorf("funding amount %v is too large, the "+
+			"max channel size with peer %x is: %v", capacity,
+			peerKey.SerializeCompressed(), maxChanSize)
+		return
+	}
+
+	// We can only commit to an upfront shutdown script if the remote peer
+	// knows of the feature, as it would otherwise ignore it.
+	if len(msg.shutdownScript) != 0 {
+		remoteFeatures := msg.peer.RemoteLocalFeatures()
+		if remoteFeatures == nil || !remoteFeatures.HasFeature(
+			lnwire.UpfrontShutdownScriptOptional) {
+
+			msg.err <- fmt.Errorf("peer %x doesn't support upfront "+
+				"shutdown scripts", peerKey.SerializeCompressed())
+			return
+		}
+	}
+
+	// Similarly, we can only ask for a zero-conf channel if the remote
+	// peer knows of them.
+	if msg.zeroConf {
+		remoteFeatures := msg.peer.RemoteLocalFeatures()
+		if remoteFeatures == nil || !remoteFeatures.HasFeature(
+			lnwire.ZeroConfOptional) {
+
+			msg.err <- fmt.Errorf("peer %x doesn't support "+
+				"zero-conf channels", peerKey.SerializeCompressed())
+			return
+		}
+	}
+
+	// If the remote CSV delay was not set in the open channel request,
+	// we'll use the RequiredRemoteDelay closure to compute the delay we
+	// require given the total amount of funds within the channel.
+	if remoteCsvDelay == 0 {
+		remoteCsvDelay = f.cfg.RequiredRemoteDelay(capacity)
+	}
+
+	// If no minimum HTLC value was specified, use the default one.
+	if minHtlc == 0 {
+		minHtlc = f.cfg.DefaultRoutingPolicy.MinHTLC
+	}
+
+	// Any of the remaining constraints for the remote party that weren't
+	// set in the open channel request are derived from the channel
+	// capacity and our default policy.
+	if chanReserve == 0 {
+		chanReserve = f.cfg.RequiredRemoteChanReserve(
+			capacity, ourDustLimit,
+		)
+	}
+	if maxValue == 0 {
+		maxValue = f.cfg.RequiredRemoteMaxValue(capacity)
+	}
+	if maxHtlcs == 0 {
+		maxHtlcs = f.cfg.RequiredRemoteMaxHTLCs(capacity)
+	}
+
+	// Before reserving any funds, we'll make sure the constraints are
+	// within the bounds of the protocol, so they won't be rejected by
+	// the remote peer.
+	err := lnwallet.ValidateRemoteConstraints(
+		capacity, ourDustLimit, maxHtlcs, maxValue, minHtlc,
+		chanReserve,
+	)
+	if err != nil {
+		msg.err <- err
+		return
 	}
 
 	fndgLog.Infof("Initiating fundingRequest(localAmt=%v, remoteAmt=%v, "+
@@ -2697,6 +3492,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		PushMSat:        msg.pushAmt,
 		Flags:           channelFlags,
 		MinConfs:        msg.minConfs,
+		ExternalFunding: msg.psbtFunding,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -2705,6 +3501,13 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		return
 	}
 
+	// If a close address was specified, we'll commit to it as our upfront
+	// shutdown script.
+	reservation.SetOurUpfrontShutdown(msg.shutdownScript)
+
+	// Our commitment transaction will use the dust limit we settled on.
+	reservation.SetOurDustLimit(ourDustLimit)
+
 	// Obtain a new pending channel ID which is used to track this
 	// reservation throughout its lifetime.
 	chanID := f.nextPendingChanID()
@@ -2712,18 +3515,6 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	fndgLog.Infof("Target commit tx sat/kw for pendingID(%x): %v", chanID,
 		int64(commitFeePerKw))
 
-	// If the remote CSV delay was not set in the open channel request,
-	// we'll use the RequiredRemoteDelay closure to compute the delay we
-	// require given the total amount of funds within the channel.
-	if remoteCsvDelay == 0 {
-		remoteCsvDelay = f.cfg.RequiredRemoteDelay(capacity)
-	}
-
-	// If no minimum HTLC value was specified, use the default one.
-	if minHtlc == 0 {
-		minHtlc = f.cfg.DefaultRoutingPolicy.MinHTLC
-	}
-
 	// If a pending channel map for this peer isn't already created, then
 	// we create one, ultimately allowing us to track this pending
 	// reservation within the target peer.
@@ -2734,13 +3525,17 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	}
 
 	resCtx := &reservationWithCtx{
-		chanAmt:        capacity,
-		remoteCsvDelay: remoteCsvDelay,
-		remoteMinHtlc:  minHtlc,
-		reservation:    reservation,
-		peer:           msg.peer,
-		updates:        msg.updates,
-		err:            msg.err,
+		chanAmt:           capacity,
+		remoteCsvDelay:    remoteCsvDelay,
+		remoteMinHtlc:     minHtlc,
+		remoteChanReserve: chanReserve,
+		remoteMaxValue:    maxValue,
+		remoteMaxHtlcs:    maxHtlcs,
+		zeroConf:          msg.zeroConf,
+		reservation:       reservation,
+		peer:              msg.peer,
+		updates:           msg.updates,
+		err:               msg.err,
 	}
 	f.activeReservations[peerIDKey][chanID] = resCtx
 	f.resMtx.Unlock()
@@ -2752,35 +3547,37 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	// request to the remote peer, kicking off the funding workflow.
 	ourContribution := reservation.OurContribution()
 
-	// Finally, we'll use the current value of the channels and our default
-	// policy to determine of required commitment constraints for the
-	// remote party.
-	chanReserve := f.cfg.RequiredRemoteChanReserve(capacity, ourDustLimit)
-	maxValue := f.cfg.RequiredRemoteMaxValue(capacity)
-	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
-
 	fndgLog.Infof("Starting funding workflow with %v for pendingID(%x)",
 		msg.peer.Address(), chanID)
 
 	fundingOpen := lnwire.OpenChannel{
-		ChainHash:            *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
-		PendingChannelID:     chanID,
-		FundingAmount:        capacity,
-		PushAmount:           msg.pushAmt,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		HtlcMinimum:          minHtlc,
-		FeePerKiloWeight:     uint32(commitFeePerKw),
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
-		ChannelFlags:         channelFlags,
+		ChainHash:             *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
+		PendingChannelID:      chanID,
+		FundingAmount:         capacity,
+		PushAmount:            msg.pushAmt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		HtlcMinimum:           minHtlc,
+		FeePerKiloWeight:      uint32(commitFeePerKw),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+	}
+
+	// A zero-conf channel is explicitly requested through the channel
+	// type, which the responder must echo back to agree to it.
+	if msg.zeroConf {
+		fundingOpen.ChannelType = lnwire.NewChannelType(
+			lnwire.ZeroConfRequired,
+		)
 	}
 	if err := msg.peer.SendMessage(false, &fundingOpen); err != nil {
 		e := fmt.Errorf("Unable to send funding request message: %v",
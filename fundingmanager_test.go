@@ -3,6 +3,7 @@
 package main
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -17,10 +18,12 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -28,6 +31,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/psbt"
 )
 
 const (
@@ -83,6 +87,24 @@ var (
 		Address:     bobTCPAddr,
 	}
 
+	// Carol is only used by tests that need a second remote peer.
+	carolPrivKeyBytes = [32]byte{
+		0x4c, 0x1b, 0x8f, 0x52, 0x6e, 0x0a, 0x93, 0x27,
+		0xd5, 0x3e, 0x61, 0xa8, 0x19, 0xc4, 0x77, 0x0f,
+		0x2b, 0x90, 0xe3, 0x5d, 0x48, 0xb6, 0x0c, 0x71,
+		0x9a, 0x25, 0xf8, 0x3d, 0x64, 0xe2, 0x13, 0xab,
+	}
+
+	carolPrivKey, carolPubKey = btcec.PrivKeyFromBytes(btcec.S256(),
+		carolPrivKeyBytes[:])
+
+	carolTCPAddr, _ = net.ResolveTCPAddr("tcp", "10.0.0.2:9002")
+
+	carolAddr = &lnwire.NetAddress{
+		IdentityKey: carolPubKey,
+		Address:     carolTCPAddr,
+	}
+
 	testSig = &btcec.Signature{
 		R: new(big.Int),
 		S: new(big.Int),
@@ -95,6 +117,10 @@ type mockNotifier struct {
 	oneConfChannel chan *chainntnfs.TxConfirmation
 	sixConfChannel chan *chainntnfs.TxConfirmation
 	epochChan      chan *chainntnfs.BlockEpoch
+
+	// spendChan, if set, delivers the spends of all outpoints registered
+	// for spend notifications.
+	spendChan chan *chainntnfs.SpendDetail
 }
 
 func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
@@ -128,8 +154,14 @@ func (m *mockNotifier) Stop() error {
 
 func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint, _ []byte,
 	heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	spendChan := m.spendChan
+	if spendChan == nil {
+		spendChan = make(chan *chainntnfs.SpendDetail)
+	}
+
 	return &chainntnfs.SpendEvent{
-		Spend:  make(chan *chainntnfs.SpendDetail),
+		Spend:  spendChan,
 		Cancel: func() {},
 	}, nil
 }
@@ -143,11 +175,17 @@ type testNode struct {
 	fundingMgr      *fundingManager
 	newChannels     chan *newChannelMsg
 	mockNotifier    *mockNotifier
+	walletCtrl      *mockWalletController
+	chainIO         *mockChainIO
 	testDir         string
 	shutdownChannel chan struct{}
 
 	remotePeer  *testNode
 	sendMessage func(lnwire.Message) error
+
+	// localFeatures is the set of local features the node advertises to
+	// its peers.
+	localFeatures *lnwire.RawFeatureVector
 }
 
 var _ lnpeer.Peer = (*testNode)(nil)
@@ -176,6 +214,10 @@ func (n *testNode) QuitSignal() <-chan struct{} {
 	return n.shutdownChannel
 }
 
+func (n *testNode) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(n.localFeatures, lnwire.LocalFeatures)
+}
+
 func (n *testNode) AddNewChannel(channel *channeldb.OpenChannel,
 	quit <-chan struct{}) error {
 
@@ -245,10 +287,10 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 	shutdownChan := make(chan struct{})
 
 	wc := &mockWalletController{
-		rootKey: alicePrivKey,
+		rootKey: privKey,
 	}
 	signer := &mockSigner{
-		key: alicePrivKey,
+		key: privKey,
 	}
 	bio := &mockChainIO{}
 
@@ -259,7 +301,7 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 	}
 
 	keyRing := &mockSecretKeyRing{
-		rootKey: alicePrivKey,
+		rootKey: privKey,
 	}
 
 	lnw, err := createTestWallet(
@@ -351,6 +393,17 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		},
 		ZombieSweeperInterval: 1 * time.Hour,
 		ReservationTimeout:    1 * time.Nanosecond,
+		MaxChanSize:           maxFundingAmount,
+		OpenChannelPredicate:  chanacceptor.NewChainedAcceptor(),
+		AllowZeroConf: func(*btcec.PublicKey) bool {
+			return false
+		},
+		DeleteAliasEdge: func(*wire.OutPoint) error {
+			return nil
+		},
+		FailZeroConfChannel: func(wire.OutPoint) error {
+			return nil
+		},
 	})
 	if err != nil {
 		t.Fatalf("failed creating fundingManager: %v", err)
@@ -367,9 +420,12 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		publTxChan:      publTxChan,
 		fundingMgr:      f,
 		mockNotifier:    chainNotifier,
+		walletCtrl:      wc,
+		chainIO:         bio,
 		testDir:         tempTestDir,
 		shutdownChannel: shutdownChan,
 		addr:            addr,
+		localFeatures:   lnwire.NewRawFeatureVector(),
 	}
 
 	f.cfg.NotifyWhenOnline = func(peer *btcec.PublicKey,
@@ -436,6 +492,11 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 		},
 		ZombieSweeperInterval: oldCfg.ZombieSweeperInterval,
 		ReservationTimeout:    oldCfg.ReservationTimeout,
+		MaxChanSize:           oldCfg.MaxChanSize,
+		OpenChannelPredicate:  oldCfg.OpenChannelPredicate,
+		AllowZeroConf:         oldCfg.AllowZeroConf,
+		DeleteAliasEdge:       oldCfg.DeleteAliasEdge,
+		FailZeroConfChannel:   oldCfg.FailZeroConfChannel,
 	})
 	if err != nil {
 		t.Fatalf("failed recreating aliceFundingManager: %v", err)
@@ -670,6 +731,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		ok      bool
 	)
 	switch msgType {
+	case "OpenChannel":
+		sentMsg, ok = msg.(*lnwire.OpenChannel)
 	case "AcceptChannel":
 		sentMsg, ok = msg.(*lnwire.AcceptChannel)
 	case "FundingCreated":
@@ -680,6 +743,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		sentMsg, ok = msg.(*lnwire.FundingLocked)
 	case "Error":
 		sentMsg, ok = msg.(*lnwire.Error)
+	case "NodeAnnouncement":
+		sentMsg, ok = msg.(*lnwire.NodeAnnouncement)
 	default:
 		t.Fatalf("unknown message type: %s", msgType)
 	}
@@ -2102,6 +2167,10 @@ func TestFundingManagerCustomChannelParameters(t *testing.T) {
 	// This is the custom parameters we'll use.
 	const csvDelay = 67
 	const minHtlc = 1234
+	const chanReserve = 100000
+	const dustLimit = 1000
+	const maxHtlcs = 100
+	const maxValueInFlight = 50000000
 
 	// We will consume the channel updates as we go, so no buffering is
 	// needed.
@@ -2111,15 +2180,19 @@ func TestFundingManagerCustomChannelParameters(t *testing.T) {
 	// workflow.
 	errChan := make(chan error, 1)
 	initReq := &openChanReq{
-		targetPubkey:    bob.privKey.PubKey(),
-		chainHash:       *activeNetParams.GenesisHash,
-		localFundingAmt: 5000000,
-		pushAmt:         lnwire.NewMSatFromSatoshis(0),
-		private:         false,
-		minHtlc:         minHtlc,
-		remoteCsvDelay:  csvDelay,
-		updates:         updateChan,
-		err:             errChan,
+		targetPubkey:      bob.privKey.PubKey(),
+		chainHash:         *activeNetParams.GenesisHash,
+		localFundingAmt:   5000000,
+		pushAmt:           lnwire.NewMSatFromSatoshis(0),
+		private:           false,
+		minHtlc:           minHtlc,
+		remoteCsvDelay:    csvDelay,
+		remoteChanReserve: chanReserve,
+		dustLimit:         dustLimit,
+		remoteMaxHtlcs:    maxHtlcs,
+		remoteMaxValue:    maxValueInFlight,
+		updates:           updateChan,
+		err:               errChan,
 	}
 
 	alice.fundingMgr.initFundingWorkflow(bob, initReq)
@@ -2158,6 +2231,26 @@ func TestFundingManagerCustomChannelParameters(t *testing.T) {
 			minHtlc, openChannelReq.HtlcMinimum)
 	}
 
+	// As well as the remaining custom constraints, and our custom dust
+	// limit.
+	if openChannelReq.ChannelReserve != chanReserve {
+		t.Fatalf("expected OpenChannel to have reserve %v, got %v",
+			chanReserve, openChannelReq.ChannelReserve)
+	}
+	if openChannelReq.DustLimit != dustLimit {
+		t.Fatalf("expected OpenChannel to have dust limit %v, got %v",
+			dustLimit, openChannelReq.DustLimit)
+	}
+	if openChannelReq.MaxAcceptedHTLCs != maxHtlcs {
+		t.Fatalf("expected OpenChannel to have max htlcs %v, got %v",
+			maxHtlcs, openChannelReq.MaxAcceptedHTLCs)
+	}
+	if openChannelReq.MaxValueInFlight != maxValueInFlight {
+		t.Fatalf("expected OpenChannel to have max value in flight "+
+			"%v, got %v", maxValueInFlight,
+			openChannelReq.MaxValueInFlight)
+	}
+
 	chanID := openChannelReq.PendingChannelID
 
 	// Let Bob handle the init message.
@@ -2225,6 +2318,28 @@ func TestFundingManagerCustomChannelParameters(t *testing.T) {
 		return nil
 	}
 
+	// Helper method for checking the remaining constraints of a
+	// contribution.
+	assertConstraints := func(contribution *lnwallet.ChannelContribution,
+		expReserve btcutil.Amount, expMaxHtlcs uint16,
+		expMaxValue lnwire.MilliSatoshi) error {
+
+		if contribution.ChanReserve != expReserve {
+			return fmt.Errorf("expected reserve to be %v, was %v",
+				expReserve, contribution.ChanReserve)
+		}
+		if contribution.MaxAcceptedHtlcs != expMaxHtlcs {
+			return fmt.Errorf("expected max htlcs to be %v, was %v",
+				expMaxHtlcs, contribution.MaxAcceptedHtlcs)
+		}
+		if contribution.MaxPendingAmount != expMaxValue {
+			return fmt.Errorf("expected max value in flight to be "+
+				"%v, was %v", expMaxValue,
+				contribution.MaxPendingAmount)
+		}
+		return nil
+	}
+
 	// Check that the custom channel parameters were properly set in the
 	// channel reservation.
 	resCtx, err := alice.fundingMgr.getReservationCtx(bobPubKey, chanID)
@@ -2244,6 +2359,21 @@ func TestFundingManagerCustomChannelParameters(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// Bob should be bound by the remaining custom constraints, while
+	// Alice's commitment uses the custom dust limit.
+	err = assertConstraints(
+		resCtx.reservation.TheirContribution(), chanReserve,
+		maxHtlcs, maxValueInFlight,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ourDustLimit := resCtx.reservation.OurContribution().DustLimit
+	if ourDustLimit != dustLimit {
+		t.Fatalf("expected our dust limit to be %v, was %v",
+			dustLimit, ourDustLimit)
+	}
+
 	// Also make sure the parameters are properly set on Bob's end.
 	resCtx, err = bob.fundingMgr.getReservationCtx(alicePubKey, chanID)
 	if err != nil {
@@ -2258,6 +2388,19 @@ func TestFundingManagerCustomChannelParameters(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	err = assertConstraints(
+		resCtx.reservation.OurContribution(), chanReserve,
+		maxHtlcs, maxValueInFlight,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	theirDustLimit := resCtx.reservation.TheirContribution().DustLimit
+	if theirDustLimit != dustLimit {
+		t.Fatalf("expected their dust limit to be %v, was %v",
+			dustLimit, theirDustLimit)
+	}
+
 	// Give the message to Bob.
 	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
 
@@ -2317,6 +2460,84 @@ func TestFundingManagerCustomChannelParameters(t *testing.T) {
 	waitForOpenUpdate(t, updateChan)
 }
 
+// TestFundingManagerInvalidChannelParameters checks that custom channel
+// parameters outside the bounds of the protocol are rejected before the
+// funding flow starts.
+func TestFundingManagerInvalidChannelParameters(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	const localAmt = 5000000
+
+	testCases := []struct {
+		name      string
+		setParams func(*openChanReq)
+	}{
+		{
+			name: "dust limit below minimum",
+			setParams: func(req *openChanReq) {
+				req.dustLimit = lnwallet.MinDustLimit - 1
+			},
+		},
+		{
+			name: "reserve below dust limit",
+			setParams: func(req *openChanReq) {
+				req.dustLimit = 1000
+				req.remoteChanReserve = 999
+			},
+		},
+		{
+			name: "reserve too large",
+			setParams: func(req *openChanReq) {
+				req.remoteChanReserve = localAmt/5 + 1
+			},
+		},
+		{
+			name: "too many htlcs",
+			setParams: func(req *openChanReq) {
+				req.remoteMaxHtlcs = lnwallet.MaxHTLCNumber/2 + 1
+			},
+		},
+		{
+			name: "max value in flight above capacity",
+			setParams: func(req *openChanReq) {
+				req.remoteMaxValue = lnwire.NewMSatFromSatoshis(
+					localAmt + 1,
+				)
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		initReq := &openChanReq{
+			targetPubkey:    bob.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: localAmt,
+			updates:         make(chan *lnrpc.OpenStatusUpdate),
+			err:             make(chan error, 1),
+		}
+		test.setParams(initReq)
+
+		alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+		select {
+		case err := <-initReq.err:
+			if _, ok := err.(lnwallet.ReservationError); !ok {
+				t.Fatalf("%v: expected ReservationError, got %v",
+					test.name, err)
+			}
+		case msg := <-alice.msgChan:
+			t.Fatalf("%v: expected funding to fail, alice sent %T",
+				test.name, msg)
+		case <-time.After(time.Second * 5):
+			t.Fatalf("%v: funding workflow didn't fail", test.name)
+		}
+	}
+
+	// None of the requests should have left a reservation behind.
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+}
+
 // TestFundingManagerMaxPendingChannels checks that trying to open another
 // channel with the same peer when MaxPending channels are pending fails.
 func TestFundingManagerMaxPendingChannels(t *testing.T) {
@@ -2477,3 +2698,1709 @@ func TestFundingManagerMaxPendingChannels(t *testing.T) {
 		t, bob.msgChan, "AcceptChannel",
 	).(*lnwire.AcceptChannel)
 }
+
+// TestFundingManagerPsbtFunding checks that a channel can be funded by an
+// external wallet: the funding workflow must pause once the funding output is
+// known, and only resume once an acceptable signed PSBT has been supplied.
+func TestFundingManagerPsbtFunding(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// We'll receive both the PSBT funding and the pending update before
+	// continuing, so we buffer the channel.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate, 2)
+
+	const localAmt = 500000
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: localAmt,
+		updates:         updateChan,
+		err:             errChan,
+		psbtFunding:     true,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	var openChannelReq *lnwire.OpenChannel
+	select {
+	case msg := <-alice.msgChan:
+		var ok bool
+		openChannelReq, ok = msg.(*lnwire.OpenChannel)
+		if !ok {
+			t.Fatalf("expected OpenChannel to be sent from "+
+				"alice, instead got %T", msg)
+		}
+	case err := <-errChan:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	// Rather than sending FundingCreated, Alice should ask the caller to
+	// fund the channel.
+	var fundUpdate *lnrpc.ReadyForPsbtFunding
+	select {
+	case upd := <-updateChan:
+		psbtFund, ok := upd.Update.(*lnrpc.OpenStatusUpdate_PsbtFund)
+		if !ok {
+			t.Fatalf("expected PSBT funding update, got %T",
+				upd.Update)
+		}
+		fundUpdate = psbtFund.PsbtFund
+	case err := <-errChan:
+		t.Fatalf("error during funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not request PSBT funding")
+	}
+	assertErrorNotSent(t, alice.msgChan)
+
+	if fundUpdate.FundingAmount != localAmt {
+		t.Fatalf("expected funding amount %v, got %v", localAmt,
+			fundUpdate.FundingAmount)
+	}
+	template, err := psbt.NewFromRawBytes(
+		bytes.NewReader(fundUpdate.Psbt), false,
+	)
+	if err != nil {
+		t.Fatalf("unable to parse PSBT template: %v", err)
+	}
+	if len(template.UnsignedTx.TxOut) != 1 {
+		t.Fatalf("expected single output in template, got %v",
+			len(template.UnsignedTx.TxOut))
+	}
+	fundingOutput := template.UnsignedTx.TxOut[0]
+
+	var pendingChanID [32]byte
+	copy(pendingChanID[:], fundUpdate.PendingChanId)
+
+	// newPacket returns a signed PSBT spending a single segwit input to
+	// the given output.
+	newPacket := func(txOut *wire.TxOut,
+		witness wire.TxWitness) *psbt.Packet {
+
+		tx := wire.NewMsgTx(2)
+		tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+		})
+		tx.AddTxOut(txOut)
+		packet, err := psbt.NewFromUnsignedTx(tx)
+		if err != nil {
+			t.Fatalf("unable to create PSBT: %v", err)
+		}
+		packet.Inputs[0].FinalScriptWitness = witness
+		return packet
+	}
+
+	// A PSBT paying the wrong amount, or spending a non-segwit input,
+	// must be rejected without failing the funding flow.
+	witness := wire.TxWitness{{0x01}}
+	badAmt := newPacket(&wire.TxOut{
+		Value:    fundingOutput.Value - 1,
+		PkScript: fundingOutput.PkScript,
+	}, witness)
+	if err := alice.fundingMgr.ProcessPsbt(pendingChanID, badAmt); err == nil {
+		t.Fatalf("expected PSBT with wrong amount to be rejected")
+	}
+	nonSegwit := newPacket(fundingOutput, nil)
+	nonSegwit.Inputs[0].FinalScriptSig = []byte{0x01}
+	if err := alice.fundingMgr.ProcessPsbt(pendingChanID, nonSegwit); err == nil {
+		t.Fatalf("expected PSBT with non-segwit input to be rejected")
+	}
+	assertErrorNotSent(t, alice.msgChan)
+	assertNumPendingReservations(t, alice, bobPubKey, 1)
+
+	// Now supply a valid PSBT, which should resume the funding flow.
+	packet := newPacket(fundingOutput, witness)
+	if err := alice.fundingMgr.ProcessPsbt(pendingChanID, packet); err != nil {
+		t.Fatalf("unable to process PSBT: %v", err)
+	}
+	fundingTx, err := psbt.Extract(packet)
+	if err != nil {
+		t.Fatalf("unable to extract funding tx: %v", err)
+	}
+
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+	if fundingCreated.FundingPoint.Hash != fundingTx.TxHash() {
+		t.Fatalf("expected funding point to reference %v, got %v",
+			fundingTx.TxHash(), fundingCreated.FundingPoint.Hash)
+	}
+
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	// Alice should publish the funding transaction of the PSBT unchanged.
+	select {
+	case publ := <-alice.publTxChan:
+		if publ.TxHash() != fundingTx.TxHash() {
+			t.Fatalf("expected published tx %v, got %v",
+				fundingTx.TxHash(), publ.TxHash())
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+
+	select {
+	case upd := <-updateChan:
+		if _, ok := upd.Update.(*lnrpc.OpenStatusUpdate_ChanPending); !ok {
+			t.Fatalf("expected pending update, got %T", upd.Update)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+}
+
+// batchFundResult houses the outcome of a call to BatchFund.
+type batchFundResult struct {
+	outPoints []*wire.OutPoint
+	err       error
+}
+
+// batchPeer couples a remote node of a batch with the peer it uses to
+// communicate with Alice.
+type batchPeer struct {
+	node  *testNode
+	alice *testNode
+}
+
+// setupCarol creates a third funding manager, wired up to exchange messages
+// with Alice just like Bob does. As messages are intercepted in the msgChan of
+// their sender, Carol needs her own view of Alice as a peer.
+func setupCarol(t *testing.T, alice *testNode) *batchPeer {
+	t.Helper()
+
+	carolTestDir, err := ioutil.TempDir("", "carollnwallet")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	carol, err := createTestFundingManager(
+		t, carolPrivKey, carolAddr, carolTestDir,
+	)
+	if err != nil {
+		t.Fatalf("failed creating fundingManager: %v", err)
+	}
+
+	carol.remotePeer = alice
+	carol.sendMessage = func(msg lnwire.Message) error {
+		select {
+		case alice.msgChan <- msg:
+		case <-carol.shutdownChannel:
+			return errors.New("shutting down")
+		}
+		return nil
+	}
+
+	aliceForCarol := *alice
+	aliceForCarol.remotePeer = carol
+	aliceForCarol.sendMessage = func(msg lnwire.Message) error {
+		select {
+		case carol.msgChan <- msg:
+		case <-alice.shutdownChannel:
+			return errors.New("shutting down")
+		}
+		return nil
+	}
+
+	return &batchPeer{node: carol, alice: &aliceForCarol}
+}
+
+// tearDownCarol stops the funding manager created by setupCarol.
+func tearDownCarol(t *testing.T, carol *batchPeer) {
+	close(carol.node.shutdownChannel)
+	if err := carol.node.fundingMgr.Stop(); err != nil {
+		t.Fatalf("unable to stop fundingManager: %v", err)
+	}
+	os.RemoveAll(carol.node.testDir)
+}
+
+// startBatchFund has Alice open a channel with each of the given peers within
+// a single batch, and runs the funding workflows until Alice has sent
+// FundingCreated for all of them.
+func startBatchFund(t *testing.T, alice *testNode,
+	peers []*batchPeer) ([]*lnwire.FundingCreated, chan *batchFundResult) {
+
+	t.Helper()
+
+	lnPeers := make([]lnpeer.Peer, 0, len(peers))
+	reqs := make([]*openChanReq, 0, len(peers))
+	for _, peer := range peers {
+		lnPeers = append(lnPeers, peer.node)
+		reqs = append(reqs, &openChanReq{
+			targetPubkey:    peer.node.privKey.PubKey(),
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: 500000,
+			updates:         make(chan *lnrpc.OpenStatusUpdate, 2),
+			err:             make(chan error, 1),
+		})
+	}
+
+	resultChan := make(chan *batchFundResult, 1)
+	go func() {
+		outPoints, err := alice.fundingMgr.BatchFund(
+			lnPeers, reqs, 6250, 1,
+		)
+		resultChan <- &batchFundResult{outPoints, err}
+	}()
+
+	// Every channel should go through the regular funding workflow, up
+	// until Alice sends FundingCreated.
+	for _, peer := range peers {
+		var openChannelReq *lnwire.OpenChannel
+		select {
+		case msg := <-alice.msgChan:
+			var ok bool
+			openChannelReq, ok = msg.(*lnwire.OpenChannel)
+			if !ok {
+				t.Fatalf("expected OpenChannel to be sent "+
+					"from alice, instead got %T", msg)
+			}
+		case <-time.After(time.Second * 5):
+			t.Fatalf("alice did not send OpenChannel message")
+		}
+
+		peer.node.fundingMgr.processFundingOpen(
+			openChannelReq, peer.alice,
+		)
+		acceptChannelResponse := assertFundingMsgSent(
+			t, peer.node.msgChan, "AcceptChannel",
+		).(*lnwire.AcceptChannel)
+		alice.fundingMgr.processFundingAccept(
+			acceptChannelResponse, peer.node,
+		)
+	}
+
+	fundingCreated := make([]*lnwire.FundingCreated, 0, len(peers))
+	for range peers {
+		fundingCreated = append(fundingCreated, assertFundingMsgSent(
+			t, alice.msgChan, "FundingCreated",
+		).(*lnwire.FundingCreated))
+	}
+
+	// All channels must be funded by the same transaction.
+	for _, msg := range fundingCreated[1:] {
+		if msg.FundingPoint.Hash != fundingCreated[0].FundingPoint.Hash {
+			t.Fatalf("channels of batch funded by different txs")
+		}
+	}
+
+	return fundingCreated, resultChan
+}
+
+// TestFundingManagerBatchFund checks that several channels can be funded by
+// a single transaction, which is only broadcast once every channel of the
+// batch has been signed.
+func TestFundingManagerBatchFund(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+	carol := setupCarol(t, alice)
+	defer tearDownCarol(t, carol)
+
+	peers := []*batchPeer{{node: bob, alice: alice}, carol}
+	fundingCreated, resultChan := startBatchFund(t, alice, peers)
+
+	for i, peer := range peers {
+		peer.node.fundingMgr.processFundingCreated(
+			fundingCreated[i], peer.alice,
+		)
+		fundingSigned := assertFundingMsgSent(
+			t, peer.node.msgChan, "FundingSigned",
+		).(*lnwire.FundingSigned)
+		alice.fundingMgr.processFundingSigned(fundingSigned, peer.node)
+
+		// The funding transaction must not be broadcast until the
+		// last channel of the batch has been signed.
+		if i == len(peers)-1 {
+			break
+		}
+		select {
+		case <-alice.publTxChan:
+			t.Fatalf("funding tx broadcast before batch was signed")
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	var publ *wire.MsgTx
+	select {
+	case publ = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx of batch broadcast twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	var result *batchFundResult
+	select {
+	case result = <-resultChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("batch funding did not complete")
+	}
+	if result.err != nil {
+		t.Fatalf("unable to fund batch: %v", result.err)
+	}
+	if len(result.outPoints) != len(peers) {
+		t.Fatalf("expected %v outpoints, got %v", len(peers),
+			len(result.outPoints))
+	}
+	for i, outPoint := range result.outPoints {
+		if *outPoint != fundingCreated[i].FundingPoint {
+			t.Fatalf("expected outpoint %v, got %v",
+				fundingCreated[i].FundingPoint, outPoint)
+		}
+		if outPoint.Hash != publ.TxHash() {
+			t.Fatalf("outpoint %v doesn't spend published tx %v",
+				outPoint, publ.TxHash())
+		}
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, alice, carolPubKey, 0)
+	assertNumPendingChannelsBecomes(t, alice, len(peers))
+}
+
+// TestFundingManagerBatchFundAbort checks that a batch is aborted as a whole
+// if the funding workflow of any of its channels fails.
+func TestFundingManagerBatchFundAbort(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+	carol := setupCarol(t, alice)
+	defer tearDownCarol(t, carol)
+
+	peers := []*batchPeer{{node: bob, alice: alice}, carol}
+	fundingCreated, resultChan := startBatchFund(t, alice, peers)
+
+	// Bob signs his channel, but Carol fails hers.
+	bob.fundingMgr.processFundingCreated(fundingCreated[0], alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	alice.fundingMgr.processFundingError(&lnwire.Error{
+		ChanID: fundingCreated[1].PendingChannelID,
+		Data:   []byte{byte(lnwire.ErrSynchronizingChain)},
+	}, carolPubKey)
+
+	// Alice should fail Bob's channel, and must neither publish the
+	// funding transaction nor keep any of the channels.
+	assertErrorSent(t, alice.msgChan)
+
+	var result *batchFundResult
+	select {
+	case result = <-resultChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("batch funding did not complete")
+	}
+	if result.err == nil {
+		t.Fatalf("expected batch to be aborted")
+	}
+
+	select {
+	case <-alice.publTxChan:
+		t.Fatalf("funding tx of aborted batch was broadcast")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, alice, carolPubKey, 0)
+	assertNumPendingChannelsRemains(t, alice, 0)
+	if len(alice.fundingMgr.cfg.Wallet.LockedOutpoints()) != 0 {
+		t.Fatalf("inputs of aborted batch still locked")
+	}
+}
+
+// forwardTxContribution forwards the messages the sending node uses to add
+// its inputs and outputs to the funding transaction of a dual funded channel
+// to the receiving node, until the sender signals that its contribution is
+// complete. The final TxComplete message is returned.
+func forwardTxContribution(t *testing.T, from, to *testNode) *lnwire.TxComplete {
+	for {
+		var msg lnwire.Message
+		select {
+		case msg = <-from.msgChan:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("peer did not send TxComplete message")
+		}
+
+		switch m := msg.(type) {
+		case *lnwire.TxAddInput:
+			to.fundingMgr.processTxAddInput(m, from)
+		case *lnwire.TxAddOutput:
+			to.fundingMgr.processTxAddOutput(m, from)
+		case *lnwire.TxComplete:
+			to.fundingMgr.processTxComplete(m, from)
+			return m
+		case *lnwire.Error:
+			t.Fatalf("expected funding tx contribution, instead "+
+				"got error: %v", lnwire.ErrorCode(m.Data[0]))
+		default:
+			t.Fatalf("expected funding tx contribution, instead "+
+				"got %T", msg)
+		}
+	}
+}
+
+// exchangeDualFundingMsgs runs the funding flow of a dual funded channel
+// initiated by alice, up until both of them have processed the signatures for
+// the inputs to the funding transaction. The open channel request, along with
+// the TxComplete messages sent by alice and bob, are returned.
+func exchangeDualFundingMsgs(t *testing.T, alice, bob *testNode,
+	localAmt btcutil.Amount) (*openChanReq, *lnwire.TxComplete,
+	*lnwire.TxComplete) {
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: localAmt,
+		pushAmt:         0,
+		fundingFeePerKw: lnwallet.FeePerKwFloor,
+		updates:         updateChan,
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	// Instead of FundingCreated, Alice should now send her contribution
+	// to the funding transaction, after which Bob answers with his own.
+	aliceComplete := forwardTxContribution(t, alice, bob)
+	bobComplete := forwardTxContribution(t, bob, alice)
+
+	// With the funding transaction constructed, the commitment signatures
+	// are exchanged, followed by the signatures for the inputs.
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+
+	var bobMsg lnwire.Message
+	select {
+	case bobMsg = <-bob.msgChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("bob did not send TxSignatures message")
+	}
+	bobSigs, ok := bobMsg.(*lnwire.TxSignatures)
+	if !ok {
+		t.Fatalf("expected TxSignatures to be sent from bob, "+
+			"instead got %T", bobMsg)
+	}
+	alice.fundingMgr.processTxSignatures(bobSigs, bob)
+
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send TxSignatures message")
+	}
+	aliceSigs, ok := aliceMsg.(*lnwire.TxSignatures)
+	if !ok {
+		t.Fatalf("expected TxSignatures to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+	bob.fundingMgr.processTxSignatures(aliceSigs, alice)
+
+	return initReq, aliceComplete, bobComplete
+}
+
+// TestFundingManagerDualFunding checks that Alice and Bob are able to jointly
+// fund a channel when both of them signal support for dual funding, with Bob
+// contributing up to his configured maximum.
+func TestFundingManagerDualFunding(t *testing.T) {
+	const localAmt = btcutil.Amount(500000)
+
+	tests := []struct {
+		name    string
+		bobMax  btcutil.Amount
+		bobAmt  btcutil.Amount
+		chanTyp channeldb.ChannelType
+	}{
+		{
+			name:    "bob contributes",
+			bobMax:  300000,
+			bobAmt:  300000,
+			chanTyp: channeldb.DualFunder,
+		},
+		{
+			name:    "bob contribution capped at capacity",
+			bobMax:  btcutil.SatoshiPerBitcoin,
+			bobAmt:  localAmt,
+			chanTyp: channeldb.DualFunder,
+		},
+		{
+			name:    "bob contributes nothing",
+			bobMax:  0,
+			bobAmt:  0,
+			chanTyp: channeldb.SingleFunder,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testDualFunding(
+				t, localAmt, test.bobMax, test.bobAmt,
+				test.chanTyp,
+			)
+		})
+	}
+}
+
+func testDualFunding(t *testing.T, localAmt, bobMax, bobAmt btcutil.Amount,
+	chanType channeldb.ChannelType) {
+
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Both nodes advertise dual funding, and know of each other's unspent
+	// outputs, such that they're able to verify the remote inputs.
+	alice.localFeatures.Set(lnwire.DualFundOptional)
+	bob.localFeatures.Set(lnwire.DualFundOptional)
+	alice.fundingMgr.cfg.DualFunding = true
+	bob.fundingMgr.cfg.DualFunding = true
+	alice.chainIO.wallets = []*mockWalletController{
+		alice.walletCtrl, bob.walletCtrl,
+	}
+	bob.chainIO.wallets = alice.chainIO.wallets
+	bob.fundingMgr.cfg.MaxDualFundContribution = bobMax
+
+	initReq, aliceComplete, bobComplete := exchangeDualFundingMsgs(
+		t, alice, bob, localAmt,
+	)
+	if aliceComplete.FundingAmount != localAmt {
+		t.Fatalf("expected alice to fund %v, got %v", localAmt,
+			aliceComplete.FundingAmount)
+	}
+	if bobComplete.FundingAmount != bobAmt {
+		t.Fatalf("expected bob to fund %v, got %v", bobAmt,
+			bobComplete.FundingAmount)
+	}
+
+	var pendingUpdate *lnrpc.OpenStatusUpdate
+	select {
+	case pendingUpdate = <-initReq.updates:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+	if _, ok := pendingUpdate.Update.(*lnrpc.OpenStatusUpdate_ChanPending); !ok {
+		t.Fatal("OpenStatusUpdate was not OpenStatusUpdate_ChanPending")
+	}
+
+	// Both of them should broadcast the same, fully signed, funding
+	// transaction.
+	var alicePubl, bobPubl *wire.MsgTx
+	select {
+	case alicePubl = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+	select {
+	case bobPubl = <-bob.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("bob did not publish funding tx")
+	}
+	if alicePubl.TxHash() != bobPubl.TxHash() {
+		t.Fatalf("alice published %v, bob published %v",
+			alicePubl.TxHash(), bobPubl.TxHash())
+	}
+
+	numInputs := 1
+	if bobAmt > 0 {
+		numInputs++
+	}
+	if len(alicePubl.TxIn) != numInputs {
+		t.Fatalf("expected %d funding inputs, got %d", numInputs,
+			len(alicePubl.TxIn))
+	}
+
+	hashCache := txscript.NewTxSigHashes(alicePubl)
+	for i, txIn := range alicePubl.TxIn {
+		prevOut, err := alice.chainIO.GetUtxo(
+			&txIn.PreviousOutPoint, nil, 0,
+		)
+		if err != nil || prevOut == nil {
+			t.Fatalf("unknown funding input %v", txIn.PreviousOutPoint)
+		}
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, alicePubl, i,
+			txscript.StandardVerifyFlags, nil, hashCache,
+			prevOut.Value,
+		)
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+		if err := vm.Execute(); err != nil {
+			t.Fatalf("funding input %d invalid: %v", i, err)
+		}
+	}
+
+	assertNumPendingReservations(t, alice, bobPubKey, 0)
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+	assertNumPendingChannelsBecomes(t, alice, 1)
+	assertNumPendingChannelsBecomes(t, bob, 1)
+
+	// Finally, the capacity and balances of the channel should reflect
+	// the funds contributed by both of them.
+	capacity := localAmt + bobAmt
+	bobBalance := lnwire.NewMSatFromSatoshis(bobAmt)
+	for _, node := range []*testNode{alice, bob} {
+		pendingChannels, err := node.fundingMgr.
+			cfg.Wallet.Cfg.Database.FetchPendingChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch pending channels: %v", err)
+		}
+		channel := pendingChannels[0]
+
+		if channel.Capacity != capacity {
+			t.Fatalf("expected capacity %v, got %v", capacity,
+				channel.Capacity)
+		}
+		if channel.ChanType != chanType {
+			t.Fatalf("expected channel type %v, got %v", chanType,
+				channel.ChanType)
+		}
+		fundingOut := alicePubl.TxOut[channel.FundingOutpoint.Index]
+		if btcutil.Amount(fundingOut.Value) != capacity {
+			t.Fatalf("expected funding output of %v, got %v",
+				capacity, fundingOut.Value)
+		}
+
+		commit := channel.LocalCommitment
+		balance := commit.RemoteBalance
+		if node == bob {
+			balance = commit.LocalBalance
+		}
+		if balance != bobBalance {
+			t.Fatalf("expected bob's balance to be %v, got %v",
+				bobBalance, balance)
+		}
+	}
+}
+
+// TestFundingManagerDualFundingInvalidInput checks that the initiator of a
+// dual funded channel fails the funding flow if the responder adds an input
+// to the funding transaction that it can't verify to hold the claimed funds.
+func TestFundingManagerDualFundingInvalidInput(t *testing.T) {
+	tests := []struct {
+		name string
+
+		// knowBobUtxos is true if alice is able to find bob's unspent
+		// outputs within the UTXO set.
+		knowBobUtxos bool
+
+		// inflateValue is added to the value of each of bob's inputs.
+		inflateValue btcutil.Amount
+
+		// p2wsh is true if bob's inputs claim to spend p2wsh outputs,
+		// whose witness size alice can't know.
+		p2wsh bool
+	}{
+		{
+			name:         "unknown input",
+			knowBobUtxos: false,
+		},
+		{
+			name:         "inflated input value",
+			knowBobUtxos: true,
+			inflateValue: btcutil.SatoshiPerBitcoin,
+		},
+		{
+			name:         "p2wsh input",
+			knowBobUtxos: true,
+			p2wsh:        true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			alice, bob := setupFundingManagers(
+				t, defaultMaxPendingChannels,
+			)
+			defer tearDownFundingManagers(t, alice, bob)
+
+			alice.localFeatures.Set(lnwire.DualFundOptional)
+			bob.localFeatures.Set(lnwire.DualFundOptional)
+			alice.fundingMgr.cfg.DualFunding = true
+			bob.fundingMgr.cfg.DualFunding = true
+			alice.chainIO.wallets = []*mockWalletController{
+				alice.walletCtrl,
+			}
+			if test.knowBobUtxos {
+				alice.chainIO.wallets = append(
+					alice.chainIO.wallets, bob.walletCtrl,
+				)
+			}
+			bob.chainIO.wallets = []*mockWalletController{
+				alice.walletCtrl, bob.walletCtrl,
+			}
+			bob.fundingMgr.cfg.MaxDualFundContribution = 300000
+
+			updateChan := make(chan *lnrpc.OpenStatusUpdate)
+			errChan := make(chan error, 1)
+			initReq := &openChanReq{
+				targetPubkey:    bob.privKey.PubKey(),
+				chainHash:       *activeNetParams.GenesisHash,
+				localFundingAmt: 500000,
+				pushAmt:         0,
+				fundingFeePerKw: lnwallet.FeePerKwFloor,
+				updates:         updateChan,
+				err:             errChan,
+			}
+			alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+			openChannelReq := assertFundingMsgSent(
+				t, alice.msgChan, "OpenChannel",
+			).(*lnwire.OpenChannel)
+			bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+			acceptChannelResponse := assertFundingMsgSent(
+				t, bob.msgChan, "AcceptChannel",
+			).(*lnwire.AcceptChannel)
+			alice.fundingMgr.processFundingAccept(
+				acceptChannelResponse, bob,
+			)
+
+			forwardTxContribution(t, alice, bob)
+
+			// Bob's inputs are tampered with before being handed
+			// to alice. If they're made out to spend p2wsh
+			// outputs, then those are part of the UTXO set, so
+			// alice can only reject them based on their type.
+			p2wsh := append(
+				[]byte{txscript.OP_0, txscript.OP_DATA_32},
+				bytes.Repeat([]byte{1}, 32)...,
+			)
+			utxos := make(map[wire.OutPoint]*wire.TxOut)
+			alice.chainIO.utxos = utxos
+			for done := false; !done; {
+				var msg lnwire.Message
+				select {
+				case msg = <-bob.msgChan:
+				case <-time.After(time.Second * 5):
+					t.Fatalf("bob did not send TxComplete")
+				}
+
+				switch m := msg.(type) {
+				case *lnwire.TxAddInput:
+					m.Value += test.inflateValue
+					if test.p2wsh {
+						m.PkScript = p2wsh
+						utxos[m.PreviousOutPoint] = &wire.TxOut{
+							Value:    int64(m.Value),
+							PkScript: p2wsh,
+						}
+					}
+					alice.fundingMgr.processTxAddInput(m, bob)
+				case *lnwire.TxAddOutput:
+					alice.fundingMgr.processTxAddOutput(m, bob)
+				case *lnwire.TxComplete:
+					alice.fundingMgr.processTxComplete(m, bob)
+					done = true
+				default:
+					t.Fatalf("expected funding tx "+
+						"contribution, instead got %T",
+						msg)
+				}
+			}
+
+			// Alice should refuse to sign anything for the
+			// channel, and fail the funding flow instead.
+			assertErrorSent(t, alice.msgChan)
+			select {
+			case <-errChan:
+			case <-time.After(time.Second * 5):
+				t.Fatalf("funding flow was not failed")
+			}
+			assertNumPendingReservations(t, alice, bobPubKey, 0)
+			assertNumPendingChannelsRemains(t, alice, 0)
+		})
+	}
+}
+
+// TestFundingManagerDualFundingDisabled checks that the funding transaction
+// isn't constructed interactively with a peer that signals support for it,
+// unless we've been configured to do so as well.
+func TestFundingManagerDualFundingDisabled(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	alice.localFeatures.Set(lnwire.DualFundOptional)
+	bob.localFeatures.Set(lnwire.DualFundOptional)
+	bob.fundingMgr.cfg.DualFunding = true
+
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		updates:         make(chan *lnrpc.OpenStatusUpdate),
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+
+	// As alice isn't configured to construct the funding transaction
+	// interactively, she should go on with the regular funding flow.
+	assertFundingMsgSent(t, alice.msgChan, "FundingCreated")
+}
+
+// TestFundingManagerDualFundingDoubleSpend checks that a dual funded channel
+// is abandoned, and the inputs to its funding transaction released, if the
+// funding transaction is rejected as it double spends one of its inputs.
+func TestFundingManagerDualFundingDoubleSpend(t *testing.T) {
+	for _, aliceFails := range []bool{true, false} {
+		aliceFails := aliceFails
+		name := "responder"
+		if aliceFails {
+			name = "initiator"
+		}
+		t.Run(name, func(t *testing.T) {
+			alice, bob := setupFundingManagers(
+				t, defaultMaxPendingChannels,
+			)
+			defer tearDownFundingManagers(t, alice, bob)
+
+			alice.localFeatures.Set(lnwire.DualFundOptional)
+			bob.localFeatures.Set(lnwire.DualFundOptional)
+			alice.fundingMgr.cfg.DualFunding = true
+			bob.fundingMgr.cfg.DualFunding = true
+			alice.chainIO.wallets = []*mockWalletController{
+				alice.walletCtrl, bob.walletCtrl,
+			}
+			bob.chainIO.wallets = alice.chainIO.wallets
+			bob.fundingMgr.cfg.MaxDualFundContribution = 300000
+
+			failing, other := bob, alice
+			if aliceFails {
+				failing, other = alice, bob
+			}
+			failing.fundingMgr.cfg.PublishTransaction =
+				func(*wire.MsgTx) error {
+					return lnwallet.ErrDoubleSpend
+				}
+
+			initReq, _, _ := exchangeDualFundingMsgs(
+				t, alice, bob, 500000,
+			)
+
+			// The failing node should tell its peer that the
+			// channel is abandoned, while the other node still
+			// broadcasts the funding transaction.
+			assertErrorSent(t, failing.msgChan)
+			select {
+			case <-other.publTxChan:
+			case <-time.After(time.Second * 5):
+				t.Fatalf("funding tx not published")
+			}
+
+			if aliceFails {
+				select {
+				case err := <-initReq.err:
+					if err != errDualFundingBroadcast {
+						t.Fatalf("expected %v, got %v",
+							errDualFundingBroadcast, err)
+					}
+				case <-time.After(time.Second * 5):
+					t.Fatalf("funding flow was not failed")
+				}
+			} else {
+				select {
+				case <-initReq.updates:
+				case <-time.After(time.Second * 5):
+					t.Fatalf("alice did not send " +
+						"OpenStatusUpdate_ChanPending")
+				}
+			}
+
+			assertNumPendingChannelsRemains(t, failing, 0)
+			assertNumPendingChannelsBecomes(t, other, 1)
+			locked := failing.fundingMgr.cfg.Wallet.LockedOutpoints()
+			if len(locked) != 0 {
+				t.Fatalf("inputs of abandoned channel still " +
+					"locked")
+			}
+		})
+	}
+}
+
+// TestFundingManagerWumboChannels tests that channels above the soft-limit for
+// channel size are only opened if both parties signal support for them, and
+// the responder's max channel size permits them.
+func TestFundingManagerWumboChannels(t *testing.T) {
+	const chanAmt = maxBtcFundingAmount + 1
+
+	tests := []struct {
+		name       string
+		aliceWumbo bool
+		bobWumbo   bool
+		bobMaxSize btcutil.Amount
+
+		// initErr is true if alice should refuse to initiate the
+		// funding flow.
+		initErr bool
+
+		// accepted is true if bob should accept the channel.
+		accepted bool
+	}{
+		{
+			name:       "neither signals wumbo",
+			bobMaxSize: maxBtcFundingAmountWumbo,
+			initErr:    true,
+		},
+		{
+			name:       "only initiator signals wumbo",
+			aliceWumbo: true,
+			bobMaxSize: maxBtcFundingAmountWumbo,
+			initErr:    true,
+		},
+		{
+			name:       "max chan size of responder exceeded",
+			aliceWumbo: true,
+			bobWumbo:   true,
+			bobMaxSize: maxBtcFundingAmount,
+		},
+		{
+			name:       "both signal wumbo",
+			aliceWumbo: true,
+			bobWumbo:   true,
+			bobMaxSize: maxBtcFundingAmountWumbo,
+			accepted:   true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			alice, bob := setupFundingManagers(
+				t, defaultMaxPendingChannels,
+			)
+			defer tearDownFundingManagers(t, alice, bob)
+
+			if test.aliceWumbo {
+				alice.fundingMgr.cfg.WumboChannels = true
+				alice.localFeatures.Set(
+					lnwire.WumboChannelsOptional,
+				)
+			}
+			if test.bobWumbo {
+				bob.fundingMgr.cfg.WumboChannels = true
+				bob.localFeatures.Set(
+					lnwire.WumboChannelsOptional,
+				)
+			}
+			bob.fundingMgr.cfg.MaxChanSize = test.bobMaxSize
+
+			updateChan := make(chan *lnrpc.OpenStatusUpdate)
+			errChan := make(chan error, 1)
+			initReq := &openChanReq{
+				targetPubkey:    bob.privKey.PubKey(),
+				chainHash:       *activeNetParams.GenesisHash,
+				localFundingAmt: chanAmt,
+				pushAmt:         0,
+				updates:         updateChan,
+				err:             errChan,
+			}
+			alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+			var aliceMsg lnwire.Message
+			select {
+			case aliceMsg = <-alice.msgChan:
+			case err := <-initReq.err:
+				if test.initErr {
+					return
+				}
+				t.Fatalf("error init funding workflow: %v", err)
+			case <-time.After(time.Second * 5):
+				t.Fatalf("alice did not send OpenChannel message")
+			}
+			if test.initErr {
+				t.Fatalf("alice initiated wumbo channel with "+
+					"peer not signaling support: %T",
+					aliceMsg)
+			}
+
+			openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+			if !ok {
+				t.Fatalf("expected OpenChannel to be sent "+
+					"from alice, instead got %T", aliceMsg)
+			}
+			bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+			if test.accepted {
+				assertFundingMsgSent(
+					t, bob.msgChan, "AcceptChannel",
+				)
+				return
+			}
+			assertFundingMsgSent(t, bob.msgChan, "Error")
+		})
+	}
+}
+
+// mockChanAcceptor is a channel acceptor which records the requests it
+// receives, and returns a fixed response.
+type mockChanAcceptor struct {
+	requests chan *chanacceptor.ChannelAcceptRequest
+	resp     *chanacceptor.ChannelAcceptResponse
+}
+
+func (m *mockChanAcceptor) Accept(
+	req *chanacceptor.ChannelAcceptRequest) *chanacceptor.ChannelAcceptResponse {
+
+	m.requests <- req
+	return m.resp
+}
+
+// TestFundingManagerChannelAcceptor tests that inbound channel requests are
+// passed to the channel acceptors, and that the reason for a rejection is
+// sent to the remote peer.
+func TestFundingManagerChannelAcceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		resp     *chanacceptor.ChannelAcceptResponse
+		errorMsg string
+	}{
+		{
+			name: "accept",
+			resp: chanacceptor.NewAcceptResponse(),
+		},
+		{
+			name:     "reject with reason",
+			resp:     chanacceptor.NewRejectResponse("go away"),
+			errorMsg: "go away",
+		},
+		{
+			name:     "reject without reason",
+			resp:     chanacceptor.NewRejectResponse(""),
+			errorMsg: "channel rejected",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			alice, bob := setupFundingManagers(
+				t, defaultMaxPendingChannels,
+			)
+			defer tearDownFundingManagers(t, alice, bob)
+
+			acceptor := &mockChanAcceptor{
+				requests: make(
+					chan *chanacceptor.ChannelAcceptRequest, 1,
+				),
+				resp: test.resp,
+			}
+			chained := chanacceptor.NewChainedAcceptor()
+			chained.AddAcceptor(acceptor)
+			bob.fundingMgr.cfg.OpenChannelPredicate = chained
+
+			updateChan := make(chan *lnrpc.OpenStatusUpdate)
+			errChan := make(chan error, 1)
+			initReq := &openChanReq{
+				targetPubkey:    bob.privKey.PubKey(),
+				chainHash:       *activeNetParams.GenesisHash,
+				localFundingAmt: 500000,
+				pushAmt:         0,
+				private:         true,
+				updates:         updateChan,
+				err:             errChan,
+			}
+			alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+			openChannelReq := assertFundingMsgSent(
+				t, alice.msgChan, "OpenChannel",
+			).(*lnwire.OpenChannel)
+			bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+			// The acceptor should be presented with alice's
+			// request.
+			select {
+			case req := <-acceptor.requests:
+				if !req.Node.IsEqual(alice.privKey.PubKey()) {
+					t.Fatalf("wrong node in request")
+				}
+				if req.OpenChanMsg.FundingAmount != 500000 {
+					t.Fatalf("wrong funding amount in "+
+						"request: %v",
+						req.OpenChanMsg.FundingAmount)
+				}
+				if req.OpenChanMsg.ChannelFlags&
+					lnwire.FFAnnounceChannel != 0 {
+
+					t.Fatalf("private channel announced")
+				}
+			case <-time.After(time.Second * 5):
+				t.Fatalf("acceptor not consulted")
+			}
+
+			if test.resp.Accept {
+				assertFundingMsgSent(
+					t, bob.msgChan, "AcceptChannel",
+				)
+				return
+			}
+
+			errMsg := assertFundingMsgSent(
+				t, bob.msgChan, "Error",
+			).(*lnwire.Error)
+			if string(errMsg.Data) != test.errorMsg {
+				t.Fatalf("expected error %q, got %q",
+					test.errorMsg, errMsg.Data)
+			}
+		})
+	}
+}
+
+// TestFundingManagerChannelAcceptorNonBlocking tests that the funding manager
+// keeps processing requests while a channel acceptor decides on an inbound
+// channel.
+func TestFundingManagerChannelAcceptorNonBlocking(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// The acceptor blocks until we read the request it's presented with.
+	acceptor := &mockChanAcceptor{
+		requests: make(chan *chanacceptor.ChannelAcceptRequest),
+		resp:     chanacceptor.NewAcceptResponse(),
+	}
+	chained := chanacceptor.NewChainedAcceptor()
+	chained.AddAcceptor(acceptor)
+	bob.fundingMgr.cfg.OpenChannelPredicate = chained
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         0,
+		updates:         updateChan,
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	// While the acceptor is deciding, bob should still be able to answer
+	// queries.
+	queryErr := make(chan error, 1)
+	go func() {
+		_, err := bob.fundingMgr.PendingChannels()
+		queryErr <- err
+	}()
+	select {
+	case err := <-queryErr:
+		if err != nil {
+			t.Fatalf("unable to query pending channels: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding manager blocked on channel acceptor")
+	}
+
+	// Once the acceptor decides, the funding workflow should continue.
+	select {
+	case <-acceptor.requests:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("acceptor not consulted")
+	}
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+}
+
+// TestFundingManagerUpfrontShutdown tests that an upfront shutdown script is
+// only committed to if the remote peer supports it, that non-standard scripts
+// are rejected, and that the committed script is stored by both parties.
+func TestFundingManagerUpfrontShutdown(t *testing.T) {
+	// A P2WPKH script is one of the standard scripts allowed within a
+	// cooperative close.
+	script := append([]byte{0x00, 0x14}, bytes.Repeat([]byte{0x01}, 20)...)
+
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// As Bob doesn't signal support for upfront shutdown scripts, Alice
+	// should refuse to commit to one.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		shutdownScript:  script,
+		updates:         updateChan,
+		err:             make(chan error, 1),
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	select {
+	case <-initReq.err:
+	case msg := <-alice.msgChan:
+		t.Fatalf("expected funding workflow to fail, instead alice "+
+			"sent %T", msg)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not fail the funding workflow")
+	}
+
+	// Once Bob signals support, Alice should commit to the script within
+	// her OpenChannel message.
+	bob.localFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+	initReq.err = make(chan error, 1)
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if !bytes.Equal(openChannelReq.UpfrontShutdownScript, script) {
+		t.Fatalf("expected upfront shutdown script %x, got %x",
+			script, openChannelReq.UpfrontShutdownScript)
+	}
+
+	// A non-standard script should be rejected by Bob.
+	nonStandard := *openChannelReq
+	nonStandard.PendingChannelID = [32]byte{0xff}
+	nonStandard.UpfrontShutdownScript = []byte{txscript.OP_TRUE}
+	bob.fundingMgr.processFundingOpen(&nonStandard, alice)
+	assertFundingMsgSent(t, bob.msgChan, "Error")
+
+	// We'll now run through the rest of the funding flow with the
+	// original request.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+	select {
+	case <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	// Both parties should have stored the script Alice committed to, while
+	// Bob didn't commit to a script of his own.
+	assertShutdownScripts := func(node *testNode, local, remote []byte) {
+		t.Helper()
+
+		pendingChannels, err := node.fundingMgr.cfg.Wallet.Cfg.
+			Database.FetchPendingChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch pending channels: %v", err)
+		}
+		if len(pendingChannels) != 1 {
+			t.Fatalf("expected 1 pending channel, got %v",
+				len(pendingChannels))
+		}
+
+		channel := pendingChannels[0]
+		if !bytes.Equal(channel.LocalShutdownScript, local) {
+			t.Fatalf("expected local shutdown script %x, got %x",
+				local, channel.LocalShutdownScript)
+		}
+		if !bytes.Equal(channel.RemoteShutdownScript, remote) {
+			t.Fatalf("expected remote shutdown script %x, got %x",
+				remote, channel.RemoteShutdownScript)
+		}
+	}
+	assertShutdownScripts(alice, script, nil)
+	assertShutdownScripts(bob, nil, script)
+}
+
+// openZeroConfTestChannel runs through the funding flow of a private zero-conf
+// channel from Alice to Bob, up until the point at which both consider the
+// channel open under its alias, while the funding transaction is still
+// unconfirmed. The funding outpoint is returned.
+func openZeroConfTestChannel(t *testing.T, alice, bob *testNode,
+	updateChan chan *lnrpc.OpenStatusUpdate) *wire.OutPoint {
+
+	t.Helper()
+
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		private:         true,
+		zeroConf:        true,
+		updates:         updateChan,
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if !openChannelReq.ChannelType.IsSet(lnwire.ZeroConfRequired) {
+		t.Fatalf("expected OpenChannel to ask for a zero-conf channel")
+	}
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	if acceptChannelResponse.MinAcceptDepth != 0 {
+		t.Fatalf("expected min accept depth of 0, got %v",
+			acceptChannelResponse.MinAcceptDepth)
+	}
+	if !acceptChannelResponse.ChannelType.IsSet(lnwire.ZeroConfRequired) {
+		t.Fatalf("expected AcceptChannel to echo the channel type")
+	}
+
+	alice.fundingMgr.processFundingAccept(acceptChannelResponse, bob)
+	fundingCreated := assertFundingMsgSent(
+		t, alice.msgChan, "FundingCreated",
+	).(*lnwire.FundingCreated)
+
+	bob.fundingMgr.processFundingCreated(fundingCreated, alice)
+	fundingSigned := assertFundingMsgSent(
+		t, bob.msgChan, "FundingSigned",
+	).(*lnwire.FundingSigned)
+
+	alice.fundingMgr.processFundingSigned(fundingSigned, bob)
+	select {
+	case <-updateChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenStatusUpdate_ChanPending")
+	}
+
+	var publ *wire.MsgTx
+	select {
+	case publ = <-alice.publTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not publish funding tx")
+	}
+	fundingOutPoint := &wire.OutPoint{
+		Hash:  publ.TxHash(),
+		Index: 0,
+	}
+
+	// Without the funding transaction being confirmed, both should send
+	// FundingLocked right away.
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+
+	// Both should add the channel to their graph under the same alias.
+	alias := zeroConfAlias(*fundingOutPoint)
+	if !alias.IsAlias() {
+		t.Fatalf("derived short chan id %v isn't an alias", alias)
+	}
+	assertChannelAnnouncementScid(t, alice, alias)
+	assertChannelAnnouncementScid(t, bob, alias)
+	assertAddedToRouterGraph(t, alice, bob, fundingOutPoint)
+
+	waitForOpenUpdate(t, updateChan)
+
+	alice.fundingMgr.processFundingLocked(fundingLockedBob, bob)
+	bob.fundingMgr.processFundingLocked(fundingLockedAlice, alice)
+	assertHandleFundingLocked(t, alice, bob)
+
+	return fundingOutPoint
+}
+
+// assertChannelAnnouncementScid asserts that the node sends a
+// ChannelAnnouncement and ChannelUpdate for the given short channel ID to its
+// gossiper.
+func assertChannelAnnouncementScid(t *testing.T, node *testNode,
+	scid lnwire.ShortChannelID) {
+
+	t.Helper()
+
+	for i := 0; i < 2; i++ {
+		var msg lnwire.Message
+		select {
+		case msg = <-node.announceChan:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("node did not send announcement: %v", i)
+		}
+
+		var msgScid lnwire.ShortChannelID
+		switch m := msg.(type) {
+		case *lnwire.ChannelAnnouncement:
+			msgScid = m.ShortChannelID
+		case *lnwire.ChannelUpdate:
+			msgScid = m.ShortChannelID
+		default:
+			t.Fatalf("unexpected announcement %T", msg)
+		}
+
+		if msgScid != scid {
+			t.Fatalf("expected short chan id %v, got %v", scid,
+				msgScid)
+		}
+	}
+}
+
+// TestFundingManagerZeroConf tests that a zero-conf channel opened with a
+// trusted peer is usable before its funding transaction confirms, and that
+// it's moved onto its confirmed short channel ID once it does.
+func TestFundingManagerZeroConf(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	alice.localFeatures.Set(lnwire.ZeroConfOptional)
+	bob.localFeatures.Set(lnwire.ZeroConfOptional)
+	bob.fundingMgr.cfg.AllowZeroConf = func(*btcec.PublicKey) bool {
+		return true
+	}
+
+	aliasDeleted := make(chan struct{}, 2)
+	deleteAliasEdge := func(*wire.OutPoint) error {
+		aliasDeleted <- struct{}{}
+		return nil
+	}
+	alice.fundingMgr.cfg.DeleteAliasEdge = deleteAliasEdge
+	bob.fundingMgr.cfg.DeleteAliasEdge = deleteAliasEdge
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint := openZeroConfTestChannel(t, alice, bob, updateChan)
+
+	// Once the funding transaction confirms, both should replace the
+	// alias edge by the edge of the confirmed channel.
+	confirmedScid := lnwire.ShortChannelID{
+		BlockHeight: 500,
+		TxIndex:     3,
+	}
+	conf := &chainntnfs.TxConfirmation{
+		BlockHeight: confirmedScid.BlockHeight,
+		TxIndex:     confirmedScid.TxIndex,
+	}
+	alice.mockNotifier.oneConfChannel <- conf
+	bob.mockNotifier.oneConfChannel <- conf
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-aliasDeleted:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("alias edge not deleted")
+		}
+	}
+	assertChannelAnnouncementScid(t, alice, confirmedScid)
+	assertChannelAnnouncementScid(t, bob, confirmedScid)
+
+	// As the channel is private, only the node announcements are sent,
+	// after which the opening process is complete.
+	assertFundingMsgSent(t, alice.msgChan, "NodeAnnouncement")
+	assertFundingMsgSent(t, bob.msgChan, "NodeAnnouncement")
+	assertNoChannelState(t, alice, bob, fundingOutPoint)
+
+	// The channel should be known under its confirmed short channel ID,
+	// while its alias is retained.
+	for _, node := range []*testNode{alice, bob} {
+		channels, err := node.fundingMgr.cfg.Wallet.Cfg.Database.
+			FetchAllOpenChannels()
+		if err != nil {
+			t.Fatalf("unable to fetch channels: %v", err)
+		}
+		if len(channels) != 1 {
+			t.Fatalf("expected 1 channel, got %v", len(channels))
+		}
+		if channels[0].ShortChanID() != confirmedScid {
+			t.Fatalf("expected short chan id %v, got %v",
+				confirmedScid, channels[0].ShortChanID())
+		}
+		if channels[0].AliasShortChanID !=
+			zeroConfAlias(*fundingOutPoint) {
+
+			t.Fatalf("alias not retained")
+		}
+	}
+}
+
+// TestFundingManagerZeroConfDoubleSpend tests that a zero-conf channel is
+// failed if its funding transaction is double spent.
+func TestFundingManagerZeroConfDoubleSpend(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	alice.localFeatures.Set(lnwire.ZeroConfOptional)
+	bob.localFeatures.Set(lnwire.ZeroConfOptional)
+	bob.fundingMgr.cfg.AllowZeroConf = func(*btcec.PublicKey) bool {
+		return true
+	}
+
+	failedChans := make(chan wire.OutPoint, 1)
+	alice.fundingMgr.cfg.FailZeroConfChannel = func(op wire.OutPoint) error {
+		failedChans <- op
+		return nil
+	}
+	alice.mockNotifier.spendChan = make(chan *chainntnfs.SpendDetail, 1)
+
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	fundingOutPoint := openZeroConfTestChannel(t, alice, bob, updateChan)
+
+	// Bob is online, so Alice should be able to tell him about the failed
+	// channel right away.
+	alice.fundingMgr.cfg.NotifyWhenOnline = func(peer *btcec.PublicKey,
+		connectedChan chan<- lnpeer.Peer) {
+
+		connectedChan <- bob
+	}
+
+	// We'll now notify Alice of an input of the funding transaction being
+	// spent by another transaction.
+	doubleSpend := chainhash.Hash{0x01}
+	alice.mockNotifier.spendChan <- &chainntnfs.SpendDetail{
+		SpentOutPoint: &wire.OutPoint{},
+		SpenderTxHash: &doubleSpend,
+	}
+
+	select {
+	case op := <-failedChans:
+		if op != *fundingOutPoint {
+			t.Fatalf("expected ChannelPoint(%v) to be failed, "+
+				"got %v", fundingOutPoint, op)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("zero-conf channel not failed")
+	}
+
+	errMsg := assertFundingMsgSent(t, alice.msgChan, "Error").(*lnwire.Error)
+	if errMsg.ChanID != lnwire.NewChanIDFromOutPoint(fundingOutPoint) {
+		t.Fatalf("error sent for wrong channel %v", errMsg.ChanID)
+	}
+
+	// The channel should be closed, and its opening state forgotten.
+	assertErrChannelNotFound(t, alice, fundingOutPoint)
+	channels, err := alice.fundingMgr.cfg.Wallet.Cfg.Database.
+		FetchAllOpenChannels()
+	if err != nil {
+		t.Fatalf("unable to fetch channels: %v", err)
+	}
+	if len(channels) != 0 {
+		t.Fatalf("expected no open channels, got %v", len(channels))
+	}
+}
+
+// TestFundingManagerZeroConfUntrusted tests that a zero-conf channel is
+// rejected if the initiator isn't trusted.
+func TestFundingManagerZeroConfUntrusted(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	alice.localFeatures.Set(lnwire.ZeroConfOptional)
+	bob.localFeatures.Set(lnwire.ZeroConfOptional)
+
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		zeroConf:        true,
+		updates:         make(chan *lnrpc.OpenStatusUpdate),
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	assertFundingMsgSent(t, bob.msgChan, "Error")
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+}
+
+// TestFundingManagerZeroConfNotRequested tests that a channel opened by a
+// trusted peer isn't considered zero-conf unless the peer explicitly asked
+// for it.
+func TestFundingManagerZeroConfNotRequested(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	alice.localFeatures.Set(lnwire.ZeroConfOptional)
+	bob.localFeatures.Set(lnwire.ZeroConfOptional)
+	bob.fundingMgr.cfg.AllowZeroConf = func(*btcec.PublicKey) bool {
+		return true
+	}
+
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		updates:         make(chan *lnrpc.OpenStatusUpdate),
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if openChannelReq.ChannelType != nil {
+		t.Fatalf("expected no channel type, got %v",
+			openChannelReq.ChannelType)
+	}
+
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+	acceptChannelResponse := assertFundingMsgSent(
+		t, bob.msgChan, "AcceptChannel",
+	).(*lnwire.AcceptChannel)
+	if acceptChannelResponse.MinAcceptDepth == 0 {
+		t.Fatalf("expected non-zero min accept depth")
+	}
+	if acceptChannelResponse.ChannelType != nil {
+		t.Fatalf("expected no channel type, got %v",
+			acceptChannelResponse.ChannelType)
+	}
+}
@@ -114,6 +114,19 @@ type ChannelLink interface {
 	// have buffered messages.
 	AttachMailBox(MailBox)
 
+	// Quiesce requests the link to bring the channel into a quiescent
+	// state, in which neither party proposes any further updates. The
+	// returned channel is sent upon once the channel is quiescent, or the
+	// request failed.
+	Quiesce() <-chan error
+
+	// IsQuiescent returns true if the channel is in a quiescent state.
+	IsQuiescent() bool
+
+	// ExitQuiescence ends the quiescence of the channel, returning it to
+	// its normal state in which both parties may propose updates again.
+	ExitQuiescence()
+
 	// Start/Stop are used to initiate the start/stop of the channel link
 	// functioning.
 	Start() error
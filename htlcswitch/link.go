@@ -54,6 +54,11 @@ const (
 	// DefaultMaxLinkFeeUpdateTimeout represents the maximum interval in
 	// which a link should propose to update its commitment fee rate.
 	DefaultMaxLinkFeeUpdateTimeout = 60 * time.Minute
+
+	// DefaultQuiescenceTimeout is the time after which a link returns to
+	// its normal state if the quiescence handshake hasn't completed, or
+	// the channel hasn't been used while quiescent.
+	DefaultQuiescenceTimeout = time.Minute
 )
 
 // ForwardingPolicy describes the set of constraints that a given ChannelLink
@@ -237,6 +242,19 @@ type ChannelLinkConfig struct {
 	// fee rate. A random timeout will be selected between these values.
 	MinFeeUpdateTimeout time.Duration
 	MaxFeeUpdateTimeout time.Duration
+
+	// StartQuiescent indicates that the link should start out quiescent,
+	// refraining from proposing any updates to the channel. This is the
+	// case for channels with a pending splice, which remain quiescent
+	// until the splice is locked in.
+	StartQuiescent bool
+
+	// QuiescenceTimeout is the time after which the link returns to its
+	// normal state if the quiescence handshake hasn't completed, or the
+	// quiescent channel hasn't been claimed by a call to Quiesce. If
+	// zero, the link remains quiescent until quiescence is explicitly
+	// ended.
+	QuiescenceTimeout time.Duration
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -246,8 +264,10 @@ type ChannelLinkConfig struct {
 // message ordering and updates.
 type channelLink struct {
 	// The following fields are only meant to be used *atomically*
-	started  int32
-	shutdown int32
+	started   int32
+	shutdown  int32
+	quiescent int32
+	quiescing int32
 
 	// failed should be set to true in case a link error happens, making
 	// sure we don't process any more updates.
@@ -331,6 +351,39 @@ type channelLink struct {
 	// commitment fee every time it fires.
 	updateFeeTimer *time.Timer
 
+	// quiesceReqs receives requests to bring the channel into a quiescent
+	// state, in which neither party proposes any updates to the channel.
+	quiesceReqs chan chan error
+
+	// quiesceWaiters are the pending quiescence requests, which are
+	// notified once the channel becomes quiescent.
+	quiesceWaiters []chan error
+
+	// stfuSent and stfuReceived track the quiescence handshake with the
+	// remote peer. Once we've sent an Stfu message, we no longer propose
+	// any updates. The channel is quiescent once both parties have sent
+	// an Stfu message.
+	stfuSent     bool
+	stfuReceived bool
+
+	// quiesceClaimed is true once a quiescence request has been satisfied,
+	// in which case the channel is being used while quiescent, and
+	// remains so until quiescence is explicitly ended.
+	quiesceClaimed bool
+
+	// quiesceTimer is running while the quiescence handshake is in
+	// progress, or the quiescent channel hasn't been claimed yet. Once it
+	// fires, the channel returns to its normal state.
+	quiesceTimer *time.Timer
+
+	// exitQuiesceReqs receives requests to end the quiescence of the
+	// channel, returning it to its normal state.
+	exitQuiesceReqs chan error
+
+	// heldPkts are the settles and fails received from the switch while
+	// quiescing, which are only applied once quiescence has ended.
+	heldPkts []*htlcPacket
+
 	sync.RWMutex
 
 	wg   sync.WaitGroup
@@ -347,10 +400,12 @@ func NewChannelLink(cfg ChannelLinkConfig,
 		channel:     channel,
 		shortChanID: channel.ShortChanID(),
 		// TODO(roasbeef): just do reserve here?
-		logCommitTimer: time.NewTimer(300 * time.Millisecond),
-		overflowQueue:  newPacketQueue(lnwallet.MaxHTLCNumber / 2),
-		htlcUpdates:    make(chan []channeldb.HTLC),
-		quit:           make(chan struct{}),
+		logCommitTimer:  time.NewTimer(300 * time.Millisecond),
+		overflowQueue:   newPacketQueue(lnwallet.MaxHTLCNumber / 2),
+		htlcUpdates:     make(chan []channeldb.HTLC),
+		quiesceReqs:     make(chan chan error),
+		exitQuiesceReqs: make(chan error),
+		quit:            make(chan struct{}),
 	}
 }
 
@@ -420,6 +475,14 @@ func (l *channelLink) Start() error {
 
 	l.updateFeeTimer = time.NewTimer(l.randomFeeUpdateTimeout())
 
+	if l.cfg.StartQuiescent {
+		l.stfuSent = true
+		l.stfuReceived = true
+		l.quiesceClaimed = true
+		atomic.StoreInt32(&l.quiescing, 1)
+		atomic.StoreInt32(&l.quiescent, 1)
+	}
+
 	l.wg.Add(1)
 	go l.htlcManager()
 
@@ -461,9 +524,170 @@ func (l *channelLink) WaitForShutdown() {
 // we know the remote party's next revocation point. Otherwise, we can't
 // initiate new channel state. We also require that the short channel ID not be
 // the all-zero source ID, meaning that the channel has had its ID finalized.
+// While the channel is being brought into, or is in a quiescent state, no
+// HTLCs can be forwarded over it.
 func (l *channelLink) EligibleToForward() bool {
 	return l.channel.RemoteNextRevocation() != nil &&
-		l.ShortChanID() != sourceHop
+		l.ShortChanID() != sourceHop &&
+		atomic.LoadInt32(&l.quiescing) == 0
+}
+
+// Quiesce requests the link to bring the channel into a quiescent state, in
+// which neither party proposes any further updates to the channel. The
+// returned channel is sent upon once the channel is quiescent, or the request
+// fails. Once satisfied, quiescence lasts until it's ended by a call to
+// ExitQuiescence, or the remote party aborting it.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) Quiesce() <-chan error {
+	errChan := make(chan error, 1)
+
+	select {
+	case l.quiesceReqs <- errChan:
+	case <-l.quit:
+		errChan <- ErrLinkShuttingDown
+	}
+
+	return errChan
+}
+
+// IsQuiescent returns true if both parties of the channel have agreed to stop
+// proposing updates to the channel.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) IsQuiescent() bool {
+	return atomic.LoadInt32(&l.quiescent) == 1
+}
+
+// ExitQuiescence ends the quiescence of the channel, returning it to its
+// normal state, in which both parties may propose updates again. This is a
+// no-op if the channel isn't quiescing, or has a pending splice.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ExitQuiescence() {
+	select {
+	case l.exitQuiesceReqs <- ErrQuiescenceAborted:
+	case <-l.quit:
+	}
+}
+
+// isQuiescing returns true if quiescence has been requested, or either party
+// has initiated the quiescence handshake, in which case we no longer add any
+// new updates to the channel.
+func (l *channelLink) isQuiescing() bool {
+	return l.stfuSent || l.stfuReceived || len(l.quiesceWaiters) != 0
+}
+
+// startQuiescing marks the channel as quiescing once quiescence has been
+// requested by either party, and starts the quiescence timer.
+func (l *channelLink) startQuiescing() {
+	if atomic.SwapInt32(&l.quiescing, 1) == 1 {
+		return
+	}
+
+	if l.cfg.QuiescenceTimeout != 0 {
+		l.quiesceTimer = time.NewTimer(l.cfg.QuiescenceTimeout)
+	}
+
+	// Kick off draining the overflow queue, so the HTLCs waiting in it are
+	// failed back to the switch.
+	l.overflowQueue.SignalFreeSlot()
+}
+
+// claimQuiescence satisfies the pending quiescence requests, now that the
+// channel is quiescent. As the channel is used while quiescent from now on,
+// the quiescence timer is stopped.
+func (l *channelLink) claimQuiescence() {
+	for _, errChan := range l.quiesceWaiters {
+		errChan <- nil
+	}
+	l.quiesceWaiters = nil
+
+	l.quiesceClaimed = true
+	if l.quiesceTimer != nil {
+		l.quiesceTimer.Stop()
+		l.quiesceTimer = nil
+	}
+}
+
+// exitQuiescence returns the channel to its normal state, failing any pending
+// quiescence requests with the passed error. Any settles and fails held back
+// while quiescing are applied to the channel. A channel with a pending splice
+// remains quiescent, as the splice can no longer be aborted.
+func (l *channelLink) exitQuiescence(reason error) {
+	if !l.isQuiescing() {
+		return
+	}
+
+	_, err := l.channel.State().PendingSplice()
+	switch {
+	case err == nil:
+		l.warnf("unable to exit quiescence: channel has pending splice")
+		return
+
+	case err != channeldb.ErrNoPendingSplice:
+		l.errorf("unable to fetch pending splice: %v", err)
+		return
+	}
+
+	l.infof("exiting quiescence: %v", reason)
+
+	for _, errChan := range l.quiesceWaiters {
+		errChan <- reason
+	}
+	l.quiesceWaiters = nil
+
+	if l.quiesceTimer != nil {
+		l.quiesceTimer.Stop()
+		l.quiesceTimer = nil
+	}
+	l.stfuSent = false
+	l.stfuReceived = false
+	l.quiesceClaimed = false
+	atomic.StoreInt32(&l.quiescent, 0)
+	atomic.StoreInt32(&l.quiescing, 0)
+
+	heldPkts := l.heldPkts
+	l.heldPkts = nil
+	for _, pkt := range heldPkts {
+		l.handleDownStreamPkt(pkt, false)
+	}
+	if l.batchCounter > 0 {
+		l.cfg.BatchTicker.Resume()
+	}
+}
+
+// maybeSendStfu sends our Stfu message to the remote peer once quiescence has
+// been requested by either party, and we no longer have any updates pending.
+// Once both parties have sent an Stfu message, any pending quiescence requests
+// are notified.
+func (l *channelLink) maybeSendStfu() {
+	if !l.stfuSent && (l.stfuReceived || len(l.quiesceWaiters) != 0) {
+		if !l.channel.FullySynced() || l.channel.HasPendingCommitments() {
+			return
+		}
+
+		stfu := lnwire.NewStfu(l.ChanID(), !l.stfuReceived)
+		if err := l.cfg.Peer.SendMessage(false, stfu); err != nil {
+			l.errorf("unable to send stfu: %v", err)
+			return
+		}
+		l.stfuSent = true
+		l.startQuiescing()
+
+		l.debugf("sent stfu, initiator=%v", stfu.Initiator)
+	}
+
+	if !l.stfuSent || !l.stfuReceived || l.IsQuiescent() {
+		return
+	}
+
+	l.infof("channel is now quiescent")
+
+	atomic.StoreInt32(&l.quiescent, 1)
+	if len(l.quiesceWaiters) != 0 {
+		l.claimQuiescence()
+	}
 }
 
 // sampleNetworkFee samples the current fee rate on the network to get into the
@@ -763,6 +987,9 @@ func (l *channelLink) fwdPkgGarbager() {
 // NOTE: This MUST be run as a goroutine.
 func (l *channelLink) htlcManager() {
 	defer func() {
+		for _, errChan := range l.quiesceWaiters {
+			errChan <- ErrLinkShuttingDown
+		}
 		l.cfg.BatchTicker.Stop()
 		l.wg.Done()
 		log.Infof("ChannelLink(%v) has exited", l)
@@ -885,15 +1112,58 @@ out:
 			break out
 		}
 
+		// Once the quiescence handshake has been initiated, we may not
+		// propose any further updates to the channel, so packets from
+		// the switch are either failed back or held by
+		// handleDownStreamPkt until quiescence ends.
+		l.maybeSendStfu()
+		var quiesceTimeout <-chan time.Time
+		if l.quiesceTimer != nil {
+			quiesceTimeout = l.quiesceTimer.C
+		}
+
 		select {
+		// A request to bring the channel into a quiescent state, which
+		// we'll either satisfy immediately, or once the remote peer
+		// has replied to our Stfu message.
+		case errChan := <-l.quiesceReqs:
+			l.quiesceWaiters = append(l.quiesceWaiters, errChan)
+			l.startQuiescing()
+			if l.IsQuiescent() {
+				l.claimQuiescence()
+			}
+
+		// The quiescence of the channel is to be ended, as the splice
+		// it was requested for failed.
+		case reason := <-l.exitQuiesceReqs:
+			l.exitQuiescence(reason)
+
+		// The quiescence handshake didn't complete in time, or the
+		// quiescent channel wasn't used, so we'll let the remote peer
+		// know and return to the normal state.
+		case <-quiesceTimeout:
+			l.quiesceTimer = nil
+			if l.quiesceClaimed {
+				continue
+			}
+
+			abort := lnwire.NewSpliceAbort(
+				l.ChanID(), ErrQuiescenceTimeout.Error(),
+			)
+			if err := l.cfg.Peer.SendMessage(false, abort); err != nil {
+				l.errorf("unable to send splice abort: %v", err)
+			}
+			l.exitQuiescence(ErrQuiescenceTimeout)
+
 		// Our update fee timer has fired, so we'll check the network
 		// fee to see if we should adjust our commitment fee.
 		case <-l.updateFeeTimer.C:
 			l.updateFeeTimer.Reset(l.randomFeeUpdateTimeout())
 
 			// If we're not the initiator of the channel, don't we
-			// don't control the fees, so we can ignore this.
-			if !l.channel.IsInitiator() {
+			// don't control the fees, so we can ignore this. We
+			// also can't update the fee of a quiescent channel.
+			if !l.channel.IsInitiator() || l.isQuiescing() {
 				continue
 			}
 
@@ -987,6 +1257,12 @@ out:
 
 			l.handleDownStreamPkt(packet, true)
 
+			// While quiescing, the packet was failed back, so we
+			// continue draining the overflow queue.
+			if l.isQuiescing() {
+				l.overflowQueue.SignalFreeSlot()
+			}
+
 			// If the downstream packet resulted in a non-empty
 			// batch, reinstate the batch ticker so that it can be
 			// cleared.
@@ -1003,7 +1279,9 @@ out:
 			// directly. Once an active HTLC is either settled or
 			// failed, then we'll free up a new slot.
 			htlc, ok := pkt.htlc.(*lnwire.UpdateAddHTLC)
-			if ok && l.overflowQueue.Length() != 0 {
+			if ok && l.overflowQueue.Length() != 0 &&
+				!l.isQuiescing() {
+
 				log.Infof("Downstream htlc add update with "+
 					"payment hash(%x) have been added to "+
 					"reprocessing queue, batch_size=%v",
@@ -1035,6 +1313,67 @@ out:
 	}
 }
 
+// failAddPacket signals the switch to cancel the pending payment of an HTLC
+// add packet that couldn't be added to the channel.
+func (l *channelLink) failAddPacket(pkt *htlcPacket) {
+	var (
+		localFailure = false
+		reason       lnwire.OpaqueReason
+	)
+
+	var failure lnwire.FailureMessage
+	update, err := l.cfg.FetchLastChannelUpdate(l.ShortChanID())
+	if err != nil {
+		failure = &lnwire.FailTemporaryNodeFailure{}
+	} else {
+		failure = lnwire.NewTemporaryChannelFailure(update)
+	}
+
+	// Encrypt the error back to the source unless the payment was
+	// generated locally.
+	if pkt.obfuscator == nil {
+		var b bytes.Buffer
+		err := lnwire.EncodeFailure(&b, failure, 0)
+		if err != nil {
+			l.errorf("unable to encode failure: %v", err)
+			l.mailBox.AckPacket(pkt.inKey())
+			return
+		}
+		reason = lnwire.OpaqueReason(b.Bytes())
+		localFailure = true
+	} else {
+		var err error
+		reason, err = pkt.obfuscator.EncryptFirstHop(failure)
+		if err != nil {
+			l.errorf("unable to obfuscate error: %v", err)
+			l.mailBox.AckPacket(pkt.inKey())
+			return
+		}
+	}
+
+	failPkt := &htlcPacket{
+		incomingChanID: pkt.incomingChanID,
+		incomingHTLCID: pkt.incomingHTLCID,
+		circuit:        pkt.circuit,
+		sourceRef:      pkt.sourceRef,
+		hasSource:      true,
+		localFailure:   localFailure,
+		htlc: &lnwire.UpdateFailHTLC{
+			Reason: reason,
+		},
+	}
+
+	go l.forwardBatch(failPkt)
+
+	// Remove this packet from the link's mailbox, this prevents it from
+	// being reprocessed if the link restarts and resets it mailbox. If
+	// this response doesn't make it back to the originating link, it will
+	// be rejected upon attempting to reforward the Add to the switch,
+	// since the circuit was never fully opened, and the forwarding package
+	// shows it as unacknowledged.
+	l.mailBox.AckPacket(pkt.inKey())
+}
+
 // randomFeeUpdateTimeout returns a random timeout between the bounds defined
 // within the link's configuration that will be used to determine when the link
 // should propose an update to its commitment fee rate.
@@ -1051,6 +1390,22 @@ func (l *channelLink) randomFeeUpdateTimeout() time.Duration {
 //
 // TODO(roasbeef): add sync ntfn to ensure switch always has consistent view?
 func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
+	// While the channel is quiescing, we can't add any updates to it. New
+	// HTLCs are failed back to the switch so they can be routed over
+	// another channel, while settles and fails are held until quiescence
+	// ends.
+	if l.isQuiescing() {
+		if _, ok := pkt.htlc.(*lnwire.UpdateAddHTLC); ok {
+			l.warnf("Unable to handle downstream add HTLC: " +
+				"channel is quiescing")
+			l.failAddPacket(pkt)
+			return
+		}
+
+		l.heldPkts = append(l.heldPkts, pkt)
+		return
+	}
+
 	var isSettle bool
 	switch htlc := pkt.htlc.(type) {
 	case *lnwire.UpdateAddHTLC:
@@ -1070,11 +1425,17 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 		openCircuitRef := pkt.inKey()
 		index, err := l.channel.AddHTLC(htlc, &openCircuitRef)
 		if err != nil {
-			switch err {
+			switch {
 
 			// The channels spare bandwidth is fully allocated, so
-			// we'll put this HTLC into the overflow queue.
-			case lnwallet.ErrMaxHTLCNumber:
+			// we'll put this HTLC into the overflow queue. The same
+			// goes for an HTLC that would exceed the value the
+			// remote party accepts in flight, as long as it fits
+			// once other HTLCs are resolved.
+			case err == lnwallet.ErrMaxHTLCNumber,
+				err == lnwallet.ErrMaxPendingAmount &&
+					htlc.Amount <= l.channel.MaxPendingAmount():
+
 				l.infof("Downstream htlc add update with "+
 					"payment hash(%x) have been added to "+
 					"reprocessing queue, batch: %v",
@@ -1089,71 +1450,7 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			// cancel the pending payment.
 			default:
 				l.warnf("Unable to handle downstream add HTLC: %v", err)
-
-				var (
-					localFailure = false
-					reason       lnwire.OpaqueReason
-				)
-
-				var failure lnwire.FailureMessage
-				update, err := l.cfg.FetchLastChannelUpdate(
-					l.ShortChanID(),
-				)
-				if err != nil {
-					failure = &lnwire.FailTemporaryNodeFailure{}
-				} else {
-					failure = lnwire.NewTemporaryChannelFailure(
-						update,
-					)
-				}
-
-				// Encrypt the error back to the source unless
-				// the payment was generated locally.
-				if pkt.obfuscator == nil {
-					var b bytes.Buffer
-					err := lnwire.EncodeFailure(&b, failure, 0)
-					if err != nil {
-						l.errorf("unable to encode failure: %v", err)
-						l.mailBox.AckPacket(pkt.inKey())
-						return
-					}
-					reason = lnwire.OpaqueReason(b.Bytes())
-					localFailure = true
-				} else {
-					var err error
-					reason, err = pkt.obfuscator.EncryptFirstHop(failure)
-					if err != nil {
-						l.errorf("unable to obfuscate error: %v", err)
-						l.mailBox.AckPacket(pkt.inKey())
-						return
-					}
-				}
-
-				failPkt := &htlcPacket{
-					incomingChanID: pkt.incomingChanID,
-					incomingHTLCID: pkt.incomingHTLCID,
-					circuit:        pkt.circuit,
-					sourceRef:      pkt.sourceRef,
-					hasSource:      true,
-					localFailure:   localFailure,
-					htlc: &lnwire.UpdateFailHTLC{
-						Reason: reason,
-					},
-				}
-
-				go l.forwardBatch(failPkt)
-
-				// Remove this packet from the link's mailbox,
-				// this prevents it from being reprocessed if
-				// the link restarts and resets it mailbox. If
-				// this response doesn't make it back to the
-				// originating link, it will be rejected upon
-				// attempting to reforward the Add to the
-				// switch, since the circuit was never fully
-				// opened, and the forwarding package shows it
-				// as unacknowledged.
-				l.mailBox.AckPacket(pkt.inKey())
-
+				l.failAddPacket(pkt)
 				return
 			}
 		}
@@ -1372,6 +1669,20 @@ func (l *channelLink) cleanupSpuriousResponse(pkt *htlcPacket) {
 // updates from the upstream peer. The upstream peer is the peer whom we have a
 // direct channel with, updating our respective commitment chains.
 func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
+	// Once the remote peer has sent their Stfu message, they may no longer
+	// propose any updates to the channel.
+	if l.stfuReceived {
+		switch msg.(type) {
+		case *lnwire.UpdateAddHTLC, *lnwire.UpdateFulfillHTLC,
+			*lnwire.UpdateFailHTLC, *lnwire.UpdateFailMalformedHTLC,
+			*lnwire.UpdateFee:
+
+			l.fail(LinkFailureError{code: ErrInvalidUpdate},
+				"received %T after stfu", msg)
+			return
+		}
+	}
+
 	switch msg := msg.(type) {
 
 	case *lnwire.UpdateAddHTLC:
@@ -1585,6 +1896,31 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 				"error receiving fee update: %v", err)
 			return
 		}
+
+	case *lnwire.Stfu:
+		// The remote peer wishes to bring the channel into a quiescent
+		// state. We'll reply with our own Stfu message once all of our
+		// pending updates have been committed.
+		if l.stfuReceived {
+			l.warnf("received duplicate stfu")
+			return
+		}
+		l.stfuReceived = true
+		l.startQuiescing()
+
+		l.debugf("received stfu, initiator=%v", msg.Initiator)
+
+	case *lnwire.SpliceAbort:
+		// The remote peer aborted the splice, or the quiescence of the
+		// channel before any splice was proposed, so we'll return to
+		// the normal state.
+		reason := "non-ascii data"
+		if isASCII(msg.Data) {
+			reason = string(msg.Data)
+		}
+		l.exitQuiescence(fmt.Errorf("remote aborted quiescence: %v",
+			reason))
+
 	case *lnwire.Error:
 		// Error received from remote, MUST fail channel, but should
 		// only print the contents of the error message if all
@@ -1772,6 +2108,7 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	l.infof("Updating to short_chan_id=%v for chan_id=%v", sid, chanID)
 
 	l.Lock()
+	oldShortChanID := l.shortChanID
 	l.shortChanID = sid
 	l.Unlock()
 
@@ -1787,9 +2124,12 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	}()
 
 	// Now that the short channel ID has been properly updated, we can begin
-	// garbage collecting any forwarding packages we create.
-	l.wg.Add(1)
-	go l.fwdPkgGarbager()
+	// garbage collecting any forwarding packages we create. Links of
+	// zero-conf channels already did so under their alias.
+	if oldShortChanID == sourceHop {
+		l.wg.Add(1)
+		go l.fwdPkgGarbager()
+	}
 
 	return sid, nil
 }
@@ -1809,6 +2149,11 @@ func (l *channelLink) ChanID() lnwire.ChannelID {
 //
 // NOTE: Part of the ChannelLink interface.
 func (l *channelLink) Bandwidth() lnwire.MilliSatoshi {
+	// No HTLCs can be added to the channel while it's quiescing.
+	if atomic.LoadInt32(&l.quiescing) == 1 {
+		return 0
+	}
+
 	channelBandwidth := l.channel.AvailableBalance()
 	overflowBandwidth := l.overflowQueue.TotalHtlcAmount()
 
@@ -1828,7 +2173,22 @@ func (l *channelLink) Bandwidth() lnwire.MilliSatoshi {
 	// Else the amount that is available to flow through the link at this
 	// point is the available balance minus the reserve amount we are
 	// required to keep as collateral.
-	return linkBandwidth - reserve
+	linkBandwidth -= reserve
+
+	// The remote party also limits the value of the HTLCs we may have in
+	// flight, which the HTLCs waiting in the overflow queue will count
+	// towards once added.
+	inFlightBandwidth := l.channel.AvailableInFlight()
+	if inFlightBandwidth < overflowBandwidth {
+		return 0
+	}
+	inFlightBandwidth -= overflowBandwidth
+
+	if inFlightBandwidth < linkBandwidth {
+		return inFlightBandwidth
+	}
+
+	return linkBandwidth
 }
 
 // AttachMailBox updates the current mailbox used by this link, and hooks up
@@ -1463,6 +1463,10 @@ func (m *mockPeer) QuitSignal() <-chan struct{} {
 	return m.quit
 }
 
+func (m *mockPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
 var _ lnpeer.Peer = (*mockPeer)(nil)
 
 func (m *mockPeer) SendMessage(sync bool, msgs ...lnwire.Message) error {
@@ -3699,6 +3703,205 @@ func TestChannelLinkUpdateCommitFee(t *testing.T) {
 	}
 }
 
+// TestChannelLinkQuiescence tests that a link is able to bring its channel
+// into a quiescent state, after which both links refrain from forwarding any
+// further HTLCs.
+func TestChannelLinkQuiescence(t *testing.T) {
+	t.Parallel()
+
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		t.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	// Alice initiates the quiescence handshake, which Bob should reply to
+	// with his own Stfu message.
+	chanID := n.aliceChannelLink.ChanID()
+	messages := []expectedMessage{
+		{"alice", "bob", &lnwire.ChannelReestablish{}, false},
+		{"bob", "alice", &lnwire.ChannelReestablish{}, false},
+
+		{"alice", "bob", &lnwire.FundingLocked{}, false},
+		{"bob", "alice", &lnwire.FundingLocked{}, false},
+
+		{"alice", "bob", &lnwire.Stfu{}, false},
+		{"bob", "alice", &lnwire.Stfu{}, false},
+	}
+	n.aliceServer.intersect(createInterceptorFunc("[alice] <-- [bob]",
+		"alice", messages, chanID, false))
+	n.bobServer.intersect(createInterceptorFunc("[alice] --> [bob]",
+		"bob", messages, chanID, false))
+
+	if err := n.start(); err != nil {
+		t.Fatal(err)
+	}
+	defer n.stop()
+	defer n.feeEstimator.Stop()
+
+	select {
+	case err := <-n.aliceChannelLink.Quiesce():
+		if err != nil {
+			t.Fatalf("unable to quiesce channel: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("channel didn't become quiescent")
+	}
+
+	if !n.aliceChannelLink.IsQuiescent() {
+		t.Fatalf("alice's link should be quiescent")
+	}
+
+	// Bob may not have processed Alice's reply yet, so we'll wait for his
+	// link to become quiescent as well.
+	for i := 0; !n.firstBobChannelLink.IsQuiescent(); i++ {
+		if i == 50 {
+			t.Fatalf("bob's link should be quiescent")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// Bob's link with Carol should be unaffected.
+	if n.secondBobChannelLink.IsQuiescent() {
+		t.Fatalf("bob's link with carol shouldn't be quiescent")
+	}
+
+	// A subsequent request should be satisfied immediately.
+	select {
+	case err := <-n.firstBobChannelLink.Quiesce():
+		if err != nil {
+			t.Fatalf("unable to quiesce channel: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("quiescence request wasn't satisfied")
+	}
+
+	// The quiescent channel should no longer be eligible to forward any
+	// HTLCs, and have no bandwidth available.
+	if n.aliceChannelLink.EligibleToForward() {
+		t.Fatalf("quiescent link shouldn't be eligible to forward")
+	}
+	if bandwidth := n.aliceChannelLink.Bandwidth(); bandwidth != 0 {
+		t.Fatalf("quiescent link should have no bandwidth, got %v",
+			bandwidth)
+	}
+
+	// Finally, a payment from Alice to Bob should be failed back rather
+	// than forwarded over the quiescent channel.
+	amount := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	htlcAmt, totalTimelock, hops := generateHops(amount, testStartingHeight,
+		n.firstBobChannelLink)
+	firstHop := n.firstBobChannelLink.ShortChanID()
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := n.makePayment(
+			n.aliceServer, n.bobServer, firstHop, hops, amount,
+			htlcAmt, totalTimelock,
+		).Wait(30 * time.Second)
+		errChan <- err
+	}()
+
+	select {
+	case err := <-errChan:
+		if err == nil {
+			t.Fatalf("payment over quiescent channel completed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("payment over quiescent channel wasn't failed back")
+	}
+}
+
+// TestChannelLinkExitQuiescence tests that once the quiescence of a channel
+// is ended, either explicitly or because the remote peer didn't use the
+// quiescent channel in time, both links return to their normal state and
+// forward HTLCs again.
+func TestChannelLinkExitQuiescence(t *testing.T) {
+	t.Parallel()
+
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		t.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	n := newThreeHopNetwork(t, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+
+	// Bob only received Alice's Stfu message without being asked to
+	// quiesce the channel himself, so he'll abort the quiescence once it
+	// times out.
+	n.firstBobChannelLink.cfg.QuiescenceTimeout = time.Second
+
+	if err := n.start(); err != nil {
+		t.Fatal(err)
+	}
+	defer n.stop()
+	defer n.feeEstimator.Stop()
+
+	quiesce := func() {
+		t.Helper()
+
+		select {
+		case err := <-n.aliceChannelLink.Quiesce():
+			if err != nil {
+				t.Fatalf("unable to quiesce channel: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("channel didn't become quiescent")
+		}
+	}
+	assertNotQuiescent := func() {
+		t.Helper()
+
+		for i := 0; ; i++ {
+			if !n.aliceChannelLink.IsQuiescent() &&
+				!n.firstBobChannelLink.IsQuiescent() &&
+				n.aliceChannelLink.EligibleToForward() &&
+				n.aliceChannelLink.Bandwidth() != 0 {
+
+				return
+			}
+			if i == 50 {
+				t.Fatalf("links should have exited quiescence")
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	// Once Alice quiesces the channel, Bob's quiescence timer should
+	// return both links to their normal state.
+	quiesce()
+	assertNotQuiescent()
+
+	// Alice quiesces the channel again, this time ending the quiescence
+	// herself before Bob's timer fires.
+	quiesce()
+	n.aliceChannelLink.ExitQuiescence()
+	abort := lnwire.NewSpliceAbort(n.aliceChannelLink.ChanID(), "test")
+	n.firstBobChannelLink.HandleChannelUpdate(abort)
+	assertNotQuiescent()
+
+	// A payment from Alice to Bob should now succeed again.
+	amount := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	htlcAmt, totalTimelock, hops := generateHops(amount, testStartingHeight,
+		n.firstBobChannelLink)
+	firstHop := n.firstBobChannelLink.ShortChanID()
+	_, err = n.makePayment(
+		n.aliceServer, n.bobServer, firstHop, hops, amount, htlcAmt,
+		totalTimelock,
+	).Wait(30 * time.Second)
+	if err != nil {
+		t.Fatalf("unable to make payment: %v", err)
+	}
+}
+
 // TestChannelLinkAcceptDuplicatePayment tests that if a link receives an
 // incoming HTLC for a payment we have already settled, then it accepts the
 // HTLC. We do this to simplify the processing of settles after restarts or
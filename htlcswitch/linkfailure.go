@@ -5,6 +5,15 @@ import "github.com/go-errors/errors"
 var (
 	// ErrLinkShuttingDown signals that the link is shutting down.
 	ErrLinkShuttingDown = errors.New("link shutting down")
+
+	// ErrQuiescenceTimeout signals that the channel wasn't used while
+	// quiescent within the quiescence timeout, so it returned to its
+	// normal state.
+	ErrQuiescenceTimeout = errors.New("quiescence timed out")
+
+	// ErrQuiescenceAborted signals that the quiescence of the channel was
+	// ended before it was used, as the splice it was requested for failed.
+	ErrQuiescenceAborted = errors.New("quiescence aborted")
 )
 
 // errorCode encodes the possible types of errors that will make us fail the
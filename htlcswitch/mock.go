@@ -242,6 +242,10 @@ func (s *mockServer) QuitSignal() <-chan struct{} {
 	return s.quit
 }
 
+func (s *mockServer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
 // mockHopIterator represents the test version of hop iterator which instead
 // of encrypting the path in onion blob just stores the path as a list of hops.
 type mockHopIterator struct {
@@ -511,6 +515,10 @@ func (s *mockServer) readHandler(message lnwire.Message) error {
 		targetChan = msg.ChanID
 	case *lnwire.UpdateFee:
 		targetChan = msg.ChanID
+	case *lnwire.Stfu:
+		targetChan = msg.ChanID
+	case *lnwire.SpliceAbort:
+		targetChan = msg.ChanID
 	default:
 		return fmt.Errorf("unknown message type: %T", msg)
 	}
@@ -670,12 +678,20 @@ func (f *mockChannelLink) Bandwidth() lnwire.MilliSatoshi               { return
 func (f *mockChannelLink) Peer() lnpeer.Peer                            { return f.peer }
 func (f *mockChannelLink) Stop()                                        {}
 func (f *mockChannelLink) EligibleToForward() bool                      { return f.eligible }
+func (f *mockChannelLink) IsQuiescent() bool                            { return false }
+func (f *mockChannelLink) ExitQuiescence()                              {}
 func (f *mockChannelLink) setLiveShortChanID(sid lnwire.ShortChannelID) { f.shortChanID = sid }
 func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	f.eligible = true
 	return f.shortChanID, nil
 }
 
+func (f *mockChannelLink) Quiesce() <-chan error {
+	errChan := make(chan error, 1)
+	errChan <- nil
+	return errChan
+}
+
 var _ ChannelLink = (*mockChannelLink)(nil)
 
 type mockInvoiceRegistry struct {
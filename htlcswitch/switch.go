@@ -78,6 +78,10 @@ type pendingPayment struct {
 	// an error, it deobfuscates the onion failure blob, and extracts the
 	// exact error from it.
 	deobfuscator ErrorDecrypter
+
+	// probe denotes that this payment is a probe, which isn't tracked by
+	// the control tower.
+	probe bool
 }
 
 // plexPacket encapsulates switch packet and adds error channel to receive
@@ -117,6 +121,15 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKw lnwallet.SatPerKWeight
 
+	// MaxFeePerKw is the highest fee rate the caller is willing to pay for
+	// the cooperative closure transaction. Fee proposals of the remote
+	// party above it are rejected. If zero, there's no limit.
+	MaxFeePerKw lnwallet.SatPerKWeight
+
+	// DeliveryScript is an optional script our funds should be paid to in
+	// a cooperative closure. If empty, a fresh delivery script is used.
+	DeliveryScript lnwire.DeliveryAddress
+
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
 	Updates chan *lnrpc.CloseStatusUpdate
@@ -244,6 +257,12 @@ type Switch struct {
 	// ChannelLink
 	forwardingIndex map[lnwire.ShortChannelID]ChannelLink
 
+	// aliasIndex maps the channel ID of live zero-conf links to the alias
+	// they were added under. Once their funding transaction confirms, the
+	// links remain in the forwardingIndex under their alias, such that
+	// HTLCs which were forwarded under it can still be resolved.
+	aliasIndex map[lnwire.ChannelID]lnwire.ShortChannelID
+
 	// interfaceIndex maps the compressed public key of a peer to all the
 	// channels that the switch maintains with that peer.
 	interfaceIndex map[[33]byte]map[lnwire.ChannelID]ChannelLink
@@ -303,6 +322,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
 		mailOrchestrator:  newMailOrchestrator(),
 		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
+		aliasIndex:        make(map[lnwire.ChannelID]lnwire.ShortChannelID),
 		interfaceIndex:    make(map[[33]byte]map[lnwire.ChannelID]ChannelLink),
 		pendingLinkIndex:  make(map[lnwire.ChannelID]ChannelLink),
 		pendingPayments:   make(map[uint64]*pendingPayment),
@@ -355,11 +375,34 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 	htlc *lnwire.UpdateAddHTLC,
 	deobfuscator ErrorDecrypter) ([sha256.Size]byte, error) {
 
+	return s.sendHTLC(firstHop, htlc, deobfuscator, false)
+}
+
+// SendProbe is used by other subsystems to send a probe HTLC, which carries a
+// payment hash that the destination doesn't know the preimage of. Unlike
+// SendHTLC, the probe isn't tracked by the control tower, so it isn't stored
+// as a payment within the database.
+func (s *Switch) SendProbe(firstHop lnwire.ShortChannelID,
+	htlc *lnwire.UpdateAddHTLC,
+	deobfuscator ErrorDecrypter) ([sha256.Size]byte, error) {
+
+	return s.sendHTLC(firstHop, htlc, deobfuscator, true)
+}
+
+// sendHTLC sends the passed htlc update through the first hop, and waits for
+// its result. If probe is true, then the htlc won't be tracked by the control
+// tower.
+func (s *Switch) sendHTLC(firstHop lnwire.ShortChannelID,
+	htlc *lnwire.UpdateAddHTLC, deobfuscator ErrorDecrypter,
+	probe bool) ([sha256.Size]byte, error) {
+
 	// Before sending, double check that we don't already have 1) an
 	// in-flight payment to this payment hash, or 2) a complete payment for
 	// the same hash.
-	if err := s.control.ClearForTakeoff(htlc); err != nil {
-		return zeroPreimage, err
+	if !probe {
+		if err := s.control.ClearForTakeoff(htlc); err != nil {
+			return zeroPreimage, err
+		}
 	}
 
 	// Create payment and add to the map of payment in order later to be
@@ -370,6 +413,7 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 		paymentHash:  htlc.PaymentHash,
 		amount:       htlc.Amount,
 		deobfuscator: deobfuscator,
+		probe:        probe,
 	}
 
 	paymentID, err := s.paymentSequencer.NextID()
@@ -393,6 +437,9 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 
 	if err := s.forward(packet); err != nil {
 		s.removePendingPayment(paymentID)
+		if probe {
+			return zeroPreimage, err
+		}
 		if err := s.control.Fail(htlc.PaymentHash); err != nil {
 			return zeroPreimage, err
 		}
@@ -868,6 +915,12 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 	// has been restarted since sending the payment.
 	payment := s.findPayment(pkt.incomingHTLCID)
 
+	// Probes aren't tracked by the control tower, so we won't update the
+	// status of their payment hash. If the daemon was restarted since a
+	// probe was sent, we can't tell it apart from a regular payment
+	// anymore, in which case its status is recorded as usual.
+	trackPayment := payment == nil || !payment.probe
+
 	var (
 		preimage   [32]byte
 		paymentErr error
@@ -881,7 +934,10 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 		// Persistently mark that a payment to this payment hash
 		// succeeded. This will prevent us from ever making another
 		// payment to this hash.
-		err := s.control.Success(pkt.circuit.PaymentHash)
+		var err error
+		if trackPayment {
+			err = s.control.Success(pkt.circuit.PaymentHash)
+		}
 		if err != nil && err != ErrPaymentAlreadyCompleted {
 			log.Warnf("Unable to mark completed payment %x: %v",
 				pkt.circuit.PaymentHash, err)
@@ -896,7 +952,10 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 		// Persistently mark that a payment to this payment hash failed.
 		// This will permit us to make another attempt at a successful
 		// payment.
-		err := s.control.Fail(pkt.circuit.PaymentHash)
+		var err error
+		if trackPayment {
+			err = s.control.Fail(pkt.circuit.PaymentHash)
+		}
 		if err != nil && err != ErrPaymentAlreadyCompleted {
 			log.Warnf("Unable to ground payment %x: %v",
 				pkt.circuit.PaymentHash, err)
@@ -1412,14 +1471,21 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type if CloseRegular,
-// then the last parameter should be the ideal fee-per-kw that will be used as
-// a starting point for close negotiation.
+// then targetFeePerKw should be the ideal fee-per-kw that will be used as a
+// starting point for close negotiation, and maxFeePerKw an optional upper
+// bound of the negotiated fee-per-kw. The optional delivery script is where
+// our funds will be paid to.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
-	targetFeePerKw lnwallet.SatPerKWeight) (chan *lnrpc.CloseStatusUpdate,
+	targetFeePerKw, maxFeePerKw lnwallet.SatPerKWeight,
+	deliveryScript lnwire.DeliveryAddress) (chan *lnrpc.CloseStatusUpdate,
 	chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
-	updateChan := make(chan *lnrpc.CloseStatusUpdate, 2)
+	//
+	// Besides the two updates for the closing transaction, the buffer
+	// leaves room for the fee proposals made during the negotiation, which
+	// are dropped if they aren't consumed in time.
+	updateChan := make(chan *lnrpc.CloseStatusUpdate, 10)
 	errChan := make(chan error, 1)
 
 	command := &ChanClose{
@@ -1427,6 +1493,8 @@ func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
 		ChanPoint:      chanPoint,
 		Updates:        updateChan,
 		TargetFeePerKw: targetFeePerKw,
+		MaxFeePerKw:    maxFeePerKw,
+		DeliveryScript: deliveryScript,
 		Err:            errChan,
 	}
 
@@ -1945,6 +2013,13 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	s.linkIndex[link.ChanID()] = link
 	s.forwardingIndex[link.ShortChanID()] = link
 
+	// If this is a zero-conf link used under an alias, we'll remember it
+	// so the link can still be located by it once its funding transaction
+	// has confirmed.
+	if link.ShortChanID().IsAlias() {
+		s.aliasIndex[link.ChanID()] = link.ShortChanID()
+	}
+
 	// Next we'll add the link to the interface index so we can
 	// quickly look up all the channels for a particular node.
 	peerPub := link.Peer().PubKey()
@@ -2028,6 +2103,10 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 	delete(s.pendingLinkIndex, link.ChanID())
 	delete(s.linkIndex, link.ChanID())
 	delete(s.forwardingIndex, link.ShortChanID())
+	if alias, ok := s.aliasIndex[link.ChanID()]; ok {
+		delete(s.forwardingIndex, alias)
+		delete(s.aliasIndex, link.ChanID())
+	}
 
 	// If the link has been added to the peer index, then we'll move to
 	// delete the entry within the index.
@@ -2053,10 +2132,17 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	defer s.indexMtx.Unlock()
 
 	// Locate the target link in the pending link index. If no such link
-	// exists, then we will ignore the request.
+	// exists, it may be a live zero-conf link whose funding transaction
+	// has now confirmed. Otherwise, we will ignore the request.
 	link, ok := s.pendingLinkIndex[chanID]
 	if !ok {
-		return fmt.Errorf("link %v not found", chanID)
+		alias, isAlias := s.aliasIndex[chanID]
+		link, ok = s.linkIndex[chanID]
+		if !ok || !isAlias {
+			return fmt.Errorf("link %v not found", chanID)
+		}
+
+		return s.updateAliasShortChanID(link, alias)
 	}
 
 	oldShortChanID := link.ShortChanID()
@@ -2093,6 +2179,37 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	return nil
 }
 
+// updateAliasShortChanID updates the short channel ID of a live zero-conf link
+// to the confirmed location of its funding transaction. The link can still be
+// located by its alias afterwards, as HTLCs may have been forwarded under it.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) updateAliasShortChanID(link ChannelLink,
+	alias lnwire.ShortChannelID) error {
+
+	chanID := link.ChanID()
+
+	shortChanID, err := link.UpdateShortChanID()
+	if err != nil {
+		return err
+	}
+
+	// If the link is still using its alias, there's nothing to update.
+	if shortChanID == alias {
+		return nil
+	}
+
+	log.Infof("Updated short_chan_id for zero-conf ChannelLink(%v): "+
+		"alias=%v, confirmed=%v", chanID, alias, shortChanID)
+
+	s.forwardingIndex[shortChanID] = link
+
+	mailbox := s.mailOrchestrator.GetOrCreateMailBox(chanID)
+	s.mailOrchestrator.BindLiveShortChanID(mailbox, chanID, shortChanID)
+
+	return nil
+}
+
 // GetLinksByInterface fetches all the links connected to a particular node
 // identified by the serialized compressed form of its public key.
 func (s *Switch) GetLinksByInterface(hop [33]byte) ([]ChannelLink, error) {
@@ -82,6 +82,64 @@ func TestSwitchAddDuplicateLink(t *testing.T) {
 	}
 }
 
+// TestSwitchZeroConfLink tests that a zero-conf link added under its alias can
+// be located by both its alias and its confirmed short channel ID once its
+// funding transaction confirms, and that both are removed with the link.
+func TestSwitchZeroConfLink(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+	alias := lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasScidStartHeight,
+		TxIndex:     1,
+	}
+
+	// The zero-conf link is live right away under its alias.
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, alias, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if _, err := s.getLinkByShortID(alias); err != nil {
+		t.Fatalf("unable to find link by alias: %v", err)
+	}
+
+	// Once the funding transaction confirms, the link should be located
+	// by both its alias and its confirmed short channel ID.
+	aliceChannelLink.setLiveShortChanID(aliceChanID)
+	if err := s.UpdateShortChanID(chanID1); err != nil {
+		t.Fatalf("unable to update alice short_chan_id: %v", err)
+	}
+	for _, sid := range []lnwire.ShortChannelID{alias, aliceChanID} {
+		if _, err := s.getLinkByShortID(sid); err != nil {
+			t.Fatalf("unable to find link by %v: %v", sid, err)
+		}
+	}
+
+	// Removing the link should clear both entries.
+	s.RemoveLink(chanID1)
+	for _, sid := range []lnwire.ShortChannelID{alias, aliceChanID} {
+		if _, err := s.getLinkByShortID(sid); err == nil {
+			t.Fatalf("link still found by %v after removal", sid)
+		}
+	}
+}
+
 // TestSwitchSendPending checks the inability of htlc switch to forward adds
 // over pending links, and the UpdateShortChanID makes a pending link live.
 func TestSwitchSendPending(t *testing.T) {
@@ -1742,6 +1800,133 @@ func TestSwitchSendPayment(t *testing.T) {
 	}
 }
 
+// TestSwitchSendProbe tests that probes sent through the switch aren't tracked
+// by the control tower, so they're neither restricted to a single in-flight
+// attempt per payment hash, nor recorded as payments.
+func TestSwitchSendProbe(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+
+	// We'll send two probes with the same payment hash. As probes aren't
+	// tracked by the control tower, both of them should be forwarded.
+	var rhash [32]byte
+	if _, err := rand.Read(rhash[:]); err != nil {
+		t.Fatalf("unable to generate payment hash: %v", err)
+	}
+	update := &lnwire.UpdateAddHTLC{
+		PaymentHash: rhash,
+		Amount:      1,
+	}
+
+	errChan := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := s.SendProbe(
+				aliceChannelLink.ShortChanID(), update,
+				newMockDeobfuscator(),
+			)
+			errChan <- err
+		}()
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case packet := <-aliceChannelLink.packets:
+			err := aliceChannelLink.completeCircuit(packet)
+			if err != nil {
+				t.Fatalf("unable to complete payment circuit: "+
+					"%v", err)
+			}
+
+		case err := <-errChan:
+			t.Fatalf("unable to send probe: %v", err)
+		case <-time.After(time.Second):
+			t.Fatal("probe was not propagated to destination")
+		}
+	}
+
+	// The payment hash of the in-flight probes shouldn't have been
+	// recorded.
+	status, err := s.cfg.DB.FetchPaymentStatus(rhash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment status: %v", err)
+	}
+	if status != channeldb.StatusGrounded {
+		t.Fatalf("expected status %v, got %v",
+			channeldb.StatusGrounded, status)
+	}
+
+	// We'll now settle the first probe, and fail the second one back.
+	// Settling a regular payment would mark its payment hash as completed.
+	obfuscator := NewMockObfuscator()
+	failure := lnwire.FailUnknownPaymentHash{}
+	reason, err := obfuscator.EncryptFirstHop(failure)
+	if err != nil {
+		t.Fatalf("unable obfuscate failure: %v", err)
+	}
+
+	responses := []lnwire.Message{
+		&lnwire.UpdateFulfillHTLC{},
+		&lnwire.UpdateFailHTLC{
+			Reason: reason,
+		},
+	}
+	for i, htlc := range responses {
+		packet := &htlcPacket{
+			outgoingChanID: aliceChannelLink.ShortChanID(),
+			outgoingHTLCID: uint64(i),
+			amount:         1,
+			htlc:           htlc,
+		}
+		if err := s.forward(packet); err != nil {
+			t.Fatalf("can't forward htlc packet: %v", err)
+		}
+
+		select {
+		case <-errChan:
+		case <-time.After(time.Second):
+			t.Fatal("probe response wasn't received")
+		}
+	}
+
+	if s.numPendingPayments() != 0 {
+		t.Fatal("wrong amount of pending payments")
+	}
+
+	// Finally, the payment hash should still be unknown to the control
+	// tower, allowing a regular payment to be made to it.
+	status, err = s.cfg.DB.FetchPaymentStatus(rhash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment status: %v", err)
+	}
+	if status != channeldb.StatusGrounded {
+		t.Fatalf("expected status %v, got %v",
+			channeldb.StatusGrounded, status)
+	}
+}
+
 // TestLocalPaymentNoForwardingEvents tests that if we send a series of locally
 // initiated payments, then they aren't reflected in the forwarding log.
 func TestLocalPaymentNoForwardingEvents(t *testing.T) {
@@ -505,6 +505,8 @@ func getChanID(msg lnwire.Message) (lnwire.ChannelID, error) {
 		chanID = msg.ChanID
 	case *lnwire.UpdateFee:
 		chanID = msg.ChanID
+	case *lnwire.Stfu:
+		chanID = msg.ChanID
 	default:
 		return chanID, fmt.Errorf("unknown type: %T", msg)
 	}
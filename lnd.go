@@ -43,6 +43,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
 	"github.com/lightningnetwork/lnd/macaroons"
@@ -330,8 +331,19 @@ func lndMain() error {
 	}
 	defer rpcServer.Stop()
 
+	// The ChainNotifier RPC server exposes our chain notifier to external
+	// services, and is served alongside the main RPC server.
+	chainNotifierServer := chainrpc.New(&chainrpc.Config{
+		ChainNotifier: activeChainControl.chainNotifier,
+	})
+	if err := chainNotifierServer.Start(); err != nil {
+		return err
+	}
+	defer chainNotifierServer.Stop()
+
 	grpcServer := grpc.NewServer(serverOpts...)
 	lnrpc.RegisterLightningServer(grpcServer, rpcServer)
+	chainrpc.RegisterChainNotifierServer(grpcServer, chainNotifierServer)
 
 	// Next, Start the gRPC server listening for HTTP/2 connections.
 	for _, listener := range cfg.RPCListeners {
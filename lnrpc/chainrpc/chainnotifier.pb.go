@@ -0,0 +1,869 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: chainrpc/chainnotifier.proto
+
+/*
+Package chainrpc is a generated protocol buffer package.
+
+It is generated from these files:
+	chainrpc/chainnotifier.proto
+
+It has these top-level messages:
+	ConfRequest
+	ConfDetails
+	Reorg
+	ConfEvent
+	Outpoint
+	SpendRequest
+	SpendDetails
+	SpendEvent
+	BlockEpoch
+*/
+package chainrpc
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ConfRequest struct {
+	// *
+	// The hash of the transaction for which we should request a confirmation
+	// notification for. If left empty, then the confirmation notification will be
+	// requested for the first transaction creating an output paying to the
+	// script instead.
+	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// *
+	// An output script within a transaction with the hash above which will be
+	// used by light clients to match block filters. If the transaction hash is
+	// left empty, then a confirmation notification will be requested for the
+	// first transaction creating an output paying to this script instead.
+	Script []byte `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	// / The number of desired confirmations the transaction must reach.
+	NumConfs uint32 `protobuf:"varint,3,opt,name=num_confs" json:"num_confs,omitempty"`
+	// *
+	// The earliest height in the chain for which the transaction could have been
+	// included in, which helps in reducing the time to discover it.
+	HeightHint uint32 `protobuf:"varint,4,opt,name=height_hint" json:"height_hint,omitempty"`
+}
+
+func (m *ConfRequest) Reset()                    { *m = ConfRequest{} }
+func (m *ConfRequest) String() string            { return proto.CompactTextString(m) }
+func (*ConfRequest) ProtoMessage()               {}
+func (*ConfRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *ConfRequest) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *ConfRequest) GetScript() []byte {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+func (m *ConfRequest) GetNumConfs() uint32 {
+	if m != nil {
+		return m.NumConfs
+	}
+	return 0
+}
+
+func (m *ConfRequest) GetHeightHint() uint32 {
+	if m != nil {
+		return m.HeightHint
+	}
+	return 0
+}
+
+type ConfDetails struct {
+	// / The raw bytes of the confirmed transaction.
+	RawTx []byte `protobuf:"bytes,1,opt,name=raw_tx,proto3" json:"raw_tx,omitempty"`
+	// / The hash of the block in which the confirmed transaction was included.
+	BlockHash []byte `protobuf:"bytes,2,opt,name=block_hash,proto3" json:"block_hash,omitempty"`
+	// / The height of the block in which the confirmed transaction was included.
+	BlockHeight uint32 `protobuf:"varint,3,opt,name=block_height" json:"block_height,omitempty"`
+	// / The index of the confirmed transaction within the block.
+	TxIndex uint32 `protobuf:"varint,4,opt,name=tx_index" json:"tx_index,omitempty"`
+	// *
+	// The index of the first output of the confirmed transaction paying to the
+	// requested script.
+	OutputIndex uint32 `protobuf:"varint,5,opt,name=output_index" json:"output_index,omitempty"`
+}
+
+func (m *ConfDetails) Reset()                    { *m = ConfDetails{} }
+func (m *ConfDetails) String() string            { return proto.CompactTextString(m) }
+func (*ConfDetails) ProtoMessage()               {}
+func (*ConfDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ConfDetails) GetRawTx() []byte {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+func (m *ConfDetails) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ConfDetails) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ConfDetails) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *ConfDetails) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+// *
+// Reorg is sent once the event that was notified before has been reorged out of
+// the chain. The event will be notified again once it happens in the new chain.
+type Reorg struct {
+}
+
+func (m *Reorg) Reset()                    { *m = Reorg{} }
+func (m *Reorg) String() string            { return proto.CompactTextString(m) }
+func (*Reorg) ProtoMessage()               {}
+func (*Reorg) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+type ConfEvent struct {
+	// Types that are valid to be assigned to Event:
+	//	*ConfEvent_Conf
+	//	*ConfEvent_Reorg
+	Event isConfEvent_Event `protobuf_oneof:"event"`
+}
+
+func (m *ConfEvent) Reset()                    { *m = ConfEvent{} }
+func (m *ConfEvent) String() string            { return proto.CompactTextString(m) }
+func (*ConfEvent) ProtoMessage()               {}
+func (*ConfEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+type isConfEvent_Event interface{ isConfEvent_Event() }
+
+type ConfEvent_Conf struct {
+	Conf *ConfDetails `protobuf:"bytes,1,opt,name=conf,oneof"`
+}
+type ConfEvent_Reorg struct {
+	Reorg *Reorg `protobuf:"bytes,2,opt,name=reorg,oneof"`
+}
+
+func (*ConfEvent_Conf) isConfEvent_Event()  {}
+func (*ConfEvent_Reorg) isConfEvent_Event() {}
+
+func (m *ConfEvent) GetEvent() isConfEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *ConfEvent) GetConf() *ConfDetails {
+	if x, ok := m.GetEvent().(*ConfEvent_Conf); ok {
+		return x.Conf
+	}
+	return nil
+}
+
+func (m *ConfEvent) GetReorg() *Reorg {
+	if x, ok := m.GetEvent().(*ConfEvent_Reorg); ok {
+		return x.Reorg
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ConfEvent) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ConfEvent_OneofMarshaler, _ConfEvent_OneofUnmarshaler, _ConfEvent_OneofSizer, []interface{}{
+		(*ConfEvent_Conf)(nil),
+		(*ConfEvent_Reorg)(nil),
+	}
+}
+
+func _ConfEvent_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ConfEvent)
+	// event
+	switch x := m.Event.(type) {
+	case *ConfEvent_Conf:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Conf); err != nil {
+			return err
+		}
+	case *ConfEvent_Reorg:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Reorg); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ConfEvent.Event has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ConfEvent_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ConfEvent)
+	switch tag {
+	case 1: // event.conf
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ConfDetails)
+		err := b.DecodeMessage(msg)
+		m.Event = &ConfEvent_Conf{msg}
+		return true, err
+	case 2: // event.reorg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Reorg)
+		err := b.DecodeMessage(msg)
+		m.Event = &ConfEvent_Reorg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ConfEvent_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ConfEvent)
+	// event
+	switch x := m.Event.(type) {
+	case *ConfEvent_Conf:
+		s := proto.Size(x.Conf)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ConfEvent_Reorg:
+		s := proto.Size(x.Reorg)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type Outpoint struct {
+	// / The hash of the transaction.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// / The index of the output within the transaction.
+	Index uint32 `protobuf:"varint,2,opt,name=index" json:"index,omitempty"`
+}
+
+func (m *Outpoint) Reset()                    { *m = Outpoint{} }
+func (m *Outpoint) String() string            { return proto.CompactTextString(m) }
+func (*Outpoint) ProtoMessage()               {}
+func (*Outpoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Outpoint) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Outpoint) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type SpendRequest struct {
+	// *
+	// The outpoint for which we should request a spend notification for. If left
+	// unset, then the spend notification will be requested for any output
+	// paying to the script instead.
+	Outpoint *Outpoint `protobuf:"bytes,1,opt,name=outpoint" json:"outpoint,omitempty"`
+	// *
+	// The output script for the outpoint above. This will be used by light
+	// clients to match block filters. If the outpoint is left unset, then a spend
+	// notification will be requested for any output paying to this script
+	// instead, which must be a P2PKH, P2SH, P2WKH or P2WSH script.
+	Script []byte `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	// *
+	// The earliest height in the chain for which the outpoint could have been
+	// spent, which helps in reducing the time to discover the spend.
+	HeightHint uint32 `protobuf:"varint,3,opt,name=height_hint" json:"height_hint,omitempty"`
+}
+
+func (m *SpendRequest) Reset()                    { *m = SpendRequest{} }
+func (m *SpendRequest) String() string            { return proto.CompactTextString(m) }
+func (*SpendRequest) ProtoMessage()               {}
+func (*SpendRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *SpendRequest) GetOutpoint() *Outpoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *SpendRequest) GetScript() []byte {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+func (m *SpendRequest) GetHeightHint() uint32 {
+	if m != nil {
+		return m.HeightHint
+	}
+	return 0
+}
+
+type SpendDetails struct {
+	// / The outpoint that was spent.
+	SpendingOutpoint *Outpoint `protobuf:"bytes,1,opt,name=spending_outpoint" json:"spending_outpoint,omitempty"`
+	// / The raw bytes of the spending transaction.
+	RawSpendingTx []byte `protobuf:"bytes,2,opt,name=raw_spending_tx,proto3" json:"raw_spending_tx,omitempty"`
+	// / The hash of the spending transaction.
+	SpendingTxHash []byte `protobuf:"bytes,3,opt,name=spending_tx_hash,proto3" json:"spending_tx_hash,omitempty"`
+	// / The input of the spending transaction that fulfilled the spend request.
+	SpendingInputIndex uint32 `protobuf:"varint,4,opt,name=spending_input_index" json:"spending_input_index,omitempty"`
+	// / The height at which the spending transaction was included in a block.
+	SpendingHeight uint32 `protobuf:"varint,5,opt,name=spending_height" json:"spending_height,omitempty"`
+}
+
+func (m *SpendDetails) Reset()                    { *m = SpendDetails{} }
+func (m *SpendDetails) String() string            { return proto.CompactTextString(m) }
+func (*SpendDetails) ProtoMessage()               {}
+func (*SpendDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *SpendDetails) GetSpendingOutpoint() *Outpoint {
+	if m != nil {
+		return m.SpendingOutpoint
+	}
+	return nil
+}
+
+func (m *SpendDetails) GetRawSpendingTx() []byte {
+	if m != nil {
+		return m.RawSpendingTx
+	}
+	return nil
+}
+
+func (m *SpendDetails) GetSpendingTxHash() []byte {
+	if m != nil {
+		return m.SpendingTxHash
+	}
+	return nil
+}
+
+func (m *SpendDetails) GetSpendingInputIndex() uint32 {
+	if m != nil {
+		return m.SpendingInputIndex
+	}
+	return 0
+}
+
+func (m *SpendDetails) GetSpendingHeight() uint32 {
+	if m != nil {
+		return m.SpendingHeight
+	}
+	return 0
+}
+
+type SpendEvent struct {
+	// Types that are valid to be assigned to Event:
+	//	*SpendEvent_Spend
+	//	*SpendEvent_Reorg
+	Event isSpendEvent_Event `protobuf_oneof:"event"`
+}
+
+func (m *SpendEvent) Reset()                    { *m = SpendEvent{} }
+func (m *SpendEvent) String() string            { return proto.CompactTextString(m) }
+func (*SpendEvent) ProtoMessage()               {}
+func (*SpendEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+type isSpendEvent_Event interface{ isSpendEvent_Event() }
+
+type SpendEvent_Spend struct {
+	Spend *SpendDetails `protobuf:"bytes,1,opt,name=spend,oneof"`
+}
+type SpendEvent_Reorg struct {
+	Reorg *Reorg `protobuf:"bytes,2,opt,name=reorg,oneof"`
+}
+
+func (*SpendEvent_Spend) isSpendEvent_Event() {}
+func (*SpendEvent_Reorg) isSpendEvent_Event() {}
+
+func (m *SpendEvent) GetEvent() isSpendEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (m *SpendEvent) GetSpend() *SpendDetails {
+	if x, ok := m.GetEvent().(*SpendEvent_Spend); ok {
+		return x.Spend
+	}
+	return nil
+}
+
+func (m *SpendEvent) GetReorg() *Reorg {
+	if x, ok := m.GetEvent().(*SpendEvent_Reorg); ok {
+		return x.Reorg
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*SpendEvent) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _SpendEvent_OneofMarshaler, _SpendEvent_OneofUnmarshaler, _SpendEvent_OneofSizer, []interface{}{
+		(*SpendEvent_Spend)(nil),
+		(*SpendEvent_Reorg)(nil),
+	}
+}
+
+func _SpendEvent_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*SpendEvent)
+	// event
+	switch x := m.Event.(type) {
+	case *SpendEvent_Spend:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Spend); err != nil {
+			return err
+		}
+	case *SpendEvent_Reorg:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Reorg); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("SpendEvent.Event has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _SpendEvent_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*SpendEvent)
+	switch tag {
+	case 1: // event.spend
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SpendDetails)
+		err := b.DecodeMessage(msg)
+		m.Event = &SpendEvent_Spend{msg}
+		return true, err
+	case 2: // event.reorg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Reorg)
+		err := b.DecodeMessage(msg)
+		m.Event = &SpendEvent_Reorg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _SpendEvent_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*SpendEvent)
+	// event
+	switch x := m.Event.(type) {
+	case *SpendEvent_Spend:
+		s := proto.Size(x.Spend)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *SpendEvent_Reorg:
+		s := proto.Size(x.Reorg)
+		n += proto.SizeVarint(2<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type BlockEpoch struct {
+	// / The hash of the block.
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// / The height of the block.
+	Height uint32 `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
+}
+
+func (m *BlockEpoch) Reset()                    { *m = BlockEpoch{} }
+func (m *BlockEpoch) String() string            { return proto.CompactTextString(m) }
+func (*BlockEpoch) ProtoMessage()               {}
+func (*BlockEpoch) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *BlockEpoch) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *BlockEpoch) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ConfRequest)(nil), "chainrpc.ConfRequest")
+	proto.RegisterType((*ConfDetails)(nil), "chainrpc.ConfDetails")
+	proto.RegisterType((*Reorg)(nil), "chainrpc.Reorg")
+	proto.RegisterType((*ConfEvent)(nil), "chainrpc.ConfEvent")
+	proto.RegisterType((*Outpoint)(nil), "chainrpc.Outpoint")
+	proto.RegisterType((*SpendRequest)(nil), "chainrpc.SpendRequest")
+	proto.RegisterType((*SpendDetails)(nil), "chainrpc.SpendDetails")
+	proto.RegisterType((*SpendEvent)(nil), "chainrpc.SpendEvent")
+	proto.RegisterType((*BlockEpoch)(nil), "chainrpc.BlockEpoch")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ChainNotifier service
+
+type ChainNotifierClient interface {
+	// *
+	// RegisterConfirmationsNtfn is a synchronous response-streaming RPC that
+	// registers an intent for a client to be notified once a confirmation request
+	// has reached its required number of confirmations on-chain.
+	//
+	// A client can specify whether the confirmation request should be for a
+	// particular transaction by its hash or for an output script by leaving the
+	// hash empty.
+	RegisterConfirmationsNtfn(ctx context.Context, in *ConfRequest, opts ...grpc.CallOption) (ChainNotifier_RegisterConfirmationsNtfnClient, error)
+	// *
+	// RegisterSpendNtfn is a synchronous response-streaming RPC that registers an
+	// intent for a client to be notified once a spend request has been spent
+	// by a transaction that has confirmed on-chain.
+	//
+	// A client can specify whether the spend request should be for a particular
+	// outpoint or for an output script by leaving the outpoint unset.
+	RegisterSpendNtfn(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (ChainNotifier_RegisterSpendNtfnClient, error)
+	// *
+	// RegisterBlockEpochNtfn is a synchronous response-streaming RPC that
+	// registers an intent for a client to be notified of blocks in the chain. The
+	// stream will return a hash and height tuple of each block connected to the
+	// main chain. After a reorg, the blocks of the new chain are sent, so a
+	// height may be notified more than once.
+	//
+	// A client can also request a historical backlog of blocks by specifying its
+	// best known block. This allows clients to be idempotent by ensuring that
+	// they do not miss processing a single block within the chain.
+	RegisterBlockEpochNtfn(ctx context.Context, in *BlockEpoch, opts ...grpc.CallOption) (ChainNotifier_RegisterBlockEpochNtfnClient, error)
+}
+
+type chainNotifierClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewChainNotifierClient(cc *grpc.ClientConn) ChainNotifierClient {
+	return &chainNotifierClient{cc}
+}
+
+func (c *chainNotifierClient) RegisterConfirmationsNtfn(ctx context.Context, in *ConfRequest, opts ...grpc.CallOption) (ChainNotifier_RegisterConfirmationsNtfnClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ChainNotifier_serviceDesc.Streams[0], c.cc, "/chainrpc.ChainNotifier/RegisterConfirmationsNtfn", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainNotifierRegisterConfirmationsNtfnClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainNotifier_RegisterConfirmationsNtfnClient interface {
+	Recv() (*ConfEvent, error)
+	grpc.ClientStream
+}
+
+type chainNotifierRegisterConfirmationsNtfnClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainNotifierRegisterConfirmationsNtfnClient) Recv() (*ConfEvent, error) {
+	m := new(ConfEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chainNotifierClient) RegisterSpendNtfn(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (ChainNotifier_RegisterSpendNtfnClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ChainNotifier_serviceDesc.Streams[1], c.cc, "/chainrpc.ChainNotifier/RegisterSpendNtfn", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainNotifierRegisterSpendNtfnClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainNotifier_RegisterSpendNtfnClient interface {
+	Recv() (*SpendEvent, error)
+	grpc.ClientStream
+}
+
+type chainNotifierRegisterSpendNtfnClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainNotifierRegisterSpendNtfnClient) Recv() (*SpendEvent, error) {
+	m := new(SpendEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *chainNotifierClient) RegisterBlockEpochNtfn(ctx context.Context, in *BlockEpoch, opts ...grpc.CallOption) (ChainNotifier_RegisterBlockEpochNtfnClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ChainNotifier_serviceDesc.Streams[2], c.cc, "/chainrpc.ChainNotifier/RegisterBlockEpochNtfn", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &chainNotifierRegisterBlockEpochNtfnClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChainNotifier_RegisterBlockEpochNtfnClient interface {
+	Recv() (*BlockEpoch, error)
+	grpc.ClientStream
+}
+
+type chainNotifierRegisterBlockEpochNtfnClient struct {
+	grpc.ClientStream
+}
+
+func (x *chainNotifierRegisterBlockEpochNtfnClient) Recv() (*BlockEpoch, error) {
+	m := new(BlockEpoch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for ChainNotifier service
+
+type ChainNotifierServer interface {
+	// *
+	// RegisterConfirmationsNtfn is a synchronous response-streaming RPC that
+	// registers an intent for a client to be notified once a confirmation request
+	// has reached its required number of confirmations on-chain.
+	//
+	// A client can specify whether the confirmation request should be for a
+	// particular transaction by its hash or for an output script by leaving the
+	// hash empty.
+	RegisterConfirmationsNtfn(*ConfRequest, ChainNotifier_RegisterConfirmationsNtfnServer) error
+	// *
+	// RegisterSpendNtfn is a synchronous response-streaming RPC that registers an
+	// intent for a client to be notified once a spend request has been spent
+	// by a transaction that has confirmed on-chain.
+	//
+	// A client can specify whether the spend request should be for a particular
+	// outpoint or for an output script by leaving the outpoint unset.
+	RegisterSpendNtfn(*SpendRequest, ChainNotifier_RegisterSpendNtfnServer) error
+	// *
+	// RegisterBlockEpochNtfn is a synchronous response-streaming RPC that
+	// registers an intent for a client to be notified of blocks in the chain. The
+	// stream will return a hash and height tuple of each block connected to the
+	// main chain. After a reorg, the blocks of the new chain are sent, so a
+	// height may be notified more than once.
+	//
+	// A client can also request a historical backlog of blocks by specifying its
+	// best known block. This allows clients to be idempotent by ensuring that
+	// they do not miss processing a single block within the chain.
+	RegisterBlockEpochNtfn(*BlockEpoch, ChainNotifier_RegisterBlockEpochNtfnServer) error
+}
+
+func RegisterChainNotifierServer(s *grpc.Server, srv ChainNotifierServer) {
+	s.RegisterService(&_ChainNotifier_serviceDesc, srv)
+}
+
+func _ChainNotifier_RegisterConfirmationsNtfn_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConfRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainNotifierServer).RegisterConfirmationsNtfn(m, &chainNotifierRegisterConfirmationsNtfnServer{stream})
+}
+
+type ChainNotifier_RegisterConfirmationsNtfnServer interface {
+	Send(*ConfEvent) error
+	grpc.ServerStream
+}
+
+type chainNotifierRegisterConfirmationsNtfnServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainNotifierRegisterConfirmationsNtfnServer) Send(m *ConfEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChainNotifier_RegisterSpendNtfn_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpendRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainNotifierServer).RegisterSpendNtfn(m, &chainNotifierRegisterSpendNtfnServer{stream})
+}
+
+type ChainNotifier_RegisterSpendNtfnServer interface {
+	Send(*SpendEvent) error
+	grpc.ServerStream
+}
+
+type chainNotifierRegisterSpendNtfnServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainNotifierRegisterSpendNtfnServer) Send(m *SpendEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChainNotifier_RegisterBlockEpochNtfn_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockEpoch)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChainNotifierServer).RegisterBlockEpochNtfn(m, &chainNotifierRegisterBlockEpochNtfnServer{stream})
+}
+
+type ChainNotifier_RegisterBlockEpochNtfnServer interface {
+	Send(*BlockEpoch) error
+	grpc.ServerStream
+}
+
+type chainNotifierRegisterBlockEpochNtfnServer struct {
+	grpc.ServerStream
+}
+
+func (x *chainNotifierRegisterBlockEpochNtfnServer) Send(m *BlockEpoch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ChainNotifier_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chainrpc.ChainNotifier",
+	HandlerType: (*ChainNotifierServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RegisterConfirmationsNtfn",
+			Handler:       _ChainNotifier_RegisterConfirmationsNtfn_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegisterSpendNtfn",
+			Handler:       _ChainNotifier_RegisterSpendNtfn_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RegisterBlockEpochNtfn",
+			Handler:       _ChainNotifier_RegisterBlockEpochNtfn_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "chainrpc/chainnotifier.proto",
+}
+
+func init() { proto.RegisterFile("chainrpc/chainnotifier.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xad, 0x93, 0x38, 0x4d, 0x27, 0xa9, 0x4a, 0x97, 0x10, 0x85, 0xa8, 0x42, 0x91, 0x2f, 0x44,
+	0x20, 0x85, 0x2a, 0x70, 0xe0, 0x88, 0x1a, 0x8a, 0xca, 0xa5, 0x48, 0xe6, 0x07, 0x58, 0xa9, 0xb3,
+	0x89, 0x17, 0xda, 0x5d, 0x63, 0xaf, 0xc1, 0xdc, 0xf9, 0x33, 0xfc, 0x32, 0xfe, 0x06, 0xda, 0xd9,
+	0xf1, 0x47, 0x9d, 0x20, 0xa1, 0xde, 0x3c, 0x6f, 0x66, 0xdf, 0xbe, 0xb7, 0xf3, 0x64, 0x38, 0x0b,
+	0xa3, 0x95, 0x90, 0x49, 0x1c, 0xbe, 0xc2, 0x0f, 0xa9, 0xb4, 0xd8, 0x08, 0x9e, 0xcc, 0xe3, 0x44,
+	0x69, 0xc5, 0x7a, 0x45, 0xd7, 0xfb, 0x09, 0xfd, 0xa5, 0x92, 0x1b, 0x9f, 0x7f, 0xcb, 0x78, 0xaa,
+	0x19, 0x83, 0x8e, 0xce, 0xc5, 0x7a, 0xec, 0x4c, 0x9d, 0xd9, 0xc0, 0xc7, 0x6f, 0x36, 0x82, 0x6e,
+	0x1a, 0x26, 0x22, 0xd6, 0xe3, 0x16, 0xa2, 0x54, 0xb1, 0x33, 0x38, 0x92, 0xd9, 0x5d, 0x10, 0x2a,
+	0xb9, 0x49, 0xc7, 0xed, 0xa9, 0x33, 0x3b, 0xf6, 0x2b, 0x80, 0x4d, 0xa1, 0x1f, 0x71, 0xb1, 0x8d,
+	0x74, 0x10, 0x09, 0xa9, 0xc7, 0x1d, 0xec, 0xd7, 0x21, 0xef, 0xb7, 0x63, 0xef, 0x7e, 0xcf, 0xf5,
+	0x4a, 0xdc, 0xa6, 0xe6, 0x9e, 0x64, 0xf5, 0x23, 0xd0, 0x39, 0xdd, 0x4e, 0x15, 0x7b, 0x06, 0x70,
+	0x73, 0xab, 0xc2, 0xaf, 0x41, 0xb4, 0x4a, 0x23, 0xd2, 0x50, 0x43, 0x98, 0x07, 0x03, 0xaa, 0x90,
+	0x9c, 0xa4, 0xdc, 0xc3, 0xd8, 0x04, 0x7a, 0x3a, 0x0f, 0x84, 0x5c, 0xf3, 0x9c, 0xa4, 0x94, 0xb5,
+	0x39, 0xaf, 0x32, 0x1d, 0x67, 0x9a, 0xfa, 0xae, 0x3d, 0x5f, 0xc7, 0xbc, 0x43, 0x70, 0x7d, 0xae,
+	0x92, 0xad, 0xf7, 0x05, 0x8e, 0x8c, 0xe6, 0xcb, 0xef, 0x5c, 0x6a, 0xf6, 0x12, 0x3a, 0xc6, 0x2c,
+	0xea, 0xed, 0x2f, 0x9e, 0xcc, 0x8b, 0x57, 0x9d, 0xd7, 0x6c, 0x5d, 0x1d, 0xf8, 0x38, 0xc4, 0x9e,
+	0x83, 0x9b, 0x18, 0x0a, 0x74, 0xd0, 0x5f, 0x9c, 0x54, 0xd3, 0xc8, 0x7c, 0x75, 0xe0, 0xdb, 0xfe,
+	0xc5, 0x21, 0xb8, 0xdc, 0xd0, 0x7b, 0x6f, 0xa0, 0xf7, 0x29, 0xd3, 0xb1, 0x12, 0x12, 0x17, 0x83,
+	0xf6, 0x69, 0x31, 0x68, 0x7c, 0x08, 0xae, 0x55, 0xdc, 0x42, 0xc5, 0xb6, 0xf0, 0x72, 0x18, 0x7c,
+	0x8e, 0xb9, 0x5c, 0x17, 0x2b, 0x9d, 0x43, 0x4f, 0x11, 0x0b, 0x09, 0x65, 0xd5, 0xd5, 0x05, 0xbf,
+	0x5f, 0xce, 0xfc, 0x73, 0xdd, 0x8d, 0x85, 0xb6, 0x77, 0x17, 0xfa, 0xab, 0x45, 0x57, 0x17, 0x1b,
+	0x7d, 0x07, 0xa7, 0xa9, 0xa9, 0x85, 0xdc, 0x06, 0xff, 0xa1, 0x61, 0x77, 0x98, 0xcd, 0xe0, 0xc4,
+	0xa4, 0xa0, 0x6c, 0xe8, 0x9c, 0x54, 0x35, 0x61, 0xf6, 0x02, 0x1e, 0xd5, 0x4a, 0x9b, 0x95, 0x36,
+	0x8e, 0xee, 0xe0, 0x6c, 0x01, 0xc3, 0x12, 0x13, 0xb2, 0xda, 0xbc, 0x4d, 0xc6, 0xde, 0x9e, 0x51,
+	0x52, 0xe2, 0x14, 0x34, 0x1b, 0x94, 0x26, 0xec, 0x49, 0x00, 0x7c, 0x05, 0x9b, 0x91, 0x39, 0xb8,
+	0x38, 0x40, 0xbe, 0x47, 0x95, 0xef, 0xfa, 0x53, 0x99, 0xed, 0xe3, 0xd8, 0x03, 0x62, 0xf2, 0x16,
+	0xe0, 0xc2, 0x64, 0xfd, 0x32, 0x56, 0x61, 0xb4, 0x37, 0x28, 0x23, 0xe8, 0x92, 0x64, 0x9b, 0x14,
+	0xaa, 0x16, 0x7f, 0x1c, 0x38, 0x5e, 0x1a, 0xfa, 0x6b, 0xfa, 0x3d, 0xb0, 0x8f, 0xf0, 0xd4, 0xe7,
+	0x5b, 0x91, 0x6a, 0x9e, 0x98, 0x0c, 0x8b, 0xe4, 0x6e, 0xa5, 0x85, 0x92, 0xe9, 0xb5, 0xde, 0x48,
+	0xd6, 0x08, 0x38, 0x05, 0x6c, 0xf2, 0xf8, 0x3e, 0x8c, 0xb6, 0xcf, 0x1d, 0xb6, 0x84, 0xd3, 0x82,
+	0x0a, 0x9d, 0x22, 0x45, 0xd3, 0x7e, 0xc1, 0x31, 0x6c, 0xe0, 0x05, 0xc9, 0x07, 0x18, 0x15, 0x24,
+	0x95, 0x47, 0x64, 0xaa, 0x9d, 0xa8, 0x3a, 0x93, 0xbd, 0xe8, 0xb9, 0x73, 0xd3, 0xc5, 0xff, 0xde,
+	0xeb, 0xbf, 0x03, 0x00, 0x28, 0x79, 0xd7, 0x44, 0x17, 0x05, 0x00, 0x00,
+}
@@ -0,0 +1,304 @@
+package chainrpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+var (
+	// ErrChainNotifierServerShuttingDown is an error returned by the
+	// ChainNotifier RPC server when the server, or the notifier backing
+	// it, is shutting down.
+	ErrChainNotifierServerShuttingDown = errors.New("chain notifier RPC " +
+		"server shutting down")
+)
+
+// Config houses the dependencies of the ChainNotifier RPC server.
+type Config struct {
+	// ChainNotifier is the notifier that the notification requests of
+	// the RPC clients are registered with.
+	ChainNotifier chainntnfs.ChainNotifier
+}
+
+// Server is the ChainNotifier RPC server, which exposes the confirmation,
+// spend and block notifications of the node's ChainNotifier to external
+// services, such that they don't need to run their own chain watchers.
+type Server struct {
+	started  int32 // To be used atomically.
+	shutdown int32 // To be used atomically.
+
+	cfg *Config
+
+	quit chan struct{}
+}
+
+// A compile time check to ensure that Server fully implements the
+// ChainNotifierServer gRPC service.
+var _ ChainNotifierServer = (*Server)(nil)
+
+// New creates a new ChainNotifier RPC server backed by the given config.
+func New(cfg *Config) *Server {
+	return &Server{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start launches any helper goroutines required for the Server to function.
+func (s *Server) Start() error {
+	if atomic.AddInt32(&s.started, 1) != 1 {
+		return nil
+	}
+
+	return nil
+}
+
+// Stop signals any active streams to exit.
+func (s *Server) Stop() error {
+	if atomic.AddInt32(&s.shutdown, 1) != 1 {
+		return nil
+	}
+
+	close(s.quit)
+
+	return nil
+}
+
+// RegisterConfirmationsNtfn is a synchronous response-streaming RPC that
+// registers an intent for a client to be notified once a confirmation request
+// has reached its required number of confirmations on-chain. If the
+// confirmed transaction is later reorged out of the chain, the client is
+// notified of the reorg, after which the confirmation is notified again once
+// the transaction confirms within the new chain.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierServer interface.
+func (s *Server) RegisterConfirmationsNtfn(in *ConfRequest,
+	confStream ChainNotifier_RegisterConfirmationsNtfnServer) error {
+
+	// We'll start by reconstructing the RPC request into what the
+	// underlying ChainNotifier expects.
+	var txid *chainhash.Hash
+	if len(in.Txid) > 0 {
+		var err error
+		txid, err = chainhash.NewHash(in.Txid)
+		if err != nil {
+			return fmt.Errorf("invalid txid: %v", err)
+		}
+	}
+	if in.NumConfs == 0 {
+		return errors.New("num_confs must be greater than 0")
+	}
+
+	confEvent, err := s.cfg.ChainNotifier.RegisterConfirmationsNtfn(
+		txid, in.Script, in.NumConfs, in.HeightHint,
+	)
+	if err != nil {
+		return err
+	}
+	defer confEvent.Cancel()
+
+	for {
+		select {
+		// A confirmation for the request has been received, so we'll
+		// send its details to the client.
+		case details, ok := <-confEvent.Confirmed:
+			if !ok {
+				return ErrChainNotifierServerShuttingDown
+			}
+
+			var rawTx bytes.Buffer
+			if details.Tx != nil {
+				err := details.Tx.Serialize(&rawTx)
+				if err != nil {
+					return err
+				}
+			}
+
+			confDetails := &ConfDetails{
+				RawTx:       rawTx.Bytes(),
+				BlockHash:   details.BlockHash[:],
+				BlockHeight: details.BlockHeight,
+				TxIndex:     details.TxIndex,
+				OutputIndex: details.OutputIndex,
+			}
+			conf := &ConfEvent{
+				Event: &ConfEvent_Conf{Conf: confDetails},
+			}
+			if err := confStream.Send(conf); err != nil {
+				return err
+			}
+
+		// The transaction of the request has been reorged out of the
+		// chain, so we'll let the client know.
+		case _, ok := <-confEvent.NegativeConf:
+			if !ok {
+				return ErrChainNotifierServerShuttingDown
+			}
+
+			reorg := &ConfEvent{
+				Event: &ConfEvent_Reorg{Reorg: &Reorg{}},
+			}
+			if err := confStream.Send(reorg); err != nil {
+				return err
+			}
+
+		// The intermediate confirmation updates aren't exposed to the
+		// client, but we'll still consume them as the notifier blocks
+		// on sending them once their buffer is full, which can happen
+		// when the transaction confirms again after a reorg.
+		case _, ok := <-confEvent.Updates:
+			if !ok {
+				return ErrChainNotifierServerShuttingDown
+			}
+
+		case <-confStream.Context().Done():
+			return confStream.Context().Err()
+
+		case <-s.quit:
+			return ErrChainNotifierServerShuttingDown
+		}
+	}
+}
+
+// RegisterSpendNtfn is a synchronous response-streaming RPC that registers an
+// intent for a client to be notified once a spend request has been spent by a
+// transaction that has confirmed on-chain. If the spending transaction is
+// later reorged out of the chain, the client is notified of the reorg.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierServer interface.
+func (s *Server) RegisterSpendNtfn(in *SpendRequest,
+	spendStream ChainNotifier_RegisterSpendNtfnServer) error {
+
+	// We'll start by reconstructing the RPC request into what the
+	// underlying ChainNotifier expects.
+	var op *wire.OutPoint
+	if in.Outpoint != nil {
+		txid, err := chainhash.NewHash(in.Outpoint.Hash)
+		if err != nil {
+			return fmt.Errorf("invalid outpoint hash: %v", err)
+		}
+		op = &wire.OutPoint{Hash: *txid, Index: in.Outpoint.Index}
+	}
+
+	spendEvent, err := s.cfg.ChainNotifier.RegisterSpendNtfn(
+		op, in.Script, in.HeightHint,
+	)
+	if err != nil {
+		return err
+	}
+	defer spendEvent.Cancel()
+
+	for {
+		select {
+		// A spend of the request has been detected, so we'll send its
+		// details to the client.
+		case details, ok := <-spendEvent.Spend:
+			if !ok {
+				return ErrChainNotifierServerShuttingDown
+			}
+
+			var rawSpendingTx bytes.Buffer
+			err := details.SpendingTx.Serialize(&rawSpendingTx)
+			if err != nil {
+				return err
+			}
+
+			spentOutPoint := details.SpentOutPoint
+			spendDetails := &SpendDetails{
+				SpendingOutpoint: &Outpoint{
+					Hash:  spentOutPoint.Hash[:],
+					Index: spentOutPoint.Index,
+				},
+				RawSpendingTx:      rawSpendingTx.Bytes(),
+				SpendingTxHash:     details.SpenderTxHash[:],
+				SpendingInputIndex: details.SpenderInputIndex,
+				SpendingHeight:     uint32(details.SpendingHeight),
+			}
+			spend := &SpendEvent{
+				Event: &SpendEvent_Spend{Spend: spendDetails},
+			}
+			if err := spendStream.Send(spend); err != nil {
+				return err
+			}
+
+		// The spending transaction of the request has been reorged out
+		// of the chain, so we'll let the client know.
+		case _, ok := <-spendEvent.Reorg:
+			if !ok {
+				return ErrChainNotifierServerShuttingDown
+			}
+
+			reorg := &SpendEvent{
+				Event: &SpendEvent_Reorg{Reorg: &Reorg{}},
+			}
+			if err := spendStream.Send(reorg); err != nil {
+				return err
+			}
+
+		case <-spendStream.Context().Done():
+			return spendStream.Context().Err()
+
+		case <-s.quit:
+			return ErrChainNotifierServerShuttingDown
+		}
+	}
+}
+
+// RegisterBlockEpochNtfn is a synchronous response-streaming RPC that
+// registers an intent for a client to be notified of blocks in the chain. If
+// the client specifies its best known block, it's first caught up on the
+// blocks it has missed since.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierServer interface.
+func (s *Server) RegisterBlockEpochNtfn(in *BlockEpoch,
+	epochStream ChainNotifier_RegisterBlockEpochNtfnServer) error {
+
+	// We'll start by reconstructing the RPC request into what the
+	// underlying ChainNotifier expects.
+	var bestBlock *chainntnfs.BlockEpoch
+	if len(in.Hash) > 0 {
+		hash, err := chainhash.NewHash(in.Hash)
+		if err != nil {
+			return fmt.Errorf("invalid block hash: %v", err)
+		}
+		bestBlock = &chainntnfs.BlockEpoch{
+			Hash:   hash,
+			Height: int32(in.Height),
+		}
+	}
+
+	epochEvent, err := s.cfg.ChainNotifier.RegisterBlockEpochNtfn(bestBlock)
+	if err != nil {
+		return err
+	}
+	defer epochEvent.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-epochEvent.Epochs:
+			if !ok {
+				return ErrChainNotifierServerShuttingDown
+			}
+
+			blockEpoch := &BlockEpoch{
+				Hash:   epoch.Hash[:],
+				Height: uint32(epoch.Height),
+			}
+			if err := epochStream.Send(blockEpoch); err != nil {
+				return err
+			}
+
+		case <-epochStream.Context().Done():
+			return epochStream.Context().Err()
+
+		case <-s.quit:
+			return ErrChainNotifierServerShuttingDown
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package chainrpc
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// mockNotifier is a ChainNotifier that hands out the events it was created
+// with, recording the requests they were registered for.
+type mockNotifier struct {
+	confEvent  *chainntnfs.ConfirmationEvent
+	spendEvent *chainntnfs.SpendEvent
+
+	txid     *chainhash.Hash
+	outpoint *wire.OutPoint
+	pkScript []byte
+}
+
+func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte, numConfs,
+	heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	m.txid = txid
+	m.pkScript = pkScript
+	return m.confEvent, nil
+}
+
+func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	m.outpoint = outpoint
+	m.pkScript = pkScript
+	return m.spendEvent, nil
+}
+
+func (m *mockNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: make(chan *chainntnfs.BlockEpoch),
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) Start() error {
+	return nil
+}
+
+func (m *mockNotifier) Stop() error {
+	return nil
+}
+
+// mockStream is a server stream of any of the ChainNotifier RPCs, which
+// forwards the events sent over it.
+type mockStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	events chan interface{}
+}
+
+func newMockStream(ctx context.Context) *mockStream {
+	return &mockStream{
+		ctx:    ctx,
+		events: make(chan interface{}, 1),
+	}
+}
+
+func (m *mockStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStream) send(event interface{}) error {
+	m.events <- event
+	return nil
+}
+
+type confStream struct{ *mockStream }
+
+func (s confStream) Send(event *ConfEvent) error { return s.send(event) }
+
+type spendStream struct{ *mockStream }
+
+func (s spendStream) Send(event *SpendEvent) error { return s.send(event) }
+
+func receiveEvent(t *testing.T, stream *mockStream) interface{} {
+	t.Helper()
+
+	select {
+	case event := <-stream.events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected event to be sent over stream")
+	}
+
+	return nil
+}
+
+// TestRegisterConfirmationsNtfn tests that the confirmation of a script, as
+// well as its reorg and subsequent confirmation, are streamed to the client,
+// and that the registration is canceled once the client goes away.
+func TestRegisterConfirmationsNtfn(t *testing.T) {
+	t.Parallel()
+
+	canceled := make(chan struct{})
+	notifier := &mockNotifier{
+		confEvent: chainntnfs.NewConfirmationEvent(1, func() {
+			close(canceled)
+		}),
+	}
+	server := New(&Config{ChainNotifier: notifier})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newMockStream(ctx)
+	pkScript := []byte{0x00, 0x14}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.RegisterConfirmationsNtfn(&ConfRequest{
+			Script:   pkScript,
+			NumConfs: 1,
+		}, confStream{stream})
+	}()
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: pkScript})
+	var rawTx bytes.Buffer
+	if err := tx.Serialize(&rawTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+
+	blockHash := chainhash.Hash{0x02}
+	notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHash:   &blockHash,
+		BlockHeight: 100,
+		TxIndex:     3,
+		Tx:          tx,
+	}
+
+	event := receiveEvent(t, stream).(*ConfEvent)
+	conf := event.GetConf()
+	if conf == nil {
+		t.Fatalf("expected conf event, got %v", event)
+	}
+	if notifier.txid != nil || !bytes.Equal(notifier.pkScript, pkScript) {
+		t.Fatalf("expected conf request for script %x, got txid=%v "+
+			"script=%x", pkScript, notifier.txid, notifier.pkScript)
+	}
+	if !bytes.Equal(conf.RawTx, rawTx.Bytes()) {
+		t.Fatalf("expected raw tx %x, got %x", rawTx.Bytes(),
+			conf.RawTx)
+	}
+	if !bytes.Equal(conf.BlockHash, blockHash[:]) ||
+		conf.BlockHeight != 100 || conf.TxIndex != 3 {
+
+		t.Fatalf("unexpected conf details: %v", conf)
+	}
+
+	// Once the transaction is reorged out of the chain, the client should
+	// be notified, after which the new confirmation is sent.
+	notifier.confEvent.NegativeConf <- 1
+	event = receiveEvent(t, stream).(*ConfEvent)
+	if event.GetReorg() == nil {
+		t.Fatalf("expected reorg event, got %v", event)
+	}
+
+	notifier.confEvent.Confirmed <- &chainntnfs.TxConfirmation{
+		BlockHash:   &blockHash,
+		BlockHeight: 101,
+		Tx:          tx,
+	}
+	event = receiveEvent(t, stream).(*ConfEvent)
+	if event.GetConf() == nil || event.GetConf().BlockHeight != 101 {
+		t.Fatalf("expected conf event at height 101, got %v", event)
+	}
+
+	cancel()
+	select {
+	case err := <-errChan:
+		if err != context.Canceled {
+			t.Fatalf("expected context canceled error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected stream to exit")
+	}
+
+	select {
+	case <-canceled:
+	default:
+		t.Fatal("expected confirmation registration to be canceled")
+	}
+}
+
+// TestRegisterSpendNtfn tests that the spend of an outpoint, and its reorg,
+// are streamed to the client, and that the registration is canceled once
+// the server shuts down.
+func TestRegisterSpendNtfn(t *testing.T) {
+	t.Parallel()
+
+	canceled := make(chan struct{})
+	notifier := &mockNotifier{
+		spendEvent: chainntnfs.NewSpendEvent(func() {
+			close(canceled)
+		}),
+	}
+	server := New(&Config{ChainNotifier: notifier})
+
+	stream := newMockStream(context.Background())
+	outpoint := wire.OutPoint{Hash: chainhash.Hash{0x01}, Index: 2}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.RegisterSpendNtfn(&SpendRequest{
+			Outpoint: &Outpoint{
+				Hash:  outpoint.Hash[:],
+				Index: outpoint.Index,
+			},
+			Script: []byte{0x00, 0x14},
+		}, spendStream{stream})
+	}()
+
+	spendingTx := wire.NewMsgTx(2)
+	spendingTx.AddTxIn(&wire.TxIn{PreviousOutPoint: outpoint})
+	spendingTxHash := spendingTx.TxHash()
+	notifier.spendEvent.Spend <- &chainntnfs.SpendDetail{
+		SpentOutPoint:     &outpoint,
+		SpenderTxHash:     &spendingTxHash,
+		SpendingTx:        spendingTx,
+		SpenderInputIndex: 0,
+		SpendingHeight:    100,
+	}
+
+	event := receiveEvent(t, stream).(*SpendEvent)
+	spend := event.GetSpend()
+	if spend == nil {
+		t.Fatalf("expected spend event, got %v", event)
+	}
+	if notifier.outpoint == nil || *notifier.outpoint != outpoint {
+		t.Fatalf("expected spend request for %v, got %v", outpoint,
+			notifier.outpoint)
+	}
+	if !bytes.Equal(spend.SpendingOutpoint.Hash, outpoint.Hash[:]) ||
+		spend.SpendingOutpoint.Index != outpoint.Index {
+
+		t.Fatalf("expected spent outpoint %v, got %v", outpoint,
+			spend.SpendingOutpoint)
+	}
+	if !bytes.Equal(spend.SpendingTxHash, spendingTxHash[:]) ||
+		spend.SpendingHeight != 100 {
+
+		t.Fatalf("unexpected spend details: %v", spend)
+	}
+
+	notifier.spendEvent.Reorg <- struct{}{}
+	event = receiveEvent(t, stream).(*SpendEvent)
+	if event.GetReorg() == nil {
+		t.Fatalf("expected reorg event, got %v", event)
+	}
+
+	// Finally, stopping the server should cancel the registration.
+	if err := server.Stop(); err != nil {
+		t.Fatalf("unable to stop server: %v", err)
+	}
+	select {
+	case err := <-errChan:
+		if err != ErrChainNotifierServerShuttingDown {
+			t.Fatalf("expected shutdown error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected stream to exit")
+	}
+	select {
+	case <-canceled:
+	default:
+		t.Fatal("expected spend registration to be canceled")
+	}
+}
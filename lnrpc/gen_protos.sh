@@ -7,6 +7,11 @@ protoc -I/usr/local/include -I. \
        --go_out=plugins=grpc:. \
        rpc.proto
 
+# Generate the protos of the ChainNotifier service.
+protoc -I/usr/local/include -I. \
+       --go_out=plugins=grpc:. \
+       chainrpc/chainnotifier.proto
+
 
 
 # Generate the REST reverse proxy.
@@ -21,8 +21,12 @@ It has these top-level messages:
 	TransactionDetails
 	FeeLimit
 	SendRequest
+	RouteWeights
 	SendResponse
 	SendToRouteRequest
+	ProbePaymentRequest
+	ProbeAttempt
+	ProbePaymentResponse
 	ChannelPoint
 	LightningAddress
 	SendManyRequest
@@ -31,6 +35,10 @@ It has these top-level messages:
 	SendCoinsResponse
 	NewAddressRequest
 	NewAddressResponse
+	SetSweepDestinationRequest
+	SetSweepDestinationResponse
+	GetSweepDestinationRequest
+	GetSweepDestinationResponse
 	SignMessageRequest
 	SignMessageResponse
 	VerifyMessageRequest
@@ -44,6 +52,7 @@ It has these top-level messages:
 	ListChannelsRequest
 	ListChannelsResponse
 	ChannelCloseSummary
+	Resolution
 	ClosedChannelsRequest
 	ClosedChannelsResponse
 	Peer
@@ -56,10 +65,21 @@ It has these top-level messages:
 	ChannelCloseUpdate
 	CloseChannelRequest
 	CloseStatusUpdate
+	ClosingFeeUpdate
 	PendingUpdate
+	BatchOpenChannel
+	BatchOpenChannelRequest
+	BatchOpenChannelResponse
+	ChannelAcceptRequest
+	ChannelAcceptResponse
 	OpenChannelRequest
 	OpenStatusUpdate
+	ReadyForPsbtFunding
+	PsbtFinalize
+	FundingTransitionMsg
+	FundingStateStepResp
 	PendingHTLC
+	ResolverReport
 	PendingChannelsRequest
 	PendingChannelsResponse
 	WalletBalanceRequest
@@ -103,6 +123,8 @@ It has these top-level messages:
 	DeleteAllPaymentsResponse
 	AbandonChannelRequest
 	AbandonChannelResponse
+	SpliceChannelRequest
+	SpliceChannelResponse
 	DebugLevelRequest
 	DebugLevelResponse
 	PayReqString
@@ -139,6 +161,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type SendRequest_RouteStrategy int32
+
+const (
+	// / Weigh the fees of a route along with a small time lock penalty.
+	SendRequest_DEFAULT SendRequest_RouteStrategy = 0
+	// / Select the route with the lowest total fee.
+	SendRequest_CHEAPEST SendRequest_RouteStrategy = 1
+	// / Select the route with the lowest total time lock.
+	SendRequest_FASTEST SendRequest_RouteStrategy = 2
+	// / Select the route most likely to succeed given past payment attempts.
+	SendRequest_MOST_RELIABLE SendRequest_RouteStrategy = 3
+	// / Blend the above strategies using the factors of route_weights.
+	SendRequest_WEIGHTED SendRequest_RouteStrategy = 4
+)
+
+var SendRequest_RouteStrategy_name = map[int32]string{
+	0: "DEFAULT",
+	1: "CHEAPEST",
+	2: "FASTEST",
+	3: "MOST_RELIABLE",
+	4: "WEIGHTED",
+}
+var SendRequest_RouteStrategy_value = map[string]int32{
+	"DEFAULT":       0,
+	"CHEAPEST":      1,
+	"FASTEST":       2,
+	"MOST_RELIABLE": 3,
+	"WEIGHTED":      4,
+}
+
+func (x SendRequest_RouteStrategy) String() string {
+	return proto.EnumName(SendRequest_RouteStrategy_name, int32(x))
+}
+func (SendRequest_RouteStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{12, 0}
+}
+
 type NewAddressRequest_AddressType int32
 
 const (
@@ -159,7 +218,7 @@ func (x NewAddressRequest_AddressType) String() string {
 	return proto.EnumName(NewAddressRequest_AddressType_name, int32(x))
 }
 func (NewAddressRequest_AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{21, 0}
+	return fileDescriptor0, []int{25, 0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -194,7 +253,142 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{35, 0}
+	return fileDescriptor0, []int{43, 0}
+}
+
+type Resolution_ResolutionType int32
+
+const (
+	// / Our output on the commitment transaction
+	Resolution_COMMIT Resolution_ResolutionType = 0
+	// / An htlc offered to us
+	Resolution_INCOMING_HTLC Resolution_ResolutionType = 1
+	// / An htlc offered by us
+	Resolution_OUTGOING_HTLC Resolution_ResolutionType = 2
+	// / An output of a revoked commitment broadcast by the remote party
+	Resolution_REVOKED Resolution_ResolutionType = 3
+)
+
+var Resolution_ResolutionType_name = map[int32]string{
+	0: "COMMIT",
+	1: "INCOMING_HTLC",
+	2: "OUTGOING_HTLC",
+	3: "REVOKED",
+}
+var Resolution_ResolutionType_value = map[string]int32{
+	"COMMIT":        0,
+	"INCOMING_HTLC": 1,
+	"OUTGOING_HTLC": 2,
+	"REVOKED":       3,
+}
+
+func (x Resolution_ResolutionType) String() string {
+	return proto.EnumName(Resolution_ResolutionType_name, int32(x))
+}
+func (Resolution_ResolutionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{44, 0}
+}
+
+type Resolution_ResolutionOutcome int32
+
+const (
+	// / We swept the output into our wallet
+	Resolution_CLAIMED Resolution_ResolutionOutcome = 0
+	// / Our outgoing htlc timed out, and we swept it back
+	Resolution_TIMEOUT Resolution_ResolutionOutcome = 1
+	// / The remote party swept our outgoing htlc with its preimage
+	Resolution_LOST Resolution_ResolutionOutcome = 2
+	// / The incoming htlc timed out before we learned its preimage
+	Resolution_ABANDONED Resolution_ResolutionOutcome = 3
+)
+
+var Resolution_ResolutionOutcome_name = map[int32]string{
+	0: "CLAIMED",
+	1: "TIMEOUT",
+	2: "LOST",
+	3: "ABANDONED",
+}
+var Resolution_ResolutionOutcome_value = map[string]int32{
+	"CLAIMED":   0,
+	"TIMEOUT":   1,
+	"LOST":      2,
+	"ABANDONED": 3,
+}
+
+func (x Resolution_ResolutionOutcome) String() string {
+	return proto.EnumName(Resolution_ResolutionOutcome_name, int32(x))
+}
+func (Resolution_ResolutionOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{44, 1}
+}
+
+type ResolverReport_ResolverType int32
+
+const (
+	ResolverReport_HTLC_TIMEOUT          ResolverReport_ResolverType = 0
+	ResolverReport_HTLC_SUCCESS          ResolverReport_ResolverType = 1
+	ResolverReport_HTLC_OUTGOING_CONTEST ResolverReport_ResolverType = 2
+	ResolverReport_HTLC_INCOMING_CONTEST ResolverReport_ResolverType = 3
+	ResolverReport_COMMIT_SWEEP          ResolverReport_ResolverType = 4
+)
+
+var ResolverReport_ResolverType_name = map[int32]string{
+	0: "HTLC_TIMEOUT",
+	1: "HTLC_SUCCESS",
+	2: "HTLC_OUTGOING_CONTEST",
+	3: "HTLC_INCOMING_CONTEST",
+	4: "COMMIT_SWEEP",
+}
+var ResolverReport_ResolverType_value = map[string]int32{
+	"HTLC_TIMEOUT":          0,
+	"HTLC_SUCCESS":          1,
+	"HTLC_OUTGOING_CONTEST": 2,
+	"HTLC_INCOMING_CONTEST": 3,
+	"COMMIT_SWEEP":          4,
+}
+
+func (x ResolverReport_ResolverType) String() string {
+	return proto.EnumName(ResolverReport_ResolverType_name, int32(x))
+}
+func (ResolverReport_ResolverType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{71, 0}
+}
+
+type ResolverReport_ResolverStage int32
+
+const (
+	// / Waiting for the htlc to expire, or to learn its preimage
+	ResolverReport_WAITING_EXPIRY ResolverReport_ResolverStage = 0
+	// / Waiting for the commitment transaction to confirm
+	ResolverReport_WAITING_COMMIT_CONF ResolverReport_ResolverStage = 1
+	// / The second-level htlc transaction has been published
+	ResolverReport_FIRST_LEVEL_PUBLISHED ResolverReport_ResolverStage = 2
+	// / The output to be swept is waiting for its CSV delay to expire
+	ResolverReport_SECOND_LEVEL_CSV ResolverReport_ResolverStage = 3
+	// / The output has been swept
+	ResolverReport_SWEPT ResolverReport_ResolverStage = 4
+)
+
+var ResolverReport_ResolverStage_name = map[int32]string{
+	0: "WAITING_EXPIRY",
+	1: "WAITING_COMMIT_CONF",
+	2: "FIRST_LEVEL_PUBLISHED",
+	3: "SECOND_LEVEL_CSV",
+	4: "SWEPT",
+}
+var ResolverReport_ResolverStage_value = map[string]int32{
+	"WAITING_EXPIRY":        0,
+	"WAITING_COMMIT_CONF":   1,
+	"FIRST_LEVEL_PUBLISHED": 2,
+	"SECOND_LEVEL_CSV":      3,
+	"SWEPT":                 4,
+}
+
+func (x ResolverReport_ResolverStage) String() string {
+	return proto.EnumName(ResolverReport_ResolverStage_name, int32(x))
+}
+func (ResolverReport_ResolverStage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{71, 1}
 }
 
 type GenSeedRequest struct {
@@ -645,6 +839,27 @@ type SendRequest struct {
 	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
 	// send the payment.
 	FeeLimit *FeeLimit `protobuf:"bytes,8,opt,name=fee_limit,json=feeLimit" json:"fee_limit,omitempty"`
+	// *
+	// The strategy used to weigh channels when finding a route for the payment.
+	// If unspecified, the default strategy of the node is used.
+	RouteStrategy SendRequest_RouteStrategy `protobuf:"varint,9,opt,name=route_strategy,json=routeStrategy,enum=lnrpc.SendRequest_RouteStrategy" json:"route_strategy,omitempty"`
+	// *
+	// The factors used to blend the cheapest, fastest and most reliable
+	// strategies. Only used if route_strategy is WEIGHTED.
+	RouteWeights *RouteWeights `protobuf:"bytes,10,opt,name=route_weights,json=routeWeights" json:"route_weights,omitempty"`
+	// *
+	// The maximum effective fee rate, in parts per million of the amount
+	// forwarded, that any single hop of the route may charge, including its base
+	// fee. If zero, no per-hop fee rate limit is enforced.
+	MaxHopFeeRatePpm uint32 `protobuf:"varint,11,opt,name=max_hop_fee_rate_ppm,json=maxHopFeeRatePpm" json:"max_hop_fee_rate_ppm,omitempty"`
+	// *
+	// The maximum fee in milli-satoshis that any single hop of the route may
+	// charge. If zero, no per-hop fee limit is enforced.
+	MaxHopFeeMsat int64 `protobuf:"varint,12,opt,name=max_hop_fee_msat,json=maxHopFeeMsat" json:"max_hop_fee_msat,omitempty"`
+	// *
+	// The maximum number of blocks the funds of the payment may be locked up
+	// for, including the final CLTV delta. If zero, no CLTV limit is enforced.
+	CltvLimit uint32 `protobuf:"varint,13,opt,name=cltv_limit,json=cltvLimit" json:"cltv_limit,omitempty"`
 }
 
 func (m *SendRequest) Reset()                    { *m = SendRequest{} }
@@ -708,6 +923,78 @@ func (m *SendRequest) GetFeeLimit() *FeeLimit {
 	return nil
 }
 
+func (m *SendRequest) GetRouteStrategy() SendRequest_RouteStrategy {
+	if m != nil {
+		return m.RouteStrategy
+	}
+	return SendRequest_DEFAULT
+}
+
+func (m *SendRequest) GetRouteWeights() *RouteWeights {
+	if m != nil {
+		return m.RouteWeights
+	}
+	return nil
+}
+
+func (m *SendRequest) GetMaxHopFeeRatePpm() uint32 {
+	if m != nil {
+		return m.MaxHopFeeRatePpm
+	}
+	return 0
+}
+
+func (m *SendRequest) GetMaxHopFeeMsat() int64 {
+	if m != nil {
+		return m.MaxHopFeeMsat
+	}
+	return 0
+}
+
+func (m *SendRequest) GetCltvLimit() uint32 {
+	if m != nil {
+		return m.CltvLimit
+	}
+	return 0
+}
+
+type RouteWeights struct {
+	// / The weight of each milli-satoshi of fees paid along the route.
+	FeeWeight float64 `protobuf:"fixed64,1,opt,name=fee_weight,json=feeWeight" json:"fee_weight,omitempty"`
+	// / The weight of each block of time lock added along the route.
+	TimeLockWeight float64 `protobuf:"fixed64,2,opt,name=time_lock_weight,json=timeLockWeight" json:"time_lock_weight,omitempty"`
+	// *
+	// The weight of each millionth of the negative log-probability that the
+	// route will succeed, as estimated from past payment attempts.
+	ReliabilityWeight float64 `protobuf:"fixed64,3,opt,name=reliability_weight,json=reliabilityWeight" json:"reliability_weight,omitempty"`
+}
+
+func (m *RouteWeights) Reset()                    { *m = RouteWeights{} }
+func (m *RouteWeights) String() string            { return proto.CompactTextString(m) }
+func (*RouteWeights) ProtoMessage()               {}
+func (*RouteWeights) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *RouteWeights) GetFeeWeight() float64 {
+	if m != nil {
+		return m.FeeWeight
+	}
+	return 0
+}
+
+func (m *RouteWeights) GetTimeLockWeight() float64 {
+	if m != nil {
+		return m.TimeLockWeight
+	}
+	return 0
+}
+
+func (m *RouteWeights) GetReliabilityWeight() float64 {
+	if m != nil {
+		return m.ReliabilityWeight
+	}
+	return 0
+}
+
 type SendResponse struct {
 	PaymentError    string `protobuf:"bytes,1,opt,name=payment_error" json:"payment_error,omitempty"`
 	PaymentPreimage []byte `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
@@ -717,7 +1004,7 @@ type SendResponse struct {
 func (m *SendResponse) Reset()                    { *m = SendResponse{} }
 func (m *SendResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()               {}
-func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *SendResponse) GetPaymentError() string {
 	if m != nil {
@@ -752,7 +1039,7 @@ type SendToRouteRequest struct {
 func (m *SendToRouteRequest) Reset()                    { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()               {}
-func (*SendToRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*SendToRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *SendToRouteRequest) GetPaymentHash() []byte {
 	if m != nil {
@@ -775,6 +1062,173 @@ func (m *SendToRouteRequest) GetRoutes() []*Route {
 	return nil
 }
 
+type ProbePaymentRequest struct {
+	// / The identity pubkey of the node to probe
+	Dest []byte `protobuf:"bytes,1,opt,name=dest,proto3" json:"dest,omitempty"`
+	// / The hex-encoded identity pubkey of the node to probe
+	DestString string `protobuf:"bytes,2,opt,name=dest_string,json=destString" json:"dest_string,omitempty"`
+	// / Number of satoshis to probe for.
+	Amt int64 `protobuf:"varint,3,opt,name=amt" json:"amt,omitempty"`
+	// *
+	// An optional payment request. If set, the destination, amount, final CLTV
+	// delta and routing hints will be taken from the payment request. The payment
+	// hash of the payment request is not used.
+	PaymentRequest string `protobuf:"bytes,4,opt,name=payment_request,json=paymentRequest" json:"payment_request,omitempty"`
+	// *
+	// The CLTV delta from the current height that should be used to set the
+	// timelock for the final hop.
+	FinalCltvDelta int32 `protobuf:"varint,5,opt,name=final_cltv_delta,json=finalCltvDelta" json:"final_cltv_delta,omitempty"`
+	// *
+	// The maximum number of satoshis that the probed route may charge as a fee.
+	// This value can be represented either as a percentage of the amount being
+	// probed, or as a fixed amount.
+	FeeLimit *FeeLimit `protobuf:"bytes,6,opt,name=fee_limit,json=feeLimit" json:"fee_limit,omitempty"`
+}
+
+func (m *ProbePaymentRequest) Reset()                    { *m = ProbePaymentRequest{} }
+func (m *ProbePaymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*ProbePaymentRequest) ProtoMessage()               {}
+func (*ProbePaymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *ProbePaymentRequest) GetDest() []byte {
+	if m != nil {
+		return m.Dest
+	}
+	return nil
+}
+
+func (m *ProbePaymentRequest) GetDestString() string {
+	if m != nil {
+		return m.DestString
+	}
+	return ""
+}
+
+func (m *ProbePaymentRequest) GetAmt() int64 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
+}
+
+func (m *ProbePaymentRequest) GetFinalCltvDelta() int32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetFeeLimit() *FeeLimit {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
+}
+
+type ProbeAttempt struct {
+	// / The route that the probe HTLC was sent over.
+	Route *Route `protobuf:"bytes,1,opt,name=route" json:"route,omitempty"`
+	// / The hex-encoded pubkey of the node that failed this attempt, if known.
+	FailureSourcePubkey string `protobuf:"bytes,2,opt,name=failure_source_pubkey" json:"failure_source_pubkey,omitempty"`
+	// / The onion failure that was returned for this attempt, if any.
+	Failure string `protobuf:"bytes,3,opt,name=failure" json:"failure,omitempty"`
+	// / The time in milliseconds it took for the result of this attempt to be known.
+	LatencyMs int64 `protobuf:"varint,4,opt,name=latency_ms" json:"latency_ms,omitempty"`
+}
+
+func (m *ProbeAttempt) Reset()                    { *m = ProbeAttempt{} }
+func (m *ProbeAttempt) String() string            { return proto.CompactTextString(m) }
+func (*ProbeAttempt) ProtoMessage()               {}
+func (*ProbeAttempt) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *ProbeAttempt) GetRoute() *Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *ProbeAttempt) GetFailureSourcePubkey() string {
+	if m != nil {
+		return m.FailureSourcePubkey
+	}
+	return ""
+}
+
+func (m *ProbeAttempt) GetFailure() string {
+	if m != nil {
+		return m.Failure
+	}
+	return ""
+}
+
+func (m *ProbeAttempt) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+type ProbePaymentResponse struct {
+	// / Whether the destination was reached with the probed amount.
+	Success bool `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
+	// / The route over which the destination was reached, if successful.
+	Route *Route `protobuf:"bytes,2,opt,name=route" json:"route,omitempty"`
+	// / All attempts made while probing, in the order they were sent.
+	Attempts []*ProbeAttempt `protobuf:"bytes,3,rep,name=attempts" json:"attempts,omitempty"`
+	// / The total time in milliseconds the probe took.
+	LatencyMs int64 `protobuf:"varint,4,opt,name=latency_ms" json:"latency_ms,omitempty"`
+	// / The reason the probe was not successful, if any.
+	ProbeError string `protobuf:"bytes,5,opt,name=probe_error" json:"probe_error,omitempty"`
+}
+
+func (m *ProbePaymentResponse) Reset()                    { *m = ProbePaymentResponse{} }
+func (m *ProbePaymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*ProbePaymentResponse) ProtoMessage()               {}
+func (*ProbePaymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *ProbePaymentResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ProbePaymentResponse) GetRoute() *Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *ProbePaymentResponse) GetAttempts() []*ProbeAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
+}
+
+func (m *ProbePaymentResponse) GetLatencyMs() int64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+func (m *ProbePaymentResponse) GetProbeError() string {
+	if m != nil {
+		return m.ProbeError
+	}
+	return ""
+}
+
 type ChannelPoint struct {
 	// Types that are valid to be assigned to FundingTxid:
 	//	*ChannelPoint_FundingTxidBytes
@@ -787,7 +1241,7 @@ type ChannelPoint struct {
 func (m *ChannelPoint) Reset()                    { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string            { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()               {}
-func (*ChannelPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*ChannelPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 type isChannelPoint_FundingTxid interface{ isChannelPoint_FundingTxid() }
 
@@ -905,7 +1359,7 @@ type LightningAddress struct {
 func (m *LightningAddress) Reset()                    { *m = LightningAddress{} }
 func (m *LightningAddress) String() string            { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()               {}
-func (*LightningAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*LightningAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
 
 func (m *LightningAddress) GetPubkey() string {
 	if m != nil {
@@ -933,7 +1387,7 @@ type SendManyRequest struct {
 func (m *SendManyRequest) Reset()                    { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()               {}
-func (*SendManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*SendManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *SendManyRequest) GetAddrToAmount() map[string]int64 {
 	if m != nil {
@@ -964,7 +1418,7 @@ type SendManyResponse struct {
 func (m *SendManyResponse) Reset()                    { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()               {}
-func (*SendManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*SendManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *SendManyResponse) GetTxid() string {
 	if m != nil {
@@ -987,7 +1441,7 @@ type SendCoinsRequest struct {
 func (m *SendCoinsRequest) Reset()                    { *m = SendCoinsRequest{} }
 func (m *SendCoinsRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendCoinsRequest) ProtoMessage()               {}
-func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *SendCoinsRequest) GetAddr() string {
 	if m != nil {
@@ -1025,7 +1479,7 @@ type SendCoinsResponse struct {
 func (m *SendCoinsResponse) Reset()                    { *m = SendCoinsResponse{} }
 func (m *SendCoinsResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendCoinsResponse) ProtoMessage()               {}
-func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
 func (m *SendCoinsResponse) GetTxid() string {
 	if m != nil {
@@ -1047,7 +1501,7 @@ type NewAddressRequest struct {
 func (m *NewAddressRequest) Reset()                    { *m = NewAddressRequest{} }
 func (m *NewAddressRequest) String() string            { return proto.CompactTextString(m) }
 func (*NewAddressRequest) ProtoMessage()               {}
-func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
 
 func (m *NewAddressRequest) GetType() NewAddressRequest_AddressType {
 	if m != nil {
@@ -1064,7 +1518,7 @@ type NewAddressResponse struct {
 func (m *NewAddressResponse) Reset()                    { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()               {}
-func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
 func (m *NewAddressResponse) GetAddress() string {
 	if m != nil {
@@ -1073,6 +1527,85 @@ func (m *NewAddressResponse) GetAddress() string {
 	return ""
 }
 
+type SetSweepDestinationRequest struct {
+	// / The address that all swept funds are sent to
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// *
+	// The extended public key that a fresh address is derived from for each
+	// sweep. Only addresses of its external branch (m/0/i) are used.
+	Xpub string `protobuf:"bytes,2,opt,name=xpub" json:"xpub,omitempty"`
+}
+
+func (m *SetSweepDestinationRequest) Reset()                    { *m = SetSweepDestinationRequest{} }
+func (m *SetSweepDestinationRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetSweepDestinationRequest) ProtoMessage()               {}
+func (*SetSweepDestinationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *SetSweepDestinationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SetSweepDestinationRequest) GetXpub() string {
+	if m != nil {
+		return m.Xpub
+	}
+	return ""
+}
+
+type SetSweepDestinationResponse struct {
+}
+
+func (m *SetSweepDestinationResponse) Reset()                    { *m = SetSweepDestinationResponse{} }
+func (m *SetSweepDestinationResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetSweepDestinationResponse) ProtoMessage()               {}
+func (*SetSweepDestinationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+type GetSweepDestinationRequest struct {
+}
+
+func (m *GetSweepDestinationRequest) Reset()                    { *m = GetSweepDestinationRequest{} }
+func (m *GetSweepDestinationRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSweepDestinationRequest) ProtoMessage()               {}
+func (*GetSweepDestinationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+type GetSweepDestinationResponse struct {
+	// / The address that all swept funds are sent to, if set
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// / The extended public key that addresses are derived from, if set
+	Xpub string `protobuf:"bytes,2,opt,name=xpub" json:"xpub,omitempty"`
+	// / The index of the child key that the next address is derived from
+	NextIndex uint32 `protobuf:"varint,3,opt,name=next_index" json:"next_index,omitempty"`
+}
+
+func (m *GetSweepDestinationResponse) Reset()                    { *m = GetSweepDestinationResponse{} }
+func (m *GetSweepDestinationResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetSweepDestinationResponse) ProtoMessage()               {}
+func (*GetSweepDestinationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *GetSweepDestinationResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetSweepDestinationResponse) GetXpub() string {
+	if m != nil {
+		return m.Xpub
+	}
+	return ""
+}
+
+func (m *GetSweepDestinationResponse) GetNextIndex() uint32 {
+	if m != nil {
+		return m.NextIndex
+	}
+	return 0
+}
+
 type SignMessageRequest struct {
 	// / The message to be signed
 	Msg []byte `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -1081,7 +1614,7 @@ type SignMessageRequest struct {
 func (m *SignMessageRequest) Reset()                    { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()               {}
-func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
 
 func (m *SignMessageRequest) GetMsg() []byte {
 	if m != nil {
@@ -1098,7 +1631,7 @@ type SignMessageResponse struct {
 func (m *SignMessageResponse) Reset()                    { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()               {}
-func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
 
 func (m *SignMessageResponse) GetSignature() string {
 	if m != nil {
@@ -1117,7 +1650,7 @@ type VerifyMessageRequest struct {
 func (m *VerifyMessageRequest) Reset()                    { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()               {}
-func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
 
 func (m *VerifyMessageRequest) GetMsg() []byte {
 	if m != nil {
@@ -1143,7 +1676,7 @@ type VerifyMessageResponse struct {
 func (m *VerifyMessageResponse) Reset()                    { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()               {}
-func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
 
 func (m *VerifyMessageResponse) GetValid() bool {
 	if m != nil {
@@ -1170,7 +1703,7 @@ type ConnectPeerRequest struct {
 func (m *ConnectPeerRequest) Reset()                    { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string            { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()               {}
-func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
 
 func (m *ConnectPeerRequest) GetAddr() *LightningAddress {
 	if m != nil {
@@ -1192,7 +1725,7 @@ type ConnectPeerResponse struct {
 func (m *ConnectPeerResponse) Reset()                    { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string            { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()               {}
-func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
 
 type DisconnectPeerRequest struct {
 	// / The pubkey of the node to disconnect from
@@ -1202,7 +1735,7 @@ type DisconnectPeerRequest struct {
 func (m *DisconnectPeerRequest) Reset()                    { *m = DisconnectPeerRequest{} }
 func (m *DisconnectPeerRequest) String() string            { return proto.CompactTextString(m) }
 func (*DisconnectPeerRequest) ProtoMessage()               {}
-func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 func (m *DisconnectPeerRequest) GetPubKey() string {
 	if m != nil {
@@ -1217,7 +1750,7 @@ type DisconnectPeerResponse struct {
 func (m *DisconnectPeerResponse) Reset()                    { *m = DisconnectPeerResponse{} }
 func (m *DisconnectPeerResponse) String() string            { return proto.CompactTextString(m) }
 func (*DisconnectPeerResponse) ProtoMessage()               {}
-func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 type HTLC struct {
 	Incoming         bool   `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
@@ -1229,7 +1762,7 @@ type HTLC struct {
 func (m *HTLC) Reset()                    { *m = HTLC{} }
 func (m *HTLC) String() string            { return proto.CompactTextString(m) }
 func (*HTLC) ProtoMessage()               {}
-func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *HTLC) GetIncoming() bool {
 	if m != nil {
@@ -1319,7 +1852,7 @@ type Channel struct {
 func (m *Channel) Reset()                    { *m = Channel{} }
 func (m *Channel) String() string            { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()               {}
-func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *Channel) GetActive() bool {
 	if m != nil {
@@ -1450,7 +1983,7 @@ type ListChannelsRequest struct {
 func (m *ListChannelsRequest) Reset()                    { *m = ListChannelsRequest{} }
 func (m *ListChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListChannelsRequest) ProtoMessage()               {}
-func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *ListChannelsRequest) GetActiveOnly() bool {
 	if m != nil {
@@ -1488,7 +2021,7 @@ type ListChannelsResponse struct {
 func (m *ListChannelsResponse) Reset()                    { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()               {}
-func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *ListChannelsResponse) GetChannels() []*Channel {
 	if m != nil {
@@ -1518,12 +2051,14 @@ type ChannelCloseSummary struct {
 	TimeLockedBalance int64 `protobuf:"varint,9,opt,name=time_locked_balance" json:"time_locked_balance,omitempty"`
 	// / Details on how the channel was closed.
 	CloseType ChannelCloseSummary_ClosureType `protobuf:"varint,10,opt,name=close_type,enum=lnrpc.ChannelCloseSummary_ClosureType" json:"close_type,omitempty"`
+	// / The final resolution of each output of the closing transaction.
+	Resolutions []*Resolution `protobuf:"bytes,11,rep,name=resolutions" json:"resolutions,omitempty"`
 }
 
 func (m *ChannelCloseSummary) Reset()                    { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string            { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()               {}
-func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *ChannelCloseSummary) GetChannelPoint() string {
 	if m != nil {
@@ -1595,21 +2130,108 @@ func (m *ChannelCloseSummary) GetCloseType() ChannelCloseSummary_ClosureType {
 	return ChannelCloseSummary_COOPERATIVE_CLOSE
 }
 
-type ClosedChannelsRequest struct {
-	Cooperative     bool `protobuf:"varint,1,opt,name=cooperative" json:"cooperative,omitempty"`
-	LocalForce      bool `protobuf:"varint,2,opt,name=local_force,json=localForce" json:"local_force,omitempty"`
-	RemoteForce     bool `protobuf:"varint,3,opt,name=remote_force,json=remoteForce" json:"remote_force,omitempty"`
-	Breach          bool `protobuf:"varint,4,opt,name=breach" json:"breach,omitempty"`
-	FundingCanceled bool `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled" json:"funding_canceled,omitempty"`
-	Abandoned       bool `protobuf:"varint,6,opt,name=abandoned" json:"abandoned,omitempty"`
+func (m *ChannelCloseSummary) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
 }
 
-func (m *ClosedChannelsRequest) Reset()                    { *m = ClosedChannelsRequest{} }
-func (m *ClosedChannelsRequest) String() string            { return proto.CompactTextString(m) }
-func (*ClosedChannelsRequest) ProtoMessage()               {}
-func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+type Resolution struct {
+	// / The type of output that was resolved
+	ResolutionType Resolution_ResolutionType `protobuf:"varint,1,opt,name=resolution_type,enum=lnrpc.Resolution_ResolutionType" json:"resolution_type,omitempty"`
+	// / How the output was resolved
+	Outcome Resolution_ResolutionOutcome `protobuf:"varint,2,opt,name=outcome,enum=lnrpc.Resolution_ResolutionOutcome" json:"outcome,omitempty"`
+	// / The output on the closing transaction that was resolved
+	Outpoint string `protobuf:"bytes,3,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The value of the output that was swept
+	Amount int64 `protobuf:"varint,4,opt,name=amount" json:"amount,omitempty"`
+	// / The txid of the second-level htlc transaction, if any
+	SecondLevelTxid string `protobuf:"bytes,5,opt,name=second_level_txid" json:"second_level_txid,omitempty"`
+	// / The txid of the transaction that swept the output, if it was swept
+	SweepTxid string `protobuf:"bytes,6,opt,name=sweep_txid" json:"sweep_txid,omitempty"`
+	// / The on-chain fee we paid to sweep the output, if known
+	SweepFee int64 `protobuf:"varint,7,opt,name=sweep_fee" json:"sweep_fee,omitempty"`
+	// / The address that the output was swept to, if we swept it
+	SweepAddress string `protobuf:"bytes,8,opt,name=sweep_address" json:"sweep_address,omitempty"`
+}
 
-func (m *ClosedChannelsRequest) GetCooperative() bool {
+func (m *Resolution) Reset()                    { *m = Resolution{} }
+func (m *Resolution) String() string            { return proto.CompactTextString(m) }
+func (*Resolution) ProtoMessage()               {}
+func (*Resolution) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *Resolution) GetResolutionType() Resolution_ResolutionType {
+	if m != nil {
+		return m.ResolutionType
+	}
+	return Resolution_COMMIT
+}
+
+func (m *Resolution) GetOutcome() Resolution_ResolutionOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return Resolution_CLAIMED
+}
+
+func (m *Resolution) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *Resolution) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Resolution) GetSecondLevelTxid() string {
+	if m != nil {
+		return m.SecondLevelTxid
+	}
+	return ""
+}
+
+func (m *Resolution) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
+func (m *Resolution) GetSweepFee() int64 {
+	if m != nil {
+		return m.SweepFee
+	}
+	return 0
+}
+
+func (m *Resolution) GetSweepAddress() string {
+	if m != nil {
+		return m.SweepAddress
+	}
+	return ""
+}
+
+type ClosedChannelsRequest struct {
+	Cooperative     bool `protobuf:"varint,1,opt,name=cooperative" json:"cooperative,omitempty"`
+	LocalForce      bool `protobuf:"varint,2,opt,name=local_force,json=localForce" json:"local_force,omitempty"`
+	RemoteForce     bool `protobuf:"varint,3,opt,name=remote_force,json=remoteForce" json:"remote_force,omitempty"`
+	Breach          bool `protobuf:"varint,4,opt,name=breach" json:"breach,omitempty"`
+	FundingCanceled bool `protobuf:"varint,5,opt,name=funding_canceled,json=fundingCanceled" json:"funding_canceled,omitempty"`
+	Abandoned       bool `protobuf:"varint,6,opt,name=abandoned" json:"abandoned,omitempty"`
+}
+
+func (m *ClosedChannelsRequest) Reset()                    { *m = ClosedChannelsRequest{} }
+func (m *ClosedChannelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ClosedChannelsRequest) ProtoMessage()               {}
+func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *ClosedChannelsRequest) GetCooperative() bool {
 	if m != nil {
 		return m.Cooperative
 	}
@@ -1658,7 +2280,7 @@ type ClosedChannelsResponse struct {
 func (m *ClosedChannelsResponse) Reset()                    { *m = ClosedChannelsResponse{} }
 func (m *ClosedChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()               {}
-func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	if m != nil {
@@ -1689,7 +2311,7 @@ type Peer struct {
 func (m *Peer) Reset()                    { *m = Peer{} }
 func (m *Peer) String() string            { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()               {}
-func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *Peer) GetPubKey() string {
 	if m != nil {
@@ -1753,7 +2375,7 @@ type ListPeersRequest struct {
 func (m *ListPeersRequest) Reset()                    { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()               {}
-func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 type ListPeersResponse struct {
 	// / The list of currently connected peers
@@ -1763,7 +2385,7 @@ type ListPeersResponse struct {
 func (m *ListPeersResponse) Reset()                    { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()               {}
-func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *ListPeersResponse) GetPeers() []*Peer {
 	if m != nil {
@@ -1778,7 +2400,7 @@ type GetInfoRequest struct {
 func (m *GetInfoRequest) Reset()                    { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()               {}
-func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 type GetInfoResponse struct {
 	// / The identity pubkey of the current node.
@@ -1807,12 +2429,21 @@ type GetInfoResponse struct {
 	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp" json:"best_header_timestamp,omitempty"`
 	// / The version of the LND software that the node is running.
 	Version string `protobuf:"bytes,14,opt,name=version" json:"version,omitempty"`
+	// *
+	// The number of times we didn't force close a channel to claim an incoming
+	// htlc close to expiry, as its value didn't justify the on-chain fees.
+	NumSkippedIncomingGoOnChain uint64 `protobuf:"varint,15,opt,name=num_skipped_incoming_go_on_chain" json:"num_skipped_incoming_go_on_chain,omitempty"`
+	// *
+	// The number of times we didn't force close a channel to time out an
+	// outgoing htlc close to expiry, as its value didn't justify the on-chain
+	// fees.
+	NumSkippedOutgoingGoOnChain uint64 `protobuf:"varint,16,opt,name=num_skipped_outgoing_go_on_chain" json:"num_skipped_outgoing_go_on_chain,omitempty"`
 }
 
 func (m *GetInfoResponse) Reset()                    { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()               {}
-func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *GetInfoResponse) GetIdentityPubkey() string {
 	if m != nil {
@@ -1905,6 +2536,20 @@ func (m *GetInfoResponse) GetVersion() string {
 	return ""
 }
 
+func (m *GetInfoResponse) GetNumSkippedIncomingGoOnChain() uint64 {
+	if m != nil {
+		return m.NumSkippedIncomingGoOnChain
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetNumSkippedOutgoingGoOnChain() uint64 {
+	if m != nil {
+		return m.NumSkippedOutgoingGoOnChain
+	}
+	return 0
+}
+
 type ConfirmationUpdate struct {
 	BlockSha     []byte `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
 	BlockHeight  int32  `protobuf:"varint,2,opt,name=block_height,json=blockHeight" json:"block_height,omitempty"`
@@ -1914,7 +2559,7 @@ type ConfirmationUpdate struct {
 func (m *ConfirmationUpdate) Reset()                    { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()               {}
-func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *ConfirmationUpdate) GetBlockSha() []byte {
 	if m != nil {
@@ -1944,7 +2589,7 @@ type ChannelOpenUpdate struct {
 func (m *ChannelOpenUpdate) Reset()                    { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()               {}
-func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
 	if m != nil {
@@ -1961,7 +2606,7 @@ type ChannelCloseUpdate struct {
 func (m *ChannelCloseUpdate) Reset()                    { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()               {}
-func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
 	if m != nil {
@@ -1989,12 +2634,22 @@ type CloseChannelRequest struct {
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
 	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
 	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// *
+	// An optional address to send our funds to in a cooperative close. If not
+	// set, a new wallet address is used. If we committed to an upfront shutdown
+	// script when opening the channel, it must match it.
+	DeliveryAddress string `protobuf:"bytes,5,opt,name=delivery_address" json:"delivery_address,omitempty"`
+	// *
+	// An optional maximum fee rate set in sat/byte for the closure transaction.
+	// Fee proposals of the remote node above this rate are rejected during the
+	// negotiation of a cooperative close.
+	MaxSatPerByte int64 `protobuf:"varint,6,opt,name=max_sat_per_byte" json:"max_sat_per_byte,omitempty"`
 }
 
 func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()               {}
-func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
 func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
@@ -2024,18 +2679,33 @@ func (m *CloseChannelRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *CloseChannelRequest) GetDeliveryAddress() string {
+	if m != nil {
+		return m.DeliveryAddress
+	}
+	return ""
+}
+
+func (m *CloseChannelRequest) GetMaxSatPerByte() int64 {
+	if m != nil {
+		return m.MaxSatPerByte
+	}
+	return 0
+}
+
 type CloseStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*CloseStatusUpdate_ClosePending
 	//	*CloseStatusUpdate_Confirmation
 	//	*CloseStatusUpdate_ChanClose
+	//	*CloseStatusUpdate_FeeUpdate
 	Update isCloseStatusUpdate_Update `protobuf_oneof:"update"`
 }
 
 func (m *CloseStatusUpdate) Reset()                    { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string            { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()               {}
-func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
 
 type isCloseStatusUpdate_Update interface{ isCloseStatusUpdate_Update() }
 
@@ -2048,10 +2718,14 @@ type CloseStatusUpdate_Confirmation struct {
 type CloseStatusUpdate_ChanClose struct {
 	ChanClose *ChannelCloseUpdate `protobuf:"bytes,3,opt,name=chan_close,oneof"`
 }
+type CloseStatusUpdate_FeeUpdate struct {
+	FeeUpdate *ClosingFeeUpdate `protobuf:"bytes,4,opt,name=fee_update,oneof"`
+}
 
 func (*CloseStatusUpdate_ClosePending) isCloseStatusUpdate_Update() {}
 func (*CloseStatusUpdate_Confirmation) isCloseStatusUpdate_Update() {}
 func (*CloseStatusUpdate_ChanClose) isCloseStatusUpdate_Update()    {}
+func (*CloseStatusUpdate_FeeUpdate) isCloseStatusUpdate_Update()    {}
 
 func (m *CloseStatusUpdate) GetUpdate() isCloseStatusUpdate_Update {
 	if m != nil {
@@ -2081,12 +2755,20 @@ func (m *CloseStatusUpdate) GetChanClose() *ChannelCloseUpdate {
 	return nil
 }
 
+func (m *CloseStatusUpdate) GetFeeUpdate() *ClosingFeeUpdate {
+	if x, ok := m.GetUpdate().(*CloseStatusUpdate_FeeUpdate); ok {
+		return x.FeeUpdate
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*CloseStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _CloseStatusUpdate_OneofMarshaler, _CloseStatusUpdate_OneofUnmarshaler, _CloseStatusUpdate_OneofSizer, []interface{}{
 		(*CloseStatusUpdate_ClosePending)(nil),
 		(*CloseStatusUpdate_Confirmation)(nil),
 		(*CloseStatusUpdate_ChanClose)(nil),
+		(*CloseStatusUpdate_FeeUpdate)(nil),
 	}
 }
 
@@ -2109,6 +2791,11 @@ func _CloseStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error
 		if err := b.EncodeMessage(x.ChanClose); err != nil {
 			return err
 		}
+	case *CloseStatusUpdate_FeeUpdate:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FeeUpdate); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("CloseStatusUpdate.Update has unexpected type %T", x)
@@ -2143,6 +2830,14 @@ func _CloseStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *pr
 		err := b.DecodeMessage(msg)
 		m.Update = &CloseStatusUpdate_ChanClose{msg}
 		return true, err
+	case 4: // update.fee_update
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClosingFeeUpdate)
+		err := b.DecodeMessage(msg)
+		m.Update = &CloseStatusUpdate_FeeUpdate{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2167,6 +2862,11 @@ func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(3<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CloseStatusUpdate_FeeUpdate:
+		s := proto.Size(x.FeeUpdate)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2174,6 +2874,32 @@ func _CloseStatusUpdate_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type ClosingFeeUpdate struct {
+	// / The total fee in satoshis of the proposed closure transaction.
+	FeeSat int64 `protobuf:"varint,1,opt,name=fee_sat" json:"fee_sat,omitempty"`
+	// / Whether the fee was proposed by us rather than the remote node.
+	Local bool `protobuf:"varint,2,opt,name=local" json:"local,omitempty"`
+}
+
+func (m *ClosingFeeUpdate) Reset()                    { *m = ClosingFeeUpdate{} }
+func (m *ClosingFeeUpdate) String() string            { return proto.CompactTextString(m) }
+func (*ClosingFeeUpdate) ProtoMessage()               {}
+func (*ClosingFeeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+func (m *ClosingFeeUpdate) GetFeeSat() int64 {
+	if m != nil {
+		return m.FeeSat
+	}
+	return 0
+}
+
+func (m *ClosingFeeUpdate) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
 type PendingUpdate struct {
 	Txid        []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index" json:"output_index,omitempty"`
@@ -2182,7 +2908,7 @@ type PendingUpdate struct {
 func (m *PendingUpdate) Reset()                    { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string            { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()               {}
-func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
 
 func (m *PendingUpdate) GetTxid() []byte {
 	if m != nil {
@@ -2198,6 +2924,302 @@ func (m *PendingUpdate) GetOutputIndex() uint32 {
 	return 0
 }
 
+type BatchOpenChannel struct {
+	// / The pubkey of the node to open a channel with
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The number of satoshis the wallet should commit to the channel
+	LocalFundingAmount int64 `protobuf:"varint,2,opt,name=local_funding_amount" json:"local_funding_amount,omitempty"`
+	// / The number of satoshis to push to the remote side as part of the initial commitment state
+	PushSat int64 `protobuf:"varint,3,opt,name=push_sat" json:"push_sat,omitempty"`
+	// / Whether this channel should be private, not announced to the greater network.
+	Private bool `protobuf:"varint,4,opt,name=private" json:"private,omitempty"`
+	// / The minimum value in millisatoshi we will require for incoming HTLCs on the channel.
+	MinHtlcMsat int64 `protobuf:"varint,5,opt,name=min_htlc_msat" json:"min_htlc_msat,omitempty"`
+	// / The delay we require on the remote's commitment transaction. If this is not set, it will be scaled automatically with the channel size.
+	RemoteCsvDelay uint32 `protobuf:"varint,6,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
+}
+
+func (m *BatchOpenChannel) Reset()                    { *m = BatchOpenChannel{} }
+func (m *BatchOpenChannel) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannel) ProtoMessage()               {}
+func (*BatchOpenChannel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *BatchOpenChannel) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *BatchOpenChannel) GetLocalFundingAmount() int64 {
+	if m != nil {
+		return m.LocalFundingAmount
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetPushSat() int64 {
+	if m != nil {
+		return m.PushSat
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *BatchOpenChannel) GetMinHtlcMsat() int64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *BatchOpenChannel) GetRemoteCsvDelay() uint32 {
+	if m != nil {
+		return m.RemoteCsvDelay
+	}
+	return 0
+}
+
+type BatchOpenChannelRequest struct {
+	// / The list of channels to open within the same funding transaction.
+	Channels []*BatchOpenChannel `protobuf:"bytes,1,rep,name=channels" json:"channels,omitempty"`
+	// / The target number of blocks that the funding transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the funding transaction.
+	SatPerByte int64 `protobuf:"varint,3,opt,name=sat_per_byte" json:"sat_per_byte,omitempty"`
+	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,4,opt,name=min_confs" json:"min_confs,omitempty"`
+	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
+	SpendUnconfirmed bool `protobuf:"varint,5,opt,name=spend_unconfirmed" json:"spend_unconfirmed,omitempty"`
+}
+
+func (m *BatchOpenChannelRequest) Reset()                    { *m = BatchOpenChannelRequest{} }
+func (m *BatchOpenChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannelRequest) ProtoMessage()               {}
+func (*BatchOpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *BatchOpenChannelRequest) GetChannels() []*BatchOpenChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *BatchOpenChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *BatchOpenChannelRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
+type BatchOpenChannelResponse struct {
+	// / The funding outpoints of the pending channels, in the order of the request.
+	PendingChannels []*PendingUpdate `protobuf:"bytes,1,rep,name=pending_channels" json:"pending_channels,omitempty"`
+}
+
+func (m *BatchOpenChannelResponse) Reset()                    { *m = BatchOpenChannelResponse{} }
+func (m *BatchOpenChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*BatchOpenChannelResponse) ProtoMessage()               {}
+func (*BatchOpenChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *BatchOpenChannelResponse) GetPendingChannels() []*PendingUpdate {
+	if m != nil {
+		return m.PendingChannels
+	}
+	return nil
+}
+
+type ChannelAcceptRequest struct {
+	// / The pubkey of the node that wishes to open an inbound channel.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hash of the genesis block that the proposed channel resides in.
+	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,proto3" json:"chain_hash,omitempty"`
+	// / The pending channel id.
+	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / The funding amount in satoshis that initiator wishes to use in the channel.
+	FundingAmt uint64 `protobuf:"varint,4,opt,name=funding_amt" json:"funding_amt,omitempty"`
+	// / The push amount of the proposed channel in millisatoshis.
+	PushAmt uint64 `protobuf:"varint,5,opt,name=push_amt" json:"push_amt,omitempty"`
+	// / The dust limit of the initiator's commitment tx.
+	DustLimit uint64 `protobuf:"varint,6,opt,name=dust_limit" json:"dust_limit,omitempty"`
+	// / The maximum amount of coins in millisatoshis that can be pending in this channel.
+	MaxValueInFlight uint64 `protobuf:"varint,7,opt,name=max_value_in_flight" json:"max_value_in_flight,omitempty"`
+	// / The minimum amount of satoshis the initiator requires us to have at all times.
+	ChannelReserve uint64 `protobuf:"varint,8,opt,name=channel_reserve" json:"channel_reserve,omitempty"`
+	// / The smallest HTLC in millisatoshis that the initiator will accept.
+	MinHtlc uint64 `protobuf:"varint,9,opt,name=min_htlc" json:"min_htlc,omitempty"`
+	// / The initial fee rate that the initiator suggests for both commitment transactions.
+	FeePerKw uint64 `protobuf:"varint,10,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
+	// *
+	// The number of blocks to use for the relative time lock in the pay-to-self
+	// output of both commitment transactions.
+	CsvDelay uint32 `protobuf:"varint,11,opt,name=csv_delay" json:"csv_delay,omitempty"`
+	// / The total number of incoming HTLC's that the initiator will accept.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs" json:"max_accepted_htlcs,omitempty"`
+	// / Whether the proposed channel is private, not announced to the greater network.
+	Private bool `protobuf:"varint,13,opt,name=private" json:"private,omitempty"`
+}
+
+func (m *ChannelAcceptRequest) Reset()                    { *m = ChannelAcceptRequest{} }
+func (m *ChannelAcceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAcceptRequest) ProtoMessage()               {}
+func (*ChannelAcceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *ChannelAcceptRequest) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *ChannelAcceptRequest) GetChainHash() []byte {
+	if m != nil {
+		return m.ChainHash
+	}
+	return nil
+}
+
+func (m *ChannelAcceptRequest) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *ChannelAcceptRequest) GetFundingAmt() uint64 {
+	if m != nil {
+		return m.FundingAmt
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetPushAmt() uint64 {
+	if m != nil {
+		return m.PushAmt
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetDustLimit() uint64 {
+	if m != nil {
+		return m.DustLimit
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetMaxValueInFlight() uint64 {
+	if m != nil {
+		return m.MaxValueInFlight
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetChannelReserve() uint64 {
+	if m != nil {
+		return m.ChannelReserve
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetMinHtlc() uint64 {
+	if m != nil {
+		return m.MinHtlc
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetFeePerKw() uint64 {
+	if m != nil {
+		return m.FeePerKw
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetCsvDelay() uint32 {
+	if m != nil {
+		return m.CsvDelay
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetMaxAcceptedHtlcs() uint32 {
+	if m != nil {
+		return m.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+type ChannelAcceptResponse struct {
+	// / Whether or not the client accepts the channel.
+	Accept bool `protobuf:"varint,1,opt,name=accept" json:"accept,omitempty"`
+	// / The pending channel id to which this response applies.
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// *
+	// The reason for rejecting the channel, which is sent to the remote peer.
+	// If empty, a generic error is sent to the peer instead.
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ChannelAcceptResponse) Reset()                    { *m = ChannelAcceptResponse{} }
+func (m *ChannelAcceptResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAcceptResponse) ProtoMessage()               {}
+func (*ChannelAcceptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+func (m *ChannelAcceptResponse) GetAccept() bool {
+	if m != nil {
+		return m.Accept
+	}
+	return false
+}
+
+func (m *ChannelAcceptResponse) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *ChannelAcceptResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type OpenChannelRequest struct {
 	// / The pubkey of the node to open a channel with
 	NodePubkey []byte `protobuf:"bytes,2,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
@@ -2221,12 +3243,46 @@ type OpenChannelRequest struct {
 	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs" json:"min_confs,omitempty"`
 	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
 	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed" json:"spend_unconfirmed,omitempty"`
+	// *
+	// Whether the funding transaction should be crafted by an external wallet
+	// using a PSBT rather than by the internal wallet. If set, a psbt_fund
+	// update is sent once the remote party has accepted the channel, and the
+	// signed PSBT must then be supplied through FundingStateStep.
+	Psbt bool `protobuf:"varint,13,opt,name=psbt" json:"psbt,omitempty"`
+	// *
+	// An optional address to commit to as the upfront shutdown script of the
+	// channel. If set, the funds of a cooperative close of the channel can only
+	// be paid to this address. This requires the remote peer to support upfront
+	// shutdown scripts.
+	CloseAddress string `protobuf:"bytes,14,opt,name=close_address" json:"close_address,omitempty"`
+	// *
+	// Whether the channel should be usable right away, before its funding
+	// transaction confirms. This requires the remote peer to trust us not to
+	// double spend the funding transaction.
+	ZeroConf bool `protobuf:"varint,15,opt,name=zero_conf" json:"zero_conf,omitempty"`
+	// *
+	// The channel reserve in satoshis we require the remote node to keep. If
+	// not set, it's derived from the channel size.
+	RemoteChanReserveSat int64 `protobuf:"varint,16,opt,name=remote_chan_reserve_sat" json:"remote_chan_reserve_sat,omitempty"`
+	// *
+	// The dust limit in satoshis of our commitment transaction. Outputs below
+	// this value won't be added to it. If not set, the default dust limit of
+	// the chain is used.
+	DustLimitSat int64 `protobuf:"varint,17,opt,name=dust_limit_sat" json:"dust_limit_sat,omitempty"`
+	// *
+	// The maximum number of HTLCs the remote node may offer us at once. If not
+	// set, the maximum permitted by the protocol is used.
+	RemoteMaxHtlcs uint32 `protobuf:"varint,18,opt,name=remote_max_htlcs" json:"remote_max_htlcs,omitempty"`
+	// *
+	// The maximum value in millisatoshis of the HTLCs the remote node may offer
+	// us at once. If not set, it's derived from the channel size.
+	RemoteMaxValueInFlightMsat uint64 `protobuf:"varint,19,opt,name=remote_max_value_in_flight_msat" json:"remote_max_value_in_flight_msat,omitempty"`
 }
 
 func (m *OpenChannelRequest) Reset()                    { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string            { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()               {}
-func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
 func (m *OpenChannelRequest) GetNodePubkey() []byte {
 	if m != nil {
@@ -2305,18 +3361,68 @@ func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
 	return false
 }
 
+func (m *OpenChannelRequest) GetPsbt() bool {
+	if m != nil {
+		return m.Psbt
+	}
+	return false
+}
+
+func (m *OpenChannelRequest) GetCloseAddress() string {
+	if m != nil {
+		return m.CloseAddress
+	}
+	return ""
+}
+
+func (m *OpenChannelRequest) GetZeroConf() bool {
+	if m != nil {
+		return m.ZeroConf
+	}
+	return false
+}
+
+func (m *OpenChannelRequest) GetRemoteChanReserveSat() int64 {
+	if m != nil {
+		return m.RemoteChanReserveSat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetDustLimitSat() int64 {
+	if m != nil {
+		return m.DustLimitSat
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetRemoteMaxHtlcs() uint32 {
+	if m != nil {
+		return m.RemoteMaxHtlcs
+	}
+	return 0
+}
+
+func (m *OpenChannelRequest) GetRemoteMaxValueInFlightMsat() uint64 {
+	if m != nil {
+		return m.RemoteMaxValueInFlightMsat
+	}
+	return 0
+}
+
 type OpenStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*OpenStatusUpdate_ChanPending
 	//	*OpenStatusUpdate_Confirmation
 	//	*OpenStatusUpdate_ChanOpen
+	//	*OpenStatusUpdate_PsbtFund
 	Update isOpenStatusUpdate_Update `protobuf_oneof:"update"`
 }
 
 func (m *OpenStatusUpdate) Reset()                    { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string            { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()               {}
-func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 type isOpenStatusUpdate_Update interface{ isOpenStatusUpdate_Update() }
 
@@ -2329,10 +3435,14 @@ type OpenStatusUpdate_Confirmation struct {
 type OpenStatusUpdate_ChanOpen struct {
 	ChanOpen *ChannelOpenUpdate `protobuf:"bytes,3,opt,name=chan_open,oneof"`
 }
+type OpenStatusUpdate_PsbtFund struct {
+	PsbtFund *ReadyForPsbtFunding `protobuf:"bytes,4,opt,name=psbt_fund,oneof"`
+}
 
 func (*OpenStatusUpdate_ChanPending) isOpenStatusUpdate_Update()  {}
 func (*OpenStatusUpdate_Confirmation) isOpenStatusUpdate_Update() {}
 func (*OpenStatusUpdate_ChanOpen) isOpenStatusUpdate_Update()     {}
+func (*OpenStatusUpdate_PsbtFund) isOpenStatusUpdate_Update()     {}
 
 func (m *OpenStatusUpdate) GetUpdate() isOpenStatusUpdate_Update {
 	if m != nil {
@@ -2362,12 +3472,20 @@ func (m *OpenStatusUpdate) GetChanOpen() *ChannelOpenUpdate {
 	return nil
 }
 
+func (m *OpenStatusUpdate) GetPsbtFund() *ReadyForPsbtFunding {
+	if x, ok := m.GetUpdate().(*OpenStatusUpdate_PsbtFund); ok {
+		return x.PsbtFund
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*OpenStatusUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _OpenStatusUpdate_OneofMarshaler, _OpenStatusUpdate_OneofUnmarshaler, _OpenStatusUpdate_OneofSizer, []interface{}{
 		(*OpenStatusUpdate_ChanPending)(nil),
 		(*OpenStatusUpdate_Confirmation)(nil),
 		(*OpenStatusUpdate_ChanOpen)(nil),
+		(*OpenStatusUpdate_PsbtFund)(nil),
 	}
 }
 
@@ -2390,6 +3508,11 @@ func _OpenStatusUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error
 		if err := b.EncodeMessage(x.ChanOpen); err != nil {
 			return err
 		}
+	case *OpenStatusUpdate_PsbtFund:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PsbtFund); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("OpenStatusUpdate.Update has unexpected type %T", x)
@@ -2424,6 +3547,14 @@ func _OpenStatusUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *pro
 		err := b.DecodeMessage(msg)
 		m.Update = &OpenStatusUpdate_ChanOpen{msg}
 		return true, err
+	case 4: // update.psbt_fund
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ReadyForPsbtFunding)
+		err := b.DecodeMessage(msg)
+		m.Update = &OpenStatusUpdate_PsbtFund{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2448,6 +3579,172 @@ func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
 		n += proto.SizeVarint(3<<3 | proto.WireBytes)
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *OpenStatusUpdate_PsbtFund:
+		s := proto.Size(x.PsbtFund)
+		n += proto.SizeVarint(4<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ReadyForPsbtFunding struct {
+	// / The P2WSH address of the funding output that the channel capacity must be sent to.
+	FundingAddress string `protobuf:"bytes,1,opt,name=funding_address" json:"funding_address,omitempty"`
+	// / The exact amount in satoshis that must be sent to the funding address.
+	FundingAmount int64 `protobuf:"varint,2,opt,name=funding_amount" json:"funding_amount,omitempty"`
+	// / A PSBT template containing only the funding output, which can be used to fund the channel within an external wallet.
+	Psbt []byte `protobuf:"bytes,3,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	// / The pending channel ID that references the channel within FundingStateStep.
+	PendingChanId []byte `protobuf:"bytes,4,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+}
+
+func (m *ReadyForPsbtFunding) Reset()                    { *m = ReadyForPsbtFunding{} }
+func (m *ReadyForPsbtFunding) String() string            { return proto.CompactTextString(m) }
+func (*ReadyForPsbtFunding) ProtoMessage()               {}
+func (*ReadyForPsbtFunding) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+
+func (m *ReadyForPsbtFunding) GetFundingAddress() string {
+	if m != nil {
+		return m.FundingAddress
+	}
+	return ""
+}
+
+func (m *ReadyForPsbtFunding) GetFundingAmount() int64 {
+	if m != nil {
+		return m.FundingAmount
+	}
+	return 0
+}
+
+func (m *ReadyForPsbtFunding) GetPsbt() []byte {
+	if m != nil {
+		return m.Psbt
+	}
+	return nil
+}
+
+func (m *ReadyForPsbtFunding) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+type PsbtFinalize struct {
+	// / The pending channel ID of the channel the PSBT funds.
+	PendingChanId []byte `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// *
+	// The fully signed PSBT paying the full channel capacity to the funding
+	// address. All inputs must be finalized, and must spend segwit outputs to
+	// ensure the txid of the funding transaction can't be malleated.
+	SignedPsbt []byte `protobuf:"bytes,2,opt,name=signed_psbt,proto3" json:"signed_psbt,omitempty"`
+}
+
+func (m *PsbtFinalize) Reset()                    { *m = PsbtFinalize{} }
+func (m *PsbtFinalize) String() string            { return proto.CompactTextString(m) }
+func (*PsbtFinalize) ProtoMessage()               {}
+func (*PsbtFinalize) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+
+func (m *PsbtFinalize) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *PsbtFinalize) GetSignedPsbt() []byte {
+	if m != nil {
+		return m.SignedPsbt
+	}
+	return nil
+}
+
+type FundingTransitionMsg struct {
+	// Types that are valid to be assigned to Trigger:
+	//	*FundingTransitionMsg_PsbtFinalize
+	Trigger isFundingTransitionMsg_Trigger `protobuf_oneof:"trigger"`
+}
+
+func (m *FundingTransitionMsg) Reset()                    { *m = FundingTransitionMsg{} }
+func (m *FundingTransitionMsg) String() string            { return proto.CompactTextString(m) }
+func (*FundingTransitionMsg) ProtoMessage()               {}
+func (*FundingTransitionMsg) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+
+type isFundingTransitionMsg_Trigger interface{ isFundingTransitionMsg_Trigger() }
+
+type FundingTransitionMsg_PsbtFinalize struct {
+	PsbtFinalize *PsbtFinalize `protobuf:"bytes,1,opt,name=psbt_finalize,oneof"`
+}
+
+func (*FundingTransitionMsg_PsbtFinalize) isFundingTransitionMsg_Trigger() {}
+
+func (m *FundingTransitionMsg) GetTrigger() isFundingTransitionMsg_Trigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
+}
+
+func (m *FundingTransitionMsg) GetPsbtFinalize() *PsbtFinalize {
+	if x, ok := m.GetTrigger().(*FundingTransitionMsg_PsbtFinalize); ok {
+		return x.PsbtFinalize
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*FundingTransitionMsg) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _FundingTransitionMsg_OneofMarshaler, _FundingTransitionMsg_OneofUnmarshaler, _FundingTransitionMsg_OneofSizer, []interface{}{
+		(*FundingTransitionMsg_PsbtFinalize)(nil),
+	}
+}
+
+func _FundingTransitionMsg_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*FundingTransitionMsg)
+	// trigger
+	switch x := m.Trigger.(type) {
+	case *FundingTransitionMsg_PsbtFinalize:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PsbtFinalize); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("FundingTransitionMsg.Trigger has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _FundingTransitionMsg_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*FundingTransitionMsg)
+	switch tag {
+	case 1: // trigger.psbt_finalize
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PsbtFinalize)
+		err := b.DecodeMessage(msg)
+		m.Trigger = &FundingTransitionMsg_PsbtFinalize{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _FundingTransitionMsg_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*FundingTransitionMsg)
+	// trigger
+	switch x := m.Trigger.(type) {
+	case *FundingTransitionMsg_PsbtFinalize:
+		s := proto.Size(x.PsbtFinalize)
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2455,6 +3752,14 @@ func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type FundingStateStepResp struct {
+}
+
+func (m *FundingStateStepResp) Reset()                    { *m = FundingStateStepResp{} }
+func (m *FundingStateStepResp) String() string            { return proto.CompactTextString(m) }
+func (*FundingStateStepResp) ProtoMessage()               {}
+func (*FundingStateStepResp) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+
 type PendingHTLC struct {
 	// / The direction within the channel that the htlc was sent
 	Incoming bool `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
@@ -2473,51 +3778,143 @@ type PendingHTLC struct {
 	Stage uint32 `protobuf:"varint,6,opt,name=stage" json:"stage,omitempty"`
 }
 
-func (m *PendingHTLC) Reset()                    { *m = PendingHTLC{} }
-func (m *PendingHTLC) String() string            { return proto.CompactTextString(m) }
-func (*PendingHTLC) ProtoMessage()               {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
-
-func (m *PendingHTLC) GetIncoming() bool {
+func (m *PendingHTLC) Reset()                    { *m = PendingHTLC{} }
+func (m *PendingHTLC) String() string            { return proto.CompactTextString(m) }
+func (*PendingHTLC) ProtoMessage()               {}
+func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+
+func (m *PendingHTLC) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+func (m *PendingHTLC) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PendingHTLC) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *PendingHTLC) GetMaturityHeight() uint32 {
+	if m != nil {
+		return m.MaturityHeight
+	}
+	return 0
+}
+
+func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+	if m != nil {
+		return m.BlocksTilMaturity
+	}
+	return 0
+}
+
+func (m *PendingHTLC) GetStage() uint32 {
+	if m != nil {
+		return m.Stage
+	}
+	return 0
+}
+
+type ResolverReport struct {
+	// / The type of contract resolver that is resolving the output
+	ResolverType ResolverReport_ResolverType `protobuf:"varint,1,opt,name=resolver_type,enum=lnrpc.ResolverReport_ResolverType" json:"resolver_type,omitempty"`
+	// / The output on the commitment transaction being resolved
+	Outpoint string `protobuf:"bytes,2,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The value of the output that will be swept
+	Amount int64 `protobuf:"varint,3,opt,name=amount" json:"amount,omitempty"`
+	// / The stage of resolution the resolver has reached
+	Stage ResolverReport_ResolverStage `protobuf:"varint,4,opt,name=stage,enum=lnrpc.ResolverReport_ResolverStage" json:"stage,omitempty"`
+	// / The absolute expiry height of the htlc, zero for non-htlc outputs
+	ExpiryHeight uint32 `protobuf:"varint,5,opt,name=expiry_height" json:"expiry_height,omitempty"`
+	// / The height at which the CSV delay of the output expires, if known
+	MaturityHeight uint32 `protobuf:"varint,6,opt,name=maturity_height" json:"maturity_height,omitempty"`
+	// *
+	// The number of blocks remaining until the CSV delay of the output
+	// expires. Negative values indicate how many blocks have passed since
+	// becoming mature.
+	BlocksTilMaturity int32 `protobuf:"varint,7,opt,name=blocks_til_maturity" json:"blocks_til_maturity,omitempty"`
+	// / The transaction id of the transaction that swept the output, if known
+	SweepTxid string `protobuf:"bytes,8,opt,name=sweep_txid" json:"sweep_txid,omitempty"`
+	// / The address that the output was swept to, if known
+	SweepAddress string `protobuf:"bytes,9,opt,name=sweep_address" json:"sweep_address,omitempty"`
+}
+
+func (m *ResolverReport) Reset()                    { *m = ResolverReport{} }
+func (m *ResolverReport) String() string            { return proto.CompactTextString(m) }
+func (*ResolverReport) ProtoMessage()               {}
+func (*ResolverReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+
+func (m *ResolverReport) GetResolverType() ResolverReport_ResolverType {
+	if m != nil {
+		return m.ResolverType
+	}
+	return ResolverReport_HTLC_TIMEOUT
+}
+
+func (m *ResolverReport) GetOutpoint() string {
 	if m != nil {
-		return m.Incoming
+		return m.Outpoint
 	}
-	return false
+	return ""
 }
 
-func (m *PendingHTLC) GetAmount() int64 {
+func (m *ResolverReport) GetAmount() int64 {
 	if m != nil {
 		return m.Amount
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetOutpoint() string {
+func (m *ResolverReport) GetStage() ResolverReport_ResolverStage {
 	if m != nil {
-		return m.Outpoint
+		return m.Stage
 	}
-	return ""
+	return ResolverReport_WAITING_EXPIRY
 }
 
-func (m *PendingHTLC) GetMaturityHeight() uint32 {
+func (m *ResolverReport) GetExpiryHeight() uint32 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *ResolverReport) GetMaturityHeight() uint32 {
 	if m != nil {
 		return m.MaturityHeight
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+func (m *ResolverReport) GetBlocksTilMaturity() int32 {
 	if m != nil {
 		return m.BlocksTilMaturity
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetStage() uint32 {
+func (m *ResolverReport) GetSweepTxid() string {
 	if m != nil {
-		return m.Stage
+		return m.SweepTxid
 	}
-	return 0
+	return ""
+}
+
+func (m *ResolverReport) GetSweepAddress() string {
+	if m != nil {
+		return m.SweepAddress
+	}
+	return ""
 }
 
 type PendingChannelsRequest struct {
@@ -2526,7 +3923,7 @@ type PendingChannelsRequest struct {
 func (m *PendingChannelsRequest) Reset()                    { *m = PendingChannelsRequest{} }
 func (m *PendingChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()               {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 type PendingChannelsResponse struct {
 	// / The balance in satoshis encumbered in pending channels
@@ -2544,7 +3941,7 @@ type PendingChannelsResponse struct {
 func (m *PendingChannelsResponse) Reset()                    { *m = PendingChannelsResponse{} }
 func (m *PendingChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()               {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
 	if m != nil {
@@ -2595,7 +3992,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 0}
+	return fileDescriptor0, []int{73, 0}
 }
 
 func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
@@ -2662,7 +4059,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 1}
+	return fileDescriptor0, []int{73, 1}
 }
 
 func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2705,6 +4102,8 @@ type PendingChannelsResponse_WaitingCloseChannel struct {
 	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
 	// / The balance in satoshis encumbered in this channel
 	LimboBalance int64 `protobuf:"varint,2,opt,name=limbo_balance" json:"limbo_balance,omitempty"`
+	// / The outputs of the closing transaction that pay out our funds
+	Resolutions []*Resolution `protobuf:"bytes,3,rep,name=resolutions" json:"resolutions,omitempty"`
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
@@ -2715,7 +4114,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 2}
+	return fileDescriptor0, []int{73, 2}
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2732,6 +4131,13 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
 	return 0
 }
 
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetResolutions() []*Resolution {
+	if m != nil {
+		return m.Resolutions
+	}
+	return nil
+}
+
 type PendingChannelsResponse_ClosedChannel struct {
 	// / The pending channel to be closed
 	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel" json:"channel,omitempty"`
@@ -2743,7 +4149,7 @@ func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingCh
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 3}
+	return fileDescriptor0, []int{73, 3}
 }
 
 func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2777,6 +4183,8 @@ type PendingChannelsResponse_ForceClosedChannel struct {
 	// / The total value of funds successfully recovered from this channel
 	RecoveredBalance int64          `protobuf:"varint,6,opt,name=recovered_balance" json:"recovered_balance,omitempty"`
 	PendingHtlcs     []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs" json:"pending_htlcs,omitempty"`
+	// / The resolution progress of each output that is still being resolved
+	Resolvers []*ResolverReport `protobuf:"bytes,9,rep,name=resolvers" json:"resolvers,omitempty"`
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
@@ -2787,7 +4195,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{53, 4}
+	return fileDescriptor0, []int{73, 4}
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2839,13 +4247,20 @@ func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*Pendin
 	return nil
 }
 
+func (m *PendingChannelsResponse_ForceClosedChannel) GetResolvers() []*ResolverReport {
+	if m != nil {
+		return m.Resolvers
+	}
+	return nil
+}
+
 type WalletBalanceRequest struct {
 }
 
 func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()               {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 type WalletBalanceResponse struct {
 	// / The balance of the wallet
@@ -2859,7 +4274,7 @@ type WalletBalanceResponse struct {
 func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()               {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 func (m *WalletBalanceResponse) GetTotalBalance() int64 {
 	if m != nil {
@@ -2888,7 +4303,7 @@ type ChannelBalanceRequest struct {
 func (m *ChannelBalanceRequest) Reset()                    { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()               {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 type ChannelBalanceResponse struct {
 	// / Sum of channels balances denominated in satoshis
@@ -2900,7 +4315,7 @@ type ChannelBalanceResponse struct {
 func (m *ChannelBalanceResponse) Reset()                    { *m = ChannelBalanceResponse{} }
 func (m *ChannelBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()               {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 func (m *ChannelBalanceResponse) GetBalance() int64 {
 	if m != nil {
@@ -2936,7 +4351,7 @@ type QueryRoutesRequest struct {
 func (m *QueryRoutesRequest) Reset()                    { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string            { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()               {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 func (m *QueryRoutesRequest) GetPubKey() string {
 	if m != nil {
@@ -2980,7 +4395,7 @@ type QueryRoutesResponse struct {
 func (m *QueryRoutesResponse) Reset()                    { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string            { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()               {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 func (m *QueryRoutesResponse) GetRoutes() []*Route {
 	if m != nil {
@@ -3006,7 +4421,7 @@ type Hop struct {
 func (m *Hop) Reset()                    { *m = Hop{} }
 func (m *Hop) String() string            { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()               {}
-func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
 func (m *Hop) GetChanId() uint64 {
 	if m != nil {
@@ -3096,7 +4511,7 @@ type Route struct {
 func (m *Route) Reset()                    { *m = Route{} }
 func (m *Route) String() string            { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()               {}
-func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 func (m *Route) GetTotalTimeLock() uint32 {
 	if m != nil {
@@ -3148,7 +4563,7 @@ type NodeInfoRequest struct {
 func (m *NodeInfoRequest) Reset()                    { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()               {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 func (m *NodeInfoRequest) GetPubKey() string {
 	if m != nil {
@@ -3171,7 +4586,7 @@ type NodeInfo struct {
 func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
 func (m *NodeInfo) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()               {}
-func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
 func (m *NodeInfo) GetNode() *LightningNode {
 	if m != nil {
@@ -3210,7 +4625,7 @@ type LightningNode struct {
 func (m *LightningNode) Reset()                    { *m = LightningNode{} }
 func (m *LightningNode) String() string            { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()               {}
-func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
 
 func (m *LightningNode) GetLastUpdate() uint32 {
 	if m != nil {
@@ -3255,7 +4670,7 @@ type NodeAddress struct {
 func (m *NodeAddress) Reset()                    { *m = NodeAddress{} }
 func (m *NodeAddress) String() string            { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()               {}
-func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
 
 func (m *NodeAddress) GetNetwork() string {
 	if m != nil {
@@ -3282,7 +4697,7 @@ type RoutingPolicy struct {
 func (m *RoutingPolicy) Reset()                    { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string            { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()               {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
 func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
 	if m != nil {
@@ -3343,7 +4758,7 @@ type ChannelEdge struct {
 func (m *ChannelEdge) Reset()                    { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string            { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()               {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
 func (m *ChannelEdge) GetChannelId() uint64 {
 	if m != nil {
@@ -3412,7 +4827,7 @@ type ChannelGraphRequest struct {
 func (m *ChannelGraphRequest) Reset()                    { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()               {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
 
 func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
 	if m != nil {
@@ -3432,7 +4847,7 @@ type ChannelGraph struct {
 func (m *ChannelGraph) Reset()                    { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string            { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()               {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
 func (m *ChannelGraph) GetNodes() []*LightningNode {
 	if m != nil {
@@ -3459,7 +4874,7 @@ type ChanInfoRequest struct {
 func (m *ChanInfoRequest) Reset()                    { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()               {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
 func (m *ChanInfoRequest) GetChanId() uint64 {
 	if m != nil {
@@ -3474,7 +4889,7 @@ type NetworkInfoRequest struct {
 func (m *NetworkInfoRequest) Reset()                    { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()               {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
 type NetworkInfo struct {
 	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter" json:"graph_diameter,omitempty"`
@@ -3491,7 +4906,7 @@ type NetworkInfo struct {
 func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()               {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
 func (m *NetworkInfo) GetGraphDiameter() uint32 {
 	if m != nil {
@@ -3562,7 +4977,7 @@ type StopRequest struct {
 func (m *StopRequest) Reset()                    { *m = StopRequest{} }
 func (m *StopRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()               {}
-func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
 type StopResponse struct {
 }
@@ -3570,7 +4985,7 @@ type StopResponse struct {
 func (m *StopResponse) Reset()                    { *m = StopResponse{} }
 func (m *StopResponse) String() string            { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()               {}
-func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
 
 type GraphTopologySubscription struct {
 }
@@ -3578,7 +4993,7 @@ type GraphTopologySubscription struct {
 func (m *GraphTopologySubscription) Reset()                    { *m = GraphTopologySubscription{} }
 func (m *GraphTopologySubscription) String() string            { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()               {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
 
 type GraphTopologyUpdate struct {
 	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates" json:"node_updates,omitempty"`
@@ -3589,7 +5004,7 @@ type GraphTopologyUpdate struct {
 func (m *GraphTopologyUpdate) Reset()                    { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string            { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()               {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
 
 func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
 	if m != nil {
@@ -3622,7 +5037,7 @@ type NodeUpdate struct {
 func (m *NodeUpdate) Reset()                    { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string            { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()               {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
 
 func (m *NodeUpdate) GetAddresses() []string {
 	if m != nil {
@@ -3668,7 +5083,7 @@ type ChannelEdgeUpdate struct {
 func (m *ChannelEdgeUpdate) Reset()                    { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()               {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
 
 func (m *ChannelEdgeUpdate) GetChanId() uint64 {
 	if m != nil {
@@ -3726,7 +5141,7 @@ type ClosedChannelUpdate struct {
 func (m *ClosedChannelUpdate) Reset()                    { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()               {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
 func (m *ClosedChannelUpdate) GetChanId() uint64 {
 	if m != nil {
@@ -3774,7 +5189,7 @@ type HopHint struct {
 func (m *HopHint) Reset()                    { *m = HopHint{} }
 func (m *HopHint) String() string            { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()               {}
-func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
 
 func (m *HopHint) GetNodeId() string {
 	if m != nil {
@@ -3821,7 +5236,7 @@ type RouteHint struct {
 func (m *RouteHint) Reset()                    { *m = RouteHint{} }
 func (m *RouteHint) String() string            { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()               {}
-func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
 
 func (m *RouteHint) GetHopHints() []*HopHint {
 	if m != nil {
@@ -3910,7 +5325,7 @@ type Invoice struct {
 func (m *Invoice) Reset()                    { *m = Invoice{} }
 func (m *Invoice) String() string            { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()               {}
-func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
 
 func (m *Invoice) GetMemo() string {
 	if m != nil {
@@ -4070,7 +5485,7 @@ type AddInvoiceResponse struct {
 func (m *AddInvoiceResponse) Reset()                    { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string            { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()               {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
 func (m *AddInvoiceResponse) GetRHash() []byte {
 	if m != nil {
@@ -4105,7 +5520,7 @@ type PaymentHash struct {
 func (m *PaymentHash) Reset()                    { *m = PaymentHash{} }
 func (m *PaymentHash) String() string            { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()               {}
-func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
 
 func (m *PaymentHash) GetRHashStr() string {
 	if m != nil {
@@ -4139,7 +5554,7 @@ type ListInvoiceRequest struct {
 func (m *ListInvoiceRequest) Reset()                    { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()               {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
 func (m *ListInvoiceRequest) GetPendingOnly() bool {
 	if m != nil {
@@ -4187,7 +5602,7 @@ type ListInvoiceResponse struct {
 func (m *ListInvoiceResponse) Reset()                    { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()               {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
 
 func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
 	if m != nil {
@@ -4228,7 +5643,7 @@ type InvoiceSubscription struct {
 func (m *InvoiceSubscription) Reset()                    { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string            { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()               {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
 
 func (m *InvoiceSubscription) GetAddIndex() uint64 {
 	if m != nil {
@@ -4266,7 +5681,7 @@ type Payment struct {
 func (m *Payment) Reset()                    { *m = Payment{} }
 func (m *Payment) String() string            { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()               {}
-func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
 
 func (m *Payment) GetPaymentHash() string {
 	if m != nil {
@@ -4330,7 +5745,7 @@ type ListPaymentsRequest struct {
 func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()               {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
 
 type ListPaymentsResponse struct {
 	// / The list of payments
@@ -4340,7 +5755,7 @@ type ListPaymentsResponse struct {
 func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()               {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
 
 func (m *ListPaymentsResponse) GetPayments() []*Payment {
 	if m != nil {
@@ -4355,7 +5770,7 @@ type DeleteAllPaymentsRequest struct {
 func (m *DeleteAllPaymentsRequest) Reset()                    { *m = DeleteAllPaymentsRequest{} }
 func (m *DeleteAllPaymentsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()               {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
 
 type DeleteAllPaymentsResponse struct {
 }
@@ -4363,7 +5778,7 @@ type DeleteAllPaymentsResponse struct {
 func (m *DeleteAllPaymentsResponse) Reset()                    { *m = DeleteAllPaymentsResponse{} }
 func (m *DeleteAllPaymentsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
 
 type AbandonChannelRequest struct {
 	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
@@ -4372,7 +5787,7 @@ type AbandonChannelRequest struct {
 func (m *AbandonChannelRequest) Reset()                    { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string            { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()               {}
-func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
 
 func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
@@ -4387,7 +5802,77 @@ type AbandonChannelResponse struct {
 func (m *AbandonChannelResponse) Reset()                    { *m = AbandonChannelResponse{} }
 func (m *AbandonChannelResponse) String() string            { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()               {}
-func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
+
+type SpliceChannelRequest struct {
+	// / The outpoint (txid:index) of the funding transaction of the channel to splice.
+	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point" json:"channel_point,omitempty"`
+	// / The number of satoshis to splice into the channel. A negative amount splices funds out of our side of the channel.
+	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	// / The address that spliced out funds should be sent to. If empty, a new wallet address is used.
+	Addr string `protobuf:"bytes,3,opt,name=addr" json:"addr,omitempty"`
+	// / The target number of blocks that the splice transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,4,opt,name=target_conf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the splice transaction.
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte" json:"sat_per_byte,omitempty"`
+}
+
+func (m *SpliceChannelRequest) Reset()                    { *m = SpliceChannelRequest{} }
+func (m *SpliceChannelRequest) String() string            { return proto.CompactTextString(m) }
+func (*SpliceChannelRequest) ProtoMessage()               {}
+func (*SpliceChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
+
+func (m *SpliceChannelRequest) GetChannelPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return nil
+}
+
+func (m *SpliceChannelRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SpliceChannelRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *SpliceChannelRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *SpliceChannelRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type SpliceChannelResponse struct {
+	// / The new funding outpoint of the channel once the splice confirms.
+	SplicePending *PendingUpdate `protobuf:"bytes,1,opt,name=splice_pending" json:"splice_pending,omitempty"`
+}
+
+func (m *SpliceChannelResponse) Reset()                    { *m = SpliceChannelResponse{} }
+func (m *SpliceChannelResponse) String() string            { return proto.CompactTextString(m) }
+func (*SpliceChannelResponse) ProtoMessage()               {}
+func (*SpliceChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
+
+func (m *SpliceChannelResponse) GetSplicePending() *PendingUpdate {
+	if m != nil {
+		return m.SplicePending
+	}
+	return nil
+}
 
 type DebugLevelRequest struct {
 	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
@@ -4397,7 +5882,7 @@ type DebugLevelRequest struct {
 func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()               {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
 
 func (m *DebugLevelRequest) GetShow() bool {
 	if m != nil {
@@ -4420,7 +5905,7 @@ type DebugLevelResponse struct {
 func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()               {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
 
 func (m *DebugLevelResponse) GetSubSystems() string {
 	if m != nil {
@@ -4437,7 +5922,7 @@ type PayReqString struct {
 func (m *PayReqString) Reset()                    { *m = PayReqString{} }
 func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()               {}
-func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
 
 func (m *PayReqString) GetPayReq() string {
 	if m != nil {
@@ -4462,7 +5947,7 @@ type PayReq struct {
 func (m *PayReq) Reset()                    { *m = PayReq{} }
 func (m *PayReq) String() string            { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()               {}
-func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
 
 func (m *PayReq) GetDestination() string {
 	if m != nil {
@@ -4540,7 +6025,7 @@ type FeeReportRequest struct {
 func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()               {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
 
 type ChannelFeeReport struct {
 	// / The channel that this fee report belongs to.
@@ -4556,7 +6041,7 @@ type ChannelFeeReport struct {
 func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()               {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
 
 func (m *ChannelFeeReport) GetChanPoint() string {
 	if m != nil {
@@ -4600,7 +6085,7 @@ type FeeReportResponse struct {
 func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()               {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
 
 func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
 	if m != nil {
@@ -4646,7 +6131,7 @@ type PolicyUpdateRequest struct {
 func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()               {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
 
 type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
 
@@ -4781,7 +6266,7 @@ type PolicyUpdateResponse struct {
 func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()               {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
 
 type ForwardingHistoryRequest struct {
 	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
@@ -4797,7 +6282,7 @@ type ForwardingHistoryRequest struct {
 func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
 func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()               {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
 
 func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
 	if m != nil {
@@ -4845,7 +6330,7 @@ type ForwardingEvent struct {
 func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()               {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
 
 func (m *ForwardingEvent) GetTimestamp() uint64 {
 	if m != nil {
@@ -4899,7 +6384,7 @@ type ForwardingHistoryResponse struct {
 func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
 func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()               {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
 
 func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
 	if m != nil {
@@ -4929,8 +6414,12 @@ func init() {
 	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
 	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
 	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
+	proto.RegisterType((*RouteWeights)(nil), "lnrpc.RouteWeights")
 	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
 	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
+	proto.RegisterType((*ProbePaymentRequest)(nil), "lnrpc.ProbePaymentRequest")
+	proto.RegisterType((*ProbeAttempt)(nil), "lnrpc.ProbeAttempt")
+	proto.RegisterType((*ProbePaymentResponse)(nil), "lnrpc.ProbePaymentResponse")
 	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
 	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
 	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
@@ -4939,6 +6428,10 @@ func init() {
 	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
 	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
 	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
+	proto.RegisterType((*SetSweepDestinationRequest)(nil), "lnrpc.SetSweepDestinationRequest")
+	proto.RegisterType((*SetSweepDestinationResponse)(nil), "lnrpc.SetSweepDestinationResponse")
+	proto.RegisterType((*GetSweepDestinationRequest)(nil), "lnrpc.GetSweepDestinationRequest")
+	proto.RegisterType((*GetSweepDestinationResponse)(nil), "lnrpc.GetSweepDestinationResponse")
 	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
 	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
 	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
@@ -4952,6 +6445,7 @@ func init() {
 	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
 	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
 	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
+	proto.RegisterType((*Resolution)(nil), "lnrpc.Resolution")
 	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
 	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
@@ -4964,10 +6458,21 @@ func init() {
 	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
 	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
 	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
+	proto.RegisterType((*ClosingFeeUpdate)(nil), "lnrpc.ClosingFeeUpdate")
 	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
+	proto.RegisterType((*BatchOpenChannel)(nil), "lnrpc.BatchOpenChannel")
+	proto.RegisterType((*BatchOpenChannelRequest)(nil), "lnrpc.BatchOpenChannelRequest")
+	proto.RegisterType((*BatchOpenChannelResponse)(nil), "lnrpc.BatchOpenChannelResponse")
+	proto.RegisterType((*ChannelAcceptRequest)(nil), "lnrpc.ChannelAcceptRequest")
+	proto.RegisterType((*ChannelAcceptResponse)(nil), "lnrpc.ChannelAcceptResponse")
 	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
 	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
+	proto.RegisterType((*ReadyForPsbtFunding)(nil), "lnrpc.ReadyForPsbtFunding")
+	proto.RegisterType((*PsbtFinalize)(nil), "lnrpc.PsbtFinalize")
+	proto.RegisterType((*FundingTransitionMsg)(nil), "lnrpc.FundingTransitionMsg")
+	proto.RegisterType((*FundingStateStepResp)(nil), "lnrpc.FundingStateStepResp")
 	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
+	proto.RegisterType((*ResolverReport)(nil), "lnrpc.ResolverReport")
 	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
 	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
 	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
@@ -5016,6 +6521,8 @@ func init() {
 	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
 	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
 	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
+	proto.RegisterType((*SpliceChannelRequest)(nil), "lnrpc.SpliceChannelRequest")
+	proto.RegisterType((*SpliceChannelResponse)(nil), "lnrpc.SpliceChannelResponse")
 	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
 	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
 	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
@@ -5028,8 +6535,13 @@ func init() {
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterEnum("lnrpc.SendRequest_RouteStrategy", SendRequest_RouteStrategy_name, SendRequest_RouteStrategy_value)
 	proto.RegisterEnum("lnrpc.NewAddressRequest_AddressType", NewAddressRequest_AddressType_name, NewAddressRequest_AddressType_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+	proto.RegisterEnum("lnrpc.Resolution_ResolutionType", Resolution_ResolutionType_name, Resolution_ResolutionType_value)
+	proto.RegisterEnum("lnrpc.Resolution_ResolutionOutcome", Resolution_ResolutionOutcome_name, Resolution_ResolutionOutcome_value)
+	proto.RegisterEnum("lnrpc.ResolverReport_ResolverType", ResolverReport_ResolverType_name, ResolverReport_ResolverType_value)
+	proto.RegisterEnum("lnrpc.ResolverReport_ResolverStage", ResolverReport_ResolverStage_name, ResolverReport_ResolverStage_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5296,6 +6808,17 @@ type LightningClient interface {
 	// * lncli: `newaddress`
 	// NewAddress creates a new address under control of the local wallet.
 	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
+	// * lncli: `setsweepdest`
+	// SetSweepDestination sets the destination that all funds swept from closed
+	// channels, including justice transactions, and our funds of cooperative
+	// closes are sent to. Either a fixed address, or an extended public key that
+	// a fresh address is derived from for each sweep can be set. If neither is
+	// set, funds are sent to a fresh address of the wallet again.
+	SetSweepDestination(ctx context.Context, in *SetSweepDestinationRequest, opts ...grpc.CallOption) (*SetSweepDestinationResponse, error)
+	// * lncli: `getsweepdest`
+	// GetSweepDestination returns the destination that swept funds are currently
+	// sent to.
+	GetSweepDestination(ctx context.Context, in *GetSweepDestinationRequest, opts ...grpc.CallOption) (*GetSweepDestinationResponse, error)
 	// * lncli: `signmessage`
 	// SignMessage signs a message with this node's private key. The returned
 	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
@@ -5352,6 +6875,31 @@ type LightningClient interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+	// OpenChannel requests are sent to the client and the client responds with
+	// a boolean that tells LND whether or not to accept the channel. This allows
+	// node operators to specify their own criteria for accepting inbound
+	// channels through a single persistent connection. If the client doesn't
+	// respond within the configured acceptor timeout, the channel is rejected.
+	ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error)
+	// *
+	// FundingStateStep is an advanced funding related call that allows the
+	// caller to advance the funding workflow of a pending channel that is funded
+	// by an external wallet. Once OpenChannel with the psbt flag set has sent a
+	// psbt_fund update, the caller must fund the described output within its
+	// external wallet, then supply the fully signed PSBT through this call, after
+	// which the funding workflow resumes and the funding transaction is
+	// broadcast once the remote party has signed our commitment.
+	FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel attempts to open multiple singly funded channels with
+	// different remote peers within a single funding transaction. The funding
+	// transaction is only broadcast once every remote peer has signed our
+	// commitment transaction. If the funding workflow of any of the channels
+	// fails, none of them are opened. The call returns once the funding
+	// transaction has been broadcast.
+	BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error)
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -5367,6 +6915,14 @@ type LightningClient interface {
 	// channels due to bugs fixed in newer versions of lnd. Only available
 	// when in debug builds of lnd.
 	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
+	// * lncli: `splicechannel`
+	// SpliceChannel adds funds to or removes funds from an active channel
+	// without closing it. The existing funding output is spent by a new funding
+	// transaction which either pulls in additional wallet inputs, or pays part
+	// of our balance out to the given address. The channel remains usable under
+	// its current channel point until the splice transaction confirms. The call
+	// returns once the splice transaction has been broadcast.
+	SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error)
 	// * lncli: `sendpayment`
 	// SendPayment dispatches a bi-directional streaming RPC for sending payments
 	// through the Lightning Network. A single RPC invocation creates a persistent
@@ -5389,6 +6945,15 @@ type LightningClient interface {
 	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
 	// until the payment either fails or succeeds.
 	SendToRouteSync(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	// * lncli: `probepayment`
+	// ProbePayment attempts to determine whether a destination is able to
+	// receive a payment of a particular amount, without actually sending any
+	// funds. HTLCs carrying a random payment hash are dispatched through the
+	// regular payment retry loop, and the probe is deemed successful once the
+	// destination itself rejects one of them for having an unknown payment hash.
+	// Failures encountered while probing are used to update mission control, but
+	// the probe is never stored as a payment.
+	ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbePaymentResponse, error)
 	// * lncli: `addinvoice`
 	// AddInvoice attempts to add a new invoice to the invoice database. Any
 	// duplicated invoices are rejected, therefore all invoices *must* have a
@@ -5595,6 +7160,24 @@ func (c *lightningClient) NewAddress(ctx context.Context, in *NewAddressRequest,
 	return out, nil
 }
 
+func (c *lightningClient) SetSweepDestination(ctx context.Context, in *SetSweepDestinationRequest, opts ...grpc.CallOption) (*SetSweepDestinationResponse, error) {
+	out := new(SetSweepDestinationResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SetSweepDestination", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetSweepDestination(ctx context.Context, in *GetSweepDestinationRequest, opts ...grpc.CallOption) (*GetSweepDestinationResponse, error) {
+	out := new(GetSweepDestinationResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetSweepDestination", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
 	out := new(SignMessageResponse)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SignMessage", in, out, c.cc, opts...)
@@ -5717,8 +7300,57 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 	return m, nil
 }
 
+func (c *lightningClient) ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/ChannelAcceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningChannelAcceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_ChannelAcceptorClient interface {
+	Send(*ChannelAcceptResponse) error
+	Recv() (*ChannelAcceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningChannelAcceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningChannelAcceptorClient) Send(m *ChannelAcceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
+	m := new(ChannelAcceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error) {
+	out := new(FundingStateStepResp)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/FundingStateStep", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BatchOpenChannel(ctx context.Context, in *BatchOpenChannelRequest, opts ...grpc.CallOption) (*BatchOpenChannelResponse, error) {
+	out := new(BatchOpenChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/BatchOpenChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5758,8 +7390,17 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 	return out, nil
 }
 
+func (c *lightningClient) SpliceChannel(ctx context.Context, in *SpliceChannelRequest, opts ...grpc.CallOption) (*SpliceChannelResponse, error) {
+	out := new(SpliceChannelResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SpliceChannel", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5799,7 +7440,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5838,6 +7479,15 @@ func (c *lightningClient) SendToRouteSync(ctx context.Context, in *SendToRouteRe
 	return out, nil
 }
 
+func (c *lightningClient) ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbePaymentResponse, error) {
+	out := new(ProbePaymentResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ProbePayment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) AddInvoice(ctx context.Context, in *Invoice, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
 	out := new(AddInvoiceResponse)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AddInvoice", in, out, c.cc, opts...)
@@ -5866,7 +7516,7 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 }
 
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5979,7 +7629,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[7], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -6083,6 +7733,17 @@ type LightningServer interface {
 	// * lncli: `newaddress`
 	// NewAddress creates a new address under control of the local wallet.
 	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
+	// * lncli: `setsweepdest`
+	// SetSweepDestination sets the destination that all funds swept from closed
+	// channels, including justice transactions, and our funds of cooperative
+	// closes are sent to. Either a fixed address, or an extended public key that
+	// a fresh address is derived from for each sweep can be set. If neither is
+	// set, funds are sent to a fresh address of the wallet again.
+	SetSweepDestination(context.Context, *SetSweepDestinationRequest) (*SetSweepDestinationResponse, error)
+	// * lncli: `getsweepdest`
+	// GetSweepDestination returns the destination that swept funds are currently
+	// sent to.
+	GetSweepDestination(context.Context, *GetSweepDestinationRequest) (*GetSweepDestinationResponse, error)
 	// * lncli: `signmessage`
 	// SignMessage signs a message with this node's private key. The returned
 	// signature string is `zbase32` encoded and pubkey recoverable, meaning that
@@ -6139,6 +7800,31 @@ type LightningServer interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+	// OpenChannel requests are sent to the client and the client responds with
+	// a boolean that tells LND whether or not to accept the channel. This allows
+	// node operators to specify their own criteria for accepting inbound
+	// channels through a single persistent connection. If the client doesn't
+	// respond within the configured acceptor timeout, the channel is rejected.
+	ChannelAcceptor(Lightning_ChannelAcceptorServer) error
+	// *
+	// FundingStateStep is an advanced funding related call that allows the
+	// caller to advance the funding workflow of a pending channel that is funded
+	// by an external wallet. Once OpenChannel with the psbt flag set has sent a
+	// psbt_fund update, the caller must fund the described output within its
+	// external wallet, then supply the fully signed PSBT through this call, after
+	// which the funding workflow resumes and the funding transaction is
+	// broadcast once the remote party has signed our commitment.
+	FundingStateStep(context.Context, *FundingTransitionMsg) (*FundingStateStepResp, error)
+	// * lncli: `batchopenchannel`
+	// BatchOpenChannel attempts to open multiple singly funded channels with
+	// different remote peers within a single funding transaction. The funding
+	// transaction is only broadcast once every remote peer has signed our
+	// commitment transaction. If the funding workflow of any of the channels
+	// fails, none of them are opened. The call returns once the funding
+	// transaction has been broadcast.
+	BatchOpenChannel(context.Context, *BatchOpenChannelRequest) (*BatchOpenChannelResponse, error)
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -6154,6 +7840,14 @@ type LightningServer interface {
 	// channels due to bugs fixed in newer versions of lnd. Only available
 	// when in debug builds of lnd.
 	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
+	// * lncli: `splicechannel`
+	// SpliceChannel adds funds to or removes funds from an active channel
+	// without closing it. The existing funding output is spent by a new funding
+	// transaction which either pulls in additional wallet inputs, or pays part
+	// of our balance out to the given address. The channel remains usable under
+	// its current channel point until the splice transaction confirms. The call
+	// returns once the splice transaction has been broadcast.
+	SpliceChannel(context.Context, *SpliceChannelRequest) (*SpliceChannelResponse, error)
 	// * lncli: `sendpayment`
 	// SendPayment dispatches a bi-directional streaming RPC for sending payments
 	// through the Lightning Network. A single RPC invocation creates a persistent
@@ -6176,6 +7870,15 @@ type LightningServer interface {
 	// SendToRouteSync is a synchronous version of SendToRoute. It Will block
 	// until the payment either fails or succeeds.
 	SendToRouteSync(context.Context, *SendToRouteRequest) (*SendResponse, error)
+	// * lncli: `probepayment`
+	// ProbePayment attempts to determine whether a destination is able to
+	// receive a payment of a particular amount, without actually sending any
+	// funds. HTLCs carrying a random payment hash are dispatched through the
+	// regular payment retry loop, and the probe is deemed successful once the
+	// destination itself rejects one of them for having an unknown payment hash.
+	// Failures encountered while probing are used to update mission control, but
+	// the probe is never stored as a payment.
+	ProbePayment(context.Context, *ProbePaymentRequest) (*ProbePaymentResponse, error)
 	// * lncli: `addinvoice`
 	// AddInvoice attempts to add a new invoice to the invoice database. Any
 	// duplicated invoices are rejected, therefore all invoices *must* have a
@@ -6421,6 +8124,42 @@ func _Lightning_NewAddress_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SetSweepDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSweepDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetSweepDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetSweepDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetSweepDestination(ctx, req.(*SetSweepDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetSweepDestination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSweepDestinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetSweepDestination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetSweepDestination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetSweepDestination(ctx, req.(*GetSweepDestinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignMessageRequest)
 	if err := dec(in); err != nil {
@@ -6622,6 +8361,68 @@ func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_ChannelAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).ChannelAcceptor(&lightningChannelAcceptorServer{stream})
+}
+
+type Lightning_ChannelAcceptorServer interface {
+	Send(*ChannelAcceptRequest) error
+	Recv() (*ChannelAcceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningChannelAcceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningChannelAcceptorServer) Send(m *ChannelAcceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
+	m := new(ChannelAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_FundingStateStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundingTransitionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FundingStateStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FundingStateStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FundingStateStep(ctx, req.(*FundingTransitionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BatchOpenChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchOpenChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BatchOpenChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BatchOpenChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BatchOpenChannel(ctx, req.(*BatchOpenChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(CloseChannelRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -6661,6 +8462,24 @@ func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SpliceChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SpliceChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SpliceChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SpliceChannel(ctx, req.(*SpliceChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
 }
@@ -6749,6 +8568,24 @@ func _Lightning_SendToRouteSync_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ProbePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ProbePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ProbePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ProbePayment(ctx, req.(*ProbePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_AddInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Invoice)
 	if err := dec(in); err != nil {
@@ -7107,6 +8944,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NewAddress",
 			Handler:    _Lightning_NewAddress_Handler,
 		},
+		{
+			MethodName: "SetSweepDestination",
+			Handler:    _Lightning_SetSweepDestination_Handler,
+		},
+		{
+			MethodName: "GetSweepDestination",
+			Handler:    _Lightning_GetSweepDestination_Handler,
+		},
 		{
 			MethodName: "SignMessage",
 			Handler:    _Lightning_SignMessage_Handler,
@@ -7147,10 +8992,22 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
 		},
+		{
+			MethodName: "FundingStateStep",
+			Handler:    _Lightning_FundingStateStep_Handler,
+		},
+		{
+			MethodName: "BatchOpenChannel",
+			Handler:    _Lightning_BatchOpenChannel_Handler,
+		},
 		{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
 		},
+		{
+			MethodName: "SpliceChannel",
+			Handler:    _Lightning_SpliceChannel_Handler,
+		},
 		{
 			MethodName: "SendPaymentSync",
 			Handler:    _Lightning_SendPaymentSync_Handler,
@@ -7159,6 +9016,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendToRouteSync",
 			Handler:    _Lightning_SendToRouteSync_Handler,
 		},
+		{
+			MethodName: "ProbePayment",
+			Handler:    _Lightning_ProbePayment_Handler,
+		},
 		{
 			MethodName: "AddInvoice",
 			Handler:    _Lightning_AddInvoice_Handler,
@@ -7235,6 +9096,12 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_OpenChannel_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ChannelAcceptor",
+			Handler:       _Lightning_ChannelAcceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "CloseChannel",
 			Handler:       _Lightning_CloseChannel_Handler,
@@ -7269,407 +9136,513 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 6432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x5d, 0x6c, 0x24, 0xd9,
-	0x55, 0xff, 0x54, 0xbb, 0xdb, 0x76, 0x9f, 0x6e, 0xdb, 0xed, 0xeb, 0x8f, 0xe9, 0xe9, 0x9d, 0x9d,
-	0x9d, 0xad, 0xac, 0x76, 0x26, 0xfe, 0x6f, 0xc6, 0xb3, 0x4e, 0xb2, 0xda, 0xec, 0xfe, 0x49, 0xf0,
-	0xd8, 0x9e, 0xf1, 0x24, 0xde, 0x19, 0xa7, 0x3c, 0x9b, 0x25, 0x09, 0xa8, 0x53, 0xee, 0xbe, 0x6e,
-	0x57, 0xa6, 0xbb, 0xaa, 0x53, 0x55, 0x6d, 0x6f, 0x67, 0x19, 0x89, 0x2f, 0xf1, 0x80, 0x88, 0x10,
-	0x02, 0x09, 0x05, 0x09, 0x21, 0x02, 0x0f, 0xe1, 0x91, 0x07, 0xf2, 0x02, 0xbc, 0xf1, 0x02, 0x12,
-	0xe2, 0x21, 0x4f, 0x11, 0x12, 0x42, 0x82, 0x17, 0x40, 0xbc, 0x20, 0xf1, 0x08, 0x42, 0xe7, 0xdc,
-	0x8f, 0xba, 0xb7, 0xaa, 0x3c, 0x9e, 0x7c, 0xf1, 0xd6, 0xf7, 0x77, 0x4f, 0xdd, 0xcf, 0xf3, 0x75,
-	0xcf, 0x3d, 0xb7, 0xa1, 0x1e, 0x8f, 0x7b, 0x77, 0xc6, 0x71, 0x94, 0x46, 0xac, 0x36, 0x0c, 0xe3,
-	0x71, 0xaf, 0x73, 0x7d, 0x10, 0x45, 0x83, 0x21, 0xdf, 0xf4, 0xc7, 0xc1, 0xa6, 0x1f, 0x86, 0x51,
-	0xea, 0xa7, 0x41, 0x14, 0x26, 0x82, 0xc8, 0xfd, 0x1a, 0x2c, 0x3e, 0xe0, 0xe1, 0x11, 0xe7, 0x7d,
-	0x8f, 0x7f, 0x63, 0xc2, 0x93, 0x94, 0xfd, 0x3f, 0x58, 0xf6, 0xf9, 0x37, 0x39, 0xef, 0x77, 0xc7,
-	0x7e, 0x92, 0x8c, 0x4f, 0x63, 0x3f, 0xe1, 0x6d, 0xe7, 0xa6, 0x73, 0xbb, 0xe9, 0xb5, 0x44, 0xc5,
-	0xa1, 0xc6, 0xd9, 0xab, 0xd0, 0x4c, 0x90, 0x94, 0x87, 0x69, 0x1c, 0x8d, 0xa7, 0xed, 0x0a, 0xd1,
-	0x35, 0x10, 0xdb, 0x13, 0x90, 0x3b, 0x84, 0x25, 0xdd, 0x43, 0x32, 0x8e, 0xc2, 0x84, 0xb3, 0xbb,
-	0xb0, 0xda, 0x0b, 0xc6, 0xa7, 0x3c, 0xee, 0xd2, 0xc7, 0xa3, 0x90, 0x8f, 0xa2, 0x30, 0xe8, 0xb5,
-	0x9d, 0x9b, 0x33, 0xb7, 0xeb, 0x1e, 0x13, 0x75, 0xf8, 0xc5, 0x7b, 0xb2, 0x86, 0xdd, 0x82, 0x25,
-	0x1e, 0x0a, 0x9c, 0xf7, 0xe9, 0x2b, 0xd9, 0xd5, 0x62, 0x06, 0xe3, 0x07, 0xee, 0x5f, 0x3b, 0xb0,
-	0xfc, 0x30, 0x0c, 0xd2, 0x0f, 0xfc, 0xe1, 0x90, 0xa7, 0x6a, 0x4e, 0xb7, 0x60, 0xe9, 0x9c, 0x00,
-	0x9a, 0xd3, 0x79, 0x14, 0xf7, 0xe5, 0x8c, 0x16, 0x05, 0x7c, 0x28, 0xd1, 0x0b, 0x47, 0x56, 0xb9,
-	0x70, 0x64, 0xa5, 0xcb, 0x35, 0x73, 0xc1, 0x72, 0xdd, 0x82, 0xa5, 0x98, 0xf7, 0xa2, 0x33, 0x1e,
-	0x4f, 0xbb, 0xe7, 0x41, 0xd8, 0x8f, 0xce, 0xdb, 0xd5, 0x9b, 0xce, 0xed, 0x9a, 0xb7, 0xa8, 0xe0,
-	0x0f, 0x08, 0x75, 0x57, 0x81, 0x99, 0xb3, 0x10, 0xeb, 0xe6, 0x0e, 0x60, 0xe5, 0xfd, 0x70, 0x18,
-	0xf5, 0x9e, 0xfe, 0x88, 0xb3, 0x2b, 0xe9, 0xbe, 0x52, 0xda, 0xfd, 0x3a, 0xac, 0xda, 0x1d, 0xc9,
-	0x01, 0x70, 0x58, 0xdb, 0x39, 0xf5, 0xc3, 0x01, 0x57, 0x4d, 0xaa, 0x21, 0x7c, 0x1c, 0x5a, 0xbd,
-	0x49, 0x1c, 0xf3, 0xb0, 0x30, 0x86, 0x25, 0x89, 0xeb, 0x41, 0xbc, 0x0a, 0xcd, 0x90, 0x9f, 0x67,
-	0x64, 0x92, 0x65, 0x42, 0x7e, 0xae, 0x48, 0xdc, 0x36, 0xac, 0xe7, 0xbb, 0x91, 0x03, 0xf8, 0x76,
-	0x05, 0x1a, 0x4f, 0x62, 0x3f, 0x4c, 0xfc, 0x1e, 0x72, 0x31, 0x6b, 0xc3, 0x5c, 0xfa, 0x61, 0xf7,
-	0xd4, 0x4f, 0x4e, 0xa9, 0xbb, 0xba, 0xa7, 0x8a, 0x6c, 0x1d, 0x66, 0xfd, 0x51, 0x34, 0x09, 0x53,
-	0xea, 0x60, 0xc6, 0x93, 0x25, 0xf6, 0x06, 0x2c, 0x87, 0x93, 0x51, 0xb7, 0x17, 0x85, 0x27, 0x41,
-	0x3c, 0x12, 0xb2, 0x40, 0xfb, 0x55, 0xf3, 0x8a, 0x15, 0xec, 0x06, 0xc0, 0x31, 0xae, 0x83, 0xe8,
-	0xa2, 0x4a, 0x5d, 0x18, 0x08, 0x73, 0xa1, 0x29, 0x4b, 0x3c, 0x18, 0x9c, 0xa6, 0xed, 0x1a, 0x35,
-	0x64, 0x61, 0xd8, 0x46, 0x1a, 0x8c, 0x78, 0x37, 0x49, 0xfd, 0xd1, 0xb8, 0x3d, 0x4b, 0xa3, 0x31,
-	0x10, 0xaa, 0x8f, 0x52, 0x7f, 0xd8, 0x3d, 0xe1, 0x3c, 0x69, 0xcf, 0xc9, 0x7a, 0x8d, 0xb0, 0xd7,
-	0x61, 0xb1, 0xcf, 0x93, 0xb4, 0xeb, 0xf7, 0xfb, 0x31, 0x4f, 0x12, 0x9e, 0xb4, 0xe7, 0x89, 0x1b,
-	0x73, 0x28, 0xae, 0xda, 0x03, 0x9e, 0x1a, 0xab, 0x93, 0xc8, 0xdd, 0x71, 0x0f, 0x80, 0x19, 0xf0,
-	0x2e, 0x4f, 0xfd, 0x60, 0x98, 0xb0, 0xb7, 0xa0, 0x99, 0x1a, 0xc4, 0x24, 0x7d, 0x8d, 0x2d, 0x76,
-	0x87, 0xd4, 0xc6, 0x1d, 0xe3, 0x03, 0xcf, 0xa2, 0x73, 0x1f, 0xc0, 0xfc, 0x7d, 0xce, 0x0f, 0x82,
-	0x51, 0x90, 0xb2, 0x75, 0xa8, 0x9d, 0x04, 0x1f, 0x72, 0xb1, 0xd9, 0x33, 0xfb, 0x57, 0x3c, 0x51,
-	0x64, 0x1d, 0x98, 0x1b, 0xf3, 0xb8, 0xc7, 0xd5, 0xf2, 0xef, 0x5f, 0xf1, 0x14, 0x70, 0x6f, 0x0e,
-	0x6a, 0x43, 0xfc, 0xd8, 0xfd, 0x6e, 0x05, 0x1a, 0x47, 0x3c, 0xd4, 0x4c, 0xc4, 0xa0, 0x8a, 0x53,
-	0x92, 0x8c, 0x43, 0xbf, 0xd9, 0x2b, 0xd0, 0xa0, 0x69, 0x26, 0x69, 0x1c, 0x84, 0x03, 0x6a, 0xac,
-	0xee, 0x01, 0x42, 0x47, 0x84, 0xb0, 0x16, 0xcc, 0xf8, 0xa3, 0x94, 0x76, 0x70, 0xc6, 0xc3, 0x9f,
-	0xc8, 0x60, 0x63, 0x7f, 0x3a, 0x42, 0x5e, 0xd4, 0xbb, 0xd6, 0xf4, 0x1a, 0x12, 0xdb, 0xc7, 0x6d,
-	0xbb, 0x03, 0x2b, 0x26, 0x89, 0x6a, 0xbd, 0x46, 0xad, 0x2f, 0x1b, 0x94, 0xb2, 0x93, 0x5b, 0xb0,
-	0xa4, 0xe8, 0x63, 0x31, 0x58, 0xda, 0xc7, 0xba, 0xb7, 0x28, 0x61, 0x35, 0x85, 0xdb, 0xd0, 0x3a,
-	0x09, 0x42, 0x7f, 0xd8, 0xed, 0x0d, 0xd3, 0xb3, 0x6e, 0x9f, 0x0f, 0x53, 0x9f, 0x76, 0xb4, 0xe6,
-	0x2d, 0x12, 0xbe, 0x33, 0x4c, 0xcf, 0x76, 0x11, 0x65, 0x6f, 0x40, 0xfd, 0x84, 0xf3, 0x2e, 0xad,
-	0x44, 0x7b, 0xfe, 0xa6, 0x73, 0xbb, 0xb1, 0xb5, 0x24, 0x97, 0x5e, 0xad, 0xae, 0x37, 0x7f, 0x22,
-	0x7f, 0xb9, 0xbf, 0xeb, 0x40, 0x53, 0x2c, 0x95, 0x54, 0xa1, 0xaf, 0xc1, 0x82, 0x1a, 0x11, 0x8f,
-	0xe3, 0x28, 0x96, 0xec, 0x6f, 0x83, 0x6c, 0x03, 0x5a, 0x0a, 0x18, 0xc7, 0x3c, 0x18, 0xf9, 0x03,
-	0x2e, 0xe5, 0xad, 0x80, 0xb3, 0xad, 0xac, 0xc5, 0x38, 0x9a, 0xa4, 0x42, 0x89, 0x35, 0xb6, 0x9a,
-	0x72, 0x50, 0x1e, 0x62, 0x9e, 0x4d, 0xe2, 0x7e, 0xcb, 0x01, 0x86, 0xc3, 0x7a, 0x12, 0x89, 0x6a,
-	0xb9, 0x0a, 0xf9, 0x1d, 0x70, 0x5e, 0x78, 0x07, 0x2a, 0x17, 0xed, 0xc0, 0x6b, 0x30, 0x4b, 0x5d,
-	0xa2, 0xac, 0xce, 0x14, 0x86, 0x25, 0xeb, 0xdc, 0xef, 0x38, 0xd0, 0x44, 0xcd, 0x11, 0xf2, 0xe1,
-	0x61, 0x14, 0x84, 0x29, 0xbb, 0x0b, 0xec, 0x64, 0x12, 0xf6, 0x83, 0x70, 0xd0, 0x4d, 0x3f, 0x0c,
-	0xfa, 0xdd, 0xe3, 0x29, 0x36, 0x41, 0xe3, 0xd9, 0xbf, 0xe2, 0x95, 0xd4, 0xb1, 0x37, 0xa0, 0x65,
-	0xa1, 0x49, 0x1a, 0x8b, 0x51, 0xed, 0x5f, 0xf1, 0x0a, 0x35, 0x28, 0xff, 0xd1, 0x24, 0x1d, 0x4f,
-	0xd2, 0x6e, 0x10, 0xf6, 0xf9, 0x87, 0xb4, 0x66, 0x0b, 0x9e, 0x85, 0xdd, 0x5b, 0x84, 0xa6, 0xf9,
-	0x9d, 0xfb, 0x59, 0x68, 0x1d, 0xa0, 0x62, 0x08, 0x83, 0x70, 0xb0, 0x2d, 0xa4, 0x17, 0xb5, 0xd5,
-	0x78, 0x72, 0xfc, 0x94, 0x4f, 0xe5, 0x3e, 0xca, 0x12, 0x8a, 0xc4, 0x69, 0x94, 0xa4, 0x72, 0x5d,
-	0xe8, 0xb7, 0xfb, 0xcf, 0x0e, 0x2c, 0xe1, 0xa2, 0xbf, 0xe7, 0x87, 0x53, 0xb5, 0xe2, 0x07, 0xd0,
-	0xc4, 0xa6, 0x9e, 0x44, 0xdb, 0x42, 0xe7, 0x09, 0x59, 0xbe, 0x2d, 0x17, 0x29, 0x47, 0x7d, 0xc7,
-	0x24, 0x45, 0x33, 0x3d, 0xf5, 0xac, 0xaf, 0x51, 0xe8, 0x52, 0x3f, 0x1e, 0xf0, 0x94, 0xb4, 0xa1,
-	0xd4, 0x8e, 0x20, 0xa0, 0x9d, 0x28, 0x3c, 0x61, 0x37, 0xa1, 0x99, 0xf8, 0x69, 0x77, 0xcc, 0x63,
-	0x5a, 0x35, 0x12, 0x9c, 0x19, 0x0f, 0x12, 0x3f, 0x3d, 0xe4, 0xf1, 0xbd, 0x69, 0xca, 0x3b, 0x9f,
-	0x83, 0xe5, 0x42, 0x2f, 0x28, 0xab, 0xd9, 0x14, 0xf1, 0x27, 0x5b, 0x85, 0xda, 0x99, 0x3f, 0x9c,
-	0x70, 0xa9, 0xa4, 0x45, 0xe1, 0x9d, 0xca, 0xdb, 0x8e, 0xfb, 0x3a, 0xb4, 0xb2, 0x61, 0x4b, 0xa6,
-	0x67, 0x50, 0xc5, 0x15, 0x94, 0x0d, 0xd0, 0x6f, 0xf7, 0x97, 0x1d, 0x41, 0xb8, 0x13, 0x05, 0x5a,
-	0xe1, 0x21, 0x21, 0xea, 0x45, 0x45, 0x88, 0xbf, 0x2f, 0x34, 0x08, 0x3f, 0xfe, 0x64, 0xdd, 0x5b,
-	0xb0, 0x6c, 0x0c, 0xe1, 0x39, 0x83, 0xfd, 0x96, 0x03, 0xcb, 0x8f, 0xf8, 0xb9, 0xdc, 0x75, 0x35,
-	0xda, 0xb7, 0xa1, 0x9a, 0x4e, 0xc7, 0xc2, 0xc9, 0x5a, 0xdc, 0x7a, 0x4d, 0x6e, 0x5a, 0x81, 0xee,
-	0x8e, 0x2c, 0x3e, 0x99, 0x8e, 0xb9, 0x47, 0x5f, 0xb8, 0x9f, 0x85, 0x86, 0x01, 0xb2, 0xab, 0xb0,
-	0xf2, 0xc1, 0xc3, 0x27, 0x8f, 0xf6, 0x8e, 0x8e, 0xba, 0x87, 0xef, 0xdf, 0xfb, 0xc2, 0xde, 0x97,
-	0xbb, 0xfb, 0xdb, 0x47, 0xfb, 0xad, 0x2b, 0x6c, 0x1d, 0xd8, 0xa3, 0xbd, 0xa3, 0x27, 0x7b, 0xbb,
-	0x16, 0xee, 0xb8, 0x77, 0x80, 0x99, 0xdd, 0xc8, 0x91, 0xb7, 0x61, 0x4e, 0x5a, 0x15, 0x65, 0x54,
-	0x65, 0xd1, 0x7d, 0x1d, 0xd8, 0x51, 0x30, 0x08, 0xdf, 0xe3, 0x49, 0xe2, 0x0f, 0xb4, 0xb8, 0xb7,
-	0x60, 0x66, 0x94, 0x0c, 0xa4, 0x94, 0xe3, 0x4f, 0xf7, 0x93, 0xb0, 0x62, 0xd1, 0xc9, 0x86, 0xaf,
-	0x43, 0x3d, 0x09, 0x06, 0xa1, 0x9f, 0x4e, 0x62, 0x2e, 0x9b, 0xce, 0x00, 0xf7, 0x3e, 0xac, 0x7e,
-	0x89, 0xc7, 0xc1, 0xc9, 0xf4, 0xb2, 0xe6, 0xed, 0x76, 0x2a, 0xf9, 0x76, 0xf6, 0x60, 0x2d, 0xd7,
-	0x8e, 0xec, 0x5e, 0x30, 0x9b, 0xdc, 0x92, 0x79, 0x4f, 0x14, 0x0c, 0xd1, 0xab, 0x98, 0xa2, 0xe7,
-	0xbe, 0x0f, 0x6c, 0x27, 0x0a, 0x43, 0xde, 0x4b, 0x0f, 0x39, 0x8f, 0x33, 0xef, 0x38, 0xe3, 0xac,
-	0xc6, 0xd6, 0x55, 0xb9, 0x57, 0x79, 0x79, 0x96, 0x2c, 0xc7, 0xa0, 0x3a, 0xe6, 0xf1, 0x88, 0x1a,
-	0x9e, 0xf7, 0xe8, 0xb7, 0xbb, 0x06, 0x2b, 0x56, 0xb3, 0xd2, 0xb1, 0x79, 0x13, 0xd6, 0x76, 0x83,
-	0xa4, 0x57, 0xec, 0xb0, 0x0d, 0x73, 0xe3, 0xc9, 0x71, 0x37, 0x93, 0x1b, 0x55, 0x44, 0x7b, 0x9f,
-	0xff, 0x44, 0x36, 0xf6, 0xeb, 0x0e, 0x54, 0xf7, 0x9f, 0x1c, 0xec, 0xb0, 0x0e, 0xcc, 0x07, 0x61,
-	0x2f, 0x1a, 0xa1, 0x6a, 0x15, 0x93, 0xd6, 0xe5, 0x0b, 0xe5, 0xe1, 0x3a, 0xd4, 0x49, 0x23, 0xa3,
-	0x0b, 0x23, 0x1d, 0xd9, 0x0c, 0x40, 0xf7, 0x89, 0x7f, 0x38, 0x0e, 0x62, 0xf2, 0x8f, 0x94, 0xd7,
-	0x53, 0x25, 0xad, 0x57, 0xac, 0x70, 0xff, 0xa7, 0x0a, 0x73, 0x52, 0x1f, 0x53, 0x7f, 0xbd, 0x34,
-	0x38, 0xe3, 0x72, 0x24, 0xb2, 0x84, 0x96, 0x2c, 0xe6, 0xa3, 0x28, 0xe5, 0x5d, 0x6b, 0x1b, 0x6c,
-	0x10, 0xa9, 0x7a, 0xa2, 0xa1, 0xee, 0x18, 0x35, 0x3b, 0x8d, 0xac, 0xee, 0xd9, 0x20, 0x2e, 0x16,
-	0x02, 0xdd, 0xa0, 0x4f, 0x63, 0xaa, 0x7a, 0xaa, 0x88, 0x2b, 0xd1, 0xf3, 0xc7, 0x7e, 0x2f, 0x48,
-	0xa7, 0x52, 0x80, 0x75, 0x19, 0xdb, 0x1e, 0x46, 0x3d, 0x7f, 0xd8, 0x3d, 0xf6, 0x87, 0x7e, 0xd8,
-	0xe3, 0xd2, 0x47, 0xb3, 0x41, 0x74, 0xc3, 0xe4, 0x90, 0x14, 0x99, 0x70, 0xd5, 0x72, 0x28, 0xba,
-	0x73, 0xbd, 0x68, 0x34, 0x0a, 0x52, 0xf4, 0xde, 0xc8, 0xb2, 0xcf, 0x78, 0x06, 0x42, 0x33, 0x11,
-	0xa5, 0x73, 0xb1, 0x7a, 0x75, 0xd1, 0x9b, 0x05, 0x62, 0x2b, 0xe8, 0x1e, 0xa0, 0xd2, 0x79, 0x7a,
-	0xde, 0x06, 0xd1, 0x4a, 0x86, 0xe0, 0x3e, 0x4c, 0xc2, 0x84, 0xa7, 0xe9, 0x90, 0xf7, 0xf5, 0x80,
-	0x1a, 0x44, 0x56, 0xac, 0x60, 0x77, 0x61, 0x45, 0x38, 0x94, 0x89, 0x9f, 0x46, 0xc9, 0x69, 0x90,
-	0x74, 0x13, 0x74, 0xcd, 0x9a, 0x44, 0x5f, 0x56, 0xc5, 0xde, 0x86, 0xab, 0x39, 0x38, 0xe6, 0x3d,
-	0x1e, 0x9c, 0xf1, 0x7e, 0x7b, 0x81, 0xbe, 0xba, 0xa8, 0x9a, 0xdd, 0x84, 0x06, 0xfa, 0xd1, 0x93,
-	0x71, 0xdf, 0x47, 0x5b, 0xbb, 0x48, 0xfb, 0x60, 0x42, 0xec, 0x4d, 0x58, 0x18, 0x73, 0x61, 0x10,
-	0x4f, 0xd3, 0x61, 0x2f, 0x69, 0x2f, 0x91, 0xb5, 0x6a, 0x48, 0x61, 0x42, 0xce, 0xf5, 0x6c, 0x0a,
-	0x64, 0xca, 0x5e, 0x42, 0x0e, 0x95, 0x3f, 0x6d, 0xb7, 0x88, 0xdd, 0x32, 0x80, 0x64, 0x24, 0x0e,
-	0xce, 0xfc, 0x94, 0xb7, 0x97, 0x89, 0xb7, 0x54, 0xd1, 0xfd, 0x43, 0x07, 0x56, 0x0e, 0x82, 0x24,
-	0x95, 0x4c, 0xa8, 0x55, 0xee, 0x2b, 0xd0, 0x10, 0xec, 0xd7, 0x8d, 0xc2, 0xe1, 0x54, 0x72, 0x24,
-	0x08, 0xe8, 0x71, 0x38, 0x9c, 0xb2, 0x8f, 0xc1, 0x42, 0x10, 0x9a, 0x24, 0x42, 0x86, 0x9b, 0x0a,
-	0x24, 0xa2, 0x57, 0xa0, 0x31, 0x9e, 0x1c, 0x0f, 0x83, 0x9e, 0x20, 0x99, 0x11, 0xad, 0x08, 0x88,
-	0x08, 0xd0, 0x11, 0x12, 0x23, 0x11, 0x14, 0x55, 0xa2, 0x68, 0x48, 0x0c, 0x49, 0xdc, 0x7b, 0xb0,
-	0x6a, 0x0f, 0x50, 0x2a, 0xab, 0x0d, 0x98, 0x97, 0xbc, 0x9d, 0xb4, 0x1b, 0xb4, 0x3e, 0x8b, 0x72,
-	0x7d, 0x24, 0xa9, 0xa7, 0xeb, 0xdd, 0xef, 0x55, 0x61, 0x45, 0xa2, 0x3b, 0xc3, 0x28, 0xe1, 0x47,
-	0x93, 0xd1, 0xc8, 0x8f, 0x4b, 0x84, 0xc6, 0xb9, 0x44, 0x68, 0x2a, 0xb6, 0xd0, 0x20, 0x2b, 0x9f,
-	0xfa, 0x41, 0x28, 0xbc, 0x38, 0x21, 0x71, 0x06, 0xc2, 0x6e, 0xc3, 0x52, 0x6f, 0x18, 0x25, 0xc2,
-	0xb3, 0x31, 0x8f, 0x48, 0x79, 0xb8, 0x28, 0xe4, 0xb5, 0x32, 0x21, 0x37, 0x85, 0x74, 0x36, 0x27,
-	0xa4, 0x2e, 0x34, 0xb1, 0x51, 0xae, 0x74, 0xce, 0x9c, 0xf0, 0xb4, 0x4c, 0x0c, 0xc7, 0x93, 0x17,
-	0x09, 0x21, 0x7f, 0x4b, 0x65, 0x02, 0x81, 0x27, 0x30, 0xd4, 0x69, 0x06, 0x75, 0x5d, 0x0a, 0x44,
-	0xb1, 0x8a, 0xdd, 0x07, 0x10, 0x7d, 0x91, 0xa9, 0x06, 0x32, 0xd5, 0xaf, 0xdb, 0x3b, 0x62, 0xae,
-	0xfd, 0x1d, 0x2c, 0x4c, 0x62, 0x4e, 0xc6, 0xda, 0xf8, 0xd2, 0xfd, 0x0d, 0x07, 0x1a, 0x46, 0x1d,
-	0x5b, 0x83, 0xe5, 0x9d, 0xc7, 0x8f, 0x0f, 0xf7, 0xbc, 0xed, 0x27, 0x0f, 0xbf, 0xb4, 0xd7, 0xdd,
-	0x39, 0x78, 0x7c, 0xb4, 0xd7, 0xba, 0x82, 0xf0, 0xc1, 0xe3, 0x9d, 0xed, 0x83, 0xee, 0xfd, 0xc7,
-	0xde, 0x8e, 0x82, 0x1d, 0x34, 0xe4, 0xde, 0xde, 0x7b, 0x8f, 0x9f, 0xec, 0x59, 0x78, 0x85, 0xb5,
-	0xa0, 0x79, 0xcf, 0xdb, 0xdb, 0xde, 0xd9, 0x97, 0xc8, 0x0c, 0x5b, 0x85, 0xd6, 0xfd, 0xf7, 0x1f,
-	0xed, 0x3e, 0x7c, 0xf4, 0xa0, 0xbb, 0xb3, 0xfd, 0x68, 0x67, 0xef, 0x60, 0x6f, 0xb7, 0x55, 0x65,
-	0x0b, 0x50, 0xdf, 0xbe, 0xb7, 0xfd, 0x68, 0xf7, 0xf1, 0xa3, 0xbd, 0xdd, 0x56, 0xcd, 0xfd, 0x47,
-	0x07, 0xd6, 0x68, 0xd4, 0xfd, 0xbc, 0x80, 0xdc, 0x84, 0x46, 0x2f, 0x8a, 0xc6, 0x1c, 0xf5, 0xb9,
-	0x56, 0xd9, 0x26, 0x84, 0xcc, 0x2f, 0x14, 0xe4, 0x49, 0x14, 0xf7, 0xb8, 0x94, 0x0f, 0x20, 0xe8,
-	0x3e, 0x22, 0xc8, 0xfc, 0x72, 0x7b, 0x05, 0x85, 0x10, 0x8f, 0x86, 0xc0, 0x04, 0xc9, 0x3a, 0xcc,
-	0x1e, 0xc7, 0xdc, 0xef, 0x9d, 0x4a, 0xc9, 0x90, 0x25, 0xf6, 0xf1, 0xcc, 0x09, 0xef, 0xe1, 0xea,
-	0x0f, 0x79, 0x9f, 0x38, 0x66, 0xde, 0x5b, 0x92, 0xf8, 0x8e, 0x84, 0x51, 0x33, 0xf8, 0xc7, 0x7e,
-	0xd8, 0x8f, 0x42, 0xde, 0x27, 0xa6, 0x99, 0xf7, 0x32, 0xc0, 0x3d, 0x84, 0xf5, 0xfc, 0xfc, 0xa4,
-	0x7c, 0xbd, 0x65, 0xc8, 0x97, 0xf0, 0x96, 0x3b, 0x17, 0xef, 0xa6, 0x21, 0x6b, 0xff, 0xe6, 0x40,
-	0x15, 0x8d, 0xed, 0xc5, 0x86, 0xd9, 0xf4, 0x9f, 0x66, 0x2c, 0xff, 0x89, 0xc2, 0x09, 0x78, 0xca,
-	0x10, 0xea, 0x57, 0x98, 0x28, 0x03, 0xc9, 0xea, 0x63, 0xde, 0x3b, 0xa3, 0x19, 0xeb, 0x7a, 0x44,
-	0x50, 0x40, 0xd0, 0x15, 0xa5, 0xaf, 0xa5, 0x80, 0xa8, 0xb2, 0xaa, 0xa3, 0x2f, 0xe7, 0xb2, 0x3a,
-	0xfa, 0xae, 0x0d, 0x73, 0x41, 0x78, 0x1c, 0x4d, 0xc2, 0x3e, 0x09, 0xc4, 0xbc, 0xa7, 0x8a, 0xb8,
-	0x7c, 0x63, 0x12, 0xd4, 0x60, 0xa4, 0xd8, 0x3f, 0x03, 0x5c, 0x86, 0x47, 0x95, 0x84, 0x9c, 0x0b,
-	0x1d, 0x4c, 0x78, 0x0b, 0x96, 0x0d, 0x4c, 0xae, 0xe6, 0xab, 0x50, 0x1b, 0x23, 0x20, 0x97, 0x52,
-	0xa9, 0x72, 0xf2, 0x4a, 0x44, 0x8d, 0xdb, 0x82, 0xc5, 0x07, 0x3c, 0x7d, 0x18, 0x9e, 0x44, 0xaa,
-	0xa5, 0x1f, 0xcc, 0xc0, 0x92, 0x86, 0x64, 0x43, 0xb7, 0x61, 0x29, 0xe8, 0xf3, 0x30, 0x0d, 0xd2,
-	0x69, 0xd7, 0x3a, 0x11, 0xe5, 0x61, 0xf4, 0xe6, 0xfc, 0x61, 0xe0, 0x27, 0xd2, 0x5f, 0x10, 0x05,
-	0xb6, 0x05, 0xab, 0x68, 0x6a, 0x94, 0xf5, 0xd0, 0x5b, 0x2c, 0x0e, 0x66, 0xa5, 0x75, 0xa8, 0x0c,
-	0x10, 0x97, 0xda, 0x5e, 0x7f, 0x22, 0xbc, 0x9a, 0xb2, 0x2a, 0x5c, 0x35, 0xd1, 0x12, 0x4e, 0xb9,
-	0x26, 0xcc, 0x91, 0x06, 0x0a, 0x41, 0xa1, 0x59, 0xa1, 0xaa, 0xf2, 0x41, 0x21, 0x23, 0xb0, 0x34,
-	0x5f, 0x08, 0x2c, 0xa1, 0x2a, 0x9b, 0x86, 0x3d, 0xde, 0xef, 0xa6, 0x51, 0x97, 0x54, 0x2e, 0xed,
-	0xce, 0xbc, 0x97, 0x87, 0x29, 0x04, 0xc6, 0x93, 0x34, 0xe4, 0x29, 0x69, 0xa5, 0x79, 0x4f, 0x15,
-	0x51, 0xba, 0x88, 0x44, 0x18, 0x90, 0xba, 0x27, 0x4b, 0xe8, 0x96, 0x4e, 0xe2, 0x20, 0x69, 0x37,
-	0x09, 0xa5, 0xdf, 0xec, 0x53, 0xb0, 0x76, 0xcc, 0x93, 0xb4, 0x7b, 0xca, 0xfd, 0x3e, 0x8f, 0x69,
-	0xf7, 0x45, 0xbc, 0x4a, 0x58, 0xfb, 0xf2, 0x4a, 0xec, 0xfb, 0x8c, 0xc7, 0x49, 0x10, 0x85, 0x64,
-	0xe7, 0xeb, 0x9e, 0x2a, 0xba, 0xdf, 0x24, 0xef, 0x59, 0x47, 0xd2, 0xde, 0x27, 0xd3, 0xcf, 0x5e,
-	0x82, 0xba, 0x98, 0x63, 0x72, 0xea, 0x4b, 0x87, 0x7e, 0x9e, 0x80, 0xa3, 0x53, 0x1f, 0xf5, 0x85,
-	0xb5, 0x6c, 0x22, 0x34, 0xd9, 0x20, 0x6c, 0x5f, 0xac, 0xda, 0x6b, 0xb0, 0xa8, 0x62, 0x74, 0x49,
-	0x77, 0xc8, 0x4f, 0x52, 0x75, 0xe0, 0x0e, 0x27, 0x23, 0xec, 0x2e, 0x39, 0xe0, 0x27, 0xa9, 0xfb,
-	0x08, 0x96, 0xa5, 0x0c, 0x3f, 0x1e, 0x73, 0xd5, 0xf5, 0x67, 0xca, 0x6c, 0x61, 0x63, 0x6b, 0xc5,
-	0x16, 0x7a, 0x8a, 0x1a, 0xe4, 0x0c, 0xa4, 0xeb, 0x01, 0x33, 0x75, 0x82, 0x6c, 0x50, 0x1a, 0x24,
-	0x75, 0xac, 0x97, 0xd3, 0xb1, 0x30, 0x5c, 0x9f, 0x64, 0xd2, 0xeb, 0xa1, 0x26, 0x10, 0xfa, 0x51,
-	0x15, 0xdd, 0xef, 0x3a, 0xb0, 0x42, 0xad, 0x29, 0x6b, 0xae, 0xcf, 0x82, 0x2f, 0x3e, 0xcc, 0x66,
-	0xcf, 0x0c, 0x75, 0xac, 0x42, 0xcd, 0xd4, 0xc4, 0xa2, 0xf0, 0xc3, 0x9f, 0x6e, 0xab, 0x85, 0xd3,
-	0xed, 0x0f, 0x1c, 0x58, 0x16, 0xca, 0x30, 0xf5, 0xd3, 0x49, 0x22, 0xa7, 0xff, 0xff, 0x61, 0x41,
-	0x58, 0x35, 0x29, 0x4e, 0x72, 0xa0, 0xab, 0x5a, 0xf2, 0x09, 0x15, 0xc4, 0xfb, 0x57, 0x3c, 0x9b,
-	0x98, 0x7d, 0x0e, 0x9a, 0x66, 0xa0, 0x95, 0xc6, 0xdc, 0xd8, 0xba, 0xa6, 0x66, 0x59, 0xe0, 0x9c,
-	0xfd, 0x2b, 0x9e, 0xf5, 0x01, 0x7b, 0x97, 0x5c, 0x93, 0xb0, 0x4b, 0xcd, 0xca, 0x50, 0xd5, 0xb5,
-	0x12, 0x05, 0xae, 0x3f, 0x37, 0xc8, 0xef, 0xcd, 0xc3, 0xac, 0xf0, 0x45, 0xdd, 0x07, 0xb0, 0x60,
-	0x8d, 0xd4, 0x3a, 0xb5, 0x37, 0xc5, 0xa9, 0xbd, 0x10, 0xe4, 0xa9, 0x14, 0x83, 0x3c, 0xee, 0x9f,
-	0xcd, 0x00, 0x43, 0x6e, 0xcb, 0x6d, 0x27, 0x3a, 0xc3, 0x51, 0xdf, 0x3a, 0xda, 0x34, 0x3d, 0x13,
-	0x62, 0x77, 0x80, 0x19, 0x45, 0x15, 0x07, 0x13, 0x76, 0xa3, 0xa4, 0x06, 0x15, 0x9c, 0x34, 0xbb,
-	0xd2, 0x40, 0xca, 0x43, 0x9c, 0xd8, 0xb7, 0xd2, 0x3a, 0x34, 0x0d, 0xe3, 0x49, 0x72, 0x8a, 0xce,
-	0xba, 0x3a, 0xfc, 0xa8, 0x72, 0x9e, 0x41, 0x66, 0x2f, 0x65, 0x90, 0xb9, 0x3c, 0x83, 0x98, 0xee,
-	0xf7, 0xbc, 0xe5, 0x7e, 0xa3, 0xdb, 0x37, 0x42, 0x67, 0x31, 0x1d, 0xf6, 0xba, 0x23, 0xec, 0x5d,
-	0x9e, 0x75, 0x2c, 0x90, 0x6d, 0x40, 0x4b, 0x3a, 0x0a, 0x99, 0x8f, 0x0f, 0xb4, 0xc6, 0x05, 0x1c,
-	0x35, 0x2f, 0x7e, 0x4c, 0x1a, 0x80, 0xce, 0x3b, 0x35, 0x2f, 0x03, 0xf0, 0x54, 0x94, 0x20, 0x8b,
-	0x75, 0x27, 0xa1, 0xe4, 0x16, 0xde, 0xa7, 0x53, 0xce, 0xbc, 0x57, 0xac, 0x70, 0xbf, 0xef, 0x40,
-	0x0b, 0xf7, 0xcc, 0xe2, 0xeb, 0x77, 0x80, 0xc4, 0xea, 0x05, 0xd9, 0xda, 0xa2, 0xfd, 0xf1, 0xb9,
-	0xfa, 0x6d, 0xa8, 0x53, 0x83, 0xd1, 0x98, 0x87, 0x92, 0xa9, 0xdb, 0x36, 0x53, 0x67, 0x1a, 0x6d,
-	0xff, 0x8a, 0x97, 0x11, 0x1b, 0x2c, 0xfd, 0xf7, 0x0e, 0x34, 0xe4, 0x30, 0x7f, 0xe4, 0x18, 0x40,
-	0x07, 0xe6, 0x91, 0xbb, 0x8d, 0x83, 0xb6, 0x2e, 0xa3, 0x65, 0x1a, 0xf9, 0xe9, 0x24, 0x46, 0x53,
-	0x6c, 0x9d, 0xff, 0xf3, 0x30, 0xda, 0x55, 0x52, 0xde, 0x49, 0x37, 0x0d, 0x86, 0x5d, 0x55, 0x2b,
-	0xef, 0x48, 0xca, 0xaa, 0x50, 0x87, 0x25, 0xa9, 0x3f, 0xe0, 0xd2, 0x64, 0x8a, 0x82, 0xdb, 0x86,
-	0x75, 0x39, 0xa1, 0x9c, 0x97, 0xea, 0xfe, 0x55, 0x13, 0xae, 0x16, 0xaa, 0xf4, 0x25, 0xa3, 0x3c,
-	0xd8, 0x0e, 0x83, 0xd1, 0x71, 0xa4, 0x5d, 0x7c, 0xc7, 0x3c, 0xf3, 0x5a, 0x55, 0x6c, 0x00, 0x6b,
-	0xca, 0x37, 0xc0, 0x35, 0xcd, 0x3c, 0x81, 0x0a, 0x39, 0x35, 0x6f, 0xda, 0x3c, 0x90, 0xef, 0x50,
-	0xe1, 0xa6, 0x16, 0x28, 0x6f, 0x8f, 0x9d, 0x42, 0x5b, 0x3b, 0x21, 0xd2, 0x5c, 0x18, 0x8e, 0x0a,
-	0xf6, 0xf5, 0xc6, 0x25, 0x7d, 0x59, 0x4e, 0xad, 0x77, 0x61, 0x6b, 0x6c, 0x0a, 0x37, 0x54, 0x1d,
-	0xd9, 0x83, 0x62, 0x7f, 0xd5, 0x17, 0x9a, 0x1b, 0xb9, 0xeb, 0x76, 0xa7, 0x97, 0x34, 0xcc, 0xbe,
-	0x0e, 0xeb, 0xe7, 0x7e, 0x90, 0xaa, 0x61, 0x19, 0x8e, 0x55, 0x8d, 0xba, 0xdc, 0xba, 0xa4, 0xcb,
-	0x0f, 0xc4, 0xc7, 0x96, 0x91, 0xbc, 0xa0, 0xc5, 0xce, 0xdf, 0x3a, 0xb0, 0x68, 0xb7, 0x83, 0x6c,
-	0x2a, 0x95, 0x87, 0x52, 0xa2, 0xca, 0x91, 0xcc, 0xc1, 0xc5, 0x53, 0x72, 0xa5, 0xec, 0x94, 0x6c,
-	0x9e, 0x4d, 0x67, 0x2e, 0x0b, 0x20, 0x55, 0x5f, 0x2c, 0x80, 0x54, 0x2b, 0x0b, 0x20, 0x75, 0xfe,
-	0xcb, 0x01, 0x56, 0xe4, 0x25, 0xf6, 0x40, 0x1c, 0xd3, 0x43, 0x3e, 0x94, 0x3a, 0xe9, 0x13, 0x2f,
-	0xc6, 0x8f, 0x6a, 0xed, 0xd4, 0xd7, 0x28, 0x18, 0xa6, 0xd2, 0x31, 0xdd, 0xad, 0x05, 0xaf, 0xac,
-	0x2a, 0x17, 0xd2, 0xaa, 0x5e, 0x1e, 0xd2, 0xaa, 0x5d, 0x1e, 0xd2, 0x9a, 0xcd, 0x87, 0xb4, 0x3a,
-	0xbf, 0xe6, 0xc0, 0x4a, 0xc9, 0xa6, 0xff, 0xe4, 0x26, 0x8e, 0xdb, 0x64, 0xe9, 0x82, 0x8a, 0xdc,
-	0x26, 0x13, 0xec, 0xfc, 0x22, 0x2c, 0x58, 0x8c, 0xfe, 0x93, 0xeb, 0x3f, 0xef, 0x31, 0x0a, 0x3e,
-	0xb3, 0xb0, 0xce, 0xbf, 0x57, 0x80, 0x15, 0x85, 0xed, 0xff, 0x74, 0x0c, 0xc5, 0x75, 0x9a, 0x29,
-	0x59, 0xa7, 0x9f, 0xaa, 0x1d, 0x78, 0x03, 0x96, 0x65, 0x46, 0x82, 0x11, 0x9c, 0x11, 0x1c, 0x53,
-	0xac, 0x40, 0x9f, 0xd9, 0x8e, 0x27, 0xce, 0x5b, 0x37, 0xd9, 0x86, 0x31, 0xcc, 0x85, 0x15, 0xdd,
-	0x75, 0x58, 0x15, 0x19, 0x0e, 0xf7, 0x44, 0x53, 0xca, 0xae, 0xfc, 0x81, 0x03, 0x6b, 0xb9, 0x8a,
-	0xec, 0xde, 0x55, 0x98, 0x0e, 0xdb, 0x9e, 0xd8, 0x20, 0x8e, 0x5f, 0xbb, 0x19, 0x39, 0x6e, 0x2b,
-	0x56, 0xe0, 0xfa, 0x18, 0x6e, 0x49, 0x6e, 0xd5, 0xcb, 0xaa, 0xdc, 0xab, 0x22, 0x0f, 0x23, 0xe4,
-	0xc3, 0xdc, 0xc0, 0x4f, 0x44, 0xe6, 0x84, 0x59, 0x91, 0x5d, 0xea, 0xd8, 0x43, 0x56, 0x45, 0xf4,
-	0x28, 0x2d, 0x33, 0x65, 0x8f, 0xb7, 0xb4, 0xce, 0xfd, 0x9e, 0x03, 0xec, 0x8b, 0x13, 0x1e, 0x4f,
-	0xe9, 0xfe, 0x55, 0x47, 0x8d, 0xae, 0xe6, 0x63, 0x22, 0xb3, 0xe3, 0xc9, 0xf1, 0x17, 0xf8, 0x54,
-	0xdd, 0xd2, 0x57, 0xb2, 0x5b, 0xfa, 0x97, 0x01, 0xf0, 0x28, 0xa7, 0x2f, 0x75, 0xc9, 0x93, 0x0b,
-	0x27, 0x23, 0xd1, 0x60, 0xe9, 0x45, 0x7a, 0xf5, 0xf2, 0x8b, 0xf4, 0xda, 0x65, 0x17, 0xe9, 0xef,
-	0xc2, 0x8a, 0x35, 0x6e, 0xbd, 0xad, 0xea, 0x7a, 0xd9, 0x79, 0xce, 0xf5, 0xf2, 0x7f, 0x38, 0x30,
-	0xb3, 0x1f, 0x8d, 0xcd, 0x88, 0xa9, 0x63, 0x47, 0x4c, 0xa5, 0x2d, 0xe9, 0x6a, 0x53, 0x21, 0x55,
-	0x8c, 0x05, 0xb2, 0x0d, 0x58, 0xf4, 0x47, 0x29, 0x1e, 0xe1, 0x4f, 0xa2, 0xf8, 0xdc, 0x8f, 0xfb,
-	0x62, 0xaf, 0xef, 0x55, 0xda, 0x8e, 0x97, 0xab, 0x61, 0xab, 0x30, 0xa3, 0x95, 0x2e, 0x11, 0x60,
-	0x11, 0x1d, 0x37, 0xba, 0x6d, 0x99, 0xca, 0xe8, 0x83, 0x2c, 0x21, 0x2b, 0xd9, 0xdf, 0x0b, 0xb7,
-	0x5b, 0x88, 0x4e, 0x59, 0x15, 0xda, 0x35, 0x5c, 0x3e, 0x22, 0x93, 0x61, 0x23, 0x55, 0x76, 0xff,
-	0xd5, 0x81, 0x1a, 0xad, 0x00, 0x0a, 0xbb, 0xe0, 0x70, 0x1d, 0x1a, 0xa5, 0x99, 0x2f, 0x78, 0x79,
-	0x98, 0xb9, 0x56, 0x36, 0x4b, 0x45, 0x0f, 0xdb, 0xcc, 0x68, 0xb9, 0x09, 0x75, 0x51, 0xd2, 0x99,
-	0x1b, 0x44, 0x92, 0x81, 0xec, 0x06, 0x54, 0x4f, 0xa3, 0xb1, 0xf2, 0x4e, 0x40, 0xdd, 0x0c, 0x44,
-	0x63, 0x8f, 0xf0, 0x6c, 0x3c, 0xd8, 0x9e, 0x18, 0xbc, 0xb0, 0x39, 0x79, 0x18, 0xad, 0xae, 0x6e,
-	0xd6, 0x5c, 0x8c, 0x1c, 0xea, 0x6e, 0xc0, 0xd2, 0xa3, 0xa8, 0xcf, 0x8d, 0xf8, 0xd4, 0x85, 0xdc,
-	0xec, 0xfe, 0x92, 0x03, 0xf3, 0x8a, 0x98, 0xdd, 0x86, 0x2a, 0xba, 0x12, 0xb9, 0x83, 0x82, 0xbe,
-	0x11, 0x44, 0x3a, 0x8f, 0x28, 0x50, 0xf7, 0x52, 0xf4, 0x22, 0x73, 0x2b, 0x55, 0xec, 0x22, 0xf3,
-	0x9a, 0xf4, 0x70, 0x73, 0xce, 0x46, 0x0e, 0x75, 0xff, 0xd4, 0x81, 0x05, 0xab, 0x0f, 0x3c, 0x6a,
-	0x0e, 0xfd, 0x24, 0x95, 0xb7, 0x2c, 0x72, 0x7b, 0x4c, 0xc8, 0x8c, 0x58, 0x56, 0xec, 0x88, 0xa5,
-	0x8e, 0xa5, 0xcd, 0x98, 0xb1, 0xb4, 0xbb, 0x50, 0xcf, 0x72, 0x8e, 0xaa, 0x96, 0x4e, 0xc5, 0x1e,
-	0xd5, 0x5d, 0x67, 0x46, 0x84, 0xed, 0xf4, 0xa2, 0x61, 0x14, 0xcb, 0xf0, 0xbe, 0x28, 0xb8, 0xef,
-	0x42, 0xc3, 0xa0, 0xc7, 0x61, 0x84, 0x3c, 0x3d, 0x8f, 0xe2, 0xa7, 0x2a, 0x70, 0x2a, 0x8b, 0xfa,
-	0xda, 0xbe, 0x92, 0x5d, 0xdb, 0xbb, 0x7f, 0xe3, 0xc0, 0x02, 0xf2, 0x60, 0x10, 0x0e, 0x0e, 0xa3,
-	0x61, 0xd0, 0x9b, 0xd2, 0xde, 0x2b, 0x76, 0x93, 0x9a, 0x41, 0xf1, 0xa2, 0x0d, 0x23, 0x6f, 0xab,
-	0x93, 0xa6, 0x14, 0x44, 0x5d, 0x46, 0x49, 0x45, 0x3e, 0x3f, 0xf6, 0x13, 0xc9, 0xfc, 0xd2, 0xc8,
-	0x59, 0x20, 0xca, 0x13, 0x02, 0xb1, 0x9f, 0xf2, 0xee, 0x28, 0x18, 0x0e, 0x03, 0x41, 0x2b, 0x5c,
-	0xa0, 0xb2, 0x2a, 0xec, 0xb3, 0x1f, 0x24, 0xfe, 0x71, 0x16, 0xb2, 0xd6, 0x65, 0xf7, 0x2f, 0x2a,
-	0xd0, 0x90, 0xea, 0x79, 0xaf, 0x3f, 0xe0, 0xf2, 0x7e, 0x85, 0x9c, 0x4c, 0xad, 0x4a, 0x0c, 0x44,
-	0xd5, 0x5b, 0x6e, 0xa9, 0x81, 0xe4, 0xb7, 0x7c, 0xa6, 0xb8, 0xe5, 0xd7, 0xa1, 0x8e, 0xac, 0xf7,
-	0x26, 0xf9, 0xbf, 0xe2, 0x6e, 0x26, 0x03, 0x54, 0xed, 0x16, 0xd5, 0xd6, 0xb2, 0x5a, 0x02, 0x9e,
-	0x7b, 0x1b, 0xf3, 0x36, 0x34, 0x65, 0x33, 0xb4, 0x27, 0xa4, 0x39, 0x32, 0xe6, 0xb7, 0xf6, 0xcb,
-	0xb3, 0x28, 0xd5, 0x97, 0x5b, 0xea, 0xcb, 0xf9, 0xcb, 0xbe, 0x54, 0x94, 0xee, 0x03, 0x7d, 0xc9,
-	0xf5, 0x20, 0xf6, 0xc7, 0xa7, 0x4a, 0x4a, 0xef, 0xc2, 0x4a, 0x10, 0xf6, 0x86, 0x93, 0x3e, 0xef,
-	0x4e, 0x42, 0x3f, 0x0c, 0xa3, 0x49, 0xd8, 0xe3, 0xea, 0x8e, 0xbf, 0xac, 0xca, 0xed, 0xeb, 0x24,
-	0x21, 0x6a, 0x88, 0x6d, 0x40, 0x0d, 0x3b, 0x52, 0xba, 0xbf, 0x5c, 0x84, 0x05, 0x09, 0xbb, 0x0d,
-	0x35, 0xde, 0x1f, 0x70, 0x75, 0x26, 0x64, 0xf6, 0xe9, 0x1c, 0x77, 0xd5, 0x13, 0x04, 0xa8, 0x50,
-	0x10, 0xcd, 0x29, 0x14, 0xdb, 0x6e, 0xcc, 0x62, 0xf1, 0x61, 0xdf, 0x5d, 0x05, 0xf6, 0x48, 0xc8,
-	0x80, 0x19, 0x1f, 0xff, 0xd5, 0x19, 0x68, 0x18, 0x30, 0xea, 0x86, 0x01, 0x0e, 0xb8, 0xdb, 0x0f,
-	0xfc, 0x11, 0x4f, 0x79, 0x2c, 0xf9, 0x3e, 0x87, 0x22, 0x9d, 0x7f, 0x36, 0xe8, 0x46, 0x93, 0xb4,
-	0xdb, 0xe7, 0x83, 0x98, 0x0b, 0x53, 0x8e, 0xa6, 0xc5, 0x42, 0x91, 0x6e, 0xe4, 0x7f, 0x68, 0xd2,
-	0x09, 0x0e, 0xca, 0xa1, 0x2a, 0xda, 0x2d, 0xd6, 0xa8, 0x9a, 0x45, 0xbb, 0xc5, 0x8a, 0xe4, 0xb5,
-	0x5a, 0xad, 0x44, 0xab, 0xbd, 0x05, 0xeb, 0x42, 0x7f, 0x49, 0x49, 0xef, 0xe6, 0x18, 0xeb, 0x82,
-	0x5a, 0xb6, 0x01, 0x2d, 0x1c, 0xb3, 0x12, 0x89, 0x24, 0xf8, 0xa6, 0x88, 0x3f, 0x39, 0x5e, 0x01,
-	0x47, 0x5a, 0x0a, 0x04, 0x99, 0xb4, 0xe2, 0xf6, 0xaf, 0x80, 0x13, 0xad, 0xff, 0xa1, 0x4d, 0x5b,
-	0x97, 0xb4, 0x39, 0xdc, 0x5d, 0x80, 0xc6, 0x51, 0x1a, 0x8d, 0xd5, 0xa6, 0x2c, 0x42, 0x53, 0x14,
-	0x65, 0xae, 0xc5, 0x4b, 0x70, 0x8d, 0xb8, 0xe8, 0x49, 0x34, 0x8e, 0x86, 0xd1, 0x60, 0x7a, 0x34,
-	0x39, 0x4e, 0x7a, 0x71, 0x30, 0xc6, 0xf3, 0x93, 0xfb, 0x77, 0x0e, 0xac, 0x58, 0xb5, 0x32, 0xc8,
-	0xf4, 0x29, 0x21, 0x04, 0xfa, 0x92, 0x5c, 0x30, 0xde, 0xb2, 0xa1, 0x5c, 0x05, 0xa1, 0x08, 0x15,
-	0xbe, 0x2f, 0xef, 0xcd, 0xb7, 0x61, 0x49, 0x8d, 0x4c, 0x7d, 0x28, 0xb8, 0xb0, 0x5d, 0xe4, 0x42,
-	0xf9, 0xfd, 0xa2, 0xfc, 0x40, 0x35, 0xf1, 0x33, 0xf2, 0x16, 0xb5, 0x4f, 0x73, 0x54, 0xd1, 0x06,
-	0x7d, 0xf3, 0x65, 0x9e, 0x39, 0xd4, 0x08, 0x7a, 0x1a, 0x4c, 0xdc, 0xdf, 0x74, 0x00, 0xb2, 0xd1,
-	0xd1, 0xdd, 0x9b, 0x36, 0x10, 0x22, 0x79, 0xdb, 0x30, 0x06, 0xaf, 0x42, 0x53, 0xdf, 0xd9, 0x64,
-	0x36, 0xa7, 0xa1, 0x30, 0x74, 0x0b, 0x6f, 0xc1, 0xd2, 0x60, 0x18, 0x1d, 0x93, 0xc1, 0xa6, 0xe4,
-	0x9d, 0x44, 0x66, 0x9c, 0x2c, 0x0a, 0xf8, 0xbe, 0x44, 0x33, 0x03, 0x55, 0x35, 0x0c, 0x94, 0xfb,
-	0xad, 0x8a, 0x8e, 0xf4, 0x67, 0x73, 0xbe, 0x50, 0xca, 0xd8, 0x56, 0x41, 0x9d, 0x5e, 0x10, 0x58,
-	0xa7, 0xb8, 0xda, 0xe1, 0xa5, 0xc7, 0xfe, 0x77, 0x61, 0x31, 0x16, 0xfa, 0x4a, 0x29, 0xb3, 0xea,
-	0x73, 0x94, 0xd9, 0x42, 0x6c, 0x59, 0xb1, 0x8f, 0x43, 0xcb, 0xef, 0x9f, 0xf1, 0x38, 0x0d, 0xe8,
-	0xe0, 0x45, 0x2e, 0x84, 0x50, 0xc1, 0x4b, 0x06, 0x4e, 0x96, 0xfd, 0x16, 0x2c, 0xc9, 0x2c, 0x1f,
-	0x4d, 0x29, 0xb3, 0x4f, 0x33, 0x18, 0x09, 0xdd, 0x3f, 0x56, 0x97, 0x0a, 0xf6, 0x1e, 0x5e, 0xbc,
-	0x22, 0xe6, 0xec, 0x2a, 0xb9, 0xd9, 0x7d, 0x4c, 0x06, 0xf8, 0xfb, 0xea, 0x74, 0x37, 0x63, 0xdc,
-	0xb8, 0xf7, 0xe5, 0x85, 0x8c, 0xbd, 0xa4, 0xd5, 0x17, 0x59, 0x52, 0xf7, 0xfb, 0x0e, 0xcc, 0xed,
-	0x47, 0xe3, 0x7d, 0x99, 0x7b, 0x40, 0x82, 0xa0, 0xf3, 0xe4, 0x54, 0xf1, 0x39, 0x59, 0x09, 0xa5,
-	0x96, 0x7b, 0x21, 0x6f, 0xb9, 0x7f, 0x16, 0x5e, 0xa2, 0xd8, 0x42, 0x1c, 0x8d, 0xa3, 0x18, 0x85,
-	0xd1, 0x1f, 0x0a, 0x33, 0x1d, 0x85, 0xe9, 0xa9, 0x52, 0x63, 0xcf, 0x23, 0xa1, 0x43, 0x1c, 0x1e,
-	0x3e, 0x84, 0x6b, 0x2d, 0x3d, 0x0d, 0xa1, 0xdd, 0x8a, 0x15, 0xee, 0x67, 0xa0, 0x4e, 0xae, 0x32,
-	0x4d, 0xeb, 0x0d, 0xa8, 0x9f, 0x46, 0xe3, 0xee, 0x69, 0x10, 0xa6, 0x4a, 0xb8, 0x17, 0x33, 0x1f,
-	0x76, 0x9f, 0x16, 0x44, 0x13, 0xb8, 0xbf, 0x57, 0x83, 0xb9, 0x87, 0xe1, 0x59, 0x14, 0xf4, 0xe8,
-	0xfe, 0x61, 0xc4, 0x47, 0x91, 0xca, 0x1a, 0xc4, 0xdf, 0xb8, 0x14, 0x94, 0x5d, 0x33, 0x4e, 0xe5,
-	0x05, 0x82, 0x2a, 0xa2, 0x83, 0x10, 0x67, 0x99, 0xbd, 0x42, 0x74, 0x0c, 0x04, 0x8f, 0x09, 0xb1,
-	0x99, 0x04, 0x2d, 0x4b, 0x59, 0xda, 0x65, 0xcd, 0x48, 0xbb, 0xa4, 0xdb, 0x2a, 0x91, 0x27, 0x21,
-	0x2f, 0xd2, 0x55, 0x91, 0x8e, 0x35, 0x31, 0x17, 0x31, 0x21, 0x72, 0x35, 0xe6, 0xe4, 0xb1, 0xc6,
-	0x04, 0xd1, 0x1d, 0x11, 0x1f, 0x08, 0x1a, 0xa1, 0x7c, 0x4d, 0x08, 0x5d, 0xb7, 0x7c, 0x1e, 0x75,
-	0x5d, 0xf0, 0x7c, 0x0e, 0x46, 0x0d, 0xdd, 0xe7, 0x5a, 0x91, 0x8a, 0x39, 0x80, 0xc8, 0x5c, 0xce,
-	0xe3, 0xc6, 0x61, 0x48, 0x24, 0x40, 0xa9, 0xc3, 0x10, 0x32, 0x8a, 0x3f, 0x1c, 0x1e, 0xfb, 0xbd,
-	0xa7, 0x94, 0x26, 0x4f, 0x37, 0x01, 0x75, 0xcf, 0x06, 0x29, 0xd3, 0x21, 0xdb, 0x4d, 0xba, 0xef,
-	0xac, 0x7a, 0x26, 0xc4, 0xb6, 0xa0, 0x41, 0x07, 0x40, 0xb9, 0x9f, 0x8b, 0xb4, 0x9f, 0x2d, 0xf3,
-	0x84, 0x48, 0x3b, 0x6a, 0x12, 0x99, 0x77, 0x22, 0x4b, 0xf6, 0x9d, 0x88, 0x50, 0x9a, 0xf2, 0x2a,
-	0xa9, 0x45, 0xbd, 0x65, 0x00, 0x5a, 0x53, 0xb9, 0x60, 0x82, 0x60, 0x99, 0x08, 0x2c, 0x8c, 0xdd,
-	0x80, 0x79, 0x3c, 0xb6, 0x8c, 0xfd, 0xa0, 0xdf, 0x66, 0xfa, 0xf4, 0xa4, 0x31, 0x6c, 0x43, 0xfd,
-	0xa6, 0x2b, 0x9f, 0x15, 0x5a, 0x15, 0x0b, 0xc3, 0xb5, 0xd1, 0x65, 0x12, 0xa2, 0x55, 0xb1, 0xa3,
-	0x16, 0xe8, 0xa6, 0xc0, 0xb6, 0xfb, 0x7d, 0xc9, 0x9b, 0xfa, 0xb0, 0x9c, 0x71, 0x95, 0x63, 0x71,
-	0x55, 0xc9, 0xee, 0x56, 0xca, 0x77, 0xf7, 0xb9, 0x6b, 0xe0, 0xee, 0x41, 0xe3, 0xd0, 0x48, 0x15,
-	0x27, 0x26, 0x57, 0x49, 0xe2, 0x52, 0x30, 0x0c, 0xc4, 0x18, 0x4e, 0xc5, 0x1c, 0x8e, 0xfb, 0x27,
-	0x0e, 0xb0, 0x83, 0x20, 0x49, 0xf5, 0xf0, 0x45, 0xdf, 0x2e, 0x34, 0x75, 0x48, 0x23, 0xcb, 0xfd,
-	0xb2, 0x30, 0xa4, 0xa1, 0xa1, 0x74, 0xa3, 0x93, 0x93, 0x84, 0xab, 0x4c, 0x0d, 0x0b, 0x43, 0x0e,
-	0x45, 0x1f, 0x07, 0xfd, 0x85, 0x40, 0xf4, 0x90, 0xc8, 0x8c, 0x8d, 0x02, 0x8e, 0x7a, 0x36, 0xe6,
-	0x67, 0x3c, 0x4e, 0xb4, 0x68, 0xe9, 0xb2, 0x4e, 0x51, 0xcb, 0xaf, 0xf2, 0x06, 0xcc, 0xeb, 0x76,
-	0x6d, 0x15, 0xa2, 0x28, 0x75, 0x3d, 0xaa, 0x2a, 0xf2, 0xfa, 0xad, 0x41, 0x0b, 0xb5, 0x59, 0xac,
-	0x60, 0x77, 0x80, 0x9d, 0x04, 0x71, 0x9e, 0x7c, 0x86, 0xc8, 0x4b, 0x6a, 0xdc, 0x0f, 0x60, 0x45,
-	0x76, 0x69, 0x3a, 0x37, 0xf6, 0x26, 0x3a, 0x97, 0x31, 0x72, 0xa5, 0xc8, 0xc8, 0xee, 0x7f, 0x3b,
-	0x30, 0x27, 0x77, 0x9a, 0xb6, 0x25, 0xff, 0x66, 0xa0, 0xee, 0x59, 0x18, 0x6b, 0x5b, 0xd9, 0xe2,
-	0xc4, 0xf5, 0x52, 0x75, 0x15, 0x14, 0xd4, 0x4c, 0x99, 0x82, 0x62, 0x50, 0x1d, 0xfb, 0xe9, 0x29,
-	0x9d, 0x65, 0xeb, 0x1e, 0xfd, 0x66, 0x2d, 0x11, 0x5f, 0x11, 0x8a, 0x90, 0x62, 0x2b, 0x65, 0x8f,
-	0x26, 0x84, 0xbd, 0x2d, 0x3e, 0x9a, 0xb8, 0x0e, 0x75, 0x1a, 0x40, 0x37, 0x0b, 0x9f, 0x64, 0x00,
-	0x72, 0xae, 0x28, 0x90, 0x84, 0xc9, 0x54, 0xd0, 0x0c, 0x71, 0xd7, 0xc4, 0xce, 0xcb, 0x25, 0xd0,
-	0xb7, 0x5a, 0x32, 0x25, 0x30, 0x83, 0x33, 0x8e, 0x90, 0x03, 0xc8, 0x73, 0x84, 0x24, 0xf5, 0x74,
-	0xbd, 0xdb, 0x81, 0xf6, 0x2e, 0x1f, 0xf2, 0x94, 0x6f, 0x0f, 0x87, 0xf9, 0xf6, 0x5f, 0x82, 0x6b,
-	0x25, 0x75, 0xd2, 0x9f, 0xfd, 0x22, 0xac, 0x6d, 0x8b, 0xf4, 0xa9, 0x9f, 0x54, 0x66, 0x82, 0xdb,
-	0x86, 0xf5, 0x7c, 0x93, 0xb2, 0xb3, 0xfb, 0xb0, 0xbc, 0xcb, 0x8f, 0x27, 0x83, 0x03, 0x7e, 0x96,
-	0x75, 0xc4, 0xa0, 0x9a, 0x9c, 0x46, 0xe7, 0x52, 0x30, 0xe9, 0x37, 0x7b, 0x19, 0x60, 0x88, 0x34,
-	0xdd, 0x64, 0xcc, 0x7b, 0x2a, 0xe5, 0x9b, 0x90, 0xa3, 0x31, 0xef, 0xb9, 0x6f, 0x01, 0x33, 0xdb,
-	0x91, 0xeb, 0x85, 0xf6, 0x68, 0x72, 0xdc, 0x4d, 0xa6, 0x49, 0xca, 0x47, 0x2a, 0x97, 0xdd, 0x84,
-	0xdc, 0x5b, 0xd0, 0x3c, 0xf4, 0xa7, 0x1e, 0xff, 0x86, 0x7c, 0x65, 0x72, 0x15, 0xe6, 0xc6, 0xfe,
-	0x14, 0xd5, 0x94, 0x8e, 0xf8, 0x50, 0xb5, 0xfb, 0x9f, 0x15, 0x98, 0x15, 0x94, 0xd8, 0x6a, 0x9f,
-	0x27, 0x69, 0x10, 0x8a, 0x3b, 0x5e, 0xd9, 0xaa, 0x01, 0x15, 0x58, 0xb9, 0x52, 0xc2, 0xca, 0xf2,
-	0xd4, 0xa4, 0xd2, 0x67, 0x25, 0xbf, 0x5a, 0x18, 0x32, 0x57, 0x96, 0x87, 0x23, 0x42, 0x0e, 0x19,
-	0x90, 0x0b, 0x01, 0x66, 0x56, 0x4f, 0x8c, 0x4f, 0x49, 0xa9, 0xe4, 0x5c, 0x13, 0x2a, 0xb5, 0xad,
-	0x73, 0x82, 0xc1, 0x0b, 0xb6, 0xb5, 0x60, 0x43, 0xe7, 0x5f, 0xc0, 0x86, 0x8a, 0xa3, 0xd4, 0xf3,
-	0x6c, 0x28, 0xbc, 0x80, 0x0d, 0x75, 0x19, 0xb4, 0xee, 0x73, 0xee, 0x71, 0xf4, 0xce, 0x14, 0xef,
-	0x7e, 0xdb, 0x81, 0x96, 0xe4, 0x22, 0x5d, 0xc7, 0x5e, 0xb5, 0xbc, 0xd0, 0xd2, 0x24, 0xd7, 0xd7,
-	0x60, 0x81, 0x7c, 0x43, 0x1d, 0xeb, 0x94, 0x81, 0x59, 0x0b, 0xc4, 0x79, 0xa8, 0x0b, 0xa9, 0x51,
-	0x30, 0x94, 0x9b, 0x62, 0x42, 0x2a, 0x5c, 0x1a, 0xfb, 0x32, 0x55, 0xc6, 0xf1, 0x74, 0xd9, 0xfd,
-	0x4b, 0x07, 0x96, 0x8d, 0x01, 0x4b, 0x2e, 0x7c, 0x17, 0x94, 0x34, 0x88, 0x90, 0xa8, 0x90, 0xdc,
-	0xab, 0xb6, 0xd8, 0x64, 0x9f, 0x59, 0xc4, 0xb4, 0x99, 0xfe, 0x94, 0x06, 0x98, 0x4c, 0x46, 0x52,
-	0x89, 0x9a, 0x10, 0x32, 0xd2, 0x39, 0xe7, 0x4f, 0x35, 0x89, 0x50, 0xe3, 0x16, 0x46, 0x69, 0x18,
-	0xe8, 0xd3, 0x6a, 0x22, 0x61, 0xcf, 0x6c, 0xd0, 0xfd, 0x07, 0x07, 0x56, 0xc4, 0xe1, 0x44, 0x1e,
-	0xfd, 0xf4, 0x0b, 0x84, 0x59, 0x71, 0x1a, 0x13, 0x12, 0xb9, 0x7f, 0xc5, 0x93, 0x65, 0xf6, 0xe9,
-	0x17, 0x3c, 0x50, 0xe9, 0xf4, 0x9b, 0x0b, 0xf6, 0x62, 0xa6, 0x6c, 0x2f, 0x9e, 0xb3, 0xd2, 0x65,
-	0x21, 0xc0, 0x5a, 0x69, 0x08, 0xf0, 0xde, 0x1c, 0xd4, 0x92, 0x5e, 0x34, 0xe6, 0xee, 0x3a, 0xac,
-	0xda, 0x93, 0x93, 0x2a, 0xe8, 0x3b, 0x0e, 0xb4, 0xef, 0x8b, 0x80, 0x78, 0x10, 0x0e, 0xf6, 0x83,
-	0x24, 0x8d, 0x62, 0xfd, 0xac, 0xea, 0x06, 0x40, 0x92, 0xfa, 0x71, 0x2a, 0xd2, 0x23, 0x65, 0x80,
-	0x2e, 0x43, 0x70, 0x8c, 0x3c, 0xec, 0x8b, 0x5a, 0xb1, 0x37, 0xba, 0x5c, 0xf0, 0x21, 0xe4, 0xf1,
-	0xc9, 0xb2, 0xc4, 0xaf, 0x8b, 0x7c, 0x36, 0xf4, 0x15, 0xf8, 0x19, 0xe9, 0x75, 0x71, 0x2e, 0xc9,
-	0xa1, 0xee, 0x9f, 0x3b, 0xb0, 0x94, 0x0d, 0x72, 0x0f, 0x41, 0x5b, 0x3b, 0x48, 0xf3, 0x9b, 0x69,
-	0x07, 0x15, 0x3a, 0x0c, 0xd0, 0x1e, 0xcb, 0xb1, 0x19, 0x08, 0x49, 0xac, 0x2c, 0x45, 0x13, 0xe5,
-	0xe0, 0x98, 0x90, 0xc8, 0x0d, 0x41, 0x4f, 0x40, 0x7a, 0x35, 0xb2, 0x44, 0xd9, 0xad, 0xa3, 0x94,
-	0xbe, 0x9a, 0x15, 0x07, 0x33, 0x59, 0x54, 0xa6, 0x74, 0x8e, 0x50, 0xfc, 0xe9, 0xfe, 0x96, 0x03,
-	0xd7, 0x4a, 0x16, 0x57, 0x4a, 0xc6, 0x2e, 0x2c, 0x9f, 0xe8, 0x4a, 0xb5, 0x00, 0x42, 0x3c, 0xd6,
-	0xd5, 0x0d, 0x8e, 0x3d, 0x69, 0xaf, 0xf8, 0x81, 0xf6, 0x7d, 0xc4, 0x92, 0x5a, 0x29, 0x5a, 0xc5,
-	0x8a, 0xad, 0xdf, 0x9e, 0x81, 0x45, 0x71, 0xb3, 0x27, 0x1e, 0x38, 0xf3, 0x98, 0xbd, 0x07, 0x73,
-	0xf2, 0x81, 0x3a, 0x5b, 0x93, 0xdd, 0xda, 0x4f, 0xe2, 0x3b, 0xeb, 0x79, 0x58, 0xf2, 0xce, 0xca,
-	0xaf, 0x7c, 0xff, 0x5f, 0x7e, 0xa7, 0xb2, 0xc0, 0x1a, 0x9b, 0x67, 0x6f, 0x6e, 0x0e, 0x78, 0x98,
-	0x60, 0x1b, 0x3f, 0x0f, 0x90, 0x3d, 0xdd, 0x66, 0x6d, 0xed, 0xb3, 0xe5, 0xde, 0xa4, 0x77, 0xae,
-	0x95, 0xd4, 0xc8, 0x76, 0xaf, 0x51, 0xbb, 0x2b, 0xee, 0x22, 0xb6, 0x1b, 0x84, 0x41, 0x2a, 0xde,
-	0x71, 0xbf, 0xe3, 0x6c, 0xb0, 0x3e, 0x34, 0xcd, 0x97, 0xd9, 0x4c, 0x85, 0x6e, 0x4a, 0xde, 0x85,
-	0x77, 0x5e, 0x2a, 0xad, 0x53, 0x71, 0x2b, 0xea, 0x63, 0xcd, 0x6d, 0x61, 0x1f, 0x13, 0xa2, 0xc8,
-	0x7a, 0x19, 0xc2, 0xa2, 0xfd, 0x00, 0x9b, 0x5d, 0x37, 0xc4, 0xba, 0xf0, 0xfc, 0xbb, 0xf3, 0xf2,
-	0x05, 0xb5, 0xb2, 0xaf, 0x97, 0xa9, 0xaf, 0xab, 0x2e, 0xc3, 0xbe, 0x7a, 0x44, 0xa3, 0x9e, 0x7f,
-	0xbf, 0xe3, 0x6c, 0x6c, 0xfd, 0xd3, 0x0d, 0xa8, 0xeb, 0x60, 0x2b, 0xfb, 0x3a, 0x2c, 0x58, 0x57,
-	0xaf, 0x4c, 0x4d, 0xa3, 0xec, 0xa6, 0xb6, 0x73, 0xbd, 0xbc, 0x52, 0x76, 0x7c, 0x83, 0x3a, 0x6e,
-	0xb3, 0x75, 0xec, 0x58, 0xde, 0x5d, 0x6e, 0xd2, 0x85, 0xb3, 0xc8, 0x9d, 0x7d, 0x2a, 0xe6, 0x99,
-	0x5d, 0x97, 0x5a, 0xf3, 0x2c, 0x5c, 0xaf, 0x5a, 0xf3, 0x2c, 0xde, 0xb1, 0xba, 0xd7, 0xa9, 0xbb,
-	0x75, 0xb6, 0x6a, 0x76, 0xa7, 0x83, 0xa0, 0x9c, 0xb2, 0x9d, 0xcd, 0xf7, 0xd9, 0xec, 0x65, 0xcd,
-	0x58, 0x65, 0xef, 0xb6, 0x35, 0x8b, 0x14, 0x1f, 0x6f, 0xbb, 0x6d, 0xea, 0x8a, 0x31, 0xda, 0x3e,
-	0xf3, 0x79, 0x36, 0xfb, 0x2a, 0xd4, 0xf5, 0x63, 0x44, 0x76, 0xd5, 0x78, 0x01, 0x6a, 0xbe, 0x90,
-	0xec, 0xb4, 0x8b, 0x15, 0x65, 0x8c, 0x61, 0xb6, 0x8c, 0x8c, 0x71, 0x00, 0x6b, 0xf2, 0x0c, 0x70,
-	0xcc, 0x7f, 0x98, 0x99, 0x94, 0xbc, 0x2a, 0xbf, 0xeb, 0xb0, 0x77, 0x61, 0x5e, 0xbd, 0xf1, 0x64,
-	0xeb, 0xe5, 0x6f, 0x55, 0x3b, 0x57, 0x0b, 0xb8, 0xd4, 0x1e, 0x5f, 0x06, 0xc8, 0xde, 0x2e, 0x6a,
-	0x39, 0x2b, 0xbc, 0x9a, 0xd4, 0x8b, 0x58, 0x7c, 0xe8, 0xe8, 0xae, 0xd3, 0x54, 0x5b, 0x8c, 0xe4,
-	0x2c, 0xe4, 0xe7, 0x2a, 0x4d, 0x7f, 0x17, 0x1a, 0xc6, 0xf3, 0x45, 0xa6, 0x5a, 0x28, 0x3e, 0x7d,
-	0xec, 0x74, 0xca, 0xaa, 0xe4, 0x00, 0x3f, 0x0f, 0x0b, 0xd6, 0x3b, 0x44, 0xcd, 0xc8, 0x65, 0xaf,
-	0x1c, 0x35, 0x23, 0x97, 0x3f, 0x5d, 0xfc, 0x0a, 0x34, 0x8c, 0x57, 0x83, 0xcc, 0x48, 0x29, 0xcc,
-	0xbd, 0x17, 0xd4, 0x23, 0x2a, 0x7b, 0x64, 0xb8, 0x4a, 0xf3, 0x5d, 0x74, 0xeb, 0x38, 0x5f, 0xca,
-	0x55, 0xc7, 0x3d, 0xfd, 0x3a, 0x2c, 0xda, 0xef, 0x08, 0xb5, 0x10, 0x94, 0xbe, 0x48, 0xd4, 0x42,
-	0x70, 0xc1, 0xe3, 0x43, 0xc9, 0x3f, 0x1b, 0x2b, 0xba, 0x93, 0xcd, 0x8f, 0xe4, 0x3d, 0xe3, 0x33,
-	0xf6, 0x45, 0x94, 0x74, 0xf9, 0x78, 0x80, 0x65, 0xaf, 0x27, 0xed, 0x27, 0x06, 0x9a, 0x39, 0x0b,
-	0xef, 0x0c, 0xdc, 0x65, 0x6a, 0xbc, 0xc1, 0xb2, 0x19, 0x08, 0xf5, 0x4d, 0x8f, 0x08, 0x0c, 0xf5,
-	0x6d, 0xbe, 0x33, 0x30, 0xd4, 0xb7, 0xf5, 0xd6, 0x20, 0xaf, 0xbe, 0xd3, 0x00, 0xdb, 0x08, 0x61,
-	0x29, 0x97, 0x53, 0xa3, 0x79, 0xbb, 0x3c, 0x09, 0xb1, 0x73, 0xe3, 0xf9, 0xa9, 0x38, 0xb6, 0x56,
-	0x50, 0xda, 0x60, 0x53, 0xe5, 0x8c, 0xfe, 0x02, 0x34, 0xcd, 0xf7, 0x5f, 0x5a, 0xa1, 0x97, 0xbc,
-	0x5a, 0xd3, 0x0a, 0xbd, 0xec, 0xc1, 0x98, 0xda, 0x5c, 0xd6, 0x34, 0xbb, 0xc1, 0xcd, 0xb5, 0x1f,
-	0xc0, 0x64, 0x1a, 0xae, 0xec, 0xdd, 0x4f, 0xa6, 0xe1, 0x4a, 0x5f, 0xcd, 0xa8, 0xcd, 0x65, 0x2b,
-	0xd6, 0x5c, 0x44, 0x48, 0x98, 0x7d, 0x05, 0x96, 0x8c, 0x84, 0xb5, 0xa3, 0x69, 0xd8, 0xd3, 0x8c,
-	0x5a, 0x4c, 0x8d, 0xee, 0x94, 0x39, 0x8a, 0xee, 0x55, 0x6a, 0x7f, 0xd9, 0xb5, 0x26, 0x81, 0x4c,
-	0xba, 0x03, 0x0d, 0x33, 0x19, 0xee, 0x39, 0xed, 0x5e, 0x35, 0xaa, 0xcc, 0xcc, 0xde, 0xbb, 0x0e,
-	0xfb, 0x7d, 0x07, 0x9a, 0x56, 0x6a, 0x99, 0x75, 0xf1, 0x91, 0x6b, 0xa7, 0x6d, 0xd6, 0x99, 0x0d,
-	0xb9, 0x1e, 0x0d, 0xf2, 0x60, 0xe3, 0xf3, 0xd6, 0x22, 0x7c, 0x64, 0x1d, 0x38, 0xee, 0xe4, 0xff,
-	0x2a, 0xe0, 0x59, 0x9e, 0xc0, 0x4c, 0x1f, 0x7f, 0x76, 0xd7, 0x61, 0x7f, 0xe4, 0xc0, 0xa2, 0x7d,
-	0x4c, 0xd6, 0x5b, 0x55, 0x7a, 0x20, 0xd7, 0x5b, 0x75, 0xc1, 0xd9, 0xfa, 0xa7, 0x30, 0x4a, 0xf6,
-	0x8e, 0xf8, 0xc3, 0x0e, 0x15, 0xb3, 0x61, 0x86, 0x6e, 0xce, 0x6f, 0xab, 0xf9, 0x6f, 0x15, 0xb7,
-	0x9d, 0xbb, 0x0e, 0xfb, 0x9a, 0xf8, 0xd7, 0x02, 0xf9, 0x2d, 0x71, 0xc7, 0x8b, 0x7e, 0xef, 0xbe,
-	0x46, 0x73, 0xb9, 0xe1, 0x5e, 0xb3, 0xe6, 0x92, 0x37, 0x4e, 0xdb, 0x62, 0x74, 0xf2, 0xcf, 0x28,
-	0x32, 0xb5, 0x5d, 0xf8, 0x83, 0x8a, 0x8b, 0x07, 0x39, 0x12, 0x83, 0x94, 0xe4, 0x16, 0x0b, 0xbf,
-	0x60, 0x33, 0xee, 0x06, 0x8d, 0xf5, 0x35, 0xf7, 0x95, 0x0b, 0xc7, 0xba, 0x49, 0x87, 0x5c, 0x1c,
-	0xf1, 0x21, 0x40, 0x16, 0x5f, 0x65, 0xb9, 0xf8, 0x9e, 0xb6, 0x5c, 0xc5, 0x10, 0xac, 0x2d, 0x27,
-	0x2a, 0x0c, 0x88, 0x2d, 0x7e, 0x55, 0xa8, 0x93, 0x87, 0x2a, 0x32, 0x78, 0xcd, 0x50, 0x19, 0x76,
-	0x20, 0xb4, 0xd3, 0x29, 0xab, 0x2a, 0x53, 0x26, 0x3a, 0xcc, 0xf8, 0x3e, 0x2c, 0x1c, 0x44, 0xd1,
-	0xd3, 0xc9, 0x58, 0xdf, 0x56, 0xd8, 0xf1, 0xa7, 0x7d, 0x3f, 0x39, 0xed, 0xe4, 0x66, 0xe1, 0xde,
-	0xa4, 0xa6, 0x3a, 0xac, 0x6d, 0x34, 0xb5, 0xf9, 0x51, 0x16, 0xbf, 0x7d, 0xc6, 0x7c, 0x58, 0xd6,
-	0x4e, 0x85, 0x1e, 0x78, 0xc7, 0x6e, 0xc6, 0x8c, 0x3c, 0x16, 0xba, 0xb0, 0xdc, 0x3c, 0x35, 0xda,
-	0xcd, 0x44, 0xb5, 0x79, 0xd7, 0x61, 0x87, 0xd0, 0xdc, 0xe5, 0xbd, 0xa8, 0xcf, 0x65, 0x10, 0x67,
-	0x25, 0x1b, 0xb8, 0x8e, 0xfe, 0x74, 0x16, 0x2c, 0xd0, 0xd6, 0xdb, 0x63, 0x7f, 0x1a, 0xf3, 0x6f,
-	0x6c, 0x7e, 0x24, 0xc3, 0x43, 0xcf, 0x94, 0xde, 0x56, 0xf1, 0x33, 0x4b, 0x6f, 0xe7, 0x02, 0x6e,
-	0x96, 0xde, 0x2e, 0x04, 0xdc, 0xac, 0xa5, 0x56, 0xf1, 0x3b, 0x36, 0x84, 0xe5, 0x42, 0x8c, 0x8e,
-	0xbd, 0xa2, 0x2c, 0xef, 0x05, 0x91, 0xbd, 0xce, 0xcd, 0x8b, 0x09, 0xec, 0xde, 0x36, 0xec, 0xde,
-	0x8e, 0x60, 0x61, 0x97, 0x8b, 0xc5, 0x12, 0x29, 0x11, 0xb9, 0xb7, 0x90, 0x66, 0xc2, 0x45, 0x5e,
-	0x71, 0x53, 0x9d, 0x6d, 0x98, 0x29, 0x1f, 0x81, 0x7d, 0x15, 0x1a, 0x0f, 0x78, 0xaa, 0x72, 0x20,
-	0xb4, 0x83, 0x97, 0x4b, 0x8a, 0xe8, 0x94, 0xa4, 0x50, 0xd8, 0x3c, 0x43, 0xad, 0x6d, 0xf2, 0xfe,
-	0x80, 0x0b, 0xe5, 0xd4, 0x0d, 0xfa, 0xcf, 0xd8, 0xcf, 0x51, 0xe3, 0x3a, 0x09, 0x6b, 0xdd, 0xb8,
-	0x3a, 0x37, 0x1b, 0x5f, 0xca, 0xe1, 0x65, 0x2d, 0x87, 0x51, 0x9f, 0x1b, 0x2e, 0xca, 0x47, 0xd0,
-	0x30, 0x32, 0x04, 0xb5, 0x00, 0x15, 0xb3, 0x1d, 0xb5, 0x00, 0x95, 0x24, 0x14, 0xba, 0x9f, 0xa6,
-	0x7e, 0x36, 0xd9, 0x27, 0xb2, 0x7e, 0x44, 0x12, 0x61, 0xd6, 0xd3, 0xe6, 0x47, 0xfe, 0x28, 0x7d,
-	0xb6, 0xf9, 0x51, 0x96, 0x06, 0xf9, 0x8c, 0x7d, 0x40, 0x8f, 0x24, 0xcd, 0xa4, 0x8f, 0xcc, 0x7d,
-	0xcd, 0xe7, 0x87, 0xe8, 0x95, 0x33, 0xaa, 0x6c, 0x97, 0x56, 0xf4, 0x4b, 0x6e, 0xcd, 0xa7, 0x01,
-	0x8e, 0xd2, 0x68, 0xbc, 0xeb, 0xf3, 0x51, 0x14, 0x66, 0x8a, 0x37, 0x4b, 0x6c, 0xc8, 0x94, 0x99,
-	0x91, 0xdd, 0xc0, 0x3e, 0x30, 0xfc, 0x7d, 0x2b, 0x67, 0x46, 0x71, 0xda, 0x85, 0xb9, 0x0f, 0x7a,
-	0x75, 0x4a, 0xf2, 0x1f, 0xee, 0x3a, 0x6c, 0x1b, 0x20, 0x8b, 0xd8, 0x6a, 0xef, 0xbd, 0x10, 0x0c,
-	0xd6, 0x3a, 0xb0, 0x24, 0xbc, 0x7b, 0x08, 0xf5, 0x2c, 0x04, 0x78, 0x35, 0x4b, 0xf9, 0xb4, 0x02,
-	0x86, 0xda, 0x8c, 0x17, 0x02, 0x73, 0x6e, 0x8b, 0x96, 0x0a, 0xd8, 0x3c, 0x2e, 0x15, 0x45, 0xdb,
-	0x02, 0x58, 0x11, 0x03, 0xd4, 0x3e, 0x09, 0x5d, 0xd5, 0xab, 0x99, 0x94, 0x04, 0xc7, 0xb4, 0x68,
-	0x97, 0xc6, 0x96, 0xac, 0x73, 0x3c, 0xb2, 0xae, 0x48, 0x13, 0x40, 0x3d, 0x3d, 0x82, 0xe5, 0x42,
-	0x60, 0x44, 0xcb, 0xf7, 0x45, 0xf1, 0x28, 0x2d, 0xdf, 0x17, 0xc6, 0x54, 0xdc, 0x35, 0xea, 0x72,
-	0xc9, 0x05, 0xec, 0x32, 0x39, 0x0f, 0xd2, 0xde, 0xe9, 0x3b, 0xce, 0xc6, 0xf1, 0x2c, 0xfd, 0xdb,
-	0xdf, 0x27, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xfb, 0x05, 0x88, 0x1f, 0x50, 0x00, 0x00,
+	// 8115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7d, 0xeb, 0x6f, 0x24, 0x59,
+	0x96, 0x57, 0x45, 0x3e, 0xca, 0xce, 0x93, 0x0f, 0xa7, 0xaf, 0x1f, 0x95, 0x95, 0xf5, 0x68, 0x77,
+	0x4c, 0xa9, 0xab, 0x28, 0x7a, 0xca, 0xd5, 0xee, 0x9d, 0x56, 0x3f, 0xf6, 0xe5, 0xb2, 0xd3, 0x8f,
+	0x1e, 0x57, 0xd9, 0x13, 0xe9, 0xea, 0x9a, 0xc7, 0x42, 0x6e, 0x38, 0xf3, 0xda, 0x8e, 0xa9, 0xcc,
+	0x88, 0xdc, 0x88, 0x48, 0xbb, 0xdc, 0x4d, 0x49, 0x0b, 0x48, 0x8b, 0x84, 0x18, 0xad, 0x10, 0x2b,
+	0xa1, 0x45, 0x42, 0xc0, 0x02, 0x42, 0xfc, 0x01, 0x20, 0x21, 0xf8, 0x04, 0x48, 0x08, 0x24, 0xc4,
+	0x87, 0xfd, 0x88, 0x40, 0x83, 0xe0, 0x0b, 0x20, 0x24, 0x40, 0x82, 0x6f, 0x83, 0xd0, 0xb9, 0xaf,
+	0xb8, 0x37, 0x22, 0xd2, 0x76, 0xcf, 0xf4, 0xec, 0xb7, 0xbc, 0xbf, 0x73, 0xe2, 0xbe, 0xcf, 0xb9,
+	0xe7, 0x9e, 0x7b, 0xee, 0x4d, 0xa8, 0x84, 0xe3, 0xfe, 0x93, 0x71, 0x18, 0xc4, 0x01, 0x29, 0x0f,
+	0xfd, 0x70, 0xdc, 0x6f, 0xdf, 0x3d, 0x09, 0x82, 0x93, 0x21, 0x5d, 0x75, 0xc7, 0xde, 0xaa, 0xeb,
+	0xfb, 0x41, 0xec, 0xc6, 0x5e, 0xe0, 0x47, 0x9c, 0xc9, 0xfe, 0x6d, 0x68, 0x6c, 0x53, 0xbf, 0x4b,
+	0xe9, 0xc0, 0xa1, 0xbf, 0x33, 0xa1, 0x51, 0x4c, 0xfe, 0x34, 0xcc, 0xbb, 0xf4, 0x4b, 0x4a, 0x07,
+	0xbd, 0xb1, 0x1b, 0x45, 0xe3, 0xd3, 0xd0, 0x8d, 0x68, 0xcb, 0x5a, 0xb1, 0x1e, 0xd5, 0x9c, 0x26,
+	0x27, 0x1c, 0x28, 0x9c, 0xbc, 0x0b, 0xb5, 0x08, 0x59, 0xa9, 0x1f, 0x87, 0xc1, 0xf8, 0xa2, 0x55,
+	0x60, 0x7c, 0x55, 0xc4, 0x3a, 0x1c, 0xb2, 0x87, 0x30, 0xa7, 0x4a, 0x88, 0xc6, 0x81, 0x1f, 0x51,
+	0xf2, 0x14, 0x16, 0xfb, 0xde, 0xf8, 0x94, 0x86, 0x3d, 0xf6, 0xf1, 0xc8, 0xa7, 0xa3, 0xc0, 0xf7,
+	0xfa, 0x2d, 0x6b, 0xa5, 0xf8, 0xa8, 0xe2, 0x10, 0x4e, 0xc3, 0x2f, 0x9e, 0x0b, 0x0a, 0x79, 0x08,
+	0x73, 0xd4, 0xe7, 0x38, 0x1d, 0xb0, 0xaf, 0x44, 0x51, 0x8d, 0x04, 0xc6, 0x0f, 0xec, 0x7f, 0x69,
+	0xc1, 0xfc, 0xae, 0xef, 0xc5, 0xaf, 0xdc, 0xe1, 0x90, 0xc6, 0xb2, 0x4d, 0x0f, 0x61, 0xee, 0x9c,
+	0x01, 0xac, 0x4d, 0xe7, 0x41, 0x38, 0x10, 0x2d, 0x6a, 0x70, 0xf8, 0x40, 0xa0, 0x53, 0x6b, 0x56,
+	0x98, 0x5a, 0xb3, 0xdc, 0xee, 0x2a, 0x4e, 0xe9, 0xae, 0x87, 0x30, 0x17, 0xd2, 0x7e, 0x70, 0x46,
+	0xc3, 0x8b, 0xde, 0xb9, 0xe7, 0x0f, 0x82, 0xf3, 0x56, 0x69, 0xc5, 0x7a, 0x54, 0x76, 0x1a, 0x12,
+	0x7e, 0xc5, 0x50, 0x7b, 0x11, 0x88, 0xde, 0x0a, 0xde, 0x6f, 0xf6, 0x09, 0x2c, 0xbc, 0xf4, 0x87,
+	0x41, 0xff, 0xf5, 0xcf, 0xd9, 0xba, 0x9c, 0xe2, 0x0b, 0xb9, 0xc5, 0x2f, 0xc3, 0xa2, 0x59, 0x90,
+	0xa8, 0x00, 0x85, 0xa5, 0x8d, 0x53, 0xd7, 0x3f, 0xa1, 0x32, 0x4b, 0x59, 0x85, 0x3f, 0x05, 0xcd,
+	0xfe, 0x24, 0x0c, 0xa9, 0x9f, 0xa9, 0xc3, 0x9c, 0xc0, 0x55, 0x25, 0xde, 0x85, 0x9a, 0x4f, 0xcf,
+	0x13, 0x36, 0x31, 0x65, 0x7c, 0x7a, 0x2e, 0x59, 0xec, 0x16, 0x2c, 0xa7, 0x8b, 0x11, 0x15, 0xf8,
+	0xc3, 0x02, 0x54, 0x0f, 0x43, 0xd7, 0x8f, 0xdc, 0x3e, 0xce, 0x62, 0xd2, 0x82, 0x99, 0xf8, 0x4d,
+	0xef, 0xd4, 0x8d, 0x4e, 0x59, 0x71, 0x15, 0x47, 0x26, 0xc9, 0x32, 0xdc, 0x74, 0x47, 0xc1, 0xc4,
+	0x8f, 0x59, 0x01, 0x45, 0x47, 0xa4, 0xc8, 0xfb, 0x30, 0xef, 0x4f, 0x46, 0xbd, 0x7e, 0xe0, 0x1f,
+	0x7b, 0xe1, 0x88, 0xcb, 0x02, 0x1b, 0xaf, 0xb2, 0x93, 0x25, 0x90, 0xfb, 0x00, 0x47, 0xd8, 0x0f,
+	0xbc, 0x88, 0x12, 0x2b, 0x42, 0x43, 0x88, 0x0d, 0x35, 0x91, 0xa2, 0xde, 0xc9, 0x69, 0xdc, 0x2a,
+	0xb3, 0x8c, 0x0c, 0x0c, 0xf3, 0x88, 0xbd, 0x11, 0xed, 0x45, 0xb1, 0x3b, 0x1a, 0xb7, 0x6e, 0xb2,
+	0xda, 0x68, 0x08, 0xa3, 0x07, 0xb1, 0x3b, 0xec, 0x1d, 0x53, 0x1a, 0xb5, 0x66, 0x04, 0x5d, 0x21,
+	0xe4, 0x3d, 0x68, 0x0c, 0x68, 0x14, 0xf7, 0xdc, 0xc1, 0x20, 0xa4, 0x51, 0x44, 0xa3, 0xd6, 0x2c,
+	0x9b, 0x8d, 0x29, 0x14, 0x7b, 0x6d, 0x9b, 0xc6, 0x5a, 0xef, 0x44, 0x62, 0x74, 0xec, 0x3d, 0x20,
+	0x1a, 0xbc, 0x49, 0x63, 0xd7, 0x1b, 0x46, 0xe4, 0x23, 0xa8, 0xc5, 0x1a, 0x33, 0x93, 0xbe, 0xea,
+	0x1a, 0x79, 0xc2, 0xd4, 0xc6, 0x13, 0xed, 0x03, 0xc7, 0xe0, 0xb3, 0xb7, 0x61, 0x76, 0x8b, 0xd2,
+	0x3d, 0x6f, 0xe4, 0xc5, 0x64, 0x19, 0xca, 0xc7, 0xde, 0x1b, 0xca, 0x07, 0xbb, 0xb8, 0x73, 0xc3,
+	0xe1, 0x49, 0xd2, 0x86, 0x99, 0x31, 0x0d, 0xfb, 0x54, 0x76, 0xff, 0xce, 0x0d, 0x47, 0x02, 0xcf,
+	0x66, 0xa0, 0x3c, 0xc4, 0x8f, 0xed, 0x9f, 0x95, 0xa0, 0xda, 0xa5, 0xbe, 0x9a, 0x44, 0x04, 0x4a,
+	0xd8, 0x24, 0x31, 0x71, 0xd8, 0x6f, 0xf2, 0x0e, 0x54, 0x59, 0x33, 0xa3, 0x38, 0xf4, 0xfc, 0x13,
+	0x96, 0x59, 0xc5, 0x01, 0x84, 0xba, 0x0c, 0x21, 0x4d, 0x28, 0xba, 0xa3, 0x98, 0x8d, 0x60, 0xd1,
+	0xc1, 0x9f, 0x38, 0xc1, 0xc6, 0xee, 0xc5, 0x08, 0xe7, 0xa2, 0x1a, 0xb5, 0x9a, 0x53, 0x15, 0xd8,
+	0x0e, 0x0e, 0xdb, 0x13, 0x58, 0xd0, 0x59, 0x64, 0xee, 0x65, 0x96, 0xfb, 0xbc, 0xc6, 0x29, 0x0a,
+	0x79, 0x08, 0x73, 0x92, 0x3f, 0xe4, 0x95, 0x65, 0xe3, 0x58, 0x71, 0x1a, 0x02, 0x96, 0x4d, 0x78,
+	0x04, 0xcd, 0x63, 0xcf, 0x77, 0x87, 0xbd, 0xfe, 0x30, 0x3e, 0xeb, 0x0d, 0xe8, 0x30, 0x76, 0xd9,
+	0x88, 0x96, 0x9d, 0x06, 0xc3, 0x37, 0x86, 0xf1, 0xd9, 0x26, 0xa2, 0xe4, 0x7d, 0xa8, 0x1c, 0x53,
+	0xda, 0x63, 0x3d, 0xd1, 0x9a, 0x5d, 0xb1, 0x1e, 0x55, 0xd7, 0xe6, 0x44, 0xd7, 0xcb, 0xde, 0x75,
+	0x66, 0x8f, 0xc5, 0x2f, 0xb2, 0x0d, 0x8d, 0x30, 0x98, 0xc4, 0x38, 0x65, 0x42, 0x37, 0xa6, 0x27,
+	0x17, 0xad, 0xca, 0x8a, 0xf5, 0xa8, 0xb1, 0xb6, 0x22, 0x3e, 0xd1, 0xba, 0xf1, 0x89, 0x83, 0x8c,
+	0x5d, 0xc1, 0xe7, 0xd4, 0x43, 0x3d, 0x49, 0x3e, 0x06, 0x0e, 0xf4, 0xce, 0xd9, 0xe4, 0x8c, 0x5a,
+	0xc0, 0x8a, 0x5e, 0x10, 0xf9, 0xb0, 0x6f, 0x5f, 0x71, 0x92, 0x53, 0x0b, 0xb5, 0x14, 0x79, 0x02,
+	0x8b, 0x23, 0xf7, 0x4d, 0xef, 0x34, 0x18, 0xe3, 0xb4, 0xec, 0x61, 0x7e, 0xbd, 0xf1, 0x78, 0xd4,
+	0xaa, 0xae, 0x58, 0x8f, 0xea, 0x4e, 0x73, 0xe4, 0xbe, 0xd9, 0x09, 0xc6, 0x5b, 0x94, 0x3a, 0x6e,
+	0x4c, 0x0f, 0xc6, 0x23, 0xf2, 0x10, 0x9a, 0x3a, 0xff, 0x28, 0x72, 0xe3, 0x56, 0x8d, 0x8d, 0x52,
+	0x5d, 0xf1, 0x3e, 0x8f, 0xdc, 0x98, 0xdc, 0x03, 0x60, 0xbd, 0xc5, 0xbb, 0xa2, 0xce, 0xb2, 0xab,
+	0x20, 0xc2, 0x9a, 0x6e, 0x7f, 0x1f, 0xea, 0x46, 0x8b, 0x48, 0x15, 0x66, 0x36, 0x3b, 0x5b, 0xeb,
+	0x2f, 0xf7, 0x0e, 0x9b, 0x37, 0x48, 0x0d, 0x66, 0x37, 0x76, 0x3a, 0xeb, 0x07, 0x9d, 0xee, 0x61,
+	0xd3, 0x42, 0xd2, 0xd6, 0x7a, 0xf7, 0x10, 0x13, 0x05, 0x32, 0x0f, 0xf5, 0xe7, 0xfb, 0xdd, 0xc3,
+	0x9e, 0xd3, 0xd9, 0xdb, 0x5d, 0x7f, 0xb6, 0xd7, 0x69, 0x16, 0x91, 0xfb, 0x55, 0x67, 0x77, 0x7b,
+	0xe7, 0xb0, 0xb3, 0xd9, 0x2c, 0xd9, 0xbf, 0x67, 0x41, 0x4d, 0x6f, 0x30, 0xd6, 0xe4, 0x98, 0xca,
+	0xae, 0x61, 0xd3, 0xd0, 0x72, 0x70, 0x94, 0x38, 0x1d, 0x07, 0x97, 0x89, 0x2d, 0x13, 0x6e, 0xc1,
+	0x54, 0x60, 0x4c, 0x0d, 0xc4, 0xf7, 0x50, 0x5f, 0x72, 0xce, 0x6f, 0x03, 0x09, 0xe9, 0xd0, 0x73,
+	0x8f, 0xbc, 0xa1, 0x17, 0x5f, 0x48, 0xde, 0x22, 0xe3, 0x9d, 0xd7, 0x28, 0x9c, 0xdd, 0xfe, 0x03,
+	0x0b, 0x6a, 0x7c, 0x04, 0xc5, 0x02, 0xf9, 0x00, 0xea, 0x72, 0xbe, 0xd1, 0x30, 0x0c, 0x42, 0xa1,
+	0xdc, 0x4c, 0x90, 0x3c, 0x86, 0xa6, 0x04, 0xc6, 0x21, 0xf5, 0x46, 0xee, 0x09, 0x15, 0xda, 0x34,
+	0x83, 0x93, 0xb5, 0x24, 0x47, 0x36, 0xaa, 0xac, 0x32, 0xd5, 0xb5, 0x9a, 0x3e, 0xee, 0x8e, 0xc9,
+	0x62, 0xff, 0xc4, 0x02, 0x82, 0xd5, 0x3a, 0x0c, 0x38, 0x59, 0xcc, 0xf1, 0xb4, 0x7c, 0x59, 0xd7,
+	0x96, 0xaf, 0xc2, 0x34, 0xf9, 0x7a, 0x00, 0x37, 0x59, 0x91, 0xa8, 0x89, 0x8b, 0x99, 0x6a, 0x09,
+	0x9a, 0xfd, 0x1f, 0x2c, 0x58, 0x38, 0x08, 0x83, 0x23, 0x7a, 0x60, 0x0a, 0xdd, 0x37, 0xa4, 0x37,
+	0x72, 0x84, 0xbc, 0x74, 0x6d, 0x21, 0x2f, 0x5f, 0x2d, 0xe4, 0x37, 0xaf, 0x10, 0x72, 0xfb, 0x8f,
+	0x2c, 0xa8, 0xb1, 0xf6, 0xad, 0xc7, 0x31, 0x1d, 0x8d, 0x63, 0x62, 0x43, 0x99, 0x0f, 0x96, 0x95,
+	0x33, 0x58, 0x9c, 0x44, 0x7e, 0x05, 0x96, 0x8e, 0x5d, 0x6f, 0x38, 0x09, 0x69, 0x2f, 0x0a, 0x26,
+	0x61, 0x9f, 0xf6, 0xc6, 0x93, 0xa3, 0xd7, 0xf4, 0x42, 0x34, 0x39, 0x9f, 0x88, 0xeb, 0xa6, 0x20,
+	0xb0, 0x1e, 0xa8, 0x38, 0x32, 0x89, 0xab, 0xd1, 0xd0, 0x8d, 0xa9, 0xdf, 0xbf, 0xe8, 0x8d, 0x22,
+	0xd6, 0x01, 0x45, 0x47, 0x43, 0xec, 0x7f, 0x65, 0xc1, 0xa2, 0x39, 0x08, 0x62, 0xce, 0xb6, 0x60,
+	0x26, 0x9a, 0xf4, 0xfb, 0x34, 0x8a, 0x58, 0x75, 0x67, 0x1d, 0x99, 0x4c, 0x9a, 0x51, 0x98, 0xde,
+	0x8c, 0x55, 0x98, 0x75, 0x79, 0xab, 0xe5, 0x1c, 0x90, 0x2a, 0x49, 0xef, 0x11, 0x47, 0x31, 0x5d,
+	0x55, 0x4f, 0xb2, 0x02, 0xd5, 0x31, 0x7e, 0x29, 0x04, 0x88, 0xab, 0x76, 0x1d, 0x62, 0xdd, 0x8d,
+	0x66, 0x86, 0x4f, 0x87, 0x07, 0x81, 0xe7, 0xc7, 0xe4, 0x29, 0x90, 0xe3, 0x89, 0x3f, 0xf0, 0xfc,
+	0x93, 0x5e, 0xfc, 0xc6, 0x1b, 0xf4, 0x8e, 0x2e, 0x62, 0xca, 0x1b, 0x53, 0xdb, 0xb9, 0xe1, 0xe4,
+	0xd0, 0xc8, 0xfb, 0xd0, 0x34, 0xd0, 0x28, 0x0e, 0x79, 0xbf, 0xef, 0xdc, 0x70, 0x32, 0x14, 0x34,
+	0x16, 0x82, 0x49, 0x3c, 0x9e, 0xc4, 0x3d, 0xcf, 0x1f, 0xd0, 0x37, 0xac, 0xe7, 0xeb, 0x8e, 0x81,
+	0x3d, 0x6b, 0x40, 0x4d, 0xff, 0xce, 0xfe, 0x75, 0x68, 0xee, 0xa1, 0x8e, 0xf0, 0x3d, 0xff, 0x64,
+	0x9d, 0x2f, 0xf5, 0x68, 0xda, 0x88, 0x31, 0xe6, 0x6a, 0x41, 0xa4, 0x50, 0x0e, 0x4e, 0x83, 0x28,
+	0x16, 0x23, 0xcf, 0x7e, 0xdb, 0xff, 0xd9, 0x82, 0x39, 0x94, 0xe1, 0xe7, 0xae, 0x7f, 0x21, 0xe7,
+	0xef, 0x1e, 0xd4, 0x30, 0xab, 0xc3, 0x60, 0x9d, 0x1b, 0x48, 0x7c, 0xe1, 0x7f, 0xa4, 0x2d, 0x25,
+	0x1a, 0xf7, 0x13, 0x9d, 0x15, 0x6d, 0xfa, 0x0b, 0xc7, 0xf8, 0x1a, 0x25, 0x2d, 0x76, 0xc3, 0x13,
+	0x1a, 0x33, 0xd3, 0x49, 0x98, 0x52, 0xc0, 0xa1, 0x8d, 0xc0, 0x3f, 0x26, 0x2b, 0x50, 0x8b, 0xdc,
+	0xb8, 0x37, 0xa6, 0x21, 0xeb, 0x35, 0x36, 0x14, 0x45, 0x07, 0x22, 0x37, 0x3e, 0xa0, 0xe1, 0xb3,
+	0x8b, 0x98, 0xb6, 0x7f, 0x03, 0xe6, 0x33, 0xa5, 0xa0, 0x80, 0x26, 0x4d, 0xc4, 0x9f, 0x64, 0x11,
+	0xca, 0x67, 0xee, 0x70, 0x42, 0x85, 0x45, 0xc7, 0x13, 0x9f, 0x16, 0x3e, 0xb6, 0xec, 0xf7, 0xa0,
+	0x99, 0x54, 0x5b, 0xcc, 0x47, 0x02, 0x25, 0xec, 0x41, 0x91, 0x01, 0xfb, 0x6d, 0xff, 0x79, 0x8b,
+	0x33, 0x6e, 0x04, 0x9e, 0xb2, 0x8e, 0x90, 0x11, 0x8d, 0x28, 0xc9, 0x88, 0xbf, 0xa7, 0x5a, 0x8f,
+	0xbf, 0x78, 0x63, 0xed, 0x87, 0x30, 0xaf, 0x55, 0xe1, 0x92, 0xca, 0xfe, 0xc4, 0x82, 0xf9, 0x17,
+	0xf4, 0x5c, 0x8c, 0xba, 0xac, 0xed, 0xc7, 0x50, 0x8a, 0x2f, 0xc6, 0x5c, 0x25, 0x34, 0xd6, 0x1e,
+	0x88, 0x41, 0xcb, 0xf0, 0x3d, 0x11, 0xc9, 0xc3, 0x8b, 0x31, 0x75, 0xd8, 0x17, 0xf6, 0xaf, 0x43,
+	0x55, 0x03, 0xc9, 0x2d, 0x58, 0x78, 0xb5, 0x7b, 0xf8, 0xa2, 0xd3, 0xed, 0xf6, 0x0e, 0x5e, 0x3e,
+	0xfb, 0x6e, 0xe7, 0x07, 0xbd, 0x9d, 0xf5, 0xee, 0x4e, 0xf3, 0x06, 0x59, 0x06, 0xf2, 0xa2, 0xd3,
+	0x3d, 0xec, 0x6c, 0x1a, 0xb8, 0x65, 0x3f, 0x01, 0xa2, 0x17, 0x93, 0x88, 0xbd, 0x30, 0x41, 0xa5,
+	0x05, 0x2e, 0x92, 0xf6, 0xe7, 0xd0, 0xee, 0xd2, 0xb8, 0x7b, 0x4e, 0xe9, 0x78, 0x93, 0x46, 0xb1,
+	0xe7, 0x33, 0x9b, 0x5a, 0xb6, 0x63, 0xea, 0x77, 0xd8, 0x17, 0x6f, 0xc6, 0x93, 0x23, 0x39, 0x8d,
+	0xf1, 0xb7, 0x7d, 0x0f, 0xee, 0xe4, 0xe6, 0x25, 0xb6, 0x05, 0x77, 0xa1, 0xbd, 0x3d, 0xb5, 0x28,
+	0xfb, 0x35, 0xdc, 0xd9, 0x9e, 0xfe, 0xf1, 0xd7, 0xab, 0x09, 0xea, 0x1d, 0x9f, 0xbe, 0x31, 0x45,
+	0x58, 0x43, 0xec, 0xf7, 0x80, 0x74, 0xbd, 0x13, 0xff, 0x39, 0x8d, 0x22, 0xf7, 0x44, 0xad, 0x99,
+	0x4d, 0x28, 0x8e, 0xa2, 0x13, 0xb1, 0x42, 0xe1, 0x4f, 0xfb, 0x43, 0x58, 0x30, 0xf8, 0x44, 0x65,
+	0xee, 0x42, 0x25, 0xf2, 0x4e, 0x7c, 0x37, 0x46, 0xd5, 0xcc, 0xab, 0x93, 0x00, 0xf6, 0x16, 0x2c,
+	0x7e, 0x41, 0x43, 0xef, 0xf8, 0xe2, 0xaa, 0xec, 0xcd, 0x7c, 0x0a, 0xe9, 0x7c, 0x3a, 0xb0, 0x94,
+	0xca, 0x47, 0x14, 0xcf, 0x45, 0x4c, 0x4c, 0xc4, 0x59, 0x87, 0x27, 0x34, 0x85, 0x53, 0xd0, 0x15,
+	0x8e, 0xfd, 0x12, 0xc8, 0x46, 0xe0, 0xfb, 0xb4, 0x1f, 0x1f, 0x50, 0x1a, 0x26, 0x0e, 0x84, 0x44,
+	0x9e, 0xaa, 0x6b, 0xb7, 0xc4, 0x0c, 0x4d, 0x6b, 0x31, 0x21, 0x68, 0x04, 0x4a, 0x63, 0x1a, 0x8e,
+	0x58, 0xc6, 0xb3, 0x0e, 0xfb, 0x6d, 0x2f, 0xc1, 0x82, 0x91, 0xad, 0x18, 0xe4, 0x0f, 0x60, 0x69,
+	0xd3, 0x8b, 0xfa, 0xd9, 0x02, 0x5b, 0x30, 0x33, 0x9e, 0x1c, 0xf5, 0x12, 0x6d, 0x21, 0x93, 0xb8,
+	0x25, 0x4a, 0x7f, 0x22, 0x32, 0xfb, 0x3d, 0x0b, 0x4a, 0x3b, 0x87, 0x7b, 0x1b, 0xa4, 0x0d, 0xb3,
+	0x9e, 0xdf, 0x0f, 0x46, 0x68, 0x25, 0xf0, 0x46, 0xab, 0xf4, 0x54, 0x2d, 0x70, 0x17, 0x2a, 0xcc,
+	0xac, 0x41, 0x43, 0x50, 0xec, 0xf5, 0x13, 0x00, 0x77, 0x98, 0xf4, 0xcd, 0xd8, 0x0b, 0xd9, 0x2c,
+	0x93, 0x1b, 0xc3, 0x12, 0x9b, 0x28, 0x59, 0x82, 0xfd, 0xff, 0x4a, 0x30, 0x23, 0x56, 0x21, 0x56,
+	0x5e, 0x3f, 0xf6, 0xce, 0xa8, 0xa8, 0x89, 0x48, 0xa1, 0x39, 0x18, 0xd2, 0x51, 0x10, 0xa7, 0xd6,
+	0x76, 0x13, 0x44, 0xae, 0x3e, 0xcf, 0xa8, 0x37, 0xc6, 0xf5, 0x4c, 0xac, 0xec, 0x26, 0x88, 0x9d,
+	0x85, 0x40, 0xcf, 0x1b, 0xb0, 0x3a, 0x95, 0x1c, 0x99, 0xc4, 0x9e, 0xe8, 0xbb, 0x63, 0xb7, 0xef,
+	0xc5, 0x17, 0x42, 0x6d, 0xa9, 0x34, 0xe6, 0x3d, 0x0c, 0xfa, 0xee, 0xb0, 0x77, 0xe4, 0x0e, 0x5d,
+	0xbf, 0x4f, 0xc5, 0x36, 0xd6, 0x04, 0x71, 0xa7, 0x2a, 0xaa, 0x24, 0xd9, 0xf8, 0x6e, 0x36, 0x85,
+	0xa2, 0x0c, 0xf5, 0x83, 0xd1, 0xc8, 0x8b, 0x71, 0x67, 0xc0, 0x36, 0x3f, 0x45, 0x47, 0x43, 0x58,
+	0x4b, 0x78, 0x4a, 0x58, 0xce, 0x15, 0x5e, 0x9a, 0x01, 0x62, 0x2e, 0x68, 0x5c, 0xa1, 0xaa, 0x7d,
+	0x7d, 0xce, 0xf6, 0x31, 0x45, 0x47, 0x43, 0x70, 0x1c, 0x26, 0x7e, 0x44, 0xe3, 0x78, 0x48, 0x07,
+	0xaa, 0x42, 0x55, 0xc6, 0x96, 0x25, 0x90, 0xa7, 0xb0, 0xc0, 0xf7, 0xdc, 0x91, 0x1b, 0x07, 0xd1,
+	0xa9, 0x17, 0xf5, 0x22, 0xea, 0xcb, 0x1d, 0x4b, 0x1e, 0x89, 0x7c, 0x0c, 0xb7, 0x52, 0x70, 0x48,
+	0xfb, 0xd4, 0x3b, 0xa3, 0x03, 0xb6, 0x89, 0x29, 0x3a, 0xd3, 0xc8, 0x68, 0x9b, 0xa0, 0xab, 0x61,
+	0x32, 0x1e, 0xb8, 0x68, 0x61, 0x34, 0xd8, 0x38, 0xe8, 0x10, 0xf9, 0x00, 0xea, 0x63, 0xca, 0xcd,
+	0x80, 0xd3, 0x78, 0xd8, 0x8f, 0x5a, 0x73, 0x6c, 0x8d, 0xae, 0x0a, 0x61, 0xc2, 0x99, 0xeb, 0x98,
+	0x1c, 0x38, 0x29, 0xfb, 0x11, 0x33, 0x47, 0xdd, 0x8b, 0x56, 0x53, 0xec, 0xa2, 0x24, 0xc0, 0x64,
+	0x24, 0xf4, 0xce, 0xdc, 0x98, 0xb6, 0xe6, 0xb9, 0x75, 0x26, 0x92, 0xf6, 0xdf, 0xb2, 0x60, 0x61,
+	0xcf, 0x8b, 0x62, 0x31, 0x09, 0xd5, 0x42, 0xf3, 0x0e, 0x54, 0xf9, 0xf4, 0xeb, 0x05, 0xfe, 0xf0,
+	0x42, 0xcc, 0x48, 0xe0, 0xd0, 0xbe, 0x3f, 0xbc, 0x20, 0xdf, 0x82, 0xba, 0xe7, 0xeb, 0x2c, 0x5c,
+	0x86, 0x6b, 0x9e, 0xaf, 0x31, 0xbd, 0x03, 0xd5, 0xf1, 0xe4, 0x68, 0xe8, 0xf5, 0x39, 0x4b, 0x91,
+	0xe7, 0xc2, 0x21, 0xc6, 0x80, 0xbb, 0x09, 0x5e, 0x13, 0xce, 0x51, 0x62, 0x1c, 0x55, 0x81, 0x21,
+	0x8b, 0xfd, 0x0c, 0x16, 0xcd, 0x0a, 0x0a, 0x65, 0xf5, 0x18, 0x66, 0xc5, 0xdc, 0x8e, 0x5a, 0x55,
+	0xd6, 0x3f, 0x0d, 0xd1, 0x3f, 0x82, 0xd5, 0x51, 0x74, 0xfb, 0xbf, 0x97, 0x60, 0x41, 0xa0, 0x1b,
+	0xc3, 0x20, 0xa2, 0xdd, 0xc9, 0x68, 0xe4, 0x86, 0x39, 0x42, 0x63, 0x5d, 0x21, 0x34, 0x05, 0x53,
+	0x68, 0x70, 0x2a, 0x9f, 0xba, 0x9e, 0xcf, 0xb7, 0x42, 0x5c, 0xe2, 0x34, 0x84, 0x3c, 0x82, 0xb9,
+	0xfe, 0x30, 0x88, 0xb8, 0x3d, 0xa7, 0x7b, 0x91, 0xd2, 0x70, 0x56, 0xc8, 0xcb, 0x79, 0x42, 0xae,
+	0x0b, 0xe9, 0xcd, 0x94, 0x90, 0xda, 0x50, 0xc3, 0x4c, 0xa9, 0xd4, 0x39, 0x33, 0xdc, 0xbe, 0xd4,
+	0x31, 0xac, 0x4f, 0x5a, 0x24, 0xb8, 0xfc, 0xcd, 0xe5, 0x09, 0x84, 0xdc, 0xed, 0x6a, 0xdc, 0x15,
+	0x21, 0x10, 0x59, 0x12, 0xd9, 0x02, 0xe0, 0x65, 0x31, 0x03, 0x05, 0x98, 0x81, 0xf2, 0x9e, 0x39,
+	0x22, 0x7a, 0xdf, 0x3f, 0xc1, 0xc4, 0x24, 0xa4, 0xcc, 0x44, 0xd1, 0xbe, 0x24, 0x1f, 0x42, 0x35,
+	0xa4, 0x51, 0x30, 0x9c, 0x70, 0xbf, 0x14, 0x1f, 0xda, 0x79, 0x91, 0x91, 0xa3, 0x28, 0x8e, 0xce,
+	0x65, 0xff, 0x65, 0x0b, 0xaa, 0x5a, 0x86, 0x64, 0x09, 0xe6, 0x37, 0xf6, 0xf7, 0x0f, 0x3a, 0xce,
+	0xfa, 0xe1, 0xee, 0x17, 0x9d, 0xde, 0xc6, 0xde, 0x7e, 0xb7, 0xd3, 0xbc, 0x81, 0xf0, 0xde, 0xfe,
+	0xc6, 0xfa, 0x5e, 0x6f, 0x6b, 0xdf, 0xd9, 0x90, 0xb0, 0x85, 0x36, 0x8f, 0xd3, 0x79, 0xbe, 0x7f,
+	0xd8, 0x31, 0xf0, 0x02, 0x69, 0x42, 0xed, 0x99, 0xd3, 0x59, 0xdf, 0xd8, 0x11, 0x48, 0x91, 0x2c,
+	0x42, 0x73, 0xeb, 0xe5, 0x8b, 0xcd, 0xdd, 0x17, 0xdb, 0xbd, 0x8d, 0xf5, 0x17, 0x1b, 0x9d, 0x3d,
+	0x74, 0x25, 0x90, 0x3a, 0x54, 0xd6, 0x9f, 0xad, 0xbf, 0xd8, 0xdc, 0x7f, 0xd1, 0xd9, 0x6c, 0x96,
+	0xed, 0xff, 0x5b, 0x04, 0x48, 0x2a, 0x4a, 0x3e, 0x47, 0xbf, 0xab, 0x4c, 0xf5, 0x34, 0xf3, 0x6d,
+	0x25, 0xd3, 0x28, 0xed, 0x27, 0xeb, 0x97, 0xf4, 0x87, 0xe4, 0xd7, 0x60, 0x26, 0x98, 0xc4, 0xfd,
+	0x60, 0xc4, 0x97, 0xf5, 0xc6, 0xda, 0xb7, 0x2e, 0xcb, 0x63, 0x9f, 0xb3, 0x3a, 0xf2, 0x1b, 0x9c,
+	0x3f, 0xb8, 0xdf, 0xd0, 0xd6, 0x07, 0x95, 0xd6, 0x96, 0xbb, 0x52, 0xda, 0x65, 0x1a, 0xd1, 0x7e,
+	0xe0, 0x0f, 0x7a, 0x43, 0x7a, 0x46, 0x87, 0x6c, 0x63, 0x22, 0x7d, 0x65, 0x19, 0x02, 0x4a, 0x44,
+	0x84, 0xa6, 0x16, 0x67, 0xe3, 0x6e, 0x32, 0x0d, 0x61, 0x96, 0x09, 0x4b, 0xa1, 0xee, 0xe7, 0xeb,
+	0x43, 0x02, 0xa0, 0x14, 0xf0, 0x84, 0x34, 0xc9, 0x66, 0xb9, 0x14, 0x18, 0xa0, 0xbd, 0x0f, 0x0d,
+	0xb3, 0x9f, 0x08, 0xc0, 0xcd, 0x8d, 0xfd, 0xe7, 0xcf, 0x77, 0xd1, 0x27, 0x34, 0x0f, 0xf5, 0xdd,
+	0x17, 0x1b, 0xfb, 0xcf, 0x71, 0x8c, 0x50, 0x53, 0x36, 0x2d, 0x84, 0xf6, 0x5f, 0x1e, 0x6e, 0xef,
+	0x2b, 0xa8, 0x80, 0xbe, 0x22, 0xa7, 0xf3, 0xc5, 0xfe, 0x77, 0x3b, 0x9b, 0xcd, 0xa2, 0xbd, 0x05,
+	0xf3, 0x99, 0x4e, 0x43, 0x8e, 0x8d, 0xbd, 0xf5, 0xdd, 0xe7, 0x9d, 0xcd, 0xe6, 0x0d, 0x4c, 0x1c,
+	0xee, 0x3e, 0xef, 0xec, 0xbf, 0x44, 0x3f, 0xd3, 0x2c, 0x94, 0xf6, 0xf6, 0x99, 0x93, 0xc9, 0x18,
+	0xf8, 0xa2, 0xfd, 0x1f, 0x2d, 0x58, 0x62, 0x73, 0x7c, 0x90, 0x56, 0xa7, 0x2b, 0x50, 0xed, 0x07,
+	0xc1, 0x98, 0x86, 0xae, 0xb6, 0xc0, 0xeb, 0x10, 0xaa, 0x4a, 0xbe, 0x9c, 0x1e, 0x07, 0x61, 0x9f,
+	0x0a, 0x6d, 0x0a, 0x0c, 0xda, 0x42, 0x04, 0x55, 0xa5, 0x50, 0x06, 0x9c, 0x83, 0x2b, 0xd3, 0x2a,
+	0xc7, 0x38, 0xcb, 0x32, 0xdc, 0x3c, 0x0a, 0xa9, 0xdb, 0x3f, 0x15, 0x7a, 0x54, 0xa4, 0xd0, 0x3f,
+	0x2f, 0xb7, 0x95, 0x7d, 0x94, 0xd5, 0x21, 0xe5, 0x23, 0x38, 0xeb, 0xcc, 0x09, 0x7c, 0x43, 0xc0,
+	0x38, 0x3e, 0xee, 0x91, 0xeb, 0x0f, 0x02, 0x9f, 0xf2, 0xe1, 0x9b, 0x75, 0x12, 0xc0, 0x3e, 0x80,
+	0xe5, 0x74, 0xfb, 0x84, 0x36, 0xfe, 0x48, 0xd3, 0xc6, 0x7c, 0x47, 0xd9, 0x9e, 0x2e, 0xfb, 0x9a,
+	0x66, 0xfe, 0x6f, 0x16, 0x94, 0xd0, 0x34, 0x9b, 0x6e, 0xc6, 0xe9, 0x16, 0x7a, 0xd1, 0xb4, 0xd0,
+	0xd1, 0x3f, 0x8f, 0x3b, 0x71, 0xbe, 0x58, 0x73, 0x83, 0x46, 0x43, 0x12, 0x7a, 0x48, 0xfb, 0x67,
+	0xad, 0xb2, 0x4e, 0x47, 0x04, 0xc5, 0x01, 0xb7, 0x6b, 0xec, 0x6b, 0xa1, 0x4e, 0x65, 0x5a, 0xd2,
+	0xd8, 0x97, 0x33, 0x09, 0x8d, 0x7d, 0xd7, 0x82, 0x19, 0xcf, 0x3f, 0x0a, 0x26, 0xfe, 0x80, 0x4d,
+	0xd0, 0x59, 0x47, 0x26, 0xb1, 0xfb, 0xc6, 0x4c, 0xad, 0x7b, 0x23, 0xa9, 0x2c, 0x13, 0xc0, 0x26,
+	0xb8, 0x9d, 0x8f, 0x98, 0x29, 0xaa, 0xbc, 0xf3, 0x1f, 0xc1, 0xbc, 0x86, 0x89, 0xde, 0x7c, 0x17,
+	0xca, 0x63, 0x04, 0x5a, 0x96, 0xb1, 0xf0, 0x23, 0x93, 0xc3, 0x29, 0x76, 0x13, 0x8f, 0xee, 0xe2,
+	0x5d, 0xff, 0x38, 0x90, 0x39, 0xfd, 0xaf, 0x12, 0xcc, 0x29, 0x48, 0x64, 0xf4, 0x08, 0xe6, 0xbc,
+	0x01, 0xf5, 0x63, 0xf4, 0x43, 0x1a, 0x5e, 0x83, 0x34, 0x8c, 0xb6, 0xbf, 0x3b, 0xf4, 0xdc, 0x48,
+	0x58, 0x97, 0x3c, 0x41, 0xd6, 0x60, 0x11, 0x0d, 0x13, 0x69, 0x6b, 0xa8, 0x21, 0xe6, 0x3b, 0x9f,
+	0x5c, 0x1a, 0x2e, 0x1d, 0x88, 0x0b, 0xdb, 0x40, 0x7d, 0xc2, 0x6d, 0xe0, 0x3c, 0x12, 0xf6, 0x1a,
+	0xcf, 0x09, 0x9b, 0x5c, 0xe6, 0xc6, 0x8b, 0x02, 0x32, 0xa7, 0x2c, 0x37, 0xf9, 0xc2, 0x96, 0x3e,
+	0x65, 0xd1, 0x4e, 0x6a, 0x66, 0x33, 0x27, 0x35, 0xb8, 0xf0, 0x5d, 0xf8, 0x7d, 0x3a, 0xe8, 0xc5,
+	0x41, 0x8f, 0x2d, 0xd0, 0x6c, 0x74, 0x66, 0x9d, 0x34, 0x8c, 0x63, 0x1b, 0xd3, 0x28, 0xf6, 0x69,
+	0xcc, 0xd6, 0xb0, 0x59, 0x47, 0x26, 0x51, 0xba, 0x18, 0x0b, 0x5f, 0x93, 0x2a, 0x8e, 0x48, 0xe1,
+	0x26, 0x66, 0x12, 0x7a, 0x51, 0xab, 0xc6, 0x50, 0xf6, 0x1b, 0xfd, 0x72, 0x47, 0x34, 0x8a, 0x7b,
+	0xa7, 0xd4, 0x1d, 0xd0, 0x90, 0x8d, 0x3e, 0x3f, 0x00, 0xe2, 0xb6, 0x61, 0x3e, 0x11, 0xcb, 0x3e,
+	0xa3, 0x61, 0xe4, 0x05, 0x3e, 0xb3, 0x0a, 0x2b, 0x8e, 0x4c, 0x92, 0xcf, 0x61, 0x05, 0x3b, 0x24,
+	0x7a, 0xed, 0x8d, 0xc7, 0x74, 0xd0, 0x93, 0x7b, 0x94, 0xde, 0x49, 0xd0, 0x0b, 0x7c, 0xd1, 0xa0,
+	0x39, 0x36, 0xbf, 0xaf, 0xe4, 0x4b, 0xe7, 0x15, 0x4c, 0xe2, 0x93, 0x20, 0x9d, 0x57, 0x33, 0x9b,
+	0x57, 0x1e, 0x9f, 0xfd, 0x25, 0xdb, 0x03, 0xaa, 0x23, 0xb3, 0x97, 0xcc, 0x80, 0x25, 0x77, 0xa0,
+	0xc2, 0xfb, 0x3e, 0x3a, 0x75, 0xc5, 0xb6, 0x74, 0x96, 0x01, 0xdd, 0x53, 0x17, 0xf5, 0x98, 0x31,
+	0x9c, 0xfc, 0x0c, 0xb2, 0xca, 0xb0, 0x1d, 0x3e, 0x9a, 0x0f, 0xa0, 0x21, 0x0f, 0xe3, 0xa2, 0xde,
+	0x90, 0x1e, 0xc7, 0xd2, 0x59, 0xe6, 0x4f, 0x46, 0x58, 0x5c, 0xb4, 0x47, 0x8f, 0x63, 0xfb, 0x05,
+	0xcc, 0x0b, 0xdd, 0xb2, 0x3f, 0xa6, 0xb2, 0xe8, 0x4f, 0xf2, 0x2c, 0xba, 0xc4, 0x9d, 0xa8, 0x7b,
+	0xfc, 0x52, 0x66, 0x9e, 0xed, 0x00, 0xd1, 0x75, 0x95, 0xc8, 0x50, 0x98, 0x55, 0xd2, 0x25, 0x27,
+	0x9a, 0x63, 0x60, 0xba, 0xf3, 0xb3, 0x60, 0x38, 0x3f, 0xed, 0xdf, 0x2d, 0xc0, 0x02, 0xcb, 0x4d,
+	0xe4, 0x9c, 0xf8, 0x71, 0xae, 0x5f, 0xcd, 0x5a, 0x5f, 0x4b, 0xa1, 0x9c, 0xea, 0x2b, 0x04, 0x4f,
+	0x7c, 0x7d, 0xcf, 0x54, 0x29, 0xed, 0x99, 0xc2, 0xf3, 0x84, 0x01, 0x1d, 0x7a, 0xec, 0x78, 0x58,
+	0xea, 0x5b, 0xbe, 0xcc, 0x67, 0x70, 0xf2, 0x98, 0x9f, 0xee, 0x18, 0x39, 0x72, 0x05, 0x9a, 0xc1,
+	0xed, 0x3f, 0x28, 0xc0, 0x3c, 0x57, 0xfe, 0xb1, 0x1b, 0x4f, 0x22, 0xd1, 0xad, 0xbf, 0x0a, 0x75,
+	0x6e, 0xf3, 0x09, 0xf5, 0x21, 0x3a, 0x60, 0x51, 0x69, 0x3a, 0x86, 0x72, 0xe6, 0x9d, 0x1b, 0x8e,
+	0xc9, 0x4c, 0x7e, 0x03, 0x6a, 0xfa, 0x49, 0xad, 0x70, 0x2d, 0xdf, 0x96, 0xbd, 0x97, 0x99, 0x91,
+	0x3b, 0x37, 0x1c, 0xe3, 0x03, 0xf2, 0x19, 0x33, 0xdc, 0xfd, 0x1e, 0xcb, 0xb6, 0x55, 0x34, 0x3f,
+	0xcf, 0x4c, 0x82, 0x9d, 0x1b, 0x8e, 0xc6, 0x4e, 0x3e, 0xe1, 0x5b, 0x4f, 0xbe, 0x5b, 0x6b, 0x95,
+	0x0c, 0x47, 0xc7, 0x06, 0x9f, 0x17, 0x5b, 0x54, 0xfb, 0x34, 0x61, 0x7e, 0x36, 0x0b, 0x37, 0xf9,
+	0x2f, 0xfb, 0x19, 0x34, 0xd3, 0xbc, 0xcc, 0x2f, 0x4f, 0x29, 0x76, 0x1f, 0x3f, 0x51, 0x75, 0x64,
+	0x12, 0x47, 0x9d, 0x99, 0x02, 0x72, 0xd4, 0x59, 0xc2, 0xde, 0x86, 0xba, 0xd1, 0x51, 0x86, 0x23,
+	0xb1, 0xc6, 0x1d, 0x89, 0x19, 0xbf, 0x73, 0x21, 0xeb, 0x77, 0xb6, 0xff, 0xa7, 0x05, 0xcd, 0x67,
+	0x6e, 0xdc, 0x3f, 0x45, 0x49, 0x92, 0xfe, 0x08, 0xdc, 0xa7, 0x06, 0x03, 0xaa, 0xaf, 0x1b, 0x35,
+	0x47, 0x87, 0x70, 0x75, 0x10, 0x36, 0x8b, 0xb0, 0x2e, 0x0c, 0x7f, 0x49, 0x2e, 0x0d, 0xd7, 0xd5,
+	0xf1, 0x04, 0x0f, 0x85, 0x5c, 0x79, 0xfc, 0xa2, 0xd2, 0xfa, 0x36, 0xb5, 0x64, 0x6c, 0x53, 0xd1,
+	0x30, 0x1c, 0xe1, 0xa6, 0x2a, 0x1e, 0xf6, 0xf9, 0x59, 0x62, 0x59, 0x9c, 0x25, 0xea, 0x20, 0x4e,
+	0x4b, 0x61, 0x22, 0x25, 0x7b, 0x61, 0xbe, 0x5a, 0x64, 0x70, 0xfb, 0xa7, 0x16, 0xdc, 0x4a, 0x37,
+	0x59, 0x4a, 0xe7, 0x87, 0x19, 0x63, 0x46, 0x0e, 0x6f, 0xe6, 0x0b, 0xc5, 0x88, 0xdd, 0xa5, 0x8b,
+	0xa0, 0x50, 0x6b, 0x1a, 0x84, 0x23, 0x61, 0x48, 0x0c, 0x6f, 0xbe, 0x81, 0xe1, 0x52, 0x88, 0x6d,
+	0x42, 0xfe, 0x48, 0x44, 0x87, 0x24, 0x00, 0xb3, 0xc5, 0x51, 0x06, 0x7a, 0x13, 0x5f, 0x4c, 0x67,
+	0x65, 0xc9, 0x65, 0x09, 0xf6, 0x6f, 0x41, 0x2b, 0xdb, 0x42, 0x61, 0x18, 0xfc, 0x26, 0x34, 0x33,
+	0x8b, 0x3a, 0x6f, 0x6a, 0xae, 0x08, 0x3a, 0x19, 0x6e, 0xfb, 0xa7, 0x45, 0x58, 0x14, 0xb9, 0xae,
+	0xf7, 0xfb, 0x74, 0x1c, 0x6b, 0xb6, 0xee, 0x15, 0xf3, 0xc6, 0xdc, 0x36, 0xf3, 0x43, 0xcb, 0xd4,
+	0xb6, 0x59, 0x2f, 0x0e, 0x37, 0xde, 0xdc, 0xcf, 0x96, 0x86, 0xb1, 0xac, 0x64, 0x7e, 0x49, 0x13,
+	0x50, 0x87, 0xd4, 0x7c, 0x43, 0x32, 0xb7, 0x00, 0x55, 0x1a, 0xeb, 0x31, 0x98, 0x44, 0xb1, 0x76,
+	0x42, 0x57, 0x72, 0x34, 0x04, 0x2d, 0x19, 0x54, 0x67, 0xec, 0xa4, 0xa1, 0xe7, 0xf9, 0xbd, 0xe3,
+	0xa1, 0xda, 0x59, 0x97, 0x9c, 0x3c, 0x12, 0xdb, 0xf0, 0x0b, 0xbd, 0x1e, 0xd2, 0x88, 0x86, 0x67,
+	0x7c, 0x83, 0x5d, 0x72, 0xd2, 0x30, 0xd6, 0x4b, 0x4e, 0x5e, 0x66, 0x8a, 0x94, 0x1c, 0x95, 0xce,
+	0xf1, 0x6d, 0x95, 0x0c, 0xdf, 0x96, 0xe1, 0xec, 0xa9, 0xa6, 0x9d, 0x3d, 0x4f, 0x80, 0x60, 0xd5,
+	0x5c, 0x36, 0x28, 0x74, 0x20, 0x5c, 0x48, 0x35, 0xc6, 0x96, 0x43, 0xd1, 0xa5, 0xae, 0x6e, 0x3a,
+	0x87, 0x02, 0x58, 0x4a, 0x8d, 0xb0, 0x98, 0x3d, 0xcc, 0x55, 0x89, 0x48, 0xe2, 0xaa, 0xc4, 0x54,
+	0xde, 0xc0, 0x15, 0xf2, 0x07, 0x6e, 0x11, 0xca, 0xfc, 0x68, 0x8e, 0x9b, 0xf4, 0x3c, 0x61, 0xff,
+	0xa7, 0x32, 0x90, 0x1c, 0x79, 0x4c, 0xcd, 0xa8, 0x42, 0x76, 0x46, 0x3d, 0x01, 0xa2, 0x25, 0xe5,
+	0xb9, 0x2f, 0xcf, 0x3b, 0x87, 0x32, 0x55, 0x73, 0x95, 0xae, 0xa9, 0xb9, 0xca, 0x29, 0xcd, 0x95,
+	0x5a, 0x7f, 0x6f, 0x5e, 0xb9, 0xfe, 0xce, 0x64, 0xd6, 0x5f, 0x6d, 0x18, 0x66, 0xaf, 0x50, 0x7e,
+	0x95, 0xeb, 0x2a, 0x3f, 0xc8, 0x57, 0x7e, 0xa6, 0x96, 0xa9, 0x5e, 0x4b, 0xcb, 0xd4, 0xa6, 0x68,
+	0x19, 0xe6, 0xc3, 0x8f, 0x8e, 0x62, 0x31, 0x77, 0xd8, 0x6f, 0xac, 0x31, 0x5f, 0xb0, 0xa5, 0x21,
+	0xd1, 0x10, 0x7e, 0x35, 0x1d, 0xc4, 0x5a, 0x7c, 0x49, 0xc3, 0x80, 0x77, 0xd9, 0x1c, 0xdf, 0x6b,
+	0x2a, 0x00, 0x1d, 0xac, 0xb2, 0xde, 0x38, 0x67, 0x84, 0xdc, 0xb0, 0xde, 0x6f, 0x72, 0x07, 0xeb,
+	0x14, 0x32, 0x0b, 0x99, 0x52, 0x42, 0xcc, 0x3e, 0x98, 0xe7, 0x8e, 0x68, 0x13, 0xd5, 0x7a, 0x8c,
+	0x85, 0xaa, 0x30, 0x31, 0x21, 0x46, 0x8f, 0x29, 0x9c, 0xec, 0xc0, 0x3b, 0x1a, 0x96, 0x12, 0x7b,
+	0x3e, 0x2a, 0x0b, 0x4c, 0x4e, 0xaf, 0x62, 0xb3, 0x7f, 0xbf, 0x00, 0x4d, 0x9c, 0xe3, 0x86, 0x39,
+	0xf4, 0x29, 0x30, 0x2b, 0xef, 0x9a, 0xd6, 0x90, 0xc1, 0xfb, 0x8b, 0x1b, 0x43, 0x1f, 0x43, 0x85,
+	0x65, 0x18, 0x8c, 0xa9, 0x2f, 0x6c, 0xa1, 0x96, 0x69, 0x0b, 0x25, 0x06, 0xf6, 0xce, 0x0d, 0x27,
+	0x61, 0x26, 0x9f, 0x42, 0x05, 0xc7, 0x9b, 0x49, 0x8a, 0x30, 0x84, 0xda, 0xca, 0x21, 0xe5, 0x0e,
+	0x2e, 0xb6, 0x82, 0xf0, 0x20, 0x3a, 0x8a, 0xb7, 0xb8, 0x20, 0xe1, 0xb7, 0x8a, 0x5d, 0x33, 0x85,
+	0xfe, 0xbe, 0x05, 0x0b, 0x39, 0xec, 0xa8, 0x4d, 0x94, 0x08, 0x1a, 0x47, 0x74, 0x69, 0x18, 0x47,
+	0x3c, 0xd7, 0x04, 0x49, 0xa1, 0x6a, 0xae, 0xf2, 0xd5, 0x84, 0xfd, 0xce, 0xd3, 0x59, 0xa5, 0x5c,
+	0x9d, 0x65, 0xff, 0x10, 0x6a, 0xac, 0x7a, 0x9e, 0xef, 0x0e, 0xbd, 0x2f, 0x69, 0xde, 0x97, 0xd6,
+	0xd4, 0x65, 0x0a, 0x8f, 0xdf, 0xe8, 0xa0, 0xc7, 0x8a, 0x97, 0x71, 0xb2, 0x09, 0x64, 0xff, 0x59,
+	0x58, 0x14, 0xcd, 0x66, 0xa1, 0x77, 0x1e, 0x0e, 0xcc, 0xf3, 0xe8, 0x84, 0x7c, 0x06, 0x75, 0xde,
+	0x65, 0xa2, 0xd0, 0xd4, 0x46, 0x41, 0xaf, 0x0f, 0x9a, 0xc9, 0x06, 0xef, 0xb3, 0x0a, 0xcc, 0xc4,
+	0xa1, 0x77, 0x72, 0x42, 0x43, 0x7b, 0x59, 0xe5, 0x8f, 0xf3, 0x8e, 0x76, 0x63, 0x3a, 0x46, 0x6d,
+	0x6e, 0xff, 0x3b, 0x0b, 0xaa, 0x62, 0x7a, 0xfd, 0xdc, 0x07, 0x62, 0x97, 0x79, 0x15, 0x1f, 0xc1,
+	0xdc, 0x08, 0x4f, 0x1d, 0xd1, 0xd3, 0x60, 0x1c, 0x86, 0xa5, 0x61, 0x5c, 0x6c, 0xd9, 0x1e, 0x30,
+	0xea, 0xc5, 0xde, 0xb0, 0x27, 0xa9, 0x22, 0xb4, 0x26, 0x8f, 0x84, 0x6b, 0x48, 0x14, 0x63, 0xd8,
+	0x13, 0xb7, 0xf1, 0x78, 0xc2, 0xfe, 0x4b, 0x65, 0xe1, 0x1e, 0x3c, 0xa3, 0xa1, 0x43, 0xc7, 0x41,
+	0x18, 0x93, 0x1d, 0x74, 0xae, 0x73, 0x44, 0xf7, 0xbf, 0xda, 0xba, 0xef, 0x54, 0x71, 0xab, 0x24,
+	0xf3, 0xc0, 0x9a, 0x1f, 0x1a, 0x4d, 0x2d, 0x4c, 0x75, 0xa0, 0x16, 0x8d, 0xee, 0xf9, 0x44, 0x56,
+	0xb3, 0x94, 0xf5, 0xd8, 0x66, 0x4b, 0xed, 0x22, 0xab, 0x68, 0x0b, 0xea, 0x51, 0x76, 0x66, 0x78,
+	0xa1, 0x47, 0x98, 0xd6, 0x1d, 0x13, 0xcc, 0xeb, 0xe3, 0x9b, 0x5f, 0xab, 0x8f, 0x67, 0xa6, 0xf7,
+	0xb1, 0xe9, 0xcf, 0x9d, 0xcd, 0xf8, 0x73, 0x33, 0x1e, 0xdb, 0x4a, 0x9e, 0xc7, 0xf6, 0x4b, 0xa8,
+	0xe9, 0xbd, 0x8a, 0x8e, 0x75, 0x9c, 0x6d, 0x3d, 0xe9, 0x53, 0xbd, 0xa1, 0x90, 0xee, 0xcb, 0x8d,
+	0x8d, 0x4e, 0xb7, 0xdb, 0xb4, 0xc8, 0x6d, 0x58, 0x62, 0x88, 0xf2, 0xdc, 0x6e, 0xec, 0xbf, 0x10,
+	0xb1, 0x7d, 0x92, 0xa4, 0xfc, 0xbc, 0x92, 0x54, 0xc4, 0x7c, 0xb8, 0x27, 0xb8, 0xd7, 0x7d, 0xd5,
+	0xe9, 0x1c, 0x34, 0x4b, 0xf6, 0x1b, 0xa8, 0x1b, 0x7d, 0x4b, 0x08, 0x34, 0x5e, 0xad, 0xef, 0x1e,
+	0xe2, 0x77, 0x9d, 0xef, 0x1f, 0xec, 0x3a, 0x3f, 0x68, 0xde, 0x60, 0xe1, 0x10, 0x02, 0x13, 0x9f,
+	0x6f, 0xec, 0xbf, 0xd8, 0xe2, 0xb5, 0xd8, 0xda, 0x75, 0xba, 0x87, 0xbd, 0xbd, 0xce, 0x17, 0x9d,
+	0x3d, 0x8c, 0x89, 0xd8, 0xdb, 0xed, 0xee, 0x74, 0x36, 0x9b, 0x05, 0x3c, 0x0b, 0xe8, 0x76, 0x36,
+	0xf6, 0x5f, 0x6c, 0x0a, 0xda, 0x46, 0xf7, 0x8b, 0x66, 0x91, 0x54, 0xa0, 0xdc, 0x7d, 0xd5, 0x39,
+	0x38, 0x6c, 0x96, 0xf0, 0xfc, 0x59, 0x88, 0x56, 0xca, 0x1d, 0x6c, 0xff, 0x83, 0x3a, 0xdc, 0xca,
+	0x90, 0x54, 0x78, 0xbc, 0x38, 0x6f, 0x1c, 0x7a, 0xa3, 0xa3, 0x40, 0x9d, 0xbc, 0x58, 0xfa, 0x51,
+	0xa4, 0x41, 0x22, 0x27, 0xb0, 0x24, 0x15, 0x0e, 0x6a, 0xe5, 0xc4, 0xa0, 0x2f, 0x30, 0x83, 0xfe,
+	0x03, 0x73, 0x15, 0x49, 0x17, 0x28, 0x71, 0xdd, 0xee, 0xca, 0xcf, 0x8f, 0x9c, 0x42, 0x4b, 0x12,
+	0xa4, 0xff, 0x43, 0xf3, 0x08, 0x62, 0x59, 0xef, 0x5f, 0x51, 0x96, 0xe1, 0x3d, 0x76, 0xa6, 0xe6,
+	0x46, 0x2e, 0xe0, 0xbe, 0xa4, 0x31, 0x07, 0x47, 0xb6, 0xbc, 0xd2, 0xb5, 0xda, 0xc6, 0xfc, 0xe2,
+	0x66, 0xa1, 0x57, 0x64, 0x4c, 0x7e, 0x0c, 0xcb, 0xe7, 0xae, 0x17, 0xcb, 0x6a, 0x69, 0x1e, 0xcc,
+	0x32, 0x2b, 0x72, 0xed, 0x8a, 0x22, 0x5f, 0xf1, 0x8f, 0x0d, 0xaf, 0xcf, 0x94, 0x1c, 0xdb, 0xff,
+	0xc6, 0x82, 0x86, 0x99, 0x0f, 0x0a, 0xb3, 0xb0, 0x20, 0xa4, 0xd9, 0x2a, 0x17, 0xbd, 0x14, 0x9c,
+	0x3d, 0xbc, 0x2c, 0xe4, 0x1d, 0x5e, 0xea, 0x47, 0x86, 0xc5, 0xab, 0xce, 0xf5, 0x4b, 0xd7, 0x3b,
+	0xd7, 0x2f, 0xe7, 0x9d, 0xeb, 0xb7, 0xff, 0x8f, 0x05, 0x24, 0x3b, 0x97, 0xc8, 0x36, 0x3f, 0x3d,
+	0xf5, 0xe9, 0x50, 0xac, 0x5d, 0xdf, 0xbe, 0xde, 0x7c, 0x94, 0x7d, 0x27, 0xbf, 0x46, 0xc1, 0xd0,
+	0xcd, 0x16, 0xdd, 0x7f, 0x58, 0x77, 0xf2, 0x48, 0xa9, 0x48, 0x83, 0xd2, 0xd5, 0x91, 0x06, 0xe5,
+	0xab, 0x23, 0x0d, 0x6e, 0xa6, 0x23, 0x0d, 0xda, 0xff, 0xc4, 0x82, 0x85, 0x9c, 0x41, 0xff, 0xe6,
+	0x1a, 0x8e, 0xc3, 0x64, 0xe8, 0x82, 0x82, 0x18, 0x26, 0x1d, 0x4c, 0x9f, 0x9b, 0x16, 0xaf, 0x73,
+	0x6e, 0xda, 0xfe, 0x73, 0x50, 0x37, 0xa4, 0xe3, 0x9b, 0xab, 0x74, 0xda, 0x6f, 0xca, 0x27, 0xa7,
+	0x81, 0xb5, 0xff, 0x76, 0x11, 0x48, 0x56, 0x42, 0xff, 0x44, 0xeb, 0x90, 0xed, 0xdc, 0x62, 0x5e,
+	0xe7, 0xfe, 0x32, 0xcd, 0x98, 0xf7, 0x61, 0x5e, 0x5c, 0xc0, 0xd1, 0x0e, 0xda, 0xf9, 0x34, 0xcb,
+	0x12, 0xd0, 0x73, 0x6c, 0xc6, 0x86, 0xcc, 0x1a, 0x17, 0x37, 0x34, 0x5b, 0x2e, 0x1d, 0x22, 0xf2,
+	0x21, 0x54, 0xa4, 0x31, 0x83, 0xcb, 0x34, 0x7e, 0xb5, 0x94, 0x6b, 0x8b, 0x38, 0x09, 0x1f, 0xda,
+	0x8d, 0xfc, 0x16, 0xd0, 0x33, 0x5e, 0xbe, 0x5c, 0xc1, 0xfe, 0xa6, 0x05, 0x4b, 0x29, 0x42, 0x12,
+	0xbd, 0xce, 0x17, 0x29, 0x73, 0xe5, 0x32, 0x41, 0x6c, 0xb4, 0xda, 0x42, 0xa6, 0xe6, 0x75, 0x96,
+	0x80, 0x9d, 0x3a, 0xf1, 0x33, 0xb0, 0x18, 0xaa, 0x3c, 0x92, 0x7d, 0x4b, 0xb9, 0x2e, 0x52, 0x15,
+	0x3f, 0x86, 0xe5, 0x34, 0x21, 0x89, 0x04, 0x34, 0xab, 0x2c, 0x93, 0xe8, 0x2d, 0x30, 0x16, 0x44,
+	0xb3, 0xbe, 0xb9, 0x34, 0xfb, 0x1f, 0x5b, 0x40, 0xbe, 0x37, 0xa1, 0xe1, 0x05, 0x0b, 0x74, 0x56,
+	0x07, 0xc1, 0xb7, 0xd2, 0xc7, 0x9c, 0x18, 0x4d, 0xf7, 0x5d, 0x7a, 0x21, 0x23, 0xd2, 0x0b, 0x49,
+	0x44, 0xfa, 0x3d, 0x00, 0x3c, 0x05, 0x51, 0xa1, 0xf1, 0x6c, 0x97, 0xee, 0x4f, 0x46, 0x3c, 0xc3,
+	0xdc, 0x38, 0xf4, 0xd2, 0xd5, 0x71, 0xe8, 0xe5, 0xab, 0xe2, 0xd0, 0x3f, 0x83, 0x05, 0xa3, 0xde,
+	0x6a, 0x58, 0x65, 0x90, 0xbe, 0x75, 0x49, 0x90, 0xfe, 0xff, 0xb0, 0xa0, 0xb8, 0x13, 0x8c, 0xf5,
+	0x90, 0x19, 0xcb, 0x0c, 0x99, 0x11, 0xab, 0x56, 0x4f, 0x2d, 0x4a, 0x42, 0x99, 0x19, 0x20, 0x79,
+	0x0c, 0x0d, 0x77, 0x14, 0xe3, 0xa9, 0xdc, 0x71, 0x10, 0x9e, 0xbb, 0x21, 0x77, 0x00, 0x16, 0x9f,
+	0x15, 0x5a, 0x96, 0x93, 0xa2, 0x90, 0x45, 0x28, 0x2a, 0xf5, 0xce, 0x18, 0x30, 0x89, 0xd6, 0x38,
+	0xb7, 0x92, 0x85, 0xcd, 0x2c, 0x52, 0x38, 0x95, 0xcc, 0xef, 0xf9, 0xe6, 0x9d, 0xcb, 0x5b, 0x1e,
+	0x09, 0x57, 0x50, 0x75, 0x85, 0x45, 0x9c, 0x04, 0xcb, 0xb4, 0xfd, 0x5f, 0x2d, 0x28, 0xb3, 0x1e,
+	0x40, 0x0d, 0xc1, 0x67, 0xb8, 0x8a, 0x8d, 0x61, 0x2d, 0xaf, 0x3b, 0x69, 0x98, 0xd8, 0xc6, 0x8d,
+	0xaf, 0x82, 0xaa, 0xb6, 0x86, 0x92, 0x15, 0xa8, 0xf0, 0x94, 0xba, 0xa5, 0xc0, 0x58, 0x12, 0x90,
+	0xdc, 0xc7, 0x70, 0xef, 0xb1, 0xb4, 0x83, 0x40, 0x86, 0x86, 0x05, 0x63, 0x87, 0xe1, 0x49, 0x7d,
+	0x30, 0x3f, 0xdd, 0x67, 0x9e, 0x86, 0x71, 0x7d, 0x57, 0xd9, 0xea, 0x9d, 0x91, 0x42, 0xed, 0xc7,
+	0x30, 0xf7, 0x22, 0x18, 0x50, 0xed, 0xc8, 0x79, 0xea, 0x6c, 0xb6, 0x7f, 0xd7, 0x82, 0x59, 0xc9,
+	0x4c, 0x1e, 0x41, 0x09, 0x8d, 0x96, 0x94, 0x53, 0x43, 0x85, 0x84, 0x22, 0x9f, 0xc3, 0x38, 0x50,
+	0x61, 0xb3, 0x83, 0xbf, 0xc4, 0x80, 0x95, 0xc7, 0x7e, 0x0a, 0x4b, 0xaa, 0x9b, 0x32, 0x6b, 0x52,
+	0xa8, 0xfd, 0x0f, 0x2d, 0xa8, 0x1b, 0x65, 0xe0, 0x2e, 0x7c, 0xe8, 0x46, 0xb1, 0x3c, 0xb8, 0xe1,
+	0xc3, 0xa3, 0x43, 0x7a, 0x10, 0x42, 0xc1, 0x0c, 0x42, 0x50, 0xc7, 0xe3, 0x45, 0xfd, 0x78, 0xfc,
+	0x29, 0x54, 0x92, 0x7b, 0x79, 0x25, 0x43, 0x11, 0x63, 0x89, 0x32, 0xd8, 0x35, 0x61, 0xc2, 0x7c,
+	0xfa, 0xc1, 0x50, 0x5d, 0x49, 0xe0, 0x09, 0xfb, 0x33, 0xa8, 0x6a, 0xfc, 0x58, 0x0d, 0x9f, 0xc6,
+	0xe7, 0x41, 0xf8, 0x5a, 0xc6, 0x42, 0x88, 0xa4, 0x8a, 0x56, 0x2f, 0x24, 0xd1, 0xea, 0xf6, 0xbf,
+	0xb6, 0xf8, 0x1d, 0x29, 0xcf, 0x3f, 0x39, 0x08, 0x86, 0x5e, 0xff, 0x82, 0x8d, 0xbd, 0xba, 0xaa,
+	0xc4, 0x35, 0x83, 0x9c, 0x8b, 0x26, 0x6c, 0x78, 0xa1, 0xb9, 0x20, 0xaa, 0x34, 0x4a, 0x2a, 0xce,
+	0xf3, 0x23, 0x37, 0x12, 0x93, 0x5f, 0xac, 0x8c, 0x06, 0x88, 0xf2, 0xa4, 0x2e, 0x84, 0x8d, 0xbc,
+	0xe1, 0xd0, 0xe3, 0xbc, 0xdc, 0xd8, 0xca, 0x23, 0x61, 0x99, 0x03, 0x2f, 0x72, 0x8f, 0x92, 0x28,
+	0x14, 0x95, 0xb6, 0xff, 0x69, 0x01, 0xaa, 0x42, 0x3d, 0x77, 0x06, 0x27, 0x54, 0x9c, 0x14, 0x60,
+	0x32, 0x51, 0x25, 0x1a, 0x22, 0xe9, 0x86, 0x01, 0xac, 0x21, 0xe9, 0x21, 0x2f, 0x66, 0x87, 0x1c,
+	0x63, 0x0f, 0x82, 0x01, 0xfd, 0x80, 0x59, 0xda, 0x3c, 0x38, 0x2f, 0x01, 0x24, 0x75, 0x8d, 0x51,
+	0xcb, 0x09, 0x95, 0x01, 0x97, 0x86, 0xe3, 0x7d, 0x0c, 0x35, 0x91, 0x0d, 0x1b, 0x93, 0xd6, 0x8c,
+	0x31, 0xf9, 0x8d, 0xf1, 0x72, 0x0c, 0x4e, 0xf9, 0xe5, 0x9a, 0xfc, 0x72, 0xf6, 0xaa, 0x2f, 0x25,
+	0xa7, 0xbd, 0xad, 0xa2, 0x1c, 0xb7, 0x43, 0x77, 0x7c, 0x2a, 0xa5, 0xf4, 0x29, 0x2c, 0x78, 0x7e,
+	0x7f, 0x38, 0x19, 0xd0, 0xde, 0xc4, 0x77, 0x7d, 0x3f, 0x98, 0xf8, 0x7d, 0x2a, 0x83, 0xbc, 0xf3,
+	0x48, 0xf6, 0x00, 0x6a, 0x7a, 0x46, 0xe4, 0x31, 0x94, 0xb1, 0xa0, 0xf4, 0x11, 0x91, 0x29, 0xc2,
+	0x9c, 0x85, 0x3c, 0x82, 0x32, 0x1d, 0x9c, 0x50, 0xb9, 0xfb, 0x24, 0xa6, 0x27, 0x11, 0x47, 0xd5,
+	0xe1, 0x0c, 0xa8, 0x50, 0x10, 0x4d, 0x29, 0x14, 0x73, 0xdd, 0xc0, 0x20, 0x0b, 0x7f, 0x77, 0x80,
+	0x57, 0xa2, 0x5f, 0x70, 0x19, 0xd0, 0xd8, 0xed, 0xbf, 0x58, 0x84, 0xaa, 0x06, 0xa3, 0x6e, 0x38,
+	0xc1, 0x0a, 0xf7, 0x06, 0x9e, 0x3b, 0xa2, 0x31, 0x0d, 0xc5, 0xbc, 0x4f, 0xa1, 0xc8, 0xe7, 0x9e,
+	0x9d, 0x60, 0x48, 0x43, 0x6f, 0x40, 0x4f, 0x42, 0x4a, 0xe5, 0x4d, 0x3e, 0x13, 0x45, 0x3e, 0xf4,
+	0xe3, 0x6a, 0x7c, 0x7c, 0x06, 0xa5, 0x50, 0x19, 0xc0, 0xc2, 0xfb, 0xa8, 0x94, 0x04, 0xb0, 0xf0,
+	0x1e, 0x49, 0x6b, 0xb5, 0x72, 0x8e, 0x56, 0xfb, 0x08, 0x96, 0xb9, 0xfe, 0x12, 0x92, 0xde, 0x4b,
+	0x4d, 0xac, 0x29, 0x54, 0xf4, 0x61, 0x63, 0x9d, 0xa5, 0x48, 0x44, 0xe8, 0x22, 0x9c, 0x61, 0x6d,
+	0xc9, 0xe0, 0xc8, 0xcb, 0x9c, 0xfc, 0x3a, 0xef, 0xac, 0x38, 0xb5, 0xf7, 0xfc, 0x2c, 0xaf, 0xfb,
+	0xc6, 0xe4, 0xad, 0x24, 0x27, 0xfc, 0x3a, 0x6e, 0xd7, 0xa1, 0xda, 0x8d, 0x83, 0xb1, 0x1c, 0x94,
+	0x06, 0xd4, 0x78, 0x52, 0x04, 0xdb, 0xdf, 0x81, 0xdb, 0x6c, 0x16, 0x1d, 0x06, 0xe3, 0x60, 0x18,
+	0x9c, 0x5c, 0x74, 0x27, 0x47, 0x51, 0x3f, 0xf4, 0xc6, 0xb8, 0x03, 0xb1, 0xff, 0xad, 0x05, 0x0b,
+	0x06, 0x55, 0x38, 0xc4, 0x7f, 0x85, 0x0b, 0x81, 0x8a, 0x92, 0xb6, 0x8c, 0xed, 0x0c, 0xce, 0x37,
+	0xce, 0xc8, 0x8f, 0x81, 0xf8, 0xef, 0x88, 0xac, 0x27, 0xc7, 0x6f, 0xf2, 0x43, 0x3e, 0x0b, 0x5b,
+	0xd9, 0x59, 0x28, 0xbe, 0x6f, 0x88, 0x0f, 0x64, 0x16, 0xbf, 0x26, 0xc2, 0x68, 0x07, 0xac, 0x8d,
+	0x72, 0x1f, 0xd5, 0xd6, 0x8e, 0xf7, 0xd5, 0x46, 0x45, 0xd6, 0xa0, 0xaf, 0xc0, 0xc8, 0xfe, 0x2b,
+	0x16, 0x40, 0x52, 0x3b, 0x9c, 0x18, 0xc9, 0x02, 0xc1, 0x1f, 0x38, 0x48, 0x00, 0x0c, 0x85, 0x51,
+	0x61, 0x58, 0xc9, 0x9a, 0x53, 0x95, 0x18, 0x9a, 0x85, 0x0f, 0x61, 0xee, 0x64, 0x18, 0x1c, 0xb1,
+	0x05, 0x9b, 0xdd, 0xde, 0x88, 0x84, 0xf3, 0xba, 0xc1, 0xe1, 0x2d, 0x81, 0x26, 0x0b, 0x54, 0x49,
+	0x5b, 0xa0, 0xec, 0x9f, 0x14, 0x60, 0x3e, 0xd3, 0xe6, 0xa9, 0x52, 0x46, 0xd6, 0x32, 0xea, 0x74,
+	0x4a, 0x4c, 0x0a, 0x3b, 0x03, 0x38, 0xb8, 0xd2, 0xc1, 0xf0, 0x19, 0xbf, 0xb8, 0x8c, 0xc6, 0xb1,
+	0x50, 0x66, 0xa5, 0x4b, 0x94, 0x59, 0x3d, 0xd4, 0x93, 0x18, 0xb5, 0xe8, 0x0e, 0xce, 0x68, 0x18,
+	0x7b, 0x6c, 0xb7, 0xc6, 0x4c, 0x08, 0xae, 0x82, 0xe7, 0x34, 0x9c, 0xad, 0xec, 0x0f, 0x61, 0x4e,
+	0x5c, 0xf3, 0x50, 0x9c, 0xe2, 0x86, 0x76, 0x02, 0x23, 0xa3, 0xfd, 0x77, 0x2d, 0x11, 0x8f, 0x63,
+	0x8e, 0xe1, 0xf4, 0x1e, 0xd1, 0x5b, 0x57, 0x48, 0xb5, 0xee, 0x5b, 0xe2, 0x94, 0x6b, 0x20, 0xb7,
+	0x84, 0x45, 0x2d, 0xe4, 0x7a, 0x20, 0x62, 0x99, 0xcc, 0x2e, 0x2d, 0x5d, 0xa7, 0x4b, 0xed, 0x3f,
+	0xb6, 0x60, 0x66, 0x27, 0x18, 0xef, 0x88, 0xe0, 0x73, 0x26, 0x08, 0xea, 0x7a, 0x98, 0x4c, 0x5e,
+	0x12, 0x96, 0x9e, 0xbb, 0x72, 0xd7, 0xd3, 0x2b, 0xf7, 0x6f, 0xc2, 0x1d, 0x04, 0xc6, 0x61, 0x80,
+	0x9b, 0x3e, 0x2f, 0xc0, 0xbd, 0x04, 0x5b, 0xa6, 0x03, 0x3f, 0x3e, 0x95, 0x6a, 0xec, 0x32, 0x16,
+	0xb6, 0x89, 0xc3, 0xcd, 0x87, 0x70, 0x47, 0x27, 0x77, 0x61, 0xeb, 0x4e, 0x96, 0x60, 0x7f, 0x02,
+	0x15, 0x66, 0x2a, 0xb3, 0x66, 0xbd, 0x0f, 0x15, 0xbc, 0x1b, 0x7e, 0xea, 0xf9, 0xb1, 0x14, 0xee,
+	0x46, 0x62, 0xc3, 0xee, 0xb0, 0x0e, 0x51, 0x0c, 0xf6, 0x5f, 0x2f, 0xc3, 0xcc, 0xae, 0x7f, 0x16,
+	0x78, 0x7d, 0x16, 0xe3, 0x32, 0xa2, 0xa3, 0x40, 0x5e, 0x96, 0xc3, 0xdf, 0xd8, 0x15, 0xec, 0x7a,
+	0xc5, 0x58, 0x9e, 0xad, 0xc8, 0x24, 0x1a, 0x08, 0x61, 0x72, 0x3f, 0x9a, 0x8b, 0x8e, 0x86, 0xe0,
+	0x36, 0x21, 0xd4, 0x1f, 0x0a, 0x10, 0xa9, 0xe4, 0xb6, 0x61, 0x59, 0xbb, 0x6d, 0x88, 0xe5, 0x88,
+	0x40, 0x79, 0x11, 0x1b, 0x2b, 0x93, 0x6c, 0x5b, 0x13, 0x52, 0xee, 0x7d, 0x62, 0xa6, 0xc6, 0x8c,
+	0xd8, 0xd6, 0xe8, 0x20, 0x3b, 0x07, 0x62, 0x1f, 0x70, 0x1e, 0xae, 0x7c, 0x75, 0x88, 0x9d, 0x29,
+	0xa5, 0xae, 0x21, 0x73, 0x8f, 0x7a, 0x1a, 0xe6, 0xf1, 0x5a, 0x4a, 0x91, 0xf2, 0x36, 0x00, 0xbf,
+	0xff, 0x9d, 0xc6, 0xb5, 0xcd, 0x10, 0xbf, 0x01, 0x23, 0x52, 0x6c, 0xa2, 0xb8, 0xc3, 0xe1, 0x91,
+	0xdb, 0x7f, 0xcd, 0x7c, 0xf5, 0xec, 0x94, 0xb7, 0xe2, 0x98, 0x20, 0xd6, 0x5a, 0x1b, 0x4d, 0x76,
+	0xd0, 0x5b, 0x72, 0x74, 0x88, 0xac, 0x41, 0x95, 0xbf, 0x2b, 0xc0, 0xc7, 0xb3, 0xc1, 0xc6, 0xb3,
+	0xa9, 0xef, 0x10, 0xd9, 0x88, 0xea, 0x4c, 0xfa, 0x79, 0xf7, 0x9c, 0x79, 0xde, 0xcd, 0x95, 0xa6,
+	0x08, 0x57, 0xe2, 0x91, 0x88, 0x09, 0xc0, 0xa2, 0x68, 0x78, 0x87, 0x71, 0x86, 0x79, 0xc6, 0x60,
+	0x60, 0xe4, 0x3e, 0xcc, 0xe2, 0xb6, 0x65, 0xec, 0x7a, 0x83, 0x16, 0x51, 0xbb, 0x27, 0x85, 0x61,
+	0x1e, 0xf2, 0x77, 0x4f, 0x1e, 0xdd, 0x16, 0x1d, 0x03, 0xc3, 0xbe, 0x51, 0x69, 0x26, 0x44, 0x8b,
+	0x7c, 0x44, 0x0d, 0xd0, 0x8e, 0x81, 0xac, 0x0f, 0x06, 0x62, 0x6e, 0xea, 0xf1, 0x11, 0xa1, 0x7e,
+	0x3d, 0x5e, 0xa4, 0xf2, 0x46, 0xb7, 0x90, 0x3f, 0xba, 0x97, 0xf6, 0x81, 0xdd, 0x81, 0xea, 0x81,
+	0x76, 0xe1, 0x9e, 0x4d, 0x72, 0x79, 0xd5, 0x5e, 0x08, 0x86, 0x86, 0x68, 0xd5, 0x29, 0xe8, 0xd5,
+	0xb1, 0xff, 0x9e, 0x05, 0x04, 0x83, 0x8f, 0x55, 0xf5, 0x79, 0xd9, 0x36, 0xd4, 0x94, 0x4b, 0x23,
+	0xb9, 0xfc, 0x63, 0x60, 0xc8, 0xc3, 0xaa, 0xd2, 0x0b, 0x8e, 0x8f, 0x23, 0x2a, 0x23, 0x6f, 0x0c,
+	0x0c, 0x67, 0x28, 0xda, 0x38, 0x68, 0x2f, 0x78, 0xbc, 0x84, 0x48, 0x84, 0xe0, 0x64, 0x70, 0xd4,
+	0xb3, 0x21, 0x45, 0x8f, 0x93, 0x12, 0x2d, 0x95, 0x56, 0x77, 0x94, 0xd2, 0xbd, 0xfc, 0x18, 0xcf,
+	0x2a, 0x45, 0xbe, 0xa6, 0x0a, 0x91, 0x9c, 0x8a, 0x8e, 0xaa, 0x8a, 0x59, 0xfd, 0x46, 0xa5, 0xb9,
+	0xda, 0xcc, 0x12, 0x30, 0x9c, 0xe4, 0xd8, 0x0b, 0xd3, 0xec, 0x45, 0xc6, 0x9e, 0x43, 0xb1, 0x5f,
+	0xc1, 0x82, 0x28, 0x52, 0x37, 0x6e, 0xcc, 0x41, 0xb4, 0xae, 0x9a, 0xc8, 0x85, 0xec, 0x44, 0xb6,
+	0x7f, 0x66, 0xc1, 0x8c, 0x18, 0x69, 0x36, 0x2c, 0xe9, 0x97, 0x17, 0x2a, 0x8e, 0x81, 0x91, 0x96,
+	0x71, 0x49, 0x9a, 0xcd, 0x7a, 0x0e, 0x64, 0x15, 0x54, 0x31, 0x4f, 0x41, 0xe1, 0x01, 0xb9, 0x1b,
+	0x9f, 0xb2, 0xbd, 0x6c, 0xc5, 0x61, 0xbf, 0x49, 0x93, 0xfb, 0x57, 0xb8, 0x22, 0xc4, 0x9f, 0xb9,
+	0x4f, 0x4f, 0xf0, 0xf5, 0x36, 0x83, 0x63, 0x1f, 0xb0, 0x0a, 0xf4, 0x12, 0xf7, 0x49, 0x02, 0xe0,
+	0xcc, 0xe5, 0x09, 0x26, 0x61, 0xe2, 0x2e, 0x60, 0x82, 0xd8, 0x4b, 0x7c, 0xe4, 0x45, 0x17, 0xa8,
+	0xf3, 0x33, 0x71, 0x27, 0x2c, 0x81, 0x93, 0x19, 0x21, 0x2a, 0x90, 0x9e, 0x11, 0x82, 0xd5, 0x51,
+	0x74, 0xbb, 0x0d, 0xad, 0x4d, 0x3a, 0xa4, 0x31, 0x5d, 0x1f, 0x0e, 0xd3, 0xf9, 0xdf, 0x81, 0xdb,
+	0x39, 0x34, 0x61, 0xcf, 0x7e, 0x0f, 0x96, 0xd6, 0xf9, 0x8d, 0x88, 0x6f, 0x2a, 0xa8, 0x17, 0x4f,
+	0x0a, 0xd3, 0x59, 0x8a, 0xc2, 0xfe, 0x85, 0x05, 0x8b, 0xdd, 0xf1, 0xd0, 0xeb, 0xa7, 0x23, 0x88,
+	0x7f, 0xfe, 0x40, 0xe7, 0xa9, 0xe7, 0xf8, 0xd2, 0xb9, 0x50, 0xd4, 0xae, 0xc2, 0xa7, 0xa2, 0x1a,
+	0x4b, 0x57, 0x47, 0x35, 0x96, 0xb3, 0x51, 0x8d, 0xf6, 0x4b, 0x58, 0x4a, 0x35, 0x42, 0x0c, 0xd8,
+	0xaf, 0x42, 0x23, 0x62, 0x84, 0xeb, 0x44, 0xbe, 0x38, 0x29, 0x5e, 0xbc, 0xb7, 0xb3, 0x49, 0x8f,
+	0x26, 0x27, 0x7b, 0xf4, 0x2c, 0xe9, 0x18, 0x02, 0xa5, 0xe8, 0x34, 0x38, 0x17, 0x5a, 0x8b, 0xfd,
+	0x46, 0x57, 0x2a, 0xbf, 0xa3, 0x14, 0x8d, 0x69, 0x5f, 0x5e, 0x88, 0x66, 0x48, 0x77, 0x4c, 0xfb,
+	0xf6, 0x47, 0x40, 0xf4, 0x7c, 0x44, 0xdd, 0x70, 0xb1, 0x9e, 0x1c, 0xf5, 0xa2, 0x8b, 0x28, 0xa6,
+	0x23, 0x19, 0x7a, 0xa2, 0x43, 0xf6, 0x43, 0xa8, 0x1d, 0xb8, 0xf8, 0x54, 0x82, 0x78, 0x55, 0x04,
+	0xdd, 0x61, 0xee, 0x05, 0xea, 0x70, 0xe5, 0x0e, 0x63, 0x64, 0xfb, 0x7f, 0x17, 0xe0, 0x26, 0xe7,
+	0xc4, 0x5c, 0x07, 0xc9, 0x35, 0x74, 0x99, 0xab, 0x06, 0x65, 0xe4, 0xbc, 0x90, 0x23, 0xe7, 0x62,
+	0x4b, 0x29, 0x2f, 0x97, 0xca, 0x50, 0x52, 0x1d, 0x43, 0xc9, 0x4b, 0xee, 0x1d, 0x70, 0x7f, 0x4c,
+	0x02, 0xa4, 0xfc, 0xa3, 0x89, 0x49, 0xc0, 0xeb, 0x27, 0x55, 0x98, 0x10, 0x6b, 0x1d, 0xca, 0x35,
+	0x3c, 0x66, 0x64, 0xa0, 0xb8, 0x89, 0x67, 0x0d, 0x8c, 0xd9, 0x6b, 0x18, 0x18, 0x7c, 0x9f, 0x79,
+	0x99, 0x81, 0x01, 0xd7, 0x30, 0x30, 0xf0, 0xb6, 0x0d, 0x3e, 0x48, 0xc4, 0x8f, 0x34, 0x84, 0x60,
+	0xff, 0xa1, 0x05, 0x4d, 0x31, 0x07, 0x15, 0x8d, 0xbc, 0x6b, 0x98, 0xe8, 0xb9, 0x57, 0x40, 0x1f,
+	0x40, 0x9d, 0x19, 0xce, 0xca, 0x11, 0x2c, 0xbc, 0xd6, 0x06, 0xc8, 0xa2, 0x51, 0xc5, 0xb9, 0xe0,
+	0xc8, 0x1b, 0x8a, 0x41, 0xd1, 0x21, 0xe9, 0x4b, 0x0e, 0x65, 0x88, 0xb3, 0xe5, 0xa8, 0xb4, 0xfd,
+	0xcf, 0x2c, 0x98, 0xd7, 0x2a, 0x2c, 0x66, 0xe1, 0x67, 0x20, 0x55, 0x05, 0xf7, 0x17, 0x9b, 0xf1,
+	0xc8, 0xe9, 0xb6, 0x38, 0x06, 0x33, 0x1b, 0x4c, 0xf7, 0x82, 0x55, 0x30, 0x9a, 0x8c, 0xc4, 0x0a,
+	0xa3, 0x43, 0x38, 0x91, 0xce, 0x29, 0x7d, 0xad, 0x58, 0xf8, 0x1a, 0x67, 0x60, 0xd8, 0xf8, 0x11,
+	0x1a, 0xfc, 0x8a, 0x89, 0x2f, 0xf6, 0x26, 0x68, 0xff, 0x7b, 0x7c, 0x9f, 0x87, 0xed, 0xdc, 0x84,
+	0xb4, 0xaa, 0xfb, 0xf9, 0x37, 0xf9, 0x56, 0x95, 0x4b, 0xe4, 0xce, 0x0d, 0x47, 0xa4, 0xc9, 0x77,
+	0xae, 0xb9, 0xdb, 0x54, 0xe1, 0xf7, 0x53, 0xc6, 0xa2, 0x98, 0x37, 0x16, 0x97, 0xf4, 0x74, 0x9e,
+	0x7f, 0xb4, 0x9c, 0xeb, 0x1f, 0xc5, 0xd7, 0xca, 0xa2, 0x7e, 0x30, 0xa6, 0x78, 0x0e, 0x66, 0x36,
+	0x4e, 0xe8, 0xe7, 0x3f, 0xb2, 0xa0, 0xb5, 0xc5, 0x4f, 0x0b, 0xf0, 0xd8, 0xcd, 0x8b, 0xe2, 0x20,
+	0x54, 0x4f, 0xad, 0x60, 0xf0, 0x4c, 0xec, 0x86, 0x31, 0xbf, 0x0e, 0x26, 0xbc, 0x97, 0x09, 0x82,
+	0x75, 0xa4, 0xfe, 0x80, 0x53, 0xf9, 0xd8, 0xa8, 0x74, 0xc6, 0xc0, 0x12, 0x7b, 0x4b, 0x1d, 0x43,
+	0xf7, 0x94, 0x34, 0xa4, 0xe8, 0x19, 0x5b, 0xf4, 0xf8, 0xa6, 0x2d, 0x85, 0xda, 0xff, 0xc8, 0x82,
+	0xb9, 0xa4, 0x92, 0x1d, 0x04, 0x4d, 0xed, 0x20, 0x6c, 0x13, 0x05, 0x28, 0xbf, 0xaa, 0x87, 0xc6,
+	0x8a, 0xa8, 0x9b, 0x86, 0x30, 0x89, 0x15, 0xa9, 0x60, 0xa2, 0xe2, 0xae, 0x35, 0x88, 0x2f, 0x32,
+	0x68, 0x26, 0x09, 0x93, 0x4f, 0xa4, 0xd8, 0x6d, 0xbe, 0x51, 0xcc, 0xbe, 0xe2, 0x01, 0xd7, 0x32,
+	0x29, 0xed, 0x0c, 0x1e, 0x5d, 0x8d, 0x3f, 0xed, 0xdf, 0xb7, 0xe0, 0x76, 0x4e, 0xe7, 0x0a, 0xc9,
+	0xd8, 0x84, 0xf9, 0x63, 0x45, 0x94, 0x1d, 0xc0, 0xc5, 0x63, 0x59, 0x1e, 0x6f, 0x99, 0x8d, 0x76,
+	0xb2, 0x1f, 0x28, 0xc3, 0x90, 0x77, 0xa9, 0x71, 0x47, 0x22, 0x4b, 0x58, 0xfb, 0xab, 0x45, 0x68,
+	0xf0, 0x63, 0x4f, 0xfe, 0x42, 0x22, 0x0d, 0xc9, 0x73, 0x98, 0x11, 0x2f, 0x5c, 0x12, 0x79, 0x9c,
+	0x6a, 0xbe, 0xa9, 0xd9, 0x5e, 0x4e, 0xc3, 0x62, 0xee, 0x2c, 0xfc, 0x85, 0x3f, 0xfe, 0x2f, 0x7f,
+	0xad, 0x50, 0x27, 0xd5, 0xd5, 0xb3, 0x0f, 0x56, 0x4f, 0xa8, 0x1f, 0x61, 0x1e, 0xbf, 0x05, 0x90,
+	0xbc, 0xfd, 0x48, 0x5a, 0xca, 0xa0, 0x4d, 0x3d, 0x6a, 0xd9, 0xbe, 0x9d, 0x43, 0x11, 0xf9, 0xde,
+	0x66, 0xf9, 0x2e, 0xd8, 0x0d, 0xcc, 0xd7, 0xf3, 0xbd, 0x98, 0x3f, 0x04, 0xf9, 0xa9, 0xf5, 0x98,
+	0x0c, 0xa0, 0xa6, 0x3f, 0xed, 0x48, 0xa4, 0x5f, 0x2b, 0xe7, 0x61, 0xc9, 0xf6, 0x9d, 0x5c, 0x9a,
+	0x74, 0xea, 0xb1, 0x32, 0x96, 0xec, 0x26, 0x96, 0x31, 0x61, 0x1c, 0x49, 0x29, 0x43, 0x68, 0x98,
+	0x2f, 0x38, 0x92, 0xbb, 0x9a, 0x58, 0x67, 0xde, 0x8f, 0x6c, 0xdf, 0x9b, 0x42, 0x15, 0x65, 0xdd,
+	0x63, 0x65, 0xdd, 0xb2, 0x09, 0x96, 0xd5, 0x67, 0x3c, 0xf2, 0xfd, 0xc8, 0x4f, 0xad, 0xc7, 0x6b,
+	0xff, 0xfc, 0x01, 0x54, 0x94, 0x27, 0x9a, 0xfc, 0x18, 0xea, 0xc6, 0xb9, 0x34, 0x91, 0xcd, 0xc8,
+	0x3b, 0xc6, 0x6e, 0xdf, 0xcd, 0x27, 0x8a, 0x82, 0xef, 0xb3, 0x82, 0x5b, 0x64, 0x19, 0x0b, 0x16,
+	0x07, 0xbb, 0xab, 0xec, 0x08, 0x9f, 0xdf, 0xa4, 0x7b, 0x0d, 0x0d, 0xf3, 0x2c, 0xd9, 0x68, 0x67,
+	0xe6, 0xec, 0xb9, 0x7d, 0x6f, 0x0a, 0x55, 0xbe, 0x63, 0xc3, 0x8a, 0x5b, 0x26, 0x8b, 0x7a, 0x71,
+	0xca, 0x43, 0x4c, 0xd9, 0xed, 0x4e, 0xfd, 0x81, 0x47, 0x72, 0x4f, 0x4d, 0xac, 0xbc, 0x87, 0x1f,
+	0xd5, 0x14, 0xc9, 0xbe, 0xfe, 0x68, 0xb7, 0x58, 0x51, 0x84, 0xb0, 0xe1, 0xd3, 0xdf, 0x77, 0x24,
+	0x3f, 0x82, 0x8a, 0x7a, 0xa0, 0x88, 0xdc, 0xd2, 0x5e, 0x85, 0xd2, 0x5f, 0x4d, 0x6a, 0xb7, 0xb2,
+	0x84, 0xbc, 0x89, 0xa1, 0xe7, 0x8c, 0x13, 0x63, 0x0f, 0x96, 0xc4, 0x06, 0xe9, 0x88, 0x7e, 0x9d,
+	0x96, 0xe4, 0x3c, 0x4b, 0xf9, 0xd4, 0x22, 0x9f, 0xc1, 0xac, 0x7c, 0xf7, 0x89, 0x2c, 0xe7, 0xbf,
+	0x5f, 0xd5, 0xbe, 0x95, 0xc1, 0x85, 0xf6, 0xf8, 0x01, 0x40, 0xf2, 0x9e, 0x91, 0x92, 0xb3, 0xcc,
+	0x4b, 0x4a, 0xed, 0xdb, 0x39, 0x14, 0xd1, 0xd4, 0x65, 0xd6, 0xd4, 0x26, 0x61, 0x72, 0xe6, 0xd3,
+	0x73, 0x19, 0x8d, 0x7c, 0x06, 0x0b, 0x39, 0xcf, 0x15, 0x91, 0x77, 0x55, 0x55, 0xa6, 0xbd, 0x55,
+	0xd4, 0xb6, 0x2f, 0x63, 0x11, 0xa5, 0x8a, 0xa1, 0xb3, 0xeb, 0x58, 0x2a, 0x0b, 0xb3, 0x44, 0xe3,
+	0x11, 0x7b, 0x37, 0x82, 0x85, 0xed, 0x4b, 0xca, 0xdd, 0xbe, 0xba, 0xdc, 0x4b, 0x1e, 0x4a, 0xb2,
+	0x97, 0x58, 0xb9, 0x73, 0xc4, 0x2c, 0x97, 0x6c, 0x42, 0x55, 0x7b, 0xc9, 0x88, 0xc8, 0xee, 0xca,
+	0xbe, 0x82, 0xd4, 0x6e, 0xe7, 0x91, 0xc4, 0x68, 0x7c, 0x0e, 0x75, 0xe3, 0x49, 0x22, 0x25, 0xb5,
+	0x79, 0x0f, 0x1e, 0xb5, 0xef, 0xe6, 0x13, 0x45, 0x5e, 0x3f, 0x84, 0xaa, 0xf6, 0x80, 0x10, 0xd1,
+	0x02, 0xe1, 0x53, 0x4f, 0x07, 0xb5, 0xdb, 0x79, 0x24, 0xd1, 0xdc, 0x45, 0xd6, 0xdc, 0x86, 0x5d,
+	0xc1, 0xe6, 0xb2, 0x8b, 0xc8, 0xd8, 0xc5, 0x3f, 0x86, 0x86, 0xf9, 0xa4, 0x90, 0x92, 0xf8, 0xdc,
+	0xc7, 0x89, 0xda, 0xf7, 0xa6, 0x50, 0x4d, 0x61, 0x79, 0xbc, 0xa0, 0x0a, 0x59, 0xfd, 0x4a, 0x9c,
+	0x38, 0xbf, 0x25, 0xdf, 0x83, 0x8a, 0xba, 0x19, 0x4e, 0x92, 0x87, 0x94, 0xcc, 0xfb, 0xe3, 0xed,
+	0x56, 0x96, 0x20, 0x32, 0x9f, 0x67, 0x99, 0x57, 0x49, 0xd2, 0x02, 0xbe, 0x56, 0xb1, 0x1b, 0xe2,
+	0xda, 0x5a, 0xa5, 0x5f, 0x22, 0x6f, 0x2f, 0xa7, 0xe1, 0xfc, 0xb5, 0x2a, 0xf6, 0x30, 0x0f, 0x1f,
+	0xe6, 0x52, 0x21, 0x59, 0x4a, 0x90, 0xf3, 0x03, 0x5f, 0xdb, 0xf7, 0x2f, 0x8f, 0xe4, 0x32, 0x55,
+	0xa0, 0x54, 0x7d, 0xab, 0xf2, 0xa6, 0xc3, 0x9f, 0x81, 0x9a, 0xfe, 0x14, 0x8c, 0x5a, 0xbd, 0x72,
+	0x1e, 0xb0, 0x69, 0xdf, 0xc9, 0xa5, 0x99, 0x83, 0x4b, 0x6a, 0x7a, 0x31, 0x38, 0xb8, 0xe6, 0xeb,
+	0x06, 0x89, 0x3a, 0xcf, 0x7b, 0xd4, 0xa1, 0x7d, 0x6f, 0x0a, 0xd5, 0x1c, 0x5c, 0xb2, 0x60, 0xb4,
+	0x85, 0x1f, 0x0e, 0x90, 0x1f, 0xc2, 0x9c, 0x16, 0x24, 0xd9, 0xbd, 0xf0, 0xfb, 0x6a, 0xa2, 0x66,
+	0x2f, 0x40, 0xb5, 0xf3, 0xac, 0x62, 0xfb, 0x16, 0xcb, 0x7f, 0xde, 0x36, 0x1a, 0x81, 0x93, 0x74,
+	0x03, 0xaa, 0x5a, 0x1e, 0x97, 0xe5, 0x7b, 0x4b, 0x23, 0xe9, 0xf7, 0x51, 0x9e, 0x5a, 0xe4, 0x00,
+	0xe6, 0x8c, 0xbb, 0x5f, 0x41, 0x98, 0x5e, 0xdc, 0xcc, 0x3b, 0x61, 0xed, 0x3b, 0xf9, 0x54, 0x56,
+	0xd0, 0x23, 0xeb, 0xa9, 0x45, 0xf6, 0xa0, 0x99, 0xbe, 0x82, 0xa0, 0xc4, 0x3c, 0xef, 0xee, 0x43,
+	0x3b, 0x45, 0x34, 0x2e, 0x2e, 0x90, 0x30, 0xe7, 0xc6, 0xea, 0xfd, 0x69, 0xb7, 0x34, 0x45, 0x73,
+	0xdf, 0x99, 0x4a, 0x9f, 0x66, 0x69, 0xb0, 0x21, 0x3b, 0x42, 0x76, 0xec, 0xd8, 0xbf, 0x81, 0x6f,
+	0x46, 0xea, 0x21, 0x9e, 0xc6, 0xb1, 0x60, 0xaa, 0xb0, 0x96, 0x4e, 0xd3, 0x3b, 0xd7, 0x76, 0x58,
+	0x29, 0x7b, 0x8f, 0x3f, 0x37, 0x4a, 0xf9, 0xca, 0xd8, 0x71, 0x3e, 0x49, 0xbf, 0x1f, 0xf9, 0x36,
+	0xcd, 0xa0, 0x5f, 0xe0, 0x7d, 0xfb, 0xd4, 0x22, 0x7f, 0xc7, 0x82, 0x86, 0xe9, 0x44, 0x52, 0x03,
+	0x96, 0xeb, 0xae, 0x6a, 0xdf, 0x9b, 0x42, 0x15, 0x7d, 0xf1, 0x4b, 0xa8, 0x25, 0x1a, 0x67, 0x86,
+	0x1f, 0x48, 0x8d, 0x7f, 0x9e, 0x8b, 0xab, 0x7d, 0x37, 0x9f, 0x68, 0x1a, 0x67, 0xb6, 0x29, 0x5e,
+	0xdc, 0x43, 0x84, 0x83, 0xf5, 0x29, 0x7f, 0x5e, 0x5a, 0x7a, 0x4f, 0x49, 0xf6, 0xad, 0xe4, 0xf6,
+	0x82, 0x81, 0xf1, 0x7c, 0xd9, 0x54, 0xfd, 0x6d, 0x98, 0xd3, 0xbe, 0x65, 0xd2, 0x79, 0xdd, 0xef,
+	0xed, 0x07, 0xac, 0x5e, 0xf7, 0xed, 0xdb, 0x46, 0xbd, 0xd2, 0x96, 0xd0, 0x3a, 0x54, 0xb5, 0xc7,
+	0x75, 0x93, 0x65, 0x33, 0xf3, 0xe0, 0xee, 0xf4, 0x4a, 0x8e, 0x60, 0x4e, 0x63, 0x37, 0x54, 0xc8,
+	0x35, 0xb3, 0xb1, 0x1f, 0xb3, 0xba, 0x3e, 0xb0, 0xdf, 0x99, 0x5a, 0xd7, 0x55, 0xe6, 0x51, 0xe1,
+	0xd6, 0x45, 0x4d, 0x7f, 0xf9, 0x55, 0xcd, 0xfd, 0x9c, 0x37, 0x79, 0xdb, 0x77, 0x72, 0x69, 0xd7,
+	0x2f, 0x94, 0x3d, 0xd4, 0x8a, 0x85, 0x1e, 0x00, 0x24, 0xc7, 0x2b, 0x24, 0xe5, 0xde, 0x57, 0xb6,
+	0x59, 0xf6, 0x04, 0xc6, 0x54, 0x8e, 0xf2, 0x14, 0x00, 0x73, 0xfc, 0x11, 0x5f, 0x43, 0x04, 0x7f,
+	0xa4, 0xba, 0x2c, 0x7b, 0x0e, 0xd2, 0x6e, 0xe7, 0x91, 0xf2, 0x56, 0x10, 0x99, 0x3f, 0x79, 0x09,
+	0xf5, 0xbd, 0x20, 0x78, 0x3d, 0x19, 0xcb, 0x1a, 0x13, 0xd3, 0xfd, 0x8c, 0xa7, 0x35, 0xed, 0x54,
+	0x2b, 0xec, 0x15, 0x96, 0x55, 0x9b, 0xb4, 0xb4, 0xac, 0x56, 0xbf, 0x4a, 0x8e, 0x6f, 0xde, 0x12,
+	0x17, 0xe6, 0x95, 0xd9, 0xac, 0x2a, 0xde, 0x36, 0xb3, 0xd1, 0x0f, 0x1e, 0x32, 0x45, 0x18, 0x1b,
+	0x19, 0x59, 0xdb, 0xd5, 0x48, 0xe6, 0xc9, 0xd4, 0x7d, 0x6d, 0x93, 0xf6, 0x83, 0x01, 0x15, 0x6e,
+	0xca, 0x85, 0xa4, 0xe2, 0xca, 0xbf, 0xd9, 0xae, 0x1b, 0xa0, 0xb9, 0x58, 0x8f, 0xdd, 0x8b, 0x90,
+	0xfe, 0xce, 0xea, 0x57, 0xc2, 0x01, 0xfa, 0x56, 0x2e, 0xd6, 0xa2, 0xe5, 0xe6, 0x62, 0x9d, 0xf2,
+	0xb7, 0xb7, 0xef, 0xe4, 0xd2, 0xf2, 0xba, 0x5a, 0xba, 0xef, 0xc9, 0x10, 0xe6, 0x33, 0x2e, 0x7a,
+	0x22, 0x15, 0xfc, 0x34, 0xc7, 0x7e, 0x7b, 0x65, 0x3a, 0x83, 0x59, 0xda, 0x63, 0xb3, 0xb4, 0x2e,
+	0xd4, 0x37, 0x29, 0xef, 0x2c, 0x1e, 0x11, 0x95, 0x7a, 0xdd, 0x48, 0x8f, 0xb7, 0x6a, 0x2f, 0xe4,
+	0xd0, 0x4c, 0x6b, 0x8c, 0x85, 0x23, 0x91, 0x1f, 0x41, 0x75, 0x9b, 0xc6, 0x32, 0x04, 0x4a, 0x6d,
+	0x61, 0x52, 0x31, 0x51, 0xed, 0x9c, 0x08, 0x2a, 0x73, 0xce, 0xb0, 0xdc, 0x56, 0x31, 0xa6, 0x8a,
+	0x6b, 0xdf, 0x9e, 0x37, 0x78, 0x4b, 0xbe, 0xcf, 0x32, 0x57, 0x31, 0x98, 0xcb, 0x5a, 0xe4, 0x8c,
+	0x9e, 0xf9, 0x5c, 0x0a, 0xcf, 0xcb, 0xd9, 0x0f, 0x06, 0x54, 0xb3, 0x4b, 0xbf, 0x82, 0xaa, 0x16,
+	0x20, 0xac, 0x04, 0x28, 0x1b, 0xec, 0xdc, 0x6e, 0xe7, 0x91, 0x44, 0x3f, 0x7f, 0x87, 0x95, 0xb3,
+	0x4a, 0xbe, 0x9d, 0x94, 0xc3, 0x63, 0x88, 0x93, 0x92, 0x56, 0xbf, 0x72, 0x47, 0xf1, 0xdb, 0xd5,
+	0xaf, 0x92, 0x28, 0xe8, 0xb7, 0xe4, 0x15, 0x7b, 0xf6, 0x48, 0x8f, 0xf9, 0x4a, 0x36, 0x68, 0xe9,
+	0xf0, 0xb0, 0x36, 0xc9, 0x92, 0xcc, 0x4d, 0x1b, 0x2f, 0x97, 0xd9, 0xb2, 0xdf, 0x01, 0xc0, 0xa8,
+	0xa5, 0x4d, 0x97, 0x8e, 0x02, 0x3f, 0xd1, 0xf6, 0x49, 0x5c, 0x53, 0x7b, 0xc1, 0xc0, 0xc4, 0x66,
+	0xe3, 0x95, 0xb6, 0xa3, 0xd5, 0xc7, 0x9b, 0xc8, 0x99, 0x36, 0x35, 0xf4, 0xa9, 0xdd, 0xce, 0xe3,
+	0x50, 0xf6, 0xd7, 0x3a, 0x40, 0x72, 0x26, 0xa1, 0xf6, 0xa7, 0x99, 0xe3, 0x8e, 0xf6, 0xed, 0x1c,
+	0x8a, 0xa8, 0xdb, 0x01, 0x54, 0x12, 0x27, 0xf7, 0xad, 0x24, 0xe2, 0xdb, 0x70, 0x89, 0xb7, 0x5b,
+	0x59, 0x82, 0x18, 0xa2, 0x26, 0xeb, 0x2a, 0x20, 0xb3, 0xd8, 0x55, 0xcc, 0x9f, 0xec, 0xc1, 0x02,
+	0xaf, 0xa0, 0x32, 0x44, 0x59, 0xa4, 0x8e, 0x5a, 0x0a, 0xb2, 0xee, 0xdf, 0xf6, 0x9d, 0x5c, 0x5a,
+	0x9e, 0xa7, 0x0a, 0xa7, 0x2e, 0x8f, 0x12, 0x42, 0x3d, 0x3d, 0x82, 0xf9, 0x8c, 0xeb, 0x4f, 0xc9,
+	0xf7, 0x34, 0x8f, 0x6b, 0x7b, 0x65, 0x3a, 0x83, 0xb9, 0x8d, 0xb5, 0x81, 0x6f, 0x63, 0x3d, 0x6e,
+	0xda, 0x1d, 0xdd, 0x64, 0x7f, 0x88, 0xf3, 0xe1, 0xff, 0x1f, 0x00, 0xfb, 0xf3, 0x8b, 0x9b, 0x42,
+	0x67, 0x00, 0x00,
 }
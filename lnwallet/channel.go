@@ -2278,7 +2278,11 @@ func (lc *LightningChannel) fetchCommitmentView(remoteChain bool,
 	}
 
 	// Actually generate unsigned commitment transaction for this view.
-	if err := lc.createCommitmentTx(c, filteredHTLCView, keyRing); err != nil {
+	err := lc.createCommitmentTx(
+		c, filteredHTLCView, keyRing, lc.fundingTxIn(),
+		lc.channelState.Capacity,
+	)
+	if err != nil {
 		return nil, err
 	}
 
@@ -2308,9 +2312,11 @@ func (lc *LightningChannel) fundingTxIn() wire.TxIn {
 }
 
 // createCommitmentTx generates the unsigned commitment transaction for a
-// commitment view and assigns to txn field.
+// commitment view and assigns to txn field. The commitment transaction spends
+// the passed funding input, which holds the given capacity.
 func (lc *LightningChannel) createCommitmentTx(c *commitment,
-	filteredHTLCView *htlcView, keyRing *CommitmentKeyRing) error {
+	filteredHTLCView *htlcView, keyRing *CommitmentKeyRing,
+	fundingTxIn wire.TxIn, capacity btcutil.Amount) error {
 
 	ourBalance := c.ourBalance
 	theirBalance := c.theirBalance
@@ -2380,7 +2386,7 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 
 	// Generate a new commitment transaction with all the latest
 	// unsettled/un-timed out HTLCs.
-	commitTx, err := CreateCommitTx(lc.fundingTxIn(), keyRing, delay,
+	commitTx, err := CreateCommitTx(fundingTxIn, keyRing, delay,
 		delayBalance, p2wkhBalance, c.dustLimit)
 	if err != nil {
 		return err
@@ -2439,11 +2445,11 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	for _, txOut := range commitTx.TxOut {
 		totalOut += btcutil.Amount(txOut.Value)
 	}
-	if totalOut > lc.channelState.Capacity {
+	if totalOut > capacity {
 		return fmt.Errorf("height=%v, for ChannelPoint(%v) attempts "+
 			"to consume %v while channel capacity is %v",
-			c.height, lc.channelState.FundingOutpoint,
-			totalOut, lc.channelState.Capacity)
+			c.height, fundingTxIn.PreviousOutPoint,
+			totalOut, capacity)
 	}
 
 	c.txn = commitTx
@@ -4164,6 +4170,16 @@ func (lc *LightningChannel) FullySynced() bool {
 	return localUpdatesSynced && remoteUpdatesSynced && !pendingFeeAck
 }
 
+// HasPendingCommitments returns true if either commitment chain holds a
+// commitment which hasn't been revoked yet in favor of a newer commitment.
+func (lc *LightningChannel) HasPendingCommitments() bool {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.localCommitChain.tip() != lc.localCommitChain.tail() ||
+		lc.remoteCommitChain.hasUnackedCommitment()
+}
+
 // RevokeCurrentCommitment revokes the next lowest unrevoked commitment
 // transaction in the local commitment chain. As a result the edge of our
 // revocation window is extended by one, and the tail of our local commitment
@@ -5915,6 +5931,34 @@ func (lc *LightningChannel) availableBalance() (lnwire.MilliSatoshi, int64) {
 	return ourBalance, commitWeight
 }
 
+// AvailableInFlight returns the value of the HTLCs we can still add to the
+// channel before exceeding the max value in flight the remote party accepts.
+// An HTLC above this value will be rejected by AddHTLC with
+// ErrMaxPendingAmount.
+func (lc *LightningChannel) AvailableInFlight() lnwire.MilliSatoshi {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	// We'll evaluate the same view AddHTLC validates new HTLCs against,
+	// which leaves only the HTLCs we've added that are still in flight.
+	remoteACKedIndex := lc.localCommitChain.tail().theirMessageIndex
+	htlcView := lc.fetchHTLCView(remoteACKedIndex,
+		lc.localUpdateLog.logIndex)
+	_, _, _, filteredView, _ := lc.computeView(htlcView, true, false)
+
+	var amtInFlight lnwire.MilliSatoshi
+	for _, htlc := range filteredView.ourUpdates {
+		amtInFlight += htlc.Amount
+	}
+
+	maxInFlight := lc.localChanCfg.MaxPendingAmount
+	if amtInFlight >= maxInFlight {
+		return 0
+	}
+
+	return maxInFlight - amtInFlight
+}
+
 // StateSnapshot returns a snapshot of the current fully committed state within
 // the channel.
 func (lc *LightningChannel) StateSnapshot() *channeldb.ChannelSnapshot {
@@ -6237,6 +6281,12 @@ func (lc *LightningChannel) LocalChanReserve() btcutil.Amount {
 	return lc.localChanCfg.ChanReserve
 }
 
+// MaxPendingAmount returns the max value of HTLCs the remote party accepts us
+// to have in flight.
+func (lc *LightningChannel) MaxPendingAmount() lnwire.MilliSatoshi {
+	return lc.localChanCfg.MaxPendingAmount
+}
+
 // NextLocalHtlcIndex returns the next unallocated local htlc index. To ensure
 // this always returns the next index that has been not been allocated, this
 // will first try to examine any pending commitments, before falling back to the
@@ -5036,6 +5036,71 @@ func TestMaxPendingAmount(t *testing.T) {
 	}
 }
 
+// TestAvailableInFlight tests that the value of the HTLCs we can still add
+// without exceeding the max pending amount of the remote party is reported
+// correctly as HTLCs are added and settled.
+func TestAvailableInFlight(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Bob only accepts 3 BTC worth of HTLCs from Alice in flight.
+	maxPending := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin * 3)
+	aliceChannel.localChanCfg.MaxPendingAmount = maxPending
+	bobChannel.remoteChanCfg.MaxPendingAmount = maxPending
+
+	assertAvailable := func(expected lnwire.MilliSatoshi) {
+		t.Helper()
+
+		available := aliceChannel.AvailableInFlight()
+		if available != expected {
+			t.Fatalf("expected %v available in flight, got %v",
+				expected, available)
+		}
+	}
+	assertAvailable(maxPending)
+
+	// Each HTLC Alice adds counts towards the value in flight, until
+	// there's nothing left.
+	const numHTLCs = 2
+	htlcAmt := lnwire.NewMSatFromSatoshis(1.5 * btcutil.SatoshiPerBitcoin)
+	var preimages [numHTLCs][32]byte
+	for i := 0; i < numHTLCs; i++ {
+		htlc, preimage := createHTLC(i, htlcAmt)
+		if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+			t.Fatalf("unable to add htlc: %v", err)
+		}
+		if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+			t.Fatalf("unable to recv htlc: %v", err)
+		}
+		preimages[i] = preimage
+
+		assertAvailable(maxPending - htlcAmt*lnwire.MilliSatoshi(i+1))
+	}
+
+	if err := forceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+	assertAvailable(0)
+
+	// Once Bob settles one of the HTLCs, its value is available again.
+	err = bobChannel.SettleHTLC(preimages[0], 0, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("unable to settle htlc: %v", err)
+	}
+	if err := aliceChannel.ReceiveHTLCSettle(preimages[0], 0); err != nil {
+		t.Fatalf("unable to recv settle: %v", err)
+	}
+	if err := forceStateTransition(bobChannel, aliceChannel); err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+	assertAvailable(htlcAmt)
+}
+
 func assertChannelBalances(t *testing.T, alice, bob *LightningChannel,
 	aliceBalance, bobBalance btcutil.Amount) {
 
@@ -116,6 +116,25 @@ func ErrMaxValueInFlightTooSmall(maxValInFlight,
 	}
 }
 
+// ErrMaxValueInFlightTooLarge returns an error indicating that the 'max HTLC
+// value in flight' we'd require of the remote exceeds the channel capacity.
+func ErrMaxValueInFlightTooLarge(maxValInFlight,
+	maxMaxValInFlight lnwire.MilliSatoshi) ReservationError {
+	return ReservationError{
+		fmt.Errorf("maxValueInFlight too large: %v, max is %v",
+			maxValInFlight, maxMaxValInFlight),
+	}
+}
+
+// ErrDustLimitTooSmall returns an error indicating that the dust limit we'd
+// use for our commitment transaction is below the minimum of BOLT-02.
+func ErrDustLimitTooSmall(dustLimit, minDustLimit btcutil.Amount) ReservationError {
+	return ReservationError{
+		fmt.Errorf("dust limit of %v sat is too small, min is %v sat",
+			int64(dustLimit), int64(minDustLimit)),
+	}
+}
+
 // ErrChanTooSmall returns an error indicating that an incoming channel request
 // was too small. We'll reject any incoming channels if they're below our
 // configured value for the min channel size we'll accept.
@@ -126,6 +145,42 @@ func ErrChanTooSmall(chanSize, minChanSize btcutil.Amount) ReservationError {
 	}
 }
 
+// ErrChanTooLarge returns an error indicating that an incoming channel
+// request was too large. We'll reject any incoming channels if they're above
+// our configured value for the max channel size we'll accept.
+func ErrChanTooLarge(chanSize, maxChanSize btcutil.Amount) ReservationError {
+	return ReservationError{
+		fmt.Errorf("chan size of %v exceeds maximum chan size of %v",
+			chanSize, maxChanSize),
+	}
+}
+
+// ErrChanRejected returns an error indicating that an incoming channel request
+// was rejected by one of our channel acceptors. The passed reason is sent to
+// the remote peer.
+func ErrChanRejected(reason string) ReservationError {
+	return ReservationError{errors.New(reason)}
+}
+
+// ErrNonStandardUpfrontShutdown returns an error indicating that the upfront
+// shutdown script committed to by the remote party isn't one of the standard
+// script types allowed within a cooperative close.
+func ErrNonStandardUpfrontShutdown(script []byte) ReservationError {
+	return ReservationError{
+		fmt.Errorf("upfront shutdown script %x is non-standard", script),
+	}
+}
+
+// ErrZeroConfRejected returns an error indicating that an incoming channel
+// request asked for a zero-conf channel, but the remote party isn't trusted
+// to open channels without waiting for the funding transaction to confirm.
+func ErrZeroConfRejected() ReservationError {
+	return ReservationError{
+		errors.New("zero-conf channels are only accepted from " +
+			"trusted peers"),
+	}
+}
+
 // ErrHtlcIndexAlreadyFailed is returned when the HTLC index has already been
 // failed, but has not been committed by our commitment state.
 type ErrHtlcIndexAlreadyFailed uint64
@@ -2157,7 +2157,9 @@ func TestLightningWallet(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to create height hint cache: %v", err)
 	}
-	chainNotifier, err := btcdnotify.New(&rpcConfig, hintCache, hintCache)
+	chainNotifier, err := btcdnotify.New(
+		&rpcConfig, hintCache, hintCache, false,
+	)
 	if err != nil {
 		t.Fatalf("unable to create notifier: %v", err)
 	}
@@ -10,3 +10,8 @@ import (
 func DefaultDustLimit() btcutil.Amount {
 	return txrules.GetDustThreshold(P2WSHSize, txrules.DefaultRelayFeePerKb)
 }
+
+// MinDustLimit is the smallest dust limit a party may use for its commitment
+// transaction, as specified in BOLT-02. Any lower dust limit would allow
+// outputs on the commitment transaction that can't be relayed.
+const MinDustLimit = btcutil.Amount(354)
@@ -1,11 +1,13 @@
 package lnwallet
 
 import (
+	"fmt"
 	"net"
 	"sync"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -36,6 +38,11 @@ type ChannelContribution struct {
 	// send to the remote party.
 	FirstCommitmentPoint *btcec.PublicKey
 
+	// UpfrontShutdown is the optional script the funds of this party are
+	// paid to upon a cooperative close of the channel. If set, the party
+	// cannot request its funds to be sent to any other script.
+	UpfrontShutdown lnwire.DeliveryAddress
+
 	// ChannelConfig is the concrete contribution that this node is
 	// offering to the channel. This includes all the various constraints
 	// such as the min HTLC, and also all the keys which will be used for
@@ -120,6 +127,12 @@ type ChannelReservation struct {
 	// commitment state.
 	pushMSat lnwire.MilliSatoshi
 
+	// externalFunding denotes whether the funding transaction of this
+	// reservation is crafted by an external wallet. If so, it's supplied
+	// through ProcessExternalFunding after the counterparty's contribution
+	// has been processed.
+	externalFunding bool
+
 	// chanOpen houses a struct containing the channel and additional
 	// confirmation details will be sent on once the channel is considered
 	// 'open'. A channel is open once the funding transaction has reached a
@@ -276,6 +289,19 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
+// SetOurUpfrontShutdown sets the upfront shutdown script we commit to pay our
+// funds to upon a cooperative close of the channel.
+func (r *ChannelReservation) SetOurUpfrontShutdown(script lnwire.DeliveryAddress) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.ourContribution.UpfrontShutdown = script
+}
+
+// minNumHtlc is the smallest number of HTLCs we require a channel party to
+// accept in flight, as a channel allowing fewer HTLCs isn't of much use.
+const minNumHtlc = 5
+
 // CommitConstraints takes the constraints that the remote party specifies for
 // the type of commitments that we can generate for them. These constraints
 // include several parameters that serve as flow control restricting the amount
@@ -325,7 +351,6 @@ func (r *ChannelReservation) CommitConstraints(csvDelay, maxHtlcs uint16,
 
 	// Fail if we consider maxHtlcs too small. If this is too small we
 	// cannot offer many HTLCs to the remote.
-	const minNumHtlc = 5
 	if maxHtlcs < minNumHtlc {
 		return ErrMaxHtlcNumTooSmall(maxHtlcs, minNumHtlc)
 	}
@@ -352,6 +377,67 @@ func (r *ChannelReservation) CommitConstraints(csvDelay, maxHtlcs uint16,
 	return nil
 }
 
+// ValidateRemoteConstraints checks the constraints we're about to require of
+// the remote party of a channel with the given capacity, with our given dust
+// limit, against the bounds of the protocol. The same bounds the remote party
+// will check in CommitConstraints are enforced, so the constraints aren't
+// bound to be rejected.
+func ValidateRemoteConstraints(capacity, dustLimit btcutil.Amount,
+	maxHtlcs uint16, maxValueInFlight, minHtlc lnwire.MilliSatoshi,
+	chanReserve btcutil.Amount) error {
+
+	// Our dust limit may not be so low that our commitment transaction
+	// contains outputs that can't be relayed.
+	if dustLimit < MinDustLimit {
+		return ErrDustLimitTooSmall(dustLimit, MinDustLimit)
+	}
+
+	// The reserve we require must be at least our dust limit, as
+	// otherwise the remote party's output may not make it onto our
+	// commitment transaction, leaving it with nothing to lose.
+	if chanReserve < dustLimit {
+		return ErrChanReserveTooSmall(chanReserve, dustLimit)
+	}
+
+	maxChanReserve := capacity / 5
+	if chanReserve > maxChanReserve {
+		return ErrChanReserveTooLarge(chanReserve, maxChanReserve)
+	}
+
+	// The number of HTLCs the remote may offer us is bounded by BOLT-02.
+	if maxHtlcs > uint16(MaxHTLCNumber/2) {
+		return ErrMaxHtlcNumTooLarge(maxHtlcs, uint16(MaxHTLCNumber/2))
+	}
+	if maxHtlcs < minNumHtlc {
+		return ErrMaxHtlcNumTooSmall(maxHtlcs, minNumHtlc)
+	}
+
+	// The remote can never have more than the channel capacity in flight,
+	// and must at least be able to offer minNumHtlc of our smallest
+	// HTLCs.
+	maxMaxValueInFlight := lnwire.NewMSatFromSatoshis(capacity)
+	if maxValueInFlight > maxMaxValueInFlight {
+		return ErrMaxValueInFlightTooLarge(
+			maxValueInFlight, maxMaxValueInFlight,
+		)
+	}
+	if maxValueInFlight < minNumHtlc*minHtlc {
+		return ErrMaxValueInFlightTooSmall(maxValueInFlight,
+			minNumHtlc*minHtlc)
+	}
+
+	return nil
+}
+
+// SetOurDustLimit sets the dust limit we'll use for our commitment
+// transaction, overriding the default dust limit of the wallet.
+func (r *ChannelReservation) SetOurDustLimit(dustLimit btcutil.Amount) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.ourContribution.DustLimit = dustLimit
+}
+
 // OurContribution returns the wallet's fully populated contribution to the
 // pending payment channel. See 'ChannelContribution' for further details
 // regarding the contents of a contribution.
@@ -384,6 +470,194 @@ func (r *ChannelReservation) ProcessContribution(theirContribution *ChannelContr
 	return <-errChan
 }
 
+// IsExternallyFunded returns true if the funding transaction of this
+// reservation is crafted by an external wallet.
+func (r *ChannelReservation) IsExternallyFunded() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return r.externalFunding
+}
+
+// FundingOutput returns the multi-sig output that the funding transaction of
+// the channel must pay the full channel capacity to. As the output commits to
+// the multi-sig keys of both parties, it's only known once the counterparty's
+// contribution has been processed.
+func (r *ChannelReservation) FundingOutput() (*wire.TxOut, error) {
+	r.RLock()
+	defer r.RUnlock()
+	return r.fundingOutput()
+}
+
+// fundingOutput returns the multi-sig output of the channel.
+//
+// NOTE: The reservation's mutex MUST be held when calling this method.
+func (r *ChannelReservation) fundingOutput() (*wire.TxOut, error) {
+	if r.theirContribution == nil ||
+		r.theirContribution.MultiSigKey.PubKey == nil {
+
+		return nil, fmt.Errorf("counterparty contribution not yet " +
+			"processed")
+	}
+
+	ourKey := r.ourContribution.MultiSigKey.PubKey
+	theirKey := r.theirContribution.MultiSigKey.PubKey
+	_, fundingOutput, err := GenFundingPkScript(
+		ourKey.SerializeCompressed(), theirKey.SerializeCompressed(),
+		int64(r.partialState.Capacity),
+	)
+	return fundingOutput, err
+}
+
+// ProcessExternalFunding supplies the fully signed funding transaction of a
+// reservation that is funded by an external wallet. The transaction must pay
+// the full channel capacity to the output returned by FundingOutput. Once
+// processed, both commitment transactions are created and our signature for
+// the counterparty's version is available, just as after ProcessContribution
+// for a reservation funded by our own wallet.
+func (r *ChannelReservation) ProcessExternalFunding(fundingTx *wire.MsgTx) error {
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addExternalFundingMsg{
+		pendingFundingID: r.reservationID,
+		fundingTx:        fundingTx,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// AddFunding adds funds of our own to a reservation we're the responder of,
+// turning it into a dual funder channel. Coins worth the given amount are
+// selected from the wallet and added to our contribution, increasing both the
+// capacity of the channel and our starting balance. This method MUST be
+// called before ProcessContribution, as the funding transaction is created
+// from both parties' contributions.
+func (r *ChannelReservation) AddFunding(amt btcutil.Amount,
+	feeRate SatPerKWeight, minConfs int32) error {
+
+	errChan := make(chan error, 1)
+
+	r.wallet.msgChan <- &addFundingMsg{
+		pendingFundingID: r.reservationID,
+		amt:              amt,
+		feeRate:          feeRate,
+		minConfs:         minConfs,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
+// AddRemoteFunding records that the counterparty adds the given amount of
+// funds to a reservation we initiated, turning it into a dual funder channel.
+// The capacity of the channel and the counterparty's starting balance are
+// increased accordingly. The inputs providing the funds are to be included
+// in the contribution passed to ProcessContribution.
+func (r *ChannelReservation) AddRemoteFunding(amt btcutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	switch {
+	case !r.partialState.IsInitiator:
+		return fmt.Errorf("only the initiator can add remote funds " +
+			"to a reservation")
+
+	case r.fundingTx != nil:
+		return fmt.Errorf("funding transaction already created")
+	}
+
+	r.addFunds(amt, false)
+
+	return nil
+}
+
+// addFunds increases the capacity of the channel by the given amount, which
+// is credited to either our or the counterparty's starting balance. As both
+// parties contribute funds, the channel becomes a dual funder channel, though
+// the initiator remains responsible for the commitment fee.
+//
+// NOTE: The reservation's mutex MUST be held when calling this method.
+func (r *ChannelReservation) addFunds(amt btcutil.Amount, ours bool) {
+	if amt == 0 {
+		return
+	}
+
+	amtMSat := lnwire.NewMSatFromSatoshis(amt)
+	chanState := r.partialState
+	chanState.Capacity += amt
+	chanState.ChanType = channeldb.DualFunder
+
+	if ours {
+		r.ourContribution.FundingAmount += amt
+		chanState.LocalCommitment.LocalBalance += amtMSat
+		chanState.RemoteCommitment.LocalBalance += amtMSat
+	} else {
+		chanState.LocalCommitment.RemoteBalance += amtMSat
+		chanState.RemoteCommitment.RemoteBalance += amtMSat
+	}
+}
+
+// VerifyCommitSig verifies the counterparty's signature for our version of
+// the commitment transaction, without completing the reservation. This allows
+// the responder of a dual funder workflow to ensure it's able to claim its
+// funds before handing out the signatures for its inputs to the funding
+// transaction.
+//
+// NOTE: This method MUST only be called after ProcessContribution.
+func (r *ChannelReservation) VerifyCommitSig(commitSig []byte) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.partialState.LocalCommitment.CommitTx == nil {
+		return fmt.Errorf("commitment transaction not yet created")
+	}
+
+	return r.verifyCommitSig(commitSig)
+}
+
+// verifyCommitSig verifies the counterparty's signature for our version of
+// the commitment transaction.
+//
+// NOTE: The reservation's mutex MUST be held when calling this method.
+func (r *ChannelReservation) verifyCommitSig(commitSig []byte) error {
+	commitTx := r.partialState.LocalCommitment.CommitTx
+	ourKey := r.ourContribution.MultiSigKey
+	theirKey := r.theirContribution.MultiSigKey
+
+	// Re-generate both the witnessScript and p2sh output. We sign the
+	// witnessScript script, but include the p2sh output as the subscript
+	// for verification.
+	channelValue := int64(r.partialState.Capacity)
+	witnessScript, _, err := GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(), channelValue,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Next, create the spending scriptSig, and then verify that the script
+	// is complete, allowing us to spend from the funding transaction.
+	hashCache := txscript.NewTxSigHashes(commitTx)
+	sigHash, err := txscript.CalcWitnessSigHash(witnessScript, hashCache,
+		txscript.SigHashAll, commitTx, 0, channelValue)
+	if err != nil {
+		return err
+	}
+
+	// Verify that we've received a valid signature from the remote party
+	// for our version of the commitment transaction.
+	sig, err := btcec.ParseSignature(commitSig, btcec.S256())
+	if err != nil {
+		return err
+	} else if !sig.Verify(sigHash, theirKey.PubKey) {
+		return fmt.Errorf("counterparty's commitment signature is " +
+			"invalid")
+	}
+
+	return nil
+}
+
 // ProcessSingleContribution verifies, and records the initiator's contribution
 // to this pending single funder channel. Internally, no further action is
 // taken other than recording the initiator's contribution to the single funder
@@ -0,0 +1,360 @@
+package lnwallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Splice is a splice of an active channel that is being negotiated. A splice
+// transaction spends the current funding output of the channel, along with any
+// additional inputs, into a new funding output locked to the same multi-sig
+// keys, along with any additional outputs. Before the splice transaction may be
+// signed, both parties exchange signatures for commitment transactions which
+// spend the new funding output. These commitments mirror the current
+// commitments of the channel, with the balances adjusted by the amount each
+// party adds to, or removes from the channel.
+type Splice struct {
+	// Tx is the splice transaction.
+	Tx *wire.MsgTx
+
+	// FundingOutpoint is the new funding output of the channel created by
+	// the splice transaction.
+	FundingOutpoint wire.OutPoint
+
+	// Capacity is the capacity of the channel after the splice.
+	Capacity btcutil.Amount
+
+	// IsInitiator is true if we initiated the splice.
+	IsInitiator bool
+
+	// fundingInputIndex is the index of the input of the splice
+	// transaction spending the current funding output.
+	fundingInputIndex int
+
+	// localCommit and remoteCommit are the commitments spending the new
+	// funding output.
+	localCommit  *commitment
+	remoteCommit *commitment
+}
+
+// FundingInputIndex returns the index of the input of the splice transaction
+// which spends the current funding output of the channel.
+func (s *Splice) FundingInputIndex() int {
+	return s.fundingInputIndex
+}
+
+// NewSplice validates the passed splice transaction, and creates the
+// commitments spending its new funding output. The local and remote delta
+// denote the amounts added to the channel by either party, negative amounts
+// are withdrawn from the channel.
+//
+// TODO(roasbeef): support splicing channels with active HTLCs, which requires
+// exchanging the second-level HTLC signatures of the new commitments as well.
+func (lc *LightningChannel) NewSplice(spliceTx *wire.MsgTx, localDelta,
+	remoteDelta btcutil.Amount, isInitiator bool) (*Splice, error) {
+
+	// A splice may only be signed once no updates are pending, as both
+	// parties need to agree on the commitments the splice is based on.
+	if !lc.FullySynced() {
+		return nil, fmt.Errorf("channel has pending updates")
+	}
+	if len(lc.ActiveHtlcs()) != 0 {
+		return nil, fmt.Errorf("unable to splice channel with active " +
+			"HTLCs")
+	}
+	if lc.HasPendingCommitments() {
+		return nil, fmt.Errorf("channel has unrevoked commitments")
+	}
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	// The splice transaction must spend the current funding output, and
+	// create a new funding output holding the new capacity of the channel.
+	splice := &Splice{
+		Tx:                spliceTx,
+		Capacity:          lc.channelState.Capacity + localDelta + remoteDelta,
+		IsInitiator:       isInitiator,
+		fundingInputIndex: -1,
+	}
+	for i, txIn := range spliceTx.TxIn {
+		if txIn.PreviousOutPoint == lc.channelState.FundingOutpoint {
+			splice.fundingInputIndex = i
+			break
+		}
+	}
+	if splice.fundingInputIndex == -1 {
+		return nil, fmt.Errorf("splice transaction doesn't spend "+
+			"funding output %v", lc.channelState.FundingOutpoint)
+	}
+
+	fundingPkScript := lc.signDesc.Output.PkScript
+	fundingOutputIndex := -1
+	for i, txOut := range spliceTx.TxOut {
+		if bytes.Equal(txOut.PkScript, fundingPkScript) {
+			fundingOutputIndex = i
+			break
+		}
+	}
+	if fundingOutputIndex == -1 {
+		return nil, fmt.Errorf("splice transaction doesn't create a " +
+			"new funding output")
+	}
+	fundingOutput := spliceTx.TxOut[fundingOutputIndex]
+	if btcutil.Amount(fundingOutput.Value) != splice.Capacity {
+		return nil, fmt.Errorf("new funding output has value %v, "+
+			"expected %v", btcutil.Amount(fundingOutput.Value),
+			splice.Capacity)
+	}
+	splice.FundingOutpoint = wire.OutPoint{
+		Hash:  spliceTx.TxHash(),
+		Index: uint32(fundingOutputIndex),
+	}
+
+	// With the splice transaction validated, we'll create both
+	// commitments spending the new funding output at the current
+	// commitment height, such that they're revoked along with the current
+	// commitments.
+	ourCommitSecret, err := lc.channelState.RevocationProducer.AtIndex(
+		lc.currentHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localKeyRing := deriveCommitmentKeys(
+		ComputeCommitmentPoint(ourCommitSecret[:]), true,
+		lc.localChanCfg, lc.remoteChanCfg,
+	)
+	remoteKeyRing := deriveCommitmentKeys(
+		lc.channelState.RemoteCurrentRevocation, false,
+		lc.localChanCfg, lc.remoteChanCfg,
+	)
+
+	fundingTxIn := *wire.NewTxIn(&splice.FundingOutpoint, nil, nil)
+	splice.localCommit, err = lc.createSpliceCommitment(
+		lc.localCommitChain.tail(), localKeyRing, fundingTxIn,
+		splice.Capacity, localDelta, remoteDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+	splice.remoteCommit, err = lc.createSpliceCommitment(
+		lc.remoteCommitChain.tail(), remoteKeyRing, fundingTxIn,
+		splice.Capacity, localDelta, remoteDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return splice, nil
+}
+
+// createSpliceCommitment creates the counterpart of the passed commitment,
+// spending the new funding output of a splice.
+func (lc *LightningChannel) createSpliceCommitment(current *commitment,
+	keyRing *CommitmentKeyRing, fundingTxIn wire.TxIn,
+	capacity, localDelta, remoteDelta btcutil.Amount) (*commitment, error) {
+
+	// We'll start with the balances of the current commitment, adding
+	// back the commitment fee to the balance of the initiator, as it will
+	// be re-applied when creating the new commitment transaction.
+	ourBalance := int64(current.ourBalance)
+	theirBalance := int64(current.theirBalance)
+	if lc.channelState.IsInitiator {
+		ourBalance += int64(lnwire.NewMSatFromSatoshis(current.fee))
+	} else {
+		theirBalance += int64(lnwire.NewMSatFromSatoshis(current.fee))
+	}
+	ourBalance += int64(lnwire.NewMSatFromSatoshis(localDelta))
+	theirBalance += int64(lnwire.NewMSatFromSatoshis(remoteDelta))
+
+	// Neither party may withdraw more than their balance, and any
+	// withdrawal must leave the channel reserve in place.
+	localReserve := int64(lnwire.NewMSatFromSatoshis(
+		lc.localChanCfg.ChanReserve,
+	))
+	remoteReserve := int64(lnwire.NewMSatFromSatoshis(
+		lc.remoteChanCfg.ChanReserve,
+	))
+	switch {
+	case ourBalance < 0 || (localDelta < 0 && ourBalance < localReserve):
+		return nil, fmt.Errorf("splice would drop local balance "+
+			"below reserve of %v", lc.localChanCfg.ChanReserve)
+
+	case theirBalance < 0 ||
+		(remoteDelta < 0 && theirBalance < remoteReserve):
+
+		return nil, fmt.Errorf("splice would drop remote balance "+
+			"below reserve of %v", lc.remoteChanCfg.ChanReserve)
+	}
+
+	c := &commitment{
+		height:            current.height,
+		isOurs:            current.isOurs,
+		ourMessageIndex:   current.ourMessageIndex,
+		theirMessageIndex: current.theirMessageIndex,
+		ourHtlcIndex:      current.ourHtlcIndex,
+		theirHtlcIndex:    current.theirHtlcIndex,
+		ourBalance:        lnwire.MilliSatoshi(ourBalance),
+		theirBalance:      lnwire.MilliSatoshi(theirBalance),
+		feePerKw:          current.feePerKw,
+		dustLimit:         current.dustLimit,
+	}
+	err := lc.createCommitmentTx(
+		c, &htlcView{}, keyRing, fundingTxIn, capacity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// spliceSignDesc returns the sign descriptor for commitment transactions
+// spending the new funding output of the splice.
+func (lc *LightningChannel) spliceSignDesc(s *Splice,
+	commitTx *wire.MsgTx) *SignDescriptor {
+
+	signDesc := *lc.signDesc
+	signDesc.Output = &wire.TxOut{
+		PkScript: lc.signDesc.Output.PkScript,
+		Value:    int64(s.Capacity),
+	}
+	signDesc.InputIndex = 0
+	signDesc.SigHashes = txscript.NewTxSigHashes(commitTx)
+
+	return &signDesc
+}
+
+// SignSplice signs the remote party's commitment transaction spending the new
+// funding output of the splice.
+func (lc *LightningChannel) SignSplice(s *Splice) (lnwire.Sig, error) {
+	lc.Lock()
+	defer lc.Unlock()
+
+	commitTx := s.remoteCommit.txn
+	rawSig, err := lc.Signer.SignOutputRaw(
+		commitTx, lc.spliceSignDesc(s, commitTx),
+	)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromRawSignature(rawSig)
+}
+
+// ReceiveSpliceSig verifies the remote party's signature for our commitment
+// transaction spending the new funding output of the splice.
+func (lc *LightningChannel) ReceiveSpliceSig(s *Splice, commitSig lnwire.Sig) error {
+	lc.Lock()
+	defer lc.Unlock()
+
+	localCommitTx := s.localCommit.txn
+	sigHash, err := txscript.CalcWitnessSigHash(
+		lc.signDesc.WitnessScript, txscript.NewTxSigHashes(localCommitTx),
+		txscript.SigHashAll, localCommitTx, 0, int64(s.Capacity),
+	)
+	if err != nil {
+		return err
+	}
+
+	verifyKey := btcec.PublicKey{
+		X:     lc.remoteChanCfg.MultiSigKey.PubKey.X,
+		Y:     lc.remoteChanCfg.MultiSigKey.PubKey.Y,
+		Curve: btcec.S256(),
+	}
+	cSig, err := commitSig.ToSignature()
+	if err != nil {
+		return err
+	}
+	if !cSig.Verify(sigHash, &verifyKey) {
+		var txBytes bytes.Buffer
+		localCommitTx.Serialize(&txBytes)
+		return &InvalidCommitSigError{
+			commitHeight: s.localCommit.height,
+			commitSig:    commitSig.ToSignatureBytes(),
+			sigHash:      sigHash,
+			commitTx:     txBytes.Bytes(),
+		}
+	}
+
+	s.localCommit.sig = commitSig.ToSignatureBytes()
+
+	return nil
+}
+
+// SignSpliceFundingInput signs the input of the splice transaction spending
+// the current funding output. The returned signature includes the sighash
+// flag.
+func (lc *LightningChannel) SignSpliceFundingInput(s *Splice) ([]byte, error) {
+	lc.Lock()
+	defer lc.Unlock()
+
+	signDesc := *lc.signDesc
+	signDesc.InputIndex = s.fundingInputIndex
+	signDesc.SigHashes = txscript.NewTxSigHashes(s.Tx)
+
+	sig, err := lc.Signer.SignOutputRaw(s.Tx, &signDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(sig, byte(txscript.SigHashAll)), nil
+}
+
+// CompleteSpliceFundingInput populates the witness of the input of the splice
+// transaction spending the current funding output, and verifies the remote
+// party's signature for it. Both signatures must include the sighash flag.
+func (lc *LightningChannel) CompleteSpliceFundingInput(s *Splice, localSig,
+	remoteSig []byte) error {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	ourKey := lc.localChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+	theirKey := lc.remoteChanCfg.MultiSigKey.PubKey.SerializeCompressed()
+
+	txIn := s.Tx.TxIn[s.fundingInputIndex]
+	txIn.Witness = SpendMultiSig(
+		lc.signDesc.WitnessScript, ourKey, localSig, theirKey,
+		remoteSig,
+	)
+
+	prevOut := lc.signDesc.Output
+	vm, err := txscript.NewEngine(
+		prevOut.PkScript, s.Tx, s.fundingInputIndex,
+		txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(s.Tx), prevOut.Value,
+	)
+	if err != nil {
+		return err
+	}
+	if err := vm.Execute(); err != nil {
+		txIn.Witness = nil
+		return fmt.Errorf("invalid signature for funding input: %v",
+			err)
+	}
+
+	return nil
+}
+
+// ToDisk returns the splice in the form in which it is persisted within the
+// database.
+func (s *Splice) ToDisk(broadcastHeight uint32) *channeldb.ChannelSplice {
+	return &channeldb.ChannelSplice{
+		SpliceTx:         s.Tx,
+		FundingOutpoint:  s.FundingOutpoint,
+		Capacity:         s.Capacity,
+		LocalCommitment:  *s.localCommit.toDiskCommit(true),
+		RemoteCommitment: *s.remoteCommit.toDiskCommit(false),
+		IsInitiator:      s.IsInitiator,
+		BroadcastHeight:  broadcastHeight,
+	}
+}
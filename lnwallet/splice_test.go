@@ -0,0 +1,163 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// createSpliceTx creates a splice transaction for the given channel, adding
+// the passed amount to the channel from an external input.
+func createSpliceTx(channel *LightningChannel,
+	amt btcutil.Amount) *wire.MsgTx {
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&channel.channelState.FundingOutpoint,
+		nil, nil))
+	spliceTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash:  chainhash.Hash{0x01},
+		Index: 2,
+	}, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(
+		int64(channel.channelState.Capacity+amt),
+		channel.signDesc.Output.PkScript,
+	))
+
+	return spliceTx
+}
+
+// TestSpliceCommitments tests that both parties of a channel are able to
+// exchange signatures for the commitments spending the new funding output of
+// a splice, and for the splice transaction spending the current funding
+// output.
+func TestSpliceCommitments(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Alice will splice an additional 1 BTC into the channel.
+	const spliceAmt = btcutil.SatoshiPerBitcoin
+	spliceTx := createSpliceTx(aliceChannel, spliceAmt)
+
+	aliceSplice, err := aliceChannel.NewSplice(
+		spliceTx.Copy(), spliceAmt, 0, true,
+	)
+	if err != nil {
+		t.Fatalf("unable to create alice's splice: %v", err)
+	}
+	bobSplice, err := bobChannel.NewSplice(
+		spliceTx.Copy(), 0, spliceAmt, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to create bob's splice: %v", err)
+	}
+
+	// Both parties should agree on each other's commitments.
+	if aliceSplice.localCommit.txn.TxHash() !=
+		bobSplice.remoteCommit.txn.TxHash() {
+
+		t.Fatalf("alice's commitment doesn't match")
+	}
+	if bobSplice.localCommit.txn.TxHash() !=
+		aliceSplice.remoteCommit.txn.TxHash() {
+
+		t.Fatalf("bob's commitment doesn't match")
+	}
+
+	// Next, both parties will exchange their signatures for the new
+	// commitments.
+	bobSig, err := bobChannel.SignSplice(bobSplice)
+	if err != nil {
+		t.Fatalf("unable to sign alice's commitment: %v", err)
+	}
+	if err := aliceChannel.ReceiveSpliceSig(aliceSplice, bobSig); err != nil {
+		t.Fatalf("alice unable to verify bob's signature: %v", err)
+	}
+	aliceSig, err := aliceChannel.SignSplice(aliceSplice)
+	if err != nil {
+		t.Fatalf("unable to sign bob's commitment: %v", err)
+	}
+	if err := bobChannel.ReceiveSpliceSig(bobSplice, aliceSig); err != nil {
+		t.Fatalf("bob unable to verify alice's signature: %v", err)
+	}
+
+	// A signature for a different commitment should be rejected.
+	if err := bobChannel.ReceiveSpliceSig(bobSplice, bobSig); err == nil {
+		t.Fatalf("bob accepted an invalid signature")
+	}
+
+	// With the commitments signed, both parties can sign the input
+	// spending the current funding output.
+	aliceFundingSig, err := aliceChannel.SignSpliceFundingInput(aliceSplice)
+	if err != nil {
+		t.Fatalf("unable to sign funding input: %v", err)
+	}
+	bobFundingSig, err := bobChannel.SignSpliceFundingInput(bobSplice)
+	if err != nil {
+		t.Fatalf("unable to sign funding input: %v", err)
+	}
+	err = aliceChannel.CompleteSpliceFundingInput(
+		aliceSplice, aliceFundingSig, bobFundingSig,
+	)
+	if err != nil {
+		t.Fatalf("unable to complete funding input: %v", err)
+	}
+	err = bobChannel.CompleteSpliceFundingInput(
+		bobSplice, bobFundingSig, bobFundingSig,
+	)
+	if err == nil {
+		t.Fatalf("bob accepted an invalid funding signature")
+	}
+
+	// Finally, the splice should add the spliced amount to Alice's
+	// balance, leaving Bob's balance untouched.
+	aliceDisk := aliceSplice.ToDisk(100)
+	prevLocal := aliceChannel.channelState.LocalCommitment
+	if aliceDisk.LocalCommitment.LocalBalance !=
+		prevLocal.LocalBalance+lnwire.NewMSatFromSatoshis(spliceAmt) {
+
+		t.Fatalf("unexpected local balance: %v",
+			aliceDisk.LocalCommitment.LocalBalance)
+	}
+	if aliceDisk.LocalCommitment.RemoteBalance != prevLocal.RemoteBalance {
+		t.Fatalf("unexpected remote balance: %v",
+			aliceDisk.LocalCommitment.RemoteBalance)
+	}
+	if aliceDisk.LocalCommitment.CommitHeight != prevLocal.CommitHeight {
+		t.Fatalf("unexpected commitment height: %v",
+			aliceDisk.LocalCommitment.CommitHeight)
+	}
+	if aliceDisk.Capacity != aliceChannel.Capacity+spliceAmt {
+		t.Fatalf("unexpected capacity: %v", aliceDisk.Capacity)
+	}
+}
+
+// TestSpliceOutReserve tests that a party can't splice funds out of a channel
+// if it would drop their balance below the channel reserve.
+func TestSpliceOutReserve(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Bob attempts to withdraw his entire balance, which should be
+	// rejected.
+	bobBalance := aliceChannel.channelState.LocalCommitment.
+		RemoteBalance.ToSatoshis()
+	spliceTx := createSpliceTx(aliceChannel, -bobBalance)
+
+	_, err = aliceChannel.NewSplice(spliceTx, 0, -bobBalance, false)
+	if err == nil {
+		t.Fatalf("splice below reserve should be rejected")
+	}
+}
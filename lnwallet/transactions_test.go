@@ -809,6 +809,7 @@ func TestCommitmentAndHTLCTransactions(t *testing.T) {
 		}
 		err = channel.createCommitmentTx(
 			commitmentView, theHTLCView, keys,
+			channel.fundingTxIn(), channel.channelState.Capacity,
 		)
 		if err != nil {
 			t.Errorf("Case %d: Failed to create new commitment tx: %v", i, err)
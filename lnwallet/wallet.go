@@ -98,6 +98,13 @@ type InitFundingReserveMsg struct {
 	// output selected to fund the channel should satisfy.
 	MinConfs int32
 
+	// ExternalFunding indicates that the funding transaction will be
+	// crafted by an external wallet rather than by our internal wallet. As
+	// a result, no coin selection is performed, and the funding
+	// transaction must be supplied through ProcessExternalFunding once the
+	// remote party's contribution has been processed.
+	ExternalFunding bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -154,6 +161,72 @@ type addSingleContributionMsg struct {
 	err chan error
 }
 
+// addFundingMsg represents a request to add funds of our own to a reservation
+// we're the responder of, turning it into a dual funder channel. Coins worth
+// the requested amount are selected from the wallet, and added as inputs to
+// our contribution to the funding transaction.
+type addFundingMsg struct {
+	pendingFundingID uint64
+
+	amt      btcutil.Amount
+	feeRate  SatPerKWeight
+	minConfs int32
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
+// addExternalFundingMsg represents the message that supplies the funding
+// transaction of a reservation that is funded by an external wallet. This
+// message is sent after the counterparty's contribution has been processed, as
+// the funding output can only be created once both multi-sig keys are known.
+type addExternalFundingMsg struct {
+	pendingFundingID uint64
+
+	// fundingTx is the fully signed funding transaction crafted by the
+	// external wallet.
+	fundingTx *wire.MsgTx
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
+// fundOutputsMsg is a request to craft a fully signed transaction paying to a
+// set of outputs, funded by coins of the wallet. This allows several channels
+// to be funded by a single transaction.
+type fundOutputsMsg struct {
+	outputs  []*wire.TxOut
+	feeRate  SatPerKWeight
+	minConfs int32
+
+	resp chan *wire.MsgTx
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
+// fundSpliceMsg is a request to select coins of the wallet which add the
+// given amount to a channel being spliced.
+type fundSpliceMsg struct {
+	amt      btcutil.Amount
+	feeRate  SatPerKWeight
+	minConfs int32
+
+	resp chan *ChannelContribution
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
+// releaseInputsMsg is a request to unlock the inputs of a transaction crafted
+// through FundOutputs, as it won't be broadcast after all.
+type releaseInputsMsg struct {
+	tx *wire.MsgTx
+
+	// NOTE: In order to avoid deadlocks, this channel MUST be buffered.
+	err chan error
+}
+
 // addCounterPartySigsMsg represents the final message required to complete,
 // and 'open' a payment channel. This message carries the counterparty's
 // signatures for each of their inputs to the funding transaction, and also a
@@ -385,10 +458,20 @@ out:
 				l.handleSingleContribution(msg)
 			case *addContributionMsg:
 				l.handleContributionMsg(msg)
+			case *addExternalFundingMsg:
+				l.handleExternalFunding(msg)
+			case *addFundingMsg:
+				l.handleAddFunding(msg)
 			case *addSingleFunderSigsMsg:
 				l.handleSingleFunderSigs(msg)
 			case *addCounterPartySigsMsg:
 				l.handleFundingCounterPartySigs(msg)
+			case *fundOutputsMsg:
+				l.handleFundOutputs(msg)
+			case *fundSpliceMsg:
+				l.handleFundSplice(msg)
+			case *releaseInputsMsg:
+				l.handleReleaseInputs(msg)
 			}
 		case <-l.quit:
 			// TODO: do some clean up
@@ -470,15 +553,17 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 
 	reservation.nodeAddr = req.NodeAddr
 	reservation.partialState.IdentityPub = req.NodeID
+	reservation.externalFunding = req.ExternalFunding
 
-	// If we're on the receiving end of a single funder channel then we
-	// don't need to perform any coin selection. Otherwise, attempt to
-	// obtain enough coins to meet the required funding amount.
-	if req.FundingAmount != 0 {
+	// If we're on the receiving end of a single funder channel, or the
+	// channel is funded by an external wallet, then we don't need to
+	// perform any coin selection. Otherwise, attempt to obtain enough
+	// coins to meet the required funding amount.
+	if req.FundingAmount != 0 && !req.ExternalFunding {
 		// Coin selection is done on the basis of sat/kw, so we'll use
 		// the fee rate passed in to perform coin selection.
 		err := l.selectCoinsAndChange(
-			req.FundingFeePerKw, req.FundingAmount, req.MinConfs,
+			req.FundingFeePerKw, req.FundingAmount, req.MinConfs, 1,
 			reservation.ourContribution,
 		)
 		if err != nil {
@@ -687,16 +772,23 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	pendingReservation.Lock()
 	defer pendingReservation.Unlock()
 
-	// Create a blank, fresh transaction. Soon to be a complete funding
-	// transaction which will allow opening a lightning channel.
-	pendingReservation.fundingTx = wire.NewMsgTx(1)
-	fundingTx := pendingReservation.fundingTx
-
 	// Some temporary variables to cut down on the resolution verbosity.
 	pendingReservation.theirContribution = req.contribution
 	theirContribution := req.contribution
 	ourContribution := pendingReservation.ourContribution
 
+	// If the funding transaction will be crafted by an external wallet,
+	// then there's nothing more we can do until it's been supplied.
+	if pendingReservation.externalFunding {
+		req.err <- nil
+		return
+	}
+
+	// Create a blank, fresh transaction. Soon to be a complete funding
+	// transaction which will allow opening a lightning channel.
+	pendingReservation.fundingTx = wire.NewMsgTx(1)
+	fundingTx := pendingReservation.fundingTx
+
 	// Add all multi-party inputs and outputs to the transaction.
 	for _, ourInput := range ourContribution.Inputs {
 		fundingTx.AddTxIn(ourInput)
@@ -717,7 +809,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// Finally, add the 2-of-2 multi-sig output which will set up the lightning
 	// channel.
 	channelCapacity := int64(pendingReservation.partialState.Capacity)
-	witnessScript, multiSigOut, err := GenFundingPkScript(
+	_, multiSigOut, err := GenFundingPkScript(
 		ourKey.PubKey.SerializeCompressed(),
 		theirKey.PubKey.SerializeCompressed(), channelCapacity,
 	)
@@ -733,14 +825,24 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	txsort.InPlaceSort(pendingReservation.fundingTx)
 
 	// Next, sign all inputs that are ours, collecting the signatures in
-	// order of the inputs.
+	// order of the inputs. We only sign the inputs of our own
+	// contribution, as the counterparty may attempt to have us sign any
+	// other coins of ours as part of its contribution.
 	pendingReservation.ourFundingInputScripts = make([]*InputScript, 0,
 		len(ourContribution.Inputs))
+	ourInputs := make(map[wire.OutPoint]struct{})
+	for _, ourInput := range ourContribution.Inputs {
+		ourInputs[ourInput.PreviousOutPoint] = struct{}{}
+	}
 	signDesc := SignDescriptor{
 		HashType:  txscript.SigHashAll,
 		SigHashes: txscript.NewTxSigHashes(fundingTx),
 	}
 	for i, txIn := range fundingTx.TxIn {
+		if _, ok := ourInputs[txIn.PreviousOutPoint]; !ok {
+			continue
+		}
+
 		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
 		if err == ErrNotMine {
 			continue
@@ -767,6 +869,150 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		)
 	}
 
+	if err := l.commitToFundingTx(pendingReservation); err != nil {
+		req.err <- err
+		return
+	}
+
+	req.err <- nil
+}
+
+// handleAddFunding adds funds of our own to a reservation we're the responder
+// of. Coin selection is performed for the requested amount, with the selected
+// coins and any change being added to our contribution. As the funding output
+// is paid for by the initiator, we only pay the fees for our own inputs and
+// outputs.
+func (l *LightningWallet) handleAddFunding(req *addFundingMsg) {
+	l.limboMtx.Lock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.Unlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	switch {
+	case pendingReservation.partialState.IsInitiator:
+		req.err <- fmt.Errorf("only the responder can add funds to " +
+			"a reservation")
+		return
+
+	case pendingReservation.fundingTx != nil:
+		req.err <- fmt.Errorf("funding transaction already created")
+		return
+
+	case len(pendingReservation.ourContribution.Inputs) != 0:
+		req.err <- fmt.Errorf("funds already added to reservation")
+		return
+	}
+
+	err := l.selectCoinsAndChange(
+		req.feeRate, req.amt, req.minConfs, 0,
+		pendingReservation.ourContribution,
+	)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	pendingReservation.addFunds(req.amt, true)
+
+	req.err <- nil
+}
+
+// handleExternalFunding processes the funding transaction of a reservation
+// that is funded by an external wallet. The transaction is verified to pay
+// the full channel capacity to the multi-sig output, after which both
+// commitment transactions are created and the counterparty's version is
+// signed, just as if we had crafted the funding transaction ourselves.
+func (l *LightningWallet) handleExternalFunding(req *addExternalFundingMsg) {
+	l.limboMtx.Lock()
+	pendingReservation, ok := l.fundingLimbo[req.pendingFundingID]
+	l.limboMtx.Unlock()
+	if !ok {
+		req.err <- fmt.Errorf("attempted to update non-existent funding state")
+		return
+	}
+
+	// Grab the mutex on the ChannelReservation to ensure thread-safety
+	pendingReservation.Lock()
+	defer pendingReservation.Unlock()
+
+	switch {
+	case !pendingReservation.externalFunding:
+		req.err <- fmt.Errorf("reservation isn't funded externally")
+		return
+
+	case pendingReservation.fundingTx != nil:
+		req.err <- fmt.Errorf("funding transaction already provided")
+		return
+	}
+
+	// The funding transaction must pay exactly the channel capacity to a
+	// single multi-sig output, as otherwise the commitment transactions
+	// wouldn't be able to spend it.
+	fundingOutput, err := pendingReservation.fundingOutput()
+	if err != nil {
+		req.err <- err
+		return
+	}
+	var numFundingOutputs int
+	for _, txOut := range req.fundingTx.TxOut {
+		if !bytes.Equal(txOut.PkScript, fundingOutput.PkScript) {
+			continue
+		}
+		if txOut.Value != fundingOutput.Value {
+			req.err <- fmt.Errorf("funding output has value %v, "+
+				"expected %v", btcutil.Amount(txOut.Value),
+				btcutil.Amount(fundingOutput.Value))
+			return
+		}
+		numFundingOutputs++
+	}
+	if numFundingOutputs != 1 {
+		req.err <- fmt.Errorf("funding transaction must contain "+
+			"exactly one funding output, found %v",
+			numFundingOutputs)
+		return
+	}
+
+	pendingReservation.fundingTx = req.fundingTx
+	if err := l.commitToFundingTx(pendingReservation); err != nil {
+		pendingReservation.fundingTx = nil
+		req.err <- err
+		return
+	}
+
+	req.err <- nil
+}
+
+// commitToFundingTx locates the multi-sig output within the funding
+// transaction of the reservation, then creates both commitment transactions
+// spending it, and generates our signature for the counterparty's version.
+//
+// NOTE: The reservation's mutex MUST be held when calling this method.
+func (l *LightningWallet) commitToFundingTx(
+	pendingReservation *ChannelReservation) error {
+
+	fundingTx := pendingReservation.fundingTx
+	theirContribution := pendingReservation.theirContribution
+	ourContribution := pendingReservation.ourContribution
+
+	ourKey := ourContribution.MultiSigKey
+	theirKey := theirContribution.MultiSigKey
+	channelCapacity := int64(pendingReservation.partialState.Capacity)
+	witnessScript, multiSigOut, err := GenFundingPkScript(
+		ourKey.PubKey.SerializeCompressed(),
+		theirKey.PubKey.SerializeCompressed(), channelCapacity,
+	)
+	if err != nil {
+		return err
+	}
+
 	// Locate the index of the multi-sig outpoint in order to record it
 	// since the outputs are canonically sorted. If this is a single funder
 	// workflow, then we'll also need to send this to the remote node.
@@ -809,39 +1055,30 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		theirContribution.FirstCommitmentPoint, fundingTxIn,
 	)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// With both commitment transactions constructed, generate the state
 	// obfuscator then use it to encode the current state number within
 	// both commitment transactions.
+	// The obfuscator is derived from the keys of the initiator followed by
+	// those of the responder, matching the derivation used once the
+	// channel is open.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType == channeldb.SingleFunder {
+	if chanState.IsInitiator {
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
 		)
 	} else {
-		ourSer := ourContribution.PaymentBasePoint.PubKey.SerializeCompressed()
-		theirSer := theirContribution.PaymentBasePoint.PubKey.SerializeCompressed()
-		switch bytes.Compare(ourSer, theirSer) {
-		case -1:
-			stateObfuscator = DeriveStateHintObfuscator(
-				ourContribution.PaymentBasePoint.PubKey,
-				theirContribution.PaymentBasePoint.PubKey,
-			)
-		default:
-			stateObfuscator = DeriveStateHintObfuscator(
-				theirContribution.PaymentBasePoint.PubKey,
-				ourContribution.PaymentBasePoint.PubKey,
-			)
-		}
+		stateObfuscator = DeriveStateHintObfuscator(
+			theirContribution.PaymentBasePoint.PubKey,
+			ourContribution.PaymentBasePoint.PubKey,
+		)
 	}
 	err = initStateHints(ourCommitTx, theirCommitTx, stateObfuscator)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 
 	// Sort both transactions according to the agreed upon canonical
@@ -862,7 +1099,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 
 	// Generate a signature for their version of the initial commitment
 	// transaction.
-	signDesc = SignDescriptor{
+	signDesc := SignDescriptor{
 		WitnessScript: witnessScript,
 		KeyDesc:       ourKey,
 		Output:        multiSigOut,
@@ -872,12 +1109,11 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	}
 	sigTheirCommit, err := l.Cfg.Signer.SignOutputRaw(theirCommitTx, &signDesc)
 	if err != nil {
-		req.err <- err
-		return
+		return err
 	}
 	pendingReservation.ourCommitmentSig = sigTheirCommit
 
-	req.err <- nil
+	return nil
 }
 
 // handleSingleContribution is called as the second step to a single funder
@@ -960,7 +1196,20 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	sigIndex := 0
 	fundingHashCache := txscript.NewTxSigHashes(fundingTx)
 	for i, txin := range fundingTx.TxIn {
-		if len(inputScripts) != 0 && len(txin.Witness) == 0 {
+		if len(txin.Witness) == 0 && len(txin.SignatureScript) == 0 {
+			// Each of their inputs must come with an input script,
+			// as otherwise the funding transaction can't be
+			// broadcast.
+			if sigIndex >= len(inputScripts) ||
+				len(inputScripts[sigIndex].Witness) == 0 {
+
+				msg.err <- fmt.Errorf("missing input script "+
+					"for funding tx input %v",
+					txin.PreviousOutPoint)
+				msg.completeChan <- nil
+				return
+			}
+
 			// Attach the input scripts so we can verify it below.
 			txin.Witness = inputScripts[sigIndex].Witness
 			txin.SignatureScript = inputScripts[sigIndex].ScriptSig
@@ -970,10 +1219,11 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 			//
 			// TODO(roasbeef): when dual funder pass actual
 			// height-hint
-			pkScript, err := WitnessScriptHash(
-				txin.Witness[len(txin.Witness)-1],
-			)
+			pkScript, err := inputPkScript(txin)
 			if err != nil {
+				msg.err <- err
+				msg.completeChan <- nil
+				return
 			}
 			output, err := l.Cfg.ChainIO.GetUtxo(
 				&txin.PreviousOutPoint,
@@ -1006,52 +1256,21 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 			sigIndex++
 		}
 	}
-
-	// At this point, we can also record and verify their signature for our
-	// commitment transaction.
-	res.theirCommitmentSig = msg.theirCommitmentSig
-	commitTx := res.partialState.LocalCommitment.CommitTx
-	ourKey := res.ourContribution.MultiSigKey
-	theirKey := res.theirContribution.MultiSigKey
-
-	// Re-generate both the witnessScript and p2sh output. We sign the
-	// witnessScript script, but include the p2sh output as the subscript
-	// for verification.
-	witnessScript, _, err := GenFundingPkScript(
-		ourKey.PubKey.SerializeCompressed(),
-		theirKey.PubKey.SerializeCompressed(),
-		int64(res.partialState.Capacity),
-	)
-	if err != nil {
-		msg.err <- err
-		msg.completeChan <- nil
-		return
-	}
-
-	// Next, create the spending scriptSig, and then verify that the script
-	// is complete, allowing us to spend from the funding transaction.
-	channelValue := int64(res.partialState.Capacity)
-	hashCache := txscript.NewTxSigHashes(commitTx)
-	sigHash, err := txscript.CalcWitnessSigHash(witnessScript, hashCache,
-		txscript.SigHashAll, commitTx, 0, channelValue)
-	if err != nil {
-		msg.err <- err
+	if sigIndex != len(inputScripts) {
+		msg.err <- fmt.Errorf("received %v input scripts for %v "+
+			"funding tx inputs", len(inputScripts), sigIndex)
 		msg.completeChan <- nil
 		return
 	}
 
-	// Verify that we've received a valid signature from the remote party
-	// for our version of the commitment transaction.
+	// At this point, we can also record and verify their signature for our
+	// commitment transaction.
+	res.theirCommitmentSig = msg.theirCommitmentSig
 	theirCommitSig := msg.theirCommitmentSig
-	sig, err := btcec.ParseSignature(theirCommitSig, btcec.S256())
-	if err != nil {
+	if err := res.verifyCommitSig(theirCommitSig); err != nil {
 		msg.err <- err
 		msg.completeChan <- nil
 		return
-	} else if !sig.Verify(sigHash, theirKey.PubKey) {
-		msg.err <- fmt.Errorf("counterparty's commitment signature is invalid")
-		msg.completeChan <- nil
-		return
 	}
 	res.partialState.LocalCommitment.CommitSig = theirCommitSig
 
@@ -1078,6 +1297,11 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	res.partialState.LocalChanCfg = res.ourContribution.toChanConfig()
 	res.partialState.RemoteChanCfg = res.theirContribution.toChanConfig()
 
+	// We'll also store any upfront shutdown scripts that were committed
+	// to, such that they can be enforced once the channel is closed.
+	res.partialState.LocalShutdownScript = res.ourContribution.UpfrontShutdown
+	res.partialState.RemoteShutdownScript = res.theirContribution.UpfrontShutdown
+
 	// We'll also record the finalized funding txn, which will allow us to
 	// rebroadcast on startup in case we fail.
 	res.partialState.FundingTxn = fundingTx
@@ -1096,6 +1320,23 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	msg.err <- nil
 }
 
+// inputPkScript returns the pkScript of the output spent by the given input,
+// as inferred from its witness. Inputs spending native P2WKH outputs are
+// recognized by their witness carrying a signature and a compressed public
+// key, while all other inputs are assumed to spend a P2WSH output.
+func inputPkScript(txIn *wire.TxIn) ([]byte, error) {
+	witness := txIn.Witness
+	if len(txIn.SignatureScript) == 0 && len(witness) == 2 &&
+		len(witness[1]) == btcec.PubKeyBytesLenCompressed {
+
+		pubKeyHash := btcutil.Hash160(witness[1])
+		return txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+			AddData(pubKeyHash).Script()
+	}
+
+	return WitnessScriptHash(witness[len(witness)-1])
+}
+
 // handleSingleFunderSigs is called once the remote peer who initiated the
 // single funder workflow has assembled the funding transaction, and generated
 // a signature for our version of the commitment transaction. This method
@@ -1241,6 +1482,8 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 	// which will be used for the lifetime of this channel.
 	chanState.LocalChanCfg = pendingReservation.ourContribution.toChanConfig()
 	chanState.RemoteChanCfg = pendingReservation.theirContribution.toChanConfig()
+	chanState.LocalShutdownScript = pendingReservation.ourContribution.UpfrontShutdown
+	chanState.RemoteShutdownScript = pendingReservation.theirContribution.UpfrontShutdown
 	err = chanState.SyncPending(pendingReservation.nodeAddr, uint32(bestHeight))
 	if err != nil {
 		req.err <- err
@@ -1257,13 +1500,13 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 }
 
 // selectCoinsAndChange performs coin selection in order to obtain witness
-// outputs which sum to at least 'numCoins' amount of satoshis. If coin
-// selection is successful/possible, then the selected coins are available
-// within the passed contribution's inputs. If necessary, a change address will
-// also be generated.
+// outputs which sum to at least 'numCoins' amount of satoshis, spread across
+// numOutputs funding outputs. If coin selection is successful/possible, then
+// the selected coins are available within the passed contribution's inputs. If
+// necessary, a change address will also be generated.
 // TODO(roasbeef): remove hardcoded fees.
 func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
-	amt btcutil.Amount, minConfs int32,
+	amt btcutil.Amount, minConfs int32, numOutputs int,
 	contribution *ChannelContribution) error {
 
 	// We hold the coin select mutex while querying for outputs, and
@@ -1285,7 +1528,9 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	// Perform coin selection over our available, unlocked unspent outputs
 	// in order to find enough coins to meet the funding amount
 	// requirements.
-	selectedCoins, changeAmt, err := coinSelect(feeRate, amt, coins)
+	selectedCoins, changeAmt, err := coinSelect(
+		feeRate, amt, numOutputs, coins,
+	)
 	if err != nil {
 		return err
 	}
@@ -1327,6 +1572,232 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	return nil
 }
 
+// FundOutputs crafts a transaction paying to all of the given outputs, funded
+// by coins of the wallet that satisfy the minimum number of confirmations. The
+// transaction is fully signed, but not broadcast. The selected coins remain
+// locked until either the transaction is broadcast, or ReleaseInputs is called
+// for it.
+func (l *LightningWallet) FundOutputs(outputs []*wire.TxOut,
+	feeRate SatPerKWeight, minConfs int32) (*wire.MsgTx, error) {
+
+	req := &fundOutputsMsg{
+		outputs:  outputs,
+		feeRate:  feeRate,
+		minConfs: minConfs,
+		resp:     make(chan *wire.MsgTx, 1),
+		err:      make(chan error, 1),
+	}
+
+	select {
+	case l.msgChan <- req:
+	case <-l.quit:
+		return nil, errors.New("wallet shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// handleFundOutputs performs coin selection for the outputs of the request,
+// then signs the resulting transaction.
+func (l *LightningWallet) handleFundOutputs(req *fundOutputsMsg) {
+	var amt btcutil.Amount
+	for _, txOut := range req.outputs {
+		amt += btcutil.Amount(txOut.Value)
+	}
+
+	// We'll reuse the coin selection of the funding workflow, storing the
+	// selected coins and change within a temporary contribution.
+	var contribution ChannelContribution
+	err := l.selectCoinsAndChange(
+		req.feeRate, amt, req.minConfs, len(req.outputs),
+		&contribution,
+	)
+	if err != nil {
+		req.resp <- nil
+		req.err <- err
+		return
+	}
+
+	tx := wire.NewMsgTx(1)
+	for _, txIn := range contribution.Inputs {
+		tx.AddTxIn(txIn)
+	}
+	for _, txOut := range req.outputs {
+		tx.AddTxOut(txOut)
+	}
+	for _, changeOutput := range contribution.ChangeOutputs {
+		tx.AddTxOut(changeOutput)
+	}
+	txsort.InPlaceSort(tx)
+
+	signDesc := SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(tx),
+	}
+	for i, txIn := range tx.TxIn {
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err == nil {
+			signDesc.Output = info
+			signDesc.InputIndex = i
+
+			var inputScript *InputScript
+			inputScript, err = l.Cfg.Signer.ComputeInputScript(
+				tx, &signDesc,
+			)
+			if err == nil {
+				txIn.SignatureScript = inputScript.ScriptSig
+				txIn.Witness = inputScript.Witness
+				continue
+			}
+		}
+
+		// As we were unable to sign the transaction, we'll release
+		// the selected coins.
+		l.releaseInputs(tx)
+		req.resp <- nil
+		req.err <- err
+		return
+	}
+
+	req.resp <- tx
+	req.err <- nil
+}
+
+// FundSplice selects coins of the wallet that add the given amount to a
+// channel being spliced, returning the selected inputs along with any change
+// within a contribution. Besides our own inputs and outputs, the selected
+// coins pay for the input spending the current funding output of the channel,
+// as well as for the new funding output. The selected coins remain locked
+// until either the splice transaction is broadcast, or ReleaseInputs is
+// called for it.
+func (l *LightningWallet) FundSplice(amt btcutil.Amount,
+	feeRate SatPerKWeight, minConfs int32) (*ChannelContribution, error) {
+
+	req := &fundSpliceMsg{
+		amt:      amt,
+		feeRate:  feeRate,
+		minConfs: minConfs,
+		resp:     make(chan *ChannelContribution, 1),
+		err:      make(chan error, 1),
+	}
+
+	select {
+	case l.msgChan <- req:
+	case <-l.quit:
+		return nil, errors.New("wallet shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// handleFundSplice performs coin selection for the splice of the request.
+func (l *LightningWallet) handleFundSplice(req *fundSpliceMsg) {
+	// Coin selection accounts for the fees of our own inputs and change,
+	// as well as for the new funding output, so we'll only need to add
+	// the fee for the input spending the current funding output.
+	fundingInputWeight := FundingInputSize*witnessScaleFactor +
+		WitnessSize
+	amt := req.amt + req.feeRate.FeeForWeight(int64(fundingInputWeight))
+
+	contribution := &ChannelContribution{
+		FundingAmount: req.amt,
+	}
+	err := l.selectCoinsAndChange(
+		req.feeRate, amt, req.minConfs, 1, contribution,
+	)
+	if err != nil {
+		req.resp <- nil
+		req.err <- err
+		return
+	}
+
+	req.resp <- contribution
+	req.err <- nil
+}
+
+// SignSpliceInputs signs all inputs of the splice transaction that spend the
+// given coins of the wallet, returning the input scripts in the order of the
+// inputs within the transaction. Only the passed coins are signed, as the
+// counterparty may attempt to have us sign any other coins of ours.
+func (l *LightningWallet) SignSpliceInputs(spliceTx *wire.MsgTx,
+	ourInputs []*wire.TxIn) ([]*InputScript, error) {
+
+	coins := make(map[wire.OutPoint]struct{}, len(ourInputs))
+	for _, txIn := range ourInputs {
+		coins[txIn.PreviousOutPoint] = struct{}{}
+	}
+
+	signDesc := SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(spliceTx),
+	}
+	inputScripts := make([]*InputScript, 0, len(ourInputs))
+	for i, txIn := range spliceTx.TxIn {
+		if _, ok := coins[txIn.PreviousOutPoint]; !ok {
+			continue
+		}
+
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		signDesc.Output = info
+		signDesc.InputIndex = i
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			spliceTx, &signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+		inputScripts = append(inputScripts, inputScript)
+	}
+
+	if len(inputScripts) != len(ourInputs) {
+		return nil, fmt.Errorf("splice transaction only spends %v of "+
+			"our %v inputs", len(inputScripts), len(ourInputs))
+	}
+
+	return inputScripts, nil
+}
+
+// ReleaseInputs unlocks the inputs of a transaction crafted through
+// FundOutputs, making them available for future funding requests.
+func (l *LightningWallet) ReleaseInputs(tx *wire.MsgTx) error {
+	req := &releaseInputsMsg{
+		tx:  tx,
+		err: make(chan error, 1),
+	}
+
+	select {
+	case l.msgChan <- req:
+	case <-l.quit:
+		return errors.New("wallet shutting down")
+	}
+
+	return <-req.err
+}
+
+// handleReleaseInputs unlocks the inputs of the transaction of the request.
+func (l *LightningWallet) handleReleaseInputs(req *releaseInputsMsg) {
+	l.releaseInputs(req.tx)
+	req.err <- nil
+}
+
+// releaseInputs unlocks all inputs of the transaction that were locked by the
+// wallet.
+func (l *LightningWallet) releaseInputs(tx *wire.MsgTx) {
+	for _, txIn := range tx.TxIn {
+		if _, ok := l.lockedOutPoints[txIn.PreviousOutPoint]; !ok {
+			continue
+		}
+
+		delete(l.lockedOutPoints, txIn.PreviousOutPoint)
+		l.UnlockOutpoint(txIn.PreviousOutPoint)
+	}
+}
+
 // DeriveStateHintObfuscator derives the bytes to be used for obfuscating the
 // state hints from the root to be used for a new channel. The obfuscator is
 // generated via the following computation:
@@ -1380,10 +1851,10 @@ func selectInputs(amt btcutil.Amount, coins []*Utxo) (btcutil.Amount, []*Utxo, e
 }
 
 // coinSelect attempts to select a sufficient amount of coins, including a
-// change output to fund amt satoshis, adhering to the specified fee rate. The
-// specified fee rate should be expressed in sat/kw for coin selection to
-// function properly.
-func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
+// change output to fund amt satoshis spread across numOutputs channel funding
+// outputs, adhering to the specified fee rate. The specified fee rate should be
+// expressed in sat/kw for coin selection to function properly.
+func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount, numOutputs int,
 	coins []*Utxo) ([]*Utxo, btcutil.Amount, error) {
 
 	amtNeeded := amt
@@ -1409,8 +1880,10 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 			}
 		}
 
-		// Channel funding multisig output is P2WSH.
-		weightEstimate.AddP2WSHOutput()
+		// Channel funding multisig outputs are P2WSH.
+		for i := 0; i < numOutputs; i++ {
+			weightEstimate.AddP2WSHOutput()
+		}
 
 		// Assume that change output is a P2WKH output.
 		//
@@ -86,6 +86,17 @@ type AcceptChannel struct {
 	// base point in order to derive the revocation keys that are placed
 	// within the commitment transaction of the sender.
 	FirstCommitmentPoint *btcec.PublicKey
+
+	// UpfrontShutdownScript is the script the sender commits to pay its
+	// funds to upon a cooperative close. If set, the receiver must reject
+	// any Shutdown message with a different delivery script. This field is
+	// optional, and empty if the sender didn't commit to a script.
+	UpfrontShutdownScript DeliveryAddress
+
+	// ChannelType is the channel type requested by the initiator, echoed
+	// back by the responder to agree to it. This field is optional, and
+	// nil if the initiator didn't request a particular channel type.
+	ChannelType *ChannelType
 }
 
 // A compile time check to ensure AcceptChannel implements the lnwire.Message
@@ -98,7 +109,7 @@ var _ Message = (*AcceptChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
-	return writeElements(w,
+	err := writeElements(w,
 		a.PendingChannelID[:],
 		a.DustLimit,
 		a.MaxValueInFlight,
@@ -113,7 +124,13 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 		a.DelayedPaymentPoint,
 		a.HtlcPoint,
 		a.FirstCommitmentPoint,
+		a.UpfrontShutdownScript,
 	)
+	if err != nil {
+		return err
+	}
+
+	return writeChannelTypeTLV(w, a.ChannelType)
 }
 
 // Decode deserializes the serialized AcceptChannel stored in the passed
@@ -122,7 +139,7 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
-	return readElements(r,
+	err := readElements(r,
 		a.PendingChannelID[:],
 		&a.DustLimit,
 		&a.MaxValueInFlight,
@@ -138,6 +155,17 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 		&a.HtlcPoint,
 		&a.FirstCommitmentPoint,
 	)
+	if err != nil {
+		return err
+	}
+
+	err = readOptionalDeliveryAddress(r, &a.UpfrontShutdownScript)
+	if err != nil {
+		return err
+	}
+
+	a.ChannelType, err = readChannelTypeTLV(r)
+	return err
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -153,6 +181,7 @@ func (a *AcceptChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) MaxPayloadLength(uint32) uint32 {
-	// 32 + (8 * 4) + (4 * 1) + (2 * 2) + (33 * 6)
-	return 270
+	// 32 + (8 * 4) + (4 * 1) + (2 * 2) + (33 * 6) + (2 + 34) +
+	// maxChannelTypeRecordSize
+	return 306 + maxChannelTypeRecordSize
 }
@@ -0,0 +1,203 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	// channelTypeRecordType is the type of the TLV record that carries the
+	// channel type within the open_channel and accept_channel messages.
+	channelTypeRecordType = 1
+
+	// maxChannelTypeRecordSize is the maximum size of the TLV stream of
+	// the open_channel and accept_channel messages that we'll send: the
+	// type and length of the channel type record, followed by enough
+	// bytes to cover all feature bits we know of.
+	maxChannelTypeRecordSize = 1 + 1 + 8
+)
+
+// ChannelType is the set of feature bits that describe the type of a
+// channel, such as whether it's a zero-conf channel. It's sent within the TLV
+// stream that trails the open_channel and accept_channel messages, and
+// allows the initiator to explicitly request a channel type, which the
+// responder must echo back to agree to it.
+type ChannelType RawFeatureVector
+
+// NewChannelType creates a new channel type with the given feature bits set.
+func NewChannelType(bits ...FeatureBit) *ChannelType {
+	return (*ChannelType)(NewRawFeatureVector(bits...))
+}
+
+// IsSet returns whether a particular feature bit is set in the channel type.
+func (c *ChannelType) IsSet(feature FeatureBit) bool {
+	return c != nil && (*RawFeatureVector)(c).IsSet(feature)
+}
+
+// writeChannelTypeTLV writes the TLV stream carrying the channel type, if
+// set, to the passed io.Writer.
+func writeChannelTypeTLV(w io.Writer, channelType *ChannelType) error {
+	if channelType == nil {
+		return nil
+	}
+
+	// The feature bits are encoded without the length prefix, as the
+	// record already carries the length of its value.
+	var b bytes.Buffer
+	if err := (*RawFeatureVector)(channelType).Encode(&b); err != nil {
+		return err
+	}
+	value := b.Bytes()[2:]
+
+	if err := writeBigSize(w, channelTypeRecordType); err != nil {
+		return err
+	}
+	if err := writeBigSize(w, uint64(len(value))); err != nil {
+		return err
+	}
+
+	_, err := w.Write(value)
+	return err
+}
+
+// readChannelTypeTLV reads the TLV stream trailing the open_channel and
+// accept_channel messages, returning the channel type if the stream carries
+// one. Unknown odd records are ignored, while unknown even records result in
+// an error, as the sender requires us to understand them.
+func readChannelTypeTLV(r io.Reader) (*ChannelType, error) {
+	var (
+		channelType *ChannelType
+		lastType    uint64
+		first       = true
+	)
+	for {
+		recordType, err := readBigSize(r)
+		switch {
+		case err == io.EOF:
+			return channelType, nil
+
+		case err != nil:
+			return nil, err
+		}
+
+		if !first && recordType <= lastType {
+			return nil, fmt.Errorf("TLV record type %v not in "+
+				"ascending order", recordType)
+		}
+		first = false
+		lastType = recordType
+
+		length, err := readBigSize(r)
+		if err != nil {
+			return nil, err
+		}
+		if length > MaxMessagePayload {
+			return nil, fmt.Errorf("TLV record length %v exceeds "+
+				"max message size", length)
+		}
+
+		switch {
+		case recordType == channelTypeRecordType:
+			value := make([]byte, length+2)
+			binary.BigEndian.PutUint16(value[:2], uint16(length))
+			if _, err := io.ReadFull(r, value[2:]); err != nil {
+				return nil, err
+			}
+
+			fv := NewRawFeatureVector()
+			if err := fv.Decode(bytes.NewReader(value)); err != nil {
+				return nil, err
+			}
+			channelType = (*ChannelType)(fv)
+
+		case recordType%2 == 0:
+			return nil, fmt.Errorf("unknown required TLV record "+
+				"type %v", recordType)
+
+		default:
+			_, err := io.CopyN(ioutil.Discard, r, int64(length))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// writeBigSize writes the passed integer using the variable length BigSize
+// encoding of TLV streams.
+func writeBigSize(w io.Writer, i uint64) error {
+	var b []byte
+	switch {
+	case i < 0xfd:
+		b = []byte{byte(i)}
+
+	case i <= 0xffff:
+		b = make([]byte, 3)
+		b[0] = 0xfd
+		binary.BigEndian.PutUint16(b[1:], uint16(i))
+
+	case i <= 0xffffffff:
+		b = make([]byte, 5)
+		b[0] = 0xfe
+		binary.BigEndian.PutUint32(b[1:], uint32(i))
+
+	default:
+		b = make([]byte, 9)
+		b[0] = 0xff
+		binary.BigEndian.PutUint64(b[1:], i)
+	}
+
+	_, err := w.Write(b)
+	return err
+}
+
+// readBigSize reads an integer encoded using the variable length BigSize
+// encoding of TLV streams. If the reader is exhausted before the first byte
+// is read, io.EOF is returned.
+func readBigSize(r io.Reader) (uint64, error) {
+	var discriminant [1]byte
+	if _, err := io.ReadFull(r, discriminant[:]); err != nil {
+		return 0, err
+	}
+
+	var (
+		size uint64
+		min  uint64
+		b    []byte
+	)
+	switch discriminant[0] {
+	case 0xff:
+		b, min = make([]byte, 8), 0x100000000
+	case 0xfe:
+		b, min = make([]byte, 4), 0x10000
+	case 0xfd:
+		b, min = make([]byte, 2), 0xfd
+	default:
+		return uint64(discriminant[0]), nil
+	}
+
+	if _, err := io.ReadFull(r, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+
+	switch len(b) {
+	case 8:
+		size = binary.BigEndian.Uint64(b)
+	case 4:
+		size = uint64(binary.BigEndian.Uint32(b))
+	case 2:
+		size = uint64(binary.BigEndian.Uint16(b))
+	}
+
+	if size < min {
+		return 0, fmt.Errorf("BigSize %v not minimally encoded", size)
+	}
+
+	return size, nil
+}
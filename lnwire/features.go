@@ -35,6 +35,17 @@ const (
 	// connection is established.
 	InitialRoutingSync FeatureBit = 3
 
+	// UpfrontShutdownScriptRequired is a feature bit that indicates that
+	// the sending peer *requires* the remote peer to commit to the script
+	// its funds are paid to upon a cooperative close when opening a
+	// channel.
+	UpfrontShutdownScriptRequired FeatureBit = 4
+
+	// UpfrontShutdownScriptOptional is an optional feature bit that
+	// signals that the sending peer supports committing to the script its
+	// funds are paid to upon a cooperative close when opening a channel.
+	UpfrontShutdownScriptOptional FeatureBit = 5
+
 	// GossipQueriesRequired is a feature bit that indicates that the
 	// receiving peer MUST know of the set of features that allows nodes to
 	// more efficiently query the network view of peers on the network for
@@ -46,6 +57,54 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// WumboChannelsRequired is a feature bit that indicates that the
+	// sending peer *requires* the remote peer to accept channels larger
+	// than the soft-limit defined in BOLT-0002.
+	WumboChannelsRequired FeatureBit = 18
+
+	// WumboChannelsOptional is an optional feature bit that signals that
+	// the sending peer is willing to open and accept channels larger than
+	// the soft-limit defined in BOLT-0002.
+	WumboChannelsOptional FeatureBit = 19
+
+	// ZeroConfRequired is a feature bit that indicates that the sending
+	// peer *requires* the remote peer to support zero-conf channels, which
+	// are used under an alias short channel ID before their funding
+	// transaction confirms.
+	ZeroConfRequired FeatureBit = 50
+
+	// ZeroConfOptional is an optional feature bit that signals that the
+	// sending peer supports zero-conf channels, which are used under an
+	// alias short channel ID before their funding transaction confirms.
+	ZeroConfOptional FeatureBit = 51
+
+	// DualFundRequired is a feature bit that indicates that the sending
+	// peer *requires* the remote peer to support the interactive
+	// construction of funding transactions, which allows both parties to
+	// contribute funds to a channel. As the feature is experimental, it
+	// uses a bit within the experimental range.
+	DualFundRequired FeatureBit = 228
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// sending peer supports the interactive construction of funding
+	// transactions, which allows both parties to contribute funds to a
+	// channel. As the feature is experimental, it uses a bit within the
+	// experimental range.
+	DualFundOptional FeatureBit = 229
+
+	// SpliceRequired is a feature bit that indicates that the sending
+	// peer *requires* the remote peer to support splicing funds into or
+	// out of a live channel, along with the quiescence handshake it
+	// relies on. As the feature is experimental, it uses a bit within the
+	// experimental range.
+	SpliceRequired FeatureBit = 230
+
+	// SpliceOptional is an optional feature bit that signals that the
+	// sending peer supports splicing funds into or out of a live channel,
+	// along with the quiescence handshake it relies on. As the feature is
+	// experimental, it uses a bit within the experimental range.
+	SpliceOptional FeatureBit = 231
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -67,11 +126,21 @@ const (
 // not advertised to the entire network. A full description of these feature
 // bits is provided in the BOLT-09 specification.
 var LocalFeatures = map[FeatureBit]string{
-	DataLossProtectRequired: "data-loss-protect-required",
-	DataLossProtectOptional: "data-loss-protect-optional",
-	InitialRoutingSync:      "initial-routing-sync",
-	GossipQueriesRequired:   "gossip-queries-required",
-	GossipQueriesOptional:   "gossip-queries-optional",
+	DataLossProtectRequired:       "data-loss-protect-required",
+	DataLossProtectOptional:       "data-loss-protect-optional",
+	InitialRoutingSync:            "initial-routing-sync",
+	UpfrontShutdownScriptRequired: "upfront-shutdown-script-required",
+	UpfrontShutdownScriptOptional: "upfront-shutdown-script-optional",
+	GossipQueriesRequired:         "gossip-queries-required",
+	GossipQueriesOptional:         "gossip-queries-optional",
+	WumboChannelsRequired:         "wumbo-channels-required",
+	WumboChannelsOptional:         "wumbo-channels-optional",
+	ZeroConfRequired:              "zero-conf-required",
+	ZeroConfOptional:              "zero-conf-optional",
+	DualFundRequired:              "dual-fund-required",
+	DualFundOptional:              "dual-fund-optional",
+	SpliceRequired:                "splice-required",
+	SpliceOptional:                "splice-optional",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
@@ -160,6 +160,27 @@ func writeElement(w io.Writer, element interface{}) error {
 				return err
 			}
 		}
+	case []InputWitness:
+		if err := writeElement(w, uint16(len(e))); err != nil {
+			return err
+		}
+
+		for _, inputWitness := range e {
+			err := writeVarBytes16(w, inputWitness.SigScript)
+			if err != nil {
+				return err
+			}
+
+			numItems := uint16(len(inputWitness.Witness))
+			if err := writeElement(w, numItems); err != nil {
+				return err
+			}
+			for _, item := range inputWitness.Witness {
+				if err := writeVarBytes16(w, item); err != nil {
+					return err
+				}
+			}
+		}
 	case Sig:
 		// Write buffer
 		if _, err := w.Write(e[:]); err != nil {
@@ -528,6 +549,43 @@ func readElement(r io.Reader, element interface{}) error {
 
 		*e = sigs
 
+	case *[]InputWitness:
+		var numInputs uint16
+		if err := readElement(r, &numInputs); err != nil {
+			return err
+		}
+
+		var inputWitnesses []InputWitness
+		if numInputs > 0 {
+			inputWitnesses = make([]InputWitness, numInputs)
+		}
+		for i := range inputWitnesses {
+			sigScript, err := readVarBytes16(r)
+			if err != nil {
+				return err
+			}
+			inputWitnesses[i].SigScript = sigScript
+
+			var numItems uint16
+			if err := readElement(r, &numItems); err != nil {
+				return err
+			}
+			if numItems == 0 {
+				continue
+			}
+
+			witness := make(wire.TxWitness, numItems)
+			for j := range witness {
+				witness[j], err = readVarBytes16(r)
+				if err != nil {
+					return err
+				}
+			}
+			inputWitnesses[i].Witness = witness
+		}
+
+		*e = inputWitnesses
+
 	case *Sig:
 		if _, err := io.ReadFull(r, e[:]); err != nil {
 			return err
@@ -796,6 +854,39 @@ func readElement(r io.Reader, element interface{}) error {
 	return nil
 }
 
+// writeVarBytes16 writes the passed byte slice prefixed by its length, encoded
+// as a big-endian uint16.
+func writeVarBytes16(w io.Writer, b []byte) error {
+	if len(b) > math.MaxUint16 {
+		return fmt.Errorf("byte slice of length %v is too long", len(b))
+	}
+
+	if err := writeElement(w, uint16(len(b))); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// readVarBytes16 reads a byte slice prefixed by its length, encoded as a
+// big-endian uint16. An empty byte slice is returned as nil.
+func readVarBytes16(r io.Reader) ([]byte, error) {
+	var length uint16
+	if err := readElement(r, &length); err != nil {
+		return nil, err
+	}
+	if length == 0 {
+		return nil, nil
+	}
+
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
 // readElements deserializes a variable number of elements into the passed
 // io.Reader, with each element being deserialized according to the readElement
 // function.
@@ -73,6 +73,17 @@ func randRawFeatureVector(r *rand.Rand) *RawFeatureVector {
 	return featureVec
 }
 
+// randDeliveryAddress returns a random, non-empty delivery address of at most
+// the maximum length allowed on the wire.
+func randDeliveryAddress(r *rand.Rand) (DeliveryAddress, error) {
+	addr := make(DeliveryAddress, r.Intn(34)+1)
+	if _, err := r.Read(addr); err != nil {
+		return nil, err
+	}
+
+	return addr, nil
+}
+
 func randTCP4Addr(r *rand.Rand) (*net.TCPAddr, error) {
 	var ip [4]byte
 	if _, err := r.Read(ip[:]); err != nil {
@@ -190,6 +201,93 @@ func TestEmptyMessageUnknownType(t *testing.T) {
 	}
 }
 
+// TestOptionalUpfrontShutdownScript tests that an AcceptChannel message sent
+// by a peer unaware of the upfront shutdown script can still be decoded.
+func TestOptionalUpfrontShutdownScript(t *testing.T) {
+	t.Parallel()
+
+	key, err := randPubKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	msg := &AcceptChannel{
+		FundingKey:           key,
+		RevocationPoint:      key,
+		PaymentPoint:         key,
+		DelayedPaymentPoint:  key,
+		HtlcPoint:            key,
+		FirstCommitmentPoint: key,
+	}
+
+	var b bytes.Buffer
+	if err := msg.Encode(&b, 0); err != nil {
+		t.Fatalf("unable to encode message: %v", err)
+	}
+
+	// Strip the length prefix of the empty shutdown script, leaving the
+	// message as encoded by a legacy peer.
+	legacy := b.Bytes()[:b.Len()-2]
+
+	var decoded AcceptChannel
+	err = decoded.Decode(bytes.NewReader(legacy), 0)
+	if err != nil {
+		t.Fatalf("unable to decode legacy message: %v", err)
+	}
+	if decoded.UpfrontShutdownScript != nil {
+		t.Fatalf("expected no shutdown script, got %x",
+			decoded.UpfrontShutdownScript)
+	}
+	if !reflect.DeepEqual(msg, &decoded) {
+		t.Fatalf("messages don't match: %v vs %v",
+			spew.Sdump(msg), spew.Sdump(decoded))
+	}
+}
+
+// TestChannelTypeTLV tests that the channel type is decoded from the TLV
+// stream trailing an OpenChannel message, that unknown odd records are
+// skipped, and that unknown even records are rejected.
+func TestChannelTypeTLV(t *testing.T) {
+	t.Parallel()
+
+	key, err := randPubKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	msg := &OpenChannel{
+		FundingKey:           key,
+		RevocationPoint:      key,
+		PaymentPoint:         key,
+		DelayedPaymentPoint:  key,
+		HtlcPoint:            key,
+		FirstCommitmentPoint: key,
+		ChannelType:          NewChannelType(ZeroConfRequired),
+	}
+
+	var b bytes.Buffer
+	if err := msg.Encode(&b, 0); err != nil {
+		t.Fatalf("unable to encode message: %v", err)
+	}
+
+	// Append an unknown odd record, which we should skip.
+	withOdd := append(append([]byte{}, b.Bytes()...), 3, 1, 0xff)
+
+	var decoded OpenChannel
+	err = decoded.Decode(bytes.NewReader(withOdd), 0)
+	if err != nil {
+		t.Fatalf("unable to decode message: %v", err)
+	}
+	if !decoded.ChannelType.IsSet(ZeroConfRequired) {
+		t.Fatalf("expected zero-conf channel type")
+	}
+
+	// An unknown even record must cause the message to be rejected.
+	withEven := append(append([]byte{}, b.Bytes()...), 4, 1, 0xff)
+	err = decoded.Decode(bytes.NewReader(withEven), 0)
+	if err == nil {
+		t.Fatalf("expected unknown even record to be rejected")
+	}
+}
+
 // TestLightningWireProtocol uses the testing/quick package to create a series
 // of fuzz tests to attempt to break a primary scenario which is implemented as
 // property based testing scenario.
@@ -305,6 +403,21 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			// The upfront shutdown script is optional, so we only
+			// set it half of the time.
+			if r.Intn(2) == 0 {
+				req.UpfrontShutdownScript, err = randDeliveryAddress(r)
+				if err != nil {
+					t.Fatalf("unable to generate address: %v", err)
+					return
+				}
+			}
+
+			// Likewise, the channel type is optional.
+			if r.Intn(2) == 0 {
+				req.ChannelType = NewChannelType(ZeroConfRequired)
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgAcceptChannel: func(v []reflect.Value, r *rand.Rand) {
@@ -355,6 +468,21 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			// The upfront shutdown script is optional, so we only
+			// set it half of the time.
+			if r.Intn(2) == 0 {
+				req.UpfrontShutdownScript, err = randDeliveryAddress(r)
+				if err != nil {
+					t.Fatalf("unable to generate address: %v", err)
+					return
+				}
+			}
+
+			// Likewise, the channel type is optional.
+			if r.Intn(2) == 0 {
+				req.ChannelType = NewChannelType(ZeroConfRequired)
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgFundingCreated: func(v []reflect.Value, r *rand.Rand) {
@@ -380,6 +508,95 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			v[0] = reflect.ValueOf(req)
 		},
+		MsgTxAddInput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddInput{
+				Sequence: uint32(r.Int63()),
+				Value:    btcutil.Amount(r.Int63()),
+			}
+
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v", err)
+				return
+			}
+
+			_, err := r.Read(req.PreviousOutPoint.Hash[:])
+			if err != nil {
+				t.Fatalf("unable to generate hash: %v", err)
+				return
+			}
+			req.PreviousOutPoint.Index = uint32(r.Int31()) % math.MaxUint16
+
+			req.PkScript = make([]byte, 1+r.Intn(34))
+			if _, err := r.Read(req.PkScript); err != nil {
+				t.Fatalf("unable to generate pkscript: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxAddOutput: func(v []reflect.Value, r *rand.Rand) {
+			req := TxAddOutput{
+				Value: btcutil.Amount(r.Int63()),
+			}
+
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v", err)
+				return
+			}
+
+			req.PkScript = make([]byte, 1+r.Intn(34))
+			if _, err := r.Read(req.PkScript); err != nil {
+				t.Fatalf("unable to generate pkscript: %v", err)
+				return
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
+		MsgTxSignatures: func(v []reflect.Value, r *rand.Rand) {
+			req := TxSignatures{}
+
+			if _, err := r.Read(req.PendingChannelID[:]); err != nil {
+				t.Fatalf("unable to generate pending chan id: %v", err)
+				return
+			}
+			if _, err := r.Read(req.TxHash[:]); err != nil {
+				t.Fatalf("unable to generate hash: %v", err)
+				return
+			}
+
+			// randBytes returns either nil, or a random non-empty
+			// slice, as empty slices are decoded as nil.
+			randBytes := func(maxLen int) []byte {
+				if r.Intn(2) == 0 {
+					return nil
+				}
+
+				b := make([]byte, 1+r.Intn(maxLen))
+				if _, err := r.Read(b); err != nil {
+					t.Fatalf("unable to generate bytes: %v", err)
+				}
+				return b
+			}
+
+			numInputs := r.Intn(10)
+			for i := 0; i < numInputs; i++ {
+				inputWitness := InputWitness{
+					SigScript: randBytes(40),
+				}
+
+				numItems := r.Intn(4)
+				for j := 0; j < numItems; j++ {
+					inputWitness.Witness = append(
+						inputWitness.Witness,
+						randBytes(80),
+					)
+				}
+
+				req.Witnesses = append(req.Witnesses, inputWitness)
+			}
+
+			v[0] = reflect.ValueOf(req)
+		},
 		MsgFundingSigned: func(v []reflect.Value, r *rand.Rand) {
 			var c [32]byte
 			_, err := r.Read(c[:])
@@ -820,6 +1037,66 @@ func TestLightningWireProtocol(t *testing.T) {
 				return mainScenario(&m)
 			},
 		},
+		{
+			msgType: MsgTxAddInput,
+			scenario: func(m TxAddInput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxAddOutput,
+			scenario: func(m TxAddOutput) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxComplete,
+			scenario: func(m TxComplete) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgTxSignatures,
+			scenario: func(m TxSignatures) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgStfu,
+			scenario: func(m Stfu) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceInit,
+			scenario: func(m SpliceInit) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAck,
+			scenario: func(m SpliceAck) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceSigned,
+			scenario: func(m SpliceSigned) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceLocked,
+			scenario: func(m SpliceLocked) bool {
+				return mainScenario(&m)
+			},
+		},
+		{
+			msgType: MsgSpliceAbort,
+			scenario: func(m SpliceAbort) bool {
+				return mainScenario(&m)
+			},
+		},
 		{
 			msgType: MsgClosingSigned,
 			scenario: func(m ClosingSigned) bool {
@@ -54,11 +54,30 @@ const (
 	MsgQueryChannelRange                   = 263
 	MsgReplyChannelRange                   = 264
 	MsgGossipTimestampRange                = 265
+
+	// The messages used to construct funding transactions interactively
+	// are experimental, so they use odd types within the experimental
+	// range, which peers that don't know of them will ignore.
+	MsgTxAddInput   = 32769
+	MsgTxAddOutput  = 32771
+	MsgTxComplete   = 32773
+	MsgTxSignatures = 32775
+
+	// Likewise, the messages used to splice active channels, along with
+	// the quiescence handshake they rely on, are experimental.
+	MsgStfu         = 32777
+	MsgSpliceLocked = 32779
+	MsgSpliceInit   = 32781
+	MsgSpliceAck    = 32783
+	MsgSpliceSigned = 32785
+	MsgSpliceAbort  = 32787
 )
 
 // String return the string representation of message type.
 func (t MessageType) String() string {
 	switch t {
+	case MsgStfu:
+		return "Stfu"
 	case MsgInit:
 		return "Init"
 	case MsgOpenChannel:
@@ -75,6 +94,24 @@ func (t MessageType) String() string {
 		return "Shutdown"
 	case MsgClosingSigned:
 		return "ClosingSigned"
+	case MsgTxAddInput:
+		return "TxAddInput"
+	case MsgTxAddOutput:
+		return "TxAddOutput"
+	case MsgTxComplete:
+		return "TxComplete"
+	case MsgTxSignatures:
+		return "TxSignatures"
+	case MsgSpliceInit:
+		return "SpliceInit"
+	case MsgSpliceAck:
+		return "SpliceAck"
+	case MsgSpliceSigned:
+		return "SpliceSigned"
+	case MsgSpliceLocked:
+		return "SpliceLocked"
+	case MsgSpliceAbort:
+		return "SpliceAbort"
 	case MsgUpdateAddHTLC:
 		return "UpdateAddHTLC"
 	case MsgUpdateFailHTLC:
@@ -160,6 +197,8 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 	var msg Message
 
 	switch msgType {
+	case MsgStfu:
+		msg = &Stfu{}
 	case MsgInit:
 		msg = &Init{}
 	case MsgOpenChannel:
@@ -176,6 +215,24 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &Shutdown{}
 	case MsgClosingSigned:
 		msg = &ClosingSigned{}
+	case MsgTxAddInput:
+		msg = &TxAddInput{}
+	case MsgTxAddOutput:
+		msg = &TxAddOutput{}
+	case MsgTxComplete:
+		msg = &TxComplete{}
+	case MsgTxSignatures:
+		msg = &TxSignatures{}
+	case MsgSpliceInit:
+		msg = &SpliceInit{}
+	case MsgSpliceAck:
+		msg = &SpliceAck{}
+	case MsgSpliceSigned:
+		msg = &SpliceSigned{}
+	case MsgSpliceLocked:
+		msg = &SpliceLocked{}
+	case MsgSpliceAbort:
+		msg = &SpliceAbort{}
 	case MsgUpdateAddHTLC:
 		msg = &UpdateAddHTLC{}
 	case MsgUpdateFailHTLC:
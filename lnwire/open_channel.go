@@ -122,6 +122,17 @@ type OpenChannel struct {
 	// Currently, the least significant bit of this bit field indicates the
 	// initiator of the channel wishes to advertise this channel publicly.
 	ChannelFlags FundingFlag
+
+	// UpfrontShutdownScript is the script the sender commits to pay its
+	// funds to upon a cooperative close. If set, the receiver must reject
+	// any Shutdown message with a different delivery script. This field is
+	// optional, and empty if the sender didn't commit to a script.
+	UpfrontShutdownScript DeliveryAddress
+
+	// ChannelType is the type of channel the initiator explicitly requests,
+	// such as a zero-conf channel. This field is optional, and nil if the
+	// initiator didn't request a particular channel type.
+	ChannelType *ChannelType
 }
 
 // A compile time check to ensure OpenChannel implements the lnwire.Message
@@ -134,7 +145,7 @@ var _ Message = (*OpenChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
-	return writeElements(w,
+	err := writeElements(w,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		o.FundingAmount,
@@ -153,7 +164,13 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 		o.HtlcPoint,
 		o.FirstCommitmentPoint,
 		o.ChannelFlags,
+		o.UpfrontShutdownScript,
 	)
+	if err != nil {
+		return err
+	}
+
+	return writeChannelTypeTLV(w, o.ChannelType)
 }
 
 // Decode deserializes the serialized OpenChannel stored in the passed
@@ -162,7 +179,7 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
-	return readElements(r,
+	err := readElements(r,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		&o.FundingAmount,
@@ -182,6 +199,17 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 		&o.FirstCommitmentPoint,
 		&o.ChannelFlags,
 	)
+	if err != nil {
+		return err
+	}
+
+	err = readOptionalDeliveryAddress(r, &o.UpfrontShutdownScript)
+	if err != nil {
+		return err
+	}
+
+	o.ChannelType, err = readChannelTypeTLV(r)
+	return err
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -197,6 +225,7 @@ func (o *OpenChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) MaxPayloadLength(uint32) uint32 {
-	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1
-	return 319
+	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1 + (2 + 34) +
+	// maxChannelTypeRecordSize
+	return 355 + maxChannelTypeRecordSize
 }
@@ -4,6 +4,19 @@ import (
 	"fmt"
 )
 
+const (
+	// AliasScidStartHeight is the first block height used within alias
+	// short channel IDs. Aliases are assigned locally to zero-conf
+	// channels, and use block heights that won't be reached for decades
+	// so they can't collide with the short channel ID of a confirmed
+	// channel.
+	AliasScidStartHeight = 16000000
+
+	// AliasScidEndHeight is the block height up to which, exclusively,
+	// alias short channel IDs are assigned.
+	AliasScidEndHeight = 16250000
+)
+
 // ShortChannelID represents the set of data which is needed to retrieve all
 // necessary data to validate the channel existence.
 type ShortChannelID struct {
@@ -46,3 +59,10 @@ func (c ShortChannelID) ToUint64() uint64 {
 func (c ShortChannelID) String() string {
 	return fmt.Sprintf("%d:%d:%d", c.BlockHeight, c.TxIndex, c.TxPosition)
 }
+
+// IsAlias returns true if the short channel ID is a locally assigned alias of
+// a zero-conf channel, rather than the location of a confirmed funding output.
+func (c ShortChannelID) IsAlias() bool {
+	return c.BlockHeight >= AliasScidStartHeight &&
+		c.BlockHeight < AliasScidEndHeight
+}
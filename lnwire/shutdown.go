@@ -22,6 +22,27 @@ type Shutdown struct {
 // p2wpkh.
 type DeliveryAddress []byte
 
+// readOptionalDeliveryAddress reads a delivery address placed at the end of a
+// message, which peers unaware of the field omit. A missing or empty address
+// is returned as nil.
+func readOptionalDeliveryAddress(r io.Reader, addr *DeliveryAddress) error {
+	err := readElement(r, addr)
+	switch {
+	case err == io.EOF:
+		*addr = nil
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	if len(*addr) == 0 {
+		*addr = nil
+	}
+
+	return nil
+}
+
 // NewShutdown creates a new Shutdown message.
 func NewShutdown(cid ChannelID, addr DeliveryAddress) *Shutdown {
 	return &Shutdown{
@@ -0,0 +1,66 @@
+package lnwire
+
+import "io"
+
+// SpliceAbort is sent by either party of a quiescent channel to abort the
+// splice being negotiated, or to end the quiescence of the channel if no
+// splice has been proposed yet. Upon receiving it, the channel returns to its
+// normal state, in which both parties may propose updates again. A splice can
+// no longer be aborted once either party has handed out its signature for the
+// splice transaction.
+type SpliceAbort struct {
+	// ChanID identifies the channel whose splice is aborted.
+	ChanID ChannelID
+
+	// Data describes the reason the splice was aborted. A receiving node
+	// SHOULD only print out data verbatim if it's composed solely of
+	// printable ASCII characters.
+	Data ErrorData
+}
+
+// NewSpliceAbort creates a new SpliceAbort message for the target channel.
+func NewSpliceAbort(cid ChannelID, reason string) *SpliceAbort {
+	return &SpliceAbort{
+		ChanID: cid,
+		Data:   ErrorData(reason),
+	}
+}
+
+// A compile time check to ensure SpliceAbort implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceAbort)(nil)
+
+// Encode serializes the target SpliceAbort into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAbort) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, s.ChanID, s.Data)
+}
+
+// Decode deserializes the serialized SpliceAbort stored in the passed
+// io.Reader into the target SpliceAbort using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAbort) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &s.ChanID, &s.Data)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceAbort on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAbort) MsgType() MessageType {
+	return MsgSpliceAbort
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceAbort message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAbort) MaxPayloadLength(uint32) uint32 {
+	// 32 + 2 + 65501
+	return 65535
+}
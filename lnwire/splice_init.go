@@ -0,0 +1,75 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// SpliceInit is sent by the initiator of a splice once the channel is
+// quiescent, in order to propose replacing the funding output of the channel
+// with a new one. The new funding output is created by a splice transaction
+// spending the current funding output, which may add funds to the channel
+// through additional inputs, or remove funds from it through additional
+// outputs.
+type SpliceInit struct {
+	// ChanID identifies the channel to be spliced.
+	ChanID ChannelID
+
+	// RelativeAmount is the amount by which the sender's balance changes
+	// as a result of the splice. A positive amount splices funds into the
+	// channel, while a negative amount splices funds out of it. The
+	// capacity of the channel changes by the same amount.
+	RelativeAmount btcutil.Amount
+
+	// FeePerKiloWeight is the fee rate of the splice transaction, paid by
+	// the sender.
+	FeePerKiloWeight uint32
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceInit)(nil)
+
+// Encode serializes the target SpliceInit into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		s.ChanID,
+		s.RelativeAmount,
+		s.FeePerKiloWeight,
+	)
+}
+
+// Decode deserializes the serialized SpliceInit stored in the passed
+// io.Reader into the target SpliceInit using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		&s.ChanID,
+		&s.RelativeAmount,
+		&s.FeePerKiloWeight,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceInit on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MsgType() MessageType {
+	return MsgSpliceInit
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceInit message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MaxPayloadLength(uint32) uint32 {
+	// 32 + 8 + 4
+	return 44
+}
@@ -0,0 +1,60 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// SpliceLocked is sent by both parties of a splice once the splice
+// transaction has reached the number of confirmations required for the
+// channel. Once both parties have sent SpliceLocked, the channel continues
+// operating on top of the new funding output, and the commitment transactions
+// spending the prior funding output are discarded.
+type SpliceLocked struct {
+	// ChanID identifies the channel that was spliced, as derived from its
+	// prior funding output.
+	ChanID ChannelID
+
+	// SpliceTxid is the txid of the confirmed splice transaction.
+	SpliceTxid chainhash.Hash
+}
+
+// A compile time check to ensure SpliceLocked implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceLocked)(nil)
+
+// Encode serializes the target SpliceLocked into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, s.ChanID, s.SpliceTxid[:])
+}
+
+// Decode deserializes the serialized SpliceLocked stored in the passed
+// io.Reader into the target SpliceLocked using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &s.ChanID, s.SpliceTxid[:])
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceLocked on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) MsgType() MessageType {
+	return MsgSpliceLocked
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceLocked message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) MaxPayloadLength(uint32) uint32 {
+	// 32 + 32
+	return 64
+}
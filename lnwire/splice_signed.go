@@ -0,0 +1,57 @@
+package lnwire
+
+import "io"
+
+// SpliceSigned is sent by both parties of a splice once the splice
+// transaction has been constructed. It carries the sender's signature for the
+// receiver's commitment transaction spending the new funding output, at the
+// same commitment height as the receiver's current commitment transaction.
+// Neither party signs its input of the current funding output before having
+// received a valid signature for its new commitment transaction.
+type SpliceSigned struct {
+	// ChanID identifies the channel being spliced.
+	ChanID ChannelID
+
+	// CommitSig is the sender's signature for the receiver's commitment
+	// transaction spending the new funding output.
+	CommitSig Sig
+}
+
+// A compile time check to ensure SpliceSigned implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceSigned)(nil)
+
+// Encode serializes the target SpliceSigned into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, s.ChanID, s.CommitSig)
+}
+
+// Decode deserializes the serialized SpliceSigned stored in the passed
+// io.Reader into the target SpliceSigned using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, &s.ChanID, &s.CommitSig)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// SpliceSigned on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) MsgType() MessageType {
+	return MsgSpliceSigned
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// SpliceSigned message.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceSigned) MaxPayloadLength(uint32) uint32 {
+	// 32 + 64
+	return 96
+}
@@ -0,0 +1,77 @@
+package lnwire
+
+import "io"
+
+// Stfu is sent by either party of a channel to request that the channel be
+// brought into a quiescent state, in which neither party proposes any new
+// updates to the commitment transactions. A party only sends Stfu once all of
+// its own pending updates have been irrevocably committed, and stops adding
+// new updates afterwards. Once both parties have sent Stfu, the channel is
+// quiescent, allowing protocols such as splicing to modify the channel
+// without having to account for in-flight updates.
+type Stfu struct {
+	// ChanID identifies the channel to be brought into quiescence.
+	ChanID ChannelID
+
+	// Initiator is true if the sender requested the channel to become
+	// quiescent, and false if it's replying to the request of the remote
+	// party.
+	Initiator bool
+}
+
+// NewStfu creates a new Stfu message for the target channel.
+func NewStfu(cid ChannelID, initiator bool) *Stfu {
+	return &Stfu{
+		ChanID:    cid,
+		Initiator: initiator,
+	}
+}
+
+// A compile time check to ensure Stfu implements the lnwire.Message interface.
+var _ Message = (*Stfu)(nil)
+
+// Encode serializes the target Stfu into the passed io.Writer implementation.
+// Serialization will observe the rules defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) Encode(w io.Writer, pver uint32) error {
+	var initiator uint8
+	if s.Initiator {
+		initiator = 1
+	}
+
+	return writeElements(w, s.ChanID, initiator)
+}
+
+// Decode deserializes the serialized Stfu stored in the passed io.Reader into
+// the target Stfu using the deserialization rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) Decode(r io.Reader, pver uint32) error {
+	var initiator uint8
+	if err := readElements(r, &s.ChanID, &initiator); err != nil {
+		return err
+	}
+
+	s.Initiator = initiator != 0
+
+	return nil
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// Stfu on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) MsgType() MessageType {
+	return MsgStfu
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a Stfu
+// message.
+//
+// This is part of the lnwire.Message interface.
+func (s *Stfu) MaxPayloadLength(uint32) uint32 {
+	// 32 + 1
+	return 33
+}
@@ -0,0 +1,87 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// TxAddInput is sent by either party of a dual funded channel during the
+// interactive construction of the funding transaction, in order to add one of
+// its inputs to the transaction. As the funding transaction is sorted
+// according to BIP-69 once both parties have added all of their inputs and
+// outputs, the position of the input within the final transaction doesn't
+// need to be communicated.
+type TxAddInput struct {
+	// PendingChannelID identifies the pending channel whose funding
+	// transaction the input is added to.
+	PendingChannelID [32]byte
+
+	// PreviousOutPoint is the outpoint spent by the input.
+	PreviousOutPoint wire.OutPoint
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// Value is the value of the output spent by the input. The receiver
+	// MUST verify the value against the chain, as it determines whether
+	// the sender is able to cover its contribution to the funding
+	// transaction.
+	Value btcutil.Amount
+
+	// PkScript is the script of the output spent by the input, which
+	// allows the receiver to look up the output within the chain.
+	PkScript PkScript
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// Encode serializes the target TxAddInput into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		t.PendingChannelID[:],
+		t.PreviousOutPoint,
+		t.Sequence,
+		t.Value,
+		t.PkScript,
+	)
+}
+
+// Decode deserializes the serialized TxAddInput stored in the passed
+// io.Reader into the target TxAddInput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		t.PendingChannelID[:],
+		&t.PreviousOutPoint,
+		&t.Sequence,
+		&t.Value,
+		&t.PkScript,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxAddInput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxAddInput message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MaxPayloadLength(uint32) uint32 {
+	// 32 + 34 + 4 + 8 + 1 + 34
+	return 113
+}
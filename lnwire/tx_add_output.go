@@ -0,0 +1,71 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// TxAddOutput is sent by either party of a dual funded channel during the
+// interactive construction of the funding transaction, in order to add one of
+// its outputs, such as a change output, to the transaction. The funding output
+// itself is never added through this message, as both parties are able to
+// derive it from the multi-sig keys and the capacity of the channel.
+type TxAddOutput struct {
+	// PendingChannelID identifies the pending channel whose funding
+	// transaction the output is added to.
+	PendingChannelID [32]byte
+
+	// Value is the value of the output.
+	Value btcutil.Amount
+
+	// PkScript is the script the output pays to.
+	PkScript PkScript
+}
+
+// A compile time check to ensure TxAddOutput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddOutput)(nil)
+
+// Encode serializes the target TxAddOutput into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		t.PendingChannelID[:],
+		t.Value,
+		t.PkScript,
+	)
+}
+
+// Decode deserializes the serialized TxAddOutput stored in the passed
+// io.Reader into the target TxAddOutput using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		t.PendingChannelID[:],
+		&t.Value,
+		&t.PkScript,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxAddOutput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MsgType() MessageType {
+	return MsgTxAddOutput
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxAddOutput message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MaxPayloadLength(uint32) uint32 {
+	// 32 + 8 + 1 + 34
+	return 75
+}
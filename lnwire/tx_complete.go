@@ -0,0 +1,59 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcutil"
+)
+
+// TxComplete is sent by either party of a dual funded channel once it has
+// added all of its inputs and outputs to the funding transaction. It carries
+// the amount the sender commits to the channel, which together with the
+// amount committed by the other party makes up the capacity of the channel.
+type TxComplete struct {
+	// PendingChannelID identifies the pending channel whose funding
+	// transaction the sender has finished contributing to.
+	PendingChannelID [32]byte
+
+	// FundingAmount is the amount the sender commits to the channel.
+	FundingAmount btcutil.Amount
+}
+
+// A compile time check to ensure TxComplete implements the lnwire.Message
+// interface.
+var _ Message = (*TxComplete)(nil)
+
+// Encode serializes the target TxComplete into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w, t.PendingChannelID[:], t.FundingAmount)
+}
+
+// Decode deserializes the serialized TxComplete stored in the passed
+// io.Reader into the target TxComplete using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Decode(r io.Reader, pver uint32) error {
+	return readElements(r, t.PendingChannelID[:], &t.FundingAmount)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxComplete on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) MsgType() MessageType {
+	return MsgTxComplete
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxComplete message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) MaxPayloadLength(uint32) uint32 {
+	// 32 + 8
+	return 40
+}
@@ -0,0 +1,82 @@
+package lnwire
+
+import (
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// InputWitness houses the final sigScript and witness of a single input to a
+// transaction. The sigScript is only populated for nested p2sh inputs.
+type InputWitness struct {
+	// SigScript is the final sigScript of the input.
+	SigScript []byte
+
+	// Witness is the final witness of the input.
+	Witness wire.TxWitness
+}
+
+// TxSignatures is sent by either party of a dual funded channel once both
+// parties have exchanged valid signatures for each other's version of the
+// commitment transaction. It carries the final witnesses of all of the
+// sender's inputs to the funding transaction, allowing the receiver to
+// assemble the fully signed funding transaction.
+type TxSignatures struct {
+	// PendingChannelID identifies the pending channel whose funding
+	// transaction the witnesses belong to.
+	PendingChannelID [32]byte
+
+	// TxHash is the txid of the funding transaction.
+	TxHash chainhash.Hash
+
+	// Witnesses are the witnesses of all of the sender's inputs, in the
+	// order in which the inputs appear within the funding transaction.
+	Witnesses []InputWitness
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed io.Writer
+// implementation. Serialization will observe the rules defined by the passed
+// protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w io.Writer, pver uint32) error {
+	return writeElements(w,
+		t.PendingChannelID[:],
+		t.TxHash[:],
+		t.Witnesses,
+	)
+}
+
+// Decode deserializes the serialized TxSignatures stored in the passed
+// io.Reader into the target TxSignatures using the deserialization rules
+// defined by the passed protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, pver uint32) error {
+	return readElements(r,
+		t.PendingChannelID[:],
+		t.TxHash[:],
+		&t.Witnesses,
+	)
+}
+
+// MsgType returns the uint32 code which uniquely identifies this message as a
+// TxSignatures on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
+
+// MaxPayloadLength returns the maximum allowed payload length for a
+// TxSignatures message.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
@@ -142,6 +142,24 @@ func (m *mockSpendNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	return &chainntnfs.SpendEvent{
 		Spend: spendChan,
 		Cancel: func() {
+			m.mtx.Lock()
+			defer m.mtx.Unlock()
+
+			spendChans := m.spendMap[*outpoint]
+			for i, c := range spendChans {
+				if c != spendChan {
+					continue
+				}
+
+				spendChans = append(spendChans[:i], spendChans[i+1:]...)
+				break
+			}
+
+			if len(spendChans) == 0 {
+				delete(m.spendMap, *outpoint)
+				return
+			}
+			m.spendMap[*outpoint] = spendChans
 		},
 	}, nil
 }
@@ -168,14 +186,35 @@ func (m *mockSpendNotifier) Spend(outpoint *wire.OutPoint, height int32,
 	}
 }
 
-type mockChainIO struct{}
+// mockChainIO is a mock implementation of the BlockChainIO interface. The
+// unspent outputs of the wallets it knows of are reported as part of the
+// UTXO set.
+type mockChainIO struct {
+	wallets []*mockWalletController
+
+	// utxos holds outputs that are part of the UTXO set, besides those of
+	// the wallets.
+	utxos map[wire.OutPoint]*wire.TxOut
+}
 
 func (*mockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
 	return activeNetParams.GenesisHash, fundingBroadcastHeight, nil
 }
 
-func (*mockChainIO) GetUtxo(op *wire.OutPoint, _ []byte,
+func (m *mockChainIO) GetUtxo(op *wire.OutPoint, _ []byte,
 	heightHint uint32) (*wire.TxOut, error) {
+
+	if txOut, ok := m.utxos[*op]; ok {
+		return txOut, nil
+	}
+
+	for _, wallet := range m.wallets {
+		txOut, err := wallet.FetchInputInfo(op)
+		if err == nil {
+			return txOut, nil
+		}
+	}
+
 	return nil, nil
 }
 
@@ -201,13 +240,34 @@ func (*mockWalletController) BackEnd() string {
 	return "mock"
 }
 
+// utxoHash returns the hash of the transaction that created the unspent
+// outputs of the wallet. It is derived from the wallet's root key, such that
+// the outputs of different wallets never collide.
+func (m *mockWalletController) utxoHash() chainhash.Hash {
+	return chainhash.HashH(m.rootKey.PubKey().SerializeCompressed())
+}
+
+// utxoPkScript returns the P2WKH script paying to the wallet's root key, which
+// all of its unspent outputs are locked to.
+func (m *mockWalletController) utxoPkScript() []byte {
+	pkHash := btcutil.Hash160(m.rootKey.PubKey().SerializeCompressed())
+	pkScript, _ := txscript.NewScriptBuilder().AddOp(txscript.OP_0).
+		AddData(pkHash).Script()
+	return pkScript
+}
+
 // FetchInputInfo will be called to get info about the inputs to the funding
 // transaction.
-func (*mockWalletController) FetchInputInfo(
+func (m *mockWalletController) FetchInputInfo(
 	prevOut *wire.OutPoint) (*wire.TxOut, error) {
+
+	if prevOut.Hash != m.utxoHash() {
+		return nil, lnwallet.ErrNotMine
+	}
+
 	txOut := &wire.TxOut{
 		Value:    int64(10 * btcutil.SatoshiPerBitcoin),
-		PkScript: []byte("dummy"),
+		PkScript: m.utxoPkScript(),
 	}
 	return txOut, nil
 }
@@ -239,9 +299,9 @@ func (m *mockWalletController) ListUnspentWitness(minconfirms,
 	utxo := &lnwallet.Utxo{
 		AddressType: lnwallet.WitnessPubKey,
 		Value:       btcutil.Amount(10 * btcutil.SatoshiPerBitcoin),
-		PkScript:    make([]byte, 22),
+		PkScript:    m.utxoPkScript(),
 		OutPoint: wire.OutPoint{
-			Hash:  chainhash.Hash{},
+			Hash:  m.utxoHash(),
 			Index: m.index,
 		},
 	}
@@ -23,19 +23,17 @@ import (
 //
 //   utxn<chain-hash>/
 //   |
-//   |   LAST PURGED + FINALIZED HEIGHTS
+//   |   LAST PURGED HEIGHT
 //   |
 //   |   Each nursery store tracks a "last graduated height", which records the
 //   |   most recent block height for which the nursery store has successfully
-//   |   graduated all outputs. It also tracks a "last finalized height", which
-//   |   records the last block height that the nursery attempted to graduate
-//   |   If a finalized height has kindergarten outputs, the sweep txn for these
-//   |   outputs will be stored in the height bucket. This ensure that the same
-//   |   txid will be used after restarts. Otherwise, the nursery will be unable
-//   |   to recover the txid of kindergarten sweep transaction it has already
-//   |   broadcast.
+//   |   processed all outputs. Kindergarten outputs are swept by the
+//   |   sweep.UtxoSweeper, which keeps track of the sweep txns it publishes
+//   |   itself. Earlier versions of the nursery also tracked a "last finalized
+//   |   height", and stored the kindergarten sweep txn of each height in the
+//   |   height bucket. These txns are migrated to the sweeper store, and are
+//   |   otherwise ignored.
 //   |
-//   ├── last-finalized-height-key: <last-finalized-height>
 //   ├── last-graduated-height-key: <last-graduated-height>
 //   |
 //   |   CHANNEL INDEX
@@ -72,19 +70,15 @@ import (
 //   |   relative file path:
 //   |     e.g. <chan-point-3>/<prefix><outpoint-2>/
 //   |   that can be queried in the channel index to retrieve the serialized
-//   |   output. If a height bucket is less than or equal to the current last
-//   |   finalized height and has a non-zero number of kindergarten outputs, a
-//   |   height bucket will also contain the finalized kindergarten sweep txn
-//   |   under the "finalized-kndr-txn" key.
+//   |   output.
 //   |
 //   └── height-index-key/
 //       ├── <height-1>/                             <- HEIGHT BUCKET
 //       |   ├── <chan-point-3>/                     <- HEIGHT-CHANNEL BUCKET
 //       |   |    ├── <state-prefix><outpoint-4>: "" <- PREFIXED OUTPOINT
 //       |   |    └── <state-prefix><outpoint-5>: ""
-//       |   ├── <chan-point-2>/
-//       |   |    └── <state-prefix><outpoint-3>: ""
-//       |   └── finalized-kndr-txn:              "" | <kndr-sweep-tnx>
+//       |   └── <chan-point-2>/
+//       |        └── <state-prefix><outpoint-3>: ""
 //       └── <height-2>/
 //           └── <chan-point-1>/
 //                └── <state-prefix><outpoint-1>: ""
@@ -116,33 +110,20 @@ type NurseryStore interface {
 	// our commitment outputs fall into this class.
 	PreschoolToKinder(*kidOutput) error
 
-	// GraduateKinder atomically moves the kindergarten class at the
-	// provided height into the graduated status. This involves removing the
-	// kindergarten entries from both the height and channel indexes, and
-	// cleaning up the finalized kindergarten sweep txn. The height bucket
-	// will be opportunistically pruned from the height index as outputs are
-	// removed.
-	GraduateKinder(height uint32) error
+	// GraduateKinder atomically moves an output at the provided height
+	// into the graduated status. This involves removing the kindergarten
+	// entries from both the height and channel indexes. The height bucket
+	// will be opportunistically pruned from the height index as outputs
+	// are removed.
+	GraduateKinder(height uint32, output *kidOutput) error
 
 	// FetchPreschools returns a list of all outputs currently stored in
 	// the preschool bucket.
 	FetchPreschools() ([]kidOutput, error)
 
 	// FetchClass returns a list of kindergarten and crib outputs whose
-	// timelocks expire at the given height. If the kindergarten class at
-	// this height hash been finalized previously, via FinalizeKinder, it
-	// will also returns the finalized kindergarten sweep txn.
-	FetchClass(height uint32) (*wire.MsgTx, []kidOutput, []babyOutput, error)
-
-	// FinalizeKinder accepts a block height and the kindergarten sweep txn
-	// computed for this height. Upon startup, we will rebroadcast any
-	// finalized kindergarten txns instead of signing a new txn, as this
-	// result in a different txid from a preceding broadcast.
-	FinalizeKinder(height uint32, tx *wire.MsgTx) error
-
-	// LastFinalizedHeight returns the last block height for which the
-	// nursery store finalized a kindergarten class.
-	LastFinalizedHeight() (uint32, error)
+	// timelocks expire at the given height.
+	FetchClass(height uint32) ([]kidOutput, []babyOutput, error)
 
 	// GraduateHeight records the provided height as the last height for
 	// which the nursery store successfully graduated all outputs.
@@ -181,10 +162,6 @@ var (
 	// the root-level, chain-segmented bucket for each nursery store.
 	utxnChainPrefix = []byte("utxn")
 
-	// lastFinalizedHeightKey is a static key used to locate nursery store's
-	// last finalized height.
-	lastFinalizedHeightKey = []byte("last-finalized-height")
-
 	// lastGraduatedHeightKey is a static key used to retrieve the height of
 	// the last bucket that successfully graduated all outputs.
 	lastGraduatedHeightKey = []byte("last-graduated-height")
@@ -197,10 +174,6 @@ var (
 	// containing all heights for which the nursery will need to take
 	// action.
 	heightIndexKey = []byte("height-index")
-
-	// finalizedKndrTxnKey is a static key that can be used to locate a
-	// finalized kindergarten sweep txn.
-	finalizedKndrTxnKey = []byte("finalized-kndr-txn")
 )
 
 // Defines the state prefixes that will be used to persistently track an
@@ -515,99 +488,55 @@ func (ns *nurseryStore) PreschoolToKinder(kid *kidOutput) error {
 	})
 }
 
-// GraduateKinder atomically moves the kindergarten class at the provided height
-// into the graduated status. This involves removing the kindergarten entries
-// from both the height and channel indexes, and cleaning up the finalized
-// kindergarten sweep txn. The height bucket will be opportunistically pruned
-// from the height index as outputs are removed.
-func (ns *nurseryStore) GraduateKinder(height uint32) error {
+// GraduateKinder atomically moves an output at the provided height into the
+// graduated status. This involves removing the kindergarten entries from both
+// the height and channel indexes. The height bucket will be opportunistically
+// pruned from the height index as outputs are removed.
+func (ns *nurseryStore) GraduateKinder(height uint32, kid *kidOutput) error {
 	return ns.db.Update(func(tx *bolt.Tx) error {
+		// For the kindergarten output, delete its entry from the
+		// height and channel index, and create a new grad output in the
+		// channel index.
+		outpoint := kid.OutPoint()
+		chanPoint := kid.OriginChanPoint()
 
-		// Since all kindergarten outputs at a particular height are
-		// swept in a single txn, we can now safely delete the finalized
-		// txn, since it has already been broadcast and confirmed.
-		hghtBucket := ns.getHeightBucket(tx, height)
-		if hghtBucket == nil {
-			// Nothing to delete, bucket has already been removed.
-			return nil
+		// Construct the key under which the output is currently stored
+		// height and channel indexes.
+		pfxOutputKey, err := prefixOutputKey(kndrPrefix, outpoint)
+		if err != nil {
+			return err
 		}
 
-		// Remove the finalized kindergarten txn, we do this before
-		// removing the outputs so that the extra entry doesn't prevent
-		// the height bucket from being opportunistically pruned below.
-		if err := hghtBucket.Delete(finalizedKndrTxnKey); err != nil {
+		// Remove the kindergarten output's entry in the height index.
+		err = ns.removeOutputFromHeight(
+			tx, height, chanPoint, pfxOutputKey,
+		)
+		if err != nil {
 			return err
 		}
 
-		// For each kindergarten found output, delete its entry from the
-		// height and channel index, and create a new grad output in the
-		// channel index.
-		return ns.forEachHeightPrefix(tx, kndrPrefix, height,
-			func(v []byte) error {
-				var kid kidOutput
-				err := kid.Decode(bytes.NewReader(v))
-				if err != nil {
-					return err
-				}
-
-				outpoint := kid.OutPoint()
-				chanPoint := kid.OriginChanPoint()
-
-				// Construct the key under which the output is
-				// currently stored height and channel indexes.
-				pfxOutputKey, err := prefixOutputKey(kndrPrefix,
-					outpoint)
-				if err != nil {
-					return err
-				}
-
-				// Remove the grad output's entry in the height
-				// index.
-				err = ns.removeOutputFromHeight(tx, height,
-					chanPoint, pfxOutputKey)
-				if err != nil {
-					return err
-				}
-
-				chanBucket := ns.getChannelBucket(tx,
-					chanPoint)
-				if chanBucket == nil {
-					return ErrContractNotFound
-				}
-
-				// Remove previous output with kindergarten
-				// prefix.
-				err = chanBucket.Delete(pfxOutputKey)
-				if err != nil {
-					return err
-				}
-
-				// Convert kindergarten key to graduate key.
-				copy(pfxOutputKey, gradPrefix)
+		chanBucket := ns.getChannelBucket(tx, chanPoint)
+		if chanBucket == nil {
+			return ErrContractNotFound
+		}
 
-				var gradBuffer bytes.Buffer
-				if err := kid.Encode(&gradBuffer); err != nil {
-					return err
-				}
+		// Remove previous output with kindergarten prefix.
+		err = chanBucket.Delete(pfxOutputKey)
+		if err != nil {
+			return err
+		}
 
-				// Insert serialized output into channel bucket
-				// using graduate-prefixed key.
-				return chanBucket.Put(pfxOutputKey,
-					gradBuffer.Bytes())
-			},
-		)
-	})
-}
+		// Convert kindergarten key to graduate key.
+		copy(pfxOutputKey, gradPrefix)
 
-// FinalizeKinder accepts a block height and a finalized kindergarten sweep
-// transaction, persisting the transaction at the appropriate height bucket. The
-// nursery store's last finalized height is also updated with the provided
-// height.
-func (ns *nurseryStore) FinalizeKinder(height uint32,
-	finalTx *wire.MsgTx) error {
+		var gradBuffer bytes.Buffer
+		if err := kid.Encode(&gradBuffer); err != nil {
+			return err
+		}
 
-	return ns.db.Update(func(tx *bolt.Tx) error {
-		return ns.finalizeKinder(tx, height, finalTx)
+		// Insert serialized output into channel bucket using
+		// graduate-prefixed key.
+		return chanBucket.Put(pfxOutputKey, gradBuffer.Bytes())
 	})
 }
 
@@ -625,23 +554,15 @@ func (ns *nurseryStore) GraduateHeight(height uint32) error {
 // FetchClass returns a list of the kindergarten and crib outputs whose timeouts
 // are expiring
 func (ns *nurseryStore) FetchClass(
-	height uint32) (*wire.MsgTx, []kidOutput, []babyOutput, error) {
+	height uint32) ([]kidOutput, []babyOutput, error) {
 
 	// Construct list of all crib and kindergarten outputs that need to be
 	// processed at the provided block height.
-	var finalTx *wire.MsgTx
 	var kids []kidOutput
 	var babies []babyOutput
 	if err := ns.db.View(func(tx *bolt.Tx) error {
-
-		var err error
-		finalTx, err = ns.getFinalizedTxn(tx, height)
-		if err != nil {
-			return err
-		}
-
 		// Append each crib output to our list of babyOutputs.
-		if err = ns.forEachHeightPrefix(tx, cribPrefix, height,
+		if err := ns.forEachHeightPrefix(tx, cribPrefix, height,
 			func(buf []byte) error {
 
 				// We will attempt to deserialize all outputs
@@ -683,10 +604,10 @@ func (ns *nurseryStore) FetchClass(
 			})
 
 	}); err != nil {
-		return nil, nil, nil, err
+		return nil, nil, err
 	}
 
-	return finalTx, kids, babies, nil
+	return kids, babies, nil
 }
 
 // FetchPreschools returns a list of all outputs currently stored in the
@@ -938,19 +859,6 @@ func (ns *nurseryStore) RemoveChannel(chanPoint *wire.OutPoint) error {
 	})
 }
 
-// LastFinalizedHeight returns the last block height for which the nursery
-// store has finalized a kindergarten class.
-func (ns *nurseryStore) LastFinalizedHeight() (uint32, error) {
-	var lastFinalizedHeight uint32
-	err := ns.db.View(func(tx *bolt.Tx) error {
-		var err error
-		lastFinalizedHeight, err = ns.getLastFinalizedHeight(tx)
-		return err
-	})
-
-	return lastFinalizedHeight, err
-}
-
 // LastGraduatedHeight returns the last block height for which the nursery
 // store has successfully graduated all outputs.
 func (ns *nurseryStore) LastGraduatedHeight() (uint32, error) {
@@ -1332,107 +1240,6 @@ func (ns *nurseryStore) forChanOutputs(tx *bolt.Tx, chanPoint *wire.OutPoint,
 	return chanBucket.ForEach(callback)
 }
 
-// getLastFinalizedHeight is a helper method that retrieves the last height for
-// which the database finalized its persistent state.
-func (ns *nurseryStore) getLastFinalizedHeight(tx *bolt.Tx) (uint32, error) {
-	// Retrieve the chain bucket associated with the given nursery store.
-	chainBucket := tx.Bucket(ns.pfxChainKey)
-	if chainBucket == nil {
-		return 0, nil
-	}
-
-	// Lookup the last finalized height in the top-level chain bucket.
-	heightBytes := chainBucket.Get(lastFinalizedHeightKey)
-	if heightBytes == nil {
-		// We have never finalized, return height 0.
-		return 0, nil
-	}
-
-	// If the resulting bytes are not sized like a uint32, then we have
-	// never finalized, so we return 0.
-
-	// Otherwise, parse the bytes and return the last finalized height.
-	return byteOrder.Uint32(heightBytes), nil
-}
-
-// finalizeKinder records a finalized kindergarten sweep txn to the given height
-// bucket. It also updates the nursery store's last finalized height, so that we
-// do not finalize the same height twice. If the finalized txn is nil, i.e. if
-// the height has no kindergarten outputs, the height will be marked as
-// finalized, and we skip the process of writing the txn. When the class is
-// loaded, a nil value will be returned if no txn has been written to a
-// finalized height bucket.
-func (ns *nurseryStore) finalizeKinder(tx *bolt.Tx, height uint32,
-	finalTx *wire.MsgTx) error {
-
-	// TODO(conner) ensure height is greater that current finalized height.
-
-	// 1. Write the last finalized height to the chain bucket.
-
-	// Ensure that the chain bucket for this nursery store exists.
-	chainBucket, err := tx.CreateBucketIfNotExists(ns.pfxChainKey)
-	if err != nil {
-		return err
-	}
-
-	// Serialize the provided last-finalized height, and store it in the
-	// top-level chain bucket for this nursery store.
-	var lastHeightBytes [4]byte
-	byteOrder.PutUint32(lastHeightBytes[:], height)
-
-	err = chainBucket.Put(lastFinalizedHeightKey, lastHeightBytes[:])
-	if err != nil {
-		return err
-	}
-
-	// 2. Write the finalized txn in the appropriate height bucket.
-
-	// If there is no finalized txn, we have nothing to do.
-	if finalTx == nil {
-		return nil
-	}
-
-	// Otherwise serialize the finalized txn and write it to the height
-	// bucket.
-	hghtBucket := ns.getHeightBucket(tx, height)
-	if hghtBucket == nil {
-		return nil
-	}
-
-	var finalTxnBuf bytes.Buffer
-	if err := finalTx.Serialize(&finalTxnBuf); err != nil {
-		return err
-	}
-
-	return hghtBucket.Put(finalizedKndrTxnKey, finalTxnBuf.Bytes())
-}
-
-// getFinalizedTxn retrieves the finalized kindergarten sweep txn at the given
-// height, returning nil if one was not found.
-func (ns *nurseryStore) getFinalizedTxn(tx *bolt.Tx,
-	height uint32) (*wire.MsgTx, error) {
-
-	hghtBucket := ns.getHeightBucket(tx, height)
-	if hghtBucket == nil {
-		// No class to finalize.
-		return nil, nil
-	}
-
-	finalTxBytes := hghtBucket.Get(finalizedKndrTxnKey)
-	if finalTxBytes == nil {
-		// No finalized txn for this height.
-		return nil, nil
-	}
-
-	// Otherwise, deserialize and return the finalized transaction.
-	txn := &wire.MsgTx{}
-	if err := txn.Deserialize(bytes.NewReader(finalTxBytes)); err != nil {
-		return nil, err
-	}
-
-	return txn, nil
-}
-
 // getLastGraduatedHeight is a helper method that retrieves the last height for
 // which the database graduated all outputs successfully.
 func (ns *nurseryStore) getLastGraduatedHeight(tx *bolt.Tx) (uint32, error) {
@@ -1541,7 +1348,8 @@ func (ns *nurseryStore) pruneHeight(tx *bolt.Tx, height uint32) (bool, error) {
 	// attempt to remove each one if they are empty, keeping track of the
 	// number of height-channel buckets that still have active outputs.
 	if err := hghtBucket.ForEach(func(chanBytes, v []byte) error {
-		// Skip the finalized txn key.
+		// Skip any values, such as the kindergarten sweep txns
+		// finalized by earlier versions of the nursery.
 		if v != nil {
 			return nil
 		}
@@ -88,7 +88,6 @@ func TestNurseryStoreInit(t *testing.T) {
 
 	assertNumChannels(t, ns, 0)
 	assertNumPreschools(t, ns, 0)
-	assertLastFinalizedHeight(t, ns, 0)
 	assertLastGraduatedHeight(t, ns, 0)
 }
 
@@ -212,7 +211,7 @@ func TestNurseryStoreIncubate(t *testing.T) {
 			maturityHeight := test.commOutput.ConfHeight() +
 				test.commOutput.BlocksToMaturity()
 
-			err = ns.GraduateKinder(maturityHeight)
+			err = ns.GraduateKinder(maturityHeight, test.commOutput)
 			if err != nil {
 				t.Fatalf("unable to graduate kindergarten class at "+
 					"height %d: %v", maturityHeight, err)
@@ -286,7 +285,9 @@ func TestNurseryStoreIncubate(t *testing.T) {
 				maturityHeight := htlcOutput.ConfHeight() +
 					htlcOutput.BlocksToMaturity()
 
-				err = ns.GraduateKinder(maturityHeight)
+				err = ns.GraduateKinder(
+					maturityHeight, &htlcOutput.kidOutput,
+				)
 				if err != nil {
 					t.Fatalf("unable to graduate htlc output "+
 						"from kndr to grad: %v", err)
@@ -333,93 +334,6 @@ func TestNurseryStoreIncubate(t *testing.T) {
 	}
 }
 
-// TestNurseryStoreFinalize tests that kindergarten sweep transactions are
-// properly persisted, and that the last finalized height is being set
-// accordingly.
-func TestNurseryStoreFinalize(t *testing.T) {
-	cdb, cleanUp, err := makeTestDB()
-	if err != nil {
-		t.Fatalf("unable to open channel db: %v", err)
-	}
-	defer cleanUp()
-
-	ns, err := newNurseryStore(&bitcoinTestnetGenesis, cdb)
-	if err != nil {
-		t.Fatalf("unable to open nursery store: %v", err)
-	}
-
-	kid := &kidOutputs[3]
-
-	// Compute the maturity height at which to enter the commitment output.
-	maturityHeight := kid.ConfHeight() + kid.BlocksToMaturity()
-
-	// Since we haven't finalized before, we should see a last finalized
-	// height of 0.
-	assertLastFinalizedHeight(t, ns, 0)
-
-	// Begin incubating the commitment output, which will be placed in the
-	// preschool bucket.
-	err = ns.Incubate([]kidOutput{*kid}, nil)
-	if err != nil {
-		t.Fatalf("unable to incubate commitment output: %v", err)
-	}
-
-	// Then move the commitment output to the kindergarten bucket, so that
-	// the output is registered in the height index.
-	err = ns.PreschoolToKinder(kid)
-	if err != nil {
-		t.Fatalf("unable to move pscl output to kndr: %v", err)
-	}
-
-	// We should still see a last finalized height of 0, since no classes
-	// have been graduated.
-	assertLastFinalizedHeight(t, ns, 0)
-
-	// Now, iteratively finalize all heights below the maturity height,
-	// ensuring that the last finalized height is properly persisted, and
-	// that the finalized transactions are all nil.
-	for i := 0; i < int(maturityHeight); i++ {
-		err = ns.FinalizeKinder(uint32(i), nil)
-		if err != nil {
-			t.Fatalf("unable to finalize kndr at height=%d: %v",
-				i, err)
-		}
-		assertLastFinalizedHeight(t, ns, uint32(i))
-		assertFinalizedTxn(t, ns, uint32(i), nil)
-	}
-
-	// As we have now finalized all heights below the maturity height, we
-	// should still see the commitment output in the kindergarten bucket at
-	// its maturity height.
-	assertKndrAtMaturityHeight(t, ns, kid)
-
-	// Now, finalize the kindergarten sweep transaction at the maturity
-	// height.
-	err = ns.FinalizeKinder(maturityHeight, timeoutTx)
-	if err != nil {
-		t.Fatalf("unable to finalize kndr at height=%d: %v",
-			maturityHeight, err)
-	}
-
-	// The nursery store should now see the maturity height finalized, and
-	// the finalized kindergarten sweep txn should be returned at this
-	// height.
-	assertLastFinalizedHeight(t, ns, maturityHeight)
-	assertFinalizedTxn(t, ns, maturityHeight, timeoutTx)
-
-	// Lastly, continue to finalize heights above the maturity height. Each
-	// should report having a nil finalized kindergarten sweep txn.
-	for i := maturityHeight + 1; i < maturityHeight+10; i++ {
-		err = ns.FinalizeKinder(uint32(i), nil)
-		if err != nil {
-			t.Fatalf("unable to finalize kndr at height=%d: %v",
-				i, err)
-		}
-		assertLastFinalizedHeight(t, ns, uint32(i))
-		assertFinalizedTxn(t, ns, uint32(i), nil)
-	}
-}
-
 // TestNurseryStoreGraduate verifies that the nursery store properly removes
 // populated entries from the height index as it is purged, and that the last
 // purged height is set appropriately.
@@ -475,18 +389,6 @@ func TestNurseryStoreGraduate(t *testing.T) {
 	// height.
 	assertKndrAtMaturityHeight(t, ns, kid)
 
-	// Finalize the kindergarten transaction, ensuring that it is a non-nil
-	// value.
-	err = ns.FinalizeKinder(maturityHeight, timeoutTx)
-	if err != nil {
-		t.Fatalf("unable to finalize kndr at height=%d: %v",
-			maturityHeight, err)
-	}
-
-	// Verify that the maturity height has now been finalized.
-	assertLastFinalizedHeight(t, ns, maturityHeight)
-	assertFinalizedTxn(t, ns, maturityHeight, timeoutTx)
-
 	// Finally, purge the non-empty maturity height, and check that returned
 	// class is empty.
 	err = ns.GraduateHeight(maturityHeight)
@@ -495,7 +397,7 @@ func TestNurseryStoreGraduate(t *testing.T) {
 			err)
 	}
 
-	err = ns.GraduateKinder(maturityHeight)
+	err = ns.GraduateKinder(maturityHeight, kid)
 	if err != nil {
 		t.Fatalf("unable to graduate kindergarten outputs at height=%d: "+
 			"%v", maturityHeight, err)
@@ -528,22 +430,6 @@ func assertNumChanOutputs(t *testing.T, ns NurseryStore,
 	}
 }
 
-// assertLastFinalizedHeight checks that the nursery stores last finalized
-// height matches the expected height.
-func assertLastFinalizedHeight(t *testing.T, ns NurseryStore,
-	expected uint32) {
-
-	lfh, err := ns.LastFinalizedHeight()
-	if err != nil {
-		t.Fatalf("unable to get last finalized height: %v", err)
-	}
-
-	if lfh != expected {
-		t.Fatalf("expected last finalized height to be %d, got %d",
-			expected, lfh)
-	}
-}
-
 // assertLastGraduatedHeight checks that the nursery stores last purged height
 // matches the expected height.
 func assertLastGraduatedHeight(t *testing.T, ns NurseryStore, expected uint32) {
@@ -587,21 +473,17 @@ func assertNumChannels(t *testing.T, ns NurseryStore, expected int) {
 	}
 }
 
-// assertHeightIsPurged checks that the finalized transaction, kindergarten, and
-// htlc outputs at a particular height are all nil.
+// assertHeightIsPurged checks that the kindergarten and htlc outputs at a
+// particular height are all nil.
 func assertHeightIsPurged(t *testing.T, ns NurseryStore,
 	height uint32) {
 
-	finalTx, kndrOutputs, cribOutputs, err := ns.FetchClass(height)
+	kndrOutputs, cribOutputs, err := ns.FetchClass(height)
 	if err != nil {
 		t.Fatalf("unable to retrieve class at height=%d: %v",
 			height, err)
 	}
 
-	if finalTx != nil {
-		t.Fatalf("height=%d not purged, final txn should be nil", height)
-	}
-
 	if kndrOutputs != nil {
 		t.Fatalf("height=%d not purged, kndr outputs should be nil", height)
 	}
@@ -617,7 +499,7 @@ func assertCribAtExpiryHeight(t *testing.T, ns NurseryStore,
 	htlcOutput *babyOutput) {
 
 	expiryHeight := htlcOutput.expiry
-	_, _, cribOutputs, err := ns.FetchClass(expiryHeight)
+	_, cribOutputs, err := ns.FetchClass(expiryHeight)
 	if err != nil {
 		t.Fatalf("unable to retrieve class at height=%d: %v",
 			expiryHeight, err)
@@ -639,7 +521,7 @@ func assertCribNotAtExpiryHeight(t *testing.T, ns NurseryStore,
 	htlcOutput *babyOutput) {
 
 	expiryHeight := htlcOutput.expiry
-	_, _, cribOutputs, err := ns.FetchClass(expiryHeight)
+	_, cribOutputs, err := ns.FetchClass(expiryHeight)
 	if err != nil {
 		t.Fatalf("unable to retrieve class at height %d: %v",
 			expiryHeight, err)
@@ -653,25 +535,6 @@ func assertCribNotAtExpiryHeight(t *testing.T, ns NurseryStore,
 	}
 }
 
-// assertFinalizedTxn loads the class at the given height and compares the
-// returned finalized txn to that in the class. It is safe to presented a nil
-// expected transaction.
-func assertFinalizedTxn(t *testing.T, ns NurseryStore, height uint32,
-	exFinalTx *wire.MsgTx) {
-
-	finalTx, _, _, err := ns.FetchClass(height)
-	if err != nil {
-		t.Fatalf("unable to fetch class at height=%d: %v", height,
-			err)
-	}
-
-	if !reflect.DeepEqual(finalTx, exFinalTx) {
-		t.Fatalf("expected finalized txn at height=%d "+
-			"to be %v, got %v", height, finalTx.TxHash(),
-			exFinalTx.TxHash())
-	}
-}
-
 // assertKndrAtMaturityHeight loads the class at the provided height and
 // verifies that the provided kid output is one of the kindergarten outputs
 // returned.
@@ -680,7 +543,7 @@ func assertKndrAtMaturityHeight(t *testing.T, ns NurseryStore,
 
 	maturityHeight := kndrOutput.ConfHeight() +
 		kndrOutput.BlocksToMaturity()
-	_, kndrOutputs, _, err := ns.FetchClass(maturityHeight)
+	kndrOutputs, _, err := ns.FetchClass(maturityHeight)
 	if err != nil {
 		t.Fatalf("unable to retrieve class at height %d: %v",
 			maturityHeight, err)
@@ -705,7 +568,7 @@ func assertKndrNotAtMaturityHeight(t *testing.T, ns NurseryStore,
 	maturityHeight := kndrOutput.ConfHeight() +
 		kndrOutput.BlocksToMaturity()
 
-	_, kndrOutputs, _, err := ns.FetchClass(maturityHeight)
+	kndrOutputs, _, err := ns.FetchClass(maturityHeight)
 	if err != nil {
 		t.Fatalf("unable to retrieve class at height %d: %v",
 			maturityHeight, err)
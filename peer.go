@@ -13,8 +13,8 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/connmgr"
-	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/lightningnetwork/lnd/brontide"
@@ -76,6 +76,22 @@ type closeMsg struct {
 	msg lnwire.Message
 }
 
+// spliceMsg is a wrapper struct around any wire messages that deal with the
+// splice of an active channel. This struct includes the raw channel ID
+// targeted along with the original message.
+type spliceMsg struct {
+	cid lnwire.ChannelID
+	msg lnwire.Message
+}
+
+// spliceConf is sent once the splice transaction of a channel has
+// sufficiently confirmed, along with the short channel ID of the channel on
+// top of the new funding output.
+type spliceConf struct {
+	cid         lnwire.ChannelID
+	shortChanID lnwire.ShortChannelID
+}
+
 // chanSnapshotReq is a message sent by outside subsystems to a peer in order
 // to gain a snapshot of the peer's currently active channels.
 type chanSnapshotReq struct {
@@ -158,6 +174,31 @@ type peer struct {
 	// well as lnwire.ClosingSigned messages.
 	chanCloseMsgs chan *closeMsg
 
+	// activeSplices is a map that keeps track of all the active channel
+	// splices. Any splice related messages are directed to one of these
+	// state machines. Once the splice has been locked in, the state
+	// machine will be deleted from the map.
+	activeSplices map[lnwire.ChannelID]*channelSplicer
+
+	// localSpliceReqs is a channel in which any local requests to splice
+	// a particular channel are sent over, once its link is quiescent.
+	localSpliceReqs chan *spliceReq
+
+	// chanSpliceMsgs is a channel that any message related to channel
+	// splices are sent over. This includes the interactive construction
+	// of the splice transaction, as well as the lnwire.SpliceLocked
+	// message.
+	chanSpliceMsgs chan *spliceMsg
+
+	// spliceConfs is a channel over which the confirmation of a splice
+	// transaction is sent, once it has sufficiently confirmed.
+	spliceConfs chan *spliceConf
+
+	// spliceTimeouts is a channel over which a splicer is sent once its
+	// negotiation timeout has expired. If the splice hasn't been
+	// committed to by then, it's aborted.
+	spliceTimeouts chan *channelSplicer
+
 	server *server
 
 	// localFeatures is the set of local features that we advertised to the
@@ -223,6 +264,11 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 		localCloseChanReqs: make(chan *htlcswitch.ChanClose),
 		linkFailures:       make(chan linkFailureReport),
 		chanCloseMsgs:      make(chan *closeMsg),
+		activeSplices:      make(map[lnwire.ChannelID]*channelSplicer),
+		localSpliceReqs:    make(chan *spliceReq),
+		chanSpliceMsgs:     make(chan *spliceMsg),
+		spliceConfs:        make(chan *spliceConf),
+		spliceTimeouts:     make(chan *channelSplicer),
 		failedChannels:     make(map[lnwire.ChannelID]struct{}),
 
 		queueQuit: make(chan struct{}),
@@ -373,6 +419,14 @@ func (p *peer) QuitSignal() <-chan struct{} {
 	return p.quit
 }
 
+// RemoteLocalFeatures returns the set of local features that has been
+// advertised by the remote peer.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return p.remoteLocalFeatures
+}
+
 // loadActiveChannels creates indexes within the peer for tracking all active
 // channels returned by the database.
 func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
@@ -489,6 +543,17 @@ func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
 		p.activeChannels[chanID] = lnChan
 		p.activeChanMtx.Unlock()
 
+		// If the channel has a pending splice, we'll resume waiting
+		// for the splice transaction to confirm.
+		pendingSplice, err := dbChan.PendingSplice()
+		switch {
+		case err == channeldb.ErrNoPendingSplice:
+		case err != nil:
+			return err
+		default:
+			p.resumeChanSplice(lnChan, pendingSplice)
+		}
+
 		// To ensure we can route through this channel now that the peer
 		// is back online, we'll attempt to send an update to enable it.
 		// This will only be used for non-pending public channels, as
@@ -545,6 +610,17 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		}
 	}
 
+	// If the channel has a pending splice, then its link remains
+	// quiescent until the splice has been locked in.
+	var startQuiescent bool
+	_, err := lnChan.State().PendingSplice()
+	switch {
+	case err == nil:
+		startQuiescent = true
+	case err != channeldb.ErrNoPendingSplice:
+		return err
+	}
+
 	linkCfg := htlcswitch.ChannelLinkConfig{
 		Peer:                   p,
 		DecodeHopIterators:     p.server.sphinx.DecodeHopIterators,
@@ -573,6 +649,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		UnsafeReplay:        cfg.UnsafeReplay,
 		MinFeeUpdateTimeout: htlcswitch.DefaultMinLinkFeeUpdateTimeout,
 		MaxFeeUpdateTimeout: htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
+		StartQuiescent:      startQuiescent,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -968,6 +1045,16 @@ out:
 			}
 		}
 
+		// Splicing is experimental, so we'll ignore any messages of the
+		// quiescence handshake or the splice of an active channel
+		// unless both parties have signaled support for it.
+		if p.isSpliceMsg(nextMsg) && !p.spliceEnabled() {
+			peerLog.Warnf("Received %v from peer %v without splicing "+
+				"enabled, ignoring", nextMsg.MsgType(), p)
+			idleTimer.Reset(idleTimeout)
+			continue
+		}
+
 		var (
 			isChanUpdate bool
 			targetChan   lnwire.ChannelID
@@ -998,6 +1085,89 @@ out:
 		case *lnwire.FundingLocked:
 			p.server.fundingMgr.processFundingLocked(msg, p)
 
+		// The interactive transaction construction messages are used
+		// both by the funding of dual funded channels, and the splice
+		// of active channels. We'll direct the message to the
+		// splicer if it refers to an active channel.
+		case *lnwire.TxAddInput:
+			if !p.isActiveChannel(msg.PendingChannelID) {
+				p.server.fundingMgr.processTxAddInput(msg, p)
+				break
+			}
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.PendingChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.TxAddOutput:
+			if !p.isActiveChannel(msg.PendingChannelID) {
+				p.server.fundingMgr.processTxAddOutput(msg, p)
+				break
+			}
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.PendingChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.TxComplete:
+			if !p.isActiveChannel(msg.PendingChannelID) {
+				p.server.fundingMgr.processTxComplete(msg, p)
+				break
+			}
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.PendingChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.TxSignatures:
+			if !p.isActiveChannel(msg.PendingChannelID) {
+				p.server.fundingMgr.processTxSignatures(msg, p)
+				break
+			}
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.PendingChannelID, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		case *lnwire.SpliceInit:
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceAck:
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceSigned:
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+		case *lnwire.SpliceLocked:
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+
+		// An aborted splice returns the channel to its normal state,
+		// so the message is directed to both the splicer and the link
+		// of the channel.
+		case *lnwire.SpliceAbort:
+			select {
+			case p.chanSpliceMsgs <- &spliceMsg{msg.ChanID, msg}:
+			case <-p.quit:
+				break out
+			}
+
+			isChanUpdate = true
+			targetChan = msg.ChanID
+
 		case *lnwire.Shutdown:
 			select {
 			case p.chanCloseMsgs <- &closeMsg{msg.ChannelID, msg}:
@@ -1070,6 +1240,9 @@ out:
 		case *lnwire.ChannelReestablish:
 			isChanUpdate = true
 			targetChan = msg.ChanID
+		case *lnwire.Stfu:
+			isChanUpdate = true
+			targetChan = msg.ChanID
 
 		case *lnwire.ChannelUpdate,
 			*lnwire.ChannelAnnouncement,
@@ -1147,6 +1320,22 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("chan_id=%v, next_point=%x",
 			msg.ChanID, msg.NextPerCommitmentPoint.SerializeCompressed())
 
+	case *lnwire.TxAddInput:
+		return fmt.Sprintf("temp_chan_id=%x, outpoint=%v",
+			msg.PendingChannelID[:], msg.PreviousOutPoint)
+
+	case *lnwire.TxAddOutput:
+		return fmt.Sprintf("temp_chan_id=%x, value=%v",
+			msg.PendingChannelID[:], msg.Value)
+
+	case *lnwire.TxComplete:
+		return fmt.Sprintf("temp_chan_id=%x, funding_amt=%v",
+			msg.PendingChannelID[:], msg.FundingAmount)
+
+	case *lnwire.TxSignatures:
+		return fmt.Sprintf("temp_chan_id=%x, txid=%v, num_witnesses=%v",
+			msg.PendingChannelID[:], msg.TxHash, len(msg.Witnesses))
+
 	case *lnwire.Shutdown:
 		return fmt.Sprintf("chan_id=%v, script=%x", msg.ChannelID,
 			msg.Address[:])
@@ -1496,18 +1685,33 @@ func (p *peer) ChannelSnapshots() []*channeldb.ChannelSnapshot {
 }
 
 // genDeliveryScript returns a new script to be used to send our funds to in
-// the case of a cooperative channel close negotiation.
+// the case of a cooperative channel close negotiation. Like the funds we
+// sweep from closed channels, these are sent to the configured sweep
+// destination.
 func (p *peer) genDeliveryScript() ([]byte, error) {
-	deliveryAddr, err := p.server.cc.wallet.NewAddress(
-		lnwallet.WitnessPubKey, false,
-	)
+	deliveryScript, err := p.server.sweepDest.GenSweepScript()
 	if err != nil {
 		return nil, err
 	}
-	peerLog.Infof("Delivery addr for channel close: %v",
-		deliveryAddr)
+	peerLog.Infof("Delivery script for channel close: %x",
+		deliveryScript)
 
-	return txscript.PayToAddrScript(deliveryAddr)
+	return deliveryScript, nil
+}
+
+// chooseDeliveryScript returns the script our funds are sent to upon a
+// cooperative close of the channel. If we committed to an upfront shutdown
+// script when opening the channel we must use it, otherwise a fresh delivery
+// script is generated.
+func (p *peer) chooseDeliveryScript(
+	channel *lnwallet.LightningChannel) ([]byte, error) {
+
+	upfrontScript := channel.State().LocalShutdownScript
+	if len(upfrontScript) != 0 {
+		return upfrontScript, nil
+	}
+
+	return p.genDeliveryScript()
 }
 
 // channelManager is goroutine dedicated to handling all requests/signals
@@ -1561,82 +1765,12 @@ out:
 				continue
 			}
 
-			// If not already active, we'll add this channel to the
-			// set of active channels, so we can look it up later
-			// easily according to its channel ID.
-			lnChan, err := lnwallet.NewLightningChannel(
-				p.server.cc.signer, p.server.witnessBeacon,
-				newChan,
-			)
-			if err != nil {
-				p.activeChanMtx.Unlock()
-				err := fmt.Errorf("unable to create "+
-					"LightningChannel: %v", err)
-				peerLog.Errorf(err.Error())
-
-				newChanReq.err <- err
-				continue
-			}
-
-			p.activeChannels[chanID] = lnChan
 			p.activeChanMtx.Unlock()
 
-			peerLog.Infof("New channel active ChannelPoint(%v) "+
-				"with NodeKey(%x)", chanPoint, p.PubKey())
-
-			// Next, we'll assemble a ChannelLink along with the
-			// necessary items it needs to function.
-			//
-			// TODO(roasbeef): panic on below?
-			_, currentHeight, err := p.server.cc.chainIO.GetBestBlock()
-			if err != nil {
-				err := fmt.Errorf("unable to get best "+
-					"block: %v", err)
-				peerLog.Errorf(err.Error())
-
-				lnChan.Stop()
-				newChanReq.err <- err
-				continue
-			}
-			chainEvents, err := p.server.chainArb.SubscribeChannelEvents(
-				*chanPoint,
-			)
-			if err != nil {
-				err := fmt.Errorf("unable to subscribe to "+
-					"chain events: %v", err)
-				peerLog.Errorf(err.Error())
-
-				lnChan.Stop()
-				newChanReq.err <- err
-				continue
-			}
-
-			// We'll query the localChanCfg of the new channel to
-			// determine the minimum HTLC value that can be
-			// forwarded. For fees we'll use the default values, as
-			// they currently are always set to the default values
-			// at initial channel creation.
-			fwdMinHtlc := lnChan.FwdMinHtlc()
-			defaultPolicy := p.server.cc.routingPolicy
-			forwardingPolicy := &htlcswitch.ForwardingPolicy{
-				MinHTLC:       fwdMinHtlc,
-				BaseFee:       defaultPolicy.BaseFee,
-				FeeRate:       defaultPolicy.FeeRate,
-				TimeLockDelta: defaultPolicy.TimeLockDelta,
-			}
-
-			// Create the link and add it to the switch.
-			err = p.addLink(
-				chanPoint, lnChan, forwardingPolicy,
-				chainEvents, currentHeight, false,
-			)
-			if err != nil {
-				err := fmt.Errorf("can't register new channel "+
-					"link(%v) with NodeKey(%x)", chanPoint,
-					p.PubKey())
-				peerLog.Errorf(err.Error())
-
-				lnChan.Stop()
+			// If not already active, we'll add this channel to the
+			// set of active channels, and register its link with
+			// the switch.
+			if err := p.addActiveChannel(newChan); err != nil {
 				newChanReq.err <- err
 				continue
 			}
@@ -1689,6 +1823,16 @@ out:
 				closeMsg.msg,
 			)
 			if err != nil {
+				// If the remote party violated its upfront
+				// shutdown script, we'll let it know why we're
+				// refusing to close the channel.
+				if err == ErrUpfrontShutdownScriptMismatch {
+					p.queueMsg(&lnwire.Error{
+						ChanID: closeMsg.cid,
+						Data:   lnwire.ErrorData(err.Error()),
+					}, nil)
+				}
+
 				err := fmt.Errorf("unable to process close "+
 					"msg: %v", err)
 				peerLog.Error(err)
@@ -1723,6 +1867,48 @@ out:
 			// relevant sub-systems and launching a goroutine to
 			// wait for close tx conf.
 			p.finalizeChanClosure(chanCloser)
+
+		// We've received a local request to splice an active channel,
+		// whose link has been brought into a quiescent state. We'll
+		// kick off the splice by proposing it to the remote party.
+		case req := <-p.localSpliceReqs:
+			p.handleLocalSpliceReq(req)
+
+		// We've received a new splice related message from the remote
+		// peer, we'll use this message to advance the splicer state
+		// machine.
+		case spliceMsg := <-p.chanSpliceMsgs:
+			p.handleSpliceMsg(spliceMsg)
+
+		// The negotiation of a splice has timed out. Unless the splice
+		// has been committed to in the meantime, we'll abort it,
+		// returning the channel to its normal state.
+		case splicer := <-p.spliceTimeouts:
+			if p.activeSplices[splicer.cid] != splicer ||
+				splicer.PendingSplice() != nil {
+
+				continue
+			}
+
+			p.failChanSplice(splicer, ErrSpliceTimeout, true)
+
+		// The splice transaction of a channel has sufficiently
+		// confirmed, so we'll signal the remote party that we're
+		// ready to continue the channel on top of its new funding
+		// output.
+		case conf := <-p.spliceConfs:
+			splicer, ok := p.activeSplices[conf.cid]
+			if !ok {
+				continue
+			}
+
+			spliceLocked := splicer.SpliceConfirmed(conf.shortChanID)
+			p.queueMsg(spliceLocked, nil)
+
+			if splicer.IsLocked() {
+				p.finalizeChanSplice(splicer)
+			}
+
 		case <-p.quit:
 
 			// As, we've been signalled to exit, we'll reset all
@@ -1738,6 +1924,83 @@ out:
 	}
 }
 
+// addActiveChannel adds a newly opened, or spliced channel to the set of
+// active channels, and registers a new link for it with the switch.
+func (p *peer) addActiveChannel(newChan *channeldb.OpenChannel) error {
+	chanPoint := &newChan.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	lnChan, err := lnwallet.NewLightningChannel(
+		p.server.cc.signer, p.server.witnessBeacon, newChan,
+	)
+	if err != nil {
+		err := fmt.Errorf("unable to create LightningChannel: %v", err)
+		peerLog.Errorf(err.Error())
+
+		return err
+	}
+
+	p.activeChanMtx.Lock()
+	p.activeChannels[chanID] = lnChan
+	p.activeChanMtx.Unlock()
+
+	peerLog.Infof("New channel active ChannelPoint(%v) with NodeKey(%x)",
+		chanPoint, p.PubKey())
+
+	// Next, we'll assemble a ChannelLink along with the necessary items it
+	// needs to function.
+	//
+	// TODO(roasbeef): panic on below?
+	_, currentHeight, err := p.server.cc.chainIO.GetBestBlock()
+	if err != nil {
+		err := fmt.Errorf("unable to get best block: %v", err)
+		peerLog.Errorf(err.Error())
+
+		lnChan.Stop()
+		return err
+	}
+	chainEvents, err := p.server.chainArb.SubscribeChannelEvents(
+		*chanPoint,
+	)
+	if err != nil {
+		err := fmt.Errorf("unable to subscribe to chain events: %v",
+			err)
+		peerLog.Errorf(err.Error())
+
+		lnChan.Stop()
+		return err
+	}
+
+	// We'll query the localChanCfg of the new channel to determine the
+	// minimum HTLC value that can be forwarded. For fees we'll use the
+	// default values, as they currently are always set to the default
+	// values at initial channel creation.
+	fwdMinHtlc := lnChan.FwdMinHtlc()
+	defaultPolicy := p.server.cc.routingPolicy
+	forwardingPolicy := &htlcswitch.ForwardingPolicy{
+		MinHTLC:       fwdMinHtlc,
+		BaseFee:       defaultPolicy.BaseFee,
+		FeeRate:       defaultPolicy.FeeRate,
+		TimeLockDelta: defaultPolicy.TimeLockDelta,
+	}
+
+	// Create the link and add it to the switch.
+	err = p.addLink(
+		chanPoint, lnChan, forwardingPolicy, chainEvents,
+		currentHeight, false,
+	)
+	if err != nil {
+		err := fmt.Errorf("can't register new channel link(%v) with "+
+			"NodeKey(%x)", chanPoint, p.PubKey())
+		peerLog.Errorf(err.Error())
+
+		lnChan.Stop()
+		return err
+	}
+
+	return nil
+}
+
 // fetchActiveChanCloser attempts to fetch the active chan closer state machine
 // for the target channel ID. If the channel isn't active an error is returned.
 // Otherwise, either an existing state machine will be returned, or a new one
@@ -1767,7 +2030,7 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 
 		// We'll create a valid closing state machine in order to
 		// respond to the initiated cooperative channel closure.
-		deliveryAddr, err := p.genDeliveryScript()
+		deliveryAddr, err := p.chooseDeliveryScript(channel)
 		if err != nil {
 			peerLog.Errorf("unable to gen delivery script: %v", err)
 
@@ -1799,10 +2062,18 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 					return p.server.announceChanStatus(op,
 						true)
 				},
+				putResolverReport: func(
+					r *channeldb.ResolverReport) error {
+
+					return p.server.chanDB.PutResolverReport(
+						channel.ChannelPoint(), r,
+					)
+				},
 				quit: p.quit,
 			},
 			deliveryAddr,
 			feePerKw,
+			0,
 			uint32(startingHeight),
 			nil,
 		)
@@ -1834,11 +2105,28 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	// out this channel on-chain, so we execute the cooperative channel
 	// closure workflow.
 	case htlcswitch.CloseRegular:
-		// First, we'll fetch a fresh delivery address that we'll use
-		// to send the funds to in the case of a successful
-		// negotiation.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
+		// First, we'll fetch the delivery address that we'll use to
+		// send the funds to in the case of a successful negotiation.
+		// If the caller chose a delivery address, we'll use it, as long
+		// as it doesn't conflict with our upfront shutdown script.
+		deliveryAddr := []byte(req.DeliveryScript)
+		upfrontScript := channel.State().LocalShutdownScript
+		switch {
+		case len(deliveryAddr) == 0:
+			var err error
+			deliveryAddr, err = p.chooseDeliveryScript(channel)
+			if err != nil {
+				peerLog.Errorf(err.Error())
+				req.Err <- err
+				return
+			}
+
+		case len(upfrontScript) != 0 &&
+			!bytes.Equal(deliveryAddr, upfrontScript):
+
+			err := fmt.Errorf("delivery address doesn't match "+
+				"upfront shutdown script of ChannelPoint(%v)",
+				req.ChanPoint)
 			peerLog.Errorf(err.Error())
 			req.Err <- err
 			return
@@ -1862,10 +2150,18 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 					return p.server.announceChanStatus(op,
 						true)
 				},
+				putResolverReport: func(
+					r *channeldb.ResolverReport) error {
+
+					return p.server.chanDB.PutResolverReport(
+						channel.ChannelPoint(), r,
+					)
+				},
 				quit: p.quit,
 			},
 			deliveryAddr,
 			req.TargetFeePerKw,
+			req.MaxFeePerKw,
 			uint32(startingHeight),
 			req,
 		)
@@ -2085,6 +2381,464 @@ func waitForChanToClose(bestHeight uint32, notifier chainntnfs.ChainNotifier,
 	cb()
 }
 
+// isActiveChannel returns true if the passed channel ID belongs to one of the
+// active channels with the peer.
+func (p *peer) isActiveChannel(chanID lnwire.ChannelID) bool {
+	p.activeChanMtx.RLock()
+	defer p.activeChanMtx.RUnlock()
+
+	_, ok := p.activeChannels[chanID]
+	return ok
+}
+
+// spliceEnabled returns true if both parties have signaled support for
+// splicing active channels.
+func (p *peer) spliceEnabled() bool {
+	return p.localFeatures.IsSet(lnwire.SpliceOptional) &&
+		p.remoteLocalFeatures.HasFeature(lnwire.SpliceOptional)
+}
+
+// isSpliceMsg returns true if the passed message is part of the quiescence
+// handshake, or the splice of an active channel. The interactive transaction
+// construction messages are only considered to be part of a splice if they
+// refer to an active channel, as they're used to fund new channels as well.
+func (p *peer) isSpliceMsg(msg lnwire.Message) bool {
+	switch msg := msg.(type) {
+	case *lnwire.Stfu, *lnwire.SpliceInit, *lnwire.SpliceAck,
+		*lnwire.SpliceSigned, *lnwire.SpliceLocked,
+		*lnwire.SpliceAbort:
+
+		return true
+
+	case *lnwire.TxAddInput:
+		return p.isActiveChannel(msg.PendingChannelID)
+	case *lnwire.TxAddOutput:
+		return p.isActiveChannel(msg.PendingChannelID)
+	case *lnwire.TxComplete:
+		return p.isActiveChannel(msg.PendingChannelID)
+	case *lnwire.TxSignatures:
+		return p.isActiveChannel(msg.PendingChannelID)
+	}
+
+	return false
+}
+
+// newChanSpliceCfg returns the configuration of a splicer for the passed
+// channel.
+func (p *peer) newChanSpliceCfg(
+	channel *lnwallet.LightningChannel) chanSpliceCfg {
+
+	chanID := lnwire.NewChanIDFromOutPoint(channel.ChannelPoint())
+	wallet := p.server.cc.wallet
+
+	return chanSpliceCfg{
+		channel: channel,
+		claimQuiescence: func() error {
+			link, err := p.server.htlcSwitch.GetLink(chanID)
+			if err != nil {
+				return err
+			}
+			if !link.IsQuiescent() {
+				return fmt.Errorf("channel isn't quiescent")
+			}
+
+			// As the link is already quiescent, this request is
+			// satisfied right away.
+			select {
+			case err := <-link.Quiesce():
+				return err
+			case <-p.quit:
+				return ErrPeerExiting
+			}
+		},
+		fundSplice: func(amt btcutil.Amount,
+			feeRate lnwallet.SatPerKWeight) (
+			*lnwallet.ChannelContribution, error) {
+
+			return wallet.FundSplice(amt, feeRate, 1)
+		},
+		fetchInputInfo: wallet.FetchInputInfo,
+		signInputs:     wallet.SignSpliceInputs,
+		releaseInputs:  wallet.ReleaseInputs,
+		broadcastTx:    wallet.PublishTransaction,
+		bestHeight: func() (uint32, error) {
+			_, height, err := p.server.cc.chainIO.GetBestBlock()
+			return uint32(height), err
+		},
+	}
+}
+
+// SpliceChannel splices funds into or out of the channel of the passed
+// request. The link of the channel is first brought into a quiescent state,
+// after which the splice is proposed to the remote party. The new funding
+// output is sent over the request's response channel once the splice
+// transaction has been broadcast.
+func (p *peer) SpliceChannel(req *spliceReq) {
+	if !p.spliceEnabled() {
+		req.err <- fmt.Errorf("splicing not enabled with peer %x",
+			p.PubKey())
+		return
+	}
+
+	chanID := lnwire.NewChanIDFromOutPoint(req.chanPoint)
+	link, err := p.server.htlcSwitch.GetLink(chanID)
+	if err != nil {
+		req.err <- err
+		return
+	}
+
+	// We may only propose the splice once neither party proposes any
+	// further updates to the channel.
+	select {
+	case err := <-link.Quiesce():
+		if err != nil {
+			req.err <- err
+			return
+		}
+	case <-p.quit:
+		req.err <- ErrPeerExiting
+		return
+	}
+
+	select {
+	case p.localSpliceReqs <- req:
+	case <-p.quit:
+		req.err <- ErrPeerExiting
+	}
+}
+
+// handleLocalSpliceReq kicks-off the splice of a channel requested by a local
+// subsystem, whose link has been brought into a quiescent state.
+func (p *peer) handleLocalSpliceReq(req *spliceReq) {
+	chanID := lnwire.NewChanIDFromOutPoint(req.chanPoint)
+
+	p.activeChanMtx.RLock()
+	channel, ok := p.activeChannels[chanID]
+	p.activeChanMtx.RUnlock()
+	if !ok {
+		err := fmt.Errorf("unable to splice channel, ChannelID(%v) is "+
+			"unknown", chanID)
+		peerLog.Errorf(err.Error())
+		req.err <- err
+		return
+	}
+
+	if _, ok := p.activeSplices[chanID]; ok {
+		req.err <- ErrChanAlreadySplicing
+		return
+	}
+
+	splicer := newChannelSplicer(p.newChanSpliceCfg(channel), req)
+	p.addChanSplicer(splicer)
+
+	spliceInit, err := splicer.InitSplice()
+	if err != nil {
+		p.failChanSplice(splicer, err, true)
+		return
+	}
+
+	p.queueMsg(spliceInit, nil)
+}
+
+// handleSpliceMsg advances the splicer state machine of a channel with the
+// passed message received from the remote peer.
+func (p *peer) handleSpliceMsg(spliceMsg *spliceMsg) {
+	splicer, ok := p.activeSplices[spliceMsg.cid]
+	if !ok {
+		switch msg := spliceMsg.msg.(type) {
+		// The remote party wishes to splice one of our channels, so
+		// we'll create a new splicer to respond to the splice.
+		case *lnwire.SpliceInit:
+			p.activeChanMtx.RLock()
+			channel, ok := p.activeChannels[spliceMsg.cid]
+			p.activeChanMtx.RUnlock()
+			if !ok {
+				peerLog.Warnf("Received SpliceInit for unknown "+
+					"ChannelID(%v)", spliceMsg.cid)
+				return
+			}
+
+			splicer = newChannelSplicer(
+				p.newChanSpliceCfg(channel), nil,
+			)
+			p.addChanSplicer(splicer)
+
+		// If we've already completed the splice, the remote party
+		// may not have received our SpliceLocked message, so we'll
+		// retransmit it.
+		case *lnwire.SpliceLocked:
+			p.retransmitSpliceLocked(msg)
+			return
+
+		// If no splice has been proposed yet, the remote party only
+		// aborted the quiescence of the channel, which is handled by
+		// its link.
+		case *lnwire.SpliceAbort:
+			return
+
+		default:
+			peerLog.Warnf("Received %T for unknown splice of "+
+				"ChannelID(%v)", msg, spliceMsg.cid)
+			return
+		}
+	}
+
+	if msg, ok := spliceMsg.msg.(*lnwire.SpliceAbort); ok {
+		p.failChanSplice(splicer, fmt.Errorf("remote aborted "+
+			"splice: %q", msg.Data), false)
+		return
+	}
+
+	msgs, broadcast, err := splicer.ProcessSpliceMsg(spliceMsg.msg)
+	if err != nil {
+		p.failChanSplice(splicer, fmt.Errorf("unable to process "+
+			"splice msg: %v", err), true)
+		return
+	}
+
+	for _, msg := range msgs {
+		p.queueMsg(msg, nil)
+	}
+
+	// Once the splice transaction has been broadcast, we'll wait for it to
+	// confirm before continuing the channel on top of its new funding
+	// output.
+	if broadcast {
+		pendingSplice := splicer.PendingSplice()
+		if req := splicer.SpliceRequest(); req != nil {
+			req.resp <- &pendingSplice.FundingOutpoint
+		}
+
+		p.wg.Add(1)
+		go p.waitForSpliceConf(
+			splicer.cid, splicer.cfg.channel.State(), pendingSplice,
+		)
+	}
+
+	if splicer.IsLocked() {
+		p.finalizeChanSplice(splicer)
+	}
+}
+
+// retransmitSpliceLocked retransmits our SpliceLocked message for a splice we
+// have already completed, which the remote party still considers pending.
+func (p *peer) retransmitSpliceLocked(msg *lnwire.SpliceLocked) {
+	p.activeChanMtx.RLock()
+	defer p.activeChanMtx.RUnlock()
+
+	for _, channel := range p.activeChannels {
+		if channel.ChannelPoint().Hash != msg.SpliceTxid {
+			continue
+		}
+
+		peerLog.Infof("Retransmitting SpliceLocked for splice %v",
+			msg.SpliceTxid)
+
+		p.queueMsg(&lnwire.SpliceLocked{
+			ChanID:     msg.ChanID,
+			SpliceTxid: msg.SpliceTxid,
+		}, nil)
+		return
+	}
+
+	peerLog.Warnf("Received SpliceLocked for unknown ChannelID(%v)",
+		msg.ChanID)
+}
+
+// addChanSplicer tracks the passed splicer as the active splice of its
+// channel, and starts its negotiation timeout.
+func (p *peer) addChanSplicer(splicer *channelSplicer) {
+	p.activeSplices[splicer.cid] = splicer
+
+	time.AfterFunc(spliceNegotiationTimeout, func() {
+		select {
+		case p.spliceTimeouts <- splicer:
+		case <-p.quit:
+		}
+	})
+}
+
+// failChanSplice aborts the splice of a channel, returning its link to the
+// normal state. If notifyRemote is true, the remote party is sent a
+// SpliceAbort message so it returns to the normal state as well. A splice
+// that has already been committed to can no longer be aborted, so we'll wait
+// for its transaction to confirm instead.
+func (p *peer) failChanSplice(splicer *channelSplicer, err error,
+	notifyRemote bool) {
+
+	peerLog.Errorf("ChannelPoint(%v): splice failed: %v",
+		splicer.chanPoint, err)
+
+	// Once the splice transaction has been broadcast, we're already
+	// waiting for it to confirm.
+	if splicer.IsAwaitingConf() {
+		return
+	}
+
+	if pendingSplice := splicer.PendingSplice(); pendingSplice != nil {
+		if req := splicer.SpliceRequest(); req != nil {
+			req.err <- fmt.Errorf("%v, waiting for committed splice "+
+				"%v to confirm", err, pendingSplice.SpliceTx.TxHash())
+		}
+
+		splicer.AwaitConf()
+
+		p.wg.Add(1)
+		go p.waitForSpliceConf(
+			splicer.cid, splicer.cfg.channel.State(), pendingSplice,
+		)
+		return
+	}
+
+	if notifyRemote {
+		p.queueMsg(lnwire.NewSpliceAbort(splicer.cid, err.Error()), nil)
+	}
+
+	splicer.ReleaseInputs()
+	if req := splicer.SpliceRequest(); req != nil {
+		req.err <- err
+	}
+	delete(p.activeSplices, splicer.cid)
+
+	link, linkErr := p.server.htlcSwitch.GetLink(splicer.cid)
+	if linkErr != nil {
+		peerLog.Errorf("ChannelPoint(%v): unable to find link to exit "+
+			"quiescence: %v", splicer.chanPoint, linkErr)
+		return
+	}
+	link.ExitQuiescence()
+}
+
+// resumeChanSplice resumes a splice of the passed channel which was committed
+// to before the peer was last connected. The splice transaction is
+// rebroadcast, and we'll wait for it to confirm.
+//
+// TODO(roasbeef): abandon splices that fail to confirm, as the channel remains
+// quiescent until then.
+func (p *peer) resumeChanSplice(channel *lnwallet.LightningChannel,
+	pendingSplice *channeldb.ChannelSplice) {
+
+	splicer := restoreChannelSplicer(
+		p.newChanSpliceCfg(channel), pendingSplice,
+	)
+	p.activeSplices[splicer.cid] = splicer
+
+	peerLog.Infof("ChannelPoint(%v) has pending splice %v, waiting for "+
+		"confirmation", splicer.chanPoint,
+		pendingSplice.SpliceTx.TxHash())
+
+	if pendingSplice.IsSigned() {
+		err := p.server.cc.wallet.PublishTransaction(
+			pendingSplice.SpliceTx,
+		)
+		if err != nil && err != lnwallet.ErrDoubleSpend {
+			peerLog.Errorf("Unable to rebroadcast splice tx %v: %v",
+				pendingSplice.SpliceTx.TxHash(), err)
+		}
+	}
+
+	p.wg.Add(1)
+	go p.waitForSpliceConf(splicer.cid, channel.State(), pendingSplice)
+}
+
+// waitForSpliceConf waits for the splice transaction of a channel to reach the
+// number of confirmations required by the channel, then notifies the
+// channelManager of the position of the new funding output within the chain.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *peer) waitForSpliceConf(chanID lnwire.ChannelID,
+	channel *channeldb.OpenChannel, pendingSplice *channeldb.ChannelSplice) {
+
+	defer p.wg.Done()
+
+	fundingPoint := pendingSplice.FundingOutpoint
+	fundingScript := pendingSplice.SpliceTx.TxOut[fundingPoint.Index].PkScript
+	numConfs := uint32(channel.NumConfsRequired)
+	confNtfn, err := p.server.cc.chainNotifier.RegisterConfirmationsNtfn(
+		&fundingPoint.Hash, fundingScript, numConfs,
+		pendingSplice.BroadcastHeight,
+	)
+	if err != nil {
+		peerLog.Errorf("Unable to register for confirmation of "+
+			"splice %v: %v", fundingPoint.Hash, err)
+		return
+	}
+
+	peerLog.Infof("Waiting for splice tx (%v) to reach %v confirmations",
+		fundingPoint.Hash, numConfs)
+
+	var confDetails *chainntnfs.TxConfirmation
+	select {
+	case details, ok := <-confNtfn.Confirmed:
+		if !ok {
+			return
+		}
+		confDetails = details
+
+	case <-p.quit:
+		return
+	}
+
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: confDetails.BlockHeight,
+		TxIndex:     confDetails.TxIndex,
+		TxPosition:  uint16(fundingPoint.Index),
+	}
+
+	select {
+	case p.spliceConfs <- &spliceConf{chanID, shortChanID}:
+	case <-p.quit:
+	}
+}
+
+// finalizeChanSplice continues a spliced channel on top of its new funding
+// output, once the splice transaction has sufficiently confirmed and both
+// parties have sent their SpliceLocked message. The link of the channel is
+// replaced by a new link, and the channel is announced under its new short
+// channel ID.
+func (p *peer) finalizeChanSplice(splicer *channelSplicer) {
+	delete(p.activeSplices, splicer.cid)
+
+	dbChan := splicer.cfg.channel.State()
+	prevChanPoint := dbChan.FundingOutpoint
+	shortChanID := *splicer.ShortChanID()
+
+	if err := dbChan.CompleteSplice(shortChanID); err != nil {
+		peerLog.Errorf("Unable to complete splice of "+
+			"ChannelPoint(%v): %v", prevChanPoint, err)
+		return
+	}
+
+	peerLog.Infof("ChannelPoint(%v) has been spliced into "+
+		"ChannelPoint(%v), short_chan_id=%v", prevChanPoint,
+		dbChan.FundingOutpoint, shortChanID)
+
+	if err := p.WipeChannel(&prevChanPoint); err != nil {
+		peerLog.Errorf("Unable to wipe spliced ChannelPoint(%v): %v",
+			prevChanPoint, err)
+		return
+	}
+
+	err := p.server.chainArb.SpliceChannel(prevChanPoint, dbChan)
+	if err != nil {
+		peerLog.Errorf("Unable to watch spliced ChannelPoint(%v): %v",
+			dbChan.FundingOutpoint, err)
+		return
+	}
+
+	if err := p.addActiveChannel(dbChan); err != nil {
+		return
+	}
+
+	// TODO(roasbeef): carry over the forwarding policy of the spliced
+	// channel, rather than announcing it with the default policy.
+	err = p.server.fundingMgr.announceSplicedChannel(dbChan, &shortChanID)
+	if err != nil {
+		peerLog.Errorf("Unable to announce spliced ChannelPoint(%v): "+
+			"%v", dbChan.FundingOutpoint, err)
+	}
+}
+
 // WipeChannel removes the passed channel point from all indexes associated with
 // the peer, and the switch.
 func (p *peer) WipeChannel(chanPoint *wire.OutPoint) error {
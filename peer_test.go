@@ -3,6 +3,8 @@
 package main
 
 import (
+	"bytes"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -121,7 +123,7 @@ func TestPeerChannelClosureAcceptFeeInitiator(t *testing.T) {
 	defer cleanUp()
 
 	// We make the initiator send a shutdown request.
-	updateChan := make(chan *lnrpc.CloseStatusUpdate, 1)
+	updateChan := make(chan *lnrpc.CloseStatusUpdate, 10)
 	errChan := make(chan error, 1)
 	closeCommand := &htlcswitch.ChanClose{
 		CloseType:      htlcswitch.CloseRegular,
@@ -410,7 +412,7 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 	defer cleanUp()
 
 	// We make the initiator send a shutdown request.
-	updateChan := make(chan *lnrpc.CloseStatusUpdate, 1)
+	updateChan := make(chan *lnrpc.CloseStatusUpdate, 10)
 	errChan := make(chan error, 1)
 	closeCommand := &htlcswitch.ChanClose{
 		CloseType:      htlcswitch.CloseRegular,
@@ -589,3 +591,390 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestPeerChannelClosureUpfrontShutdown tests that a Shutdown message with a
+// delivery script other than the upfront shutdown script committed to by the
+// remote party is rejected, and that our own upfront shutdown script is used
+// as our delivery script.
+func TestPeerChannelClosureUpfrontShutdown(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	responder, responderChan, _, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Both parties committed to an upfront shutdown script when opening
+	// the channel.
+	localScript := append([]byte{0x00, 0x14}, dummyDeliveryScript[:20]...)
+	remoteScript := append([]byte{0x00, 0x14}, dummyDeliveryScript[1:21]...)
+	responderChan.State().LocalShutdownScript = localScript
+	responderChan.State().RemoteShutdownScript = remoteScript
+
+	chanID := lnwire.NewChanIDFromOutPoint(responderChan.ChannelPoint())
+
+	// A shutdown request paying to any other script should be rejected.
+	responder.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+	}
+
+	select {
+	case outMsg := <-responder.outgoingQueue:
+		if _, ok := outMsg.msg.(*lnwire.Error); !ok {
+			t.Fatalf("expected Error message, got %T", outMsg.msg)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive error message")
+	}
+
+	// Once the remote party pays to its upfront shutdown script, the
+	// responder should answer with a Shutdown paying to her own upfront
+	// shutdown script.
+	responder.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, remoteScript),
+	}
+
+	select {
+	case outMsg := <-responder.outgoingQueue:
+		shutdownMsg, ok := outMsg.msg.(*lnwire.Shutdown)
+		if !ok {
+			t.Fatalf("expected Shutdown message, got %T",
+				outMsg.msg)
+		}
+		if !bytes.Equal(shutdownMsg.Address, localScript) {
+			t.Fatalf("expected delivery script %x, got %x",
+				localScript, shutdownMsg.Address)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown message")
+	}
+}
+
+// TestPeerChannelClosureMaxFee tests that the shutdown initiator pays to the
+// delivery script requested by the caller, never proposes or accepts a fee
+// above the requested maximum, and reports the fee negotiation over the
+// update channel.
+func TestPeerChannelClosureMaxFee(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	initiator, initiatorChan, responderChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We make the initiator send a shutdown request paying to a custom
+	// delivery script, with a maximum fee below its target fee.
+	deliveryScript := append([]byte{0x00, 0x14}, dummyDeliveryScript[:20]...)
+	updateChan := make(chan *lnrpc.CloseStatusUpdate, 10)
+	errChan := make(chan error, 1)
+	closeCommand := &htlcswitch.ChanClose{
+		CloseType:      htlcswitch.CloseRegular,
+		ChanPoint:      initiatorChan.ChannelPoint(),
+		Updates:        updateChan,
+		TargetFeePerKw: 12500,
+		MaxFeePerKw:    2500,
+		DeliveryScript: deliveryScript,
+		Err:            errChan,
+	}
+	initiator.localCloseChanReqs <- closeCommand
+
+	// We should now be getting the shutdown request, paying to the
+	// requested delivery script.
+	var msg lnwire.Message
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown request")
+	}
+
+	shutdownMsg, ok := msg.(*lnwire.Shutdown)
+	if !ok {
+		t.Fatalf("expected Shutdown message, got %T", msg)
+	}
+	if !bytes.Equal(shutdownMsg.Address, deliveryScript) {
+		t.Fatalf("expected delivery script %x, got %x",
+			deliveryScript, shutdownMsg.Address)
+	}
+
+	chanID := shutdownMsg.ChannelID
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+	}
+
+	// The initiator's first proposal should be clamped to the max fee.
+	maxFee := responderChan.CalcFee(2500)
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive closing signed message")
+	}
+
+	closingSignedMsg, ok := msg.(*lnwire.ClosingSigned)
+	if !ok {
+		t.Fatalf("expected ClosingSigned message, got %T", msg)
+	}
+	if closingSignedMsg.FeeSatoshis != maxFee {
+		t.Fatalf("expected ClosingSigned fee to be %v, instead got %v",
+			maxFee, closingSignedMsg.FeeSatoshis)
+	}
+
+	// sendProposal makes the responder propose the given fee.
+	sendProposal := func(fee btcutil.Amount) {
+		closeSig, _, _, err := responderChan.CreateCloseProposal(fee,
+			dummyDeliveryScript, deliveryScript)
+		if err != nil {
+			t.Fatalf("unable to create close proposal: %v", err)
+		}
+		parsedSig, err := lnwire.NewSigFromRawSignature(closeSig)
+		if err != nil {
+			t.Fatalf("unable to parse signature: %v", err)
+		}
+
+		initiator.chanCloseMsgs <- &closeMsg{
+			cid: chanID,
+			msg: lnwire.NewClosingSigned(chanID, fee, parsedSig),
+		}
+	}
+
+	// A proposal above the max fee should be rejected, and the initiator
+	// should counter with the max fee again.
+	sendProposal(maxFee * 2)
+
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive closing signed message")
+	}
+
+	closingSignedMsg, ok = msg.(*lnwire.ClosingSigned)
+	if !ok {
+		t.Fatalf("expected ClosingSigned message, got %T", msg)
+	}
+	if closingSignedMsg.FeeSatoshis != maxFee {
+		t.Fatalf("expected ClosingSigned fee to be %v, instead got %v",
+			maxFee, closingSignedMsg.FeeSatoshis)
+	}
+
+	// Once the responder agrees to the max fee, the closing transaction
+	// should be broadcast, paying to the requested delivery script.
+	sendProposal(maxFee)
+
+	var closeTx *wire.MsgTx
+	select {
+	case closeTx = <-broadcastTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("closing tx not broadcast")
+	}
+
+	found := false
+	for _, txOut := range closeTx.TxOut {
+		if bytes.Equal(txOut.PkScript, deliveryScript) {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("closing tx doesn't pay to delivery script")
+	}
+
+	// Finally, the negotiation should have been reported over the update
+	// channel.
+	expectedUpdates := []*lnrpc.ClosingFeeUpdate{
+		{FeeSat: int64(maxFee), Local: true},
+		{FeeSat: int64(maxFee * 2), Local: false},
+		{FeeSat: int64(maxFee), Local: true},
+		{FeeSat: int64(maxFee), Local: false},
+	}
+	for i, expected := range expectedUpdates {
+		var update *lnrpc.CloseStatusUpdate
+		select {
+		case update = <-updateChan:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("did not receive fee update #%d", i)
+		}
+
+		feeUpdate := update.GetFeeUpdate()
+		if feeUpdate == nil {
+			t.Fatalf("expected fee update #%d, got %v", i, update)
+		}
+		if feeUpdate.FeeSat != expected.FeeSat ||
+			feeUpdate.Local != expected.Local {
+
+			t.Fatalf("fee update #%d mismatch: expected %v, got %v",
+				i, expected, feeUpdate)
+		}
+	}
+
+	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+}
+
+// TestPeerChannelClosureUpdatesNotConsumed tests that the fee negotiation
+// doesn't block on the subsystem that requested the channel closure
+// consuming the close updates, and that the final updates of the closure are
+// still delivered.
+func TestPeerChannelClosureUpdatesNotConsumed(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	initiator, initiatorChan, responderChan, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// The update channel only has room for the final updates, and won't
+	// be read from until the closing transaction is broadcast.
+	updateChan := make(chan *lnrpc.CloseStatusUpdate, numFinalCloseUpdates)
+	errChan := make(chan error, 1)
+	closeCommand := &htlcswitch.ChanClose{
+		CloseType:      htlcswitch.CloseRegular,
+		ChanPoint:      initiatorChan.ChannelPoint(),
+		Updates:        updateChan,
+		TargetFeePerKw: 12500,
+		Err:            errChan,
+	}
+	initiator.localCloseChanReqs <- closeCommand
+
+	var msg lnwire.Message
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive shutdown request")
+	}
+	shutdownMsg, ok := msg.(*lnwire.Shutdown)
+	if !ok {
+		t.Fatalf("expected Shutdown message, got %T", msg)
+	}
+
+	chanID := shutdownMsg.ChannelID
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewShutdown(chanID, dummyDeliveryScript),
+	}
+
+	select {
+	case outMsg := <-initiator.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive closing signed message")
+	}
+	closingSignedMsg, ok := msg.(*lnwire.ClosingSigned)
+	if !ok {
+		t.Fatalf("expected ClosingSigned message, got %T", msg)
+	}
+
+	// We'll accept the initiator's fee, after which it should broadcast
+	// the closing transaction.
+	fee := closingSignedMsg.FeeSatoshis
+	closeSig, _, _, err := responderChan.CreateCloseProposal(fee,
+		dummyDeliveryScript, shutdownMsg.Address)
+	if err != nil {
+		t.Fatalf("unable to create close proposal: %v", err)
+	}
+	parsedSig, err := lnwire.NewSigFromRawSignature(closeSig)
+	if err != nil {
+		t.Fatalf("unable to parse signature: %v", err)
+	}
+	initiator.chanCloseMsgs <- &closeMsg{
+		cid: chanID,
+		msg: lnwire.NewClosingSigned(chanID, fee, parsedSig),
+	}
+
+	select {
+	case <-broadcastTxChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("closing tx not broadcast")
+	}
+
+	// The fee proposals should have been dropped, leaving room for the
+	// final updates.
+	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+	for i := 0; i < numFinalCloseUpdates; i++ {
+		var update *lnrpc.CloseStatusUpdate
+		select {
+		case update = <-updateChan:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("did not receive final update #%d", i)
+		}
+
+		if update.GetFeeUpdate() != nil {
+			t.Fatalf("unexpected fee update #%d", i)
+		}
+	}
+}
+
+// TestPeerSpliceAbortedNotQuiescent tests that a splice proposed for a channel
+// whose link isn't quiescent is aborted, letting the remote party know
+// through a SpliceAbort message, rather than disconnecting from it.
+func TestPeerSpliceAbortedNotQuiescent(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockNotfier{
+		confChannel: make(chan *chainntnfs.TxConfirmation),
+	}
+	broadcastTxChan := make(chan *wire.MsgTx)
+
+	responder, responderChan, _, cleanUp, err := createTestPeer(
+		notifier, broadcastTxChan,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	chanID := lnwire.NewChanIDFromOutPoint(responderChan.ChannelPoint())
+	responder.chanSpliceMsgs <- &spliceMsg{
+		cid: chanID,
+		msg: &lnwire.SpliceInit{
+			ChanID:           chanID,
+			RelativeAmount:   -100000,
+			FeePerKiloWeight: uint32(lnwallet.FeePerKwFloor),
+		},
+	}
+
+	var msg lnwire.Message
+	select {
+	case outMsg := <-responder.outgoingQueue:
+		msg = outMsg.msg
+	case <-time.After(time.Second * 5):
+		t.Fatalf("did not receive splice abort message")
+	}
+
+	abort, ok := msg.(*lnwire.SpliceAbort)
+	if !ok {
+		t.Fatalf("expected SpliceAbort message, got %T", msg)
+	}
+	if abort.ChanID != chanID {
+		t.Fatalf("expected abort of ChannelID(%v), got %v", chanID,
+			abort.ChanID)
+	}
+
+	if atomic.LoadInt32(&responder.disconnect) != 0 {
+		t.Fatalf("peer shouldn't disconnect when aborting a splice")
+	}
+}
@@ -35,6 +35,21 @@ func (c *chanController) OpenChannel(target *btcec.PublicKey,
 		return err
 	}
 
+	// Channels above the soft-limit for channel size can only be opened
+	// if the target peer signals support for them, otherwise we'll cap
+	// the channel at the soft-limit.
+	if amt > maxFundingAmount {
+		peer, err := c.server.FindPeer(target)
+		if err != nil {
+			return err
+		}
+
+		remoteFeatures := peer.RemoteLocalFeatures()
+		if !remoteFeatures.HasFeature(lnwire.WumboChannelsOptional) {
+			amt = maxFundingAmount
+		}
+	}
+
 	// TODO(halseth): make configurable?
 	minHtlc := lnwire.NewMSatFromSatoshis(1)
 
@@ -0,0 +1,552 @@
+// Package psbt implements the serialization format of Partially Signed Bitcoin
+// Transactions as defined by BIP 174.
+package psbt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// MaxPsbtKeyLength is the maximum length of a key within a PSBT key
+	// value pair that we'll accept.
+	MaxPsbtKeyLength = 10000
+
+	// MaxPsbtValueLength is the maximum length of a value within a PSBT
+	// key value pair that we'll accept. This is bounded by the maximum
+	// size of a block, as no single value can be larger than that.
+	MaxPsbtValueLength = 4000000
+)
+
+// magic is the byte sequence that prefixes every serialized PSBT: the ASCII
+// string "psbt" followed by the separator 0xff.
+var magic = [5]byte{0x70, 0x73, 0x62, 0x74, 0xff}
+
+// The set of key types defined by BIP 174 which this package interprets. All
+// other key types are preserved verbatim as Unknowns.
+const (
+	// globalUnsignedTxType is the key type of the unsigned transaction
+	// within the global map.
+	globalUnsignedTxType = 0x00
+
+	// inputNonWitnessUtxoType is the key type of the full transaction
+	// being spent by a non-witness input.
+	inputNonWitnessUtxoType = 0x00
+
+	// inputWitnessUtxoType is the key type of the output being spent by a
+	// witness input.
+	inputWitnessUtxoType = 0x01
+
+	// inputFinalScriptSigType is the key type of the finalized sigScript
+	// of an input.
+	inputFinalScriptSigType = 0x07
+
+	// inputFinalScriptWitnessType is the key type of the finalized
+	// witness of an input.
+	inputFinalScriptWitnessType = 0x08
+)
+
+var (
+	// ErrInvalidMagicBytes is returned when a serialized PSBT doesn't
+	// begin with the expected magic bytes.
+	ErrInvalidMagicBytes = errors.New("invalid PSBT magic bytes")
+
+	// ErrInvalidPsbtFormat is returned when a serialized PSBT is
+	// malformed.
+	ErrInvalidPsbtFormat = errors.New("invalid PSBT serialization format")
+
+	// ErrDuplicateKey is returned when a key appears more than once
+	// within the same map of a PSBT.
+	ErrDuplicateKey = errors.New("invalid PSBT due to duplicate key")
+
+	// ErrInvalidKeyData is returned when a key which must not carry any
+	// key data does so.
+	ErrInvalidKeyData = errors.New("PSBT key has unexpected key data")
+
+	// ErrUnsignedTxHasSigs is returned when the unsigned transaction of a
+	// PSBT contains sigScripts or witnesses.
+	ErrUnsignedTxHasSigs = errors.New("PSBT unsigned transaction " +
+		"contains signatures")
+
+	// ErrIncompletePSBT is returned when attempting to extract the final
+	// transaction of a PSBT that has inputs which aren't finalized yet.
+	ErrIncompletePSBT = errors.New("PSBT has inputs which aren't " +
+		"finalized")
+)
+
+// Unknown is a key value pair of a PSBT map which isn't interpreted by this
+// package. Unknowns are retained so they survive a round trip through this
+// package unchanged.
+type Unknown struct {
+	// Key is the full key, including its leading key type byte.
+	Key []byte
+
+	// Value is the raw value of the pair.
+	Value []byte
+}
+
+// PInput is the set of information a PSBT carries for a single input of its
+// unsigned transaction.
+type PInput struct {
+	// NonWitnessUtxo is the full transaction being spent by this input,
+	// if it's a non-witness input.
+	NonWitnessUtxo *wire.MsgTx
+
+	// WitnessUtxo is the output being spent by this input, if it's a
+	// witness input.
+	WitnessUtxo *wire.TxOut
+
+	// FinalScriptSig is the finalized sigScript of this input.
+	FinalScriptSig []byte
+
+	// FinalScriptWitness is the finalized witness of this input.
+	FinalScriptWitness wire.TxWitness
+
+	// Unknowns is the set of all other key value pairs of this input,
+	// such as partial signatures and key derivation paths.
+	Unknowns []*Unknown
+}
+
+// IsFinalized returns true if the input carries a finalized sigScript or
+// witness.
+func (pi *PInput) IsFinalized() bool {
+	return len(pi.FinalScriptSig) != 0 || len(pi.FinalScriptWitness) != 0
+}
+
+// POutput is the set of information a PSBT carries for a single output of its
+// unsigned transaction.
+type POutput struct {
+	// Unknowns is the set of key value pairs of this output, such as
+	// redeem scripts and key derivation paths.
+	Unknowns []*Unknown
+}
+
+// Packet is a Partially Signed Bitcoin Transaction as defined by BIP 174. It
+// couples an unsigned transaction with the information required by each
+// participant to sign and finalize its inputs.
+type Packet struct {
+	// UnsignedTx is the transaction being signed. All of its sigScripts
+	// and witnesses MUST be empty.
+	UnsignedTx *wire.MsgTx
+
+	// Inputs contains an entry for each input of UnsignedTx, in the same
+	// order.
+	Inputs []PInput
+
+	// Outputs contains an entry for each output of UnsignedTx, in the
+	// same order.
+	Outputs []POutput
+
+	// Unknowns is the set of key value pairs of the global map other than
+	// the unsigned transaction.
+	Unknowns []*Unknown
+}
+
+// NewFromUnsignedTx creates a new Packet from the given unsigned transaction,
+// with empty maps for each of its inputs and outputs.
+func NewFromUnsignedTx(tx *wire.MsgTx) (*Packet, error) {
+	if err := checkUnsignedTx(tx); err != nil {
+		return nil, err
+	}
+
+	return &Packet{
+		UnsignedTx: tx,
+		Inputs:     make([]PInput, len(tx.TxIn)),
+		Outputs:    make([]POutput, len(tx.TxOut)),
+	}, nil
+}
+
+// checkUnsignedTx ensures that none of the inputs of the given transaction
+// carry a sigScript or witness.
+func checkUnsignedTx(tx *wire.MsgTx) error {
+	for _, txIn := range tx.TxIn {
+		if len(txIn.SignatureScript) != 0 || len(txIn.Witness) != 0 {
+			return ErrUnsignedTxHasSigs
+		}
+	}
+
+	return nil
+}
+
+// NewFromRawBytes parses a serialized PSBT from the given reader. If b64 is
+// true, then the serialized PSBT is expected to be base64 encoded.
+func NewFromRawBytes(r io.Reader, b64 bool) (*Packet, error) {
+	if b64 {
+		raw, err := ioutil.ReadAll(
+			base64.NewDecoder(base64.StdEncoding, r),
+		)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(raw)
+	}
+
+	var m [5]byte
+	if _, err := io.ReadFull(r, m[:]); err != nil {
+		return nil, err
+	}
+	if m != magic {
+		return nil, ErrInvalidMagicBytes
+	}
+
+	// The global map is required to contain the unsigned transaction, all
+	// other pairs are retained as unknowns.
+	globals, err := readMap(r)
+	if err != nil {
+		return nil, err
+	}
+
+	packet := &Packet{}
+	for _, pair := range globals {
+		if pair.Key[0] != globalUnsignedTxType {
+			packet.Unknowns = append(packet.Unknowns, pair)
+			continue
+		}
+		if len(pair.Key) != 1 {
+			return nil, ErrInvalidKeyData
+		}
+
+		tx := wire.NewMsgTx(2)
+		err := tx.DeserializeNoWitness(bytes.NewReader(pair.Value))
+		if err != nil {
+			return nil, err
+		}
+		if err := checkUnsignedTx(tx); err != nil {
+			return nil, err
+		}
+		packet.UnsignedTx = tx
+	}
+	if packet.UnsignedTx == nil {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	// Next, we'll read a map for each of the inputs of the unsigned
+	// transaction.
+	packet.Inputs = make([]PInput, len(packet.UnsignedTx.TxIn))
+	for i := range packet.Inputs {
+		pairs, err := readMap(r)
+		if err != nil {
+			return nil, err
+		}
+		if err := packet.Inputs[i].parse(pairs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Finally, we'll read a map for each of the outputs.
+	packet.Outputs = make([]POutput, len(packet.UnsignedTx.TxOut))
+	for i := range packet.Outputs {
+		pairs, err := readMap(r)
+		if err != nil {
+			return nil, err
+		}
+		packet.Outputs[i].Unknowns = pairs
+	}
+
+	return packet, nil
+}
+
+// parse populates the input from the given key value pairs of its map.
+func (pi *PInput) parse(pairs []*Unknown) error {
+	for _, pair := range pairs {
+		keyType := pair.Key[0]
+		switch keyType {
+		case inputNonWitnessUtxoType, inputWitnessUtxoType,
+			inputFinalScriptSigType, inputFinalScriptWitnessType:
+
+			if len(pair.Key) != 1 {
+				return ErrInvalidKeyData
+			}
+
+		default:
+			pi.Unknowns = append(pi.Unknowns, pair)
+			continue
+		}
+
+		r := bytes.NewReader(pair.Value)
+		switch keyType {
+		case inputNonWitnessUtxoType:
+			tx := wire.NewMsgTx(2)
+			if err := tx.Deserialize(r); err != nil {
+				return err
+			}
+			pi.NonWitnessUtxo = tx
+
+		case inputWitnessUtxoType:
+			var value [8]byte
+			if _, err := io.ReadFull(r, value[:]); err != nil {
+				return err
+			}
+			pkScript, err := wire.ReadVarBytes(
+				r, 0, MaxPsbtValueLength, "pkScript",
+			)
+			if err != nil {
+				return err
+			}
+			pi.WitnessUtxo = wire.NewTxOut(
+				int64(binary.LittleEndian.Uint64(value[:])),
+				pkScript,
+			)
+
+		case inputFinalScriptSigType:
+			pi.FinalScriptSig = pair.Value
+
+		case inputFinalScriptWitnessType:
+			witness, err := readWitness(r)
+			if err != nil {
+				return err
+			}
+			pi.FinalScriptWitness = witness
+		}
+	}
+
+	return nil
+}
+
+// pairs returns the key value pairs of the input's map.
+func (pi *PInput) pairs() ([]*Unknown, error) {
+	var pairs []*Unknown
+	if pi.NonWitnessUtxo != nil {
+		var b bytes.Buffer
+		if err := pi.NonWitnessUtxo.Serialize(&b); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, &Unknown{
+			Key:   []byte{inputNonWitnessUtxoType},
+			Value: b.Bytes(),
+		})
+	}
+	if pi.WitnessUtxo != nil {
+		var b bytes.Buffer
+		err := wire.WriteTxOut(&b, 0, 0, pi.WitnessUtxo)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, &Unknown{
+			Key:   []byte{inputWitnessUtxoType},
+			Value: b.Bytes(),
+		})
+	}
+	if len(pi.FinalScriptSig) != 0 {
+		pairs = append(pairs, &Unknown{
+			Key:   []byte{inputFinalScriptSigType},
+			Value: pi.FinalScriptSig,
+		})
+	}
+	if len(pi.FinalScriptWitness) != 0 {
+		var b bytes.Buffer
+		if err := writeWitness(&b, pi.FinalScriptWitness); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, &Unknown{
+			Key:   []byte{inputFinalScriptWitnessType},
+			Value: b.Bytes(),
+		})
+	}
+
+	return append(pairs, pi.Unknowns...), nil
+}
+
+// Serialize writes the binary serialization of the PSBT to the given writer.
+func (p *Packet) Serialize(w io.Writer) error {
+	if err := p.SanityCheck(); err != nil {
+		return err
+	}
+
+	if _, err := w.Write(magic[:]); err != nil {
+		return err
+	}
+
+	var tx bytes.Buffer
+	if err := p.UnsignedTx.SerializeNoWitness(&tx); err != nil {
+		return err
+	}
+	globals := append([]*Unknown{{
+		Key:   []byte{globalUnsignedTxType},
+		Value: tx.Bytes(),
+	}}, p.Unknowns...)
+	if err := writeMap(w, globals); err != nil {
+		return err
+	}
+
+	for _, input := range p.Inputs {
+		pairs, err := input.pairs()
+		if err != nil {
+			return err
+		}
+		if err := writeMap(w, pairs); err != nil {
+			return err
+		}
+	}
+	for _, output := range p.Outputs {
+		if err := writeMap(w, output.Unknowns); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// B64Encode returns the base64 encoding of the serialized PSBT, which is the
+// format commonly used to pass PSBTs between wallets.
+func (p *Packet) B64Encode() (string, error) {
+	var b bytes.Buffer
+	if err := p.Serialize(&b); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b.Bytes()), nil
+}
+
+// SanityCheck ensures that the PSBT carries an unsigned transaction, and a map
+// for each of its inputs and outputs.
+func (p *Packet) SanityCheck() error {
+	if p.UnsignedTx == nil {
+		return ErrInvalidPsbtFormat
+	}
+	if err := checkUnsignedTx(p.UnsignedTx); err != nil {
+		return err
+	}
+	if len(p.Inputs) != len(p.UnsignedTx.TxIn) ||
+		len(p.Outputs) != len(p.UnsignedTx.TxOut) {
+
+		return ErrInvalidPsbtFormat
+	}
+
+	return nil
+}
+
+// IsComplete returns true if all inputs of the PSBT have been finalized, and
+// the final transaction can be extracted.
+func (p *Packet) IsComplete() bool {
+	for i := range p.Inputs {
+		if !p.Inputs[i].IsFinalized() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Extract returns the final, fully signed transaction of a complete PSBT. An
+// error is returned if any of the inputs haven't been finalized yet.
+func Extract(p *Packet) (*wire.MsgTx, error) {
+	if err := p.SanityCheck(); err != nil {
+		return nil, err
+	}
+	if !p.IsComplete() {
+		return nil, ErrIncompletePSBT
+	}
+
+	finalTx := p.UnsignedTx.Copy()
+	for i, txIn := range finalTx.TxIn {
+		txIn.SignatureScript = p.Inputs[i].FinalScriptSig
+		txIn.Witness = p.Inputs[i].FinalScriptWitness
+	}
+
+	return finalTx, nil
+}
+
+// readMap reads a single map of key value pairs, up to and including its
+// terminating separator.
+func readMap(r io.Reader) ([]*Unknown, error) {
+	var (
+		pairs []*Unknown
+		seen  = make(map[string]struct{})
+	)
+	for {
+		key, err := wire.ReadVarBytes(r, 0, MaxPsbtKeyLength, "key")
+		if err != nil {
+			return nil, err
+		}
+
+		// A zero length key marks the end of the map.
+		if len(key) == 0 {
+			return pairs, nil
+		}
+
+		if _, ok := seen[string(key)]; ok {
+			return nil, ErrDuplicateKey
+		}
+		seen[string(key)] = struct{}{}
+
+		value, err := wire.ReadVarBytes(
+			r, 0, MaxPsbtValueLength, "value",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		pairs = append(pairs, &Unknown{
+			Key:   key,
+			Value: value,
+		})
+	}
+}
+
+// writeMap writes the given key value pairs followed by the map separator.
+func writeMap(w io.Writer, pairs []*Unknown) error {
+	for _, pair := range pairs {
+		if len(pair.Key) == 0 {
+			return fmt.Errorf("PSBT keys must not be empty")
+		}
+		if err := wire.WriteVarBytes(w, 0, pair.Key); err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, 0, pair.Value); err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write([]byte{0x00})
+	return err
+}
+
+// readWitness reads a witness serialized as the number of its items followed
+// by each length prefixed item.
+func readWitness(r *bytes.Reader) (wire.TxWitness, error) {
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each item takes up at least a single byte, so we can bound the
+	// number of items by the remaining length of the value.
+	if numItems > uint64(r.Len()) {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	witness := make(wire.TxWitness, numItems)
+	for i := range witness {
+		witness[i], err = wire.ReadVarBytes(
+			r, 0, MaxPsbtValueLength, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return witness, nil
+}
+
+// writeWitness writes the given witness as the number of its items followed
+// by each length prefixed item.
+func writeWitness(w io.Writer, witness wire.TxWitness) error {
+	if err := wire.WriteVarInt(w, 0, uint64(len(witness))); err != nil {
+		return err
+	}
+	for _, item := range witness {
+		if err := wire.WriteVarBytes(w, 0, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
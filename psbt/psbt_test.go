@@ -0,0 +1,264 @@
+package psbt
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// testUnsignedTx returns an unsigned transaction with two inputs and a single
+// output.
+func testUnsignedTx() *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash:  chainhash.Hash{1},
+		Index: 0,
+	}, nil, nil))
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash:  chainhash.Hash{2},
+		Index: 1,
+	}, nil, nil))
+	tx.AddTxOut(wire.NewTxOut(100000, []byte{0x00, 0x20, 0x01}))
+
+	return tx
+}
+
+// assertPacketsEqual asserts that both packets are equal by comparing their
+// serializations. We can't compare them directly, as empty sigScripts are
+// decoded as empty rather than nil slices.
+func assertPacketsEqual(t *testing.T, expected, actual *Packet) {
+	t.Helper()
+
+	var expectedBytes, actualBytes bytes.Buffer
+	if err := expected.Serialize(&expectedBytes); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	if err := actual.Serialize(&actualBytes); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	if !bytes.Equal(expectedBytes.Bytes(), actualBytes.Bytes()) {
+		t.Fatalf("packet mismatch: expected %x, got %x",
+			expectedBytes.Bytes(), actualBytes.Bytes())
+	}
+}
+
+// TestPacketRoundTrip asserts that a PSBT survives a round trip through both
+// its binary and base64 serialization, including any unknown pairs.
+func TestPacketRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	packet, err := NewFromUnsignedTx(testUnsignedTx())
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, []byte{0x51}, nil))
+	prevTx.AddTxOut(wire.NewTxOut(60000, []byte{0x76, 0xa9}))
+
+	packet.Inputs[0].NonWitnessUtxo = prevTx
+	packet.Inputs[0].FinalScriptSig = []byte{0x01, 0x02}
+	packet.Inputs[1].WitnessUtxo = wire.NewTxOut(50000, []byte{0x00, 0x14})
+	packet.Inputs[1].Unknowns = []*Unknown{{
+		Key:   []byte{0x02, 0x03, 0x04},
+		Value: []byte{0x05},
+	}}
+	packet.Outputs[0].Unknowns = []*Unknown{{
+		Key:   []byte{0x01},
+		Value: []byte{0x06, 0x07},
+	}}
+	packet.Unknowns = []*Unknown{{
+		Key:   []byte{0xfc, 0x08},
+		Value: []byte{0x09},
+	}}
+
+	var b bytes.Buffer
+	if err := packet.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize packet: %v", err)
+	}
+	decoded, err := NewFromRawBytes(bytes.NewReader(b.Bytes()), false)
+	if err != nil {
+		t.Fatalf("unable to decode packet: %v", err)
+	}
+	assertPacketsEqual(t, packet, decoded)
+
+	// Ensure the fields we interpret were decoded as expected.
+	if decoded.Inputs[0].NonWitnessUtxo.TxHash() != prevTx.TxHash() {
+		t.Fatalf("non-witness utxo mismatch")
+	}
+	if !bytes.Equal(decoded.Inputs[0].FinalScriptSig, []byte{0x01, 0x02}) {
+		t.Fatalf("final sigScript mismatch")
+	}
+	if !reflect.DeepEqual(decoded.Inputs[1].WitnessUtxo,
+		packet.Inputs[1].WitnessUtxo) {
+
+		t.Fatalf("witness utxo mismatch")
+	}
+	if !reflect.DeepEqual(decoded.Inputs[1].Unknowns,
+		packet.Inputs[1].Unknowns) {
+
+		t.Fatalf("input unknowns mismatch")
+	}
+	if !reflect.DeepEqual(decoded.Outputs, packet.Outputs) {
+		t.Fatalf("outputs mismatch")
+	}
+	if !reflect.DeepEqual(decoded.Unknowns, packet.Unknowns) {
+		t.Fatalf("global unknowns mismatch")
+	}
+
+	b64, err := packet.B64Encode()
+	if err != nil {
+		t.Fatalf("unable to encode packet: %v", err)
+	}
+	decoded, err = NewFromRawBytes(bytes.NewReader([]byte(b64)), true)
+	if err != nil {
+		t.Fatalf("unable to decode packet: %v", err)
+	}
+	assertPacketsEqual(t, packet, decoded)
+}
+
+// TestPacketNoInputs asserts that a PSBT whose unsigned transaction doesn't
+// have any inputs yet can be decoded, as is the case for PSBTs that merely
+// describe the outputs to be funded.
+func TestPacketNoInputs(t *testing.T) {
+	t.Parallel()
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(wire.NewTxOut(100000, []byte{0x00, 0x20, 0x01}))
+
+	packet, err := NewFromUnsignedTx(tx)
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+
+	b64, err := packet.B64Encode()
+	if err != nil {
+		t.Fatalf("unable to encode packet: %v", err)
+	}
+	decoded, err := NewFromRawBytes(bytes.NewReader([]byte(b64)), true)
+	if err != nil {
+		t.Fatalf("unable to decode packet: %v", err)
+	}
+	assertPacketsEqual(t, packet, decoded)
+}
+
+// TestPacketInvalid asserts that malformed PSBTs are rejected.
+func TestPacketInvalid(t *testing.T) {
+	t.Parallel()
+
+	var unsignedTx bytes.Buffer
+	if err := testUnsignedTx().SerializeNoWitness(&unsignedTx); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+
+	// serialize returns the serialization of a PSBT with the given global
+	// pairs, followed by the given raw bytes.
+	serialize := func(globals []*Unknown, rest []byte) []byte {
+		var b bytes.Buffer
+		b.Write(magic[:])
+		if err := writeMap(&b, globals); err != nil {
+			t.Fatalf("unable to write map: %v", err)
+		}
+		b.Write(rest)
+		return b.Bytes()
+	}
+
+	txPair := &Unknown{
+		Key:   []byte{globalUnsignedTxType},
+		Value: unsignedTx.Bytes(),
+	}
+
+	testCases := []struct {
+		name        string
+		raw         []byte
+		expectedErr error
+	}{
+		{
+			name:        "invalid magic",
+			raw:         []byte{0x70, 0x73, 0x62, 0x74, 0x00, 0x00},
+			expectedErr: ErrInvalidMagicBytes,
+		},
+		{
+			name:        "missing unsigned tx",
+			raw:         serialize(nil, nil),
+			expectedErr: ErrInvalidPsbtFormat,
+		},
+		{
+			name: "duplicate key",
+			raw: serialize(
+				[]*Unknown{txPair, txPair}, nil,
+			),
+			expectedErr: ErrDuplicateKey,
+		},
+		{
+			name: "unsigned tx key data",
+			raw: serialize([]*Unknown{{
+				Key:   []byte{globalUnsignedTxType, 0x01},
+				Value: unsignedTx.Bytes(),
+			}}, nil),
+			expectedErr: ErrInvalidKeyData,
+		},
+		{
+			name: "input key data",
+			raw: serialize([]*Unknown{txPair}, []byte{
+				0x02, inputWitnessUtxoType, 0x01, 0x01, 0x00,
+				0x00, 0x00, 0x00,
+			}),
+			expectedErr: ErrInvalidKeyData,
+		},
+	}
+
+	for _, test := range testCases {
+		_, err := NewFromRawBytes(bytes.NewReader(test.raw), false)
+		if err != test.expectedErr {
+			t.Fatalf("%v: expected error %v, got %v", test.name,
+				test.expectedErr, err)
+		}
+	}
+}
+
+// TestExtract asserts that the final transaction can only be extracted once
+// all inputs have been finalized, and that it carries the final sigScripts
+// and witnesses.
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	packet, err := NewFromUnsignedTx(testUnsignedTx())
+	if err != nil {
+		t.Fatalf("unable to create packet: %v", err)
+	}
+
+	packet.Inputs[0].FinalScriptWitness = wire.TxWitness{{0x01}, {0x02}}
+	if _, err := Extract(packet); err != ErrIncompletePSBT {
+		t.Fatalf("expected ErrIncompletePSBT, got %v", err)
+	}
+
+	packet.Inputs[1].FinalScriptSig = []byte{0x03}
+	packet.Inputs[1].FinalScriptWitness = wire.TxWitness{{0x04}}
+	finalTx, err := Extract(packet)
+	if err != nil {
+		t.Fatalf("unable to extract final tx: %v", err)
+	}
+
+	// As the second input carries a sigScript, the txid of the final
+	// transaction should differ from that of the unsigned transaction.
+	if finalTx.TxHash() == packet.UnsignedTx.TxHash() {
+		t.Fatalf("expected txid to change due to sigScript")
+	}
+	if !reflect.DeepEqual(finalTx.TxIn[0].Witness,
+		packet.Inputs[0].FinalScriptWitness) {
+
+		t.Fatalf("final witness not set")
+	}
+	if !bytes.Equal(finalTx.TxIn[1].SignatureScript, []byte{0x03}) {
+		t.Fatalf("final sigScript not set")
+	}
+
+	// The unsigned transaction of the packet must remain untouched.
+	if err := checkUnsignedTx(packet.UnsignedTx); err != nil {
+		t.Fatalf("unsigned tx was modified: %v", err)
+	}
+}
@@ -51,6 +51,14 @@ const (
 	// ErrFeeLimitExceeded is returned when the total fees of a route exceed
 	// the user-specified fee limit.
 	ErrFeeLimitExceeded
+
+	// ErrHopFeeLimitExceeded is returned when the fee charged by a single
+	// hop of a route exceeds the user-specified per-hop fee limits.
+	ErrHopFeeLimitExceeded
+
+	// ErrCltvLimitExceeded is returned when the total time lock of a route
+	// exceeds the user-specified CLTV limit.
+	ErrCltvLimitExceeded
 )
 
 // routerError is a structure that represent the error inside the routing package,
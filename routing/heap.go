@@ -23,6 +23,10 @@ type nodeWithDist struct {
 
 	// fee is the fee that this node is charging for forwarding.
 	fee lnwire.MilliSatoshi
+
+	// timeLockDelta is the accumulated time lock delta of the route from
+	// this node to the target, including the final CLTV delta.
+	timeLockDelta uint32
 }
 
 // distanceHeap is a min-distance heap that's used within our path finding
@@ -2,6 +2,7 @@ package routing
 
 import (
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -28,6 +29,19 @@ const (
 	//
 	// TODO(roasbeef): instead use random delay on each?
 	edgeDecay = time.Duration(time.Second * 5)
+
+	// aprioriHopProbability is the assumed success probability of a hop
+	// for which we have no failure history.
+	aprioriHopProbability = 0.95
+
+	// penaltyHalfLife is the time after which the penalty applied to the
+	// success probability of a failed edge or vertex has halved. Failures
+	// are forgotten entirely after historyDecay.
+	penaltyHalfLife = time.Duration(time.Hour)
+
+	// historyDecay is the period after which a failure is removed from the
+	// history used to estimate success probabilities.
+	historyDecay = time.Duration(time.Hour * 24)
 )
 
 // missionControl contains state which summarizes the past attempts of HTLC
@@ -55,6 +69,17 @@ type missionControl struct {
 	// to that particular vertex.
 	failedVertexes map[Vertex]time.Time
 
+	// edgeFailureHistory maps a short channel ID to the time of its last
+	// reported failure. Unlike failedEdges, entries are kept around for
+	// historyDecay, and are used to estimate success probabilities.
+	edgeFailureHistory map[uint64]time.Time
+
+	// vertexFailureHistory maps a node's public key to the time of its
+	// last reported failure. Unlike failedVertexes, entries are kept
+	// around for historyDecay, and are used to estimate success
+	// probabilities.
+	vertexFailureHistory map[Vertex]time.Time
+
 	graph *channeldb.ChannelGraph
 
 	selfNode *channeldb.LightningNode
@@ -76,12 +101,55 @@ func newMissionControl(g *channeldb.ChannelGraph, selfNode *channeldb.LightningN
 	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi) *missionControl {
 
 	return &missionControl{
-		failedEdges:    make(map[uint64]time.Time),
-		failedVertexes: make(map[Vertex]time.Time),
-		selfNode:       selfNode,
-		queryBandwidth: qb,
-		graph:          g,
+		failedEdges:          make(map[uint64]time.Time),
+		failedVertexes:       make(map[Vertex]time.Time),
+		edgeFailureHistory:   make(map[uint64]time.Time),
+		vertexFailureHistory: make(map[Vertex]time.Time),
+		selfNode:             selfNode,
+		queryBandwidth:       qb,
+		graph:                g,
+	}
+}
+
+// EdgeProbability returns the estimated probability that fromNode will be
+// able to successfully forward an HTLC over the channel with the given ID.
+// Edges and vertexes without any recent failures are assigned the a priori
+// hop probability. A recent failure of either will penalize the probability,
+// with the penalty halving every penaltyHalfLife.
+//
+// NOTE: This is part of the ProbabilitySource interface.
+func (m *missionControl) EdgeProbability(fromNode Vertex, chanID uint64) float64 {
+	now := time.Now()
+
+	m.Lock()
+	defer m.Unlock()
+
+	prob := float64(aprioriHopProbability)
+	if failTime, ok := m.edgeFailureHistory[chanID]; ok {
+		if now.Sub(failTime) >= historyDecay {
+			delete(m.edgeFailureHistory, chanID)
+		} else {
+			prob *= failureRecovery(now.Sub(failTime))
+		}
+	}
+	if failTime, ok := m.vertexFailureHistory[fromNode]; ok {
+		if now.Sub(failTime) >= historyDecay {
+			delete(m.vertexFailureHistory, fromNode)
+		} else {
+			prob *= failureRecovery(now.Sub(failTime))
+		}
 	}
+
+	return prob
+}
+
+// failureRecovery returns the factor by which the success probability of an
+// edge or vertex that failed the given duration ago should be multiplied.
+// Right after the failure the factor is zero, recovering towards one as the
+// failure ages.
+func failureRecovery(sinceFailure time.Duration) float64 {
+	halfLives := float64(sinceFailure) / float64(penaltyHalfLife)
+	return 1 - math.Pow(2, -halfLives)
 }
 
 // graphPruneView is a filter of sorts that path finding routines should
@@ -305,8 +373,11 @@ func (p *paymentSession) ReportVertexFailure(v Vertex) {
 	// With the vertex added, we'll now report back to the global prune
 	// view, with this new piece of information so it can be utilized for
 	// new payment sessions.
+	now := time.Now()
+
 	p.mc.Lock()
-	p.mc.failedVertexes[v] = time.Now()
+	p.mc.failedVertexes[v] = now
+	p.mc.vertexFailureHistory[v] = now
 	p.mc.Unlock()
 }
 
@@ -326,8 +397,11 @@ func (p *paymentSession) ReportChannelFailure(e uint64) {
 	// With the edge added, we'll now report back to the global prune view,
 	// with this new piece of information so it can be utilized for new
 	// payment sessions.
+	now := time.Now()
+
 	p.mc.Lock()
-	p.mc.failedEdges[e] = time.Now()
+	p.mc.failedEdges[e] = now
+	p.mc.edgeFailureHistory[e] = now
 	p.mc.Unlock()
 }
 
@@ -340,7 +414,8 @@ func (p *paymentSession) ReportChannelFailure(e uint64) {
 //
 // NOTE: This function is safe for concurrent access.
 func (p *paymentSession) RequestRoute(payment *LightningPayment,
-	height uint32, finalCltvDelta uint16) (*Route, error) {
+	height uint32, finalCltvDelta uint16,
+	scorer EdgeScorer) (*Route, error) {
 
 	switch {
 	// If we have a set of pre-built routes, then we'll just pop off the
@@ -375,7 +450,8 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 	path, err := findPath(
 		nil, p.mc.graph, p.additionalEdges, p.mc.selfNode,
 		payment.Target, pruneView.vertexes, pruneView.edges,
-		payment.Amount, payment.FeeLimit, p.bandwidthHints,
+		payment.Amount, payment.FeeLimit, p.bandwidthHints, scorer,
+		payment.Constraints, finalCltvDelta,
 	)
 	if err != nil {
 		return nil, err
@@ -386,7 +462,7 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 	sourceVertex := Vertex(p.mc.selfNode.PubKeyBytes)
 	route, err := newRoute(
 		payment.Amount, payment.FeeLimit, sourceVertex, path, height,
-		finalCltvDelta,
+		finalCltvDelta, payment.Constraints,
 	)
 	if err != nil {
 		// TODO(roasbeef): return which edge/vertex didn't work
@@ -403,5 +479,7 @@ func (m *missionControl) ResetHistory() {
 	m.Lock()
 	m.failedEdges = make(map[uint64]time.Time)
 	m.failedVertexes = make(map[Vertex]time.Time)
+	m.edgeFailureHistory = make(map[uint64]time.Time)
+	m.vertexFailureHistory = make(map[Vertex]time.Time)
 	m.Unlock()
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"strings"
 
 	"container/heap"
 
@@ -28,7 +29,7 @@ const (
 	// RiskFactorBillionths controls the influence of time lock delta
 	// of a channel on route selection. It is expressed as billionths
 	// of msat per msat sent through the channel per time lock delta
-	// block. See edgeWeight function below for more details.
+	// block. See DefaultScorer for more details.
 	// The chosen value is based on the previous incorrect weight function
 	// 1 + timelock + fee * fee. In this function, the fee penalty
 	// diminishes the time lock penalty for all but the smallest amounts.
@@ -61,6 +62,91 @@ type HopHint struct {
 	CLTVExpiryDelta uint16
 }
 
+// RouteConstraints houses a set of optional limits which every hop of a route,
+// and the route as a whole, must satisfy. A zero value for any of the limits
+// means that it won't be enforced.
+type RouteConstraints struct {
+	// MaxHopFeeRate is the maximum effective fee rate, expressed in parts
+	// per million of the amount forwarded, that any single hop of the
+	// route may charge. The effective fee rate includes the base fee of
+	// the hop.
+	MaxHopFeeRate uint32
+
+	// MaxHopFee is the maximum absolute fee that any single hop of the
+	// route may charge.
+	MaxHopFee lnwire.MilliSatoshi
+
+	// CltvLimit is the maximum number of blocks the funds of the payment
+	// may be locked up for. This is the total time lock delta of the
+	// route, including the CLTV delta of the final hop.
+	CltvLimit uint32
+}
+
+// exceedsHopFee returns true if the given fee charged by a single hop exceeds
+// the max hop fee.
+func (c *RouteConstraints) exceedsHopFee(fee lnwire.MilliSatoshi) bool {
+	return c.MaxHopFee != 0 && fee > c.MaxHopFee
+}
+
+// hopFeeRate returns the effective fee rate, in parts per million, of a hop
+// charging the given fee to forward amt.
+func hopFeeRate(amt, fee lnwire.MilliSatoshi) uint64 {
+	if amt == 0 {
+		return 0
+	}
+
+	return uint64(fee) * 1000000 / uint64(amt)
+}
+
+// exceedsHopFeeRate returns true if the effective fee rate of a hop charging
+// the given fee to forward amt exceeds the max hop fee rate.
+func (c *RouteConstraints) exceedsHopFeeRate(amt,
+	fee lnwire.MilliSatoshi) bool {
+
+	return c.MaxHopFeeRate != 0 &&
+		hopFeeRate(amt, fee) > uint64(c.MaxHopFeeRate)
+}
+
+// exceedsCltvLimit returns true if the given total time lock delta of a route
+// exceeds the CLTV limit.
+func (c *RouteConstraints) exceedsCltvLimit(totalTimeLockDelta uint32) bool {
+	return c.CltvLimit != 0 && totalTimeLockDelta > c.CltvLimit
+}
+
+// constraintRejections tracks the number of edges that were rejected during
+// path finding due to violating the RouteConstraints of the payment. This
+// allows us to report why no path could be found.
+type constraintRejections struct {
+	constraints *RouteConstraints
+
+	hopFeeRate uint32
+	hopFee     uint32
+	cltv       uint32
+}
+
+// String returns a human readable summary of the rejected edges, or an empty
+// string if no edges were rejected.
+func (r *constraintRejections) String() string {
+	var reasons []string
+	if r.hopFeeRate != 0 {
+		reasons = append(reasons, fmt.Sprintf("%v channel(s) exceeded "+
+			"the max hop fee rate of %v ppm", r.hopFeeRate,
+			r.constraints.MaxHopFeeRate))
+	}
+	if r.hopFee != 0 {
+		reasons = append(reasons, fmt.Sprintf("%v channel(s) exceeded "+
+			"the max hop fee of %v", r.hopFee,
+			r.constraints.MaxHopFee))
+	}
+	if r.cltv != 0 {
+		reasons = append(reasons, fmt.Sprintf("%v channel(s) exceeded "+
+			"the CLTV limit of %v blocks", r.cltv,
+			r.constraints.CltvLimit))
+	}
+
+	return strings.Join(reasons, ", ")
+}
+
 // Hop represents an intermediate or final node of the route. This naming
 // is in line with the definition given in BOLT #4: Onion Routing Protocol.
 // The struct houses the channel along which this hop can be reached and
@@ -257,14 +343,15 @@ func (r *Route) ToHopPayloads() []sphinx.HopData {
 
 // newRoute returns a fully valid route between the source and target that's
 // capable of supporting a payment of `amtToSend` after fees are fully
-// computed. If the route is too long, or the selected path cannot support the
-// fully payment including fees, then a non-nil error is returned.
+// computed. If the route is too long, the selected path cannot support the
+// fully payment including fees, or the route violates any of the passed
+// constraints, then a non-nil error is returned.
 //
 // NOTE: The passed slice of ChannelHops MUST be sorted in forward order: from
 // the source to the target node of the path finding attempt.
 func newRoute(amtToSend, feeLimit lnwire.MilliSatoshi, sourceVertex Vertex,
 	pathEdges []*channeldb.ChannelEdgePolicy, currentHeight uint32,
-	finalCLTVDelta uint16) (*Route, error) {
+	finalCLTVDelta uint16, constraints RouteConstraints) (*Route, error) {
 
 	var (
 		hops []*Hop
@@ -309,6 +396,22 @@ func newRoute(amtToSend, feeLimit lnwire.MilliSatoshi, sourceVertex Vertex,
 			// is stored as part of the incoming channel of
 			// the next hop.
 			fee = computeFee(amtToForward, pathEdges[i+1])
+
+			// Ensure the fee charged by this hop is within our
+			// per-hop fee limits.
+			if constraints.exceedsHopFee(fee) {
+				err := fmt.Sprintf("hop fee of %v exceeds max "+
+					"hop fee of %v", fee,
+					constraints.MaxHopFee)
+				return nil, newErr(ErrHopFeeLimitExceeded, err)
+			}
+			if constraints.exceedsHopFeeRate(amtToForward, fee) {
+				err := fmt.Sprintf("hop fee rate of %v ppm "+
+					"exceeds max hop fee rate of %v ppm",
+					hopFeeRate(amtToForward, fee),
+					constraints.MaxHopFeeRate)
+				return nil, newErr(ErrHopFeeLimitExceeded, err)
+			}
 		}
 
 		// If this is the last hop, then for verification purposes, the
@@ -366,6 +469,15 @@ func newRoute(amtToSend, feeLimit lnwire.MilliSatoshi, sourceVertex Vertex,
 		return nil, newErrf(ErrFeeLimitExceeded, err)
 	}
 
+	// Invalidate this route if it would lock up our funds for longer than
+	// permitted.
+	totalTimeLockDelta := totalTimeLock - currentHeight
+	if constraints.exceedsCltvLimit(totalTimeLockDelta) {
+		err := fmt.Sprintf("total time lock delta of %v exceeds CLTV "+
+			"limit of %v", totalTimeLockDelta, constraints.CltvLimit)
+		return nil, newErr(ErrCltvLimitExceeded, err)
+	}
+
 	return newRoute, nil
 }
 
@@ -428,41 +540,30 @@ func (v Vertex) String() string {
 	return fmt.Sprintf("%x", v[:])
 }
 
-// edgeWeight computes the weight of an edge. This value is used when searching
-// for the shortest path within the channel graph between two nodes. Weight is
-// is the fee itself plus a time lock penalty added to it. This benefits
-// channels with shorter time lock deltas and shorter (hops) routes in general.
-// RiskFactor controls the influence of time lock on route selection. This is
-// currently a fixed value, but might be configurable in the future.
-func edgeWeight(lockedAmt lnwire.MilliSatoshi, fee lnwire.MilliSatoshi,
-	timeLockDelta uint16) int64 {
-	// timeLockPenalty is the penalty for the time lock delta of this channel.
-	// It is controlled by RiskFactorBillionths and scales proportional
-	// to the amount that will pass through channel. Rationale is that it if
-	// a twice as large amount gets locked up, it is twice as bad.
-	timeLockPenalty := int64(lockedAmt) * int64(timeLockDelta) *
-		RiskFactorBillionths / 1000000000
-
-	return int64(fee) + timeLockPenalty
-}
-
 // findPath attempts to find a path from the source node within the
 // ChannelGraph to the target node that's capable of supporting a payment of
-// `amt` value. The current approach implemented is modified version of
-// Dijkstra's algorithm to find a single shortest path between the source node
-// and the destination. The distance metric used for edges is related to the
-// time-lock+fee costs along a particular edge. If a path is found, this
-// function returns a slice of ChannelHop structs which encoded the chosen path
-// from the target to the source. The search is performed backwards from
-// destination node back to source. This is to properly accumulate fees
-// that need to be paid along the path and accurately check the amount
-// to forward at every node against the available bandwidth.
+// `amt` value while satisfying the passed constraints. The final CLTV delta is
+// used to account for the time lock of the final hop when enforcing the CLTV
+// limit of the constraints. The current approach implemented is modified
+// version of Dijkstra's algorithm to find a single shortest path between the
+// source node and the destination. The distance metric used for edges is determined by
+// the passed EdgeScorer. If a path is found, this function returns a slice of
+// ChannelHop structs which encoded the chosen path from the target to the
+// source. The search is performed backwards from destination node back to
+// source. This is to properly accumulate fees that need to be paid along the
+// path and accurately check the amount to forward at every node against the
+// available bandwidth. As the exact amount each hop must forward is known
+// while searching, the min HTLC, capacity and fee limit checks account for the
+// fees of all downstream hops, so newRoute won't reject a path returned by
+// findPath for violating them.
 func findPath(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 	additionalEdges map[Vertex][]*channeldb.ChannelEdgePolicy,
 	sourceNode *channeldb.LightningNode, target *btcec.PublicKey,
 	ignoredNodes map[Vertex]struct{}, ignoredEdges map[uint64]struct{},
 	amt lnwire.MilliSatoshi, feeLimit lnwire.MilliSatoshi,
-	bandwidthHints map[uint64]lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy, error) {
+	bandwidthHints map[uint64]lnwire.MilliSatoshi, scorer EdgeScorer,
+	constraints RouteConstraints,
+	finalCLTVDelta uint16) ([]*channeldb.ChannelEdgePolicy, error) {
 
 	var err error
 	if tx == nil {
@@ -535,6 +636,13 @@ func findPath(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 		node:            targetNode,
 		amountToReceive: amt,
 		fee:             0,
+		timeLockDelta:   uint32(finalCLTVDelta),
+	}
+
+	// rejections tracks the edges we skip due to our constraints, such
+	// that we can report the reason if no path could be found.
+	rejections := &constraintRejections{
+		constraints: &constraints,
 	}
 
 	// We'll use this map as a series of "next" hop pointers. So to get
@@ -613,11 +721,32 @@ func findPath(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 			return
 		}
 
+		// Ensure this node's fee is within our per-hop fee limits, and
+		// that the accumulated time lock of the route from this node
+		// to the target is within our CLTV limit.
+		if constraints.exceedsHopFee(fee) {
+			rejections.hopFee++
+			return
+		}
+		if constraints.exceedsHopFeeRate(amountToSend, fee) {
+			rejections.hopFeeRate++
+			return
+		}
+		totalTimeLockDelta := toNodeDist.timeLockDelta +
+			uint32(timeLockDelta)
+		if constraints.exceedsCltvLimit(totalTimeLockDelta) {
+			rejections.cltv++
+			return
+		}
+
 		// By adding fromNode in the route, there will be an extra
-		// weight composed of the fee that this node will charge and
-		// the amount that will be locked for timeLockDelta blocks in
-		// the HTLC that is handed out to fromNode.
-		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
+		// weight determined by our scorer. This is typically composed
+		// of the fee that this node will charge and the amount that
+		// will be locked for timeLockDelta blocks in the HTLC that is
+		// handed out to fromNode.
+		weight := scorer.EdgeWeight(
+			fromVertex, edge, amountToReceive, fee, timeLockDelta,
+		)
 
 		// Compute the tentative distance to this new channel/edge
 		// which is the distance from our toNode to the target node
@@ -649,6 +778,7 @@ func findPath(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 			node:            fromNode,
 			amountToReceive: amountToReceive,
 			fee:             fee,
+			timeLockDelta:   totalTimeLockDelta,
 		}
 
 		next[fromVertex] = edge
@@ -741,8 +871,11 @@ func findPath(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 	// If the source node isn't found in the next hop map, then a path
 	// doesn't exist, so we terminate in an error.
 	if _, ok := next[sourceVertex]; !ok {
-		return nil, newErrf(ErrNoPathFound, "unable to find a path to "+
-			"destination")
+		errStr := "unable to find a path to destination"
+		if reason := rejections.String(); reason != "" {
+			errStr += ": " + reason
+		}
+		return nil, newErr(ErrNoPathFound, errStr)
 	}
 
 	// Use the nextHop map to unravel the forward path from source to target.
@@ -786,7 +919,9 @@ func findPath(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 func findPaths(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 	source *channeldb.LightningNode, target *btcec.PublicKey,
 	amt lnwire.MilliSatoshi, feeLimit lnwire.MilliSatoshi, numPaths uint32,
-	bandwidthHints map[uint64]lnwire.MilliSatoshi) ([][]*channeldb.ChannelEdgePolicy, error) {
+	bandwidthHints map[uint64]lnwire.MilliSatoshi, scorer EdgeScorer,
+	constraints RouteConstraints,
+	finalCLTVDelta uint16) ([][]*channeldb.ChannelEdgePolicy, error) {
 
 	ignoredEdges := make(map[uint64]struct{})
 	ignoredVertexes := make(map[Vertex]struct{})
@@ -803,7 +938,8 @@ func findPaths(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 	// satoshis along the path before fees are calculated.
 	startingPath, err := findPath(
 		tx, graph, nil, source, target, ignoredVertexes, ignoredEdges,
-		amt, feeLimit, bandwidthHints,
+		amt, feeLimit, bandwidthHints, scorer, constraints,
+		finalCLTVDelta,
 	)
 	if err != nil {
 		log.Errorf("Unable to find path: %v", err)
@@ -876,7 +1012,8 @@ func findPaths(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 			spurPath, err := findPath(
 				tx, graph, nil, spurNode, target,
 				ignoredVertexes, ignoredEdges, amt, feeLimit,
-				bandwidthHints,
+				bandwidthHints, scorer, constraints,
+				finalCLTVDelta,
 			)
 
 			// If we weren't able to find a path, we'll continue to
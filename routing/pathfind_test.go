@@ -60,6 +60,10 @@ var (
 		BitcoinSig1Bytes: testSig.Serialize(),
 		BitcoinSig2Bytes: testSig.Serialize(),
 	}
+
+	// noConstraints is a set of route constraints which doesn't enforce
+	// any per-hop fee or CLTV limits.
+	noConstraints = RouteConstraints{}
 )
 
 // testGraph is the struct which corresponds to the JSON format used to encode
@@ -580,13 +584,14 @@ func TestFindLowestFeePath(t *testing.T) {
 	path, err := findPath(
 		nil, testGraphInstance.graph, nil, sourceNode, target,
 		ignoredVertexes, ignoredEdges, paymentAmt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
 	}
 	route, err := newRoute(
 		paymentAmt, infinity, sourceVertex, path, startingHeight,
-		finalHopCLTV)
+		finalHopCLTV, noConstraints)
 	if err != nil {
 		t.Fatalf("unable to create path: %v", err)
 	}
@@ -719,6 +724,7 @@ func testBasicGraphPathFindingCase(t *testing.T, graphInstance *testGraphInstanc
 	path, err := findPath(
 		nil, graphInstance.graph, nil, sourceNode, target,
 		ignoredVertexes, ignoredEdges, paymentAmt, test.feeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if test.expectFailureNoPath {
 		if err == nil {
@@ -732,7 +738,7 @@ func testBasicGraphPathFindingCase(t *testing.T, graphInstance *testGraphInstanc
 
 	route, err := newRoute(
 		paymentAmt, test.feeLimit, sourceVertex, path, startingHeight,
-		finalHopCLTV,
+		finalHopCLTV, noConstraints,
 	)
 	if err != nil {
 		t.Fatalf("unable to create path: %v", err)
@@ -907,6 +913,7 @@ func TestPathFindingWithAdditionalEdges(t *testing.T) {
 	path, err := findPath(
 		nil, graph.graph, additionalEdges, sourceNode, dogePubKey, nil, nil,
 		paymentAmt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if err != nil {
 		t.Fatalf("unable to find private path to doge: %v", err)
@@ -944,6 +951,7 @@ func TestKShortestPathFinding(t *testing.T) {
 	paths, err := findPaths(
 		nil, graph.graph, sourceNode, target, paymentAmt, noFeeLimit, 100,
 		nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if err != nil {
 		t.Fatalf("unable to find paths between roasbeef and "+
@@ -1218,7 +1226,7 @@ func TestNewRoute(t *testing.T) {
 			route, err := newRoute(testCase.paymentAmount,
 				testCase.feeLimit,
 				sourceVertex, testCase.hops, startingHeight,
-				finalHopCLTV)
+				finalHopCLTV, noConstraints)
 
 			if testCase.expectError {
 				expectedCode := testCase.expectedErrorCode
@@ -1267,6 +1275,7 @@ func TestNewRoutePathTooLong(t *testing.T) {
 	_, err = findPath(
 		nil, graph.graph, nil, sourceNode, target, ignoredVertexes,
 		ignoredEdges, paymentAmt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if err != nil {
 		t.Fatalf("path should have been found")
@@ -1278,6 +1287,7 @@ func TestNewRoutePathTooLong(t *testing.T) {
 	path, err := findPath(
 		nil, graph.graph, nil, sourceNode, target, ignoredVertexes,
 		ignoredEdges, paymentAmt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if err == nil {
 		t.Fatalf("should not have been able to find path, supposed to be "+
@@ -1320,6 +1330,7 @@ func TestPathNotAvailable(t *testing.T) {
 	_, err = findPath(
 		nil, graph.graph, nil, sourceNode, unknownNode, ignoredVertexes,
 		ignoredEdges, 100, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if !IsError(err, ErrNoPathFound) {
 		t.Fatalf("path shouldn't have been found: %v", err)
@@ -1356,6 +1367,7 @@ func TestPathInsufficientCapacity(t *testing.T) {
 	_, err = findPath(
 		nil, graph.graph, nil, sourceNode, target, ignoredVertexes,
 		ignoredEdges, payAmt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if !IsError(err, ErrNoPathFound) {
 		t.Fatalf("graph shouldn't be able to support payment: %v", err)
@@ -1388,12 +1400,141 @@ func TestRouteFailMinHTLC(t *testing.T) {
 	_, err = findPath(
 		nil, graph.graph, nil, sourceNode, target, ignoredVertexes,
 		ignoredEdges, payAmt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if !IsError(err, ErrNoPathFound) {
 		t.Fatalf("graph shouldn't be able to support payment: %v", err)
 	}
 }
 
+// TestPathFindingExactAmounts asserts that the min HTLC and capacity checks
+// performed during path finding take into account the fees of all downstream
+// hops, as the exact amount to forward at every hop is known while searching
+// backwards from the target.
+func TestPathFindingExactAmounts(t *testing.T) {
+	t.Parallel()
+
+	const (
+		startingHeight = 100
+		finalHopCLTV   = 1
+	)
+
+	// The payment will pass through either a and b, with a total fee of
+	// 2 satoshis, or through c with a fee of 5 satoshis. When going
+	// through a, the a -> b channel will need to carry the payment amount
+	// plus the fee charged by b.
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	amtWithFee := paymentAmt + 1000
+
+	testCases := []struct {
+		name       string
+		abCapacity btcutil.Amount
+		abMinHTLC  lnwire.MilliSatoshi
+		expected   string
+	}{
+		{
+			// The min HTLC of the a -> b channel is only satisfied
+			// once the fee of b is accounted for.
+			name:       "min htlc including fee",
+			abCapacity: 100000,
+			abMinHTLC:  amtWithFee,
+			expected:   "a",
+		},
+		{
+			name:       "min htlc exceeded",
+			abCapacity: 100000,
+			abMinHTLC:  amtWithFee + 1,
+			expected:   "c",
+		},
+		{
+			// The capacity of the a -> b channel is sufficient for
+			// the payment amount, but not once the fee of b is
+			// added to it.
+			name:       "capacity excluding fee",
+			abCapacity: paymentAmt.ToSatoshis(),
+			abMinHTLC:  1,
+			expected:   "c",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			testChannels := []*testChannel{
+				symmetricTestChannel("roasbeef", "a", 100000,
+					&testChannelPolicy{
+						Expiry:  144,
+						MinHTLC: 1,
+					}),
+				symmetricTestChannel("a", "b", test.abCapacity,
+					&testChannelPolicy{
+						Expiry:      144,
+						FeeBaseMsat: 1000,
+						MinHTLC:     test.abMinHTLC,
+					}),
+				symmetricTestChannel("b", "target", 100000,
+					&testChannelPolicy{
+						Expiry:      144,
+						FeeBaseMsat: 1000,
+						MinHTLC:     1,
+					}),
+				symmetricTestChannel("roasbeef", "c", 100000,
+					&testChannelPolicy{
+						Expiry:  144,
+						MinHTLC: 1,
+					}),
+				symmetricTestChannel("c", "target", 100000,
+					&testChannelPolicy{
+						Expiry:      144,
+						FeeBaseMsat: 5000,
+						MinHTLC:     1,
+					}),
+			}
+
+			graph, err := createTestGraphFromChannels(testChannels)
+			if err != nil {
+				t.Fatalf("unable to create graph: %v", err)
+			}
+			defer graph.cleanUp()
+
+			sourceNode, err := graph.graph.SourceNode()
+			if err != nil {
+				t.Fatalf("unable to fetch source node: %v",
+					err)
+			}
+			sourceVertex := Vertex(sourceNode.PubKeyBytes)
+
+			path, err := findPath(
+				nil, graph.graph, nil, sourceNode,
+				graph.aliasMap["target"], nil, nil, paymentAmt,
+				noFeeLimit, nil, &CheapestScorer{},
+				noConstraints, finalHopCLTV,
+			)
+			if err != nil {
+				t.Fatalf("unable to find path: %v", err)
+			}
+
+			// The path found should always be accepted by
+			// newRoute, as it performs the same checks.
+			route, err := newRoute(
+				paymentAmt, noFeeLimit, sourceVertex, path,
+				startingHeight, finalHopCLTV, noConstraints,
+			)
+			if err != nil {
+				t.Fatalf("unable to create route: %v", err)
+			}
+
+			firstHop := getAliasFromPubKey(
+				route.Hops[0].PubKeyBytes[:], graph.aliasMap,
+			)
+			if firstHop != test.expected {
+				t.Fatalf("expected route through %v, got %v",
+					test.expected, firstHop)
+			}
+		})
+	}
+}
+
 // TestRouteFailDisabledEdge tests that if we attempt to route to an edge
 // that's disabled, then that edge is disqualified, and the routing attempt
 // will fail.
@@ -1420,6 +1561,7 @@ func TestRouteFailDisabledEdge(t *testing.T) {
 	_, err = findPath(
 		nil, graph.graph, nil, sourceNode, target, ignoredVertexes,
 		ignoredEdges, payAmt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
@@ -1441,12 +1583,226 @@ func TestRouteFailDisabledEdge(t *testing.T) {
 	_, err = findPath(
 		nil, graph.graph, nil, sourceNode, target, ignoredVertexes,
 		ignoredEdges, payAmt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if !IsError(err, ErrNoPathFound) {
 		t.Fatalf("graph shouldn't be able to support payment: %v", err)
 	}
 }
 
+// TestRouteConstraints asserts that path finding respects the per-hop fee and
+// CLTV limits of a payment, and that the reason for rejecting any edges is
+// reported if no path could be found.
+func TestRouteConstraints(t *testing.T) {
+	t.Parallel()
+
+	// Within the scoring graph, a payment of 10k satoshis will incur an
+	// effective fee rate of 11 ppm through songoku with a time lock delta
+	// of 144, 1100 ppm through satoshi with a delta of 9, and 110 ppm
+	// through luoji with a delta of 40.
+	payAmt := lnwire.NewMSatFromSatoshis(10000)
+
+	testCases := []struct {
+		name           string
+		scorer         EdgeScorer
+		constraints    RouteConstraints
+		finalCLTVDelta uint16
+		expected       string
+		expectedErr    string
+	}{
+		{
+			name:     "fastest unconstrained",
+			scorer:   &FastestScorer{},
+			expected: "satoshi",
+		},
+		{
+			name:   "max hop fee rate",
+			scorer: &FastestScorer{},
+			constraints: RouteConstraints{
+				MaxHopFeeRate: 500,
+			},
+			expected: "luoji",
+		},
+		{
+			name:   "max hop fee",
+			scorer: &FastestScorer{},
+			constraints: RouteConstraints{
+				MaxHopFee: 1000,
+			},
+			expected: "songoku",
+		},
+		{
+			name:     "cheapest unconstrained",
+			scorer:   &CheapestScorer{},
+			expected: "songoku",
+		},
+		{
+			name:   "cltv limit",
+			scorer: &CheapestScorer{},
+			constraints: RouteConstraints{
+				CltvLimit: 100,
+			},
+			expected: "luoji",
+		},
+		{
+			// The final CLTV delta should count towards the limit,
+			// pushing the route through luoji over it.
+			name:   "cltv limit with final delta",
+			scorer: &CheapestScorer{},
+			constraints: RouteConstraints{
+				CltvLimit: 50,
+			},
+			finalCLTVDelta: 20,
+			expected:       "satoshi",
+		},
+		{
+			name:   "no path",
+			scorer: &CheapestScorer{},
+			constraints: RouteConstraints{
+				MaxHopFeeRate: 100,
+				CltvLimit:     20,
+			},
+			expectedErr: "2 channel(s) exceeded the max hop fee " +
+				"rate of 100 ppm, 1 channel(s) exceeded the " +
+				"CLTV limit of 20 blocks",
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			graph, err := parseTestGraph(scoringGraphFilePath)
+			if err != nil {
+				t.Fatalf("unable to create graph: %v", err)
+			}
+			defer graph.cleanUp()
+
+			sourceNode, err := graph.graph.SourceNode()
+			if err != nil {
+				t.Fatalf("unable to fetch source node: %v",
+					err)
+			}
+
+			path, err := findPath(
+				nil, graph.graph, nil, sourceNode,
+				graph.aliasMap["sophon"], nil, nil, payAmt,
+				noFeeLimit, nil, test.scorer, test.constraints,
+				test.finalCLTVDelta,
+			)
+			if test.expectedErr != "" {
+				if !IsError(err, ErrNoPathFound) {
+					t.Fatalf("expected no path, got: %v",
+						err)
+				}
+				if !strings.Contains(err.Error(),
+					test.expectedErr) {
+
+					t.Fatalf("expected error to contain "+
+						"%q, got %q", test.expectedErr,
+						err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unable to find path: %v", err)
+			}
+
+			if path[0].Node.Alias != test.expected {
+				t.Fatalf("expected route through %v, got %v",
+					test.expected, path[0].Node.Alias)
+			}
+		})
+	}
+}
+
+// TestNewRouteConstraints asserts that newRoute rejects routes which violate
+// the per-hop fee and CLTV limits of a payment.
+func TestNewRouteConstraints(t *testing.T) {
+	t.Parallel()
+
+	var sourceVertex Vertex
+
+	const (
+		startingHeight = 100
+		finalHopCLTV   = 10
+	)
+
+	payAmt := lnwire.NewMSatFromSatoshis(10000)
+	hops := []*channeldb.ChannelEdgePolicy{
+		{
+			Node:          &channeldb.LightningNode{},
+			TimeLockDelta: 40,
+		},
+		{
+			Node:                      &channeldb.LightningNode{},
+			FeeBaseMSat:               100,
+			FeeProportionalMillionths: 100,
+			TimeLockDelta:             40,
+		},
+	}
+
+	// The first hop will charge an effective fee rate of 110 ppm for a
+	// total fee of 1100 msat, and the route will have a total time lock
+	// delta of 50 blocks.
+	testCases := []struct {
+		name         string
+		constraints  RouteConstraints
+		expectError  bool
+		expectedCode errorCode
+	}{
+		{
+			name: "within limits",
+			constraints: RouteConstraints{
+				MaxHopFeeRate: 110,
+				MaxHopFee:     1100,
+				CltvLimit:     50,
+			},
+		},
+		{
+			name: "max hop fee rate",
+			constraints: RouteConstraints{
+				MaxHopFeeRate: 109,
+			},
+			expectError:  true,
+			expectedCode: ErrHopFeeLimitExceeded,
+		},
+		{
+			name: "max hop fee",
+			constraints: RouteConstraints{
+				MaxHopFee: 1099,
+			},
+			expectError:  true,
+			expectedCode: ErrHopFeeLimitExceeded,
+		},
+		{
+			name: "cltv limit",
+			constraints: RouteConstraints{
+				CltvLimit: 49,
+			},
+			expectError:  true,
+			expectedCode: ErrCltvLimitExceeded,
+		},
+	}
+
+	for _, test := range testCases {
+		_, err := newRoute(
+			payAmt, noFeeLimit, sourceVertex, hops, startingHeight,
+			finalHopCLTV, test.constraints,
+		)
+		if !test.expectError {
+			if err != nil {
+				t.Fatalf("%v: unable to create route: %v",
+					test.name, err)
+			}
+			continue
+		}
+		if !IsError(err, test.expectedCode) {
+			t.Fatalf("%v: expected error code %v, got: %v",
+				test.name, test.expectedCode, err)
+		}
+	}
+}
+
 func TestPathInsufficientCapacityWithFee(t *testing.T) {
 	t.Parallel()
 
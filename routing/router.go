@@ -2,6 +2,7 @@ package routing
 
 import (
 	"bytes"
+	"crypto/rand"
 	"crypto/sha256"
 	"fmt"
 	"runtime"
@@ -34,6 +35,11 @@ const (
 	// if we should give up on a payment attempt. This will be used if a
 	// value isn't specified in the LightningNode struct.
 	defaultPayAttemptTimeout = time.Duration(time.Second * 60)
+
+	// DefaultChannelPruneExpiry is the default duration used to determine
+	// if a channel should be pruned as a zombie. Zombie channels which
+	// receive a channel update more recent than this can be resurrected.
+	DefaultChannelPruneExpiry = time.Duration(time.Hour * 24 * 14)
 )
 
 var (
@@ -77,9 +83,18 @@ type ChannelGraphSource interface {
 	IsPublicNode(node Vertex) (bool, error)
 
 	// IsKnownEdge returns true if the graph source already knows of the
-	// passed channel ID.
+	// passed channel ID either as a live or zombie edge.
 	IsKnownEdge(chanID lnwire.ShortChannelID) bool
 
+	// IsZombieEdge returns true if the graph source has marked the passed
+	// channel ID as a zombie edge. If so, the public keys of the two
+	// nodes of the channel are also returned.
+	IsZombieEdge(chanID lnwire.ShortChannelID) (bool, [33]byte, [33]byte)
+
+	// MarkEdgeLive clears an edge from the graph source's zombie index,
+	// deeming it as live.
+	MarkEdgeLive(chanID lnwire.ShortChannelID) error
+
 	// IsStaleEdgePolicy returns true if the graph source has a channel
 	// edge for the passed channel ID (and flags) that have a more recent
 	// timestamp.
@@ -164,6 +179,13 @@ type Config struct {
 		htlcAdd *lnwire.UpdateAddHTLC,
 		circuit *sphinx.Circuit) ([sha256.Size]byte, error)
 
+	// SendProbeToSwitch is a function that directs a link-layer switch to
+	// forward a fully encoded probe to the first hop in the route. Unlike
+	// SendToSwitch, the probe isn't tracked as a payment by the switch.
+	SendProbeToSwitch func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC,
+		circuit *sphinx.Circuit) ([sha256.Size]byte, error)
+
 	// ChannelPruneExpiry is the duration used to determine if a channel
 	// should be pruned or not. If the delta between now and when the
 	// channel was last updated is greater than ChannelPruneExpiry, then
@@ -187,6 +209,11 @@ type Config struct {
 	// from blocking initial usage of the wallet. This should only be
 	// enabled on testnet.
 	AssumeChannelValid bool
+
+	// EdgeScorer is the cost function used to weigh edges during path
+	// finding. It is used for all path finding attempts, unless a payment
+	// specifies its own scorer. If nil, the DefaultScorer will be used.
+	EdgeScorer EdgeScorer
 }
 
 // routeTuple is an entry within the ChannelRouter's route cache. We cache
@@ -285,6 +312,13 @@ type ChannelRouter struct {
 	rejectMtx   sync.RWMutex
 	rejectCache map[uint64]struct{}
 
+	// pendingSplices tracks the channel edges which have been spliced,
+	// keyed by their prior funding output. They're kept within the graph
+	// until the continuation of the channel is announced, or the grace
+	// period of the splice expires.
+	spliceMtx      sync.Mutex
+	pendingSplices map[wire.OutPoint]*channeldb.SplicedEdge
+
 	sync.RWMutex
 
 	quit chan struct{}
@@ -307,6 +341,10 @@ func New(cfg Config) (*ChannelRouter, error) {
 		return nil, err
 	}
 
+	if cfg.EdgeScorer == nil {
+		cfg.EdgeScorer = &DefaultScorer{}
+	}
+
 	r := &ChannelRouter{
 		cfg:               &cfg,
 		networkUpdates:    make(chan *routingMsg),
@@ -316,6 +354,7 @@ func New(cfg Config) (*ChannelRouter, error) {
 		selfNode:          selfNode,
 		routeCache:        make(map[routeTuple][]*Route),
 		rejectCache:       make(map[uint64]struct{}),
+		pendingSplices:    make(map[wire.OutPoint]*channeldb.SplicedEdge),
 		quit:              make(chan struct{}),
 	}
 
@@ -392,6 +431,12 @@ func (r *ChannelRouter) Start() error {
 		}
 	}
 
+	// We'll also restore the splices whose continuation hasn't been
+	// announced yet, so their edges aren't pruned as closed channels.
+	if err := r.restorePendingSplices(); err != nil {
+		return err
+	}
+
 	// Before we begin normal operation of the router, we first need to
 	// synchronize the channel graph to the latest state of the UTXO set.
 	if err := r.syncGraphWithChain(); err != nil {
@@ -534,14 +579,11 @@ func (r *ChannelRouter) syncGraphWithChain() error {
 
 		// We're only interested in all prior outputs that have been
 		// spent in the block, so collate all the referenced previous
-		// outpoints within each tx and input.
-		var spentOutputs []*wire.OutPoint
-		for _, tx := range filterBlock.Transactions {
-			for _, txIn := range tx.TxIn {
-				spentOutputs = append(spentOutputs,
-					&txIn.PreviousOutPoint)
-			}
-		}
+		// outpoints within each tx and input, skipping those of
+		// spliced channels.
+		spentOutputs := r.filterSpentOutputs(
+			filterBlock.Transactions, nextHeight,
+		)
 
 		// With the spent outputs gathered, attempt to prune the
 		// channel graph, also passing in the hash+height of the block
@@ -642,7 +684,7 @@ func (r *ChannelRouter) pruneZombieChans() error {
 	for _, chanToPrune := range chansToPrune {
 		log.Tracef("Pruning zombie chan ChannelPoint(%v)", chanToPrune)
 
-		err := r.cfg.Graph.DeleteChannelEdge(&chanToPrune)
+		err := r.cfg.Graph.DeleteChannelEdge(&chanToPrune, true)
 		if err != nil {
 			return fmt.Errorf("Unable to prune zombie "+
 				"chans: %v", err)
@@ -796,14 +838,10 @@ func (r *ChannelRouter) networkHandler() {
 			// We're only interested in all prior outputs that have
 			// been spent in the block, so collate all the
 			// referenced previous outpoints within each tx and
-			// input.
-			var spentOutputs []*wire.OutPoint
-			for _, tx := range chainUpdate.Transactions {
-				for _, txIn := range tx.TxIn {
-					spentOutputs = append(spentOutputs,
-						&txIn.PreviousOutPoint)
-				}
-			}
+			// input, skipping those of spliced channels.
+			spentOutputs := r.filterSpentOutputs(
+				chainUpdate.Transactions, blockHeight,
+			)
 
 			// With the spent outputs gathered, attempt to prune
 			// the channel graph, also passing in the hash+height
@@ -972,10 +1010,34 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 				"chan_id=%v", msg.ChannelID)
 		}
 
+		// If the channel was previously pruned as a zombie, we'll
+		// ignore its announcement until it has been resurrected by a
+		// fresh channel update.
+		isZombie, _, _, err := r.cfg.Graph.IsZombieEdge(msg.ChannelID)
+		if err != nil {
+			return errors.Errorf("unable to check zombie index: "+
+				"%v", err)
+		} else if isZombie {
+			return newErrf(ErrIgnored, "Ignoring msg for zombie "+
+				"chan_id=%v", msg.ChannelID)
+		}
+
+		// Edges of our zero-conf channels are identified by an alias
+		// until their funding transaction confirms, so they can't be
+		// validated against the chain.
+		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
+		if channelID.IsAlias() {
+			if err := r.addAliasEdge(msg); err != nil {
+				return err
+			}
+
+			invalidateCache = true
+			break
+		}
+
 		// Before we can add the channel to the channel graph, we need
 		// to obtain the full funding outpoint that's encoded within
 		// the channel ID.
-		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
 		fundingPoint, fundingTxOut, err := r.fetchChanPoint(&channelID)
 		if err != nil {
 			r.rejectMtx.Lock()
@@ -1049,6 +1111,10 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 			msg.NodeKey1Bytes, msg.NodeKey2Bytes,
 			fundingPoint, msg.ChannelID, msg.Capacity)
 
+		// If this channel is the continuation of a spliced channel,
+		// then the edge of the spliced channel can now be pruned.
+		r.completeSplice(msg)
+
 		// As a new edge has been added to the channel graph, we'll
 		// update the current UTXO filter within our active
 		// FilteredChainView so we are notified if/when this channel is
@@ -1095,6 +1161,22 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 
 		}
 
+		// If we don't know of the edge and it was previously pruned
+		// as a zombie, then we'll ignore any updates for it.
+		if !exists {
+			isZombie, _, _, err := r.cfg.Graph.IsZombieEdge(
+				msg.ChannelID,
+			)
+			if err != nil {
+				return errors.Errorf("unable to check zombie "+
+					"index: %v", err)
+			} else if isZombie {
+				return newErrf(ErrIgnored, "Ignoring update "+
+					"(flags=%v) for zombie chan_id=%v",
+					msg.Flags, msg.ChannelID)
+			}
+		}
+
 		// As edges are directional edge node has a unique policy for
 		// the direction of the edge they control. Therefore we first
 		// check if we already have the most up to date information for
@@ -1289,7 +1371,7 @@ func pathsToFeeSortedRoutes(source Vertex, paths [][]*channeldb.ChannelEdgePolic
 		// by our KSP algorithm.
 		route, err := newRoute(
 			amt, feeLimit, source, path[1:], currentHeight,
-			finalCLTVDelta,
+			finalCLTVDelta, RouteConstraints{},
 		)
 		if err != nil {
 			// TODO(roasbeef): report straw breaking edge?
@@ -1409,7 +1491,8 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	// our source to the destination.
 	shortestPaths, err := findPaths(
 		tx, r.cfg.Graph, r.selfNode, target, amt, feeLimit, numPaths,
-		bandwidthHints,
+		bandwidthHints, r.cfg.EdgeScorer, RouteConstraints{},
+		finalCLTVDelta,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -1563,6 +1646,16 @@ type LightningPayment struct {
 	// destination successfully.
 	RouteHints [][]HopHint
 
+	// EdgeScorer is an optional cost function used to weigh edges when
+	// finding a route for this payment. If nil, the EdgeScorer of the
+	// router's config will be used.
+	EdgeScorer EdgeScorer
+
+	// Constraints is an optional set of per-hop fee and CLTV limits that
+	// the route of this payment must satisfy, in addition to the total
+	// FeeLimit.
+	Constraints RouteConstraints
+
 	// TODO(roasbeef): add e2e message?
 }
 
@@ -1584,7 +1677,7 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte, *Route
 		return [32]byte{}, nil, err
 	}
 
-	return r.sendPayment(payment, paySession)
+	return r.sendPayment(payment, paySession, r.cfg.SendToSwitch, nil)
 }
 
 // SendToRoute attempts to send a payment as described within the passed
@@ -1601,9 +1694,152 @@ func (r *ChannelRouter) SendToRoute(routes []*Route,
 		routes,
 	)
 
-	return r.sendPayment(payment, paySession)
+	return r.sendPayment(payment, paySession, r.cfg.SendToSwitch, nil)
+}
+
+// ProbeAttempt describes a single probe HTLC that was dispatched towards the
+// destination of a probe, along with the outcome of that attempt.
+type ProbeAttempt struct {
+	// Route is the route that the probe HTLC was sent over.
+	Route *Route
+
+	// FailureSource is the node that reported the failure of this attempt.
+	// This will be nil if the attempt failed locally without an onion
+	// error being returned.
+	FailureSource *btcec.PublicKey
+
+	// Failure is the onion failure that was returned by FailureSource.
+	Failure lnwire.FailureMessage
+
+	// Latency is the time it took from handing the HTLC to the switch
+	// until the result of the attempt was known.
+	Latency time.Duration
+}
+
+// ProbeResult is the outcome of a call to ProbePayment.
+type ProbeResult struct {
+	// Success indicates that the final destination was reached and
+	// rejected the probe with an UnknownPaymentHash error. This means that
+	// the destination is able to receive the probed amount over Route.
+	Success bool
+
+	// Route is the route over which the destination was reached. This is
+	// only set if the probe was successful.
+	Route *Route
+
+	// Attempts is the full set of routes that were tried while probing,
+	// in the order that they were dispatched.
+	Attempts []*ProbeAttempt
+
+	// Latency is the total time the probe took, including path finding
+	// and all failed attempts.
+	Latency time.Duration
+
+	// Err is the reason the probe was not successful. This is nil if
+	// Success is true.
+	Err error
+}
+
+// ProbePayment attempts to determine whether the target of the passed
+// LightningPayment is able to receive the specified amount, without actually
+// sending any funds. To do so, HTLCs carrying a random payment hash are sent
+// through the regular payment retry loop. As the destination can't possibly
+// know the preimage of the random hash, a successful probe is signalled by
+// the final hop failing the HTLC with an UnknownPaymentHash error. Any
+// failures encountered along the way are reported to mission control, just
+// as they would be for a regular payment.
+//
+// NOTE: The PaymentHash of the passed LightningPayment is ignored, and
+// replaced with a randomly generated one. Probes are sent using
+// SendProbeToSwitch, so they aren't stored as payments.
+func (r *ChannelRouter) ProbePayment(payment *LightningPayment) (*ProbeResult,
+	error) {
+
+	probe := *payment
+	if _, err := rand.Read(probe.PaymentHash[:]); err != nil {
+		return nil, err
+	}
+
+	paySession, err := r.missionControl.NewPaymentSession(
+		probe.RouteHints, probe.Target,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll record each of the attempts made by the payment loop, so we
+	// can report them back to the caller once the probe is finished.
+	result := &ProbeResult{}
+	observer := func(route *Route, sendErr error, latency time.Duration) {
+		attempt := &ProbeAttempt{
+			Route:   route,
+			Latency: latency,
+		}
+		if fErr, ok := sendErr.(*htlcswitch.ForwardingError); ok {
+			attempt.FailureSource = fErr.ErrorSource
+			attempt.Failure = fErr.FailureMessage
+		}
+
+		result.Attempts = append(result.Attempts, attempt)
+	}
+
+	start := time.Now()
+	_, _, sendErr := r.sendPayment(
+		&probe, paySession, r.cfg.SendProbeToSwitch, observer,
+	)
+	result.Latency = time.Since(start)
+
+	// If the final attempt was failed by the target itself because it
+	// didn't know the payment hash, then we've successfully reached the
+	// destination.
+	var lastAttempt *ProbeAttempt
+	if len(result.Attempts) > 0 {
+		lastAttempt = result.Attempts[len(result.Attempts)-1]
+	}
+	switch {
+	case sendErr == nil:
+		// This should never happen, as the destination can't know the
+		// preimage for our random payment hash. Nevertheless, we
+		// reached the destination, so we'll treat it as a success.
+		log.Warnf("Probe with payment_hash=%x was settled by "+
+			"destination", probe.PaymentHash[:])
+
+		result.Success = true
+		result.Route = lastAttempt.Route
+
+	case lastAttempt != nil && lastAttempt.FailureSource != nil &&
+		lastAttempt.FailureSource.IsEqual(probe.Target):
+
+		_, ok := lastAttempt.Failure.(*lnwire.FailUnknownPaymentHash)
+		if !ok {
+			result.Err = sendErr
+			break
+		}
+
+		result.Success = true
+		result.Route = lastAttempt.Route
+
+	default:
+		result.Err = sendErr
+	}
+
+	log.Debugf("Probe of %v to %x finished after %v attempts (success=%v) "+
+		"in %v", probe.Amount, probe.Target.SerializeCompressed(),
+		len(result.Attempts), result.Success, result.Latency)
+
+	return result, nil
 }
 
+// switchSender is a function that hands an HTLC to the switch, to be forwarded
+// to the first hop in its route.
+type switchSender func(firstHop lnwire.ShortChannelID,
+	htlcAdd *lnwire.UpdateAddHTLC,
+	circuit *sphinx.Circuit) ([sha256.Size]byte, error)
+
+// attemptObserver is a function that is called with the route, the send
+// error (if any) and the latency of each HTLC attempt made by sendPayment.
+type attemptObserver func(route *Route, sendErr error, latency time.Duration)
+
 // sendPayment attempts to send a payment as described within the passed
 // LightningPayment. This function is blocking and will return either: when the
 // payment is successful, or all candidates routes have been attempted and
@@ -1611,8 +1847,13 @@ func (r *ChannelRouter) SendToRoute(routes []*Route,
 // will be returned which describes the path the successful payment traversed
 // within the network to reach the destination. Additionally, the payment
 // preimage will also be returned.
+//
+// Each HTLC attempt is handed to the switch using the passed sendToSwitch
+// function. If a non-nil observer is passed, then it'll be notified of every
+// HTLC attempt that is dispatched to the switch.
 func (r *ChannelRouter) sendPayment(payment *LightningPayment,
-	paySession *paymentSession) ([32]byte, *Route, error) {
+	paySession *paymentSession, sendToSwitch switchSender,
+	observer attemptObserver) ([32]byte, *Route, error) {
 
 	log.Tracef("Dispatching route for lightning payment: %v",
 		newLogClosure(func() string {
@@ -1656,6 +1897,11 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		finalCLTVDelta = *payment.FinalCLTVDelta
 	}
 
+	scorer := payment.EdgeScorer
+	if scorer == nil {
+		scorer = r.cfg.EdgeScorer
+	}
+
 	var payAttemptTimeout time.Duration
 	if payment.PayAttemptTimeout == time.Duration(0) {
 		payAttemptTimeout = defaultPayAttemptTimeout
@@ -1690,7 +1936,7 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		}
 
 		route, err := paySession.RequestRoute(
-			payment, uint32(currentHeight), finalCLTVDelta,
+			payment, uint32(currentHeight), finalCLTVDelta, scorer,
 		)
 		if err != nil {
 			// If we're unable to successfully make a payment using
@@ -1736,9 +1982,11 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		firstHop := lnwire.NewShortChanIDFromInt(
 			route.Hops[0].ChannelID,
 		)
-		preImage, sendError = r.cfg.SendToSwitch(
-			firstHop, htlcAdd, circuit,
-		)
+		attemptStart := time.Now()
+		preImage, sendError = sendToSwitch(firstHop, htlcAdd, circuit)
+		if observer != nil {
+			observer(route, sendError, time.Since(attemptStart))
+		}
 		if sendError != nil {
 			// An error occurred when attempting to send the
 			// payment, depending on the error type, we'll either
@@ -2234,13 +2482,48 @@ func (r *ChannelRouter) IsPublicNode(node Vertex) (bool, error) {
 	return r.cfg.Graph.IsPublicNode(node)
 }
 
+// EdgeProbability returns the estimated probability that fromNode will be able
+// to successfully forward an HTLC over the channel with the given ID, based on
+// the payment history gathered by mission control.
+//
+// NOTE: This is part of the ProbabilitySource interface.
+func (r *ChannelRouter) EdgeProbability(fromNode Vertex, chanID uint64) float64 {
+	return r.missionControl.EdgeProbability(fromNode, chanID)
+}
+
 // IsKnownEdge returns true if the graph source already knows of the passed
-// channel ID.
+// channel ID either as a live or zombie edge.
 //
 // NOTE: This method is part of the ChannelGraphSource interface.
 func (r *ChannelRouter) IsKnownEdge(chanID lnwire.ShortChannelID) bool {
 	_, _, exists, _ := r.cfg.Graph.HasChannelEdge(chanID.ToUint64())
-	return exists
+	if exists {
+		return true
+	}
+
+	isZombie, _, _, _ := r.cfg.Graph.IsZombieEdge(chanID.ToUint64())
+	return isZombie
+}
+
+// IsZombieEdge returns true if the graph source has marked the passed channel
+// ID as a zombie edge. If so, the public keys of the two nodes of the channel
+// are also returned.
+//
+// NOTE: This method is part of the ChannelGraphSource interface.
+func (r *ChannelRouter) IsZombieEdge(
+	chanID lnwire.ShortChannelID) (bool, [33]byte, [33]byte) {
+
+	isZombie, pubKey1, pubKey2, _ := r.cfg.Graph.IsZombieEdge(
+		chanID.ToUint64(),
+	)
+	return isZombie, pubKey1, pubKey2
+}
+
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+//
+// NOTE: This method is part of the ChannelGraphSource interface.
+func (r *ChannelRouter) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
+	return r.cfg.Graph.MarkEdgeLive(chanID.ToUint64())
 }
 
 // IsStaleEdgePolicy returns true if the graph soruce has a channel edge for
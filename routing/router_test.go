@@ -926,6 +926,140 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 	}
 }
 
+// TestProbePayment tests that a probe is considered successful once the final
+// destination fails it with an UnknownPaymentHash error, and that all
+// attempts made along the way are reported back.
+func TestProbePayment(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight, basicGraphFilePath)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	// Craft a LightningPayment struct that'll probe whether luo ji is able
+	// to receive 1000 satoshis from roasbeef.
+	payment := LightningPayment{
+		Target:   ctx.aliases["luoji"],
+		Amount:   lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit: noFeeLimit,
+	}
+
+	sourceNode, err := ctx.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+	sourcePub, err := sourceNode.PubKey()
+	if err != nil {
+		t.Fatalf("unable to fetch source node pub: %v", err)
+	}
+
+	// Probes shouldn't be sent as regular payments, as those are tracked
+	// by the switch.
+	ctx.router.cfg.SendToSwitch = func(_ lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+
+		t.Fatalf("probe sent as regular payment")
+		return [32]byte{}, nil
+	}
+
+	// We'll modify the SendProbeToSwitch method so that the direct channel
+	// to luo ji has insufficient capacity, while luo ji itself rejects any
+	// HTLC that reaches it over the route through satoshi as it doesn't
+	// know the payment hash.
+	roasbeefLuoji := lnwire.NewShortChanIDFromInt(689530843)
+	var probeHash [32]byte
+	ctx.router.cfg.SendProbeToSwitch = func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+
+		probeHash = htlcAdd.PaymentHash
+
+		if firstHop == roasbeefLuoji {
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    sourcePub,
+				FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+			}
+		}
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    ctx.aliases["luoji"],
+			FailureMessage: &lnwire.FailUnknownPaymentHash{},
+		}
+	}
+
+	ctx.router.missionControl.ResetHistory()
+
+	result, err := ctx.router.ProbePayment(&payment)
+	if err != nil {
+		t.Fatalf("unable to probe: %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("expected probe to succeed, instead failed: %v",
+			result.Err)
+	}
+
+	// A random payment hash should have been used for the probe.
+	if probeHash == payment.PaymentHash {
+		t.Fatalf("probe didn't use a random payment hash")
+	}
+
+	// Both the failed attempt over the direct channel and the successful
+	// one through satoshi should have been recorded.
+	if len(result.Attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %v", len(result.Attempts))
+	}
+	if !result.Attempts[0].FailureSource.IsEqual(sourcePub) {
+		t.Fatalf("wrong failure source for first attempt")
+	}
+	_, ok := result.Attempts[0].Failure.(*lnwire.FailTemporaryChannelFailure)
+	if !ok {
+		t.Fatalf("wrong failure for first attempt: %v",
+			result.Attempts[0].Failure)
+	}
+
+	// The successful route should have satoshi as the first hop.
+	if result.Route != result.Attempts[1].Route {
+		t.Fatalf("successful route doesn't match last attempt")
+	}
+	if !bytes.Equal(result.Route.Hops[0].PubKeyBytes[:],
+		ctx.aliases["satoshi"].SerializeCompressed()) {
+
+		t.Fatalf("route should go through satoshi as first hop, "+
+			"instead passes through: %v",
+			getAliasFromPubKey(result.Route.Hops[0].PubKeyBytes[:],
+				ctx.aliases))
+	}
+
+	// If the destination is unreachable, then the probe should fail with
+	// the error of the last attempt.
+	ctx.router.cfg.SendProbeToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    sourcePub,
+			FailureMessage: &lnwire.FailUnknownNextPeer{},
+		}
+	}
+
+	ctx.router.missionControl.ResetHistory()
+
+	result, err = ctx.router.ProbePayment(&payment)
+	if err != nil {
+		t.Fatalf("unable to probe: %v", err)
+	}
+	if result.Success {
+		t.Fatalf("expected probe to fail")
+	}
+	if result.Err == nil {
+		t.Fatalf("expected probe error to be set")
+	}
+	if len(result.Attempts) == 0 {
+		t.Fatalf("expected failed attempts to be recorded")
+	}
+}
+
 // TestAddProof checks that we can update the channel proof after channel
 // info was added to the database.
 func TestAddProof(t *testing.T) {
@@ -1873,6 +2007,7 @@ func TestFindPathFeeWeighting(t *testing.T) {
 	path, err := findPath(
 		nil, ctx.graph, nil, sourceNode, target, ignoreVertex,
 		ignoreEdge, amt, noFeeLimit, nil,
+		&DefaultScorer{}, noConstraints, 0,
 	)
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
@@ -2022,6 +2157,98 @@ func TestIsKnownEdge(t *testing.T) {
 	}
 }
 
+// TestZombieEdgeIgnored tests that the router ignores any announcements for
+// channels marked as zombies until they've been marked as live once again.
+func TestZombieEdgeIgnored(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxSingleNode(startingBlockHeight)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	var (
+		pub1 [33]byte
+		pub2 [33]byte
+	)
+	copy(pub1[:], priv1.PubKey().SerializeCompressed())
+	copy(pub2[:], priv2.PubKey().SerializeCompressed())
+
+	fundingTx, _, chanID, err := createChannelEdge(ctx,
+		bitcoinKey1.SerializeCompressed(),
+		bitcoinKey2.SerializeCompressed(),
+		10000, 500)
+	if err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	// We'll mark the channel as a zombie before the router learns of it.
+	err = ctx.graph.MarkEdgeZombie(chanID.ToUint64(), pub1, pub2)
+	if err != nil {
+		t.Fatalf("unable to mark edge as zombie: %v", err)
+	}
+
+	// The router should consider the edge as known, and report the node
+	// keys stored within the zombie index.
+	if !ctx.router.IsKnownEdge(*chanID) {
+		t.Fatalf("router should detect zombie edge as known")
+	}
+	isZombie, zombiePub1, zombiePub2 := ctx.router.IsZombieEdge(*chanID)
+	if !isZombie {
+		t.Fatalf("router should detect edge as zombie")
+	}
+	if zombiePub1 != pub1 || zombiePub2 != pub2 {
+		t.Fatalf("zombie edge has unexpected node keys")
+	}
+
+	// Both the channel announcement and any updates for it should be
+	// ignored.
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: pub1,
+		BitcoinKey2Bytes: pub2,
+		AuthProof:        nil,
+	}
+	err = ctx.router.AddEdge(edge)
+	if !IsError(err, ErrIgnored) {
+		t.Fatalf("expected to get ErrIgnored, instead got: %v", err)
+	}
+
+	edgePolicy := &channeldb.ChannelEdgePolicy{
+		SigBytes:                  testSig.Serialize(),
+		ChannelID:                 chanID.ToUint64(),
+		LastUpdate:                time.Now(),
+		TimeLockDelta:             10,
+		MinHTLC:                   1,
+		FeeBaseMSat:               10,
+		FeeProportionalMillionths: 10000,
+	}
+	err = ctx.router.UpdateEdge(edgePolicy)
+	if !IsError(err, ErrIgnored) {
+		t.Fatalf("expected to get ErrIgnored, instead got: %v", err)
+	}
+
+	// Once the edge has been marked as live, its announcement should be
+	// accepted once again.
+	if err := ctx.router.MarkEdgeLive(*chanID); err != nil {
+		t.Fatalf("unable to mark edge as live: %v", err)
+	}
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+	if err := ctx.router.UpdateEdge(edgePolicy); err != nil {
+		t.Fatalf("unable to update edge: %v", err)
+	}
+}
+
 // TestIsStaleEdgePolicy tests that the IsStaleEdgePolicy properly detects
 // stale channel edge update announcements.
 func TestIsStaleEdgePolicy(t *testing.T) {
@@ -0,0 +1,228 @@
+package routing
+
+import (
+	"math"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// reliabilityScale is the factor the negative log-probability of an
+	// edge is scaled by within the ReliabilityScorer. This allows us to
+	// express the weight as an integer while retaining enough precision
+	// to distinguish between edges with similar success probabilities.
+	reliabilityScale = 1000000
+
+	// minEdgeProbability is the lowest success probability that the
+	// ReliabilityScorer will assign to an edge. Clamping the probability
+	// ensures the weight of an edge is always finite, so edges that have
+	// recently failed are still considered as a last resort.
+	minEdgeProbability = 0.0001
+)
+
+// EdgeScorer is the cost function used during path finding to determine the
+// weight of an edge within the channel graph. The path with the lowest total
+// weight between the source and target node will be selected. Implementations
+// MUST return non-negative weights.
+type EdgeScorer interface {
+	// EdgeWeight returns the weight of traversing the given edge from
+	// fromNode. lockedAmt is the amount that will be locked within the
+	// HTLC offered to fromNode, fee is the fee that fromNode will charge
+	// to forward the payment over the edge, and timeLockDelta is the time
+	// lock delta that will be added to the route if fromNode is selected.
+	// If fromNode is the source of the payment, then both fee and
+	// timeLockDelta will be zero.
+	EdgeWeight(fromNode Vertex, edge *channeldb.ChannelEdgePolicy,
+		lockedAmt, fee lnwire.MilliSatoshi, timeLockDelta uint16) int64
+}
+
+// ProbabilitySource is an interface that abstracts the source of historical
+// payment information which can be used to estimate the probability that an
+// HTLC will successfully be forwarded over an edge.
+type ProbabilitySource interface {
+	// EdgeProbability returns the estimated probability, in the range
+	// [0, 1], that fromNode will be able to successfully forward an HTLC
+	// over the channel with the given ID.
+	EdgeProbability(fromNode Vertex, chanID uint64) float64
+}
+
+// DefaultScorer is the default EdgeScorer used by the ChannelRouter. The
+// weight of an edge is the fee itself plus a time lock penalty added to it.
+// This benefits channels with shorter time lock deltas and shorter (hops)
+// routes in general. RiskFactorBillionths controls the influence of time lock
+// on route selection.
+type DefaultScorer struct{}
+
+// A compile time check to ensure DefaultScorer meets the EdgeScorer
+// interface.
+var _ EdgeScorer = (*DefaultScorer)(nil)
+
+// EdgeWeight returns the weight of traversing the given edge from fromNode.
+//
+// NOTE: This is part of the EdgeScorer interface.
+func (s *DefaultScorer) EdgeWeight(_ Vertex, _ *channeldb.ChannelEdgePolicy,
+	lockedAmt, fee lnwire.MilliSatoshi, timeLockDelta uint16) int64 {
+
+	// timeLockPenalty is the penalty for the time lock delta of this
+	// channel. It is controlled by RiskFactorBillionths and scales
+	// proportional to the amount that will pass through channel.
+	// Rationale is that it if a twice as large amount gets locked up, it
+	// is twice as bad.
+	timeLockPenalty := int64(lockedAmt) * int64(timeLockDelta) *
+		RiskFactorBillionths / 1000000000
+
+	return int64(fee) + timeLockPenalty
+}
+
+// CheapestScorer is an EdgeScorer that selects the route with the lowest
+// total fee, disregarding the time lock of the route. The weight of an edge
+// is the fee in milli-satoshis charged to forward over it.
+type CheapestScorer struct{}
+
+// A compile time check to ensure CheapestScorer meets the EdgeScorer
+// interface.
+var _ EdgeScorer = (*CheapestScorer)(nil)
+
+// EdgeWeight returns the weight of traversing the given edge from fromNode.
+//
+// NOTE: This is part of the EdgeScorer interface.
+func (s *CheapestScorer) EdgeWeight(_ Vertex, _ *channeldb.ChannelEdgePolicy,
+	_, fee lnwire.MilliSatoshi, _ uint16) int64 {
+
+	return int64(fee)
+}
+
+// FastestScorer is an EdgeScorer that selects the route with the lowest total
+// time lock, disregarding the fees of the route. The weight of an edge is the
+// time lock delta in blocks that it adds to the route.
+type FastestScorer struct{}
+
+// A compile time check to ensure FastestScorer meets the EdgeScorer
+// interface.
+var _ EdgeScorer = (*FastestScorer)(nil)
+
+// EdgeWeight returns the weight of traversing the given edge from fromNode.
+//
+// NOTE: This is part of the EdgeScorer interface.
+func (s *FastestScorer) EdgeWeight(_ Vertex, _ *channeldb.ChannelEdgePolicy,
+	_, _ lnwire.MilliSatoshi, timeLockDelta uint16) int64 {
+
+	return int64(timeLockDelta)
+}
+
+// ReliabilityScorer is an EdgeScorer that selects the route which is most
+// likely to succeed based on the success probabilities reported by its
+// ProbabilitySource. The weight of an edge is its negative log-probability,
+// so the route with the lowest total weight is the route with the highest
+// product of its edge probabilities.
+type ReliabilityScorer struct {
+	// Source is used to query the success probability of each edge.
+	Source ProbabilitySource
+}
+
+// A compile time check to ensure ReliabilityScorer meets the EdgeScorer
+// interface.
+var _ EdgeScorer = (*ReliabilityScorer)(nil)
+
+// NewReliabilityScorer returns a new ReliabilityScorer backed by the given
+// ProbabilitySource.
+func NewReliabilityScorer(source ProbabilitySource) *ReliabilityScorer {
+	return &ReliabilityScorer{
+		Source: source,
+	}
+}
+
+// EdgeWeight returns the weight of traversing the given edge from fromNode.
+//
+// NOTE: This is part of the EdgeScorer interface.
+func (s *ReliabilityScorer) EdgeWeight(fromNode Vertex,
+	edge *channeldb.ChannelEdgePolicy, _, _ lnwire.MilliSatoshi,
+	_ uint16) int64 {
+
+	prob := s.Source.EdgeProbability(fromNode, edge.ChannelID)
+	switch {
+	case prob < minEdgeProbability:
+		prob = minEdgeProbability
+	case prob > 1:
+		prob = 1
+	}
+
+	return int64(-math.Log(prob) * reliabilityScale)
+}
+
+// ScorerWeight pairs an EdgeScorer with the weight of its contribution to a
+// WeightedScorer.
+type ScorerWeight struct {
+	// Scorer is the EdgeScorer whose weight will be blended.
+	Scorer EdgeScorer
+
+	// Weight is the factor by which the weight returned by Scorer is
+	// multiplied.
+	Weight float64
+}
+
+// WeightedScorer is an EdgeScorer which blends the weights of a set of
+// EdgeScorers. The weight of an edge is the sum of the weights returned by
+// each scorer, multiplied by their respective factor.
+type WeightedScorer []ScorerWeight
+
+// A compile time check to ensure WeightedScorer meets the EdgeScorer
+// interface.
+var _ EdgeScorer = (WeightedScorer)(nil)
+
+// NewWeightedScorer returns a WeightedScorer that blends the cheapest, fastest
+// and most reliable strategies. As the weights of these strategies are
+// expressed in different units (milli-satoshis of fees, blocks of time lock,
+// and millionths of negative log-probability respectively), the factors
+// should be chosen to express the relative cost of each unit. A factor of
+// zero excludes the strategy from the blend.
+func NewWeightedScorer(feeFactor, timeLockFactor, reliabilityFactor float64,
+	source ProbabilitySource) WeightedScorer {
+
+	var scorer WeightedScorer
+	if feeFactor != 0 {
+		scorer = append(scorer, ScorerWeight{
+			Scorer: &CheapestScorer{},
+			Weight: feeFactor,
+		})
+	}
+	if timeLockFactor != 0 {
+		scorer = append(scorer, ScorerWeight{
+			Scorer: &FastestScorer{},
+			Weight: timeLockFactor,
+		})
+	}
+	if reliabilityFactor != 0 {
+		scorer = append(scorer, ScorerWeight{
+			Scorer: NewReliabilityScorer(source),
+			Weight: reliabilityFactor,
+		})
+	}
+
+	return scorer
+}
+
+// EdgeWeight returns the weight of traversing the given edge from fromNode.
+//
+// NOTE: This is part of the EdgeScorer interface.
+func (s WeightedScorer) EdgeWeight(fromNode Vertex,
+	edge *channeldb.ChannelEdgePolicy, lockedAmt, fee lnwire.MilliSatoshi,
+	timeLockDelta uint16) int64 {
+
+	var weight float64
+	for _, component := range s {
+		componentWeight := component.Scorer.EdgeWeight(
+			fromNode, edge, lockedAmt, fee, timeLockDelta,
+		)
+		weight += float64(componentWeight) * component.Weight
+	}
+
+	// Negative factors could otherwise lead to a negative weight, which
+	// would break our path finding algorithm.
+	if weight < 0 {
+		return 0
+	}
+
+	return int64(weight)
+}
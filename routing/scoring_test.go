@@ -0,0 +1,249 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// scoringGraphFilePath is a file path which stores a graph containing three
+// distinct two-hop routes between roasbeef and sophon: a cheap route with a
+// large time lock through songoku, a fast route with large fees through
+// satoshi, and a balanced route through luoji.
+const scoringGraphFilePath = "testdata/scoring_graph.json"
+
+// mockProbabilitySource is a ProbabilitySource which reports the a priori hop
+// probability for all edges except those it has explicitly been told about.
+type mockProbabilitySource struct {
+	edgeProbs map[uint64]float64
+}
+
+// EdgeProbability returns the estimated probability that fromNode will be
+// able to successfully forward an HTLC over the channel with the given ID.
+func (m *mockProbabilitySource) EdgeProbability(_ Vertex, chanID uint64) float64 {
+	if prob, ok := m.edgeProbs[chanID]; ok {
+		return prob
+	}
+
+	return aprioriHopProbability
+}
+
+// TestEdgeScorerRouteSelection asserts that each of the built-in EdgeScorers
+// selects the route that matches its strategy.
+func TestEdgeScorerRouteSelection(t *testing.T) {
+	t.Parallel()
+
+	// Within the scoring graph, we'll mark the second hops of both the
+	// cheap and fast routes as unreliable.
+	unreliable := &mockProbabilitySource{
+		edgeProbs: map[uint64]float64{
+			2: 0.1,
+			4: 0.2,
+		},
+	}
+
+	// Within the basic graph, we'll mark the cheapest route from
+	// roasbeef to sophon through songoku as unreliable.
+	unreliableBasic := &mockProbabilitySource{
+		edgeProbs: map[uint64]float64{
+			3495345: 0.01,
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		graphPath string
+		target    string
+		amt       lnwire.MilliSatoshi
+		scorer    EdgeScorer
+		expected  []string
+	}{
+		{
+			name:      "default",
+			graphPath: scoringGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(10000),
+			scorer:    &DefaultScorer{},
+			expected:  []string{"songoku", "sophon"},
+		},
+		{
+			name:      "cheapest",
+			graphPath: scoringGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(10000),
+			scorer:    &CheapestScorer{},
+			expected:  []string{"songoku", "sophon"},
+		},
+		{
+			name:      "fastest",
+			graphPath: scoringGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(10000),
+			scorer:    &FastestScorer{},
+			expected:  []string{"satoshi", "sophon"},
+		},
+		{
+			name:      "most reliable",
+			graphPath: scoringGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(10000),
+			scorer:    NewReliabilityScorer(unreliable),
+			expected:  []string{"luoji", "sophon"},
+		},
+		{
+			// With a single block of time lock costing as much as
+			// 100 msat of fees, the balanced route is preferred.
+			name:      "weighted",
+			graphPath: scoringGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(10000),
+			scorer:    NewWeightedScorer(1, 100, 0, nil),
+			expected:  []string{"luoji", "sophon"},
+		},
+		{
+			// Only weighing the fees should result in the same
+			// route as the cheapest strategy.
+			name:      "weighted fee only",
+			graphPath: scoringGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(10000),
+			scorer:    NewWeightedScorer(1, 0, 0, nil),
+			expected:  []string{"songoku", "sophon"},
+		},
+		{
+			name:      "basic graph cheapest",
+			graphPath: basicGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(100),
+			scorer:    &CheapestScorer{},
+			expected:  []string{"songoku", "sophon"},
+		},
+		{
+			name:      "basic graph most reliable",
+			graphPath: basicGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(100),
+			scorer:    NewReliabilityScorer(unreliableBasic),
+			expected:  []string{"phamnuwen", "sophon"},
+		},
+		{
+			// Weighing reliability heavily enough should steer us
+			// away from the cheapest route.
+			name:      "basic graph weighted",
+			graphPath: basicGraphFilePath,
+			target:    "sophon",
+			amt:       lnwire.NewMSatFromSatoshis(100),
+			scorer:    NewWeightedScorer(1, 0, 1, unreliableBasic),
+			expected:  []string{"phamnuwen", "sophon"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		test := testCase
+		t.Run(test.name, func(t *testing.T) {
+			graph, err := parseTestGraph(test.graphPath)
+			if err != nil {
+				t.Fatalf("unable to create graph: %v", err)
+			}
+			defer graph.cleanUp()
+
+			sourceNode, err := graph.graph.SourceNode()
+			if err != nil {
+				t.Fatalf("unable to fetch source node: %v",
+					err)
+			}
+
+			path, err := findPath(
+				nil, graph.graph, nil, sourceNode,
+				graph.aliasMap[test.target], nil, nil,
+				test.amt, noFeeLimit, nil, test.scorer,
+				noConstraints, 0,
+			)
+			if err != nil {
+				t.Fatalf("unable to find path: %v", err)
+			}
+
+			if len(path) != len(test.expected) {
+				t.Fatalf("expected path of length %v, got %v",
+					len(test.expected), len(path))
+			}
+			for i, hop := range path {
+				if hop.Node.Alias != test.expected[i] {
+					t.Fatalf("expected hop %v to be %v, "+
+						"got %v", i, test.expected[i],
+						hop.Node.Alias)
+				}
+			}
+		})
+	}
+}
+
+// TestMissionControlEdgeProbability asserts that mission control penalizes
+// the success probability of edges and vertexes that recently failed, and that
+// the penalty decays over time.
+func TestMissionControlEdgeProbability(t *testing.T) {
+	t.Parallel()
+
+	mc := newMissionControl(nil, nil, nil)
+
+	var node, otherNode Vertex
+	node[0] = 1
+	otherNode[0] = 2
+
+	const chanID = 1234
+
+	// Without any failures, we should get the a priori probability.
+	prob := mc.EdgeProbability(node, chanID)
+	if prob != aprioriHopProbability {
+		t.Fatalf("expected probability %v, got %v",
+			aprioriHopProbability, prob)
+	}
+
+	// A channel that just failed should have a near zero probability.
+	session := &paymentSession{
+		pruneViewSnapshot: graphPruneView{
+			edges:    make(map[uint64]struct{}),
+			vertexes: make(map[Vertex]struct{}),
+		},
+		mc: mc,
+	}
+	session.ReportChannelFailure(chanID)
+
+	prob = mc.EdgeProbability(otherNode, chanID)
+	if prob > 0.01 {
+		t.Fatalf("expected near zero probability, got %v", prob)
+	}
+
+	// After a single half life, the penalty should have halved.
+	mc.Lock()
+	mc.edgeFailureHistory[chanID] = time.Now().Add(-penaltyHalfLife)
+	mc.Unlock()
+
+	prob = mc.EdgeProbability(otherNode, chanID)
+	expected := aprioriHopProbability / 2
+	if prob < expected-0.01 || prob > expected+0.01 {
+		t.Fatalf("expected probability %v, got %v", expected, prob)
+	}
+
+	// A failure of the node itself should also penalize the probability
+	// of any of its channels.
+	session.ReportVertexFailure(node)
+
+	prob = mc.EdgeProbability(node, chanID+1)
+	if prob > 0.01 {
+		t.Fatalf("expected near zero probability, got %v", prob)
+	}
+
+	// Once the failure has decayed out of the history, we should be back
+	// at the a priori probability.
+	mc.Lock()
+	mc.edgeFailureHistory[chanID] = time.Now().Add(-historyDecay)
+	mc.Unlock()
+
+	prob = mc.EdgeProbability(otherNode, chanID)
+	if prob != aprioriHopProbability {
+		t.Fatalf("expected probability %v, got %v",
+			aprioriHopProbability, prob)
+	}
+}
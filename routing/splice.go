@@ -0,0 +1,191 @@
+package routing
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// spliceGraceBlocks is the number of blocks we keep the edge of a spliced
+// channel within the graph, waiting for the announcement of the channel on top
+// of its new funding output. Once the grace period expires without the spliced
+// channel being announced, the prior edge is pruned like any closed channel.
+const spliceGraceBlocks = 12
+
+// restorePendingSplices loads the channel edges whose funding output has been
+// spent by a splice transaction, but whose continuation hasn't been announced
+// yet, from the graph's index of spliced edges.
+func (r *ChannelRouter) restorePendingSplices() error {
+	splicedEdges, err := r.cfg.Graph.FetchSplicedEdges()
+	if err != nil {
+		return err
+	}
+
+	r.spliceMtx.Lock()
+	defer r.spliceMtx.Unlock()
+
+	for _, edge := range splicedEdges {
+		r.pendingSplices[edge.ChanPoint] = edge
+	}
+
+	return nil
+}
+
+// filterSpentOutputs collates the outputs spent by the passed transactions,
+// which were confirmed at the given height, for the purpose of pruning the
+// channel graph. A channel edge whose funding output is spent into a new
+// output locked to the same funding keys is considered spliced, rather than
+// closed. Instead of being pruned right away, it's kept in the graph as the
+// spliced channel continues to exist on top of the new output. The outputs of
+// edges whose splice grace period has expired at this height are added to the
+// returned outputs.
+func (r *ChannelRouter) filterSpentOutputs(txns []*wire.MsgTx,
+	height uint32) []*wire.OutPoint {
+
+	r.spliceMtx.Lock()
+	defer r.spliceMtx.Unlock()
+
+	var spentOutputs []*wire.OutPoint
+	for _, tx := range txns {
+		for _, txIn := range tx.TxIn {
+			prevOut := txIn.PreviousOutPoint
+
+			newChanPoint, chanID, ok := r.spliceContinuation(
+				tx, &prevOut,
+			)
+			if !ok {
+				spentOutputs = append(spentOutputs,
+					&txIn.PreviousOutPoint)
+				continue
+			}
+
+			log.Infof("ChannelPoint(%v) (chan_id=%v) has been "+
+				"spliced into %v, awaiting announcement of "+
+				"its continuation", prevOut, chanID,
+				newChanPoint)
+
+			// The splice is persisted, so the edge isn't pruned
+			// if we restart before its continuation is announced.
+			splicedEdge := &channeldb.SplicedEdge{
+				ChanPoint:    prevOut,
+				ChannelID:    chanID,
+				NewChanPoint: newChanPoint,
+				Height:       height,
+			}
+			err := r.cfg.Graph.AddSplicedEdge(splicedEdge)
+			if err != nil {
+				log.Errorf("unable to persist splice of "+
+					"ChannelPoint(%v): %v", prevOut, err)
+			}
+			r.pendingSplices[prevOut] = splicedEdge
+		}
+	}
+
+	// Any splices whose grace period has expired are treated as closed
+	// channels from now on.
+	for chanPoint, splice := range r.pendingSplices {
+		if height < splice.Height+spliceGraceBlocks {
+			continue
+		}
+
+		log.Infof("Continuation of spliced ChannelPoint(%v) wasn't "+
+			"announced within %v blocks, pruning", chanPoint,
+			spliceGraceBlocks)
+
+		prunedPoint := chanPoint
+		spentOutputs = append(spentOutputs, &prunedPoint)
+		r.removePendingSplice(prunedPoint)
+	}
+
+	return spentOutputs
+}
+
+// spliceContinuation determines whether the passed transaction splices the
+// channel edge funded by the given output. If so, the new funding output and
+// the short channel ID of the spliced edge are returned.
+func (r *ChannelRouter) spliceContinuation(tx *wire.MsgTx,
+	prevOut *wire.OutPoint) (wire.OutPoint, uint64, bool) {
+
+	edgeInfo, _, _, err := r.cfg.Graph.FetchChannelEdgesByOutpoint(prevOut)
+	if err != nil {
+		if err != channeldb.ErrEdgeNotFound &&
+			err != channeldb.ErrGraphNoEdgesFound {
+
+			log.Errorf("unable to fetch edge for ChannelPoint(%v): "+
+				"%v", prevOut, err)
+		}
+		return wire.OutPoint{}, 0, false
+	}
+
+	witnessScript, err := lnwallet.GenMultiSigScript(
+		edgeInfo.BitcoinKey1Bytes[:], edgeInfo.BitcoinKey2Bytes[:],
+	)
+	if err != nil {
+		return wire.OutPoint{}, 0, false
+	}
+	fundingPkScript, err := lnwallet.WitnessScriptHash(witnessScript)
+	if err != nil {
+		return wire.OutPoint{}, 0, false
+	}
+
+	for i, txOut := range tx.TxOut {
+		if !bytes.Equal(txOut.PkScript, fundingPkScript) {
+			continue
+		}
+
+		newChanPoint := wire.OutPoint{
+			Hash:  tx.TxHash(),
+			Index: uint32(i),
+		}
+		return newChanPoint, edgeInfo.ChannelID, true
+	}
+
+	return wire.OutPoint{}, 0, false
+}
+
+// completeSplice prunes the edge of the channel that was spliced into the
+// passed funding output, now that the continuation of the channel has been
+// added to the graph.
+func (r *ChannelRouter) completeSplice(newEdge *channeldb.ChannelEdgeInfo) {
+	r.spliceMtx.Lock()
+	defer r.spliceMtx.Unlock()
+
+	for chanPoint, splice := range r.pendingSplices {
+		if splice.NewChanPoint != newEdge.ChannelPoint {
+			continue
+		}
+
+		// The spliced channel lives on as the new edge, so the
+		// previous one must not be marked as a zombie.
+		prevChanPoint := chanPoint
+		err := r.cfg.Graph.DeleteChannelEdge(&prevChanPoint, false)
+		if err != nil && err != channeldb.ErrEdgeNotFound {
+			log.Errorf("unable to prune spliced ChannelPoint(%v): "+
+				"%v", prevChanPoint, err)
+			return
+		}
+		r.removePendingSplice(prevChanPoint)
+
+		log.Infof("Channel chan_id=%v is a continuation of spliced "+
+			"chan_id=%v", newEdge.ChannelID, splice.ChannelID)
+
+		return
+	}
+}
+
+// removePendingSplice stops tracking the splice of the channel edge funded by
+// the passed outpoint, both in memory and within the graph's index of spliced
+// edges.
+//
+// NOTE: This method MUST be called with the spliceMtx held.
+func (r *ChannelRouter) removePendingSplice(chanPoint wire.OutPoint) {
+	delete(r.pendingSplices, chanPoint)
+
+	err := r.cfg.Graph.DeleteSplicedEdge(&chanPoint)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		log.Errorf("unable to remove splice of ChannelPoint(%v): %v",
+			chanPoint, err)
+	}
+}
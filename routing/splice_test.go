@@ -0,0 +1,230 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// createSpliceTx creates a splice transaction spending the passed funding
+// output into a new funding output with the same pkScript.
+func createSpliceTx(t *testing.T, ctx *testCtx, chanUtxo *wire.OutPoint,
+	chanValue btcutil.Amount) (*wire.MsgTx, *wire.OutPoint) {
+
+	t.Helper()
+
+	fundingOut, err := ctx.chain.GetUtxo(chanUtxo, nil, 0)
+	if err != nil {
+		t.Fatalf("unable to fetch funding output: %v", err)
+	}
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(chanUtxo, nil, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(int64(chanValue), fundingOut.PkScript))
+
+	newChanPoint := &wire.OutPoint{
+		Hash:  spliceTx.TxHash(),
+		Index: 0,
+	}
+	ctx.chain.addUtxo(*newChanPoint, spliceTx.TxOut[0])
+
+	return spliceTx, newChanPoint
+}
+
+// waitForPendingSplices waits until the router tracks the expected number of
+// pending splices.
+func waitForPendingSplices(t *testing.T, router *ChannelRouter, num int) {
+	t.Helper()
+
+	for i := 0; i < 50; i++ {
+		router.spliceMtx.Lock()
+		numSplices := len(router.pendingSplices)
+		router.spliceMtx.Unlock()
+
+		if numSplices == num {
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	t.Fatalf("expected %v pending splices", num)
+}
+
+// TestChannelSpliceContinuation tests that a channel edge whose funding output
+// is spent by a splice is kept in the graph until its continuation is
+// announced, and that it's pruned once the splice grace period expires if no
+// continuation is announced.
+func TestChannelSpliceContinuation(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxSingleNode(startingBlockHeight)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	const chanValue = 10000
+	fundingTx, chanUtxo, chanID, err := createChannelEdge(ctx,
+		bitcoinKey1.SerializeCompressed(), bitcoinKey2.SerializeCompressed(),
+		chanValue, startingBlockHeight)
+	if err != nil {
+		t.Fatalf("unable create channel edge: %v", err)
+	}
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	node1, err := createTestNode()
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	node2, err := createTestNode()
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+
+	newEdge := func(chanID *lnwire.ShortChannelID) *channeldb.ChannelEdgeInfo {
+		edge := &channeldb.ChannelEdgeInfo{
+			ChannelID:     chanID.ToUint64(),
+			NodeKey1Bytes: node1.PubKeyBytes,
+			NodeKey2Bytes: node2.PubKeyBytes,
+			AuthProof: &channeldb.ChannelAuthProof{
+				NodeSig1Bytes:    testSig.Serialize(),
+				NodeSig2Bytes:    testSig.Serialize(),
+				BitcoinSig1Bytes: testSig.Serialize(),
+				BitcoinSig2Bytes: testSig.Serialize(),
+			},
+		}
+		copy(edge.BitcoinKey1Bytes[:], bitcoinKey1.SerializeCompressed())
+		copy(edge.BitcoinKey2Bytes[:], bitcoinKey2.SerializeCompressed())
+
+		return edge
+	}
+	if err := ctx.router.AddEdge(newEdge(chanID)); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+
+	ntfnClient, err := ctx.router.SubscribeTopology()
+	if err != nil {
+		t.Fatalf("unable to subscribe for channel notifications: %v", err)
+	}
+
+	assertEdgeExists := func(chanID *lnwire.ShortChannelID, exists bool) {
+		t.Helper()
+
+		_, _, found, err := ctx.graph.HasChannelEdge(chanID.ToUint64())
+		if err != nil {
+			t.Fatalf("unable to query for edge: %v", err)
+		}
+		if found != exists {
+			t.Fatalf("expected edge %v to exist: %v", chanID, exists)
+		}
+	}
+
+	notifyBlock := func(height uint32, txns ...*wire.MsgTx) {
+		block := &wire.MsgBlock{
+			Transactions: txns,
+		}
+		ctx.chain.addBlock(block, height, height)
+		ctx.chainView.notifyBlock(
+			block.Header.BlockHash(), height, block.Transactions,
+		)
+	}
+
+	// We'll now splice additional funds into the channel at height 102.
+	// The channel shouldn't be pruned, as it continues to exist on top of
+	// the new funding output.
+	spliceTx, _ := createSpliceTx(t, ctx, chanUtxo, chanValue*2)
+	notifyBlock(102, spliceTx)
+	waitForPendingSplices(t, ctx.router, 1)
+	assertEdgeExists(chanID, true)
+
+	// Once the continuation of the channel is announced, the prior edge
+	// should be pruned.
+	splicedChanID := &lnwire.ShortChannelID{
+		BlockHeight: 102,
+		TxIndex:     0,
+		TxPosition:  0,
+	}
+	if err := ctx.router.AddEdge(newEdge(splicedChanID)); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+	waitForPendingSplices(t, ctx.router, 0)
+	assertEdgeExists(chanID, false)
+	assertEdgeExists(splicedChanID, true)
+
+	// As the channel lives on, the prior edge shouldn't be marked as a
+	// zombie.
+	isZombie, _, _, err := ctx.graph.IsZombieEdge(chanID.ToUint64())
+	if err != nil {
+		t.Fatalf("unable to query zombie index: %v", err)
+	}
+	if isZombie {
+		t.Fatalf("spliced edge marked as zombie")
+	}
+
+	// Next, we'll splice the channel once more, but won't announce its
+	// continuation. The spliced edge should be kept in the graph until the
+	// grace period expires.
+	splicedUtxo := &wire.OutPoint{Hash: spliceTx.TxHash(), Index: 0}
+	spliceTx2, _ := createSpliceTx(t, ctx, splicedUtxo, chanValue)
+	notifyBlock(103, spliceTx2)
+	waitForPendingSplices(t, ctx.router, 1)
+
+	// The pending splice should survive a restart of the router, rather
+	// than the spliced edge being pruned as a closed channel.
+	ctx.chain.setBestBlock(103)
+	if err := ctx.RestartRouter(); err != nil {
+		t.Fatalf("unable to restart router: %v", err)
+	}
+	waitForPendingSplices(t, ctx.router, 1)
+	assertEdgeExists(splicedChanID, true)
+
+	ntfnClient, err = ctx.router.SubscribeTopology()
+	if err != nil {
+		t.Fatalf("unable to subscribe for channel notifications: %v", err)
+	}
+
+	for height := uint32(104); height < 103+spliceGraceBlocks; height++ {
+		notifyBlock(height)
+	}
+	assertEdgeExists(splicedChanID, true)
+
+	select {
+	case <-ntfnClient.TopologyChanges:
+		t.Fatalf("spliced channel closed before grace period expired")
+	default:
+	}
+
+	closeHeight := uint32(103 + spliceGraceBlocks)
+	notifyBlock(closeHeight)
+
+	select {
+	case ntfn := <-ntfnClient.TopologyChanges:
+		closedChans := ntfn.ClosedChannels
+		if len(closedChans) != 1 {
+			t.Fatalf("expected 1 closed channel, got %v",
+				len(closedChans))
+		}
+		if closedChans[0].ChanID != splicedChanID.ToUint64() {
+			t.Fatalf("expected channel %v to be closed, got %v",
+				splicedChanID.ToUint64(), closedChans[0].ChanID)
+		}
+		if closedChans[0].ClosedHeight != closeHeight {
+			t.Fatalf("expected close height %v, got %v",
+				closeHeight, closedChans[0].ClosedHeight)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("notification not sent")
+	}
+
+	waitForPendingSplices(t, ctx.router, 0)
+	assertEdgeExists(splicedChanID, false)
+}
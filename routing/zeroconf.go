@@ -0,0 +1,102 @@
+package routing
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// addAliasEdge adds the edge of one of our zero-conf channels, which is
+// identified by its alias short channel ID until its funding transaction
+// confirms, to the channel graph. As the alias doesn't encode a location
+// within the chain, the funding outpoint and capacity of the edge are taken
+// from our own channel state, rather than validated against the chain.
+func (r *ChannelRouter) addAliasEdge(edge *channeldb.ChannelEdgeInfo) error {
+	alias := lnwire.NewShortChanIDFromInt(edge.ChannelID)
+	channel, err := r.cfg.Graph.Database().FetchZeroConfChannel(alias)
+	if err != nil {
+		return fmt.Errorf("unable to fetch zero-conf channel for "+
+			"alias chan_id=%v: %v", edge.ChannelID, err)
+	}
+
+	edge.Capacity = channel.Capacity
+	edge.ChannelPoint = channel.FundingOutpoint
+	if err := r.cfg.Graph.AddChannelEdge(edge); err != nil {
+		return fmt.Errorf("unable to add edge: %v", err)
+	}
+
+	log.Infof("New zero-conf channel added with alias chan_id=%v, "+
+		"ChannelPoint(%v), capacity=%v", edge.ChannelID,
+		edge.ChannelPoint, edge.Capacity)
+
+	// We'll still watch the funding output, such that the edge is pruned
+	// if the channel is closed before its funding transaction confirms.
+	witnessScript, err := lnwallet.GenMultiSigScript(
+		edge.BitcoinKey1Bytes[:], edge.BitcoinKey2Bytes[:],
+	)
+	if err != nil {
+		return err
+	}
+	fundingPkScript, err := lnwallet.WitnessScriptHash(witnessScript)
+	if err != nil {
+		return err
+	}
+
+	filterUpdate := []channeldb.EdgePoint{
+		{
+			FundingPkScript: fundingPkScript,
+			OutPoint:        edge.ChannelPoint,
+		},
+	}
+	err = r.cfg.ChainView.UpdateFilter(
+		filterUpdate, atomic.LoadUint32(&r.bestHeight),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update chain view: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteAliasEdge removes the edge of the zero-conf channel with the passed
+// funding outpoint from the channel graph, if it's still identified by its
+// alias short channel ID. This should be called once the funding transaction
+// of the channel has confirmed, before the edge is re-added under its
+// confirmed short channel ID, or if the channel failed before confirming.
+func (r *ChannelRouter) DeleteAliasEdge(chanPoint *wire.OutPoint) error {
+	edgeInfo, _, _, err := r.cfg.Graph.FetchChannelEdgesByOutpoint(chanPoint)
+	switch {
+	case err == channeldb.ErrEdgeNotFound ||
+		err == channeldb.ErrGraphNoEdgesFound:
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	alias := lnwire.NewShortChanIDFromInt(edgeInfo.ChannelID)
+	if !alias.IsAlias() {
+		return nil
+	}
+
+	// The edge is either superseded by the edge of the confirmed channel,
+	// or its funding output will never exist, so there's no need to mark
+	// it as a zombie.
+	err = r.cfg.Graph.DeleteChannelEdge(chanPoint, false)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		return err
+	}
+
+	r.routeCacheMtx.Lock()
+	r.routeCache = make(map[routeTuple][]*Route)
+	r.routeCacheMtx.Unlock()
+
+	log.Debugf("Removed edge with alias chan_id=%v for ChannelPoint(%v)",
+		edgeInfo.ChannelID, chanPoint)
+
+	return nil
+}
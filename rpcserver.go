@@ -25,11 +25,14 @@ import (
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/psbt"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -161,6 +164,14 @@ var (
 			Entity: "address",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SetSweepDestination": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/GetSweepDestination": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SignMessage": {{
 			Entity: "message",
 			Action: "write",
@@ -184,6 +195,13 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/ChannelAcceptor": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/OpenChannelSync": {{
 			Entity: "onchain",
 			Action: "write",
@@ -191,6 +209,20 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/BatchOpenChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/FundingStateStep": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/CloseChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -202,6 +234,13 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SpliceChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/GetInfo": {{
 			Entity: "info",
 			Action: "read",
@@ -246,6 +285,10 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/ProbePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/AddInvoice": {{
 			Entity: "invoices",
 			Action: "write",
@@ -326,6 +369,18 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/chainrpc.ChainNotifier/RegisterConfirmationsNtfn": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/chainrpc.ChainNotifier/RegisterSpendNtfn": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/chainrpc.ChainNotifier/RegisterBlockEpochNtfn": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 	}
 )
 
@@ -411,6 +466,29 @@ func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
 	return r.server.cc.wallet.SendOutputs(outputs, feeRate)
 }
 
+// parseDeliveryAddress parses the address the funds of a channel should be
+// paid to upon a cooperative close, such as the close address of an open
+// channel request, into its delivery script. If no address is specified, no
+// script is returned.
+func parseDeliveryAddress(address string) (lnwire.DeliveryAddress,
+	error) {
+
+	if address == "" {
+		return nil, nil
+	}
+
+	addr, err := btcutil.DecodeAddress(address, activeNetParams.Params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid close address: %v", err)
+	}
+	if !addr.IsForNet(activeNetParams.Params) {
+		return nil, fmt.Errorf("close address %v is not for the "+
+			"active network", address)
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
 // determineFeePerKw will determine the fee in sat/kw that should be paid given
 // an estimator, a confirmation target, and a manual value for sat/byte. A value
 // is chosen based on the two free parameters as one, or both of them can be
@@ -535,6 +613,46 @@ func (r *rpcServer) NewAddress(ctx context.Context,
 	return &lnrpc.NewAddressResponse{Address: addr.String()}, nil
 }
 
+// SetSweepDestination sets the destination that swept funds, and our funds of
+// cooperative closes, are sent to.
+func (r *rpcServer) SetSweepDestination(ctx context.Context,
+	in *lnrpc.SetSweepDestinationRequest) (
+	*lnrpc.SetSweepDestinationResponse, error) {
+
+	rpcsLog.Infof("[setsweepdest] address=%v, xpub=%v", in.Address,
+		in.Xpub)
+
+	if in.Address != "" && in.Xpub != "" {
+		return nil, fmt.Errorf("only one of address and xpub can be " +
+			"set")
+	}
+
+	if err := r.server.sweepDest.Set(in.Address, in.Xpub); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SetSweepDestinationResponse{}, nil
+}
+
+// GetSweepDestination returns the destination that swept funds are currently
+// sent to. If none is set, funds are sent to the wallet, and an empty
+// response is returned.
+func (r *rpcServer) GetSweepDestination(ctx context.Context,
+	in *lnrpc.GetSweepDestinationRequest) (
+	*lnrpc.GetSweepDestinationResponse, error) {
+
+	dest := r.server.sweepDest.Current()
+	if dest == nil {
+		return &lnrpc.GetSweepDestinationResponse{}, nil
+	}
+
+	return &lnrpc.GetSweepDestinationResponse{
+		Address:   dest.Address,
+		Xpub:      dest.XPub,
+		NextIndex: dest.NextIndex,
+	}, nil
+}
+
 var (
 	// signedMsgPrefix is a special prefix that we'll prepend to any
 	// messages we sign/verify. We do this to ensure that we don't
@@ -710,13 +828,52 @@ func (r *rpcServer) DisconnectPeer(ctx context.Context,
 	return &lnrpc.DisconnectPeerResponse{}, nil
 }
 
-// extractOpenChannelMinConfs extracts the minimum number of confirmations from
-// the OpenChannelRequest that each output used to fund the channel's funding
-// transaction should satisfy.
-func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
+// extractOpenChannelParams extracts the constraints we require of the remote
+// party, and the dust limit of our commitment transaction, from the
+// parameters of an open channel request into the passed openChanReq. Only
+// the bounds that don't depend on the channel capacity are checked here, the
+// remaining ones are checked once the funding flow starts.
+func extractOpenChannelParams(in *lnrpc.OpenChannelRequest,
+	req *openChanReq) error {
+
+	switch {
+	case in.RemoteChanReserveSat < 0:
+		return errors.New("remote channel reserve must be a " +
+			"non-negative number")
+
+	case in.DustLimitSat < 0:
+		return errors.New("dust limit must be a non-negative number")
+
+	case in.DustLimitSat != 0 &&
+		in.DustLimitSat < int64(lnwallet.MinDustLimit):
+
+		return fmt.Errorf("dust limit must be at least %v",
+			lnwallet.MinDustLimit)
+
+	case in.RemoteMaxHtlcs > lnwallet.MaxHTLCNumber/2:
+		return fmt.Errorf("remote max htlcs must be at most %v",
+			lnwallet.MaxHTLCNumber/2)
+	}
+
+	req.remoteChanReserve = btcutil.Amount(in.RemoteChanReserveSat)
+	req.dustLimit = btcutil.Amount(in.DustLimitSat)
+	req.remoteMaxHtlcs = uint16(in.RemoteMaxHtlcs)
+	req.remoteMaxValue = lnwire.MilliSatoshi(
+		in.RemoteMaxValueInFlightMsat,
+	)
+
+	return nil
+}
+
+// extractOpenChannelMinConfs extracts the minimum number of confirmations that
+// each output used to fund the channel's funding transaction should satisfy
+// from the parameters of an open channel request.
+func extractOpenChannelMinConfs(minConfs int32,
+	spendUnconfirmed bool) (int32, error) {
+
 	switch {
 	// Ensure that the MinConfs parameter is non-negative.
-	case in.MinConfs < 0:
+	case minConfs < 0:
 		return 0, errors.New("minimum number of confirmations must " +
 			"be a non-negative number")
 
@@ -725,25 +882,59 @@ func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
 	// provide sane defaults to the OpenChannel RPC, as otherwise, if the
 	// MinConfs field isn't explicitly set by the caller, we'll use
 	// unconfirmed outputs without the caller being aware.
-	case in.MinConfs == 0 && !in.SpendUnconfirmed:
+	case minConfs == 0 && !spendUnconfirmed:
 		return 1, nil
 
 	// In the event that the caller set MinConfs > 0 and SpendUnconfirmed to
 	// true, we'll return an error to indicate the conflict.
-	case in.MinConfs > 0 && in.SpendUnconfirmed:
+	case minConfs > 0 && spendUnconfirmed:
 		return 0, errors.New("SpendUnconfirmed set to true with " +
 			"MinConfs > 0")
 
 	// The funding transaction of the new channel to be created can be
 	// funded with unconfirmed outputs.
-	case in.SpendUnconfirmed:
+	case spendUnconfirmed:
 		return 0, nil
 
 	// If none of the above cases matched, we'll return the value set
 	// explicitly by the caller.
 	default:
-		return in.MinConfs, nil
+		return minConfs, nil
+	}
+}
+
+// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+// OpenChannel requests are sent to the client and the client responds with a
+// boolean that tells LND whether or not to accept the channel. This allows
+// node operators to specify their own criteria for accepting inbound
+// channels through a single persistent connection.
+func (r *rpcServer) ChannelAcceptor(
+	stream lnrpc.Lightning_ChannelAcceptorServer) error {
+
+	rpcAcceptor := chanacceptor.NewRPCAcceptor(
+		stream.Recv, stream.Send, cfg.AcceptorTimeout, r.quit,
+	)
+
+	// We register the acceptor for as long as the stream is active, such
+	// that every inbound channel request is forwarded to the client.
+	acceptorID := r.server.chanAcceptor.AddAcceptor(rpcAcceptor)
+	defer r.server.chanAcceptor.RemoveAcceptor(acceptorID)
+
+	rpcsLog.Infof("[channelacceptor] client registered with id=%v",
+		acceptorID)
+
+	err := rpcAcceptor.Run()
+
+	// The client closing its side of the stream is a regular shutdown of
+	// the acceptor.
+	if err == io.EOF {
+		err = nil
 	}
+
+	rpcsLog.Infof("[channelacceptor] client with id=%v exited: %v",
+		acceptorID, err)
+
+	return err
 }
 
 // OpenChannel attempts to open a singly funded channel specified in the
@@ -777,10 +968,12 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 
 	// Ensure that the user doesn't exceed the current soft-limit for
 	// channel size. If the funding amount is above the soft-limit, then
-	// we'll reject the request.
-	if localFundingAmt > maxFundingAmount {
+	// we'll reject the request. Whether the remote peer accepts channels
+	// above the soft-limit is checked once the funding flow starts.
+	maxChanSize := maxChanSizeLimit(cfg.WumboChans)
+	if localFundingAmt > maxChanSize {
 		return fmt.Errorf("funding amount is too large, the max "+
-			"channel size is: %v", maxFundingAmount)
+			"channel size is: %v", maxChanSize)
 	}
 
 	// Restrict the size of the channel we'll actually open. At a later
@@ -794,7 +987,9 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	// Then, we'll extract the minimum number of confirmations that each
 	// output we use to fund the channel's funding transaction should
 	// satisfy.
-	minConfs, err := extractOpenChannelMinConfs(in)
+	minConfs, err := extractOpenChannelMinConfs(
+		in.MinConfs, in.SpendUnconfirmed,
+	)
 	if err != nil {
 		return err
 	}
@@ -838,6 +1033,13 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	rpcsLog.Debugf("[openchannel]: using fee of %v sat/kw for funding tx",
 		int64(feeRate))
 
+	// If a close address was specified, we'll commit to paying our funds
+	// to it upon a cooperative close of the channel.
+	shutdownScript, err := parseDeliveryAddress(in.CloseAddress)
+	if err != nil {
+		return err
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -851,6 +1053,15 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		psbtFunding:     in.Psbt,
+		shutdownScript:  shutdownScript,
+		zeroConf:        in.ZeroConf,
+	}
+
+	// Apply any custom constraints the caller wants to require of the
+	// remote party.
+	if err := extractOpenChannelParams(in, req); err != nil {
+		return err
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -932,6 +1143,13 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 			"wallet is fully synced")
 	}
 
+	// Funding a channel through a PSBT requires the caller to act upon
+	// an intermediate update, which isn't possible with a sync call.
+	if in.Psbt {
+		return nil, errors.New("PSBT funding is only supported by " +
+			"OpenChannel")
+	}
+
 	// Decode the provided target node's public key, parsing it into a pub
 	// key object. For all sync call, byte slices are expected to be
 	// encoded as hex strings.
@@ -968,7 +1186,9 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	// Then, we'll extract the minimum number of confirmations that each
 	// output we use to fund the channel's funding transaction should
 	// satisfy.
-	minConfs, err := extractOpenChannelMinConfs(in)
+	minConfs, err := extractOpenChannelMinConfs(
+		in.MinConfs, in.SpendUnconfirmed,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -985,6 +1205,11 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	rpcsLog.Tracef("[openchannel] target sat/kw for funding tx: %v",
 		int64(feeRate))
 
+	shutdownScript, err := parseDeliveryAddress(in.CloseAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &openChanReq{
 		targetPubkey:    nodepubKey,
 		chainHash:       *activeNetParams.GenesisHash,
@@ -995,6 +1220,14 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		shutdownScript:  shutdownScript,
+		zeroConf:        in.ZeroConf,
+	}
+
+	// Apply any custom constraints the caller wants to require of the
+	// remote party.
+	if err := extractOpenChannelParams(in, req); err != nil {
+		return nil, err
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1027,6 +1260,164 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	}
 }
 
+// BatchOpenChannel attempts to open multiple singly funded channels with
+// different remote peers within a single funding transaction. The call blocks
+// until the funding transaction has been broadcast.
+func (r *rpcServer) BatchOpenChannel(ctx context.Context,
+	in *lnrpc.BatchOpenChannelRequest) (*lnrpc.BatchOpenChannelResponse,
+	error) {
+
+	rpcsLog.Tracef("[batchopenchannel] request to open %v channels",
+		len(in.Channels))
+
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	if len(in.Channels) == 0 {
+		return nil, fmt.Errorf("at least one channel must be specified")
+	}
+
+	minConfs, err := extractOpenChannelMinConfs(
+		in.MinConfs, in.SpendUnconfirmed,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll validate each channel the same way OpenChannel does, and
+	// additionally ensure that we don't open several channels with the
+	// same peer, as each peer has a single funding output.
+	maxChanSize := maxChanSizeLimit(cfg.WumboChans)
+	reqs := make([]*openChanReq, 0, len(in.Channels))
+	seenPeers := make(map[string]struct{})
+	for _, channel := range in.Channels {
+		localFundingAmt := btcutil.Amount(channel.LocalFundingAmount)
+		remoteInitialBalance := btcutil.Amount(channel.PushSat)
+
+		if remoteInitialBalance >= localFundingAmt {
+			return nil, fmt.Errorf("amount pushed to remote peer " +
+				"for initial state must be below the local " +
+				"funding amount")
+		}
+		if localFundingAmt > maxChanSize {
+			return nil, fmt.Errorf("funding amount is too large, "+
+				"the max channel size is: %v", maxChanSize)
+		}
+		if localFundingAmt < minChanFundingSize {
+			return nil, fmt.Errorf("channel is too small, the "+
+				"minimum channel size is: %v SAT",
+				int64(minChanFundingSize))
+		}
+
+		if len(channel.NodePubkey) == 0 {
+			return nil, fmt.Errorf("NodePubKey is not set")
+		}
+		nodePubKey, err := btcec.ParsePubKey(
+			channel.NodePubkey, btcec.S256(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if nodePubKey.IsEqual(r.server.identityPriv.PubKey()) {
+			return nil, fmt.Errorf("cannot open channel to self")
+		}
+
+		nodePubKeyBytes := nodePubKey.SerializeCompressed()
+		if _, ok := seenPeers[string(nodePubKeyBytes)]; ok {
+			return nil, fmt.Errorf("duplicate channel with "+
+				"NodeKey(%x) within batch", nodePubKeyBytes)
+		}
+		seenPeers[string(nodePubKeyBytes)] = struct{}{}
+
+		reqs = append(reqs, &openChanReq{
+			targetPubkey:    nodePubKey,
+			chainHash:       *activeNetParams.GenesisHash,
+			localFundingAmt: localFundingAmt,
+			pushAmt: lnwire.NewMSatFromSatoshis(
+				remoteInitialBalance,
+			),
+			minHtlc:        lnwire.MilliSatoshi(channel.MinHtlcMsat),
+			private:        channel.Private,
+			remoteCsvDelay: uint16(channel.RemoteCsvDelay),
+		})
+	}
+
+	// Based on the passed fee related parameters, we'll determine an
+	// appropriate fee rate for the funding transaction.
+	feeRate, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[batchopenchannel]: using fee of %v sat/kw for "+
+		"funding tx", int64(feeRate))
+
+	outPoints, err := r.server.BatchOpenChannel(reqs, feeRate, minConfs)
+	if err != nil {
+		rpcsLog.Errorf("unable to open batch of channels: %v", err)
+		return nil, err
+	}
+
+	resp := &lnrpc.BatchOpenChannelResponse{}
+	for _, outPoint := range outPoints {
+		resp.PendingChannels = append(
+			resp.PendingChannels, &lnrpc.PendingUpdate{
+				Txid:        outPoint.Hash[:],
+				OutputIndex: outPoint.Index,
+			},
+		)
+	}
+
+	rpcsLog.Tracef("[batchopenchannel] success, funding tx %v",
+		outPoints[0].Hash)
+
+	return resp, nil
+}
+
+// FundingStateStep advances the funding workflow of a pending channel that is
+// funded by an external wallet, by supplying the fully signed PSBT that pays
+// the channel capacity to the funding output.
+func (r *rpcServer) FundingStateStep(ctx context.Context,
+	in *lnrpc.FundingTransitionMsg) (*lnrpc.FundingStateStepResp, error) {
+
+	switch {
+	case in.GetPsbtFinalize() != nil:
+		msg := in.GetPsbtFinalize()
+
+		var pendingChanID [32]byte
+		if len(msg.PendingChanId) != len(pendingChanID) {
+			return nil, fmt.Errorf("pending channel ID must be %v "+
+				"bytes", len(pendingChanID))
+		}
+		copy(pendingChanID[:], msg.PendingChanId)
+
+		packet, err := psbt.NewFromRawBytes(
+			bytes.NewReader(msg.SignedPsbt), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse PSBT: %v", err)
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] finalizing PSBT funding of "+
+			"pending channel %x", pendingChanID[:])
+
+		err = r.server.fundingMgr.ProcessPsbt(pendingChanID, packet)
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.FundingStateStepResp{}, nil
+
+	default:
+		return nil, fmt.Errorf("funding transition message must be " +
+			"specified")
+	}
+}
+
 // getChanPointFundingTxid returns the given channel point's funding txid in
 // raw bytes.
 func getChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) ([]byte, error) {
@@ -1073,6 +1464,16 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	rpcsLog.Tracef("[closechannel] request for ChannelPoint(%v), force=%v",
 		chanPoint, force)
 
+	// A delivery address and maximum fee rate can only be negotiated in a
+	// cooperative closure.
+	if force && (in.DeliveryAddress != "" || in.MaxSatPerByte != 0) {
+		return fmt.Errorf("delivery address and max fee rate can't " +
+			"be set for a force close")
+	}
+	if in.MaxSatPerByte < 0 {
+		return fmt.Errorf("max fee rate must be a non-negative number")
+	}
+
 	var (
 		updateChan chan *lnrpc.CloseStatusUpdate
 		errChan    chan error
@@ -1176,6 +1577,28 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		rpcsLog.Debugf("Target sat/kw for closing transaction: %v",
 			int64(feeRate))
 
+		// If a maximum fee rate was set, we'll reject any fee proposal
+		// of the remote party above it during the fee negotiation. It
+		// also caps our own proposals.
+		var maxFeeRate lnwallet.SatPerKWeight
+		if in.MaxSatPerByte != 0 {
+			maxFeeRate = lnwallet.SatPerKVByte(
+				in.MaxSatPerByte * 1000,
+			).FeePerKWeight()
+			if maxFeeRate < lnwallet.FeePerKwFloor {
+				return fmt.Errorf("max fee rate of %v sat/kw "+
+					"is below the minimum relay fee rate of "+
+					"%v sat/kw", int64(maxFeeRate),
+					int64(lnwallet.FeePerKwFloor))
+			}
+		}
+
+		// If a delivery address was set, our funds will be paid to it.
+		deliveryScript, err := parseDeliveryAddress(in.DeliveryAddress)
+		if err != nil {
+			return err
+		}
+
 		// Before we attempt the cooperative channel closure, we'll
 		// examine the channel to ensure that it doesn't have a
 		// lingering HTLC.
@@ -1190,6 +1613,7 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// broadcast details.
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
 			chanPoint, htlcswitch.CloseRegular, feeRate,
+			maxFeeRate, deliveryScript,
 		)
 	}
 out:
@@ -1291,6 +1715,119 @@ func (r *rpcServer) AbandonChannel(ctx context.Context,
 	return &lnrpc.AbandonChannelResponse{}, nil
 }
 
+// SpliceChannel adds funds to or removes funds from an active channel by
+// replacing its funding output with a new one in a splice transaction. The
+// call returns once the splice transaction has been broadcast.
+func (r *rpcServer) SpliceChannel(ctx context.Context,
+	in *lnrpc.SpliceChannelRequest) (*lnrpc.SpliceChannelResponse, error) {
+
+	if !cfg.Splicing {
+		return nil, fmt.Errorf("splicing isn't enabled, restart lnd " +
+			"with --splicing")
+	}
+
+	if in.Amount == 0 {
+		return nil, fmt.Errorf("splice amount must be non-zero")
+	}
+
+	txidHash, err := getChanPointFundingTxid(in.GetChannelPoint())
+	if err != nil {
+		return nil, err
+	}
+	txid, err := chainhash.NewHash(txidHash)
+	if err != nil {
+		return nil, err
+	}
+	index := in.ChannelPoint.OutputIndex
+	chanPoint := wire.NewOutPoint(txid, index)
+
+	rpcsLog.Tracef("[splicechannel] request for ChannelPoint(%v), amt=%v",
+		chanPoint, in.Amount)
+
+	channel, err := r.fetchActiveChannel(*chanPoint)
+	if err != nil {
+		return nil, err
+	}
+	channel.Stop()
+
+	feeRate, err := determineFeePerKw(
+		r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("Target sat/kw for splice transaction: %v",
+		int64(feeRate))
+
+	// If funds are spliced out of the channel, we'll need a script to send
+	// them to. Unless the caller specified an address, we'll pay to a
+	// fresh address of our own wallet.
+	var deliveryScript []byte
+	if in.Amount < 0 {
+		var addr btcutil.Address
+		if in.Addr != "" {
+			addr, err = btcutil.DecodeAddress(
+				in.Addr, activeNetParams.Params,
+			)
+		} else {
+			addr, err = r.server.cc.wallet.NewAddress(
+				lnwallet.WitnessPubKey, false,
+			)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		deliveryScript, err = txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	remotePub := &channel.StateSnapshot().RemoteIdentity
+	peer, err := r.server.FindPeer(remotePub)
+	if err != nil {
+		return nil, fmt.Errorf("unable to splice channel while peer "+
+			"is offline: %v", err)
+	}
+
+	req := &spliceReq{
+		chanPoint:      chanPoint,
+		amt:            btcutil.Amount(in.Amount),
+		deliveryScript: deliveryScript,
+		feeRate:        feeRate,
+		resp:           make(chan *wire.OutPoint, 1),
+		err:            make(chan error, 1),
+	}
+	go peer.SpliceChannel(req)
+
+	select {
+	case newChanPoint := <-req.resp:
+		rpcsLog.Infof("[splicechannel] splice of ChannelPoint(%v) "+
+			"pending, new ChannelPoint(%v)", chanPoint,
+			newChanPoint)
+
+		return &lnrpc.SpliceChannelResponse{
+			SplicePending: &lnrpc.PendingUpdate{
+				Txid:        newChanPoint.Hash[:],
+				OutputIndex: newChanPoint.Index,
+			},
+		}, nil
+
+	case err := <-req.err:
+		rpcsLog.Errorf("[splicechannel] unable to splice "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return nil, err
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-r.quit:
+		return nil, fmt.Errorf("server shutting down")
+	}
+}
+
 // fetchOpenDbChannel attempts to locate a channel identified by its channel
 // point from the database's set of all currently opened channels.
 func (r *rpcServer) fetchOpenDbChannel(chanPoint wire.OutPoint) (
@@ -1391,6 +1928,8 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 	}
 
 	// TODO(roasbeef): add synced height n stuff
+	skippedGoOnChain := r.server.chainArb.SkippedGoOnChainStats()
+
 	return &lnrpc.GetInfoResponse{
 		IdentityPubkey:      encodedIDPub,
 		NumPendingChannels:  nPendingChannels,
@@ -1405,6 +1944,9 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		Alias:               nodeAnn.Alias.String(),
 		BestHeaderTimestamp: int64(bestHeaderTimestamp),
 		Version:             build.Version(),
+
+		NumSkippedIncomingGoOnChain: skippedGoOnChain.Incoming,
+		NumSkippedOutgoingGoOnChain: skippedGoOnChain.Outgoing,
 	}, nil
 }
 
@@ -1676,6 +2218,17 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				resp.TotalLimboBalance += int64(nurseryInfo.limboBalance)
 			}
 
+			// Finally, we'll report on the progress of each of
+			// the contract resolvers that are still resolving an
+			// output of the channel.
+			reports := r.server.chainArb.ContractReports(chanPoint)
+			for _, report := range reports {
+				forceClose.Resolvers = append(
+					forceClose.Resolvers,
+					marshallContractReport(report, currentHeight),
+				)
+			}
+
 			resp.PendingForceClosingChannels = append(
 				resp.PendingForceClosingChannels,
 				forceClose,
@@ -1703,6 +2256,21 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			LocalBalance:  int64(waitingClose.LocalCommitment.LocalBalance.ToSatoshis()),
 		}
 
+		// If this is a cooperative close, we'll have recorded the
+		// output of the closing tx that pays out our funds.
+		reports, err := r.server.chanDB.FetchResolverReports(
+			&chanPoint,
+		)
+		if err != nil {
+			return nil, err
+		}
+		var resolutions []*lnrpc.Resolution
+		for _, report := range reports {
+			resolutions = append(
+				resolutions, marshallResolverReport(report),
+			)
+		}
+
 		// A close tx has been broadcasted, all our balance will be in
 		// limbo until it confirms.
 		resp.WaitingCloseChannels = append(
@@ -1710,6 +2278,7 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 			&lnrpc.PendingChannelsResponse_WaitingCloseChannel{
 				Channel:      channel,
 				LimboBalance: channel.LocalBalance,
+				Resolutions:  resolutions,
 			},
 		)
 
@@ -1719,6 +2288,118 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 	return resp, nil
 }
 
+// marshallContractReport converts the resolution progress reported by a
+// contract resolver into its RPC counterpart.
+func marshallContractReport(report *contractcourt.ContractReport,
+	currentHeight int32) *lnrpc.ResolverReport {
+
+	rpcReport := &lnrpc.ResolverReport{
+		Outpoint:       report.Outpoint.String(),
+		Amount:         int64(report.Amount),
+		ExpiryHeight:   report.ExpiryHeight,
+		MaturityHeight: report.MaturityHeight,
+	}
+
+	switch report.Type {
+	case contractcourt.ResolverTypeHtlcTimeout:
+		rpcReport.ResolverType = lnrpc.ResolverReport_HTLC_TIMEOUT
+	case contractcourt.ResolverTypeHtlcSuccess:
+		rpcReport.ResolverType = lnrpc.ResolverReport_HTLC_SUCCESS
+	case contractcourt.ResolverTypeHtlcOutgoingContest:
+		rpcReport.ResolverType = lnrpc.ResolverReport_HTLC_OUTGOING_CONTEST
+	case contractcourt.ResolverTypeHtlcIncomingContest:
+		rpcReport.ResolverType = lnrpc.ResolverReport_HTLC_INCOMING_CONTEST
+	case contractcourt.ResolverTypeCommitSweep:
+		rpcReport.ResolverType = lnrpc.ResolverReport_COMMIT_SWEEP
+	}
+
+	switch report.Stage {
+	case contractcourt.ResolverStageWaitingExpiry:
+		rpcReport.Stage = lnrpc.ResolverReport_WAITING_EXPIRY
+	case contractcourt.ResolverStageWaitingCommitConf:
+		rpcReport.Stage = lnrpc.ResolverReport_WAITING_COMMIT_CONF
+	case contractcourt.ResolverStageFirstLevelPublished:
+		rpcReport.Stage = lnrpc.ResolverReport_FIRST_LEVEL_PUBLISHED
+	case contractcourt.ResolverStageCsvDelay:
+		rpcReport.Stage = lnrpc.ResolverReport_SECOND_LEVEL_CSV
+	case contractcourt.ResolverStageSwept:
+		rpcReport.Stage = lnrpc.ResolverReport_SWEPT
+	}
+
+	if report.MaturityHeight != 0 {
+		rpcReport.BlocksTilMaturity = int32(report.MaturityHeight) -
+			currentHeight
+	}
+
+	if report.SweepTxid != nil {
+		rpcReport.SweepTxid = report.SweepTxid.String()
+	}
+	rpcReport.SweepAddress = scriptAddress(report.SweepPkScript)
+
+	return rpcReport
+}
+
+// marshallResolverReport converts the final resolution report of an output of
+// a closed channel into its RPC representation.
+func marshallResolverReport(
+	report *channeldb.ResolverReport) *lnrpc.Resolution {
+
+	resolution := &lnrpc.Resolution{
+		Outpoint: report.OutPoint.String(),
+		Amount:   int64(report.Amount),
+		SweepFee: int64(report.SweepFee),
+	}
+
+	switch report.OutputType {
+	case channeldb.ResolverOutputCommit:
+		resolution.ResolutionType = lnrpc.Resolution_COMMIT
+	case channeldb.ResolverOutputIncomingHtlc:
+		resolution.ResolutionType = lnrpc.Resolution_INCOMING_HTLC
+	case channeldb.ResolverOutputOutgoingHtlc:
+		resolution.ResolutionType = lnrpc.Resolution_OUTGOING_HTLC
+	case channeldb.ResolverOutputRevoked:
+		resolution.ResolutionType = lnrpc.Resolution_REVOKED
+	}
+
+	switch report.Outcome {
+	case channeldb.ResolverOutcomeClaimed:
+		resolution.Outcome = lnrpc.Resolution_CLAIMED
+	case channeldb.ResolverOutcomeTimeout:
+		resolution.Outcome = lnrpc.Resolution_TIMEOUT
+	case channeldb.ResolverOutcomeLost:
+		resolution.Outcome = lnrpc.Resolution_LOST
+	case channeldb.ResolverOutcomeAbandoned:
+		resolution.Outcome = lnrpc.Resolution_ABANDONED
+	}
+
+	if report.SecondLevelTxid != nil {
+		resolution.SecondLevelTxid = report.SecondLevelTxid.String()
+	}
+	if report.SweepTxid != nil {
+		resolution.SweepTxid = report.SweepTxid.String()
+	}
+	resolution.SweepAddress = scriptAddress(report.SweepPkScript)
+
+	return resolution
+}
+
+// scriptAddress returns the address of the passed output script, or an empty
+// string if the script is empty or doesn't pay to a single address.
+func scriptAddress(pkScript []byte) string {
+	if len(pkScript) == 0 {
+		return ""
+	}
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		pkScript, activeNetParams.Params,
+	)
+	if err != nil || len(addrs) != 1 {
+		return ""
+	}
+
+	return addrs[0].String()
+}
+
 // ClosedChannels returns a list of all the channels have been closed.
 // This does not include channels that are still in the process of closing.
 func (r *rpcServer) ClosedChannels(ctx context.Context,
@@ -1799,6 +2480,18 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 			ClosingTxHash:     dbChannel.ClosingTXID.String(),
 		}
 
+		reports, err := r.server.chanDB.FetchResolverReports(
+			&dbChannel.ChanPoint,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, report := range reports {
+			channel.Resolutions = append(
+				channel.Resolutions, marshallResolverReport(report),
+			)
+		}
+
 		resp.Channels = append(resp.Channels, channel)
 	}
 
@@ -2012,6 +2705,55 @@ func calculateFeeLimit(feeLimit *lnrpc.FeeLimit,
 	}
 }
 
+// unmarshallRouteStrategy returns the EdgeScorer that implements the requested
+// route strategy. A nil EdgeScorer is returned for the default strategy, in
+// which case the router's default will be used.
+func unmarshallRouteStrategy(strategy lnrpc.SendRequest_RouteStrategy,
+	weights *lnrpc.RouteWeights,
+	source routing.ProbabilitySource) (routing.EdgeScorer, error) {
+
+	switch strategy {
+	case lnrpc.SendRequest_DEFAULT:
+		return nil, nil
+
+	case lnrpc.SendRequest_CHEAPEST:
+		return &routing.CheapestScorer{}, nil
+
+	case lnrpc.SendRequest_FASTEST:
+		return &routing.FastestScorer{}, nil
+
+	case lnrpc.SendRequest_MOST_RELIABLE:
+		return routing.NewReliabilityScorer(source), nil
+
+	case lnrpc.SendRequest_WEIGHTED:
+		if weights == nil {
+			return nil, errors.New("route weights must be " +
+				"specified for the weighted route strategy")
+		}
+
+		feeWeight := weights.FeeWeight
+		timeLockWeight := weights.TimeLockWeight
+		reliabilityWeight := weights.ReliabilityWeight
+		if feeWeight < 0 || timeLockWeight < 0 || reliabilityWeight < 0 {
+			return nil, errors.New("route weights must not be " +
+				"negative")
+		}
+		if feeWeight == 0 && timeLockWeight == 0 &&
+			reliabilityWeight == 0 {
+
+			return nil, errors.New("at least one route weight " +
+				"must be non-zero")
+		}
+
+		return routing.NewWeightedScorer(
+			feeWeight, timeLockWeight, reliabilityWeight, source,
+		), nil
+
+	default:
+		return nil, fmt.Errorf("unknown route strategy: %v", strategy)
+	}
+}
+
 // SendPayment dispatches a bi-directional streaming RPC for sending payments
 // through the Lightning Network. A single RPC invocation creates a persistent
 // bi-directional stream allowing clients to rapidly send payments through the
@@ -2098,6 +2840,10 @@ type rpcPaymentIntent struct {
 	cltvDelta  uint16
 	routeHints [][]routing.HopHint
 
+	routeStrategy lnrpc.SendRequest_RouteStrategy
+	routeWeights  *lnrpc.RouteWeights
+	constraints   routing.RouteConstraints
+
 	routes []*routing.Route
 }
 
@@ -2130,6 +2876,21 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		return payIntent, nil
 	}
 
+	// The route strategy applies to both payments specified through a
+	// payment request and through manual details.
+	payIntent.routeStrategy = rpcPayReq.RouteStrategy
+	payIntent.routeWeights = rpcPayReq.RouteWeights
+
+	// The same goes for any per-hop fee and CLTV limits.
+	if rpcPayReq.MaxHopFeeMsat < 0 {
+		return payIntent, fmt.Errorf("max hop fee cannot be negative")
+	}
+	payIntent.constraints = routing.RouteConstraints{
+		MaxHopFeeRate: rpcPayReq.MaxHopFeeRatePpm,
+		MaxHopFee:     lnwire.MilliSatoshi(rpcPayReq.MaxHopFeeMsat),
+		CltvLimit:     rpcPayReq.CltvLimit,
+	}
+
 	// If the payment request field isn't blank, then the details of the
 	// invoice are encoded entirely within the encoded payReq.  So we'll
 	// attempt to decode it, populating the payment accordingly.
@@ -2278,12 +3039,24 @@ func (r *rpcServer) dispatchPaymentIntent(
 	// If a route was specified, then we'll pass the route directly to the
 	// router, otherwise we'll create a payment session to execute it.
 	if len(payIntent.routes) == 0 {
+		scorer, err := unmarshallRouteStrategy(
+			payIntent.routeStrategy, payIntent.routeWeights,
+			r.server.chanRouter,
+		)
+		if err != nil {
+			return &paymentIntentResponse{
+				Err: err,
+			}, nil
+		}
+
 		payment := &routing.LightningPayment{
 			Target:      payIntent.dest,
 			Amount:      payIntent.msat,
 			FeeLimit:    payIntent.feeLimit,
 			PaymentHash: payIntent.rHash,
 			RouteHints:  payIntent.routeHints,
+			EdgeScorer:  scorer,
+			Constraints: payIntent.constraints,
 		}
 
 		// If the final CLTV value was specified, then we'll use that
@@ -2571,6 +3344,83 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 	}, nil
 }
 
+// ProbePayment attempts to determine whether a destination is able to receive
+// a payment of a particular amount, without actually sending any funds. The
+// probe is carried out using HTLCs with a random payment hash, so it will
+// never be settled by the destination, nor will it be stored as a payment.
+func (r *rpcServer) ProbePayment(ctx context.Context,
+	req *lnrpc.ProbePaymentRequest) (*lnrpc.ProbePaymentResponse, error) {
+
+	// We'll re-use the regular payment parsing logic in order to extract
+	// the destination, amount, and routing hints of the probe.
+	payIntent, err := extractPaymentIntent(&rpcPaymentRequest{
+		SendRequest: &lnrpc.SendRequest{
+			Dest:           req.Dest,
+			DestString:     req.DestString,
+			Amt:            req.Amt,
+			PaymentRequest: req.PaymentRequest,
+			FinalCltvDelta: req.FinalCltvDelta,
+			FeeLimit:       req.FeeLimit,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if payIntent.msat == 0 {
+		return nil, fmt.Errorf("amount to probe must be specified")
+	}
+
+	probe := &routing.LightningPayment{
+		Target:     payIntent.dest,
+		Amount:     payIntent.msat,
+		FeeLimit:   payIntent.feeLimit,
+		RouteHints: payIntent.routeHints,
+	}
+	if payIntent.cltvDelta != 0 {
+		probe.FinalCLTVDelta = &payIntent.cltvDelta
+	}
+
+	rpcsLog.Debugf("[probepayment] dest=%x, amt=%v",
+		payIntent.dest.SerializeCompressed(), payIntent.msat)
+
+	result, err := r.server.chanRouter.ProbePayment(probe)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ProbePaymentResponse{
+		Success:   result.Success,
+		Attempts:  make([]*lnrpc.ProbeAttempt, 0, len(result.Attempts)),
+		LatencyMs: int64(result.Latency / time.Millisecond),
+	}
+	if result.Route != nil {
+		resp.Route = r.marshallRoute(result.Route)
+	}
+	if result.Err != nil {
+		resp.ProbeError = result.Err.Error()
+	}
+
+	for _, attempt := range result.Attempts {
+		rpcAttempt := &lnrpc.ProbeAttempt{
+			Route:     r.marshallRoute(attempt.Route),
+			LatencyMs: int64(attempt.Latency / time.Millisecond),
+		}
+		if attempt.FailureSource != nil {
+			rpcAttempt.FailureSourcePubkey = hex.EncodeToString(
+				attempt.FailureSource.SerializeCompressed(),
+			)
+		}
+		if attempt.Failure != nil {
+			rpcAttempt.Failure = attempt.Failure.Code().String()
+		}
+
+		resp.Attempts = append(resp.Attempts, rpcAttempt)
+	}
+
+	return resp, nil
+}
+
 // AddInvoice attempts to add a new invoice to the invoice database. Any
 // duplicated invoices are rejected, therefore all invoices *must* have a
 // unique payment preimage.
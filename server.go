@@ -27,6 +27,7 @@ import (
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
@@ -138,6 +139,11 @@ type server struct {
 
 	fundingMgr *fundingManager
 
+	// chanAcceptor decides on the inbound channel requests of remote
+	// peers, on top of the checks of the funding manager. Clients of the
+	// ChannelAcceptor RPC register their acceptors with it.
+	chanAcceptor *chanacceptor.ChainedAcceptor
+
 	chanDB *channeldb.DB
 
 	htlcSwitch *htlcswitch.Switch
@@ -154,6 +160,12 @@ type server struct {
 
 	utxoNursery *utxoNursery
 
+	sweeper *sweep.UtxoSweeper
+
+	// sweepDest hands out the output scripts that swept funds, and our
+	// funds of cooperative closes, are sent to.
+	sweepDest *sweepDestination
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *htlcswitch.OnionProcessor
@@ -284,6 +296,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		peerConnectedListeners: make(map[string][]chan<- lnpeer.Peer),
 		sentDisabled:           make(map[wire.OutPoint]bool),
 
+		chanAcceptor: chanacceptor.NewChainedAcceptor(),
+
 		globalFeatures: lnwire.NewFeatureVector(globalFeatures,
 			lnwire.GlobalFeatures),
 		quit: make(chan struct{}),
@@ -522,7 +536,19 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				firstHop, htlcAdd, errorDecryptor,
 			)
 		},
-		ChannelPruneExpiry: time.Duration(time.Hour * 24 * 14),
+		SendProbeToSwitch: func(firstHop lnwire.ShortChannelID,
+			htlcAdd *lnwire.UpdateAddHTLC,
+			circuit *sphinx.Circuit) ([32]byte, error) {
+
+			errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
+				OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
+			}
+
+			return s.htlcSwitch.SendProbe(
+				firstHop, htlcAdd, errorDecryptor,
+			)
+		},
+		ChannelPruneExpiry: routing.DefaultChannelPruneExpiry,
 		GraphPruneInterval: time.Duration(time.Hour),
 		QueryBandwidth: func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
 			// If we aren't on either side of this edge, then we'll
@@ -588,24 +614,54 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
-	sweeper := sweep.New(&sweep.UtxoSweeperConfig{
-		Estimator: cc.feeEstimator,
-		GenSweepScript: func() ([]byte, error) {
-			return newSweepPkScript(cc.wallet)
+	sweeperStore, err := sweep.NewSweeperStore(
+		chanDB, activeNetParams.GenesisHash,
+	)
+	if err != nil {
+		srvrLog.Errorf("unable to create sweeper store: %v", err)
+		return nil, err
+	}
+
+	// We'll load the destination that swept funds are sent to. A
+	// destination specified within the config replaces the one that was
+	// previously set.
+	s.sweepDest, err = newSweepDestination(
+		chanDB, cc.wallet, activeNetParams.Params,
+	)
+	if err != nil {
+		srvrLog.Errorf("unable to load sweep destination: %v", err)
+		return nil, err
+	}
+	if cfg.SweepAddr != "" || cfg.SweepXPub != "" {
+		err := s.sweepDest.Set(cfg.SweepAddr, cfg.SweepXPub)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
+		Estimator:          cc.feeEstimator,
+		GenSweepScript:     s.sweepDest.GenSweepScript,
+		Signer:             cc.wallet.Cfg.Signer,
+		PublishTransaction: cc.wallet.PublishTransaction,
+		NewBatchTimer: func() <-chan time.Time {
+			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
 		},
-		Signer: cc.wallet.Cfg.Signer,
+		SweepTxConfTarget: 6,
+		Notifier:          cc.chainNotifier,
+		ChainIO:           cc.chainIO,
+		Store:             sweeperStore,
 	})
 
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
 		ChainIO:             cc.chainIO,
 		ConfDepth:           1,
-		SweepTxConfTarget:   6,
 		FetchClosedChannels: chanDB.FetchClosedChannels,
 		FetchClosedChannel:  chanDB.FetchClosedChannel,
 		Notifier:            cc.chainNotifier,
 		PublishTransaction:  cc.wallet.PublishTransaction,
 		Store:               utxnStore,
-		Sweeper:             sweeper,
+		SweepInput:          s.sweeper.SweepInput,
 	})
 
 	// Construct a closure that wraps the htlcswitch's CloseLink method.
@@ -613,22 +669,21 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		closureType htlcswitch.ChannelCloseType) {
 		// TODO(conner): Properly respect the update and error channels
 		// returned by CloseLink.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, 0, nil)
 	}
 
 	// We will use the following channel to reliably hand off contract
 	// breach events from the ChannelArbitrator to the breachArbiter,
 	contractBreaches := make(chan *ContractBreachEvent, 1)
+	contractBreachReorgs := make(chan *ContractBreachReorgEvent, 1)
 
 	s.chainArb = contractcourt.NewChainArbitrator(contractcourt.ChainArbitratorConfig{
 		ChainHash: *activeNetParams.GenesisHash,
 		// TODO(roasbeef): properly configure
 		//  * needs to be << or specified final hop time delta
 		BroadcastDelta: defaultBroadcastDelta,
-		NewSweepAddr: func() ([]byte, error) {
-			return newSweepPkScript(cc.wallet)
-		},
-		PublishTx: cc.wallet.PublishTransaction,
+		NewSweepAddr:   s.sweepDest.GenSweepScript,
+		PublishTx:      cc.wallet.PublishTransaction,
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
 			for _, msg := range msgs {
 				err := s.htlcSwitch.ProcessContractResolution(msg)
@@ -694,24 +749,47 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				return ErrServerShuttingDown
 			}
 		},
+		ContractBreachReorg: func(chanPoint wire.OutPoint) error {
+			event := &ContractBreachReorgEvent{
+				ChanPoint:  chanPoint,
+				ProcessACK: make(chan error, 1),
+			}
+
+			// Send the breach reorg event to the breachArbiter.
+			select {
+			case contractBreachReorgs <- event:
+			case <-s.quit:
+				return ErrServerShuttingDown
+			}
+
+			// Wait for the breachArbiter to ACK the event.
+			select {
+			case err := <-event.ProcessACK:
+				return err
+			case <-s.quit:
+				return ErrServerShuttingDown
+			}
+		},
 		DisableChannel: func(op wire.OutPoint) error {
 			return s.announceChanStatus(op, true)
 		},
-		Sweeper: sweeper,
+		Sweeper:                s.sweeper,
+		ForceCloseUneconomical: cfg.ForceCloseUneconomical,
+		GoOnChainConfTarget:    cfg.GoOnChainConfTarget,
+		MinGoOnChainValueRatio: cfg.MinGoOnChainValueRatio,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
-		CloseLink: closeLink,
-		DB:        chanDB,
-		Estimator: s.cc.feeEstimator,
-		GenSweepScript: func() ([]byte, error) {
-			return newSweepPkScript(cc.wallet)
-		},
-		Notifier:           cc.chainNotifier,
-		PublishTransaction: cc.wallet.PublishTransaction,
-		ContractBreaches:   contractBreaches,
-		Signer:             cc.wallet.Cfg.Signer,
-		Store:              newRetributionStore(chanDB),
+		CloseLink:            closeLink,
+		DB:                   chanDB,
+		Estimator:            s.cc.feeEstimator,
+		GenSweepScript:       s.sweepDest.GenSweepScript,
+		Notifier:             cc.chainNotifier,
+		PublishTransaction:   cc.wallet.PublishTransaction,
+		ContractBreaches:     contractBreaches,
+		ContractBreachReorgs: contractBreachReorgs,
+		Signer:               cc.wallet.Cfg.Signer,
+		Store:                newRetributionStore(chanDB),
 	})
 
 	// Select the configuration and furnding parameters for Bitcoin or
@@ -726,6 +804,26 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		maxRemoteDelay = maxLtcRemoteDelay
 	}
 
+	// We'll only accept zero-conf channels from the peers we've been
+	// configured to trust.
+	zeroConfPeers := make(map[[33]byte]struct{}, len(cfg.ZeroConfPeers))
+	for _, peer := range cfg.ZeroConfPeers {
+		pubKeyBytes, err := hex.DecodeString(peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid zero-conf peer %v: %v",
+				peer, err)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid zero-conf peer %v: %v",
+				peer, err)
+		}
+
+		var pubKeySer [33]byte
+		copy(pubKeySer[:], pubKey.SerializeCompressed())
+		zeroConfPeers[pubKeySer] = struct{}{}
+	}
+
 	nodeSigner := newNodeSigner(privKey)
 	var chanIDSeed [32]byte
 	if _, err := rand.Read(chanIDSeed[:]); err != nil {
@@ -800,6 +898,13 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			// TODO(halseth): Use 1 as minimum?
 			minConf := uint64(3)
 			maxConf := uint64(6)
+
+			// Channels above the soft-limit for channel size
+			// always require the max number of confirmations.
+			if chanAmt > maxFundingAmount {
+				return uint16(maxConf)
+			}
+
 			maxChannelSize := uint64(
 				lnwire.NewMSatFromSatoshis(maxFundingAmount))
 			stake := lnwire.NewMSatFromSatoshis(chanAmt) + pushAmt
@@ -828,6 +933,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				return defaultDelay
 			}
 
+			// Channels above the soft-limit for channel size
+			// always require the max remote delay.
+			if chanAmt > maxFundingAmount {
+				return maxRemoteDelay
+			}
+
 			// If not we scale according to channel size.
 			delay := uint16(btcutil.Amount(maxRemoteDelay) *
 				chanAmt / maxFundingAmount)
@@ -862,10 +973,16 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 
 			// By default, we'll require the remote peer to maintain
 			// at least 1% of the total channel capacity at all
-			// times. If this value ends up dipping below the dust
+			// times, unless the user has specified a default
+			// reserve. If this value ends up dipping below the dust
 			// limit, then we'll use the dust limit itself as the
 			// reserve as required by BOLT #2.
 			reserve := chanAmt / 100
+			if chainCfg.DefaultRemoteChanReserve > 0 {
+				reserve = btcutil.Amount(
+					chainCfg.DefaultRemoteChanReserve,
+				)
+			}
 			if reserve < dustLimit {
 				reserve = dustLimit
 			}
@@ -873,13 +990,31 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			return reserve
 		},
 		RequiredRemoteMaxValue: func(chanAmt btcutil.Amount) lnwire.MilliSatoshi {
+			// In case the user has explicitly specified a default
+			// value, we use it, as long as it doesn't exceed the
+			// channel capacity.
+			capacity := lnwire.NewMSatFromSatoshis(chanAmt)
+			defaultMaxValue := chainCfg.DefaultRemoteMaxValueInFlight
+			if defaultMaxValue > 0 {
+				if defaultMaxValue > capacity {
+					return capacity
+				}
+				return defaultMaxValue
+			}
+
 			// By default, we'll allow the remote peer to fully
 			// utilize the full bandwidth of the channel, minus our
 			// required reserve.
 			reserve := lnwire.NewMSatFromSatoshis(chanAmt / 100)
-			return lnwire.NewMSatFromSatoshis(chanAmt) - reserve
+			return capacity - reserve
 		},
 		RequiredRemoteMaxHTLCs: func(chanAmt btcutil.Amount) uint16 {
+			// In case the user has explicitly specified a default
+			// value, we use it.
+			if chainCfg.DefaultRemoteMaxHTLCs > 0 {
+				return chainCfg.DefaultRemoteMaxHTLCs
+			}
+
 			// By default, we'll permit them to utilize the full
 			// channel bandwidth.
 			return uint16(lnwallet.MaxHTLCNumber / 2)
@@ -887,6 +1022,36 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ZombieSweeperInterval: 1 * time.Minute,
 		ReservationTimeout:    10 * time.Minute,
 		MinChanSize:           btcutil.Amount(cfg.MinChanSize),
+		DualFunding:           cfg.DualFunding,
+		MaxDualFundContribution: btcutil.Amount(
+			cfg.MaxDualFundContribution,
+		),
+		WumboChannels:        cfg.WumboChans,
+		MaxChanSize:          btcutil.Amount(cfg.MaxChanSize),
+		OpenChannelPredicate: s.chanAcceptor,
+		AllowZeroConf: func(peer *btcec.PublicKey) bool {
+			var pubKey [33]byte
+			copy(pubKey[:], peer.SerializeCompressed())
+
+			_, ok := zeroConfPeers[pubKey]
+			return ok
+		},
+		DeleteAliasEdge: s.chanRouter.DeleteAliasEdge,
+		FailZeroConfChannel: func(chanPoint wire.OutPoint) error {
+			// The channel can no longer be used to forward HTLCs,
+			// nor will its funding output ever exist, so we'll
+			// remove it from the switch, the channel graph and the
+			// chain arbitrator.
+			chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+			s.htlcSwitch.RemoveLink(chanID)
+
+			err := s.chanRouter.DeleteAliasEdge(&chanPoint)
+			if err != nil {
+				return err
+			}
+
+			return s.chainArb.ResolveContract(chanPoint)
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -951,6 +1116,9 @@ func (s *server) Start() error {
 	if err := s.htlcSwitch.Start(); err != nil {
 		return err
 	}
+	if err := s.sweeper.Start(); err != nil {
+		return err
+	}
 	if err := s.utxoNursery.Start(); err != nil {
 		return err
 	}
@@ -1037,6 +1205,7 @@ func (s *server) Stop() error {
 	s.breachArbiter.Stop()
 	s.authGossiper.Stop()
 	s.chainArb.Stop()
+	s.sweeper.Stop()
 	s.cc.wallet.Shutdown()
 	s.cc.chainView.Stop()
 	s.connMgr.Stop()
@@ -2301,9 +2470,35 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	localFeatures := lnwire.NewRawFeatureVector()
 
 	// We'll signal that we understand the data loss protection feature,
-	// and also that we support the new gossip query features.
+	// that we support the new gossip query features and upfront shutdown
+	// scripts.
 	localFeatures.Set(lnwire.DataLossProtectOptional)
 	localFeatures.Set(lnwire.GossipQueriesOptional)
+	localFeatures.Set(lnwire.UpfrontShutdownScriptOptional)
+
+	// Only signal that we understand zero-conf channels if there's any
+	// peer we trust to open them with us.
+	if len(cfg.ZeroConfPeers) != 0 {
+		localFeatures.Set(lnwire.ZeroConfOptional)
+	}
+
+	// Only signal support for channels above the soft-limit of BOLT-0002
+	// if we've been configured to open and accept them.
+	if cfg.WumboChans {
+		localFeatures.Set(lnwire.WumboChannelsOptional)
+	}
+
+	// Likewise, we'll only signal that we're able to construct funding
+	// transactions interactively if we've been configured to do so, as
+	// the feature is experimental.
+	if cfg.DualFunding {
+		localFeatures.Set(lnwire.DualFundOptional)
+	}
+
+	// The same goes for splicing active channels.
+	if cfg.Splicing {
+		localFeatures.Set(lnwire.SpliceOptional)
+	}
 
 	// Now that we've established a connection, create a peer, and it to
 	// the set of currently active peers.
@@ -2621,7 +2816,37 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
-	// TODO(roasbeef): add ability to specify channel constraints as well
+	// psbtFunding indicates that the funding transaction will be crafted
+	// by an external wallet, and supplied as a signed PSBT once the remote
+	// party has accepted the channel.
+	psbtFunding bool
+
+	// shutdownScript is an optional upfront shutdown script for the
+	// channel. If set, the channel's cooperative close must pay our funds
+	// to this script.
+	shutdownScript lnwire.DeliveryAddress
+
+	// zeroConf indicates that the channel should be considered open before
+	// its funding transaction confirms. This requires the remote peer to
+	// trust us, as we'd be able to double spend the funding transaction.
+	zeroConf bool
+
+	// remoteChanReserve is the channel reserve we require the remote
+	// party to keep. If zero, it's derived from the channel capacity.
+	remoteChanReserve btcutil.Amount
+
+	// dustLimit is the dust limit of our commitment transaction. If zero,
+	// the default dust limit of the wallet is used.
+	dustLimit btcutil.Amount
+
+	// remoteMaxHtlcs is the maximum number of HTLCs the remote party may
+	// offer us at once. If zero, it's derived from the channel capacity.
+	remoteMaxHtlcs uint16
+
+	// remoteMaxValue is the maximum value of the HTLCs the remote party
+	// may offer us at once. If zero, it's derived from the channel
+	// capacity.
+	remoteMaxValue lnwire.MilliSatoshi
 
 	updates chan *lnrpc.OpenStatusUpdate
 	err     chan error
@@ -2802,6 +3027,50 @@ func (s *server) OpenChannel(
 	return req.updates, req.err
 }
 
+// BatchOpenChannel opens a channel with each of the target peers of the given
+// requests, funding all of them within a single funding transaction. It
+// blocks until the funding transaction has been broadcast, returning the
+// funding outpoints of the channels in the order of the requests.
+func (s *server) BatchOpenChannel(reqs []*openChanReq,
+	feeRate lnwallet.SatPerKWeight, minConfs int32) ([]*wire.OutPoint,
+	error) {
+
+	// First, we'll ensure that we're connected to every peer we'll be
+	// opening a channel with.
+	peers := make([]lnpeer.Peer, 0, len(reqs))
+	s.mu.RLock()
+	for _, req := range reqs {
+		pubKeyBytes := req.targetPubkey.SerializeCompressed()
+		peer, ok := s.peersByPub[string(pubKeyBytes)]
+		if !ok {
+			s.mu.RUnlock()
+			return nil, fmt.Errorf("peer %x is not online",
+				pubKeyBytes)
+		}
+		peers = append(peers, peer)
+	}
+	s.mu.RUnlock()
+
+	// If the fee rate wasn't specified, then we'll use a default
+	// confirmation target.
+	if feeRate == 0 {
+		var err error
+		feeRate, err = s.cc.feeEstimator.EstimateFeePerKW(6)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, req := range reqs {
+		req.updates = make(chan *lnrpc.OpenStatusUpdate, 2)
+		req.err = make(chan error, 1)
+		req.fundingFeePerKw = feeRate
+		req.minConfs = minConfs
+	}
+
+	return s.fundingMgr.BatchFund(peers, reqs, feeRate, minConfs)
+}
+
 // Peers returns a slice of all active peers.
 //
 // NOTE: This function is safe for concurrent access.
@@ -0,0 +1,200 @@
+package sweep
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+var testChainHash = chainhash.Hash{1, 2, 3}
+
+func makeTestDB() (*channeldb.DB, func(), error) {
+	tempDirName, err := ioutil.TempDir("", "sweeperstore")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := channeldb.Open(tempDirName)
+	if err != nil {
+		os.RemoveAll(tempDirName)
+		return nil, nil, err
+	}
+
+	cleanUp := func() {
+		db.Close()
+		os.RemoveAll(tempDirName)
+	}
+
+	return db, cleanUp, nil
+}
+
+// putLegacyFinalizedTx writes a finalized kindergarten sweep tx at the given
+// height, in the layout used by the utxo nursery before it was migrated onto
+// the sweeper.
+func putLegacyFinalizedTx(db *channeldb.DB, height uint32,
+	sweepTx *wire.MsgTx) error {
+
+	return db.Update(func(tx *bolt.Tx) error {
+		chainBucketKey := append(
+			append([]byte{}, utxnChainPrefix...), testChainHash[:]...,
+		)
+		chainBucket, err := tx.CreateBucketIfNotExists(chainBucketKey)
+		if err != nil {
+			return err
+		}
+
+		hghtIndex, err := chainBucket.CreateBucketIfNotExists(
+			utxnHeightIndexKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		var heightBytes [4]byte
+		byteOrder.PutUint32(heightBytes[:], height)
+		hghtBucket, err := hghtIndex.CreateBucketIfNotExists(
+			heightBytes[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := sweepTx.Serialize(&b); err != nil {
+			return err
+		}
+
+		return hghtBucket.Put(utxnFinalizedKndrTxnKey, b.Bytes())
+	})
+}
+
+func createTestTx(lockTime uint32) *wire.MsgTx {
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: lockTime},
+	})
+	tx.AddTxOut(&wire.TxOut{Value: 1000})
+	tx.LockTime = lockTime
+
+	return tx
+}
+
+// TestSweeperStore asserts that the sweeper store reports the txes it was
+// notified of as ours, and returns the last one of them.
+func TestSweeperStore(t *testing.T) {
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to open channel db: %v", err)
+	}
+	defer cleanUp()
+
+	store, err := NewSweeperStore(db, &testChainHash)
+	if err != nil {
+		t.Fatalf("unable to create sweeper store: %v", err)
+	}
+
+	lastTx, err := store.GetLastPublishedTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastTx != nil {
+		t.Fatal("expected no last published tx")
+	}
+
+	tx1 := createTestTx(1)
+	tx2 := createTestTx(2)
+	for _, tx := range []*wire.MsgTx{tx1, tx2} {
+		if err := store.NotifyPublishTx(tx); err != nil {
+			t.Fatalf("unable to notify publish tx: %v", err)
+		}
+	}
+
+	// Reopen the store to assert that the txes are persisted.
+	store, err = NewSweeperStore(db, &testChainHash)
+	if err != nil {
+		t.Fatalf("unable to create sweeper store: %v", err)
+	}
+
+	lastTx, err = store.GetLastPublishedTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastTx == nil || lastTx.TxHash() != tx2.TxHash() {
+		t.Fatalf("expected last published tx %v", tx2.TxHash())
+	}
+
+	assertIsOurTx(t, store, tx1, true)
+	assertIsOurTx(t, store, tx2, true)
+	assertIsOurTx(t, store, createTestTx(3), false)
+}
+
+// TestSweeperStoreNurseryMigration asserts that the kindergarten sweep txes
+// finalized by the utxo nursery are recognized as ours after the upgrade,
+// and that the most recent one is returned as the last published tx.
+func TestSweeperStoreNurseryMigration(t *testing.T) {
+	db, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to open channel db: %v", err)
+	}
+	defer cleanUp()
+
+	tx1 := createTestTx(1)
+	tx2 := createTestTx(2)
+	if err := putLegacyFinalizedTx(db, 200, tx2); err != nil {
+		t.Fatalf("unable to write finalized tx: %v", err)
+	}
+	if err := putLegacyFinalizedTx(db, 100, tx1); err != nil {
+		t.Fatalf("unable to write finalized tx: %v", err)
+	}
+
+	store, err := NewSweeperStore(db, &testChainHash)
+	if err != nil {
+		t.Fatalf("unable to create sweeper store: %v", err)
+	}
+
+	assertIsOurTx(t, store, tx1, true)
+	assertIsOurTx(t, store, tx2, true)
+
+	lastTx, err := store.GetLastPublishedTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastTx == nil || lastTx.TxHash() != tx2.TxHash() {
+		t.Fatalf("expected last published tx %v", tx2.TxHash())
+	}
+
+	// The migration should only run once, so that a later finalized tx is
+	// no longer picked up.
+	tx3 := createTestTx(3)
+	if err := putLegacyFinalizedTx(db, 300, tx3); err != nil {
+		t.Fatalf("unable to write finalized tx: %v", err)
+	}
+
+	store, err = NewSweeperStore(db, &testChainHash)
+	if err != nil {
+		t.Fatalf("unable to create sweeper store: %v", err)
+	}
+
+	assertIsOurTx(t, store, tx3, false)
+}
+
+func assertIsOurTx(t *testing.T, store SweeperStore, tx *wire.MsgTx,
+	expected bool) {
+
+	t.Helper()
+
+	ours, err := store.IsOurTx(tx.TxHash())
+	if err != nil {
+		t.Fatalf("unable to query store: %v", err)
+	}
+	if ours != expected {
+		t.Fatalf("expected tx %v ours=%v, got %v", tx.TxHash(),
+			expected, ours)
+	}
+}
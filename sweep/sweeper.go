@@ -1,18 +1,118 @@
 package sweep
 
 import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
 	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
-// UtxoSweeper provides the functionality to generate sweep txes. The plan is
-// to extend UtxoSweeper in the future to also manage the actual sweeping
-// process by itself.
+var (
+	// ErrRemoteSpend is returned in case an output that we try to sweep is
+	// confirmed in a tx of the remote party.
+	ErrRemoteSpend = errors.New("remote party swept utxo")
+
+	// ErrSweeperShuttingDown is an error returned when a client attempts
+	// to make a request to the UtxoSweeper, but it is unable to handle it
+	// as it is/has already been stopped.
+	ErrSweeperShuttingDown = errors.New("utxo sweeper shutting down")
+)
+
+const (
+	// DefaultBatchWindowDuration specifies how long the sweeper waits
+	// after an input matures before it sweeps it. This gives the sweeper
+	// the opportunity to batch inputs of different channels that mature
+	// around the same time into a single transaction.
+	DefaultBatchWindowDuration = 5 * time.Second
+)
+
+// Result is the struct that is pushed through the result channel. Callers
+// can use this to be informed of the final sweep result. In case of a remote
+// spend, Err will be ErrRemoteSpend.
+type Result struct {
+	// Err is the final result of the sweep. It is nil when the input is
+	// swept successfully by us. ErrRemoteSpend is returned when another
+	// party took the input.
+	Err error
+
+	// Tx is the transaction that spent the input.
+	Tx *wire.MsgTx
+}
+
+// pendingInput is created when an input is offered to the sweeper. It tracks
+// the input until it has been spent.
+type pendingInput struct {
+	// listeners is a list of channels over which the final outcome of the
+	// sweep needs to be broadcasted.
+	listeners []chan Result
+
+	// input is the original struct that contains the input and sign
+	// descriptor.
+	input Input
+
+	// maturityHeight is the height at which the input can be swept. The
+	// input is included in a sweep tx once the chain has reached this
+	// height.
+	maturityHeight uint32
+
+	// sweepTx is the hash of the last sweep tx that we published spending
+	// this input. It is nil if the input hasn't been swept yet, or if
+	// that sweep tx turned out to be invalid.
+	sweepTx *chainhash.Hash
+
+	// ntfnRegCancel is populated with a function that cancels the chain
+	// notifier spend registration.
+	ntfnRegCancel func()
+}
+
+// sweepInputMessage structs are used in the internal channel between the
+// SweepInput call and the sweeper main loop.
+type sweepInputMessage struct {
+	input          Input
+	maturityHeight uint32
+	heightHint     uint32
+	resultChan     chan Result
+}
+
+// UtxoSweeper is responsible for sweeping outputs back into the wallet. Inputs
+// are offered to the sweeper together with the height at which they mature.
+// Once mature, the sweeper batches them with the other mature inputs it is
+// tracking into a single sweep transaction, and reports back to the callers
+// once their inputs are spent.
 type UtxoSweeper struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
 	cfg *UtxoSweeperConfig
+
+	newInputs chan *sweepInputMessage
+	spendChan chan *chainntnfs.SpendDetail
+
+	// pendingInputs is the total set of inputs the UtxoSweeper has been
+	// requested to sweep.
+	pendingInputs map[wire.OutPoint]*pendingInput
+
+	// timer is the channel that signals expiry of the batch window. It is
+	// nil if no sweep is scheduled.
+	timer <-chan time.Time
+
+	// currentOutputScript is the script that sweep txes currently pay to.
+	// It's reused until a sweep tx paying to it has been published, such
+	// that retrying a sweep doesn't use up a new address each time.
+	currentOutputScript []byte
+
+	quit chan struct{}
+	wg   sync.WaitGroup
 }
 
 // UtxoSweeperConfig contains dependencies of UtxoSweeper.
@@ -29,13 +129,496 @@ type UtxoSweeperConfig struct {
 	// Signer is used by the sweeper to generate valid witnesses at the
 	// time the incubated outputs need to be spent.
 	Signer lnwallet.Signer
+
+	// PublishTransaction facilitates the process of broadcasting a signed
+	// transaction to the appropriate network.
+	PublishTransaction func(*wire.MsgTx) error
+
+	// NewBatchTimer creates a channel that will be sent on when a certain
+	// time window has passed. During this time window, new inputs can
+	// still be added to the sweep tx that is about to be generated.
+	NewBatchTimer func() <-chan time.Time
+
+	// Notifier is an instance of a chain notifier we'll use to watch for
+	// certain on-chain events.
+	Notifier chainntnfs.ChainNotifier
+
+	// ChainIO is used to determine the current block height.
+	ChainIO lnwallet.BlockChainIO
+
+	// Store stores the published sweeper txes.
+	Store SweeperStore
+
+	// SweepTxConfTarget assigns a confirmation target for sweep txes on
+	// which the fee calculation will be based.
+	SweepTxConfTarget uint32
 }
 
 // New returns a new UtxoSweeper instance.
 func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
 	return &UtxoSweeper{
-		cfg: cfg,
+		cfg:           cfg,
+		newInputs:     make(chan *sweepInputMessage),
+		spendChan:     make(chan *chainntnfs.SpendDetail),
+		pendingInputs: make(map[wire.OutPoint]*pendingInput),
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start starts the process of constructing and publishing sweep txes.
+func (s *UtxoSweeper) Start() error {
+	if !atomic.CompareAndSwapUint32(&s.started, 0, 1) {
+		return nil
+	}
+
+	log.Tracef("Sweeper starting")
+
+	// Retrieve last published tx from database.
+	lastTx, err := s.cfg.Store.GetLastPublishedTx()
+	if err != nil {
+		return err
+	}
+
+	// Republish in case the previous call crashed lnd. We don't care about
+	// the return value, because inputs will be re-offered and retried
+	// anyway. The only reason we republish here is to prevent the corner
+	// case where lnd goes into a restart loop because of a crashing
+	// publish tx where we keep deriving new output script. By publishing
+	// and possibly crashing already now, we haven't derived a new output
+	// script yet.
+	if lastTx != nil {
+		log.Debugf("Publishing last tx %v", lastTx.TxHash())
+
+		err := s.cfg.PublishTransaction(lastTx)
+		if err != nil && err != lnwallet.ErrDoubleSpend {
+			log.Errorf("last tx publish: %v", err)
+		}
+	}
+
+	// Register for block epochs to retry sweeping every block.
+	_, bestHeight, err := s.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	blockEpochs, err := s.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+
+	// Start sweeper main loop.
+	s.wg.Add(1)
+	go func() {
+		defer blockEpochs.Cancel()
+		defer s.wg.Done()
+
+		s.collector(blockEpochs.Epochs, bestHeight)
+	}()
+
+	return nil
+}
+
+// Stop stops sweeper from listening to block epochs and constructing sweep
+// txes.
+func (s *UtxoSweeper) Stop() error {
+	if !atomic.CompareAndSwapUint32(&s.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Debugf("Sweeper shutting down")
+
+	close(s.quit)
+	s.wg.Wait()
+
+	log.Debugf("Sweeper shut down")
+
+	return nil
+}
+
+// SweepInput sweeps inputs back into the wallet. The input will be swept
+// once the chain has reached its maturity height, batched together with
+// other mature inputs. For inputs that can be spent right away, the maturity
+// height should be set to zero. The height hint is used to look for a
+// confirmed spend of the input, and should therefore be a height at which it
+// can't have been spent yet.
+//
+// The caller can use the returned result channel to be informed of the final
+// outcome of the sweep: the input is either swept by one of our sweep txes,
+// or by a tx of another party, in which case ErrRemoteSpend is returned.
+// Inputs offered more than once are only swept once, but the final outcome is
+// reported to each of the callers.
+func (s *UtxoSweeper) SweepInput(input Input, maturityHeight,
+	heightHint uint32) (chan Result, error) {
+
+	if input == nil || input.OutPoint() == nil || input.SignDesc() == nil {
+		return nil, errors.New("nil input received")
+	}
+
+	log.Infof("Sweep request received: out_point=%v, witness_type=%v, "+
+		"maturity_height=%v, amount=%v", input.OutPoint(),
+		input.WitnessType(), maturityHeight,
+		btcutil.Amount(input.SignDesc().Output.Value))
+
+	sweeperInput := &sweepInputMessage{
+		input:          input,
+		maturityHeight: maturityHeight,
+		heightHint:     heightHint,
+		resultChan:     make(chan Result, 1),
+	}
+
+	// Deliver input to main event loop.
+	select {
+	case s.newInputs <- sweeperInput:
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+
+	return sweeperInput.resultChan, nil
+}
+
+// collector is the sweeper main loop. It processes new inputs, spend
+// notifications and counts down to publication of the sweep tx.
+func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
+	bestHeight int32) {
+
+	for {
+		select {
+		// A new input is offered to the sweeper. We check to see if
+		// we are already trying to sweep this input and if not, set up
+		// a listener for spend and schedule a sweep.
+		case input := <-s.newInputs:
+			outpoint := *input.input.OutPoint()
+			pendInput, pending := s.pendingInputs[outpoint]
+			if pending {
+				log.Debugf("Already pending input %v received",
+					outpoint)
+
+				// Add additional result channel to signal
+				// spend of this input.
+				pendInput.listeners = append(
+					pendInput.listeners, input.resultChan,
+				)
+				continue
+			}
+
+			// Create a new pendInput and initialize the listeners
+			// slice with the passed in result channel. If this
+			// input is offered for sweep again, the result channel
+			// will be appended to this slice.
+			pendInput = &pendingInput{
+				listeners:      []chan Result{input.resultChan},
+				input:          input.input,
+				maturityHeight: input.maturityHeight,
+			}
+			s.pendingInputs[outpoint] = pendInput
+
+			// Start watching for spend of this input, either by us
+			// or the remote party.
+			cancel, err := s.waitForSpend(
+				outpoint,
+				input.input.SignDesc().Output.PkScript,
+				input.heightHint,
+			)
+			if err != nil {
+				err := fmt.Errorf("wait for spend: %v", err)
+				s.signalAndRemove(&outpoint, Result{Err: err})
+				continue
+			}
+			pendInput.ntfnRegCancel = cancel
+
+			// Check to see if with this new input a sweep tx
+			// should be scheduled.
+			s.scheduleSweep(bestHeight)
+
+		// A spend of one of our inputs is detected. Signal sweep
+		// results to the caller(s).
+		case spend := <-s.spendChan:
+			// Query store to find out if we ever published this
+			// tx.
+			spendHash := *spend.SpenderTxHash
+			isOurTx, err := s.cfg.Store.IsOurTx(spendHash)
+			if err != nil {
+				log.Errorf("cannot determine if tx %v "+
+					"is ours: %v", spendHash, err,
+				)
+				continue
+			}
+
+			log.Debugf("Detected spend related to in flight inputs "+
+				"(is_ours=%v): %v", isOurTx,
+				newLogClosure(func() string {
+					return spew.Sdump(spend.SpendingTx)
+				}),
+			)
+
+			// Signal sweep results for inputs in this confirmed
+			// tx.
+			for _, txIn := range spend.SpendingTx.TxIn {
+				outpoint := txIn.PreviousOutPoint
+
+				// Check if this input is known to us. It could
+				// probably be unknown if we canceled the
+				// registration, deleted from pendingInputs but
+				// the ntfn was in-flight already. Or this
+				// could be not one of our inputs.
+				input, ok := s.pendingInputs[outpoint]
+				if !ok {
+					continue
+				}
+
+				// Return either a nil or a remote spend result.
+				var err error
+				if !isOurTx {
+					err = ErrRemoteSpend
+
+					// Our sweep tx that spends this input
+					// can no longer confirm, so the other
+					// inputs it spends need to be swept
+					// again.
+					s.resetSweepTx(input.sweepTx)
+				}
+
+				// Signal result channels.
+				s.signalAndRemove(&outpoint, Result{
+					Tx:  spend.SpendingTx,
+					Err: err,
+				})
+			}
+
+			// Now that an input of ours is spent, we can try to
+			// resweep the remaining inputs.
+			s.scheduleSweep(bestHeight)
+
+		// The timer expires and we are going to (re)sweep.
+		case <-s.timer:
+			log.Debugf("Sweep timer expired")
+
+			// Set timer to nil so we know that a new timer needs to
+			// be started when new inputs arrive.
+			s.timer = nil
+
+			// Sweep all inputs that are mature at the current
+			// height in a single tx.
+			s.sweepMatureInputs(bestHeight)
+
+		// A new block comes in. Things may have changed, so we retry a
+		// sweep.
+		case epoch, ok := <-blockEpochs:
+			if !ok {
+				return
+			}
+
+			bestHeight = epoch.Height
+
+			log.Debugf("New blocks: height=%v, sha=%v",
+				epoch.Height, epoch.Hash)
+
+			s.scheduleSweep(bestHeight)
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// matureInputs returns the inputs that are mature at the given height, and
+// aren't already spent by a sweep tx that we published.
+func (s *UtxoSweeper) matureInputs(bestHeight int32) []Input {
+	var inputs []Input
+	for _, pendInput := range s.pendingInputs {
+		if pendInput.sweepTx != nil {
+			continue
+		}
+
+		if pendInput.maturityHeight > uint32(bestHeight) {
+			continue
+		}
+
+		inputs = append(inputs, pendInput.input)
+	}
+
+	return inputs
+}
+
+// scheduleSweep starts the sweep timer to create an opportunity for more
+// inputs to be added, if there are mature inputs that haven't been swept yet.
+func (s *UtxoSweeper) scheduleSweep(bestHeight int32) {
+	// The timer is already ticking, no action needed for the sweep to
+	// happen.
+	if s.timer != nil {
+		log.Debugf("Timer still ticking")
+		return
 	}
+
+	// If there are no mature inputs that are waiting to be swept, there
+	// is nothing to schedule.
+	if len(s.matureInputs(bestHeight)) == 0 {
+		return
+	}
+
+	// Start sweep timer to create opportunity for more inputs to be added
+	// before a tx is constructed.
+	s.timer = s.cfg.NewBatchTimer()
+
+	log.Debugf("Sweep timer started")
+}
+
+// sweepMatureInputs creates and publishes a single sweep tx spending all of
+// the inputs that are mature at the given height.
+func (s *UtxoSweeper) sweepMatureInputs(bestHeight int32) {
+	inputs := s.matureInputs(bestHeight)
+	if len(inputs) == 0 {
+		return
+	}
+
+	// Create sweep tx.
+	tx, err := s.CreateSweepTx(
+		inputs, s.cfg.SweepTxConfTarget, uint32(bestHeight),
+	)
+	if err != nil {
+		log.Errorf("unable to create sweep tx for %v inputs at "+
+			"height=%v: %v", len(inputs), bestHeight, err)
+		return
+	}
+
+	// Add tx before publication, so that we will always know that a spend
+	// by this tx is ours. Otherwise if the publish doesn't return, but did
+	// publish, we lose track of this tx. Even republication on startup
+	// doesn't prevent this, because that call returns a double spend
+	// error then and would also not add the hash to the store.
+	err = s.cfg.Store.NotifyPublishTx(tx)
+	if err != nil {
+		log.Errorf("unable to notify publish tx: %v", err)
+		return
+	}
+
+	txHash := tx.TxHash()
+
+	log.Infof("Sweeping %v inputs with tx %v: %v", len(tx.TxIn),
+		txHash, newLogClosure(func() string {
+			return spew.Sdump(tx)
+		}),
+	)
+
+	// Mark the inputs as swept by this tx, so that they won't be swept
+	// again unless this tx turns out to be invalid.
+	for _, txIn := range tx.TxIn {
+		pendInput, ok := s.pendingInputs[txIn.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+		pendInput.sweepTx = &txHash
+	}
+
+	// Publish sweep tx. A double spend means that one of the inputs has
+	// already been spent by another tx, either one of our previous sweeps
+	// or one of the remote party. Either way, we'll learn about it
+	// through the spend notification of the input.
+	err = s.cfg.PublishTransaction(tx)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		log.Errorf("unable to publish sweep tx %v: %v", txHash, err)
+
+		// Retry the sweep of these inputs on the next block.
+		s.resetSweepTx(&txHash)
+		return
+	}
+
+	// Now that a tx paying to the current output script has been
+	// published, the next sweep tx should pay to a fresh one.
+	if err == nil {
+		s.currentOutputScript = nil
+	}
+}
+
+// resetSweepTx marks all inputs spent by the given sweep tx as not swept,
+// making them eligible to be included in the next sweep tx.
+func (s *UtxoSweeper) resetSweepTx(sweepTx *chainhash.Hash) {
+	if sweepTx == nil {
+		return
+	}
+
+	for _, pendInput := range s.pendingInputs {
+		if pendInput.sweepTx != nil && *pendInput.sweepTx == *sweepTx {
+			pendInput.sweepTx = nil
+		}
+	}
+}
+
+// signalAndRemove notifies the listeners of the final result of the input
+// sweep. It cancels any pending spend notification and removes the input from
+// the list of pending inputs. When this function returns, the sweeper has
+// completely forgotten about the input.
+func (s *UtxoSweeper) signalAndRemove(outpoint *wire.OutPoint, result Result) {
+	pendInput := s.pendingInputs[*outpoint]
+	listeners := pendInput.listeners
+
+	if result.Err == nil {
+		log.Debugf("Dispatching sweep success for %v to %v listeners",
+			outpoint, len(listeners),
+		)
+	} else {
+		log.Debugf("Dispatching sweep error for %v to %v listeners: %v",
+			outpoint, len(listeners), result.Err,
+		)
+	}
+
+	// Signal all listeners. Channel is buffered. Because we only send once
+	// on every channel, it should never block.
+	for _, resultChan := range listeners {
+		resultChan <- result
+	}
+
+	// Cancel spend notification with chain notifier. This is not necessary
+	// in case of a success, except for that a reorg could happen.
+	if pendInput.ntfnRegCancel != nil {
+		log.Debugf("Canceling spend ntfn for %v", outpoint)
+
+		pendInput.ntfnRegCancel()
+	}
+
+	// Inputs are no longer pending after result has been sent.
+	delete(s.pendingInputs, *outpoint)
+}
+
+// waitForSpend registers a spend notification with the chain notifier. It
+// returns a cancel function that can be used to cancel the registration.
+func (s *UtxoSweeper) waitForSpend(outpoint wire.OutPoint,
+	script []byte, heightHint uint32) (func(), error) {
+
+	log.Debugf("Wait for spend of %v", outpoint)
+
+	spendEvent, err := s.cfg.Notifier.RegisterSpendNtfn(
+		&outpoint, script, heightHint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		select {
+		case spend, ok := <-spendEvent.Spend:
+			if !ok {
+				log.Debugf("Spend ntfn for %v canceled",
+					outpoint)
+				return
+			}
+
+			log.Debugf("Delivering spend ntfn for %v",
+				outpoint)
+			select {
+			case s.spendChan <- spend:
+				log.Debugf("Delivered spend ntfn for %v",
+					outpoint)
+
+			case <-s.quit:
+			}
+		case <-s.quit:
+		}
+	}()
+
+	return spendEvent.Cancel, nil
 }
 
 // CreateSweepTx accepts a list of inputs and signs and generates a txn that
@@ -56,11 +639,16 @@ func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
 func (s *UtxoSweeper) CreateSweepTx(inputs []Input, confTarget uint32,
 	currentBlockHeight uint32) (*wire.MsgTx, error) {
 
-	// Generate the receiving script to which the funds will be swept.
-	pkScript, err := s.cfg.GenSweepScript()
-	if err != nil {
-		return nil, err
+	// Generate the receiving script to which the funds will be swept,
+	// unless the one we generated before hasn't been paid to yet.
+	if s.currentOutputScript == nil {
+		pkScript, err := s.cfg.GenSweepScript()
+		if err != nil {
+			return nil, err
+		}
+		s.currentOutputScript = pkScript
 	}
+	pkScript := s.currentOutputScript
 
 	// Using the txn weight estimate, compute the required txn fee.
 	feePerKw, err := s.cfg.Estimator.EstimateFeePerKW(confTarget)
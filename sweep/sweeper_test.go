@@ -0,0 +1,559 @@
+package sweep
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const defaultTestTimeout = 5 * time.Second
+
+var testPubKey *btcec.PublicKey
+
+func init() {
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		panic(err)
+	}
+	testPubKey = privKey.PubKey()
+}
+
+type mockFeeEstimator struct{}
+
+func (m *mockFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+
+	return lnwallet.SatPerKWeight(1000), nil
+}
+
+func (m *mockFeeEstimator) Start() error {
+	return nil
+}
+
+func (m *mockFeeEstimator) Stop() error {
+	return nil
+}
+
+type mockSigner struct{}
+
+func (m *mockSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	return []byte{}, nil
+}
+
+func (m *mockSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	return &lnwallet.InputScript{}, nil
+}
+
+type mockChainIO struct {
+	bestHeight int32
+}
+
+func (m *mockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
+	return nil, m.bestHeight, nil
+}
+
+func (m *mockChainIO) GetUtxo(op *wire.OutPoint, pkScript []byte,
+	heightHint uint32) (*wire.TxOut, error) {
+
+	return nil, nil
+}
+
+func (m *mockChainIO) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
+	return nil, nil
+}
+
+func (m *mockChainIO) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	return nil, nil
+}
+
+// mockNotifier dispatches the spends of the inputs of the transactions it is
+// instructed to confirm.
+type mockNotifier struct {
+	mtx        sync.Mutex
+	spendChans map[wire.OutPoint][]chan *chainntnfs.SpendDetail
+
+	epochChan chan *chainntnfs.BlockEpoch
+	t         *testing.T
+}
+
+func newMockNotifier(t *testing.T) *mockNotifier {
+	return &mockNotifier{
+		spendChans: make(map[wire.OutPoint][]chan *chainntnfs.SpendDetail),
+		epochChan:  make(chan *chainntnfs.BlockEpoch),
+		t:          t,
+	}
+}
+
+func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	_ []byte, numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent,
+	error) {
+
+	return &chainntnfs.ConfirmationEvent{
+		Confirmed: make(chan *chainntnfs.TxConfirmation),
+	}, nil
+}
+
+func (m *mockNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochChan,
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	_ []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	m.spendChans[*outpoint] = append(m.spendChans[*outpoint], spendChan)
+
+	return &chainntnfs.SpendEvent{
+		Spend:  spendChan,
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) Start() error {
+	return nil
+}
+
+func (m *mockNotifier) Stop() error {
+	return nil
+}
+
+// notifyEpoch delivers a new block at the given height to the sweeper.
+func (m *mockNotifier) notifyEpoch(height int32) {
+	select {
+	case m.epochChan <- &chainntnfs.BlockEpoch{Height: height}:
+	case <-time.After(defaultTestTimeout):
+		m.t.Fatal("epoch event not consumed")
+	}
+}
+
+// confirmTx dispatches the spends of all inputs of the given tx.
+func (m *mockNotifier) confirmTx(tx *wire.MsgTx) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	txHash := tx.TxHash()
+	for i, txIn := range tx.TxIn {
+		outpoint := txIn.PreviousOutPoint
+		for _, spendChan := range m.spendChans[outpoint] {
+			spendChan <- &chainntnfs.SpendDetail{
+				SpentOutPoint:     &outpoint,
+				SpenderTxHash:     &txHash,
+				SpendingTx:        tx,
+				SpenderInputIndex: uint32(i),
+			}
+		}
+		delete(m.spendChans, outpoint)
+	}
+}
+
+// mockSweeperStore is an in-memory SweeperStore.
+type mockSweeperStore struct {
+	mtx         sync.Mutex
+	lastTx      *wire.MsgTx
+	ourTxHashes map[chainhash.Hash]struct{}
+}
+
+func newMockSweeperStore() *mockSweeperStore {
+	return &mockSweeperStore{
+		ourTxHashes: make(map[chainhash.Hash]struct{}),
+	}
+}
+
+func (s *mockSweeperStore) IsOurTx(hash chainhash.Hash) (bool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	_, ok := s.ourTxHashes[hash]
+	return ok, nil
+}
+
+func (s *mockSweeperStore) NotifyPublishTx(tx *wire.MsgTx) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.lastTx = tx
+	s.ourTxHashes[tx.TxHash()] = struct{}{}
+
+	return nil
+}
+
+func (s *mockSweeperStore) GetLastPublishedTx() (*wire.MsgTx, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.lastTx, nil
+}
+
+type sweeperTestContext struct {
+	t *testing.T
+
+	sweeper     *UtxoSweeper
+	notifier    *mockNotifier
+	store       *mockSweeperStore
+	publishChan chan *wire.MsgTx
+	timerChan   chan time.Time
+}
+
+func createSweeperTestContext(t *testing.T,
+	bestHeight int32) *sweeperTestContext {
+
+	ctx := &sweeperTestContext{
+		t:           t,
+		notifier:    newMockNotifier(t),
+		store:       newMockSweeperStore(),
+		publishChan: make(chan *wire.MsgTx, 2),
+		timerChan:   make(chan time.Time),
+	}
+
+	ctx.sweeper = New(&UtxoSweeperConfig{
+		GenSweepScript: func() ([]byte, error) {
+			return []byte{0x00, 0x14}, nil
+		},
+		Estimator: &mockFeeEstimator{},
+		Signer:    &mockSigner{},
+		PublishTransaction: func(tx *wire.MsgTx) error {
+			ctx.publishChan <- tx
+			return nil
+		},
+		NewBatchTimer: func() <-chan time.Time {
+			return ctx.timerChan
+		},
+		Notifier:          ctx.notifier,
+		ChainIO:           &mockChainIO{bestHeight: bestHeight},
+		Store:             ctx.store,
+		SweepTxConfTarget: 6,
+	})
+
+	if err := ctx.sweeper.Start(); err != nil {
+		t.Fatalf("unable to start sweeper: %v", err)
+	}
+
+	return ctx
+}
+
+func (ctx *sweeperTestContext) finish() {
+	if err := ctx.sweeper.Stop(); err != nil {
+		ctx.t.Fatalf("unable to stop sweeper: %v", err)
+	}
+
+	select {
+	case tx := <-ctx.publishChan:
+		ctx.t.Fatalf("unexpected tx published: %v", tx.TxHash())
+	default:
+	}
+}
+
+// restart simulates a restart of the sweeper, which keeps its store.
+func (ctx *sweeperTestContext) restart() {
+	if err := ctx.sweeper.Stop(); err != nil {
+		ctx.t.Fatalf("unable to stop sweeper: %v", err)
+	}
+
+	ctx.sweeper = New(ctx.sweeper.cfg)
+	if err := ctx.sweeper.Start(); err != nil {
+		ctx.t.Fatalf("unable to start sweeper: %v", err)
+	}
+}
+
+// tick expires the batch timer of the sweeper. It fails the test if the
+// sweeper hasn't started the timer.
+func (ctx *sweeperTestContext) tick() {
+	ctx.t.Helper()
+
+	select {
+	case ctx.timerChan <- time.Time{}:
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatal("sweep timer not started")
+	}
+}
+
+// assertNoTick asserts that the sweeper hasn't started the batch timer.
+func (ctx *sweeperTestContext) assertNoTick() {
+	ctx.t.Helper()
+
+	select {
+	case ctx.timerChan <- time.Time{}:
+		ctx.t.Fatal("unexpected sweep timer")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// receiveTx returns the next tx published by the sweeper, asserting that it
+// spends the expected inputs.
+func (ctx *sweeperTestContext) receiveTx(inputs ...Input) *wire.MsgTx {
+	ctx.t.Helper()
+
+	var tx *wire.MsgTx
+	select {
+	case tx = <-ctx.publishChan:
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatal("tx not published")
+	}
+
+	if len(tx.TxIn) != len(inputs) {
+		ctx.t.Fatalf("expected %v inputs, got %v", len(inputs),
+			len(tx.TxIn))
+	}
+
+	spent := make(map[wire.OutPoint]struct{})
+	for _, txIn := range tx.TxIn {
+		spent[txIn.PreviousOutPoint] = struct{}{}
+	}
+	for _, input := range inputs {
+		if _, ok := spent[*input.OutPoint()]; !ok {
+			ctx.t.Fatalf("input %v not spent by sweep tx",
+				input.OutPoint())
+		}
+	}
+
+	return tx
+}
+
+// expectResult asserts that the result channel receives a result spending
+// the input by the given tx, with the given error.
+func (ctx *sweeperTestContext) expectResult(resultChan chan Result,
+	tx *wire.MsgTx, expectedErr error) {
+
+	ctx.t.Helper()
+
+	select {
+	case result := <-resultChan:
+		if result.Err != expectedErr {
+			ctx.t.Fatalf("expected error %v, got %v", expectedErr,
+				result.Err)
+		}
+		if result.Tx.TxHash() != tx.TxHash() {
+			ctx.t.Fatalf("expected tx %v, got %v", tx.TxHash(),
+				result.Tx.TxHash())
+		}
+	case <-time.After(defaultTestTimeout):
+		ctx.t.Fatal("no result received")
+	}
+}
+
+func (ctx *sweeperTestContext) sweepInput(input Input,
+	maturityHeight uint32) chan Result {
+
+	ctx.t.Helper()
+
+	resultChan, err := ctx.sweeper.SweepInput(input, maturityHeight, 0)
+	if err != nil {
+		ctx.t.Fatalf("unable to sweep input: %v", err)
+	}
+
+	return resultChan
+}
+
+func createTestInput(index uint32) Input {
+	input := MakeBaseInput(
+		&wire.OutPoint{Index: index},
+		lnwallet.CommitmentNoDelay,
+		&lnwallet.SignDescriptor{
+			Output: &wire.TxOut{
+				Value:    10000,
+				PkScript: []byte{0x00, 0x14},
+			},
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: testPubKey,
+			},
+		},
+	)
+
+	return &input
+}
+
+// TestSweeperBatchMatureInputs asserts that the sweeper waits for inputs to
+// mature, and sweeps the inputs that are mature at the same height in a
+// single tx.
+func TestSweeperBatchMatureInputs(t *testing.T) {
+	ctx := createSweeperTestContext(t, 100)
+
+	// Offer two inputs that are mature at the current height, and one
+	// that matures a few blocks later.
+	input1 := createTestInput(1)
+	input2 := createTestInput(2)
+	input3 := createTestInput(3)
+	resultChan1 := ctx.sweepInput(input1, 100)
+	resultChan2 := ctx.sweepInput(input2, 0)
+	resultChan3 := ctx.sweepInput(input3, 102)
+
+	// Both mature inputs should be batched in a single sweep tx, which
+	// doesn't include the immature input.
+	ctx.tick()
+	sweepTx := ctx.receiveTx(input1, input2)
+
+	// Offering an already pending input again shouldn't lead to another
+	// sweep, but it should be notified of the same result.
+	resultChan1Again := ctx.sweepInput(input1, 100)
+	ctx.assertNoTick()
+
+	// Once the sweep tx confirms, all callers of the swept inputs should
+	// be notified.
+	ctx.notifier.confirmTx(sweepTx)
+	ctx.expectResult(resultChan1, sweepTx, nil)
+	ctx.expectResult(resultChan1Again, sweepTx, nil)
+	ctx.expectResult(resultChan2, sweepTx, nil)
+
+	// The remaining input shouldn't be swept before it is mature.
+	ctx.notifier.notifyEpoch(101)
+	ctx.assertNoTick()
+
+	ctx.notifier.notifyEpoch(102)
+	ctx.tick()
+	sweepTx = ctx.receiveTx(input3)
+
+	ctx.notifier.confirmTx(sweepTx)
+	ctx.expectResult(resultChan3, sweepTx, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperRemoteSpend asserts that the sweeper reports inputs spent by a
+// tx of another party as remote spends, and sweeps the remaining inputs of
+// its invalidated sweep tx again.
+func TestSweeperRemoteSpend(t *testing.T) {
+	ctx := createSweeperTestContext(t, 100)
+
+	input1 := createTestInput(1)
+	input2 := createTestInput(2)
+	resultChan1 := ctx.sweepInput(input1, 100)
+	resultChan2 := ctx.sweepInput(input2, 100)
+
+	ctx.tick()
+	ctx.receiveTx(input1, input2)
+
+	// The remote party spends the first input before our sweep tx
+	// confirms.
+	remoteTx := wire.NewMsgTx(2)
+	remoteTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: *input1.OutPoint(),
+	})
+	ctx.notifier.confirmTx(remoteTx)
+	ctx.expectResult(resultChan1, remoteTx, ErrRemoteSpend)
+
+	// As our sweep tx can no longer confirm, the second input should be
+	// swept again in a new tx.
+	ctx.tick()
+	sweepTx := ctx.receiveTx(input2)
+
+	ctx.notifier.confirmTx(sweepTx)
+	ctx.expectResult(resultChan2, sweepTx, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperRestart asserts that the sweeper republishes its last sweep tx
+// upon restart, and recognizes the spends by its previous sweep txes as its
+// own.
+func TestSweeperRestart(t *testing.T) {
+	ctx := createSweeperTestContext(t, 100)
+
+	input1 := createTestInput(1)
+	ctx.sweepInput(input1, 100)
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx(input1)
+
+	// Upon restart, the sweeper should republish its last sweep tx.
+	ctx.restart()
+
+	republishedTx := ctx.receiveTx(input1)
+	if republishedTx.TxHash() != sweepTx.TxHash() {
+		t.Fatalf("expected republish of %v, got %v", sweepTx.TxHash(),
+			republishedTx.TxHash())
+	}
+
+	// The input is offered again after the restart, and a new sweep tx is
+	// published for it.
+	resultChan := ctx.sweepInput(input1, 100)
+	ctx.tick()
+	ctx.receiveTx(input1)
+
+	// Once the sweep tx published before the restart confirms, it should
+	// be recognized as ours.
+	ctx.notifier.confirmTx(sweepTx)
+	ctx.expectResult(resultChan, sweepTx, nil)
+
+	ctx.finish()
+}
+
+// TestSweeperReuseOutputScript asserts that the sweeper keeps paying to the
+// same output script when a sweep is retried, and only generates a new one
+// once a sweep tx paying to it has been published.
+func TestSweeperReuseOutputScript(t *testing.T) {
+	ctx := createSweeperTestContext(t, 100)
+
+	var numScripts byte
+	ctx.sweeper.cfg.GenSweepScript = func() ([]byte, error) {
+		numScripts++
+		return []byte{0x00, 0x14, numScripts}, nil
+	}
+
+	// The first attempt to publish a sweep tx fails.
+	publishErr := errors.New("publish failed")
+	ctx.sweeper.cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+		ctx.publishChan <- tx
+		err := publishErr
+		publishErr = nil
+		return err
+	}
+
+	input1 := createTestInput(1)
+	ctx.sweepInput(input1, 100)
+
+	ctx.tick()
+	failedTx := ctx.receiveTx(input1)
+
+	// The sweep is retried at the next block, which should pay to the
+	// same output script.
+	ctx.notifier.notifyEpoch(101)
+	ctx.tick()
+	sweepTx := ctx.receiveTx(input1)
+
+	if !bytes.Equal(sweepTx.TxOut[0].PkScript, failedTx.TxOut[0].PkScript) {
+		t.Fatalf("expected output script %x to be reused, got %x",
+			failedTx.TxOut[0].PkScript, sweepTx.TxOut[0].PkScript)
+	}
+
+	// As this sweep tx has been published, the next one should pay to a
+	// new output script.
+	input2 := createTestInput(2)
+	ctx.sweepInput(input2, 100)
+
+	ctx.tick()
+	nextTx := ctx.receiveTx(input2)
+
+	if bytes.Equal(nextTx.TxOut[0].PkScript, sweepTx.TxOut[0].PkScript) {
+		t.Fatalf("expected new output script, got %x",
+			nextTx.TxOut[0].PkScript)
+	}
+	if numScripts != 2 {
+		t.Fatalf("expected 2 output scripts to be generated, got %v",
+			numScripts)
+	}
+
+	ctx.finish()
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// sweepDestination hands out the output scripts that swept funds are sent
+// to. This covers the outputs we sweep from closed channels, justice
+// transactions, as well as our delivery script of cooperative closes. By
+// default, funds are sent to a fresh address of the wallet. Alternatively, a
+// fixed address, or an extended public key that fresh addresses are derived
+// from can be set, in order to send the funds to cold storage. The
+// destination is persisted, such that it survives restarts.
+type sweepDestination struct {
+	db        *channeldb.DB
+	wallet    lnwallet.WalletController
+	netParams *chaincfg.Params
+
+	// dest is the destination that is currently set, or nil if funds are
+	// sent to the wallet.
+	dest *channeldb.SweepDestination
+
+	// addrScript is the output script of the fixed address of dest, if
+	// set.
+	addrScript []byte
+
+	// branch is the external branch of the extended public key of dest,
+	// that addresses are derived from, if set.
+	branch *hdkeychain.ExtendedKey
+
+	mu sync.Mutex
+}
+
+// newSweepDestination creates a new sweepDestination, loading the
+// destination that was previously set from the database.
+func newSweepDestination(db *channeldb.DB, wallet lnwallet.WalletController,
+	netParams *chaincfg.Params) (*sweepDestination, error) {
+
+	s := &sweepDestination{
+		db:        db,
+		wallet:    wallet,
+		netParams: netParams,
+	}
+
+	dest, err := db.FetchSweepDestination()
+	switch {
+	case err == channeldb.ErrNoSweepDestination:
+		return s, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	if err := s.load(dest); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// load parses the passed destination, and sets it as the current one.
+//
+// NOTE: This method must be called with the mutex held, or before the
+// sweepDestination is used concurrently.
+func (s *sweepDestination) load(dest *channeldb.SweepDestination) error {
+	switch {
+	case dest.Address != "" && dest.XPub != "":
+		return fmt.Errorf("only one of an address or an extended " +
+			"public key can be set as sweep destination")
+
+	case dest.Address != "":
+		addrScript, err := parseSweepAddress(dest.Address, s.netParams)
+		if err != nil {
+			return err
+		}
+
+		s.addrScript = addrScript
+		s.branch = nil
+
+	case dest.XPub != "":
+		branch, err := parseSweepXPub(dest.XPub, s.netParams)
+		if err != nil {
+			return err
+		}
+
+		s.addrScript = nil
+		s.branch = branch
+
+	default:
+		return fmt.Errorf("sweep destination must have either an " +
+			"address or an extended public key")
+	}
+
+	s.dest = dest
+
+	return nil
+}
+
+// parseSweepAddress parses an address to send swept funds to, returning its
+// output script.
+func parseSweepAddress(address string,
+	netParams *chaincfg.Params) ([]byte, error) {
+
+	addr, err := btcutil.DecodeAddress(address, netParams)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sweep address: %v", err)
+	}
+	if !addr.IsForNet(netParams) {
+		return nil, fmt.Errorf("sweep address %v is not for %v",
+			address, netParams.Name)
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
+// parseSweepXPub parses an extended public key to derive the addresses to
+// send swept funds to from, returning its external branch.
+func parseSweepXPub(xpub string,
+	netParams *chaincfg.Params) (*hdkeychain.ExtendedKey, error) {
+
+	key, err := hdkeychain.NewKeyFromString(xpub)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sweep xpub: %v", err)
+	}
+
+	// We only want to know the public keys of the destination, as its
+	// private keys are meant to be kept in cold storage.
+	if key.IsPrivate() {
+		return nil, fmt.Errorf("sweep xpub must be an extended public " +
+			"key, not a private one")
+	}
+	if !key.IsForNet(netParams) {
+		return nil, fmt.Errorf("sweep xpub is not for %v",
+			netParams.Name)
+	}
+
+	return key.Child(0)
+}
+
+// Set sets the destination that swept funds are sent to. Exactly one of the
+// address and the extended public key must be set, or neither of them to
+// send swept funds to the wallet again. If the extended public key is the
+// one that's already set, derivation continues at the next unused index.
+func (s *sweepDestination) Set(address, xpub string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if address == "" && xpub == "" {
+		if err := s.db.DeleteSweepDestination(); err != nil {
+			return err
+		}
+
+		s.dest = nil
+		s.addrScript = nil
+		s.branch = nil
+
+		return nil
+	}
+
+	dest := &channeldb.SweepDestination{
+		Address: address,
+		XPub:    xpub,
+	}
+	if s.dest != nil && xpub != "" && s.dest.XPub == xpub {
+		dest.NextIndex = s.dest.NextIndex
+	}
+
+	// We'll validate the new destination before persisting it, and
+	// restore the current one if that fails.
+	prevDest, prevScript, prevBranch := s.dest, s.addrScript, s.branch
+	if err := s.load(dest); err != nil {
+		return err
+	}
+	if err := s.db.PutSweepDestination(dest); err != nil {
+		s.dest, s.addrScript, s.branch = prevDest, prevScript,
+			prevBranch
+		return err
+	}
+
+	return nil
+}
+
+// Current returns the destination that swept funds are currently sent to,
+// or nil if they're sent to the wallet.
+func (s *sweepDestination) Current() *channeldb.SweepDestination {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.dest == nil {
+		return nil
+	}
+
+	dest := *s.dest
+	return &dest
+}
+
+// GenSweepScript returns the output script that the next swept funds should
+// be sent to.
+func (s *sweepDestination) GenSweepScript() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case s.addrScript != nil:
+		return s.addrScript, nil
+
+	case s.branch != nil:
+		return s.deriveScript()
+
+	default:
+		return newSweepPkScript(s.wallet)
+	}
+}
+
+// deriveScript derives the P2WKH output script of the next unused child key
+// of the extended public key, and persists the index of the child key after
+// it, such that no address is handed out twice.
+//
+// NOTE: This method must be called with the mutex held.
+func (s *sweepDestination) deriveScript() ([]byte, error) {
+	index := s.dest.NextIndex
+	for {
+		child, err := s.branch.Child(index)
+
+		// In the rare case that the child key at this index is
+		// invalid, we'll skip it as BIP-32 prescribes.
+		if err == hdkeychain.ErrInvalidChild {
+			index++
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey, err := child.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		addr, err := btcutil.NewAddressWitnessPubKeyHash(
+			btcutil.Hash160(pubKey.SerializeCompressed()),
+			s.netParams,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		dest := *s.dest
+		dest.NextIndex = index + 1
+		if err := s.db.PutSweepDestination(&dest); err != nil {
+			return nil, err
+		}
+		s.dest = &dest
+
+		return pkScript, nil
+	}
+}
@@ -0,0 +1,194 @@
+// +build !rpctest
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/hdkeychain"
+)
+
+// TestSweepDestination tests that the sweep destination hands out the
+// scripts of the destination that's set, derives a fresh address of an
+// extended public key for each sweep without reusing any across restarts, and
+// rejects invalid destinations.
+func TestSweepDestination(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to create db: %v", err)
+	}
+	defer cleanUp()
+
+	netParams := activeNetParams.Params
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to create private key: %v", err)
+	}
+	wallet := &mockWalletController{rootKey: privKey}
+
+	sweepDest, err := newSweepDestination(cdb, wallet, netParams)
+	if err != nil {
+		t.Fatalf("unable to create sweep destination: %v", err)
+	}
+
+	assertScript := func(expected []byte) {
+		t.Helper()
+
+		pkScript, err := sweepDest.GenSweepScript()
+		if err != nil {
+			t.Fatalf("unable to generate sweep script: %v", err)
+		}
+		if !bytes.Equal(pkScript, expected) {
+			t.Fatalf("expected sweep script %x, got %x", expected,
+				pkScript)
+		}
+	}
+
+	// Without a destination being set, funds should be sent to the
+	// wallet.
+	if sweepDest.Current() != nil {
+		t.Fatalf("expected no sweep destination")
+	}
+	walletScript, err := newSweepPkScript(wallet)
+	if err != nil {
+		t.Fatalf("unable to create wallet script: %v", err)
+	}
+	assertScript(walletScript)
+
+	// When a fixed address is set, all funds should be sent to it.
+	addr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(privKey.PubKey().SerializeCompressed()),
+		netParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	addrScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create script: %v", err)
+	}
+	if err := sweepDest.Set(addr.String(), ""); err != nil {
+		t.Fatalf("unable to set sweep address: %v", err)
+	}
+	assertScript(addrScript)
+	assertScript(addrScript)
+
+	// Next, we'll set an extended public key, after which each sweep
+	// should go to the next address of its external branch.
+	master, err := hdkeychain.NewMaster(
+		bytes.Repeat([]byte{1}, 32), netParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create master key: %v", err)
+	}
+	xpub, err := master.Neuter()
+	if err != nil {
+		t.Fatalf("unable to neuter master key: %v", err)
+	}
+	childScript := func(index uint32) []byte {
+		branch, err := master.Child(0)
+		if err != nil {
+			t.Fatalf("unable to derive branch: %v", err)
+		}
+		child, err := branch.Child(index)
+		if err != nil {
+			t.Fatalf("unable to derive child: %v", err)
+		}
+		pubKey, err := child.ECPubKey()
+		if err != nil {
+			t.Fatalf("unable to get child pubkey: %v", err)
+		}
+		addr, err := btcutil.NewAddressWitnessPubKeyHash(
+			btcutil.Hash160(pubKey.SerializeCompressed()),
+			netParams,
+		)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatalf("unable to create script: %v", err)
+		}
+		return pkScript
+	}
+
+	if err := sweepDest.Set("", xpub.String()); err != nil {
+		t.Fatalf("unable to set sweep xpub: %v", err)
+	}
+	assertScript(childScript(0))
+	assertScript(childScript(1))
+
+	// After a restart, derivation should continue where it left off.
+	sweepDest, err = newSweepDestination(cdb, wallet, netParams)
+	if err != nil {
+		t.Fatalf("unable to create sweep destination: %v", err)
+	}
+	assertScript(childScript(2))
+
+	// Setting the same extended public key again shouldn't reset the
+	// index either.
+	if err := sweepDest.Set("", xpub.String()); err != nil {
+		t.Fatalf("unable to set sweep xpub: %v", err)
+	}
+	assertScript(childScript(3))
+
+	if dest := sweepDest.Current(); dest.NextIndex != 4 {
+		t.Fatalf("expected next index 4, got %v", dest.NextIndex)
+	}
+
+	// Invalid destinations should be rejected, leaving the current one
+	// untouched: an extended private key, keys and addresses of another
+	// network, and both an address and a key at once.
+	mainNetMaster, err := hdkeychain.NewMaster(
+		bytes.Repeat([]byte{1}, 32), &chaincfg.MainNetParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create master key: %v", err)
+	}
+	mainNetXPub, err := mainNetMaster.Neuter()
+	if err != nil {
+		t.Fatalf("unable to neuter master key: %v", err)
+	}
+	mainNetAddr, err := btcutil.NewAddressWitnessPubKeyHash(
+		btcutil.Hash160(privKey.PubKey().SerializeCompressed()),
+		&chaincfg.MainNetParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+
+	invalidDests := []struct {
+		address string
+		xpub    string
+	}{
+		{xpub: master.String()},
+		{xpub: mainNetXPub.String()},
+		{address: mainNetAddr.String()},
+		{address: addr.String(), xpub: xpub.String()},
+	}
+	for _, dest := range invalidDests {
+		if err := sweepDest.Set(dest.address, dest.xpub); err == nil {
+			t.Fatalf("expected sweep destination address=%v, "+
+				"xpub=%v to be rejected", dest.address,
+				dest.xpub)
+		}
+	}
+	assertScript(childScript(4))
+
+	// Finally, once the destination is unset, funds should be sent to the
+	// wallet again.
+	if err := sweepDest.Set("", ""); err != nil {
+		t.Fatalf("unable to unset sweep destination: %v", err)
+	}
+	if sweepDest.Current() != nil {
+		t.Fatalf("expected no sweep destination")
+	}
+	assertScript(walletScript)
+}
@@ -335,11 +335,19 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 	)
 	chainArb.WatchNewChannel(aliceChannelState)
 
+	sweepDest, err := newSweepDestination(
+		dbAlice, cc.wallet, activeNetParams.Params,
+	)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	s := &server{
 		chanDB:        dbAlice,
 		cc:            cc,
 		breachArbiter: breachArbiter,
 		chainArb:      chainArb,
+		sweepDest:     sweepDest,
 	}
 
 	_, currentHeight, err := s.cc.chainIO.GetBestBlock()
@@ -378,6 +386,9 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		activeChanCloses:   make(map[lnwire.ChannelID]*channelCloser),
 		localCloseChanReqs: make(chan *htlcswitch.ChanClose),
 		chanCloseMsgs:      make(chan *closeMsg),
+		activeSplices:      make(map[lnwire.ChannelID]*channelSplicer),
+		chanSpliceMsgs:     make(chan *spliceMsg),
+		spliceTimeouts:     make(chan *channelSplicer),
 
 		queueQuit: make(chan struct{}),
 		quit:      make(chan struct{}),
@@ -69,19 +69,16 @@ import (
 //    height has been fully determined. This results from having received
 //    confirmation of the UTXO we are trying to spend, contained in either the
 //    commitment txn or htlc timeout txn. Once the maturity height is reached,
-//    the utxo nursery will sweep all KNDR outputs scheduled for that height
-//    using a single txn.
+//    the utxo nursery offers the KNDR outputs scheduled for that height to
+//    the sweeper, which batches them with any other mature outputs into a
+//    single txn.
 //
-//    NOTE: Due to the fact that KNDR outputs can be dynamically aggregated and
-//    swept, we make precautions to finalize the KNDR outputs at a particular
-//    height on our first attempt to sweep it. Finalizing involves signing the
-//    sweep transaction and persisting it in the nursery store, and recording
-//    the last finalized height. Any attempts to replay an already finalized
-//    height will result in broadcasting the already finalized txn, ensuring the
-//    nursery does not broadcast different txids for the same batch of KNDR
-//    outputs. The reason txids may change is due to the probabilistic nature of
-//    generating the pkscript in the sweep txn's output, even if the set of
-//    inputs remains static across attempts.
+//    NOTE: The sweeper persists the sweep txns it publishes, and republishes
+//    the last one upon restart. As the nursery offers its KNDR outputs again
+//    after a restart, the sweeper is able to recognize that they were spent
+//    by one of its previous sweep txns, even if the txids of newly crafted
+//    sweep txns differ due to the probabilistic nature of generating the
+//    pkscript in the sweep txn's output.
 //
 //  - GRAD (kidOutput) outputs are KNDR outputs that have successfully been
 //    swept into the user's wallet. A channel is considered mature once all of
@@ -183,10 +180,6 @@ type NurseryConfig struct {
 	// determining outputs in the chain as confirmed.
 	ConfDepth uint32
 
-	// SweepTxConfTarget assigns a confirmation target for sweep txes on
-	// which the fee calculation will be based.
-	SweepTxConfTarget uint32
-
 	// FetchClosedChannels provides access to a user's channels, such that
 	// they can be marked fully closed after incubation has concluded.
 	FetchClosedChannels func(pendingOnly bool) (
@@ -210,9 +203,11 @@ type NurseryConfig struct {
 	// maintained about the utxo nursery's incubating outputs.
 	Store NurseryStore
 
-	// Sweeper provides functionality to generate sweep transactions.
-	// Nursery uses this to sweep final outputs back into the wallet.
-	Sweeper *sweep.UtxoSweeper
+	// SweepInput sweeps an input back to the wallet once the chain has
+	// reached the given maturity height. The height hint bounds the search
+	// for the spend of the input.
+	SweepInput func(input sweep.Input, maturityHeight,
+		heightHint uint32) (chan sweep.Result, error)
 }
 
 // utxoNursery is a system dedicated to incubating time-locked outputs created
@@ -672,7 +667,7 @@ func (u *utxoNursery) reloadPreschool() error {
 
 // reloadClasses reinitializes any height-dependent state transitions for which
 // the utxonursery has not received confirmation, and replays the graduation of
-// all kindergarten and crib outputs for heights that have not been finalized.
+// all kindergarten and crib outputs for heights that have not been graduated.
 // This allows the nursery to reinitialize all state to continue sweeping
 // outputs, even in the event that we missed blocks while offline.
 // reloadClasses is called during the startup of the UTXO Nursery.
@@ -740,29 +735,27 @@ func (u *utxoNursery) reloadClasses(lastGradHeight uint32) error {
 // regraduateClass handles the steps involved in re-registering for
 // confirmations for all still-active outputs at a particular height. This is
 // used during restarts to ensure that any still-pending state transitions are
-// properly registered, so they can be driven by the chain notifier. No
-// transactions or signing are done as a result of this step.
+// properly registered, so they can be driven by the chain notifier. The
+// kindergarten outputs are offered to the sweeper again, which takes care of
+// republishing its sweep txns.
 func (u *utxoNursery) regraduateClass(classHeight uint32) error {
 	// Fetch all information about the crib and kindergarten outputs at
-	// this height. In addition to the outputs, we also retrieve the
-	// finalized kindergarten sweep txn, which will be nil if we have not
-	// attempted this height before, or if no kindergarten outputs exist at
 	// this height.
-	finalTx, kgtnOutputs, cribOutputs, err := u.cfg.Store.FetchClass(
+	kgtnOutputs, cribOutputs, err := u.cfg.Store.FetchClass(
 		classHeight)
 	if err != nil {
 		return err
 	}
 
-	if finalTx != nil {
-		utxnLog.Infof("Re-registering confirmation for kindergarten "+
-			"sweep transaction at height=%d ", classHeight)
+	if len(kgtnOutputs) > 0 {
+		utxnLog.Infof("Re-offering %d kindergarten outputs at "+
+			"height=%d to the sweeper", len(kgtnOutputs),
+			classHeight)
 
-		err = u.sweepMatureOutputs(classHeight, finalTx, kgtnOutputs)
+		err = u.sweepMatureOutputs(classHeight, kgtnOutputs)
 		if err != nil {
-			utxnLog.Errorf("Failed to re-register for kindergarten "+
-				"sweep transaction at height=%d: %v",
-				classHeight, err)
+			utxnLog.Errorf("Failed to re-offer kindergarten "+
+				"outputs at height=%d: %v", classHeight, err)
 			return err
 		}
 	}
@@ -818,8 +811,8 @@ func (u *utxoNursery) incubator(newBlockChan *chainntnfs.BlockEpochEvent) {
 			// chain, which means we might be able to graduate crib
 			// or kindergarten outputs at this height. This involves
 			// broadcasting any presigned htlc timeout txns, as well
-			// as signing and broadcasting a sweep txn that spends
-			// from all kindergarten outputs at this height.
+			// as offering all kindergarten outputs at this height
+			// to the sweeper.
 			height := uint32(epoch.Height)
 			if err := u.graduateClass(height); err != nil {
 				utxnLog.Errorf("error while graduating "+
@@ -846,11 +839,8 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 	u.bestHeight = classHeight
 
 	// Fetch all information about the crib and kindergarten outputs at
-	// this height. In addition to the outputs, we also retrieve the
-	// finalized kindergarten sweep txn, which will be nil if we have not
-	// attempted this height before, or if no kindergarten outputs exist at
 	// this height.
-	finalTx, kgtnOutputs, cribOutputs, err := u.cfg.Store.FetchClass(
+	kgtnOutputs, cribOutputs, err := u.cfg.Store.FetchClass(
 		classHeight)
 	if err != nil {
 		return err
@@ -859,63 +849,12 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 	utxnLog.Infof("Attempting to graduate height=%v: num_kids=%v, "+
 		"num_babies=%v", classHeight, len(kgtnOutputs), len(cribOutputs))
 
-	// Load the last finalized height, so we can determine if the
-	// kindergarten sweep txn should be crafted.
-	lastFinalizedHeight, err := u.cfg.Store.LastFinalizedHeight()
-	if err != nil {
-		return err
-	}
-
-	// If we haven't processed this height before, we finalize the
-	// graduating kindergarten outputs, by signing a sweep transaction that
-	// spends from them. This txn is persisted such that we never broadcast
-	// a different txn for the same height. This allows us to recover from
-	// failures, and watch for the correct txid.
-	if classHeight > lastFinalizedHeight {
-		// If this height has never been finalized, we have never
-		// generated a sweep txn for this height. Generate one if there
-		// are kindergarten outputs or cltv crib outputs to be spent.
-		if len(kgtnOutputs) > 0 {
-			sweepInputs := make([]sweep.Input, len(kgtnOutputs))
-			for i := range kgtnOutputs {
-				sweepInputs[i] = &kgtnOutputs[i]
-			}
-
-			finalTx, err = u.cfg.Sweeper.CreateSweepTx(
-				sweepInputs, u.cfg.SweepTxConfTarget,
-				classHeight,
-			)
-			if err != nil {
-				utxnLog.Errorf("Failed to create sweep txn at "+
-					"height=%d", classHeight)
-				return err
-			}
-		}
-
-		// Persist the kindergarten sweep txn to the nursery store. It
-		// is safe to store a nil finalTx, which happens if there are
-		// no graduating kindergarten outputs.
-		err = u.cfg.Store.FinalizeKinder(classHeight, finalTx)
-		if err != nil {
-			utxnLog.Errorf("Failed to finalize kindergarten at "+
-				"height=%d", classHeight)
-
-			return err
-		}
-
-		// Log if the finalized transaction is non-trivial.
-		if finalTx != nil {
-			utxnLog.Infof("Finalized kindergarten at height=%d ",
-				classHeight)
-		}
-	}
-
-	// Now that the kindergarten sweep txn has either been finalized or
-	// restored, broadcast the txn, and set up notifications that will
-	// transition the swept kindergarten outputs and cltvCrib into
-	// graduated outputs.
-	if finalTx != nil {
-		err := u.sweepMatureOutputs(classHeight, finalTx, kgtnOutputs)
+	// Offer mature kindergarten outputs to the sweeper, which will batch
+	// them together with any other mature outputs into a sweep txn. Once
+	// the outputs are swept, they will be transitioned into graduated
+	// outputs.
+	if len(kgtnOutputs) > 0 {
+		err := u.sweepMatureOutputs(classHeight, kgtnOutputs)
 		if err != nil {
 			utxnLog.Errorf("Failed to sweep %d kindergarten "+
 				"outputs at height=%d: %v",
@@ -940,78 +879,68 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 	return u.cfg.Store.GraduateHeight(classHeight)
 }
 
-// sweepMatureOutputs generates and broadcasts the transaction that transfers
-// control of funds from a prior channel commitment transaction to the user's
-// wallet. The outputs swept were previously time locked (either absolute or
-// relative), but are not mature enough to sweep into the wallet.
-func (u *utxoNursery) sweepMatureOutputs(classHeight uint32, finalTx *wire.MsgTx,
+// sweepMatureOutputs offers the kindergarten outputs that matured at the given
+// height to the sweeper, which transfers control of their funds from a prior
+// channel commitment transaction to the user's wallet. The outputs swept were
+// previously time locked (either absolute or relative), but are now mature
+// enough to sweep into the wallet.
+func (u *utxoNursery) sweepMatureOutputs(classHeight uint32,
 	kgtnOutputs []kidOutput) error {
 
-	utxnLog.Infof("Sweeping %v CSV-delayed outputs with sweep tx "+
-		"(txid=%v): %v", len(kgtnOutputs),
-		finalTx.TxHash(), newLogClosure(func() string {
-			return spew.Sdump(finalTx)
-		}),
-	)
+	utxnLog.Infof("Sweeping %v CSV-delayed outputs with sweep tx for "+
+		"height %v", len(kgtnOutputs), classHeight)
 
-	// With the sweep transaction fully signed, broadcast the transaction
-	// to the network. Additionally, we can stop tracking these outputs as
-	// they've just been swept.
-	err := u.cfg.PublishTransaction(finalTx)
-	if err != nil && err != lnwallet.ErrDoubleSpend {
-		utxnLog.Errorf("unable to broadcast sweep tx: %v, %v",
-			err, spew.Sdump(finalTx))
-		return err
-	}
+	for _, output := range kgtnOutputs {
+		// Create local copy to prevent pointer to loop variable to be
+		// passed in with disastrous consequences.
+		local := output
 
-	return u.registerSweepConf(finalTx, kgtnOutputs, classHeight)
-}
-
-// registerSweepConf is responsible for registering a finalized kindergarten
-// sweep transaction for confirmation notifications. If the confirmation was
-// successfully registered, a goroutine will be spawned that waits for the
-// confirmation, and graduates the provided kindergarten class within the
-// nursery store.
-func (u *utxoNursery) registerSweepConf(finalTx *wire.MsgTx,
-	kgtnOutputs []kidOutput, heightHint uint32) error {
-
-	finalTxID := finalTx.TxHash()
+		// The output was created by a transaction confirmed at its
+		// confirmation height, so it can't have been spent before.
+		resultChan, err := u.cfg.SweepInput(
+			&local, classHeight, local.ConfHeight(),
+		)
+		if err != nil {
+			return err
+		}
 
-	confChan, err := u.cfg.Notifier.RegisterConfirmationsNtfn(
-		&finalTxID, finalTx.TxOut[0].PkScript, u.cfg.ConfDepth,
-		heightHint,
-	)
-	if err != nil {
-		utxnLog.Errorf("unable to register notification for "+
-			"sweep confirmation: %v", finalTxID)
-		return err
+		u.wg.Add(1)
+		go u.waitForSweepConf(classHeight, &local, resultChan)
 	}
 
-	utxnLog.Infof("Registering sweep tx %v for confs at height=%d",
-		finalTxID, heightHint)
-
-	u.wg.Add(1)
-	go u.waitForSweepConf(heightHint, kgtnOutputs, confChan)
-
 	return nil
 }
 
 // waitForSweepConf watches for the confirmation of a sweep transaction
-// containing a batch of kindergarten outputs. Once confirmation has been
-// received, the nursery will mark those outputs as fully graduated, and proceed
-// to mark any mature channels as fully closed in channeldb.
+// containing a kindergarten output. Once confirmation has been received, the
+// nursery will mark the output as fully graduated, and proceed to mark the
+// channel as fully closed in channeldb if all of its outputs have graduated.
 // NOTE(conner): this method MUST be called as a go routine.
 func (u *utxoNursery) waitForSweepConf(classHeight uint32,
-	kgtnOutputs []kidOutput, confChan *chainntnfs.ConfirmationEvent) {
+	output *kidOutput, resultChan chan sweep.Result) {
 
 	defer u.wg.Done()
 
 	select {
-	case _, ok := <-confChan.Confirmed:
+	case result, ok := <-resultChan:
 		if !ok {
-			utxnLog.Errorf("Notification chan closed, can't"+
-				" advance %v graduating outputs",
-				len(kgtnOutputs))
+			utxnLog.Errorf("Notification chan closed, can't" +
+				" advance graduating output")
+			return
+		}
+
+		// In case of a remote spend, still graduate the output. There
+		// is no way to sweep it anymore.
+		if result.Err == sweep.ErrRemoteSpend {
+			utxnLog.Infof("Output %v was spent by remote party",
+				output.OutPoint())
+			break
+		}
+
+		if result.Err != nil {
+			utxnLog.Errorf("Failed to sweep %v at "+
+				"height=%d", output.OutPoint(),
+				classHeight)
 			return
 		}
 
@@ -1024,32 +953,23 @@ func (u *utxoNursery) waitForSweepConf(classHeight uint32,
 
 	// TODO(conner): add retry logic?
 
-	// Mark the confirmed kindergarten outputs as graduated.
-	if err := u.cfg.Store.GraduateKinder(classHeight); err != nil {
-		utxnLog.Errorf("Unable to graduate %v kindergarten outputs: "+
-			"%v", len(kgtnOutputs), err)
+	// Mark the confirmed kindergarten output as graduated.
+	if err := u.cfg.Store.GraduateKinder(classHeight, output); err != nil {
+		utxnLog.Errorf("Unable to graduate kindergarten output %v: %v",
+			output.OutPoint(), err)
 		return
 	}
 
-	utxnLog.Infof("Graduated %d kindergarten outputs from height=%d",
-		len(kgtnOutputs), classHeight)
-
-	// Iterate over the kid outputs and construct a set of all channel
-	// points to which they belong.
-	var possibleCloses = make(map[wire.OutPoint]struct{})
-	for _, kid := range kgtnOutputs {
-		possibleCloses[*kid.OriginChanPoint()] = struct{}{}
-
-	}
+	utxnLog.Infof("Graduated kindergarten output from height=%d",
+		classHeight)
 
-	// Attempt to close each channel, only doing so if all of the channel's
+	// Attempt to close the channel, only doing so if all of the channel's
 	// outputs have been graduated.
-	for chanPoint := range possibleCloses {
-		if err := u.closeAndRemoveIfMature(&chanPoint); err != nil {
-			utxnLog.Errorf("Failed to close and remove channel %v",
-				chanPoint)
-			return
-		}
+	chanPoint := output.OriginChanPoint()
+	if err := u.closeAndRemoveIfMature(chanPoint); err != nil {
+		utxnLog.Errorf("Failed to close and remove channel %v",
+			*chanPoint)
+		return
 	}
 }
 
@@ -5,8 +5,6 @@ package main
 import (
 	"bytes"
 	"fmt"
-	"github.com/lightningnetwork/lnd/channeldb"
-	"github.com/lightningnetwork/lnd/sweep"
 	"io/ioutil"
 	"math"
 	"reflect"
@@ -20,7 +18,9 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 var (
@@ -400,6 +400,7 @@ type nurseryTestContext struct {
 	publishChan chan wire.MsgTx
 	store       *nurseryStoreInterceptor
 	restart     func() bool
+	sweeper     *mockSweeper
 	receiveTx   func() wire.MsgTx
 	t           *testing.T
 }
@@ -432,13 +433,7 @@ func createNurseryTestContext(t *testing.T,
 
 	notifier := newNurseryMockNotifier(t)
 
-	sweeper := sweep.New(&sweep.UtxoSweeperConfig{
-		GenSweepScript: func() ([]byte, error) {
-			return []byte{}, nil
-		},
-		Estimator: &mockFeeEstimator{},
-		Signer:    &nurseryMockSigner{},
-	})
+	sweeper := newMockSweeper(t)
 
 	cfg := NurseryConfig{
 		Notifier: notifier,
@@ -452,9 +447,9 @@ func createNurseryTestContext(t *testing.T,
 				CloseHeight: 0,
 			}, nil
 		},
-		Store:   storeIntercepter,
-		ChainIO: &mockChainIO{},
-		Sweeper: sweeper,
+		Store:      storeIntercepter,
+		ChainIO:    &mockChainIO{},
+		SweepInput: sweeper.sweepInput,
 	}
 
 	publishChan := make(chan wire.MsgTx, 1)
@@ -472,6 +467,7 @@ func createNurseryTestContext(t *testing.T,
 		notifier:    notifier,
 		store:       storeIntercepter,
 		publishChan: publishChan,
+		sweeper:     sweeper,
 		t:           t,
 	}
 
@@ -876,22 +872,18 @@ func testSweepHtlc(t *testing.T, ctx *nurseryTestContext) {
 
 func testSweep(t *testing.T, ctx *nurseryTestContext,
 	afterPublishAssert func()) {
-	// Wait for nursery to publish the sweep tx.
-	sweepTx := ctx.receiveTx()
+	// Wait for nursery to offer the output to the sweeper.
+	ctx.sweeper.expectSweep()
 
 	if ctx.restart() {
-		// Restart will trigger rebroadcast of sweep tx.
-		sweepTx = ctx.receiveTx()
+		// Restart will trigger the output being offered again.
+		ctx.sweeper.expectSweep()
 	}
 
 	afterPublishAssert()
 
-	// Confirm the sweep tx.
-	sweepTxHash := sweepTx.TxHash()
-	err := ctx.notifier.confirmTx(&sweepTxHash, 129)
-	if err != nil {
-		t.Fatal(err)
-	}
+	// Report the output as swept.
+	ctx.sweeper.sweepAll()
 
 	// Wait for output to be promoted in store to GRAD.
 	select {
@@ -949,8 +941,10 @@ func (i *nurseryStoreInterceptor) PreschoolToKinder(kidOutput *kidOutput) error
 	return err
 }
 
-func (i *nurseryStoreInterceptor) GraduateKinder(height uint32) error {
-	err := i.ns.GraduateKinder(height)
+func (i *nurseryStoreInterceptor) GraduateKinder(height uint32,
+	kid *kidOutput) error {
+
+	err := i.ns.GraduateKinder(height, kid)
 
 	i.graduateKinderChan <- struct{}{}
 
@@ -961,22 +955,12 @@ func (i *nurseryStoreInterceptor) FetchPreschools() ([]kidOutput, error) {
 	return i.ns.FetchPreschools()
 }
 
-func (i *nurseryStoreInterceptor) FetchClass(height uint32) (*wire.MsgTx,
-	[]kidOutput, []babyOutput, error) {
+func (i *nurseryStoreInterceptor) FetchClass(height uint32) ([]kidOutput,
+	[]babyOutput, error) {
 
 	return i.ns.FetchClass(height)
 }
 
-func (i *nurseryStoreInterceptor) FinalizeKinder(height uint32,
-	tx *wire.MsgTx) error {
-
-	return i.ns.FinalizeKinder(height, tx)
-}
-
-func (i *nurseryStoreInterceptor) LastFinalizedHeight() (uint32, error) {
-	return i.ns.LastFinalizedHeight()
-}
-
 func (i *nurseryStoreInterceptor) GraduateHeight(height uint32) error {
 	return i.ns.GraduateHeight(height)
 }
@@ -1011,34 +995,62 @@ func (i *nurseryStoreInterceptor) RemoveChannel(chanPoint *wire.OutPoint) error
 	return i.ns.RemoveChannel(chanPoint)
 }
 
-type mockFeeEstimator struct{}
+// mockSweeper records the inputs offered to it, and reports them as swept once
+// instructed to do so.
+type mockSweeper struct {
+	lock sync.Mutex
 
-func (m *mockFeeEstimator) EstimateFeePerKW(
-	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+	resultChans map[wire.OutPoint]chan sweep.Result
+	sweepChan   chan sweep.Input
 
-	return lnwallet.SatPerKWeight(10000), nil
+	t *testing.T
 }
 
-func (m *mockFeeEstimator) Start() error {
-	return nil
-}
-func (m *mockFeeEstimator) Stop() error {
-	return nil
+func newMockSweeper(t *testing.T) *mockSweeper {
+	return &mockSweeper{
+		resultChans: make(map[wire.OutPoint]chan sweep.Result),
+		sweepChan:   make(chan sweep.Input, 1),
+		t:           t,
+	}
 }
 
-type nurseryMockSigner struct {
+func (s *mockSweeper) sweepInput(input sweep.Input, maturityHeight,
+	heightHint uint32) (chan sweep.Result, error) {
+
+	s.t.Logf("Offering input %v to sweeper at height %v",
+		input.OutPoint(), maturityHeight)
+
+	s.sweepChan <- input
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	c := make(chan sweep.Result, 1)
+	s.resultChans[*input.OutPoint()] = c
+
+	return c, nil
 }
 
-func (m *nurseryMockSigner) SignOutputRaw(tx *wire.MsgTx,
-	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+func (s *mockSweeper) expectSweep() {
+	s.t.Helper()
 
-	return []byte{}, nil
+	select {
+	case <-s.sweepChan:
+	case <-time.After(defaultTestTimeout):
+		s.t.Fatal("input not offered to sweeper")
+	}
 }
 
-func (m *nurseryMockSigner) ComputeInputScript(tx *wire.MsgTx,
-	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+func (s *mockSweeper) sweepAll() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for o, c := range s.resultChans {
+		s.t.Logf("Sweeping %v", o)
+		c <- sweep.Result{}
+	}
 
-	return &lnwallet.InputScript{}, nil
+	s.resultChans = make(map[wire.OutPoint]chan sweep.Result)
 }
 
 type nurseryMockNotifier struct {
@@ -0,0 +1,383 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// zeroConfAlias derives the alias short channel ID of the zero-conf channel
+// with the given funding outpoint. The alias identifies the channel until its
+// funding transaction confirms. As it's derived from the funding outpoint,
+// both parties of the channel arrive at the same alias, without having to
+// exchange it.
+func zeroConfAlias(chanPoint wire.OutPoint) lnwire.ShortChannelID {
+	var b [36]byte
+	copy(b[:32], chanPoint.Hash[:])
+	binary.BigEndian.PutUint32(b[32:], chanPoint.Index)
+	h := sha256.Sum256(b[:])
+
+	const numHeights = lnwire.AliasScidEndHeight -
+		lnwire.AliasScidStartHeight
+	height := binary.BigEndian.Uint32(h[:4]) % numHeights
+
+	return lnwire.ShortChannelID{
+		BlockHeight: lnwire.AliasScidStartHeight + height,
+		TxIndex:     binary.BigEndian.Uint32(h[4:8]) & 0xffffff,
+		TxPosition:  uint16(chanPoint.Index),
+	}
+}
+
+// openZeroConfChannel marks a zero-conf channel whose funding transaction has
+// been broadcast as open under its alias short channel ID, and sends the
+// FundingLocked message to the remote peer right away. It then waits for the
+// funding transaction to confirm, after which the channel is moved onto its
+// confirmed short channel ID.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *fundingManager) openZeroConfChannel(peer lnpeer.Peer,
+	completeChan *channeldb.OpenChannel,
+	updates chan *lnrpc.OpenStatusUpdate) {
+
+	fundingPoint := completeChan.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+	alias := zeroConfAlias(fundingPoint)
+
+	fndgLog.Infof("Opening zero-conf ChannelPoint(%v) with alias "+
+		"short_chan_id=%v", fundingPoint, alias)
+
+	if err := completeChan.MarkZeroConfOpen(alias); err != nil {
+		fndgLog.Errorf("unable to mark zero-conf ChannelPoint(%v) "+
+			"as open: %v", fundingPoint, err)
+		return
+	}
+
+	err := f.saveChannelOpeningState(&fundingPoint, markedOpen, &alias)
+	if err != nil {
+		fndgLog.Errorf("error setting channel state to markedOpen: %v",
+			err)
+		return
+	}
+
+	// The channel is now marked as open in the database, so we can allow
+	// the FundingLocked message of the peer to be processed. We remove
+	// the discovery signal as well, as the channel will already be open
+	// once its funding transaction confirms.
+	f.localDiscoveryMtx.Lock()
+	if discoverySignal, ok := f.localDiscoverySignals[chanID]; ok {
+		close(discoverySignal)
+		delete(f.localDiscoverySignals, chanID)
+	}
+	f.localDiscoveryMtx.Unlock()
+
+	if err := f.handleZeroConfOpen(peer, completeChan, &alias); err != nil {
+		fndgLog.Errorf("failed to open zero-conf channel: %v", err)
+		return
+	}
+
+	// Give the caller a final update notifying them that the channel is
+	// now open.
+	if updates != nil {
+		upd := &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_ChanOpen{
+				ChanOpen: &lnrpc.ChannelOpenUpdate{
+					ChannelPoint: &lnrpc.ChannelPoint{
+						FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+							FundingTxidBytes: fundingPoint.Hash[:],
+						},
+						OutputIndex: fundingPoint.Index,
+					},
+				},
+			},
+		}
+
+		select {
+		case updates <- upd:
+		case <-f.quit:
+			return
+		}
+	}
+
+	f.waitForZeroConfConfirmation(completeChan)
+}
+
+// handleZeroConfOpen sends the FundingLocked message of a zero-conf channel,
+// and adds the channel to the router graph under its alias short channel ID.
+// Unlike handleFundingConfirmation, the channel isn't announced, as that
+// requires its funding transaction to be confirmed.
+func (f *fundingManager) handleZeroConfOpen(peer lnpeer.Peer,
+	completeChan *channeldb.OpenChannel,
+	alias *lnwire.ShortChannelID) error {
+
+	lnChannel, err := lnwallet.NewLightningChannel(nil, nil, completeChan)
+	if err != nil {
+		return err
+	}
+	defer lnChannel.Stop()
+
+	err = f.sendFundingLocked(peer, completeChan, lnChannel, alias)
+	if err != nil {
+		return fmt.Errorf("failed sending fundingLocked: %v", err)
+	}
+
+	err = f.addToRouterGraph(completeChan, alias)
+	if err != nil {
+		return fmt.Errorf("failed adding to router graph: %v", err)
+	}
+
+	return nil
+}
+
+// resumeZeroConfChannel resumes the opening process of a zero-conf channel
+// that's still identified by its alias short channel ID after a restart,
+// based on the opening state it was left in.
+//
+// NOTE: This MUST be run as a goroutine.
+func (f *fundingManager) resumeZeroConfChannel(
+	completeChan *channeldb.OpenChannel, channelState channelOpeningState,
+	alias *lnwire.ShortChannelID) {
+
+	defer f.wg.Done()
+
+	switch channelState {
+	case markedOpen:
+		peerChan := make(chan lnpeer.Peer, 1)
+		f.cfg.NotifyWhenOnline(completeChan.IdentityPub, peerChan)
+
+		var peer lnpeer.Peer
+		select {
+		case peer = <-peerChan:
+		case <-f.quit:
+			return
+		}
+
+		err := f.handleZeroConfOpen(peer, completeChan, alias)
+		if err != nil {
+			fndgLog.Errorf("failed to open zero-conf channel: %v",
+				err)
+			return
+		}
+
+	case fundingLockedSent:
+		if err := f.addToRouterGraph(completeChan, alias); err != nil {
+			fndgLog.Errorf("failed adding to router graph: %v", err)
+			return
+		}
+	}
+
+	f.waitForZeroConfConfirmation(completeChan)
+}
+
+// waitForZeroConfConfirmation waits for the funding transaction of an open
+// zero-conf channel to confirm. Once it does, the channel is added to the
+// router graph under its confirmed short channel ID, and announced if it's a
+// public channel. If the funding transaction is double spent instead, the
+// channel is failed.
+//
+// NOTE: The inputs of the funding transaction can only be watched if we know
+// the funding transaction. Otherwise, the channel is only failed if the
+// funding transaction doesn't confirm within maxWaitNumBlocksFundingConf
+// blocks.
+func (f *fundingManager) waitForZeroConfConfirmation(
+	completeChan *channeldb.OpenChannel) {
+
+	fundingPoint := completeChan.FundingOutpoint
+
+	confChan := make(chan *lnwire.ShortChannelID)
+	cancelChan := make(chan struct{})
+	defer close(cancelChan)
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+		f.waitForFundingConfirmation(completeChan, cancelChan, confChan)
+	}()
+
+	spends, err := f.watchFundingInputs(completeChan, cancelChan)
+	if err != nil {
+		fndgLog.Errorf("unable to watch inputs of funding tx for "+
+			"ChannelPoint(%v): %v", fundingPoint, err)
+	}
+
+	epochClient, err := f.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		fndgLog.Errorf("unable to register for epoch notification: %v",
+			err)
+		return
+	}
+	defer epochClient.Cancel()
+
+	maxHeight := completeChan.FundingBroadcastHeight +
+		maxWaitNumBlocksFundingConf
+
+	for {
+		select {
+		case shortChanID, ok := <-confChan:
+			if !ok {
+				fndgLog.Errorf("waiting for funding " +
+					"confirmation failed")
+				return
+			}
+
+			fndgLog.Infof("Zero-conf ChannelPoint(%v) confirmed "+
+				"with short_chan_id=%v", fundingPoint,
+				shortChanID)
+
+			err := f.addToRouterGraph(completeChan, shortChanID)
+			if err != nil {
+				fndgLog.Errorf("failed adding to router "+
+					"graph: %v", err)
+				return
+			}
+
+			err = f.annAfterSixConfs(completeChan, shortChanID)
+			if err != nil {
+				fndgLog.Errorf("failed sending channel "+
+					"announcement: %v", err)
+			}
+			return
+
+		// The inputs of the funding transaction are spent by the
+		// funding transaction itself once it confirms, so only a
+		// spend by any other transaction is a double spend.
+		case spend := <-spends:
+			if *spend.SpenderTxHash == fundingPoint.Hash {
+				continue
+			}
+
+			f.failZeroConfChannel(completeChan, fmt.Errorf(
+				"funding tx input %v double spent by %v",
+				spend.SpentOutPoint, spend.SpenderTxHash,
+			))
+			return
+
+		case epoch, ok := <-epochClient.Epochs:
+			if !ok {
+				return
+			}
+
+			if completeChan.FundingTxn != nil ||
+				uint32(epoch.Height) < maxHeight {
+
+				continue
+			}
+
+			f.failZeroConfChannel(completeChan, fmt.Errorf(
+				"funding tx not confirmed after %v blocks",
+				maxWaitNumBlocksFundingConf,
+			))
+			return
+
+		case <-f.quit:
+			return
+		}
+	}
+}
+
+// watchFundingInputs registers for spend notifications of all inputs of the
+// funding transaction of the given channel. Each spend is delivered over the
+// returned channel, until the passed cancel channel is closed. If the funding
+// transaction isn't known, no spends are delivered.
+func (f *fundingManager) watchFundingInputs(completeChan *channeldb.OpenChannel,
+	cancel <-chan struct{}) (<-chan *chainntnfs.SpendDetail, error) {
+
+	fundingTx := completeChan.FundingTxn
+	if fundingTx == nil {
+		return nil, nil
+	}
+
+	spends := make(chan *chainntnfs.SpendDetail, len(fundingTx.TxIn))
+	for _, txIn := range fundingTx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+
+		// The script of the spent output is only known for our own
+		// inputs, which is only required by light clients.
+		var pkScript []byte
+		if info, err := f.cfg.Wallet.FetchInputInfo(&prevOut); err == nil {
+			pkScript = info.PkScript
+		}
+
+		spendNtfn, err := f.cfg.Notifier.RegisterSpendNtfn(
+			&prevOut, pkScript, completeChan.FundingBroadcastHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		f.wg.Add(1)
+		go func() {
+			defer f.wg.Done()
+			defer spendNtfn.Cancel()
+
+			select {
+			case spend, ok := <-spendNtfn.Spend:
+				if ok {
+					spends <- spend
+				}
+			case <-cancel:
+			case <-f.quit:
+			}
+		}()
+	}
+
+	return spends, nil
+}
+
+// failZeroConfChannel fails a zero-conf channel whose funding transaction
+// won't confirm. The channel is closed within the database, and removed from
+// all sub-systems that use it. The remote peer is sent an error for the
+// channel, such that it stops using it as well.
+func (f *fundingManager) failZeroConfChannel(
+	completeChan *channeldb.OpenChannel, reason error) {
+
+	fundingPoint := completeChan.FundingOutpoint
+	fndgLog.Warnf("Failing zero-conf ChannelPoint(%v): %v", fundingPoint,
+		reason)
+
+	f.deletePendingChannel(completeChan)
+
+	err := f.deleteChannelOpeningState(&fundingPoint)
+	if err != nil {
+		fndgLog.Errorf("unable to delete opening state of "+
+			"ChannelPoint(%v): %v", fundingPoint, err)
+	}
+
+	if err := f.cfg.FailZeroConfChannel(fundingPoint); err != nil {
+		fndgLog.Errorf("unable to fail zero-conf ChannelPoint(%v): %v",
+			fundingPoint, err)
+	}
+
+	// We'll also let the remote peer know the channel failed, as it would
+	// otherwise keep using it until it notices the funding transaction
+	// won't confirm. If the peer is offline, the error is sent once it
+	// reconnects.
+	errMsg := &lnwire.Error{
+		ChanID: lnwire.NewChanIDFromOutPoint(&fundingPoint),
+		Data:   lnwire.ErrorData(reason.Error()),
+	}
+	peerChan := make(chan lnpeer.Peer, 1)
+	f.cfg.NotifyWhenOnline(completeChan.IdentityPub, peerChan)
+
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		select {
+		case peer := <-peerChan:
+			if err := peer.SendMessage(false, errMsg); err != nil {
+				fndgLog.Errorf("unable to send error for "+
+					"zero-conf ChannelPoint(%v): %v",
+					fundingPoint, err)
+			}
+		case <-f.quit:
+		}
+	}()
+}